@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_ai_vision.AiServiceVisionClient", &OracleClient{InitClientFn: initAivisionAiServiceVisionClient})
+	RegisterOracleClient("oci_ai_vision.AiServiceVisionClient", &OracleClient{InitClientFn: initAivisionAiServiceVisionClient, ZeroValue: &oci_ai_vision.AIServiceVisionClient{}})
 }
 
 func initAivisionAiServiceVisionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,10 +10,10 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_apigateway.ApiGatewayClient", &OracleClient{InitClientFn: initApigatewayApiGatewayClient})
-	RegisterOracleClient("oci_apigateway.WorkRequestsClient", &OracleClient{InitClientFn: initApigatewayWorkRequestsClient})
-	RegisterOracleClient("oci_apigateway.DeploymentClient", &OracleClient{InitClientFn: initApigatewayDeploymentClient})
-	RegisterOracleClient("oci_apigateway.GatewayClient", &OracleClient{InitClientFn: initApigatewayGatewayClient})
+	RegisterOracleClient("oci_apigateway.ApiGatewayClient", &OracleClient{InitClientFn: initApigatewayApiGatewayClient, ZeroValue: &oci_apigateway.ApiGatewayClient{}})
+	RegisterOracleClient("oci_apigateway.WorkRequestsClient", &OracleClient{InitClientFn: initApigatewayWorkRequestsClient, ZeroValue: &oci_apigateway.WorkRequestsClient{}})
+	RegisterOracleClient("oci_apigateway.DeploymentClient", &OracleClient{InitClientFn: initApigatewayDeploymentClient, ZeroValue: &oci_apigateway.DeploymentClient{}})
+	RegisterOracleClient("oci_apigateway.GatewayClient", &OracleClient{InitClientFn: initApigatewayGatewayClient, ZeroValue: &oci_apigateway.GatewayClient{}})
 }
 
 func initApigatewayApiGatewayClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
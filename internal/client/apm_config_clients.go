@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_apm_config.ConfigClient", &OracleClient{InitClientFn: initApmconfigConfigClient})
+	RegisterOracleClient("oci_apm_config.ConfigClient", &OracleClient{InitClientFn: initApmconfigConfigClient, ZeroValue: &oci_apm_config.ConfigClient{}})
 }
 
 func initApmconfigConfigClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
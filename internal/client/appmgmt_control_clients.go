@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_appmgmt_control.AppmgmtControlClient", &OracleClient{InitClientFn: initAppmgmtcontrolAppmgmtControlClient})
+	RegisterOracleClient("oci_appmgmt_control.AppmgmtControlClient", &OracleClient{InitClientFn: initAppmgmtcontrolAppmgmtControlClient, ZeroValue: &oci_appmgmt_control.AppmgmtControlClient{}})
 }
 
 func initAppmgmtcontrolAppmgmtControlClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -0,0 +1,174 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+)
+
+const opcRequestIdHeader = "opc-request-id"
+
+// Pre-authenticated request paths carry their secret in the path, e.g. /p/<secret>/n/<namespace>/b/<bucket>/o/<object>
+var preAuthenticatedRequestPathRegex = regexp.MustCompile(`/p/[^/]+/`)
+
+var (
+	auditLogsMutex sync.Mutex
+	auditLogs      = map[string]*AuditLog{}
+)
+
+// AuditLog writes one JSON line per OCI API request. Request and response bodies, headers and query strings are never
+// written, so that secrets sent to or returned by the services do not end up in the log.
+type AuditLog struct {
+	mutex    sync.Mutex
+	writer   io.Writer
+	attempts requestAttempts
+}
+
+// AuditRecord is a line of the audit log
+type AuditRecord struct {
+	Timestamp    string `json:"timestamp"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Status       int    `json:"status,omitempty"`
+	LatencyMs    int64  `json:"latency_ms"`
+	Attempt      int    `json:"attempt"`
+	OpcRequestId string `json:"opc_request_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+type auditContextKey struct{}
+
+type auditContext struct {
+	resourceType string
+	operation    string
+}
+
+// WithAuditContext returns a context that attributes the OCI API calls made with it to the given resource type and
+// operation, e.g. "oci_core_vcn" and "create"
+func WithAuditContext(ctx context.Context, resourceType string, operation string) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, auditContext{resourceType: resourceType, operation: operation})
+}
+
+// GetAuditLog returns the audit log writing to the file at path, opening the file for appending on first use.
+// Provider configurations that use the same path share the same audit log.
+func GetAuditLog(path string) (*AuditLog, error) {
+	auditLogsMutex.Lock()
+	defer auditLogsMutex.Unlock()
+
+	if auditLog, ok := auditLogs[path]; ok {
+		return auditLog, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log: %v", err)
+	}
+	auditLog := NewAuditLog(file)
+	auditLogs[path] = auditLog
+	return auditLog, nil
+}
+
+// NewAuditLog returns an audit log that writes to writer
+func NewAuditLog(writer io.Writer) *AuditLog {
+	return &AuditLog{writer: writer}
+}
+
+func (a *AuditLog) write(record AuditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] unable to write audit log: %v", err)
+		return
+	}
+	line = append(line, '\n')
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if _, err := a.writer.Write(line); err != nil {
+		log.Printf("[WARN] unable to write audit log: %v", err)
+	}
+}
+
+// redactPath returns the URL path without the secrets of pre-authenticated requests
+func redactPath(path string) string {
+	return preAuthenticatedRequestPathRegex.ReplaceAllString(path, "/p/REDACTED/")
+}
+
+// redactError returns the error without the request URL that net/http includes in it
+func redactError(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err.Error()
+	}
+	return err.Error()
+}
+
+// auditLogDispatcher writes a line to the audit log for every request sent by the wrapped dispatcher
+type auditLogDispatcher struct {
+	auditLog   *AuditLog
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d auditLogDispatcher) Do(request *http.Request) (*http.Response, error) {
+	path := redactPath(request.URL.Path)
+	key := request.Method + " " + request.URL.Host + path
+	attempt, _ := d.auditLog.attempts.next(key)
+
+	start := time.Now()
+	response, err := d.dispatcher.Do(request)
+	latency := time.Since(start)
+
+	record := AuditRecord{
+		Timestamp:    start.UTC().Format(time.RFC3339Nano),
+		Method:       request.Method,
+		Path:         path,
+		LatencyMs:    latency.Milliseconds(),
+		Attempt:      attempt,
+		OpcRequestId: request.Header.Get(opcRequestIdHeader),
+	}
+	if auditCtx, ok := request.Context().Value(auditContextKey{}).(auditContext); ok {
+		record.ResourceType = auditCtx.resourceType
+		record.Operation = auditCtx.operation
+	}
+	if response != nil {
+		record.Status = response.StatusCode
+		if opcRequestId := response.Header.Get(opcRequestIdHeader); opcRequestId != "" {
+			record.OpcRequestId = opcRequestId
+		}
+	}
+	if err != nil {
+		record.Error = redactError(err)
+	}
+
+	d.auditLog.attempts.onResponse(key, attempt, response, err)
+	d.auditLog.write(record)
+	return response, err
+}
+
+// ApplyAuditLog installs the audit log of the provider, if any, in front of the HTTP client of the client
+func ApplyAuditLog(auditLog *AuditLog, client *oci_common.BaseClient) {
+	if auditLog == nil || client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(auditLogDispatcher); ok {
+		return
+	}
+	client.HTTPClient = auditLogDispatcher{
+		auditLog:   auditLog,
+		dispatcher: client.HTTPClient,
+	}
+}
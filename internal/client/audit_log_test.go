@@ -0,0 +1,127 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type auditMockDispatcher struct {
+	statusCodes []int
+	err         error
+}
+
+func (d *auditMockDispatcher) Do(request *http.Request) (*http.Response, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	statusCode := d.statusCodes[0]
+	d.statusCodes = d.statusCodes[1:]
+	header := http.Header{}
+	header.Set(opcRequestIdHeader, "response-request-id")
+	return &http.Response{StatusCode: statusCode, Header: header, Request: request}, nil
+}
+
+func readAuditRecords(t *testing.T, buffer *bytes.Buffer) []AuditRecord {
+	var records []AuditRecord
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		record := AuditRecord{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestUnitAuditLogDispatcher(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	auditLog := NewAuditLog(buffer)
+	dispatcher := auditLogDispatcher{
+		auditLog:   auditLog,
+		dispatcher: &auditMockDispatcher{statusCodes: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK, http.StatusOK}},
+	}
+
+	ctx := WithAuditContext(context.Background(), "oci_core_vcn", "read")
+	for i := 0; i < 4; i++ {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..aaa?page=secret", nil)
+		request.Header.Set("Authorization", "Signature secret")
+		_, err := dispatcher.Do(request)
+		assert.NoError(t, err)
+	}
+
+	assert.NotContains(t, buffer.String(), "secret")
+	records := readAuditRecords(t, buffer)
+	assert.Len(t, records, 4)
+	assert.Equal(t, "oci_core_vcn", records[0].ResourceType)
+	assert.Equal(t, "read", records[0].Operation)
+	assert.Equal(t, http.MethodGet, records[0].Method)
+	assert.Equal(t, "/20160918/vcns/ocid1.vcn.oc1..aaa", records[0].Path)
+	assert.Equal(t, "response-request-id", records[0].OpcRequestId)
+	assert.NotEmpty(t, records[0].Timestamp)
+
+	// Throttled requests are retried, the request after a success is a new first attempt
+	var attempts, statuses []int
+	for _, record := range records {
+		attempts = append(attempts, record.Attempt)
+		statuses = append(statuses, record.Status)
+	}
+	assert.Equal(t, []int{1, 2, 3, 1}, attempts)
+	assert.Equal(t, []int{429, 429, 200, 200}, statuses)
+}
+
+func TestUnitAuditLogDispatcher_redaction(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	dispatcher := auditLogDispatcher{
+		auditLog:   NewAuditLog(buffer),
+		dispatcher: &auditMockDispatcher{err: &url.Error{Op: "Put", URL: "https://objectstorage/p/secret/n/ns/b/bucket/o/object?token=secret", Err: errors.New("connection reset")}},
+	}
+
+	request, _ := http.NewRequest(http.MethodPut, "https://objectstorage.us-phoenix-1.oraclecloud.com/p/secret/n/ns/b/bucket/o/object", strings.NewReader("secret"))
+	request.Header.Set(opcRequestIdHeader, "client-request-id")
+	_, err := dispatcher.Do(request)
+	assert.Error(t, err)
+
+	assert.NotContains(t, buffer.String(), "secret")
+	records := readAuditRecords(t, buffer)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "/p/REDACTED/n/ns/b/bucket/o/object", records[0].Path)
+	assert.Equal(t, "connection reset", records[0].Error)
+	assert.Equal(t, "client-request-id", records[0].OpcRequestId)
+	assert.Empty(t, records[0].ResourceType)
+	assert.Equal(t, 0, records[0].Status)
+}
+
+func TestUnitApplyAuditLog(t *testing.T) {
+	dispatcher := &auditMockDispatcher{}
+	client := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyAuditLog(nil, client)
+	assert.Equal(t, dispatcher, client.HTTPClient)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := GetAuditLog(path)
+	assert.NoError(t, err)
+	sameAuditLog, err := GetAuditLog(path)
+	assert.NoError(t, err)
+	assert.True(t, auditLog == sameAuditLog, "the audit log of a path should be shared")
+
+	ApplyAuditLog(auditLog, client)
+	ApplyAuditLog(auditLog, client)
+	audited, ok := client.HTTPClient.(auditLogDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, audited.dispatcher, "the audit log should only be installed once")
+	assert.True(t, auditLog == audited.auditLog)
+
+	_, err = GetAuditLog(filepath.Join(t.TempDir(), "missing", "audit.log"))
+	assert.Error(t, err)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_bastion.BastionClient", &OracleClient{InitClientFn: initBastionBastionClient})
+	RegisterOracleClient("oci_bastion.BastionClient", &OracleClient{InitClientFn: initBastionBastionClient, ZeroValue: &oci_bastion.BastionClient{}})
 }
 
 func initBastionBastionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
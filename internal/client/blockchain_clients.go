@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_blockchain.BlockchainPlatformClient", &OracleClient{InitClientFn: initBlockchainBlockchainPlatformClient})
+	RegisterOracleClient("oci_blockchain.BlockchainPlatformClient", &OracleClient{InitClientFn: initBlockchainBlockchainPlatformClient, ZeroValue: &oci_blockchain.BlockchainPlatformClient{}})
 }
 
 func initBlockchainBlockchainPlatformClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
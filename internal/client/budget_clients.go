@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_budget.BudgetClient", &OracleClient{InitClientFn: initBudgetBudgetClient})
+	RegisterOracleClient("oci_budget.BudgetClient", &OracleClient{InitClientFn: initBudgetBudgetClient, ZeroValue: &oci_budget.BudgetClient{}})
 }
 
 func initBudgetBudgetClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
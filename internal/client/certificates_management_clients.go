@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_certificates_management.CertificatesManagementClient", &OracleClient{InitClientFn: initCertificatesmanagementCertificatesManagementClient})
+	RegisterOracleClient("oci_certificates_management.CertificatesManagementClient", &OracleClient{InitClientFn: initCertificatesmanagementCertificatesManagementClient, ZeroValue: &oci_certificates_management.CertificatesManagementClient{}})
 }
 
 func initCertificatesmanagementCertificatesManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
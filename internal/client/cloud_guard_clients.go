@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_cloud_guard.CloudGuardClient", &OracleClient{InitClientFn: initCloudguardCloudGuardClient})
+	RegisterOracleClient("oci_cloud_guard.CloudGuardClient", &OracleClient{InitClientFn: initCloudguardCloudGuardClient, ZeroValue: &oci_cloud_guard.CloudGuardClient{}})
 }
 
 func initCloudguardCloudGuardClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
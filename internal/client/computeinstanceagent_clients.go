@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_computeinstanceagent.PluginClient", &OracleClient{InitClientFn: initComputeinstanceagentPluginClient})
-	RegisterOracleClient("oci_computeinstanceagent.PluginconfigClient", &OracleClient{InitClientFn: initComputeinstanceagentPluginconfigClient})
+	RegisterOracleClient("oci_computeinstanceagent.PluginClient", &OracleClient{InitClientFn: initComputeinstanceagentPluginClient, ZeroValue: &oci_computeinstanceagent.PluginClient{}})
+	RegisterOracleClient("oci_computeinstanceagent.PluginconfigClient", &OracleClient{InitClientFn: initComputeinstanceagentPluginconfigClient, ZeroValue: &oci_computeinstanceagent.PluginconfigClient{}})
 }
 
 func initComputeinstanceagentPluginClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -0,0 +1,41 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"fmt"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+)
+
+// configureClientFor returns a ConfigureClient that configures the named client with configureClient, then installs
+// the dispatchers of the provider in front of the HTTP client it sets. From the innermost, the dispatchers audit the
+// requests sent, report the progress of the work requests read, record the creations started, trace the requests, wait for the rate limiter, so requests are traced after waiting for it, and add the retry
+// policies of the provider to the requests and their errors.
+func (m *OracleClients) configureClientFor(clientName string, configureClient ConfigureClient) ConfigureClient {
+	return func(client *oci_common.BaseClient) error {
+		if err := configureClient(client); err != nil {
+			return err
+		}
+		// audit after the HTTP replay hook installed by configureClient, so that replayed requests are audited too
+		ApplyAuditLog(m.AuditLog, client)
+		ApplyWorkRequestProgress(client)
+		ApplyCreateRecording(client)
+		ApplyTracing(clientName, client)
+		ApplyRateLimit(m.RateLimiters, clientName, client)
+		ApplyRetryConfigs(m.RetryConfigs, client)
+		return nil
+	}
+}
+
+// configureEndpointClient configures a client created with an endpoint, like the service clients created by GetClient
+func (m *OracleClients) configureEndpointClient(clientName string, client *oci_common.BaseClient) error {
+	m.sdkClientMapLock.RLock()
+	configureClient := m.configureClient
+	m.sdkClientMapLock.RUnlock()
+	if configureClient == nil {
+		return fmt.Errorf("unable to initialize '%s' client", clientName)
+	}
+	return m.configureClientFor(clientName, configureClient)(client)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitConfigureClientFor(t *testing.T) {
+	limiters, err := NewRateLimiters(map[string]string{"oci_kms.KmsCryptoClient": "10/s"}, 0)
+	assert.NoError(t, err)
+
+	dispatcher := &mockDispatcher{statusCode: http.StatusOK}
+	clients := &OracleClients{RateLimiters: limiters, AuditLog: NewAuditLog(&bytes.Buffer{})}
+	configureClient := func(client *oci_common.BaseClient) error {
+		client.HTTPClient = dispatcher
+		return nil
+	}
+
+	client := &oci_common.BaseClient{}
+	assert.NoError(t, clients.configureClientFor("oci_kms.KmsCryptoClient", configureClient)(client))
+	limited, ok := client.HTTPClient.(rateLimitedDispatcher)
+	assert.True(t, ok, "requests should wait for the rate limiter first")
+	recording, ok := limited.dispatcher.(createRecordingDispatcher)
+	assert.True(t, ok)
+	progress, ok := recording.dispatcher.(workRequestProgressDispatcher)
+	assert.True(t, ok)
+	audit, ok := progress.dispatcher.(auditLogDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, audit.dispatcher, "requests should be audited last")
+	assert.True(t, clients.AuditLog == audit.auditLog)
+
+	// The clients of a provider without an audit log are not audited
+	otherClients := &OracleClients{}
+	otherClient := &oci_common.BaseClient{}
+	assert.NoError(t, otherClients.configureClientFor("oci_kms.KmsCryptoClient", configureClient)(otherClient))
+	otherProgress := otherClient.HTTPClient.(createRecordingDispatcher).dispatcher.(workRequestProgressDispatcher)
+	assert.Equal(t, dispatcher, otherProgress.dispatcher, "requests of another provider should not be audited")
+
+	// Clients created with an endpoint get the same dispatchers, once the clients are configured
+	endpointClient := &oci_common.BaseClient{}
+	assert.Error(t, clients.configureEndpointClient("oci_kms.KmsCryptoClient", endpointClient))
+	clients.configureClient = configureClient
+	assert.NoError(t, clients.configureEndpointClient("oci_kms.KmsCryptoClient", endpointClient))
+	assert.Equal(t, client.HTTPClient.(rateLimitedDispatcher).limiter, endpointClient.HTTPClient.(rateLimitedDispatcher).limiter)
+}
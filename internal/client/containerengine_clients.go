@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_containerengine.ContainerEngineClient", &OracleClient{InitClientFn: initContainerengineContainerEngineClient})
+	RegisterOracleClient("oci_containerengine.ContainerEngineClient", &OracleClient{InitClientFn: initContainerengineContainerEngineClient, ZeroValue: &oci_containerengine.ContainerEngineClient{}})
 }
 
 func initContainerengineContainerEngineClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
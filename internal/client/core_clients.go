@@ -10,10 +10,10 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_core.BlockstorageClient", &OracleClient{InitClientFn: initCoreBlockstorageClient})
-	RegisterOracleClient("oci_core.ComputeClient", &OracleClient{InitClientFn: initCoreComputeClient})
-	RegisterOracleClient("oci_core.ComputeManagementClient", &OracleClient{InitClientFn: initCoreComputeManagementClient})
-	RegisterOracleClient("oci_core.VirtualNetworkClient", &OracleClient{InitClientFn: initCoreVirtualNetworkClient})
+	RegisterOracleClient("oci_core.BlockstorageClient", &OracleClient{InitClientFn: initCoreBlockstorageClient, ZeroValue: &oci_core.BlockstorageClient{}})
+	RegisterOracleClient("oci_core.ComputeClient", &OracleClient{InitClientFn: initCoreComputeClient, ZeroValue: &oci_core.ComputeClient{}})
+	RegisterOracleClient("oci_core.ComputeManagementClient", &OracleClient{InitClientFn: initCoreComputeManagementClient, ZeroValue: &oci_core.ComputeManagementClient{}})
+	RegisterOracleClient("oci_core.VirtualNetworkClient", &OracleClient{InitClientFn: initCoreVirtualNetworkClient, ZeroValue: &oci_core.VirtualNetworkClient{}})
 }
 
 func initCoreBlockstorageClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -0,0 +1,62 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+const opcWorkRequestIdHeader = "opc-work-request-id"
+
+// createRecordingDispatcher records the creations accepted through the wrapped dispatcher for the requests whose
+// context has a resume.Recorder, i.e. requests made while creating resources that can be resumed
+type createRecordingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d createRecordingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	response, err := d.dispatcher.Do(request)
+	recorder := resume.RecorderFromContext(request.Context())
+	if recorder == nil || err != nil || response == nil || request.Method != http.MethodPost ||
+		response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response, err
+	}
+
+	var identifier string
+	if response.Body != nil {
+		body, readErr := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+		var created struct {
+			Id string `json:"id"`
+		}
+		if readErr == nil && json.Unmarshal(body, &created) == nil && strings.HasPrefix(created.Id, "ocid1.") {
+			identifier = created.Id
+		}
+	}
+	recorder.Record(response.Header.Get(opcWorkRequestIdHeader), identifier)
+	return response, err
+}
+
+// ApplyCreateRecording installs a dispatcher recording the creations of resources in front of the HTTP client
+// installed by ConfigureClient
+func ApplyCreateRecording(client *oci_common.BaseClient) {
+	if client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(createRecordingDispatcher); ok {
+		return
+	}
+	client.HTTPClient = createRecordingDispatcher{
+		dispatcher: client.HTTPClient,
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+type createMockDispatcher struct {
+	statusCode    int
+	body          string
+	workRequestId string
+}
+
+func (d *createMockDispatcher) Do(request *http.Request) (*http.Response, error) {
+	header := http.Header{}
+	if d.workRequestId != "" {
+		header.Set(opcWorkRequestIdHeader, d.workRequestId)
+	}
+	return &http.Response{StatusCode: d.statusCode, Header: header, Body: ioutil.NopCloser(bytes.NewBufferString(d.body)), Request: request}, nil
+}
+
+func TestUnitCreateRecordingDispatcher(t *testing.T) {
+	type testFormat struct {
+		name       string
+		method     string
+		recorded   bool
+		dispatcher *createMockDispatcher
+		entry      resume.Entry
+	}
+	tests := []testFormat{
+		{
+			name:       "Test created resource is recorded",
+			method:     http.MethodPost,
+			recorded:   true,
+			dispatcher: &createMockDispatcher{statusCode: http.StatusOK, body: `{"id": "ocid1.vcn.oc1..aaa", "lifecycleState": "PROVISIONING"}`},
+			entry:      resume.Entry{Identifier: "ocid1.vcn.oc1..aaa"},
+		},
+		{
+			name:       "Test accepted work request is recorded",
+			method:     http.MethodPost,
+			recorded:   true,
+			dispatcher: &createMockDispatcher{statusCode: http.StatusAccepted, workRequestId: "ocid1.workrequest.oc1..aaa"},
+			entry:      resume.Entry{WorkRequestId: "ocid1.workrequest.oc1..aaa"},
+		},
+		{
+			name:       "Test requests without a recorder are not recorded",
+			method:     http.MethodPost,
+			dispatcher: &createMockDispatcher{statusCode: http.StatusOK, body: `{"id": "ocid1.vcn.oc1..aaa"}`},
+		},
+		{
+			name:       "Test reads are not recorded",
+			method:     http.MethodGet,
+			recorded:   true,
+			dispatcher: &createMockDispatcher{statusCode: http.StatusOK, body: `{"id": "ocid1.vcn.oc1..aaa"}`},
+		},
+		{
+			name:       "Test failed creations are not recorded",
+			method:     http.MethodPost,
+			recorded:   true,
+			dispatcher: &createMockDispatcher{statusCode: http.StatusConflict, body: `{"code": "Conflict"}`, workRequestId: "ocid1.workrequest.oc1..aaa"},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		recorder := resume.NewRecorder(nil, "", "")
+		ctx := context.Background()
+		if test.recorded {
+			ctx = resume.WithRecorder(ctx, recorder)
+		}
+		request, _ := http.NewRequestWithContext(ctx, test.method, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+		response, err := createRecordingDispatcher{dispatcher: test.dispatcher}.Do(request)
+		assert.NoError(t, err)
+
+		body, err := ioutil.ReadAll(response.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, test.dispatcher.body, string(body), "the body should still be readable by the SDK")
+
+		entry, ok := recorder.Entry()
+		assert.Equal(t, test.entry != resume.Entry{}, ok)
+		if ok {
+			assert.Equal(t, test.entry.Identifier, entry.Identifier)
+			assert.Equal(t, test.entry.WorkRequestId, entry.WorkRequestId)
+		}
+	}
+}
+
+func TestUnitApplyCreateRecording(t *testing.T) {
+	dispatcher := &mockDispatcher{statusCode: http.StatusOK}
+	client := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyCreateRecording(client)
+	ApplyCreateRecording(client)
+	recordingDispatcher, ok := client.HTTPClient.(createRecordingDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, recordingDispatcher.dispatcher, "the dispatcher should only be installed once")
+}
@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_data_connectivity.DataConnectivityManagementClient", &OracleClient{InitClientFn: initDataconnectivityDataConnectivityManagementClient})
-	RegisterOracleClient("oci_data_connectivity.NetworkValidationClient", &OracleClient{InitClientFn: initDataconnectivityNetworkValidationClient})
+	RegisterOracleClient("oci_data_connectivity.DataConnectivityManagementClient", &OracleClient{InitClientFn: initDataconnectivityDataConnectivityManagementClient, ZeroValue: &oci_data_connectivity.DataConnectivityManagementClient{}})
+	RegisterOracleClient("oci_data_connectivity.NetworkValidationClient", &OracleClient{InitClientFn: initDataconnectivityNetworkValidationClient, ZeroValue: &oci_data_connectivity.NetworkValidationClient{}})
 }
 
 func initDataconnectivityDataConnectivityManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_data_labeling_service.DataLabelingManagementClient", &OracleClient{InitClientFn: initDatalabelingserviceDataLabelingManagementClient})
+	RegisterOracleClient("oci_data_labeling_service.DataLabelingManagementClient", &OracleClient{InitClientFn: initDatalabelingserviceDataLabelingManagementClient, ZeroValue: &oci_data_labeling_service.DataLabelingManagementClient{}})
 }
 
 func initDatalabelingserviceDataLabelingManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_data_safe.DataSafeClient", &OracleClient{InitClientFn: initDatasafeDataSafeClient})
+	RegisterOracleClient("oci_data_safe.DataSafeClient", &OracleClient{InitClientFn: initDatasafeDataSafeClient, ZeroValue: &oci_data_safe.DataSafeClient{}})
 }
 
 func initDatasafeDataSafeClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_database.DatabaseClient", &OracleClient{InitClientFn: initDatabaseDatabaseClient})
+	RegisterOracleClient("oci_database.DatabaseClient", &OracleClient{InitClientFn: initDatabaseDatabaseClient, ZeroValue: &oci_database.DatabaseClient{}})
 }
 
 func initDatabaseDatabaseClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_database_migration.DatabaseMigrationClient", &OracleClient{InitClientFn: initDatabasemigrationDatabaseMigrationClient})
+	RegisterOracleClient("oci_database_migration.DatabaseMigrationClient", &OracleClient{InitClientFn: initDatabasemigrationDatabaseMigrationClient, ZeroValue: &oci_database_migration.DatabaseMigrationClient{}})
 }
 
 func initDatabasemigrationDatabaseMigrationClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
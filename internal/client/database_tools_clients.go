@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_database_tools.DatabaseToolsClient", &OracleClient{InitClientFn: initDatabasetoolsDatabaseToolsClient})
+	RegisterOracleClient("oci_database_tools.DatabaseToolsClient", &OracleClient{InitClientFn: initDatabasetoolsDatabaseToolsClient, ZeroValue: &oci_database_tools.DatabaseToolsClient{}})
 }
 
 func initDatabasetoolsDatabaseToolsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
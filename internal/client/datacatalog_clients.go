@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_datacatalog.DataCatalogClient", &OracleClient{InitClientFn: initDatacatalogDataCatalogClient})
+	RegisterOracleClient("oci_datacatalog.DataCatalogClient", &OracleClient{InitClientFn: initDatacatalogDataCatalogClient, ZeroValue: &oci_datacatalog.DataCatalogClient{}})
 }
 
 func initDatacatalogDataCatalogClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_dataflow.DataFlowClient", &OracleClient{InitClientFn: initDataflowDataFlowClient})
+	RegisterOracleClient("oci_dataflow.DataFlowClient", &OracleClient{InitClientFn: initDataflowDataFlowClient, ZeroValue: &oci_dataflow.DataFlowClient{}})
 }
 
 func initDataflowDataFlowClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_datascience.DataScienceClient", &OracleClient{InitClientFn: initDatascienceDataScienceClient})
+	RegisterOracleClient("oci_datascience.DataScienceClient", &OracleClient{InitClientFn: initDatascienceDataScienceClient, ZeroValue: &oci_datascience.DataScienceClient{}})
 }
 
 func initDatascienceDataScienceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
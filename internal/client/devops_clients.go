@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_devops.DevopsClient", &OracleClient{InitClientFn: initDevopsDevopsClient})
+	RegisterOracleClient("oci_devops.DevopsClient", &OracleClient{InitClientFn: initDevopsDevopsClient, ZeroValue: &oci_devops.DevopsClient{}})
 }
 
 func initDevopsDevopsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_email.EmailClient", &OracleClient{InitClientFn: initEmailEmailClient})
+	RegisterOracleClient("oci_email.EmailClient", &OracleClient{InitClientFn: initEmailEmailClient, ZeroValue: &oci_email.EmailClient{}})
 }
 
 func initEmailEmailClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
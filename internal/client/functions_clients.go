@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_functions.FunctionsInvokeClient", &OracleClient{InitClientFn: initFunctionsFunctionsInvokeClient})
-	RegisterOracleClient("oci_functions.FunctionsManagementClient", &OracleClient{InitClientFn: initFunctionsFunctionsManagementClient})
+	RegisterOracleClient("oci_functions.FunctionsInvokeClient", &OracleClient{InitClientFn: initFunctionsFunctionsInvokeClient, ZeroValue: &oci_functions.FunctionsInvokeClient{}})
+	RegisterOracleClient("oci_functions.FunctionsManagementClient", &OracleClient{InitClientFn: initFunctionsFunctionsManagementClient, ZeroValue: &oci_functions.FunctionsManagementClient{}})
 }
 
 func initFunctionsFunctionsInvokeClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
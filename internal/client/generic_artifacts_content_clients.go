@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_generic_artifacts_content.GenericArtifactsContentClient", &OracleClient{InitClientFn: initGenericartifactscontentGenericArtifactsContentClient})
+	RegisterOracleClient("oci_generic_artifacts_content.GenericArtifactsContentClient", &OracleClient{InitClientFn: initGenericartifactscontentGenericArtifactsContentClient, ZeroValue: &oci_generic_artifacts_content.GenericArtifactsContentClient{}})
 }
 
 func initGenericartifactscontentGenericArtifactsContentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_health_checks.HealthChecksClient", &OracleClient{InitClientFn: initHealthchecksHealthChecksClient})
+	RegisterOracleClient("oci_health_checks.HealthChecksClient", &OracleClient{InitClientFn: initHealthchecksHealthChecksClient, ZeroValue: &oci_health_checks.HealthChecksClient{}})
 }
 
 func initHealthchecksHealthChecksClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_identity_data_plane.DataplaneClient", &OracleClient{InitClientFn: initIdentitydataplaneDataplaneClient})
+	RegisterOracleClient("oci_identity_data_plane.DataplaneClient", &OracleClient{InitClientFn: initIdentitydataplaneDataplaneClient, ZeroValue: &oci_identity_data_plane.DataplaneClient{}})
 }
 
 func initIdentitydataplaneDataplaneClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
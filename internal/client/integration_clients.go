@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_integration.IntegrationInstanceClient", &OracleClient{InitClientFn: initIntegrationIntegrationInstanceClient})
+	RegisterOracleClient("oci_integration.IntegrationInstanceClient", &OracleClient{InitClientFn: initIntegrationIntegrationInstanceClient, ZeroValue: &oci_integration.IntegrationInstanceClient{}})
 }
 
 func initIntegrationIntegrationInstanceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_limits.LimitsClient", &OracleClient{InitClientFn: initLimitsLimitsClient})
-	RegisterOracleClient("oci_limits.QuotasClient", &OracleClient{InitClientFn: initLimitsQuotasClient})
+	RegisterOracleClient("oci_limits.LimitsClient", &OracleClient{InitClientFn: initLimitsLimitsClient, ZeroValue: &oci_limits.LimitsClient{}})
+	RegisterOracleClient("oci_limits.QuotasClient", &OracleClient{InitClientFn: initLimitsQuotasClient, ZeroValue: &oci_limits.QuotasClient{}})
 }
 
 func initLimitsLimitsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_logging.LoggingManagementClient", &OracleClient{InitClientFn: initLoggingLoggingManagementClient})
+	RegisterOracleClient("oci_logging.LoggingManagementClient", &OracleClient{InitClientFn: initLoggingLoggingManagementClient, ZeroValue: &oci_logging.LoggingManagementClient{}})
 }
 
 func initLoggingLoggingManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
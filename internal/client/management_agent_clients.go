@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_management_agent.ManagementAgentClient", &OracleClient{InitClientFn: initManagementagentManagementAgentClient})
+	RegisterOracleClient("oci_management_agent.ManagementAgentClient", &OracleClient{InitClientFn: initManagementagentManagementAgentClient, ZeroValue: &oci_management_agent.ManagementAgentClient{}})
 }
 
 func initManagementagentManagementAgentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_management_dashboard.DashxApisClient", &OracleClient{InitClientFn: initManagementdashboardDashxApisClient})
+	RegisterOracleClient("oci_management_dashboard.DashxApisClient", &OracleClient{InitClientFn: initManagementdashboardDashxApisClient, ZeroValue: &oci_management_dashboard.DashxApisClient{}})
 }
 
 func initManagementdashboardDashxApisClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
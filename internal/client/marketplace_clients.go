@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_marketplace.MarketplaceClient", &OracleClient{InitClientFn: initMarketplaceMarketplaceClient})
+	RegisterOracleClient("oci_marketplace.MarketplaceClient", &OracleClient{InitClientFn: initMarketplaceMarketplaceClient, ZeroValue: &oci_marketplace.MarketplaceClient{}})
 }
 
 func initMarketplaceMarketplaceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_monitoring.MonitoringClient", &OracleClient{InitClientFn: initMonitoringMonitoringClient})
+	RegisterOracleClient("oci_monitoring.MonitoringClient", &OracleClient{InitClientFn: initMonitoringMonitoringClient, ZeroValue: &oci_monitoring.MonitoringClient{}})
 }
 
 func initMonitoringMonitoringClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
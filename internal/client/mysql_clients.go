@@ -10,11 +10,11 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_mysql.ChannelsClient", &OracleClient{InitClientFn: initMysqlChannelsClient})
-	RegisterOracleClient("oci_mysql.DbBackupsClient", &OracleClient{InitClientFn: initMysqlDbBackupsClient})
-	RegisterOracleClient("oci_mysql.DbSystemClient", &OracleClient{InitClientFn: initMysqlDbSystemClient})
-	RegisterOracleClient("oci_mysql.WorkRequestsClient", &OracleClient{InitClientFn: initMysqlWorkRequestsClient})
-	RegisterOracleClient("oci_mysql.MysqlaasClient", &OracleClient{InitClientFn: initMysqlMysqlaasClient})
+	RegisterOracleClient("oci_mysql.ChannelsClient", &OracleClient{InitClientFn: initMysqlChannelsClient, ZeroValue: &oci_mysql.ChannelsClient{}})
+	RegisterOracleClient("oci_mysql.DbBackupsClient", &OracleClient{InitClientFn: initMysqlDbBackupsClient, ZeroValue: &oci_mysql.DbBackupsClient{}})
+	RegisterOracleClient("oci_mysql.DbSystemClient", &OracleClient{InitClientFn: initMysqlDbSystemClient, ZeroValue: &oci_mysql.DbSystemClient{}})
+	RegisterOracleClient("oci_mysql.WorkRequestsClient", &OracleClient{InitClientFn: initMysqlWorkRequestsClient, ZeroValue: &oci_mysql.WorkRequestsClient{}})
+	RegisterOracleClient("oci_mysql.MysqlaasClient", &OracleClient{InitClientFn: initMysqlMysqlaasClient, ZeroValue: &oci_mysql.MysqlaasClient{}})
 }
 
 func initMysqlChannelsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_nosql.NosqlClient", &OracleClient{InitClientFn: initNosqlNosqlClient})
+	RegisterOracleClient("oci_nosql.NosqlClient", &OracleClient{InitClientFn: initNosqlNosqlClient, ZeroValue: &oci_nosql.NosqlClient{}})
 }
 
 func initNosqlNosqlClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
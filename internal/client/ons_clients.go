@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_ons.NotificationControlPlaneClient", &OracleClient{InitClientFn: initOnsNotificationControlPlaneClient})
-	RegisterOracleClient("oci_ons.NotificationDataPlaneClient", &OracleClient{InitClientFn: initOnsNotificationDataPlaneClient})
+	RegisterOracleClient("oci_ons.NotificationControlPlaneClient", &OracleClient{InitClientFn: initOnsNotificationControlPlaneClient, ZeroValue: &oci_ons.NotificationControlPlaneClient{}})
+	RegisterOracleClient("oci_ons.NotificationDataPlaneClient", &OracleClient{InitClientFn: initOnsNotificationDataPlaneClient, ZeroValue: &oci_ons.NotificationDataPlaneClient{}})
 }
 
 func initOnsNotificationControlPlaneClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,10 +10,10 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_operator_access_control.AccessRequestsClient", &OracleClient{InitClientFn: initOperatoraccesscontrolAccessRequestsClient})
-	RegisterOracleClient("oci_operator_access_control.OperatorActionsClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorActionsClient})
-	RegisterOracleClient("oci_operator_access_control.OperatorControlClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorControlClient})
-	RegisterOracleClient("oci_operator_access_control.OperatorControlAssignmentClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorControlAssignmentClient})
+	RegisterOracleClient("oci_operator_access_control.AccessRequestsClient", &OracleClient{InitClientFn: initOperatoraccesscontrolAccessRequestsClient, ZeroValue: &oci_operator_access_control.AccessRequestsClient{}})
+	RegisterOracleClient("oci_operator_access_control.OperatorActionsClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorActionsClient, ZeroValue: &oci_operator_access_control.OperatorActionsClient{}})
+	RegisterOracleClient("oci_operator_access_control.OperatorControlClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorControlClient, ZeroValue: &oci_operator_access_control.OperatorControlClient{}})
+	RegisterOracleClient("oci_operator_access_control.OperatorControlAssignmentClient", &OracleClient{InitClientFn: initOperatoraccesscontrolOperatorControlAssignmentClient, ZeroValue: &oci_operator_access_control.OperatorControlAssignmentClient{}})
 }
 
 func initOperatoraccesscontrolAccessRequestsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
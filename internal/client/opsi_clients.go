@@ -9,7 +9,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_opsi.OperationsInsightsClient", &OracleClient{InitClientFn: initOperationsinsightsOperationsInsightsClient})
+	RegisterOracleClient("oci_opsi.OperationsInsightsClient", &OracleClient{InitClientFn: initOperationsinsightsOperationsInsightsClient, ZeroValue: &oci_opsi.OperationsInsightsClient{}})
 }
 
 func initOperationsinsightsOperationsInsightsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osmanagement.EventClient", &OracleClient{InitClientFn: initOsmanagementEventClient})
-	RegisterOracleClient("oci_osmanagement.OsManagementClient", &OracleClient{InitClientFn: initOsmanagementOsManagementClient})
+	RegisterOracleClient("oci_osmanagement.EventClient", &OracleClient{InitClientFn: initOsmanagementEventClient, ZeroValue: &oci_osmanagement.EventClient{}})
+	RegisterOracleClient("oci_osmanagement.OsManagementClient", &OracleClient{InitClientFn: initOsmanagementOsManagementClient, ZeroValue: &oci_osmanagement.OsManagementClient{}})
 }
 
 func initOsmanagementEventClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osp_gateway.InvoiceServiceClient", &OracleClient{InitClientFn: initOspgatewayInvoiceServiceClient})
-	RegisterOracleClient("oci_osp_gateway.SubscriptionServiceClient", &OracleClient{InitClientFn: initOspgatewaySubscriptionServiceClient})
+	RegisterOracleClient("oci_osp_gateway.InvoiceServiceClient", &OracleClient{InitClientFn: initOspgatewayInvoiceServiceClient, ZeroValue: &oci_osp_gateway.InvoiceServiceClient{}})
+	RegisterOracleClient("oci_osp_gateway.SubscriptionServiceClient", &OracleClient{InitClientFn: initOspgatewaySubscriptionServiceClient, ZeroValue: &oci_osp_gateway.SubscriptionServiceClient{}})
 }
 
 func initOspgatewayInvoiceServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
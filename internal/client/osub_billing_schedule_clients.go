@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osub_billing_schedule.BillingScheduleClient", &OracleClient{InitClientFn: initOsubbillingscheduleBillingScheduleClient})
+	RegisterOracleClient("oci_osub_billing_schedule.BillingScheduleClient", &OracleClient{InitClientFn: initOsubbillingscheduleBillingScheduleClient, ZeroValue: &oci_osub_billing_schedule.BillingScheduleClient{}})
 }
 
 func initOsubbillingscheduleBillingScheduleClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osub_organization_subscription.OrganizationSubscriptionClient", &OracleClient{InitClientFn: initOsuborganizationsubscriptionOrganizationSubscriptionClient})
+	RegisterOracleClient("oci_osub_organization_subscription.OrganizationSubscriptionClient", &OracleClient{InitClientFn: initOsuborganizationsubscriptionOrganizationSubscriptionClient, ZeroValue: &oci_osub_organization_subscription.OrganizationSubscriptionClient{}})
 }
 
 func initOsuborganizationsubscriptionOrganizationSubscriptionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
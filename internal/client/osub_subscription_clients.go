@@ -10,9 +10,9 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osub_subscription.CommitmentClient", &OracleClient{InitClientFn: initOsubsubscriptionCommitmentClient})
-	RegisterOracleClient("oci_osub_subscription.RatecardClient", &OracleClient{InitClientFn: initOsubsubscriptionRatecardClient})
-	RegisterOracleClient("oci_osub_subscription.SubscriptionClient", &OracleClient{InitClientFn: initOsubsubscriptionSubscriptionClient})
+	RegisterOracleClient("oci_osub_subscription.CommitmentClient", &OracleClient{InitClientFn: initOsubsubscriptionCommitmentClient, ZeroValue: &oci_osub_subscription.CommitmentClient{}})
+	RegisterOracleClient("oci_osub_subscription.RatecardClient", &OracleClient{InitClientFn: initOsubsubscriptionRatecardClient, ZeroValue: &oci_osub_subscription.RatecardClient{}})
+	RegisterOracleClient("oci_osub_subscription.SubscriptionClient", &OracleClient{InitClientFn: initOsubsubscriptionSubscriptionClient, ZeroValue: &oci_osub_subscription.SubscriptionClient{}})
 }
 
 func initOsubsubscriptionCommitmentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
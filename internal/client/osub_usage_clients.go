@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_osub_usage.ComputedUsageClient", &OracleClient{InitClientFn: initOsubusageComputedUsageClient})
+	RegisterOracleClient("oci_osub_usage.ComputedUsageClient", &OracleClient{InitClientFn: initOsubusageComputedUsageClient, ZeroValue: &oci_osub_usage.ComputedUsageClient{}})
 }
 
 func initOsubusageComputedUsageClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -4,8 +4,15 @@
 package client
 
 import (
+	"crypto/rsa"
+	"errors"
 	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
 	"strings"
+	"sync"
 
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
 
@@ -46,24 +53,214 @@ type ServiceClientOverrides struct {
 
 type OracleClient struct {
 	InitClientFn InitSdkClientFn
+	ZeroValue    interface{} // pointer to a zero value of the client type, used when the client cannot be created
 }
 
 type OracleClients struct {
 	Configuration     map[string]string
 	SdkClientMap      map[string]interface{}
 	WorkRequestClient *oci_work_requests.WorkRequestClient
+	RateLimiters      *RateLimiters           // client side rate limits of the provider, nil if requests are not limited
+	RetryConfigs      map[string]*RetryConfig // retry policies of the provider by service, nil if there are none
+	AuditLog          *AuditLog               // audit log of the OCI API calls of the provider, nil if auditing is disabled
+
+	// Tags set by the default_freeform_tags and default_defined_tags provider settings
+	DefaultFreeformTags map[string]interface{}
+	DefaultDefinedTags  map[string]interface{}
+
+	// The following are recorded by CreateSDKClients so that service clients can be created on first use
+	sdkClientMapLock    sync.RWMutex
+	clientCreations     map[string]*clientCreation // the service clients being created, by name
+	registrations       *OracleClientRegistrations // the registered clients, OracleClientRegistrationsVar if nil
+	configProvider      oci_common.ConfigurationProvider
+	configureClient     ConfigureClient
+	clientHostOverrides map[string]string
+	region              string
 }
 
+// GetClient returns the named service client, creating it on first use. Clients that were never configured through
+// CreateSDKClients (e.g. in unit tests) are only looked up in SdkClientMap.
+//
+// The configuration provider and configure function are validated by CreateSDKClients, which returns their errors to
+// the provider configuration. If a service client still fails to initialize, the typed accessors cannot return the
+// error; GetClient returns a client whose requests all fail with it instead, and the next call tries again.
 func (m *OracleClients) GetClient(name string) interface{} {
-	return m.SdkClientMap[name]
+	client, err := m.getOrCreateClient(name)
+	if err != nil {
+		log.Printf("[ERROR] %v", err)
+		return m.failingClient(name, err)
+	}
+	return client
+}
+
+// failingClient creates the named service client with a placeholder configuration, and an interceptor failing every
+// request with err before it is signed or sent. If the placeholder client cannot be created either, a zero value of the
+// client type is returned, whose requests fail the same way.
+func (m *OracleClients) failingClient(name string, err error) interface{} {
+	clientRegistration := m.clientRegistrations().RegisteredClients[name]
+	failRequests := func(client *oci_common.BaseClient) error {
+		client.Interceptor = func(*http.Request) error {
+			return err
+		}
+		return nil
+	}
+	if clientRegistration.InitClientFn != nil {
+		client, fallbackErr := clientRegistration.InitClientFn(failingConfigurationProvider{region: m.region, err: err}, failRequests, ServiceClientOverrides{})
+		if fallbackErr == nil {
+			return client
+		}
+		log.Printf("[ERROR] unable to create a placeholder '%s' client: %v", name, fallbackErr)
+	}
+	return failingZeroClient(clientRegistration, err)
+}
+
+// failingZeroClient returns a new zero value of the client type of the registration, with a base client failing every
+// request with err
+func failingZeroClient(clientRegistration *OracleClient, err error) interface{} {
+	if clientRegistration.ZeroValue == nil {
+		return nil
+	}
+	client := reflect.New(reflect.TypeOf(clientRegistration.ZeroValue).Elem())
+	if baseClient := client.Elem().FieldByName("BaseClient"); baseClient.IsValid() && baseClient.CanSet() {
+		baseClient.Set(reflect.ValueOf(oci_common.BaseClient{
+			UserAgent:   globalvar.DefaultUserAgentProviderName,
+			Host:        "https://unavailable",
+			Interceptor: func(*http.Request) error { return err },
+			Signer:      failingDispatcher{err: err},
+			HTTPClient:  failingDispatcher{err: err},
+		}))
+	}
+	return client.Interface()
+}
+
+// failingDispatcher signs and dispatches no request, it fails them with err
+type failingDispatcher struct {
+	err error
+}
+
+func (d failingDispatcher) Sign(*http.Request) error                 { return d.err }
+func (d failingDispatcher) Do(*http.Request) (*http.Response, error) { return nil, d.err }
+
+// failingConfigurationProvider passes the validation of the SDK client constructors, the key and IDs are never used
+// since failingClient intercepts every request before it is signed
+type failingConfigurationProvider struct {
+	region string
+	err    error
+}
+
+func (p failingConfigurationProvider) TenancyOCID() (string, error)    { return "unavailable", nil }
+func (p failingConfigurationProvider) UserOCID() (string, error)       { return "unavailable", nil }
+func (p failingConfigurationProvider) KeyFingerprint() (string, error) { return "unavailable", nil }
+func (p failingConfigurationProvider) KeyID() (string, error)          { return "unavailable", nil }
+func (p failingConfigurationProvider) Region() (string, error)         { return p.region, nil }
+func (p failingConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return &rsa.PrivateKey{}, nil
+}
+func (p failingConfigurationProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{AuthType: oci_common.UnknownAuthenticationType}, errors.New("unsupported")
+}
+
+// clientRegistrations returns the registered clients the service clients are created from
+func (m *OracleClients) clientRegistrations() *OracleClientRegistrations {
+	if m.registrations != nil {
+		return m.registrations
+	}
+	return OracleClientRegistrationsVar
+}
+
+// clientCreation is the creation of a service client in progress, which the other callers of getOrCreateClient wait for
+type clientCreation struct {
+	done   chan struct{}
+	client interface{}
+	err    error
+}
+
+func (m *OracleClients) getOrCreateClient(name string) (interface{}, error) {
+	m.sdkClientMapLock.RLock()
+	client, ok := m.SdkClientMap[name]
+	configured := m.configProvider != nil
+	m.sdkClientMapLock.RUnlock()
+	if ok || !configured {
+		return client, nil
+	}
+
+	// Each client is created once outside of the lock, so that the creation of a client does not wait for the others
+	m.sdkClientMapLock.Lock()
+	// Another goroutine may have created the client while waiting for the lock
+	if client, ok := m.SdkClientMap[name]; ok {
+		m.sdkClientMapLock.Unlock()
+		return client, nil
+	}
+	creation, inProgress := m.clientCreations[name]
+	if !inProgress {
+		creation = &clientCreation{done: make(chan struct{})}
+		if m.clientCreations == nil {
+			m.clientCreations = make(map[string]*clientCreation)
+		}
+		m.clientCreations[name] = creation
+	}
+	configProvider, configureClient := m.configProvider, m.configureClient
+	serviceClientOverrides := ServiceClientOverrides{}
+	// apply client host override
+	if host, ok := m.clientHostOverrides[name]; ok {
+		serviceClientOverrides.HostUrlOverride = host
+	}
+	m.sdkClientMapLock.Unlock()
+	if inProgress {
+		<-creation.done
+		return creation.client, creation.err
+	}
+
+	defer func() {
+		m.sdkClientMapLock.Lock()
+		// A client failing to initialize is not cached, the next call creates it again
+		if creation.err == nil && creation.client != nil {
+			if m.SdkClientMap == nil {
+				m.SdkClientMap = make(map[string]interface{})
+			}
+			m.SdkClientMap[name] = creation.client
+		}
+		delete(m.clientCreations, name)
+		m.sdkClientMapLock.Unlock()
+		close(creation.done)
+	}()
+	creation.client, creation.err = m.createClient(name, configProvider, configureClient, serviceClientOverrides)
+	return creation.client, creation.err
+}
+
+// createClient creates the named service client with the configuration recorded by CreateSDKClients, nil if it is not
+// registered
+func (m *OracleClients) createClient(name string, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
+	registrations := m.clientRegistrations()
+	if registrations == nil {
+		return nil, nil
+	}
+	clientRegistration, ok := registrations.RegisteredClients[name]
+	if !ok {
+		return nil, nil
+	}
+	if clientRegistration.InitClientFn == nil {
+		return nil, fmt.Errorf("unable to initialize '%s' client", name)
+	}
+
+	log.Printf("[DEBUG] initializing '%s' client", name)
+	client, err := clientRegistration.InitClientFn(configProvider, m.configureClientFor(name, configureClient), serviceClientOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize '%s' client: %v", name, err)
+	}
+	return client, nil
 }
 
 // The following clients require special endpoint information that is only known at Terraform apply time; so they
 // Create duplicate clients reusing the same Configuration provider as the initialized client and adding the endpoint
 // here.
 func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
-	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.FunctionsInvokeClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+	configProvider, err := endpointConfigurationProvider("oci_functions.FunctionsInvokeClient", m.FunctionsInvokeClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(configProvider, endpoint); err == nil {
+		if err = m.configureEndpointClient("oci_functions.FunctionsInvokeClient", &client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -72,8 +269,12 @@ func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci
 	}
 }
 func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.KmsCryptoClient, error) {
-	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.KmsCryptoClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+	configProvider, err := endpointConfigurationProvider("oci_kms.KmsCryptoClient", m.KmsCryptoClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(configProvider, endpoint); err == nil {
+		if err = m.configureEndpointClient("oci_kms.KmsCryptoClient", &client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -83,8 +284,12 @@ func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.K
 }
 
 func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_kms.KmsManagementClient, error) {
-	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.KmsManagementClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+	configProvider, err := endpointConfigurationProvider("oci_kms.KmsManagementClient", m.KmsManagementClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(configProvider, endpoint); err == nil {
+		if err = m.configureEndpointClient("oci_kms.KmsManagementClient", &client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -93,6 +298,15 @@ func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_k
 	}
 }
 
+// endpointConfigurationProvider returns the configuration provider of the client the endpoint clients are created from,
+// which the zero value returned for a client that cannot be created does not have
+func endpointConfigurationProvider(name string, configProvider *oci_common.ConfigurationProvider) (oci_common.ConfigurationProvider, error) {
+	if configProvider == nil || *configProvider == nil {
+		return nil, fmt.Errorf("unable to initialize '%s' client", name)
+	}
+	return *configProvider, nil
+}
+
 func getClientHostOverrides() map[string]string {
 	// Get the host URL override for clients
 	clientHostOverrides := make(map[string]string)
@@ -112,38 +326,69 @@ func getClientHostOverrides() map[string]string {
 	return clientHostOverrides
 }
 
+// validateClientHostOverrides checks the host overrides when the provider is configured, since the clients they apply
+// to are only created on first use. Overrides of unknown clients are ignored, as they may name clients of other releases.
+func validateClientHostOverrides(registrations *OracleClientRegistrations, clientHostOverrides map[string]string) error {
+	for name, host := range clientHostOverrides {
+		if _, ok := registrations.RegisteredClients[name]; !ok {
+			log.Printf("[WARN] ignoring %s entry of unknown client '%s'", globalvar.ClientHostOverridesEnv, name)
+			continue
+		}
+		// The SDK defaults to https for hosts without a scheme
+		hostUrl := host
+		if !strings.Contains(hostUrl, "http") {
+			hostUrl = "https://" + hostUrl
+		}
+		if parsed, err := url.Parse(hostUrl); err != nil || parsed.Host == "" {
+			return fmt.Errorf("invalid %s: host '%s' of client '%s' is not a valid URL", globalvar.ClientHostOverridesEnv, host, name)
+		}
+	}
+	return nil
+}
+
+// CreateSDKClients prepares clients to create service clients with the given configuration provider. Service clients
+// are created on first use by GetClient; only the work request client is created here, which also validates the
+// configuration provider and configure function so that their errors are returned to the provider configuration.
 func CreateSDKClients(clients *OracleClients, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient) (err error) {
-	if OracleClientRegistrationsVar == nil || len(OracleClientRegistrationsVar.RegisteredClients) == 0 {
+	registrations := clients.clientRegistrations()
+	if registrations == nil || len(registrations.RegisteredClients) == 0 {
 		return fmt.Errorf("there are no clients to Create")
 	}
 
-	clientHostOverrides := getClientHostOverrides()
-	for serviceName, clientRegistration := range OracleClientRegistrationsVar.RegisteredClients {
-		if clientRegistration.InitClientFn != nil {
-			serviceClientOverrides := ServiceClientOverrides{}
-			// apply client host override
-			if host, ok := clientHostOverrides[serviceName]; ok {
-				serviceClientOverrides.HostUrlOverride = host
-			}
-
-			clients.SdkClientMap[serviceName], err = clientRegistration.InitClientFn(configProvider, configureClient, serviceClientOverrides)
-			if err != nil {
-				return err
-			}
-		} else {
+	for serviceName, clientRegistration := range registrations.RegisteredClients {
+		if clientRegistration.InitClientFn == nil {
 			return fmt.Errorf("unable to initialize '%s' client", serviceName)
 		}
 	}
 
+	clientHostOverrides := getClientHostOverrides()
+	if err = validateClientHostOverrides(registrations, clientHostOverrides); err != nil {
+		return
+	}
+
 	workRequestClient, err := oci_work_requests.NewWorkRequestClientWithConfigurationProvider(configProvider)
 	if err != nil {
 		return
 	}
-	err = configureClient(&workRequestClient.BaseClient)
+	err = clients.configureClientFor("oci_work_requests.WorkRequestClient", configureClient)(&workRequestClient.BaseClient)
 	if err != nil {
 		return
 	}
+	region, err := configProvider.Region()
+	if err != nil {
+		return
+	}
+
+	clients.sdkClientMapLock.Lock()
+	defer clients.sdkClientMapLock.Unlock()
 	clients.WorkRequestClient = &workRequestClient
+	clients.configProvider = configProvider
+	clients.configureClient = configureClient
+	clients.clientHostOverrides = clientHostOverrides
+	clients.region = region
+	if clients.SdkClientMap == nil {
+		clients.SdkClientMap = make(map[string]interface{})
+	}
 
 	return
 }
@@ -0,0 +1,251 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	oci_core "github.com/oracle/oci-go-sdk/v61/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+func testConfigurationProvider(t testing.TB) oci_common.ConfigurationProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return oci_common.NewRawConfigurationProvider("ocid1.tenancy.oc1..aaa", "ocid1.user.oc1..aaa", "us-phoenix-1", "aa:bb:cc", string(privateKey), nil)
+}
+
+func testConfigureClient(client *oci_common.BaseClient) error {
+	return nil
+}
+
+func TestUnitCreateSDKClients_lazy(t *testing.T) {
+	configProvider := testConfigurationProvider(t)
+	os.Setenv(globalvar.ClientHostOverridesEnv, "oci_core.ComputeClient=https://compute.example.com")
+	defer os.Unsetenv(globalvar.ClientHostOverridesEnv)
+
+	clients := &OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, CreateSDKClients(clients, configProvider, testConfigureClient))
+	assert.NotNil(t, clients.WorkRequestClient)
+	assert.Empty(t, clients.SdkClientMap, "no service client should be created before first use")
+
+	computeClient := clients.ComputeClient()
+	assert.NotNil(t, computeClient)
+	assert.Equal(t, "https://compute.example.com", computeClient.Host)
+	assert.Len(t, clients.SdkClientMap, 1)
+	assert.True(t, computeClient == clients.ComputeClient(), "client should be cached")
+
+	assert.Nil(t, clients.GetClient("oci_unknown.UnknownClient"))
+
+	// Clients built without CreateSDKClients only use the given map
+	prebuilt := &OracleClients{SdkClientMap: map[string]interface{}{"oci_core.ComputeClient": &oci_core.ComputeClient{}}}
+	assert.NotNil(t, prebuilt.ComputeClient())
+	assert.Nil(t, prebuilt.GetClient("oci_core.BlockstorageClient"))
+}
+
+func TestUnitGetClient_concurrentInitialization(t *testing.T) {
+	var initCount int32
+	registrations := &OracleClientRegistrations{RegisteredClients: map[string]*OracleClient{}}
+	registrations.RegisteredClients["oci_test.ConcurrentClient"] = &OracleClient{
+		InitClientFn: func(oci_common.ConfigurationProvider, ConfigureClient, ServiceClientOverrides) (interface{}, error) {
+			atomic.AddInt32(&initCount, 1)
+			return &oci_core.ComputeClient{}, nil
+		},
+	}
+	var failures int32 = 1
+	registrations.RegisteredClients["oci_test.FailingClient"] = &OracleClient{
+		InitClientFn: func(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
+			if atomic.AddInt32(&failures, -1) >= 0 {
+				return nil, errors.New("invalid configuration")
+			}
+			client, err := oci_core.NewComputeClientWithConfigurationProvider(configProvider)
+			if err != nil {
+				return nil, err
+			}
+			if err = configureClient(&client.BaseClient); err != nil {
+				return nil, err
+			}
+			return &client, nil
+		},
+	}
+
+	clients := &OracleClients{SdkClientMap: make(map[string]interface{}), registrations: registrations}
+	assert.NoError(t, CreateSDKClients(clients, testConfigurationProvider(t), testConfigureClient))
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 50)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = clients.GetClient("oci_test.ConcurrentClient")
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&initCount))
+	for _, result := range results {
+		assert.True(t, result == results[0])
+	}
+
+	// A client failing to initialize fails its requests with the error instead of crashing the provider
+	failingClient, ok := clients.GetClient("oci_test.FailingClient").(*oci_core.ComputeClient)
+	assert.True(t, ok)
+	_, err := failingClient.GetInstance(context.Background(), oci_core.GetInstanceRequest{InstanceId: oci_common.String("ocid1.instance.oc1..aaa")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to initialize 'oci_test.FailingClient' client: invalid configuration")
+
+	// The failing client is not cached, the next call initializes it again
+	client, err := clients.getOrCreateClient("oci_test.FailingClient")
+	assert.NoError(t, err)
+	assert.False(t, client == failingClient)
+	assert.True(t, client == clients.GetClient("oci_test.FailingClient"))
+}
+
+func TestUnitGetClient_zeroValue(t *testing.T) {
+	for name, clientRegistration := range OracleClientRegistrationsVar.RegisteredClients {
+		assert.NotNil(t, clientRegistration.ZeroValue, "%s should register the zero value of its client type", name)
+	}
+	registrations := &OracleClientRegistrations{RegisteredClients: map[string]*OracleClient{
+		"oci_test.ZeroValueClient": {
+			InitClientFn: func(oci_common.ConfigurationProvider, ConfigureClient, ServiceClientOverrides) (interface{}, error) {
+				return nil, errors.New("invalid configuration")
+			},
+			ZeroValue: &oci_core.ComputeClient{},
+		},
+	}}
+
+	clients := &OracleClients{SdkClientMap: make(map[string]interface{}), registrations: registrations}
+	assert.NoError(t, CreateSDKClients(clients, testConfigurationProvider(t), testConfigureClient))
+
+	// Neither the client nor its placeholder can be created, a zero value of the client type fails the requests
+	client, ok := clients.GetClient("oci_test.ZeroValueClient").(*oci_core.ComputeClient)
+	assert.True(t, ok)
+	assert.NotNil(t, client)
+	_, err := client.GetInstance(context.Background(), oci_core.GetInstanceRequest{InstanceId: oci_common.String("ocid1.instance.oc1..aaa")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to initialize 'oci_test.ZeroValueClient' client: invalid configuration")
+}
+
+func TestUnitGetClient_slowInitialization(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var initCount int32
+	registrations := &OracleClientRegistrations{RegisteredClients: map[string]*OracleClient{
+		"oci_test.SlowClient": {
+			InitClientFn: func(oci_common.ConfigurationProvider, ConfigureClient, ServiceClientOverrides) (interface{}, error) {
+				if atomic.AddInt32(&initCount, 1) == 1 {
+					close(started)
+				}
+				<-release
+				return &oci_core.ComputeClient{}, nil
+			},
+		},
+		"oci_test.FastClient": {
+			InitClientFn: func(oci_common.ConfigurationProvider, ConfigureClient, ServiceClientOverrides) (interface{}, error) {
+				return &oci_core.BlockstorageClient{}, nil
+			},
+		},
+	}}
+	clients := &OracleClients{SdkClientMap: make(map[string]interface{}), registrations: registrations}
+	assert.NoError(t, CreateSDKClients(clients, testConfigurationProvider(t), testConfigureClient))
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = clients.GetClient("oci_test.SlowClient")
+		}(i)
+	}
+	<-started
+
+	// The other clients are created while the slow client is being created
+	fast := make(chan interface{})
+	go func() {
+		fast <- clients.GetClient("oci_test.FastClient")
+	}()
+	select {
+	case client := <-fast:
+		assert.NotNil(t, client)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the creation of a client should not wait for the creation of another client")
+	}
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&initCount), "the callers should wait for the client being created")
+	for _, result := range results {
+		assert.NotNil(t, result)
+		assert.True(t, result == results[0])
+	}
+}
+
+func TestUnitCreateSDKClients_invalidHostOverrides(t *testing.T) {
+	defer os.Unsetenv(globalvar.ClientHostOverridesEnv)
+	for override, expected := range map[string]string{
+		"oci_unknown.UnknownClient=https://unknown.example.com": "",
+		"oci_unknown.UnknownClient=https://%zz":                 "",
+		"oci_core.ComputeClient=https://%zz":                    "invalid CLIENT_HOST_OVERRIDES: host 'https://%zz' of client 'oci_core.ComputeClient' is not a valid URL",
+		"oci_core.ComputeClient=compute.example.com":            "",
+	} {
+		os.Setenv(globalvar.ClientHostOverridesEnv, override)
+		err := CreateSDKClients(&OracleClients{SdkClientMap: make(map[string]interface{})}, testConfigurationProvider(t), testConfigureClient)
+		if expected == "" {
+			assert.NoError(t, err, override)
+		} else {
+			assert.EqualError(t, err, expected, override)
+		}
+	}
+}
+
+// BenchmarkCreateSDKClients measures provider configure time, which no longer creates every registered client
+func BenchmarkCreateSDKClients(b *testing.B) {
+	configProvider := testConfigurationProvider(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		clients := &OracleClients{SdkClientMap: make(map[string]interface{})}
+		if err := CreateSDKClients(clients, configProvider, testConfigureClient); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCreateSDKClients_allClients measures configure time plus creating every registered client, which is
+// what CreateSDKClients used to do eagerly
+func BenchmarkCreateSDKClients_allClients(b *testing.B) {
+	configProvider := testConfigurationProvider(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		clients := &OracleClients{SdkClientMap: make(map[string]interface{})}
+		if err := CreateSDKClients(clients, configProvider, testConfigureClient); err != nil {
+			b.Fatal(err)
+		}
+		for name := range OracleClientRegistrationsVar.RegisteredClients {
+			if _, err := clients.getOrCreateClient(name); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
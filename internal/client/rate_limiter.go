@@ -0,0 +1,244 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	// AllClientsRateLimitName configures the rate limit of every client without a rate limit of its own
+	AllClientsRateLimitName = "*"
+
+	// Rate limit in requests per second of the clients without a configured rate limit. Their rate adapts to throttling
+	// like a configured one, so that a throttled service is not hammered by retries.
+	DefaultRateLimit = 50.0
+	// Maximum number of requests of a client waiting for a response, unless configured otherwise
+	DefaultMaxInFlightRequests = 16
+
+	// On a 429 the rate is halved, down to this fraction of the configured rate
+	rateLimitMinFactor = 0.1
+	// Every successful request recovers this fraction of the configured rate
+	rateLimitRecoveryFactor = 0.05
+)
+
+// RateLimiters holds a token bucket and a limit of requests in flight per client name, e.g. "oci_identity.IdentityClient"
+type RateLimiters struct {
+	limits      map[string]float64
+	maxInFlight int
+	mutex       sync.Mutex
+	limiters    map[string]*rateLimiter
+}
+
+// rateLimiter is a token bucket that refills at rate tokens per second. The rate adapts to throttling by the service:
+// it is halved on every 429 and recovers slowly towards the configured limit as requests succeed. inFlight is a
+// semaphore bounding the number of requests waiting for a response.
+type rateLimiter struct {
+	mutex      sync.Mutex
+	limit      float64
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+	inFlight   chan struct{}
+}
+
+// ParseRateLimits parses rate limits of the form "oci_identity.IdentityClient=5/s;oci_core.ComputeClient=100/m".
+// Supported units are s, m and h; a plain number is per second.
+func ParseRateLimits(rateLimits string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, item := range strings.Split(rateLimits, globalvar.ColonDelimiter) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		clientNameLimit := strings.Split(item, globalvar.EqualToOperatorDelimiter)
+		if len(clientNameLimit) != 2 {
+			return nil, fmt.Errorf("invalid client rate limit '%s', expected <client name>=<requests>/<s|m|h>", item)
+		}
+		result[strings.TrimSpace(clientNameLimit[0])] = strings.TrimSpace(clientNameLimit[1])
+	}
+	return result, nil
+}
+
+func parseRate(rate string) (float64, error) {
+	requests, unit := rate, "s"
+	if i := strings.Index(rate, "/"); i >= 0 {
+		requests, unit = rate[:i], rate[i+1:]
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(requests), 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid rate '%s', the number of requests must be a positive number", rate)
+	}
+	switch strings.TrimSpace(unit) {
+	case "s":
+		return value, nil
+	case "m":
+		return value / 60, nil
+	case "h":
+		return value / 3600, nil
+	}
+	return 0, fmt.Errorf("invalid rate '%s', the unit must be one of s, m or h", rate)
+}
+
+// NewRateLimiters builds the rate limiters for the given client names and rates, e.g. "oci_identity.IdentityClient" => "5/s".
+// Clients without a rate use the rate of "*", or DefaultRateLimit. Each client sends at most maxInFlight requests at once,
+// DefaultMaxInFlightRequests if maxInFlight is not positive.
+func NewRateLimiters(rateLimits map[string]string, maxInFlight int) (*RateLimiters, error) {
+	limits := make(map[string]float64, len(rateLimits))
+	for clientName, rate := range rateLimits {
+		limit, err := parseRate(rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit for client '%s': %v", clientName, err)
+		}
+		limits[clientName] = limit
+	}
+	if maxInFlight <= 0 {
+		maxInFlight = DefaultMaxInFlightRequests
+	}
+	return &RateLimiters{
+		limits:      limits,
+		maxInFlight: maxInFlight,
+		limiters:    make(map[string]*rateLimiter),
+	}, nil
+}
+
+// getLimiter returns the limiter of the client name, nil if r is nil
+func (r *RateLimiters) getLimiter(clientName string) *rateLimiter {
+	if r == nil {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if limiter, ok := r.limiters[clientName]; ok {
+		return limiter
+	}
+	limit, ok := r.limits[clientName]
+	if !ok {
+		if limit, ok = r.limits[AllClientsRateLimitName]; !ok {
+			limit = DefaultRateLimit
+		}
+	}
+	burst := math.Max(1, math.Ceil(limit))
+	limiter := &rateLimiter{limit: limit, rate: limit, burst: burst, tokens: burst, lastRefill: time.Now(), inFlight: make(chan struct{}, r.maxInFlight)}
+	r.limiters[clientName] = limiter
+	return limiter
+}
+
+// wait blocks until a request may be sent or ctx is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate)
+		l.lastRefill = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mutex.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mutex.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// acquire blocks until fewer than the maximum number of requests are in flight or ctx is done. Every successful
+// acquire must be followed by a release.
+func (l *rateLimiter) acquire(ctx context.Context) error {
+	if l.inFlight == nil {
+		return nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *rateLimiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+func (l *rateLimiter) onResponse(clientName string, statusCode int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if statusCode == http.StatusTooManyRequests {
+		l.rate = math.Max(l.limit*rateLimitMinFactor, l.rate/2)
+		l.tokens = 0
+		log.Printf("[DEBUG] '%s' was throttled, reducing its rate limit to %.2f requests per second", clientName, l.rate)
+		return
+	}
+	l.rate = math.Min(l.limit, l.rate+l.limit*rateLimitRecoveryFactor)
+}
+
+func (l *rateLimiter) currentRate() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.rate
+}
+
+// rateLimitedDispatcher waits for the limiter of the client before each request and adapts the limiter to the response.
+// A request is in flight until its response headers are received: the bodies of downloads may be read long after.
+type rateLimitedDispatcher struct {
+	clientName string
+	limiter    *rateLimiter
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d rateLimitedDispatcher) Do(request *http.Request) (*http.Response, error) {
+	if err := d.limiter.wait(request.Context()); err != nil {
+		return nil, err
+	}
+	if err := d.limiter.acquire(request.Context()); err != nil {
+		return nil, err
+	}
+	response, err := d.dispatcher.Do(request)
+	d.limiter.release()
+	if response != nil {
+		d.limiter.onResponse(d.clientName, response.StatusCode)
+	}
+	return response, err
+}
+
+// ApplyRateLimit installs the rate limiter of limiters for the client name in front of the HTTP client installed by
+// ConfigureClient. Nothing is installed if limiters is nil.
+func ApplyRateLimit(limiters *RateLimiters, clientName string, client *oci_common.BaseClient) {
+	limiter := limiters.getLimiter(clientName)
+	if limiter == nil || client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(rateLimitedDispatcher); ok {
+		return
+	}
+	client.HTTPClient = rateLimitedDispatcher{
+		clientName: clientName,
+		limiter:    limiter,
+		dispatcher: client.HTTPClient,
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDispatcher struct {
+	statusCode int
+	requests   int
+}
+
+func (d *mockDispatcher) Do(request *http.Request) (*http.Response, error) {
+	d.requests++
+	return &http.Response{StatusCode: d.statusCode, Request: request}, nil
+}
+
+func TestUnitParseRateLimits(t *testing.T) {
+	type testFormat struct {
+		name     string
+		input    string
+		output   map[string]string
+		gotError bool
+	}
+	tests := []testFormat{
+		{
+			name:   "Test empty rate limits",
+			input:  "",
+			output: map[string]string{},
+		},
+		{
+			name:   "Test multiple rate limits",
+			input:  "oci_identity.IdentityClient=5/s; *=100/m",
+			output: map[string]string{"oci_identity.IdentityClient": "5/s", "*": "100/m"},
+		},
+		{
+			name:     "Test invalid rate limit",
+			input:    "oci_identity.IdentityClient",
+			gotError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		result, err := ParseRateLimits(test.input)
+		if test.gotError {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.output, result)
+	}
+}
+
+func TestUnitParseRate(t *testing.T) {
+	type testFormat struct {
+		input    string
+		output   float64
+		gotError bool
+	}
+	tests := []testFormat{
+		{input: "5/s", output: 5},
+		{input: "5", output: 5},
+		{input: "120/m", output: 2},
+		{input: "1800/h", output: 0.5},
+		{input: "0/s", gotError: true},
+		{input: "5/d", gotError: true},
+		{input: "many/s", gotError: true},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.input)
+		result, err := parseRate(test.input)
+		if test.gotError {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.output, result)
+	}
+}
+
+func TestUnitApplyRateLimit(t *testing.T) {
+	limiters, err := NewRateLimiters(map[string]string{"oci_identity.IdentityClient": "10/s", "*": "100/s"}, 4)
+	assert.NoError(t, err)
+	_, err = NewRateLimiters(map[string]string{"oci_identity.IdentityClient": "fast"}, 0)
+	assert.Error(t, err)
+
+	dispatcher := &mockDispatcher{statusCode: http.StatusOK}
+	identityClient := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyRateLimit(limiters, "oci_identity.IdentityClient", identityClient)
+	ApplyRateLimit(limiters, "oci_identity.IdentityClient", identityClient)
+	limited, ok := identityClient.HTTPClient.(rateLimitedDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, limited.dispatcher, "the limiter should only be installed once")
+	assert.Equal(t, 10.0, limited.limiter.limit)
+	assert.Equal(t, 4, cap(limited.limiter.inFlight))
+
+	// Clients without a limit of their own use the limit of all clients, and share it with clients of the same name
+	computeClient := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyRateLimit(limiters, "oci_core.ComputeClient", computeClient)
+	assert.Equal(t, 100.0, computeClient.HTTPClient.(rateLimitedDispatcher).limiter.limit)
+	otherComputeClient := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyRateLimit(limiters, "oci_core.ComputeClient", otherComputeClient)
+	assert.True(t, computeClient.HTTPClient.(rateLimitedDispatcher).limiter == otherComputeClient.HTTPClient.(rateLimitedDispatcher).limiter)
+
+	// Clients get an adaptive default limit if no limit is configured
+	defaultLimiters, err := NewRateLimiters(nil, 0)
+	assert.NoError(t, err)
+	defaultClient := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyRateLimit(defaultLimiters, "oci_identity.IdentityClient", defaultClient)
+	defaultLimiter := defaultClient.HTTPClient.(rateLimitedDispatcher).limiter
+	assert.Equal(t, DefaultRateLimit, defaultLimiter.limit)
+	assert.Equal(t, DefaultMaxInFlightRequests, cap(defaultLimiter.inFlight))
+
+	// No rate limiters
+	unlimitedClient := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyRateLimit(nil, "oci_identity.IdentityClient", unlimitedClient)
+	assert.Equal(t, dispatcher, unlimitedClient.HTTPClient)
+}
+
+func TestUnitRateLimiter_wait(t *testing.T) {
+	limiter := &rateLimiter{limit: 20, rate: 20, burst: 2, tokens: 2, lastRefill: time.Now()}
+
+	// The burst is available immediately, the next request waits for a token
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, limiter.wait(context.Background()))
+	}
+	elapsed := time.Since(start)
+	assert.True(t, elapsed >= 40*time.Millisecond, "waited %s", elapsed)
+
+	// Waiting stops when the context is cancelled
+	limiter = &rateLimiter{limit: 0.01, rate: 0.01, burst: 1, tokens: 0, lastRefill: time.Now()}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.wait(ctx))
+}
+
+func TestUnitRateLimiter_inFlight(t *testing.T) {
+	limiter := &rateLimiter{limit: 1000, rate: 1000, burst: 1000, tokens: 1000, lastRefill: time.Now(), inFlight: make(chan struct{}, 2)}
+	assert.NoError(t, limiter.acquire(context.Background()))
+	assert.NoError(t, limiter.acquire(context.Background()))
+
+	// A third request waits until one of the requests in flight completes
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.acquire(ctx))
+	limiter.release()
+	assert.NoError(t, limiter.acquire(context.Background()))
+
+	// The dispatcher releases its slot once the response is received
+	limiter.release()
+	dispatcher := &mockDispatcher{statusCode: http.StatusOK}
+	limited := rateLimitedDispatcher{clientName: "oci_core.VirtualNetworkClient", limiter: limiter, dispatcher: dispatcher}
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	for i := 0; i < 3; i++ {
+		_, err := limited.Do(request)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, len(limiter.inFlight))
+	assert.Equal(t, 3, dispatcher.requests)
+}
+
+func TestUnitRateLimitedDispatcher_adaptsToThrottling(t *testing.T) {
+	limiter := &rateLimiter{limit: 1000, rate: 1000, burst: 1000, tokens: 1000, lastRefill: time.Now()}
+	dispatcher := &mockDispatcher{statusCode: http.StatusTooManyRequests}
+	limited := rateLimitedDispatcher{clientName: "oci_core.VirtualNetworkClient", limiter: limiter, dispatcher: dispatcher}
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+
+	_, err := limited.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, 500.0, limiter.currentRate())
+
+	// The rate never drops below a fraction of the configured limit
+	for i := 0; i < 10; i++ {
+		limiter.onResponse("oci_core.VirtualNetworkClient", http.StatusTooManyRequests)
+	}
+	assert.Equal(t, 100.0, limiter.currentRate())
+
+	// Successful requests recover the rate up to the configured limit
+	dispatcher.statusCode = http.StatusOK
+	_, err = limited.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, 150.0, limiter.currentRate())
+	for i := 0; i < 100; i++ {
+		limiter.onResponse("oci_core.VirtualNetworkClient", http.StatusOK)
+	}
+	assert.Equal(t, 1000.0, limiter.currentRate())
+	assert.Equal(t, 2, dispatcher.requests)
+}
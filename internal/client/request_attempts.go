@@ -0,0 +1,52 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Bounds the number of failed requests remembered to number retry attempts
+const maxTrackedFailedRequests = 1000
+
+// requestAttempts numbers the attempts of requests sent through a dispatcher. The SDK retries a request by sending
+// it again, so a request that repeats the method and URL of a request that failed with a retryable error is counted
+// as its next attempt.
+type requestAttempts struct {
+	mutex  sync.Mutex
+	failed map[string]failedRequest
+}
+
+type failedRequest struct {
+	attempt  int
+	failedAt time.Time
+}
+
+// next returns the attempt number of a request and, for retries, when the previous attempt failed
+func (r *requestAttempts) next(key string) (int, time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	failed := r.failed[key]
+	return failed.attempt + 1, failed.failedAt
+}
+
+func (r *requestAttempts) onResponse(key string, attempt int, response *http.Response, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err == nil && (response == nil || !isRetryableStatus(response.StatusCode)) {
+		delete(r.failed, key)
+		return
+	}
+	if r.failed == nil || len(r.failed) >= maxTrackedFailedRequests {
+		r.failed = make(map[string]failedRequest)
+	}
+	r.failed[key] = failedRequest{attempt: attempt, failedAt: time.Now()}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusConflict || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+)
+
+// RetryConfig is the retry policy of a service set in the provider configuration, applied on top of the defaults of
+// the service
+type RetryConfig struct {
+	// Maximum number of attempts of a request, 0 for no limit
+	MaxAttempts uint
+	// Duration for which retryable errors are retried, instead of the duration of the service defaults
+	MaxDuration *time.Duration
+	// If set, the errors that are retried besides network and eventual consistency errors, instead of the errors
+	// retried by the service defaults
+	RetryableStatusCodes  []int
+	RetryableServiceCodes []string
+	// Backoff strategy, quadratic if empty
+	Backoff string
+}
+
+type retryConfigsContextKey struct{}
+
+// WithRetryConfigs returns a context carrying the retry policies of a provider by service
+func WithRetryConfigs(ctx context.Context, retryConfigs map[string]*RetryConfig) context.Context {
+	return context.WithValue(ctx, retryConfigsContextKey{}, retryConfigs)
+}
+
+// RetryConfigsFromContext returns the retry policies carried by ctx, nil if there are none
+func RetryConfigsFromContext(ctx context.Context) map[string]*RetryConfig {
+	retryConfigs, _ := ctx.Value(retryConfigsContextKey{}).(map[string]*RetryConfig)
+	return retryConfigs
+}
+
+// ResponseRetryConfigs returns the retry policies of the provider whose client made the attempt of the response, nil
+// if there are none. The retry policies of the requests are shared by all providers, they find the policies of the
+// provider in the responses and errors of the attempts.
+func ResponseRetryConfigs(response oci_common.OCIOperationResponse) map[string]*RetryConfig {
+	var retryConfigErr retryConfigError
+	if errors.As(response.Error, &retryConfigErr) {
+		return retryConfigErr.retryConfigs
+	}
+	if response.Response != nil {
+		if httpResponse := response.Response.HTTPResponse(); httpResponse != nil && httpResponse.Request != nil {
+			return RetryConfigsFromContext(httpResponse.Request.Context())
+		}
+	}
+	return nil
+}
+
+// retryConfigDispatcher adds the retry policies of the provider to the context of the requests, which the responses
+// keep, and to the errors of the requests without a response
+type retryConfigDispatcher struct {
+	retryConfigs map[string]*RetryConfig
+	dispatcher   oci_common.HTTPRequestDispatcher
+}
+
+func (d retryConfigDispatcher) Do(request *http.Request) (*http.Response, error) {
+	request = request.WithContext(WithRetryConfigs(request.Context(), d.retryConfigs))
+	response, err := d.dispatcher.Do(request)
+	if response != nil && response.Request == nil {
+		response.Request = request
+	}
+	// The network errors of the HTTP client stay url errors, so that they are still detected as such
+	if urlErr, ok := err.(*url.Error); ok {
+		err = &url.Error{Op: urlErr.Op, URL: urlErr.URL, Err: retryConfigError{err: urlErr.Err, retryConfigs: d.retryConfigs}}
+	}
+	return response, err
+}
+
+// retryConfigError is the error of a request without a response, with the retry policies of the provider
+type retryConfigError struct {
+	err          error
+	retryConfigs map[string]*RetryConfig
+}
+
+func (e retryConfigError) Error() string {
+	return e.err.Error()
+}
+
+func (e retryConfigError) Unwrap() error {
+	return e.err
+}
+
+func (e retryConfigError) Timeout() bool {
+	timeout, ok := e.err.(interface{ Timeout() bool })
+	return ok && timeout.Timeout()
+}
+
+func (e retryConfigError) Temporary() bool {
+	temporary, ok := e.err.(interface{ Temporary() bool })
+	return ok && temporary.Temporary()
+}
+
+// ApplyRetryConfigs installs a dispatcher adding the retry policies of the provider, if any, to the requests of the
+// client
+func ApplyRetryConfigs(retryConfigs map[string]*RetryConfig, client *oci_common.BaseClient) {
+	if len(retryConfigs) == 0 || client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(retryConfigDispatcher); ok {
+		return
+	}
+	client.HTTPClient = retryConfigDispatcher{
+		retryConfigs: retryConfigs,
+		dispatcher:   client.HTTPClient,
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type networkErrorMockDispatcher struct {
+	err error
+}
+
+func (d *networkErrorMockDispatcher) Do(request *http.Request) (*http.Response, error) {
+	return nil, &url.Error{Op: request.Method, URL: request.URL.String(), Err: d.err}
+}
+
+type retryConfigMockResponse struct {
+	response *http.Response
+}
+
+func (r retryConfigMockResponse) HTTPResponse() *http.Response {
+	return r.response
+}
+
+func TestUnitApplyRetryConfigs(t *testing.T) {
+	retryConfigs := map[string]*RetryConfig{"core": {MaxAttempts: 3}}
+	otherRetryConfigs := map[string]*RetryConfig{"core": {MaxAttempts: 5}}
+
+	// Clients without retry policies keep their dispatcher
+	client := &oci_common.BaseClient{HTTPClient: &mockDispatcher{statusCode: http.StatusOK}}
+	ApplyRetryConfigs(nil, client)
+	_, ok := client.HTTPClient.(*mockDispatcher)
+	assert.True(t, ok)
+
+	ApplyRetryConfigs(retryConfigs, client)
+	ApplyRetryConfigs(otherRetryConfigs, client)
+	dispatcher, ok := client.HTTPClient.(retryConfigDispatcher)
+	assert.True(t, ok)
+	_, ok = dispatcher.dispatcher.(*mockDispatcher)
+	assert.True(t, ok, "the dispatcher should be installed once")
+
+	// The responses of the requests carry the retry policies of the provider
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/instances", nil)
+	assert.NoError(t, err)
+	httpResponse, err := client.HTTPClient.Do(request)
+	assert.NoError(t, err)
+	response := oci_common.NewOCIOperationResponse(retryConfigMockResponse{response: httpResponse}, nil, 1)
+	assert.Equal(t, retryConfigs, ResponseRetryConfigs(response))
+
+	// Requests of another provider are not affected
+	otherClient := &oci_common.BaseClient{HTTPClient: &mockDispatcher{statusCode: http.StatusOK}}
+	ApplyRetryConfigs(otherRetryConfigs, otherClient)
+	httpResponse, err = otherClient.HTTPClient.Do(request)
+	assert.NoError(t, err)
+	response = oci_common.NewOCIOperationResponse(retryConfigMockResponse{response: httpResponse}, nil, 1)
+	assert.Equal(t, otherRetryConfigs, ResponseRetryConfigs(response))
+
+	assert.Nil(t, ResponseRetryConfigs(oci_common.NewOCIOperationResponse(nil, nil, 1)))
+	assert.Nil(t, RetryConfigsFromContext(request.Context()), "the context of the request should not be changed")
+}
+
+func TestUnitApplyRetryConfigs_networkError(t *testing.T) {
+	retryConfigs := map[string]*RetryConfig{"core": {MaxAttempts: 3}}
+	client := &oci_common.BaseClient{HTTPClient: &networkErrorMockDispatcher{err: &net.DNSError{Err: "Timeout", IsTimeout: true}}}
+	ApplyRetryConfigs(retryConfigs, client)
+
+	request, err := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/instances", nil)
+	assert.NoError(t, err)
+	httpResponse, err := client.HTTPClient.Do(request)
+	assert.Nil(t, httpResponse)
+	assert.Error(t, err)
+
+	// The errors are still network errors, with the retry policies of the provider
+	urlErr, ok := err.(*url.Error)
+	assert.True(t, ok)
+	assert.True(t, urlErr.Timeout())
+	var dnsErr *net.DNSError
+	assert.ErrorAs(t, err, &dnsErr)
+	assert.Equal(t, "lookup : Timeout", urlErr.Err.Error())
+	assert.Equal(t, retryConfigs, ResponseRetryConfigs(oci_common.NewOCIOperationResponse(nil, err, 1)))
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_secrets.SecretsClient", &OracleClient{InitClientFn: initSecretsSecretsClient})
+	RegisterOracleClient("oci_secrets.SecretsClient", &OracleClient{InitClientFn: initSecretsSecretsClient, ZeroValue: &oci_secrets.SecretsClient{}})
 }
 
 func initSecretsSecretsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
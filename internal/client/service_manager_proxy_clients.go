@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_service_manager_proxy.ServiceManagerProxyClient", &OracleClient{InitClientFn: initServicemanagerproxyServiceManagerProxyClient})
+	RegisterOracleClient("oci_service_manager_proxy.ServiceManagerProxyClient", &OracleClient{InitClientFn: initServicemanagerproxyServiceManagerProxyClient, ZeroValue: &oci_service_manager_proxy.ServiceManagerProxyClient{}})
 }
 
 func initServicemanagerproxyServiceManagerProxyClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -0,0 +1,84 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+)
+
+// tracingAttempts numbers the attempts of the requests of all traced clients
+var tracingAttempts requestAttempts
+
+// tracingDispatcher records a span for every request sent by the wrapped dispatcher, and a span for the backoff
+// between the attempts of a retried request
+type tracingDispatcher struct {
+	clientName string
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d tracingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	path := redactPath(request.URL.Path)
+	key := tracing.SpanFromContext(ctx).SpanId() + " " + request.Method + " " + request.URL.Host + path
+	attempt, failedAt := tracingAttempts.next(key)
+	if attempt > 1 {
+		tracing.RecordSpan(ctx, "retry backoff", failedAt, time.Now(), map[string]string{
+			tracing.ClientAttr:       d.clientName,
+			tracing.RetryAttemptAttr: strconv.Itoa(attempt),
+		})
+	}
+
+	_, span := tracing.StartClient(ctx, fmt.Sprintf("%s %s", d.clientName, request.Method))
+	span.SetAttribute(tracing.ClientAttr, d.clientName)
+	span.SetAttribute(tracing.ServiceAttr, serviceOfClient(d.clientName))
+	span.SetAttribute(tracing.HttpMethodAttr, request.Method)
+	span.SetAttribute(tracing.HttpUrlAttr, request.URL.Scheme+"://"+request.URL.Host+path)
+	span.SetAttribute(tracing.RetryAttemptAttr, strconv.Itoa(attempt))
+	span.SetAttribute(tracing.OpcRequestIdAttr, request.Header.Get(opcRequestIdHeader))
+
+	response, err := d.dispatcher.Do(request)
+
+	var spanErr error
+	if err != nil {
+		spanErr = errors.New(redactError(err))
+	} else if response != nil && response.StatusCode >= http.StatusBadRequest {
+		spanErr = fmt.Errorf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+	if response != nil {
+		span.SetAttribute(tracing.HttpStatusCodeAttr, strconv.Itoa(response.StatusCode))
+		span.SetAttribute(tracing.OpcRequestIdAttr, response.Header.Get(opcRequestIdHeader))
+	}
+	span.End(spanErr)
+	tracingAttempts.onResponse(key, attempt, response, err)
+	return response, err
+}
+
+// serviceOfClient returns the service of a client name, e.g. "core" for "oci_core.VirtualNetworkClient"
+func serviceOfClient(clientName string) string {
+	return strings.TrimPrefix(strings.SplitN(clientName, ".", 2)[0], "oci_")
+}
+
+// ApplyTracing installs a tracing dispatcher in front of the HTTP client installed by ConfigureClient, if tracing is
+// enabled
+func ApplyTracing(clientName string, client *oci_common.BaseClient) {
+	if !tracing.Enabled() || client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(tracingDispatcher); ok {
+		return
+	}
+	client.HTTPClient = tracingDispatcher{
+		clientName: clientName,
+		dispatcher: client.HTTPClient,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing/tracingtest"
+)
+
+func TestUnitTracingDispatcher(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	dispatcher := tracingDispatcher{
+		clientName: "oci_core.VirtualNetworkClient",
+		dispatcher: &auditMockDispatcher{statusCodes: []int{http.StatusTooManyRequests, http.StatusOK}},
+	}
+	ctx, operation := tracing.StartOperation(context.Background(), "oci_core_vcn read")
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..aaa?page=secret", nil)
+		_, err := dispatcher.Do(request)
+		assert.NoError(t, err)
+	}
+	operation.End(nil)
+
+	requests := collector.SpansNamed("oci_core.VirtualNetworkClient GET")
+	assert.Len(t, requests, 2)
+	assert.Equal(t, trace.SpanKindClient, requests[0].Kind)
+	assert.Equal(t, operation.SpanId(), requests[0].ParentSpanId)
+	assert.Equal(t, "429 Too Many Requests", requests[0].Error)
+	assert.Equal(t, map[string]string{
+		tracing.ClientAttr:         "oci_core.VirtualNetworkClient",
+		tracing.ServiceAttr:        "core",
+		tracing.HttpMethodAttr:     http.MethodGet,
+		tracing.HttpUrlAttr:        "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..aaa",
+		tracing.HttpStatusCodeAttr: "429",
+		tracing.RetryAttemptAttr:   "1",
+		tracing.OpcRequestIdAttr:   "response-request-id",
+	}, requests[0].Attributes)
+	assert.Empty(t, requests[1].Error)
+	assert.Equal(t, "2", requests[1].Attributes[tracing.RetryAttemptAttr])
+
+	// The backoff between the attempts is a span of its own
+	backoff := collector.SpansNamed("retry backoff")
+	assert.Len(t, backoff, 1)
+	assert.Equal(t, operation.SpanId(), backoff[0].ParentSpanId)
+	assert.Equal(t, "2", backoff[0].Attributes[tracing.RetryAttemptAttr])
+	assert.False(t, backoff[0].Start.Before(requests[0].End))
+	assert.False(t, requests[1].Start.Before(backoff[0].End))
+}
+
+func TestUnitApplyTracing(t *testing.T) {
+	dispatcher := &auditMockDispatcher{}
+	client := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyTracing("oci_core.VirtualNetworkClient", client)
+	assert.Equal(t, dispatcher, client.HTTPClient, "requests should not be traced while tracing is disabled")
+
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+	ApplyTracing("oci_core.VirtualNetworkClient", client)
+	ApplyTracing("oci_core.VirtualNetworkClient", client)
+	traced, ok := client.HTTPClient.(tracingDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, traced.dispatcher, "the tracing dispatcher should only be installed once")
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_usage_proxy.RewardsClient", &OracleClient{InitClientFn: initUsageRewardsClient})
+	RegisterOracleClient("oci_usage_proxy.RewardsClient", &OracleClient{InitClientFn: initUsageRewardsClient, ZeroValue: &oci_usage_proxy.RewardsClient{}})
 }
 
 func initUsageRewardsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_vault.VaultsClient", &OracleClient{InitClientFn: initVaultVaultsClient})
+	RegisterOracleClient("oci_vault.VaultsClient", &OracleClient{InitClientFn: initVaultVaultsClient, ZeroValue: &oci_vault.VaultsClient{}})
 }
 
 func initVaultVaultsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_visual_builder.VbInstanceClient", &OracleClient{InitClientFn: initVisualbuilderVbInstanceClient})
+	RegisterOracleClient("oci_visual_builder.VbInstanceClient", &OracleClient{InitClientFn: initVisualbuilderVbInstanceClient, ZeroValue: &oci_visual_builder.VbInstanceClient{}})
 }
 
 func initVisualbuilderVbInstanceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_vulnerability_scanning.VulnerabilityScanningClient", &OracleClient{InitClientFn: initVulnerabilityscanningVulnerabilityScanningClient})
+	RegisterOracleClient("oci_vulnerability_scanning.VulnerabilityScanningClient", &OracleClient{InitClientFn: initVulnerabilityscanningVulnerabilityScanningClient, ZeroValue: &oci_vulnerability_scanning.VulnerabilityScanningClient{}})
 }
 
 func initVulnerabilityscanningVulnerabilityScanningClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -10,8 +10,8 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_waas.RedirectClient", &OracleClient{InitClientFn: initWaasRedirectClient})
-	RegisterOracleClient("oci_waas.WaasClient", &OracleClient{InitClientFn: initWaasWaasClient})
+	RegisterOracleClient("oci_waas.RedirectClient", &OracleClient{InitClientFn: initWaasRedirectClient, ZeroValue: &oci_waas.RedirectClient{}})
+	RegisterOracleClient("oci_waas.WaasClient", &OracleClient{InitClientFn: initWaasWaasClient, ZeroValue: &oci_waas.WaasClient{}})
 }
 
 func initWaasRedirectClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
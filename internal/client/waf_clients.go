@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	RegisterOracleClient("oci_waf.WafClient", &OracleClient{InitClientFn: initWafWafClient})
+	RegisterOracleClient("oci_waf.WafClient", &OracleClient{InitClientFn: initWafWafClient, ZeroValue: &oci_waf.WafClient{}})
 }
 
 func initWafWafClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -0,0 +1,90 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/progress"
+)
+
+// Paths of the work requests of all services, e.g. /20180222/workRequests/{id} or /loadBalancerWorkRequests/{id}
+var workRequestPathRegex = regexp.MustCompile(`(?i)workRequests/[^/]+$`)
+
+// workRequestBody has the fields of the work requests of the services that report progress. Some services name
+// the fields of their work requests differently, e.g. the load balancer service.
+type workRequestBody struct {
+	Id              string   `json:"id"`
+	OperationType   string   `json:"operationType"`
+	Type            string   `json:"type"`
+	Status          string   `json:"status"`
+	LifecycleState  string   `json:"lifecycleState"`
+	PercentComplete *float32 `json:"percentComplete"`
+	Message         string   `json:"message"`
+}
+
+// workRequestProgressDispatcher reports the progress of the work requests read through the wrapped dispatcher, so
+// that the waiters of all services report it, including those using the work request client of their service
+type workRequestProgressDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d workRequestProgressDispatcher) Do(request *http.Request) (*http.Response, error) {
+	response, err := d.dispatcher.Do(request)
+	if err != nil || response == nil || response.Body == nil || response.StatusCode != http.StatusOK ||
+		request.Method != http.MethodGet || !workRequestPathRegex.MatchString(request.URL.Path) {
+		return response, err
+	}
+
+	body, readErr := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		// Let the SDK fail on the truncated body
+		return response, err
+	}
+
+	var workRequest workRequestBody
+	if json.Unmarshal(body, &workRequest) == nil {
+		progress.Report(workRequest.progress())
+	}
+	return response, err
+}
+
+func (w workRequestBody) progress() progress.WorkRequest {
+	result := progress.WorkRequest{
+		Id:              w.Id,
+		Operation:       w.OperationType,
+		Status:          w.Status,
+		PercentComplete: w.PercentComplete,
+		Message:         w.Message,
+	}
+	if result.Operation == "" {
+		result.Operation = w.Type
+	}
+	if result.Status == "" {
+		result.Status = w.LifecycleState
+	}
+	return result
+}
+
+// ApplyWorkRequestProgress installs a dispatcher reporting the progress of work requests in front of the HTTP client
+// installed by ConfigureClient
+func ApplyWorkRequestProgress(client *oci_common.BaseClient) {
+	if client.HTTPClient == nil {
+		return
+	}
+	if _, ok := client.HTTPClient.(workRequestProgressDispatcher); ok {
+		return
+	}
+	client.HTTPClient = workRequestProgressDispatcher{
+		dispatcher: client.HTTPClient,
+	}
+}
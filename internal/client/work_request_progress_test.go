@@ -0,0 +1,106 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type bodyMockDispatcher struct {
+	statusCode int
+	body       string
+}
+
+func (d *bodyMockDispatcher) Do(request *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: d.statusCode, Body: ioutil.NopCloser(bytes.NewBufferString(d.body)), Request: request}, nil
+}
+
+func TestUnitWorkRequestProgressDispatcher(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	previousOutput, previousFlags := log.Writer(), log.Flags()
+	log.SetOutput(buffer)
+	log.SetFlags(0)
+	defer func() {
+		log.SetOutput(previousOutput)
+		log.SetFlags(previousFlags)
+	}()
+
+	type testFormat struct {
+		name       string
+		method     string
+		url        string
+		statusCode int
+		body       string
+		report     string
+	}
+	tests := []testFormat{
+		{
+			name:       "Test work request of the work requests service",
+			method:     http.MethodGet,
+			url:        "https://iaas.us-phoenix-1.oraclecloud.com/20160918/workRequests/ocid1.coreservicesworkrequest.oc1..aaa",
+			statusCode: http.StatusOK,
+			body:       `{"id": "ocid1.coreservicesworkrequest.oc1..aaa", "operationType": "CreateDbSystem", "status": "IN_PROGRESS", "percentComplete": 45.5}`,
+			report:     "[INFO] Work request ocid1.coreservicesworkrequest.oc1..aaa (CreateDbSystem) is IN_PROGRESS, 45% complete\n",
+		},
+		{
+			name:       "Test work request of the load balancer service",
+			method:     http.MethodGet,
+			url:        "https://iaas.us-phoenix-1.oraclecloud.com/20170115/loadBalancerWorkRequests/ocid1.loadbalancerworkrequest.oc1..aaa",
+			statusCode: http.StatusOK,
+			body:       `{"id": "ocid1.loadbalancerworkrequest.oc1..aaa", "type": "CreateListener", "lifecycleState": "IN_PROGRESS", "message": "Configuring listener"}`,
+			report:     "[INFO] Work request ocid1.loadbalancerworkrequest.oc1..aaa (CreateListener) is IN_PROGRESS: Configuring listener\n",
+		},
+		{
+			name:       "Test other resources are not reported",
+			method:     http.MethodGet,
+			url:        "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..aaa",
+			statusCode: http.StatusOK,
+			body:       `{"id": "ocid1.vcn.oc1..aaa", "lifecycleState": "AVAILABLE"}`,
+		},
+		{
+			name:       "Test lists of work requests are not reported",
+			method:     http.MethodGet,
+			url:        "https://iaas.us-phoenix-1.oraclecloud.com/20160918/workRequests/ocid1.coreservicesworkrequest.oc1..bbb/errors",
+			statusCode: http.StatusOK,
+			body:       `[{"code": "InternalError", "message": "failed"}]`,
+		},
+		{
+			name:       "Test failed reads are not reported",
+			method:     http.MethodGet,
+			url:        "https://iaas.us-phoenix-1.oraclecloud.com/20160918/workRequests/ocid1.coreservicesworkrequest.oc1..ccc",
+			statusCode: http.StatusNotFound,
+			body:       `{"code": "NotAuthorizedOrNotFound"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		dispatcher := workRequestProgressDispatcher{dispatcher: &bodyMockDispatcher{statusCode: test.statusCode, body: test.body}}
+		request, _ := http.NewRequest(test.method, test.url, nil)
+		response, err := dispatcher.Do(request)
+		assert.NoError(t, err)
+
+		body, err := ioutil.ReadAll(response.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, test.body, string(body), "the body should still be readable by the SDK")
+		assert.Equal(t, test.report, buffer.String())
+		buffer.Reset()
+	}
+}
+
+func TestUnitApplyWorkRequestProgress(t *testing.T) {
+	dispatcher := &mockDispatcher{statusCode: http.StatusOK}
+	client := &oci_common.BaseClient{HTTPClient: dispatcher}
+	ApplyWorkRequestProgress(client)
+	ApplyWorkRequestProgress(client)
+	progressDispatcher, ok := client.HTTPClient.(workRequestProgressDispatcher)
+	assert.True(t, ok)
+	assert.Equal(t, dispatcher, progressDispatcher.dispatcher, "the dispatcher should only be installed once")
+}
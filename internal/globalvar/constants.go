@@ -7,6 +7,8 @@ const (
 	AuthInstancePrincipalSetting          = "InstancePrincipal"
 	AuthInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
 	AuthSecurityToken                     = "SecurityToken"
+	AuthResourcePrincipalSetting          = "ResourcePrincipal"
+	AuthOKEWorkloadIdentitySetting        = "OKEWorkloadIdentity"
 	RequestHeaderOpcOboToken              = "opc-obo-token"
 	RequestHeaderOpcHostSerial            = "opc-host-serial"
 	DefaultRequestTimeout                 = 0
@@ -20,27 +22,52 @@ const (
 	DomainNameOverrideEnv                 = "domain_name_override"
 	HasCorrectDomainNameEnv               = "has_correct_domain_name"
 	ClientHostOverridesEnv                = "CLIENT_HOST_OVERRIDES"
+	ClientRateLimitsEnv                   = "CLIENT_RATE_LIMITS"
+	AuditLogPathEnv                       = "OCI_AUDIT_LOG_PATH"
+	CreateJournalPathEnv                  = "OCI_CREATE_JOURNAL_PATH"
+	OtelSdkDisabledEnv                    = "OTEL_SDK_DISABLED"
+	OtelTracesExporterEnv                 = "OTEL_TRACES_EXPORTER"
+	OtelExporterOtlpEndpointEnv           = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	OtelExporterOtlpTracesEndpointEnv     = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	OtelExporterOtlpProtocolEnv           = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	OtelExporterOtlpTracesProtocolEnv     = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+	OtelExporterOtlpHeadersEnv            = "OTEL_EXPORTER_OTLP_HEADERS"
+	OtelServiceNameEnv                    = "OTEL_SERVICE_NAME"
+	OtelResourceAttributesEnv             = "OTEL_RESOURCE_ATTRIBUTES"
+	TraceParentEnv                        = "TRACEPARENT"
 	CustomCertLocationEnv                 = "custom_cert_location"
 	AcceptLocalCerts                      = "accept_local_certs"
+	KubernetesServiceHostEnv              = "KUBERNETES_SERVICE_HOST"
+	KubernetesServiceAccountTokenPathEnv  = "OCI_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH"
+	KubernetesServiceAccountCertPathEnv   = "OCI_KUBERNETES_SERVICE_ACCOUNT_CERT_PATH"
+	ResourcePrincipalRegionEnv            = "OCI_RESOURCE_PRINCIPAL_REGION"
 
-	AuthAttrName                 = "auth"
-	TenancyOcidAttrName          = "tenancy_ocid"
-	BoatTenancyOcidAttrName      = "boat_tenancy_ocid"
-	UserOcidAttrName             = "user_ocid"
-	FingerprintAttrName          = "fingerprint"
-	PrivateKeyAttrName           = "private_key"
-	PrivateKeyPathAttrName       = "private_key_path"
-	PrivateKeyPasswordAttrName   = "private_key_password"
-	RegionAttrName               = "region"
-	DisableAutoRetriesAttrName   = "disable_auto_retries"
-	RetryDurationSecondsAttrName = "retry_duration_seconds"
-	OboTokenAttrName             = "obo_token"
-	OboTokenPath                 = "obo_token_path"
-	ConfigFileProfileAttrName    = "config_file_profile"
-	DefinedTagsToIgnore          = "ignore_defined_tags"
+	AuthAttrName                      = "auth"
+	TenancyOcidAttrName               = "tenancy_ocid"
+	BoatTenancyOcidAttrName           = "boat_tenancy_ocid"
+	UserOcidAttrName                  = "user_ocid"
+	FingerprintAttrName               = "fingerprint"
+	PrivateKeyAttrName                = "private_key"
+	PrivateKeyPathAttrName            = "private_key_path"
+	PrivateKeyPasswordAttrName        = "private_key_password"
+	RegionAttrName                    = "region"
+	DisableAutoRetriesAttrName        = "disable_auto_retries"
+	RetryDurationSecondsAttrName      = "retry_duration_seconds"
+	OboTokenAttrName                  = "obo_token"
+	OboTokenPath                      = "obo_token_path"
+	ConfigFileProfileAttrName         = "config_file_profile"
+	DefinedTagsToIgnore               = "ignore_defined_tags"
+	ClientRateLimitsAttrName          = "client_rate_limits"
+	ClientMaxInFlightRequestsAttrName = "client_max_in_flight_requests"
+	DefaultFreeformTagsAttrName       = "default_freeform_tags"
+	DefaultDefinedTagsAttrName        = "default_defined_tags"
+	AuditLogPathAttrName              = "audit_log_path"
+	CreateJournalPathAttrName         = "create_journal_path"
+	RetryAttrName                     = "retry"
 
 	DefaultConfigFileName    = "config"
 	DefaultConfigDirName     = ".oci"
+	DefaultCreateJournalPath = ".terraform/oci_create_journal.json"
 	ColonDelimiter           = ";"
 	EqualToOperatorDelimiter = "="
 
@@ -48,8 +75,14 @@ const (
 	ExportUserAgentFormatter        = "Oracle-GoSDK/%s (go/%s; %s/%s; terraform-oci-exporter/%s)"
 	DefaultTmpStateFile             = "terraform.tfstate.tmp"
 	DefaultStateFilename            = "terraform.tfstate"
+	BackupStateFilename             = "terraform.tfstate.backup"
+	IncrementalStateFilename        = "terraform.tfstate.incremental"
+	ExportChangesFile               = "export_changes.json"
 	VarsFile                        = "vars.tf"
 	ProviderFile                    = "provider.tf"
+	OutputsFile                     = "outputs.tf"
+	CompartmentModulesFile          = "main.tf"
+	CompartmentModulesDir           = "compartments"
 	MissingRequiredAttributeWarning = `
 
 Warning: There are one or more 'Required' attributes for which a value could not be discovered.
@@ -0,0 +1,135 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package progress reports the progress of the work requests polled during long Terraform operations. Terraform only
+// shows the time elapsed while a resource is created, updated or deleted, so the percentage of completion and the
+// latest message of the work requests are written to the Terraform log as they change, and periodically otherwise.
+package progress
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Interval is the longest time between two reports of a work request that does not progress, and between two reads of
+// its latest log entry
+var Interval = 30 * time.Second
+
+// now is the clock of the reports, replaced by tests
+var now = time.Now
+
+// WorkRequest is the state of a work request read while polling it
+type WorkRequest struct {
+	Id        string
+	Operation string
+	Status    string
+	// Nil if the service does not report it
+	PercentComplete *float32
+	// Latest log entry or message of the work request, empty if unknown
+	Message string
+}
+
+type workRequestReport struct {
+	WorkRequest
+	firstSeen  time.Time
+	reportedAt time.Time
+	readAt     time.Time
+}
+
+var (
+	mutex   sync.Mutex
+	reports = map[string]*workRequestReport{}
+)
+
+// Report logs the state of a work request if it changed since it was last reported, or if it was last reported more
+// than Interval ago. The operation and message of the previous reports are kept if they are empty. Reports of work
+// requests that ended are forgotten once logged.
+func Report(workRequest WorkRequest) {
+	if workRequest.Id == "" || workRequest.Status == "" {
+		return
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	reportTime := now()
+	report, ok := reports[workRequest.Id]
+	if !ok {
+		report = &workRequestReport{firstSeen: reportTime}
+		reports[workRequest.Id] = report
+	}
+	if workRequest.Operation == "" {
+		workRequest.Operation = report.Operation
+	}
+	if workRequest.Message == "" {
+		workRequest.Message = report.Message
+	}
+
+	changed := !ok || workRequest.Status != report.Status || workRequest.Message != report.Message ||
+		percentage(workRequest.PercentComplete) != percentage(report.PercentComplete)
+	if changed || reportTime.Sub(report.reportedAt) >= Interval {
+		log.Printf("[INFO] %s", formatReport(workRequest, reportTime.Sub(report.firstSeen)))
+		report.reportedAt = reportTime
+	}
+	report.WorkRequest = workRequest
+
+	if isEnded(workRequest.Status) {
+		delete(reports, workRequest.Id)
+	}
+}
+
+// ReadDue returns whether the work request should be read again to report its progress, when it is not read anyway,
+// or to read its latest log entry. It returns true at most once per Interval for a work request.
+func ReadDue(workRequestId string) bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	readTime := now()
+	report, ok := reports[workRequestId]
+	if !ok {
+		report = &workRequestReport{firstSeen: readTime}
+		reports[workRequestId] = report
+	} else if readTime.Sub(report.readAt) < Interval {
+		return false
+	}
+	report.readAt = readTime
+	return true
+}
+
+func formatReport(workRequest WorkRequest, elapsed time.Duration) string {
+	var sb strings.Builder
+	sb.WriteString("Work request ")
+	sb.WriteString(workRequest.Id)
+	if workRequest.Operation != "" {
+		fmt.Fprintf(&sb, " (%s)", workRequest.Operation)
+	}
+	fmt.Fprintf(&sb, " is %s", workRequest.Status)
+	if workRequest.PercentComplete != nil {
+		fmt.Fprintf(&sb, ", %d%% complete", percentage(workRequest.PercentComplete))
+	}
+	if elapsed >= time.Second {
+		fmt.Fprintf(&sb, " after %v", elapsed.Round(time.Second))
+	}
+	if workRequest.Message != "" {
+		fmt.Fprintf(&sb, ": %s", workRequest.Message)
+	}
+	return sb.String()
+}
+
+// percentage rounds the percentage of completion down, -1 if unknown
+func percentage(percentComplete *float32) int {
+	if percentComplete == nil {
+		return -1
+	}
+	return int(*percentComplete)
+}
+
+func isEnded(status string) bool {
+	switch strings.ToUpper(status) {
+	case "SUCCEEDED", "FAILED", "CANCELED", "CANCELLED":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package progress
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// useTestLog captures the reports and controls their clock
+func useTestLog(t *testing.T) (*bytes.Buffer, *time.Time) {
+	buffer := &bytes.Buffer{}
+	clock := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	previousOutput, previousFlags, previousNow := log.Writer(), log.Flags(), now
+	log.SetOutput(buffer)
+	log.SetFlags(0)
+	now = func() time.Time { return clock }
+	reports = map[string]*workRequestReport{}
+	t.Cleanup(func() {
+		log.SetOutput(previousOutput)
+		log.SetFlags(previousFlags)
+		now = previousNow
+		reports = map[string]*workRequestReport{}
+	})
+	return buffer, &clock
+}
+
+func lines(buffer *bytes.Buffer) []string {
+	defer buffer.Reset()
+	if buffer.Len() == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSpace(buffer.String()), "\n")
+}
+
+func percent(p float32) *float32 {
+	return &p
+}
+
+func TestUnitReport(t *testing.T) {
+	buffer, clock := useTestLog(t)
+
+	Report(WorkRequest{Id: "ocid1.wr", Operation: "CREATE_DB_SYSTEM", Status: "ACCEPTED", PercentComplete: percent(0)})
+	assert.Equal(t, []string{"[INFO] Work request ocid1.wr (CREATE_DB_SYSTEM) is ACCEPTED, 0% complete"}, lines(buffer))
+
+	// Polls without progress are not reported until Interval elapsed
+	*clock = clock.Add(10 * time.Second)
+	Report(WorkRequest{Id: "ocid1.wr", Status: "ACCEPTED", PercentComplete: percent(0.5)})
+	assert.Empty(t, lines(buffer))
+	*clock = clock.Add(Interval)
+	Report(WorkRequest{Id: "ocid1.wr", Status: "ACCEPTED", PercentComplete: percent(0.5)})
+	assert.Equal(t, []string{"[INFO] Work request ocid1.wr (CREATE_DB_SYSTEM) is ACCEPTED, 0% complete after 40s"}, lines(buffer))
+
+	// Progress is reported right away, with the last known message
+	*clock = clock.Add(5 * time.Second)
+	Report(WorkRequest{Id: "ocid1.wr", Status: "IN_PROGRESS", PercentComplete: percent(45), Message: "Provisioning storage"})
+	*clock = clock.Add(5 * time.Second)
+	Report(WorkRequest{Id: "ocid1.wr", Status: "IN_PROGRESS", PercentComplete: percent(90)})
+	assert.Equal(t, []string{
+		"[INFO] Work request ocid1.wr (CREATE_DB_SYSTEM) is IN_PROGRESS, 45% complete after 45s: Provisioning storage",
+		"[INFO] Work request ocid1.wr (CREATE_DB_SYSTEM) is IN_PROGRESS, 90% complete after 50s: Provisioning storage",
+	}, lines(buffer))
+
+	// Ended work requests are forgotten
+	Report(WorkRequest{Id: "ocid1.wr", Status: "SUCCEEDED", PercentComplete: percent(100)})
+	assert.Len(t, lines(buffer), 1)
+	assert.Empty(t, reports)
+
+	// Services that do not report a percentage
+	Report(WorkRequest{Id: "ocid1.lbwr", Operation: "CreateLoadBalancer", Status: "IN_PROGRESS", Message: "Configuring backends"})
+	assert.Equal(t, []string{"[INFO] Work request ocid1.lbwr (CreateLoadBalancer) is IN_PROGRESS: Configuring backends"}, lines(buffer))
+
+	// Incomplete reports are ignored
+	Report(WorkRequest{Id: "ocid1.other"})
+	assert.Empty(t, lines(buffer))
+}
+
+func TestUnitReadDue(t *testing.T) {
+	_, clock := useTestLog(t)
+
+	assert.True(t, ReadDue("ocid1.wr"))
+	assert.False(t, ReadDue("ocid1.wr"))
+	assert.True(t, ReadDue("ocid1.other"))
+
+	*clock = clock.Add(Interval - time.Second)
+	assert.False(t, ReadDue("ocid1.wr"))
+	*clock = clock.Add(time.Second)
+	assert.True(t, ReadDue("ocid1.wr"))
+	assert.False(t, ReadDue("ocid1.wr"))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+// grpcProviderServer hands the pending creation saved in the private state of a resource to its operations, which the
+// CRUD functions of the SDK do not give access to, and writes it back to the private state afterwards. Plans draw the
+// retry token of the creation and save it with the pending creation.
+type grpcProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+// GRPCProviderServer returns the provider server to serve the provider with
+func GRPCProviderServer() tfprotov5.ProviderServer {
+	return grpcProviderServer{ProviderServer: schema.NewGRPCProviderServer(Provider())}
+}
+
+func (s grpcProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	// A pending creation keeps its token, any other creation, including a replacement, gets a token of its own.
+	// Plans of updates get a token as well, it is dropped once they are applied.
+	pending := resume.NewPending(req.PriorPrivate)
+	if _, resuming := pending.Entry(); !resuming || len(resp.RequiresReplace) > 0 {
+		pending = resume.NewPending(nil)
+		pending.SetRetryToken(resume.NewRetryToken())
+	}
+	private, err := pending.WritePrivate(resp.PlannedPrivate)
+	if err != nil {
+		return nil, err
+	}
+	resp.PlannedPrivate = private
+	return resp, nil
+}
+
+func (s grpcProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	pending := resume.NewPending(req.PlannedPrivate)
+	resp, err := s.ProviderServer.ApplyResourceChange(resume.WithPending(ctx, pending), req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	if _, ok := pending.Entry(); !ok {
+		// The retry token is only kept while the creation is pending
+		pending.Clear()
+	}
+	private, err := pending.WritePrivate(resp.Private)
+	if err != nil {
+		return nil, err
+	}
+	resp.Private = private
+	return resp, nil
+}
+
+func (s grpcProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	pending := resume.NewPending(req.Private)
+	resp, err := s.ProviderServer.ReadResource(resume.WithPending(ctx, pending), req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	private, err := pending.WritePrivate(resp.Private)
+	if err != nil {
+		return nil, err
+	}
+	resp.Private = private
+	return resp, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+type mockProviderServer struct {
+	tfprotov5.ProviderServer
+	operation       func(ctx context.Context)
+	requiresReplace []*tftypes.AttributePath
+}
+
+func (s mockProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	// Like the SDK, plans of changes encode the meta of the resource into the planned private state
+	return &tfprotov5.PlanResourceChangeResponse{PlannedPrivate: []byte(`{"schema_version":"0"}`), RequiresReplace: s.requiresReplace}, nil
+}
+
+func (s mockProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	s.operation(ctx)
+	return &tfprotov5.ApplyResourceChangeResponse{Private: []byte(`{"schema_version":"0"}`)}, nil
+}
+
+func (s mockProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	s.operation(ctx)
+	return &tfprotov5.ReadResourceResponse{Private: req.Private}, nil
+}
+
+func TestUnitGRPCProviderServer_pendingCreation(t *testing.T) {
+	entry := resume.Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"}
+	var server tfprotov5.ProviderServer = grpcProviderServer{ProviderServer: mockProviderServer{operation: func(ctx context.Context) {
+		resume.PendingFromContext(ctx).Set(entry)
+	}}}
+	applied, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{})
+	assert.NoError(t, err)
+	saved, ok := resume.NewPending(applied.Private).Entry()
+	assert.True(t, ok, "the pending creation should be saved to the private state")
+	assert.Equal(t, entry, saved)
+
+	var read resume.Entry
+	server = grpcProviderServer{ProviderServer: mockProviderServer{operation: func(ctx context.Context) {
+		pending := resume.PendingFromContext(ctx)
+		read, _ = pending.Entry()
+		pending.Clear()
+	}}}
+	refreshed, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{Private: applied.Private})
+	assert.NoError(t, err)
+	assert.Equal(t, entry, read, "the read should get the pending creation of the private state")
+	assert.JSONEq(t, `{"schema_version":"0"}`, string(refreshed.Private))
+}
+
+func TestUnitGRPCProviderServer_retryToken(t *testing.T) {
+	var server tfprotov5.ProviderServer = grpcProviderServer{ProviderServer: mockProviderServer{}}
+	planned, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+	assert.NoError(t, err)
+	token := resume.NewPending(planned.PlannedPrivate).RetryToken()
+	assert.Len(t, token, 64, "the plan of a creation should draw its retry token")
+
+	replanned, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, resume.NewPending(replanned.PlannedPrivate).RetryToken(), "every creation should get a token of its own")
+
+	var tokens *resume.RetryTokens
+	server = grpcProviderServer{ProviderServer: mockProviderServer{operation: func(ctx context.Context) {
+		pending := resume.PendingFromContext(ctx)
+		tokens = resume.NewRetryTokens(pending.RetryToken())
+		pending.Set(resume.Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"})
+	}}}
+	applied, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{PlannedPrivate: planned.PlannedPrivate})
+	assert.NoError(t, err)
+	assert.Equal(t, token, tokens.Next(), "the creation should use the token of its plan")
+	assert.Equal(t, token, resume.NewPending(applied.Private).RetryToken(), "the token should be kept while the creation is pending")
+
+	resumed, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{PriorPrivate: applied.Private})
+	assert.NoError(t, err)
+	assert.Equal(t, token, resume.NewPending(resumed.PlannedPrivate).RetryToken(), "a resumed creation should keep its token")
+
+	server = grpcProviderServer{ProviderServer: mockProviderServer{requiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("cidr_block")}}}
+	replaced, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{PriorPrivate: applied.Private})
+	assert.NoError(t, err)
+	replacement := resume.NewPending(replaced.PlannedPrivate)
+	_, ok := replacement.Entry()
+	assert.False(t, ok, "a replacement should not resume the creation of the resource it replaces")
+	assert.NotEqual(t, token, replacement.RetryToken(), "a replacement should get a token of its own")
+
+	server = grpcProviderServer{ProviderServer: mockProviderServer{operation: func(ctx context.Context) {}}}
+	created, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{PlannedPrivate: planned.PlannedPrivate})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0"}`, string(created.Private), "the token should be dropped once the creation is done")
+}
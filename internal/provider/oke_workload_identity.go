@@ -0,0 +1,308 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	defaultKubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultKubernetesServiceAccountCertPath  = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	okeWorkloadIdentityTokenPath             = "/resourcePrincipalSessionTokens"
+	okeWorkloadIdentityTokenRefreshWindow    = 5 * time.Minute
+)
+
+// The proxymux port of the OKE cluster that exchanges service account tokens for resource principal session tokens
+var okeWorkloadIdentityProxymuxPort = "12250"
+
+// okeWorkloadIdentityConfigurationProvider authenticates as the workload identity of an OKE pod. The Kubernetes
+// service account token of the pod is exchanged for a resource principal session token (RPST) bound to a session key
+// generated by the provider. The session token is renewed shortly before it expires.
+type okeWorkloadIdentityConfigurationProvider struct {
+	tokenEndpoint           string
+	serviceAccountTokenPath string
+	region                  string
+	dispatcher              oci_common.HTTPRequestDispatcher
+
+	mutex        sync.Mutex
+	privateKey   *rsa.PrivateKey
+	sessionToken string
+	claims       map[string]interface{}
+	expiresAt    time.Time
+}
+
+type okeWorkloadIdentityTokenRequest struct {
+	PodKey string `json:"podKey"`
+}
+
+type okeWorkloadIdentityTokenResponse struct {
+	Token string `json:"token"`
+}
+
+func newOkeWorkloadIdentityConfigurationProvider(modifier func(oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error)) (*okeWorkloadIdentityConfigurationProvider, error) {
+	host := utils.GetEnvSettingWithBlankDefault(globalvar.KubernetesServiceHostEnv)
+	if host == "" {
+		return nil, fmt.Errorf("%s is not set, OKEWorkloadIdentity can only be used in pods of an OKE cluster", globalvar.KubernetesServiceHostEnv)
+	}
+
+	certPath := utils.GetEnvSettingWithDefault(globalvar.KubernetesServiceAccountCertPathEnv, defaultKubernetesServiceAccountCertPath)
+	caCert, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not read kubernetes service account CA certificate from %s: %v", certPath, err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("invalid kubernetes service account CA certificate in %s", certPath)
+	}
+
+	httpClient := BuildHttpClient()
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: certPool}
+	var dispatcher oci_common.HTTPRequestDispatcher = httpClient
+	if modifier != nil {
+		if dispatcher, err = modifier(dispatcher); err != nil {
+			return nil, err
+		}
+	}
+
+	return &okeWorkloadIdentityConfigurationProvider{
+		tokenEndpoint:           fmt.Sprintf("https://%s%s", net.JoinHostPort(host, okeWorkloadIdentityProxymuxPort), okeWorkloadIdentityTokenPath),
+		serviceAccountTokenPath: utils.GetEnvSettingWithDefault(globalvar.KubernetesServiceAccountTokenPathEnv, defaultKubernetesServiceAccountTokenPath),
+		region:                  utils.GetEnvSettingWithBlankDefault(globalvar.ResourcePrincipalRegionEnv),
+		dispatcher:              dispatcher,
+	}, nil
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) String() string {
+	return fmt.Sprintf("OKE workload identity (%s)", p.tokenEndpoint)
+}
+
+// refreshIfNeeded must be called with the mutex held
+func (p *okeWorkloadIdentityConfigurationProvider) refreshIfNeeded() error {
+	if p.sessionToken != "" && time.Now().Add(okeWorkloadIdentityTokenRefreshWindow).Before(p.expiresAt) {
+		return nil
+	}
+
+	serviceAccountToken, err := ioutil.ReadFile(p.serviceAccountTokenPath)
+	if err != nil {
+		return fmt.Errorf("can not read kubernetes service account token from %s: %v", p.serviceAccountTokenPath, err)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return fmt.Errorf("can not generate session key: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return fmt.Errorf("can not encode session key: %v", err)
+	}
+	body, err := json.Marshal(okeWorkloadIdentityTokenRequest{PodKey: base64.StdEncoding.EncodeToString(publicKey)})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, p.tokenEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(serviceAccountToken)))
+
+	log.Printf("[DEBUG] requesting OKE workload identity session token from %s", p.tokenEndpoint)
+	response, err := p.dispatcher.Do(request)
+	if err != nil {
+		return fmt.Errorf("can not get OKE workload identity session token: %v", err)
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("can not get OKE workload identity session token, status: %d, body: %s", response.StatusCode, responseBody)
+	}
+
+	// The proxymux returns the token response as a base64 encoded JSON document
+	decoded, err := base64.StdEncoding.DecodeString(strings.Trim(strings.TrimSpace(string(responseBody)), `"`))
+	if err != nil {
+		return fmt.Errorf("invalid OKE workload identity session token response: %v", err)
+	}
+	tokenResponse := okeWorkloadIdentityTokenResponse{}
+	if err := json.Unmarshal(decoded, &tokenResponse); err != nil {
+		return fmt.Errorf("invalid OKE workload identity session token response: %v", err)
+	}
+	sessionToken := strings.TrimPrefix(tokenResponse.Token, "ST$")
+
+	claims, err := parseSessionTokenClaims(sessionToken)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(okeWorkloadIdentityTokenRefreshWindow)
+	if exp, ok := claims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0)
+	}
+
+	p.privateKey = privateKey
+	p.sessionToken = sessionToken
+	p.claims = claims
+	p.expiresAt = expiresAt
+	return nil
+}
+
+func parseSessionTokenClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid session token, expected a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid session token payload: %v", err)
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid session token payload: %v", err)
+	}
+	return claims, nil
+}
+
+// SessionKeyPair renews the session token and its session key together if needed, and returns them as the key ID and
+// the private key to sign a request with
+func (p *okeWorkloadIdentityConfigurationProvider) SessionKeyPair() (string, *rsa.PrivateKey, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.refreshIfNeeded(); err != nil {
+		return "", nil, err
+	}
+	return "ST$" + p.sessionToken, p.privateKey, nil
+}
+
+// PrivateRSAKey renews the session token if needed, the SDK request signer asks for the private key before the key ID.
+// The clients of the provider sign their requests with SessionKeyPair instead, see sessionKeyPairSigner.
+func (p *okeWorkloadIdentityConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.refreshIfNeeded(); err != nil {
+		return nil, err
+	}
+	return p.privateKey, nil
+}
+
+// KeyID returns the session token bound to the last private key returned, it is only renewed by the first call
+func (p *okeWorkloadIdentityConfigurationProvider) KeyID() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.sessionToken == "" {
+		if err := p.refreshIfNeeded(); err != nil {
+			return "", err
+		}
+	}
+	return "ST$" + p.sessionToken, nil
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) TenancyOCID() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.refreshIfNeeded(); err != nil {
+		return "", err
+	}
+	if tenancy, ok := p.claims["res_tenant"].(string); ok && tenancy != "" {
+		return tenancy, nil
+	}
+	return "", fmt.Errorf("session token does not contain a tenancy")
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) Region() (string, error) {
+	if p.region == "" {
+		return "", fmt.Errorf("%s is not set", globalvar.ResourcePrincipalRegionEnv)
+	}
+	return string(oci_common.StringToRegion(p.region)), nil
+}
+
+func (p *okeWorkloadIdentityConfigurationProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{AuthType: oci_common.UnknownAuthenticationType, IsFromConfigFile: false, OboToken: nil}, nil
+}
+
+// sessionKeyPairProvider renews a session token together with the session key it is bound to
+type sessionKeyPairProvider interface {
+	SessionKeyPair() (string, *rsa.PrivateKey, error)
+}
+
+// sessionKeyPairComposingConfigurationProvider lets the request signer of the clients take the session key pair of
+// the provider it composes
+type sessionKeyPairComposingConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	pairProvider sessionKeyPairProvider
+}
+
+func (p sessionKeyPairComposingConfigurationProvider) SessionKeyPair() (string, *rsa.PrivateKey, error) {
+	return p.pairProvider.SessionKeyPair()
+}
+
+/*
+sessionKeyPairSigner signs each request with a session token and the session key it is bound to, taken together.
+The SDK request signer asks for the private key and the key ID separately, so a session renewed by a concurrent
+request in between would sign the request with the key of the previous token and send the new one.
+*/
+type sessionKeyPairSigner struct {
+	pairProvider   sessionKeyPairProvider
+	genericHeaders []string
+	bodyHeaders    []string
+}
+
+func (s sessionKeyPairSigner) Sign(request *http.Request) error {
+	keyID, privateKey, err := s.pairProvider.SessionKeyPair()
+	if err != nil {
+		return err
+	}
+	return oci_common.RequestSigner(sessionKeyPair{keyID: keyID, privateKey: privateKey}, s.genericHeaders, s.bodyHeaders).Sign(request)
+}
+
+type sessionKeyPair struct {
+	keyID      string
+	privateKey *rsa.PrivateKey
+}
+
+func (p sessionKeyPair) KeyID() (string, error) {
+	return p.keyID, nil
+}
+
+func (p sessionKeyPair) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+// buildRequestSigner returns the request signer of the clients, which takes the session key pair of the configuration
+// providers renewing it
+func buildRequestSigner(configProvider oci_common.ConfigurationProvider, genericHeaders []string, bodyHeaders []string) oci_common.HTTPRequestSigner {
+	if pairProvider, ok := configProvider.(sessionKeyPairProvider); ok {
+		return sessionKeyPairSigner{pairProvider: pairProvider, genericHeaders: genericHeaders, bodyHeaders: bodyHeaders}
+	}
+	return oci_common.RequestSigner(configProvider, genericHeaders, bodyHeaders)
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+func testSessionToken(expiresAt time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"res_tenant":"ocid1.tenancy.oc1..aaa","exp":%d}`, expiresAt.Unix())))
+	return header + "." + payload + ".c2lnbmF0dXJl"
+}
+
+func testAuthResourceData(t *testing.T, auth string, region string) *schema.ResourceData {
+	raw := map[string]interface{}{globalvar.AuthAttrName: auth}
+	if region != "" {
+		raw[globalvar.RegionAttrName] = region
+	}
+	return schema.TestResourceDataRaw(t, SchemaMap(), raw)
+}
+
+func setTestEnv(t *testing.T, values map[string]string) {
+	for key, value := range values {
+		previous, exists := os.LookupEnv(key)
+		os.Setenv(key, value)
+		key := key
+		t.Cleanup(func() {
+			if exists {
+				os.Setenv(key, previous)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+}
+
+// startOkeTokenEndpointStub starts a local proxymux stub that issues session tokens with the given lifetime
+func startOkeTokenEndpointStub(t *testing.T, lifetime time.Duration, requests *int32, podKeys chan string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if r.URL.Path != okeWorkloadIdentityTokenPath || r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		request := okeWorkloadIdentityTokenRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		select {
+		case podKeys <- request.PodKey:
+		default:
+		}
+		// The pod key takes the place of the token signature, so that tests can check the key a token is bound to
+		token := testSessionToken(time.Now().Add(lifetime))
+		token = token[:strings.LastIndex(token, ".")+1] + request.PodKey
+		response, _ := json.Marshal(okeWorkloadIdentityTokenResponse{Token: "ST$" + token})
+		fmt.Fprintf(w, "%q", base64.StdEncoding.EncodeToString(response))
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "ca.crt")
+	tokenPath := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("sa-token\n"), 0600))
+
+	host, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	previousPort := okeWorkloadIdentityProxymuxPort
+	okeWorkloadIdentityProxymuxPort = port
+	t.Cleanup(func() { okeWorkloadIdentityProxymuxPort = previousPort })
+
+	setTestEnv(t, map[string]string{
+		globalvar.KubernetesServiceHostEnv:             host,
+		globalvar.KubernetesServiceAccountCertPathEnv:  certPath,
+		globalvar.KubernetesServiceAccountTokenPathEnv: tokenPath,
+		globalvar.ResourcePrincipalRegionEnv:           "us-ashburn-1",
+	})
+}
+
+func TestUnitGetSdkConfigProvider_okeWorkloadIdentity(t *testing.T) {
+	var requests int32
+	podKeys := make(chan string, 1)
+	startOkeTokenEndpointStub(t, time.Hour, &requests, podKeys)
+
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	configProvider, err := GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthOKEWorkloadIdentitySetting, ""), clients)
+	assert.NoError(t, err)
+
+	keyId, err := configProvider.KeyID()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(keyId, "ST$"))
+	claims, err := parseSessionTokenClaims(strings.TrimPrefix(keyId, "ST$"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.tenancy.oc1..aaa", claims["res_tenant"])
+	tenancy, err := configProvider.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.tenancy.oc1..aaa", tenancy)
+	region, err := configProvider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", region)
+
+	// The session key sent to the token endpoint is the one used to sign requests
+	privateKey, err := configProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+	publicKey, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(publicKey), <-podKeys)
+
+	// The token is cached until it is about to expire
+	_, err = configProvider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Region in the provider block overrides the region of the workload
+	configProvider, err = GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthOKEWorkloadIdentitySetting, "us-phoenix-1"), clients)
+	assert.NoError(t, err)
+	region, err = configProvider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-phoenix-1", region)
+}
+
+func TestUnitOkeWorkloadIdentityConfigurationProvider_refresh(t *testing.T) {
+	var requests int32
+	startOkeTokenEndpointStub(t, time.Minute, &requests, make(chan string))
+
+	configProvider, err := newOkeWorkloadIdentityConfigurationProvider(authClientModifier)
+	assert.NoError(t, err)
+
+	firstKey, err := configProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+	_, err = configProvider.KeyID()
+	assert.NoError(t, err)
+	secondKey, err := configProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+
+	// Tokens expiring within the refresh window are renewed with a new session key
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.NotEqual(t, firstKey, secondKey)
+
+	os.Setenv(globalvar.KubernetesServiceAccountTokenPathEnv, filepath.Join(t.TempDir(), "missing"))
+	configProvider, err = newOkeWorkloadIdentityConfigurationProvider(nil)
+	assert.NoError(t, err)
+	_, err = configProvider.KeyID()
+	assert.Error(t, err)
+}
+
+func TestUnitOkeWorkloadIdentityConfigurationProvider_sessionKeyPair(t *testing.T) {
+	var requests int32
+	startOkeTokenEndpointStub(t, time.Minute, &requests, make(chan string))
+
+	configProvider, err := GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthOKEWorkloadIdentitySetting, ""), &tf_client.OracleClients{Configuration: make(map[string]string)})
+	assert.NoError(t, err)
+	signer := buildRequestSigner(configProvider, oci_common.DefaultGenericHeaders(), oci_common.DefaultBodyHeaders())
+	_, ok := signer.(sessionKeyPairSigner)
+	assert.True(t, ok)
+
+	// Every token is renewed, the requests signed concurrently must still be signed with the key of their token
+	results := make(chan error, 20)
+	for i := 0; i < cap(results); i++ {
+		go func() {
+			request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+			request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+			if err := signer.Sign(request); err != nil {
+				results <- err
+				return
+			}
+			results <- verifyTestSessionSignature(request)
+		}()
+	}
+	for i := 0; i < cap(results); i++ {
+		assert.NoError(t, <-results)
+	}
+	assert.True(t, atomic.LoadInt32(&requests) > 1)
+}
+
+// verifyTestSessionSignature checks the signature of request against the pod key the stub put in its session token
+func verifyTestSessionSignature(request *http.Request) error {
+	parameters := map[string]string{}
+	for _, parameter := range strings.Split(strings.TrimPrefix(request.Header.Get("Authorization"), "Signature "), ",") {
+		parts := strings.SplitN(parameter, "=", 2)
+		parameters[parts[0]] = strings.Trim(parts[1], `"`)
+	}
+	tokenParts := strings.Split(strings.TrimPrefix(parameters["keyId"], "ST$"), ".")
+	podKey, err := base64.StdEncoding.DecodeString(tokenParts[len(tokenParts)-1])
+	if err != nil {
+		return err
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(podKey)
+	if err != nil {
+		return err
+	}
+
+	var signingParts []string
+	for _, header := range strings.Split(parameters["headers"], " ") {
+		switch header {
+		case "(request-target)":
+			signingParts = append(signingParts, fmt.Sprintf("%s: %s %s", header, strings.ToLower(request.Method), request.URL.RequestURI()))
+		case "host":
+			signingParts = append(signingParts, fmt.Sprintf("%s: %s", header, request.URL.Host))
+		default:
+			signingParts = append(signingParts, fmt.Sprintf("%s: %s", header, request.Header.Get(header)))
+		}
+	}
+	signature, err := base64.StdEncoding.DecodeString(parameters["signature"])
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256([]byte(strings.Join(signingParts, "\n")))
+	return rsa.VerifyPKCS1v15(publicKey.(*rsa.PublicKey), crypto.SHA256, hashed[:], signature)
+}
+
+func TestUnitOkeWorkloadIdentityConfigurationProvider_acceptLocalCerts(t *testing.T) {
+	var requests int32
+	startOkeTokenEndpointStub(t, time.Hour, &requests, make(chan string))
+	setTestEnv(t, map[string]string{globalvar.AcceptLocalCerts: "false"})
+
+	// The kubernetes CA certificate is still trusted when accept_local_certs is set
+	configProvider, err := newOkeWorkloadIdentityConfigurationProvider(authClientModifier)
+	assert.NoError(t, err)
+	_, err = configProvider.KeyID()
+	assert.NoError(t, err)
+	transport := configProvider.dispatcher.(*http.Client).Transport.(*http.Transport)
+	assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+	assert.False(t, transport.TLSClientConfig.InsecureSkipVerify)
+
+	// The transport shared by the process is not modified
+	setTestEnv(t, map[string]string{globalvar.AcceptLocalCerts: "true"})
+	dispatcher, err := authClientModifier(&http.Client{Transport: http.DefaultTransport})
+	assert.NoError(t, err)
+	transport = dispatcher.(*http.Client).Transport.(*http.Transport)
+	assert.False(t, transport == http.DefaultTransport)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	if defaultTLSConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig; defaultTLSConfig != nil {
+		assert.False(t, defaultTLSConfig.InsecureSkipVerify)
+	}
+}
+
+func TestUnitOkeWorkloadIdentityConfigurationProvider_notInCluster(t *testing.T) {
+	setTestEnv(t, map[string]string{globalvar.KubernetesServiceHostEnv: ""})
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	_, err := GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthOKEWorkloadIdentitySetting, "us-phoenix-1"), clients)
+	assert.Error(t, err)
+}
+
+func TestUnitGetSdkConfigProvider_resourcePrincipal(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	sessionToken := testSessionToken(time.Now().Add(time.Hour))
+	setTestEnv(t, map[string]string{
+		"OCI_RESOURCE_PRINCIPAL_VERSION":     "2.2",
+		"OCI_RESOURCE_PRINCIPAL_RPST":        sessionToken,
+		"OCI_RESOURCE_PRINCIPAL_PRIVATE_PEM": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		globalvar.ResourcePrincipalRegionEnv: "us-ashburn-1",
+	})
+
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	configProvider, err := GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthResourcePrincipalSetting, ""), clients)
+	assert.NoError(t, err)
+	assert.Equal(t, "resourceprincipal", clients.Configuration[globalvar.AuthAttrName])
+
+	keyId, err := configProvider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+sessionToken, keyId)
+	tenancy, err := configProvider.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.tenancy.oc1..aaa", tenancy)
+	region, err := configProvider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", region)
+	privateKey, err := configProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.Equal(t, key.N, privateKey.N)
+
+	configProvider, err = GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthResourcePrincipalSetting, "us-phoenix-1"), clients)
+	assert.NoError(t, err)
+	region, err = configProvider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-phoenix-1", region)
+
+	// Missing resource principal environment
+	os.Unsetenv("OCI_RESOURCE_PRINCIPAL_VERSION")
+	_, err = GetSdkConfigProvider(testAuthResourceData(t, globalvar.AuthResourcePrincipalSetting, ""), clients)
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rsa"
 	"fmt"
 	"io/ioutil"
@@ -27,6 +28,7 @@ import (
 	"net/http"
 	"runtime"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sdkMeta "github.com/hashicorp/terraform-plugin-sdk/v2/meta"
@@ -36,7 +38,9 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
 	tf_resource "github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
@@ -93,7 +97,7 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.AuthAPIKeySetting),
+		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.AuthResourcePrincipalSetting, globalvar.AuthOKEWorkloadIdentitySetting, globalvar.AuthAPIKeySetting),
 		globalvar.TenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.UserOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.FingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
@@ -107,8 +111,20 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		globalvar.RetryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
-		globalvar.ConfigFileProfileAttrName: "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
-		globalvar.DefinedTagsToIgnore:       "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object",
+		globalvar.ConfigFileProfileAttrName:   "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
+		globalvar.DefinedTagsToIgnore:         "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object",
+		globalvar.DefaultFreeformTagsAttrName: "(Optional) Free-form tags added to every resource that supports `freeform_tags`. Tags set on the resource take precedence. The `freeform_tags` of a resource keep their configured value, its `freeform_tags_all` include the default tags.",
+		globalvar.DefaultDefinedTagsAttrName:  "(Optional) Defined tags, in `namespace.key` form, added to every resource that supports `defined_tags`. Tags set on the resource take precedence. The `defined_tags` of a resource keep their configured value, its `defined_tags_all` include the default tags.",
+		globalvar.ClientRateLimitsAttrName: fmt.Sprintf("(Optional) Client side rate limits by SDK client name, e.g. `oci_identity.IdentityClient = \"5/s\"`. Use `*` for all other clients. "+
+			"Rates are lowered automatically while the service throttles requests, clients without a rate start at %v requests per second. "+
+			"Can also be set with the `%s` environment variable, e.g. `oci_identity.IdentityClient=5/s;*=50/s`.", tf_client.DefaultRateLimit, globalvar.ClientRateLimitsEnv),
+		globalvar.ClientMaxInFlightRequestsAttrName: fmt.Sprintf("(Optional) The maximum number of requests of an SDK client waiting for a response at the same time. The default is %d.", tf_client.DefaultMaxInFlightRequests),
+		globalvar.AuditLogPathAttrName: fmt.Sprintf("(Optional) Path of a file to which a JSON line is appended for every OCI API request, with its resource type, operation, method, URL path, status, latency, retry attempt and `opc-request-id`. "+
+			"Bodies, headers and query strings are not logged. Can also be set with the `%s` environment variable.", globalvar.AuditLogPathEnv),
+		globalvar.CreateJournalPathAttrName: fmt.Sprintf("(Optional) Path of the file in which the creations accepted by OCI are journaled until the resources are saved to the state, so that the next apply adopts the resources of an apply that crashed or was killed instead of creating them again. "+
+			"Defaults to `%s` when run from a Terraform working directory. Can also be set with the `%s` environment variable.", globalvar.DefaultCreateJournalPath, globalvar.CreateJournalPathEnv),
+		globalvar.RetryAttrName: fmt.Sprintf("(Optional) Retry policy of the requests to a service, e.g. `database`, or `%s` for all services without a policy of their own. "+
+			"Policies take precedence over `disable_auto_retries` and `retry_duration_seconds` for the errors they retry.", tf_resource.AllServicesRetryConfig),
 	}
 }
 
@@ -129,7 +145,7 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[globalvar.AuthAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.AuthAttrName), ociVarName(globalvar.AuthAttrName)}, globalvar.AuthAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken}, true),
+			ValidateFunc: validation.StringInSlice([]string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.AuthResourcePrincipalSetting, globalvar.AuthOKEWorkloadIdentitySetting}, true),
 		},
 		globalvar.TenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -204,6 +220,87 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.DefinedTagsToIgnore],
 			MaxItems:    100,
 		},
+		globalvar.DefaultFreeformTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.DefaultFreeformTagsAttrName],
+		},
+		globalvar.DefaultDefinedTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.DefaultDefinedTagsAttrName],
+		},
+		globalvar.ClientRateLimitsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.ClientRateLimitsAttrName],
+		},
+		globalvar.ClientMaxInFlightRequestsAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  descriptions[globalvar.ClientMaxInFlightRequestsAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.ClientMaxInFlightRequestsAttrName), ociVarName(globalvar.ClientMaxInFlightRequestsAttrName)}, nil),
+		},
+		globalvar.AuditLogPathAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.AuditLogPathAttrName],
+		},
+		globalvar.CreateJournalPathAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.CreateJournalPathAttrName],
+		},
+		globalvar.RetryAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[globalvar.RetryAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateRetryService,
+						Description:  "The service the policy applies to, as named in retry log messages, e.g. `core`, `database` or `object_storage`.",
+					},
+					"max_attempts": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The maximum number of attempts of a request, including the first one.",
+					},
+					"max_duration_seconds": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The duration for which a request is retried in response to a retryable error.",
+					},
+					"retryable_status_codes": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+						Description: "The HTTP status codes that are retried in addition to the errors retried by default, e.g. `[404, 409]`.",
+					},
+					"retryable_service_codes": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The service error codes that are retried in addition to the errors retried by default, e.g. `[\"IncorrectState\"]`.",
+					},
+					"backoff": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      tf_resource.QuadraticRetryBackoff,
+						ValidateFunc: validation.StringInSlice(tf_resource.RetryBackoffStrategies, false),
+						Description:  "The backoff strategy between attempts: `quadratic`, `exponential`, `linear` or `constant`.",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -211,6 +308,8 @@ func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if OciResources == nil {
 		OciResources = make(map[string]*schema.Resource)
 	}
+	instrumentResource(name, resourceSchema)
+	tf_resource.AddDefaultTags(resourceSchema)
 	OciResources[name] = resourceSchema
 }
 
@@ -218,9 +317,88 @@ func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	if OciDatasources == nil {
 		OciDatasources = make(map[string]*schema.Resource)
 	}
+	tf_resource.AddFilterValidation(datasourceSchema)
+	instrumentResource(name, datasourceSchema)
 	OciDatasources[name] = datasourceSchema
 }
 
+// instrumentResource wraps the CRUD functions of a resource or data source in a trace span of the operation and
+// attributes their OCI API calls to the resource in the audit log. Legacy CRUD functions are registered as context CRUD
+// functions, Terraform does not pass them a context, and their errors are converted with ToDiagnostics so that they keep
+// their attribute path. Creations are journaled under the create key of the resource, see tf_resource.CreateOrResume,
+// until the resource is saved to the state or its creation fails.
+func instrumentResource(name string, resource *schema.Resource) {
+	type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	type legacyFunc = func(*schema.ResourceData, interface{}) error
+	withContext := func(operation string, fn contextFunc, legacy legacyFunc) contextFunc {
+		if fn == nil && legacy != nil {
+			fn = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return tf_resource.ToDiagnostics(nil, legacy(d, m))
+			}
+		}
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			ctx, span := startOperationSpan(tf_client.WithAuditContext(ctx, name, operation), name, operation)
+			diags := fn(ctx, d, m)
+			err := diagnosticsError(diags)
+			endOperationSpan(span, d, err)
+			return diags
+		}
+	}
+	resource.CreateContext = withCreateJournal(name, resource, withContext("create", resource.CreateContext, resource.Create))
+	resource.ReadContext = withContext("read", resource.ReadContext, resource.Read)
+	resource.UpdateContext = withContext("update", resource.UpdateContext, resource.Update)
+	resource.DeleteContext = withContext("delete", resource.DeleteContext, resource.Delete)
+	resource.Create = nil
+	resource.Read = nil
+	resource.Update = nil
+	resource.Delete = nil
+}
+
+func withCreateJournal(name string, resource *schema.Resource, create schema.CreateContextFunc) schema.CreateContextFunc {
+	if create == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		journal := resume.JournalVar
+		if journal == nil {
+			return create(ctx, d, m)
+		}
+		key := tf_resource.CreateKey(name, resource.Schema, d)
+		diags := create(resume.WithCreateKey(ctx, key), d, m)
+		// The creation of an apply that is cancelled before the resource is saved is kept for the next apply
+		if d.Id() != "" || ctx.Err() == nil {
+			journal.Delete(key)
+		}
+		return diags
+	}
+}
+
+func startOperationSpan(ctx context.Context, name string, operation string) (context.Context, *tracing.Span) {
+	ctx, span := tracing.StartOperation(ctx, fmt.Sprintf("%s %s", name, operation))
+	span.SetAttribute(tracing.ResourceTypeAttr, name)
+	span.SetAttribute(tracing.OperationAttr, operation)
+	return ctx, span
+}
+
+func endOperationSpan(span *tracing.Span, d *schema.ResourceData, err error) {
+	if d != nil {
+		span.SetAttribute(tracing.OcidAttr, d.Id())
+	}
+	span.End(err)
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s", d.Summary)
+		}
+	}
+	return nil
+}
+
 // This returns a map of all data sources to register with Terraform
 // The OciDatasources map is populated by each datasource's init function being invoked before it gets here
 func DataSourcesMap() map[string]*schema.Resource {
@@ -245,11 +423,15 @@ func ResourcesMap() map[string]*schema.Resource {
 }
 
 func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
+	tracing.Configure()
 	tf_resource.DefinedTagsToSuppress = IgnoreDefinedTags(d)
 	clients := &tf_client.OracleClients{
 		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
 		Configuration: make(map[string]string),
 	}
+	if err := SetDefaultTags(d, clients); err != nil {
+		return nil, err
+	}
 
 	if d.Get(globalvar.DisableAutoRetriesAttrName).(bool) {
 		tf_resource.ShortRetryTime = 0
@@ -263,6 +445,12 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		tf_resource.ConfiguredRetryDuration = &val
 	}
 
+	var err error
+	clients.RetryConfigs, err = BuildRetryConfigs(d)
+	if err != nil {
+		return nil, err
+	}
+
 	sdkConfigProvider, err := GetSdkConfigProvider(d, clients)
 	if err != nil {
 		return nil, err
@@ -276,6 +464,19 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
+	clients.RateLimiters, err = BuildRateLimiters(d)
+	if err != nil {
+		return nil, err
+	}
+
+	clients.AuditLog, err = BuildAuditLog(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// beware: global variable `JournalVar` set here--shared by all resources
+	resume.JournalVar = BuildCreateJournal(d)
+
 	err = tf_client.CreateSDKClients(clients, sdkConfigProvider, tf_client.ConfigureClientVar)
 	if err != nil {
 		return nil, err
@@ -321,6 +522,15 @@ func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClien
 		return nil, err
 	}
 
+	for _, configProvider := range configProviders {
+		if tokenProvider, ok := configProvider.(*securityTokenConfigurationProvider); ok {
+			return securityTokenComposingConfigurationProvider{ConfigurationProvider: sdkConfigProvider, tokenProvider: tokenProvider}, nil
+		}
+		if pairProvider, ok := configProvider.(sessionKeyPairProvider); ok {
+			return sessionKeyPairComposingConfigurationProvider{ConfigurationProvider: sdkConfigProvider, pairProvider: pairProvider}, nil
+		}
+	}
+
 	return sdkConfigProvider, nil
 }
 
@@ -341,20 +551,7 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 			return nil, fmt.Errorf("can not get %s from Terraform configuration (InstancePrincipal)", globalvar.RegionAttrName)
 		}
 
-		// Used to modify InstancePrincipal auth clients so that `accept_local_certs` is honored for auth clients as well
-		// These clients are created implicitly by SDK, and are not modified by the utils.BuildConfigureClientFn that usually does this for the other SDK clients
-		instancePrincipalAuthClientModifier := func(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
-			if acceptLocalCerts := utils.GetEnvSettingWithBlankDefault(globalvar.AcceptLocalCerts); acceptLocalCerts != "" {
-				if bool, err := strconv.ParseBool(acceptLocalCerts); err == nil {
-					modifiedClient := BuildHttpClient()
-					modifiedClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = bool
-					return modifiedClient, nil
-				}
-			}
-			return client, nil
-		}
-
-		cfg, err := oci_common_auth.InstancePrincipalConfigurationForRegionWithCustomClient(oci_common.StringToRegion(region.(string)), instancePrincipalAuthClientModifier)
+		cfg, err := oci_common_auth.InstancePrincipalConfigurationForRegionWithCustomClient(oci_common.StringToRegion(region.(string)), authClientModifier)
 		if err != nil {
 			return nil, err
 		}
@@ -436,20 +633,102 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		if err := utils.CheckProfile(profileString, defaultPath); err != nil {
 			return nil, err
 		}
-		securityTokenBasedAuthConfigProvider := oci_common.CustomProfileConfigProvider(defaultPath, profileString)
+		securityTokenBasedAuthConfigProvider, err := newSecurityTokenConfigurationProvider(defaultPath, profileString, region.(string))
+		if err != nil {
+			return nil, err
+		}
 
-		keyId, err := securityTokenBasedAuthConfigProvider.KeyID()
-		if err != nil || !strings.HasPrefix(keyId, "ST$") {
-			return nil, fmt.Errorf("Security token is invalid ")
+		// Also refreshes the token if it is about to expire
+		if _, err := securityTokenBasedAuthConfigProvider.KeyID(); err != nil {
+			return nil, err
 		}
 		configProviders = append(configProviders, securityTokenBasedAuthConfigProvider)
+	case strings.ToLower(globalvar.AuthResourcePrincipalSetting):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		// The resource principal session token and key are provided by the environment, e.g. OCI Functions or Resource Manager
+		checkResourcePrincipalAcceptLocalCerts()
+		cfg, err := oci_common_auth.ResourcePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("can not get resource principal configuration (ResourcePrincipal): %v", err)
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
+		configProviders = append(configProviders, getRegionOverrideConfigProviders(d)...)
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(globalvar.AuthOKEWorkloadIdentitySetting):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		cfg, err := newOkeWorkloadIdentityConfigurationProvider(authClientModifier)
+		if err != nil {
+			return nil, fmt.Errorf("can not get OKE workload identity configuration (OKEWorkloadIdentity): %v", err)
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
+		configProviders = append(configProviders, getRegionOverrideConfigProviders(d)...)
+		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.AuthResourcePrincipalSetting, globalvar.AuthOKEWorkloadIdentitySetting)
 	}
 
 	return configProviders, nil
 }
 
+// Used to modify the auth clients of InstancePrincipal and OKEWorkloadIdentity so that `accept_local_certs` is honored for auth clients as well
+// These clients are not modified by the utils.BuildConfigureClientFn that usually does this for the other SDK clients
+// The TLS config of the client is modified in place, so that the CA certificates it trusts are kept
+func authClientModifier(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
+	acceptLocalCerts := utils.GetEnvSettingWithBlankDefault(globalvar.AcceptLocalCerts)
+	if acceptLocalCerts == "" {
+		return client, nil
+	}
+	insecureSkipVerify, err := strconv.ParseBool(acceptLocalCerts)
+	if err != nil {
+		return client, nil
+	}
+	httpClient, ok := client.(*http.Client)
+	if !ok {
+		return client, nil
+	}
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok || transport == http.DefaultTransport {
+		// Clients without a transport use the transport shared by the whole process, which must not be modified
+		transport = BuildHttpClient().Transport.(*http.Transport)
+		httpClient.Transport = transport
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = insecureSkipVerify
+	return httpClient, nil
+}
+
+// The resource principal of version 2.2 is read from the environment, so no auth client is used. The SDK does not allow
+// modifying the auth clients of version 1.1, warn that `accept_local_certs` is not honored for them.
+func checkResourcePrincipalAcceptLocalCerts() {
+	if os.Getenv(oci_common_auth.ResourcePrincipalVersionEnvVar) != oci_common_auth.ResourcePrincipalVersion1_1 {
+		return
+	}
+	if acceptLocalCerts, err := strconv.ParseBool(utils.GetEnvSettingWithBlankDefault(globalvar.AcceptLocalCerts)); err == nil && acceptLocalCerts {
+		log.Printf("[WARN] %s is not honored by the auth clients of %s version %s, only by the service clients", globalvar.AcceptLocalCerts, globalvar.AuthResourcePrincipalSetting, oci_common_auth.ResourcePrincipalVersion1_1)
+	}
+}
+
+// Resource principal and OKE workload identity tokens come with the region of the resource they were issued to.
+// If region is part of the provider block it overrides that region, as it does for SecurityToken.
+func getRegionOverrideConfigProviders(d *schema.ResourceData) []oci_common.ConfigurationProvider {
+	if region, ok := d.GetOk(globalvar.RegionAttrName); ok {
+		return []oci_common.ConfigurationProvider{oci_common.NewRawConfigurationProvider("", "", region.(string), "", "", nil)}
+	}
+	return nil
+}
+
 type ResourceDataConfigProvider struct {
 	D *schema.ResourceData
 }
@@ -498,6 +777,20 @@ func (p ResourceDataConfigProvider) Region() (string, error) {
 	return "", fmt.Errorf("can not get %s from Terraform configuration", globalvar.RegionAttrName)
 }
 
+// SetDefaultTags sets the provider default tags that are merged into the tags of every taggable resource
+func SetDefaultTags(d schemaResourceData, clients *tf_client.OracleClients) error {
+	if freeformTags, ok := d.GetOkExists(globalvar.DefaultFreeformTagsAttrName); ok {
+		clients.DefaultFreeformTags = freeformTags.(map[string]interface{})
+	}
+	if definedTags, ok := d.GetOkExists(globalvar.DefaultDefinedTagsAttrName); ok {
+		if _, err := tf_resource.MapToDefinedTags(definedTags.(map[string]interface{})); err != nil {
+			return fmt.Errorf("invalid %s: %v", globalvar.DefaultDefinedTagsAttrName, err)
+		}
+		clients.DefaultDefinedTags = definedTags.(map[string]interface{})
+	}
+	return nil
+}
+
 func IgnoreDefinedTags(d schemaResourceData) []string {
 	if ignoreTags, ok := d.GetOkExists(globalvar.DefinedTagsToIgnore); ok {
 		var tags []string
@@ -548,6 +841,119 @@ func (p ResourceDataConfigProvider) PrivateRSAKey() (key *rsa.PrivateKey, err er
 
 	return nil, fmt.Errorf("can not get private_key or private_key_path from Terraform configuration")
 }
+
+// BuildRateLimiters returns the client side rate limits of the CLIENT_RATE_LIMITS environment variable and the
+// client_rate_limits provider setting; limits in the provider block take precedence. Clients without a limit get an
+// adaptive default limit. Requests in flight are limited by the client_max_in_flight_requests provider setting.
+func BuildRateLimiters(d *schema.ResourceData) (*tf_client.RateLimiters, error) {
+	rateLimits, err := tf_client.ParseRateLimits(utils.GetEnvSettingWithBlankDefault(globalvar.ClientRateLimitsEnv))
+	if err != nil {
+		return nil, err
+	}
+	if configuredRateLimits, ok := d.GetOk(globalvar.ClientRateLimitsAttrName); ok {
+		for clientName, rate := range configuredRateLimits.(map[string]interface{}) {
+			rateLimits[clientName] = rate.(string)
+		}
+	}
+	maxInFlight, _ := d.Get(globalvar.ClientMaxInFlightRequestsAttrName).(int)
+	return tf_client.NewRateLimiters(rateLimits, maxInFlight)
+}
+
+// BuildAuditLog returns the audit log at the audit_log_path provider setting or the OCI_AUDIT_LOG_PATH environment
+// variable. Returns nil if neither is set.
+func BuildAuditLog(d *schema.ResourceData) (*tf_client.AuditLog, error) {
+	path := utils.GetEnvSettingWithBlankDefault(globalvar.AuditLogPathEnv)
+	if configuredPath, ok := d.GetOk(globalvar.AuditLogPathAttrName); ok {
+		path = configuredPath.(string)
+	}
+	if path == "" {
+		return nil, nil
+	}
+	return tf_client.GetAuditLog(utils.ExpandPath(path))
+}
+
+// BuildCreateJournal returns the journal of creations at the create_journal_path provider setting or the
+// OCI_CREATE_JOURNAL_PATH environment variable, by default in the .terraform directory of the working directory.
+// Returns nil if none is set and the provider does not run from a Terraform working directory.
+func BuildCreateJournal(d *schema.ResourceData) *resume.Journal {
+	path := utils.GetEnvSettingWithBlankDefault(globalvar.CreateJournalPathEnv)
+	if configuredPath, ok := d.GetOk(globalvar.CreateJournalPathAttrName); ok {
+		path = configuredPath.(string)
+	}
+	if path == "" {
+		if info, err := os.Stat(filepath.Dir(globalvar.DefaultCreateJournalPath)); err != nil || !info.IsDir() {
+			return nil
+		}
+		path = globalvar.DefaultCreateJournalPath
+	}
+	return resume.GetJournal(utils.ExpandPath(path))
+}
+
+// retryServicesWithoutClient are the services of retry policies that are not named after the package of a client
+var retryServicesWithoutClient = []string{"catalog", "datasafeprivateendpoints", "domain", "migration", "work_request"}
+
+// validateRetryService warns about the retry blocks of services that no request is made to, e.g. misspelled ones. The
+// services are named after the packages of the clients, e.g. `core` for `oci_core.ComputeClient`.
+func validateRetryService(v interface{}, k string) ([]string, []error) {
+	warnings, errs := validation.StringIsNotEmpty(v, k)
+	if len(errs) > 0 {
+		return warnings, errs
+	}
+	service := v.(string)
+	if service == tf_resource.AllServicesRetryConfig {
+		return warnings, nil
+	}
+	for _, name := range retryServicesWithoutClient {
+		if service == name {
+			return warnings, nil
+		}
+	}
+	if tf_client.OracleClientRegistrationsVar != nil {
+		for clientName := range tf_client.OracleClientRegistrationsVar.RegisteredClients {
+			if strings.HasPrefix(clientName, "oci_"+service+".") {
+				return warnings, nil
+			}
+		}
+	}
+	return append(warnings, fmt.Sprintf("%s: unknown service '%s', the retry policy does not apply to any request", k, service)), nil
+}
+
+// BuildRetryConfigs returns the retry policies of the retry blocks by service. Returns nil if there are none.
+func BuildRetryConfigs(d *schema.ResourceData) (map[string]*tf_resource.RetryConfig, error) {
+	retryBlocks, ok := d.GetOk(globalvar.RetryAttrName)
+	if !ok {
+		return nil, nil
+	}
+	retryConfigs := map[string]*tf_resource.RetryConfig{}
+	for _, retryBlock := range retryBlocks.([]interface{}) {
+		retry, ok := retryBlock.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service := retry["service"].(string)
+		if _, exists := retryConfigs[service]; exists {
+			return nil, fmt.Errorf("duplicate %s block for service '%s'", globalvar.RetryAttrName, service)
+		}
+
+		retryConfig := &tf_resource.RetryConfig{
+			MaxAttempts: uint(retry["max_attempts"].(int)),
+			Backoff:     retry["backoff"].(string),
+		}
+		if maxDurationSeconds := retry["max_duration_seconds"].(int); maxDurationSeconds > 0 {
+			maxDuration := time.Duration(maxDurationSeconds) * time.Second
+			retryConfig.MaxDuration = &maxDuration
+		}
+		for _, statusCode := range retry["retryable_status_codes"].([]interface{}) {
+			retryConfig.RetryableStatusCodes = append(retryConfig.RetryableStatusCodes, statusCode.(int))
+		}
+		for _, serviceCode := range retry["retryable_service_codes"].([]interface{}) {
+			retryConfig.RetryableServiceCodes = append(retryConfig.RetryableServiceCodes, serviceCode.(string))
+		}
+		retryConfigs[service] = retryConfig
+	}
+	return retryConfigs, nil
+}
+
 func BuildHttpClient() (httpClient *http.Client) {
 	httpClient = &http.Client{
 		Timeout: globalvar.DefaultRequestTimeout,
@@ -578,13 +984,13 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 
 	simulateDb, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("simulate_db", "false"))
 
-	requestSigner := oci_common.DefaultRequestSigner(configProvider)
+	requestSigner := buildRequestSigner(configProvider, oci_common.DefaultGenericHeaders(), oci_common.DefaultBodyHeaders())
 	var oboTokenProvider OboTokenProvider
 	oboTokenProvider = emptyOboTokenProvider{}
 	if useOboToken {
 		// Add Obo token to the default list and Update the signer
 		httpHeadersToSign := append(oci_common.DefaultGenericHeaders(), globalvar.RequestHeaderOpcOboToken)
-		requestSigner = oci_common.RequestSigner(configProvider, httpHeadersToSign, oci_common.DefaultBodyHeaders())
+		requestSigner = buildRequestSigner(configProvider, httpHeadersToSign, oci_common.DefaultBodyHeaders())
 		oboTokenProvider = oboTokenProviderFromEnv{}
 	}
 
@@ -1,8 +1,28 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+	tf_resource "github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing/tracingtest"
 )
 
 type mockResourceData struct {
@@ -47,3 +67,282 @@ func TestUnitIgnoreTags(t *testing.T) {
 	}
 
 }
+
+func TestUnitBuildRateLimiters(t *testing.T) {
+	setTestEnv(t, map[string]string{globalvar.ClientRateLimitsEnv: "oci_identity.IdentityClient=1/s;*=50/s"})
+
+	rateLimiters, err := BuildRateLimiters(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.ClientRateLimitsAttrName: map[string]interface{}{"oci_identity.IdentityClient": "5/s"},
+	}))
+	assert.NoError(t, err)
+	assert.NotNil(t, rateLimiters)
+
+	// Clients are rate limited by default
+	setTestEnv(t, map[string]string{globalvar.ClientRateLimitsEnv: ""})
+	rateLimiters, err = BuildRateLimiters(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.ClientMaxInFlightRequestsAttrName: 4,
+	}))
+	assert.NoError(t, err)
+	assert.NotNil(t, rateLimiters)
+
+	_, err = BuildRateLimiters(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.ClientRateLimitsAttrName: map[string]interface{}{"oci_identity.IdentityClient": "5/week"},
+	}))
+	assert.Error(t, err)
+}
+
+func TestUnitBuildCreateJournal(t *testing.T) {
+	workingDir, err := os.Getwd()
+	assert.NoError(t, err)
+	defer os.Chdir(workingDir)
+	assert.NoError(t, os.Chdir(t.TempDir()))
+
+	envPath := filepath.Join(t.TempDir(), "env-journal.json")
+	configuredPath := filepath.Join(t.TempDir(), "journal.json")
+	setTestEnv(t, map[string]string{globalvar.CreateJournalPathEnv: envPath})
+
+	envJournal := BuildCreateJournal(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{}))
+	assert.Same(t, resume.GetJournal(envPath), envJournal)
+
+	// The path in the provider block takes precedence
+	journal := BuildCreateJournal(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.CreateJournalPathAttrName: configuredPath,
+	}))
+	assert.Same(t, resume.GetJournal(configuredPath), journal)
+
+	// Creations are only journaled by default in Terraform working directories
+	setTestEnv(t, map[string]string{globalvar.CreateJournalPathEnv: ""})
+	assert.Nil(t, BuildCreateJournal(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{})))
+	assert.NoError(t, os.Mkdir(".terraform", 0755))
+	assert.Same(t, resume.GetJournal(globalvar.DefaultCreateJournalPath), BuildCreateJournal(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{})))
+}
+
+func TestUnitBuildAuditLog(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), "env-audit.log")
+	configuredPath := filepath.Join(t.TempDir(), "audit.log")
+	setTestEnv(t, map[string]string{globalvar.AuditLogPathEnv: envPath})
+
+	auditLog, err := BuildAuditLog(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.NotNil(t, auditLog)
+	assert.FileExists(t, envPath)
+
+	// The path in the provider block takes precedence
+	auditLog, err = BuildAuditLog(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuditLogPathAttrName: configuredPath,
+	}))
+	assert.NoError(t, err)
+	assert.NotNil(t, auditLog)
+	assert.FileExists(t, configuredPath)
+
+	setTestEnv(t, map[string]string{globalvar.AuditLogPathEnv: ""})
+	auditLog, err = BuildAuditLog(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Nil(t, auditLog)
+}
+
+func TestUnitBuildRetryConfigs(t *testing.T) {
+	retryConfigs, err := BuildRetryConfigs(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Nil(t, retryConfigs)
+
+	tenMinutes := 10 * time.Minute
+	retryConfigs, err = BuildRetryConfigs(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RetryAttrName: []interface{}{
+			map[string]interface{}{
+				"service":                 "database",
+				"max_attempts":            5,
+				"max_duration_seconds":    600,
+				"retryable_status_codes":  []interface{}{429, 503},
+				"retryable_service_codes": []interface{}{"IncorrectState"},
+				"backoff":                 "exponential",
+			},
+			map[string]interface{}{
+				"service": "*",
+			},
+		},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*tf_resource.RetryConfig{
+		"database": {
+			MaxAttempts:           5,
+			MaxDuration:           &tenMinutes,
+			RetryableStatusCodes:  []int{429, 503},
+			RetryableServiceCodes: []string{"IncorrectState"},
+			Backoff:               tf_resource.ExponentialRetryBackoff,
+		},
+		tf_resource.AllServicesRetryConfig: {
+			Backoff: tf_resource.QuadraticRetryBackoff,
+		},
+	}, retryConfigs)
+
+	_, err = BuildRetryConfigs(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RetryAttrName: []interface{}{
+			map[string]interface{}{"service": "core", "max_attempts": 2},
+			map[string]interface{}{"service": "core", "max_attempts": 3},
+		},
+	}))
+	assert.EqualError(t, err, "duplicate retry block for service 'core'")
+
+	for _, service := range []string{"core", "object_storage", "work_request", "*"} {
+		warnings, errs := validateRetryService(service, "retry.0.service")
+		assert.Empty(t, warnings, "service %s", service)
+		assert.Empty(t, errs, "service %s", service)
+	}
+	warnings, errs := validateRetryService("databse", "retry.0.service")
+	assert.Equal(t, []string{"retry.0.service: unknown service 'databse', the retry policy does not apply to any request"}, warnings)
+	assert.Empty(t, errs)
+	_, errs = validateRetryService("", "retry.0.service")
+	assert.Len(t, errs, 1)
+}
+
+func TestUnitInstrumentResource_auditContext(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	client := &oci_common.BaseClient{HTTPClient: &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+	})}}
+	tf_client.ApplyAuditLog(tf_client.NewAuditLog(buffer), client)
+
+	sendRequest := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+		client.HTTPClient.Do(request)
+		return nil
+	}
+	resource := &schema.Resource{CreateContext: sendRequest, ReadContext: sendRequest}
+	instrumentResource("oci_core_vcn", resource)
+	assert.Nil(t, resource.UpdateContext)
+	assert.Nil(t, resource.DeleteContext)
+
+	resource.CreateContext(context.Background(), nil, nil)
+	resource.ReadContext(context.Background(), nil, nil)
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"resource_type":"oci_core_vcn","operation":"create"`)
+	assert.Contains(t, lines[1], `"resource_type":"oci_core_vcn","operation":"read"`)
+
+	// The CRUD helpers hand the audit context to the resource
+	buffer.Reset()
+	subnet := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			sync := &auditContextTestCrud{BaseCrud: tf_resource.BaseCrud{D: d}, client: client}
+			return tf_resource.ToDiagnostics(sync, tf_resource.ReadResourceContext(ctx, sync))
+		},
+	}
+	instrumentResource("oci_core_subnet", subnet)
+	d := subnet.TestResourceData()
+	d.SetId("ocid1.subnet.oc1..aaa")
+	assert.Empty(t, subnet.ReadContext(context.Background(), d, nil))
+	assert.Contains(t, buffer.String(), `"resource_type":"oci_core_subnet","operation":"read"`)
+}
+
+type auditContextTestCrud struct {
+	tf_resource.BaseCrud
+	client *oci_common.BaseClient
+}
+
+func (s *auditContextTestCrud) Get() error {
+	request, _ := http.NewRequestWithContext(s.Context(), http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/subnets", nil)
+	_, err := s.client.HTTPClient.Do(request)
+	return err
+}
+
+func (s *auditContextTestCrud) SetData() error {
+	return nil
+}
+
+func TestUnitInstrumentResource_tracing(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	var parentSpanId string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"display_name": {Type: schema.TypeString, Optional: true}},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			parentSpanId = tracing.SpanFromContext(ctx).SpanId()
+			d.SetId("ocid1.vcn.oc1..aaa")
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return fmt.Errorf("delete failed")
+		},
+	}
+	instrumentResource("oci_core_vcn", resource)
+	assert.Nil(t, resource.Delete, "legacy CRUD functions should be registered as context CRUD functions")
+
+	d := resource.TestResourceData()
+	resource.CreateContext(context.Background(), d, nil)
+	resource.DeleteContext(context.Background(), d, nil)
+
+	spans := collector.Spans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "oci_core_vcn create", spans[0].Name)
+	assert.Equal(t, parentSpanId, spans[0].SpanId, "the operation span should be passed to the CRUD function")
+	assert.Equal(t, map[string]string{
+		tracing.ResourceTypeAttr: "oci_core_vcn",
+		tracing.OperationAttr:    "create",
+		tracing.OcidAttr:         "ocid1.vcn.oc1..aaa",
+	}, spans[0].Attributes)
+	assert.Equal(t, "oci_core_vcn delete", spans[1].Name)
+	assert.Equal(t, "delete failed", spans[1].Error)
+}
+
+func TestUnitInstrumentResource_diagnostics(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"display_name": {Type: schema.TypeString, Optional: true}},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return tf_resource.HandleError(&tf_resource.BaseCrud{D: d}, fmt.Errorf("timeout while waiting for state to become 'TERMINATED'"))
+		},
+	}
+	instrumentResource("oci_core_vcn", resource)
+
+	diags := resource.DeleteContext(context.Background(), resource.TestResourceData(), nil)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "Operation Timeout", diags[0].Summary, "errors of legacy CRUD functions should be converted with ToDiagnostics")
+}
+
+func TestUnitInstrumentResource_createJournal(t *testing.T) {
+	previousJournal := resume.JournalVar
+	defer func() { resume.JournalVar = previousJournal }()
+	resume.JournalVar = resume.GetJournal(filepath.Join(t.TempDir(), "journal.json"))
+
+	var key string
+	var id string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"display_name": {Type: schema.TypeString, Optional: true}},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			key = resume.CreateKeyFromContext(ctx)
+			assert.NoError(t, resume.JournalVar.Put(key, resume.Creation{Entry: resume.Entry{Identifier: "ocid1.vcn.a"}}))
+			d.SetId(id)
+			return diag.FromErr(ctx.Err())
+		},
+	}
+	instrumentResource("oci_core_vcn", resource)
+	newResourceData := func() *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"display_name": "vcn"})
+	}
+
+	assert.Empty(t, resource.CreateContext(context.Background(), newResourceData(), nil))
+	assert.Equal(t, tf_resource.CreateKey("oci_core_vcn", resource.Schema, newResourceData()), key)
+	_, journaled := resume.JournalVar.Get(key)
+	assert.False(t, journaled, "the creation should be forgotten once the resource is created")
+
+	// The creation of a cancelled apply is kept, unless the resource is saved to the state
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotEmpty(t, resource.CreateContext(ctx, newResourceData(), nil))
+	_, journaled = resume.JournalVar.Get(key)
+	assert.True(t, journaled, "the creation of a cancelled apply should be kept for the next apply")
+
+	id = "ocid1.vcn.a"
+	assert.NotEmpty(t, resource.CreateContext(ctx, newResourceData(), nil))
+	_, journaled = resume.JournalVar.Get(key)
+	assert.False(t, journaled, "the creation should be forgotten once the resource is saved to the state")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
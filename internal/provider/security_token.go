@@ -0,0 +1,219 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const securityTokenRefreshWindow = 5 * time.Minute
+
+// The endpoint used by `oci session refresh`
+var securityTokenRefreshEndpointTemplate = "https://auth.{region}.{secondLevelDomain}/v1/authentication/refresh"
+
+var configFileProfileRegex = regexp.MustCompile(`^\s*\[(.*)\]\s*$`)
+
+var writeSecurityTokenFile = ioutil.WriteFile
+
+// securityTokenConfigurationProvider reads a session token created by `oci session authenticate` from a config file
+// profile and refreshes it before it expires, the same way `oci session refresh` does. The refreshed token is written
+// back to the security_token_file of the profile and used to sign all later requests.
+type securityTokenConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	profile    string
+	configPath string
+	region     string
+	dispatcher oci_common.HTTPRequestDispatcher
+
+	mutex sync.Mutex
+	// token is the session token in use, fileToken the token last read from or written to security_token_file
+	token     string
+	fileToken string
+}
+
+type securityTokenRefreshRequest struct {
+	CurrentToken string `json:"currentToken"`
+}
+
+type securityTokenRefreshResponse struct {
+	Token string `json:"token"`
+}
+
+func newSecurityTokenConfigurationProvider(configPath string, profile string, region string) (*securityTokenConfigurationProvider, error) {
+	dispatcher, err := authClientModifier(BuildHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return &securityTokenConfigurationProvider{
+		ConfigurationProvider: oci_common.CustomProfileConfigProvider(configPath, profile),
+		profile:               profile,
+		configPath:            configPath,
+		region:                region,
+		dispatcher:            dispatcher,
+	}, nil
+}
+
+func (p *securityTokenConfigurationProvider) String() string {
+	return fmt.Sprintf("security token of profile '%s' in %s", p.profile, p.configPath)
+}
+
+// KeyID returns the session token as key ID, refreshing it first if it is about to expire
+func (p *securityTokenConfigurationProvider) KeyID() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	keyId, err := p.ConfigurationProvider.KeyID()
+	if err != nil {
+		return "", fmt.Errorf("can not read security token of profile '%s': %v", p.profile, err)
+	}
+	fileToken := strings.TrimPrefix(strings.TrimSpace(keyId), "ST$")
+	if fileToken == keyId {
+		return "", fmt.Errorf("security token of profile '%s' is invalid", p.profile)
+	}
+	// Pick up tokens refreshed outside of Terraform, e.g. by `oci session refresh`
+	if p.token == "" || fileToken != p.fileToken {
+		p.token = fileToken
+		p.fileToken = fileToken
+	}
+
+	claims, err := parseSessionTokenClaims(p.token)
+	if err != nil {
+		return "", fmt.Errorf("security token of profile '%s' is invalid: %v", p.profile, err)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return "ST$" + p.token, nil
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+	if time.Now().Add(securityTokenRefreshWindow).Before(expiresAt) {
+		return "ST$" + p.token, nil
+	}
+	if !time.Now().Before(expiresAt) {
+		return "", fmt.Errorf("security token of profile '%s' expired at %s and can no longer be refreshed, run 'oci session authenticate --profile-name %s' to create a new one", p.profile, expiresAt.Format(time.RFC3339), p.profile)
+	}
+
+	log.Printf("[INFO] security token of profile '%s' expires at %s, refreshing it", p.profile, expiresAt.Format(time.RFC3339))
+	if err := p.refresh(); err != nil {
+		return "", fmt.Errorf("unable to refresh security token of profile '%s' that expires at %s: %v. Run 'oci session refresh --profile %s' or 'oci session authenticate --profile-name %s'", p.profile, expiresAt.Format(time.RFC3339), err, p.profile, p.profile)
+	}
+	return "ST$" + p.token, nil
+}
+
+// refresh must be called with the mutex held
+func (p *securityTokenConfigurationProvider) refresh() error {
+	body, err := json.Marshal(securityTokenRefreshRequest{CurrentToken: p.token})
+	if err != nil {
+		return err
+	}
+	endpoint := oci_common.StringToRegion(p.region).EndpointForTemplate("auth", securityTokenRefreshEndpointTemplate)
+	request, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	// The refresh request is signed with the current token and session key
+	signer := oci_common.DefaultRequestSigner(sessionTokenKeyProvider{p.ConfigurationProvider, "ST$" + p.token})
+	if err := signer.Sign(request); err != nil {
+		return err
+	}
+
+	response, err := p.dispatcher.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("status: %d, body: %s", response.StatusCode, responseBody)
+	}
+	refreshResponse := securityTokenRefreshResponse{}
+	if err := json.Unmarshal(responseBody, &refreshResponse); err != nil || refreshResponse.Token == "" {
+		return fmt.Errorf("invalid refresh response: %s", responseBody)
+	}
+	p.token = refreshResponse.Token
+
+	// Keep the profile usable for the OCI CLI and later Terraform runs
+	tokenFile, err := getConfigFileProfileSetting(p.configPath, p.profile, "security_token_file")
+	if err == nil {
+		err = writeSecurityTokenFile(utils.ExpandPath(tokenFile), []byte(p.token), 0600)
+	}
+	if err != nil {
+		log.Printf("[WARN] unable to write refreshed security token of profile '%s' to its security_token_file: %v", p.profile, err)
+		return nil
+	}
+	p.fileToken = p.token
+	return nil
+}
+
+// sessionTokenKeyProvider signs with the session key of a profile and the given session token
+type sessionTokenKeyProvider struct {
+	oci_common.ConfigurationProvider
+	keyId string
+}
+
+func (p sessionTokenKeyProvider) KeyID() (string, error) {
+	return p.keyId, nil
+}
+
+// getConfigFileProfileSetting returns the value of key in the given profile of an OCI config file
+func getConfigFileProfileSetting(configPath string, profile string, key string) (string, error) {
+	file, err := os.Open(configPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	inProfile := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if match := configFileProfileRegex.FindStringSubmatch(line); match != nil {
+			inProfile = match[1] == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		if parts := strings.SplitN(line, "=", 2); len(parts) == 2 && strings.TrimSpace(parts[0]) == key {
+			return strings.TrimSpace(parts[1]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s is not set for profile '%s' in %s", key, profile, configPath)
+}
+
+// securityTokenComposingConfigurationProvider reports why the security token can not be used instead of the
+// generic "did not find a proper configuration for key id" error of the composing configuration provider
+type securityTokenComposingConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	tokenProvider *securityTokenConfigurationProvider
+}
+
+// KeyID returns the token in use rather than the one in security_token_file, which is stale when a refreshed token
+// could not be written back
+func (p securityTokenComposingConfigurationProvider) KeyID() (string, error) {
+	return p.tokenProvider.KeyID()
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// writeSecurityTokenProfile writes an OCI config file with a session token profile like `oci session authenticate` does
+func writeSecurityTokenProfile(t *testing.T, token string) (tokenPath string) {
+	home := t.TempDir()
+	setTestEnv(t, map[string]string{"TF_HOME_OVERRIDE": home})
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	configDir := filepath.Join(home, globalvar.DefaultConfigDirName)
+	assert.NoError(t, os.MkdirAll(configDir, 0700))
+	keyPath := filepath.Join(configDir, "session_key.pem")
+	tokenPath = filepath.Join(configDir, "token")
+	assert.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600))
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(token), 0600))
+
+	config := fmt.Sprintf(`[DEFAULT]
+tenancy=ocid1.tenancy.oc1..default
+
+[SESSION]
+fingerprint=aa:bb:cc
+key_file=%s
+tenancy=ocid1.tenancy.oc1..aaa
+region=us-phoenix-1
+security_token_file=%s
+`, keyPath, tokenPath)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(configDir, globalvar.DefaultConfigFileName), []byte(config), 0600))
+	return tokenPath
+}
+
+// startSecurityTokenRefreshStub starts a local stub of the refresh endpoint used by `oci session refresh`
+func startSecurityTokenRefreshStub(t *testing.T, status int, refreshedToken string, requests *int32) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		request := securityTokenRefreshRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || !strings.Contains(r.Header.Get("Authorization"), `keyId="ST$`+request.CurrentToken+`"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(securityTokenRefreshResponse{Token: refreshedToken})
+	}))
+	t.Cleanup(server.Close)
+
+	previousTemplate := securityTokenRefreshEndpointTemplate
+	securityTokenRefreshEndpointTemplate = server.URL + "/v1/authentication/refresh"
+	t.Cleanup(func() { securityTokenRefreshEndpointTemplate = previousTemplate })
+}
+
+func testSecurityTokenResourceData() map[string]interface{} {
+	return map[string]interface{}{
+		globalvar.AuthAttrName:              globalvar.AuthSecurityToken,
+		globalvar.ConfigFileProfileAttrName: "SESSION",
+		globalvar.RegionAttrName:            "us-phoenix-1",
+	}
+}
+
+func TestUnitGetSdkConfigProvider_securityTokenRefresh(t *testing.T) {
+	type testFormat struct {
+		name          string
+		expiresIn     time.Duration
+		refreshStatus int
+		requests      int32
+		refreshed     bool
+		errorContains string
+	}
+	tests := []testFormat{
+		{
+			name:          "Test valid token is not refreshed",
+			expiresIn:     time.Hour,
+			refreshStatus: http.StatusOK,
+		},
+		{
+			name:          "Test token about to expire is refreshed",
+			expiresIn:     time.Minute,
+			refreshStatus: http.StatusOK,
+			requests:      1,
+			refreshed:     true,
+		},
+		{
+			name:          "Test expired token can not be refreshed",
+			expiresIn:     -time.Minute,
+			refreshStatus: http.StatusOK,
+			errorContains: "security token of profile 'SESSION' expired at",
+		},
+		{
+			name:          "Test failed refresh names the profile",
+			expiresIn:     time.Minute,
+			refreshStatus: http.StatusUnauthorized,
+			requests:      1,
+			errorContains: "unable to refresh security token of profile 'SESSION'",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests int32
+			token := testSessionToken(time.Now().Add(test.expiresIn))
+			refreshedToken := testSessionToken(time.Now().Add(time.Hour))
+			tokenPath := writeSecurityTokenProfile(t, token)
+			startSecurityTokenRefreshStub(t, test.refreshStatus, refreshedToken, &requests)
+
+			clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+			configProvider, err := GetSdkConfigProvider(schema.TestResourceDataRaw(t, SchemaMap(), testSecurityTokenResourceData()), clients)
+			assert.Equal(t, test.requests, atomic.LoadInt32(&requests))
+			if test.errorContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.errorContains)
+				return
+			}
+			assert.NoError(t, err)
+
+			keyId, err := configProvider.KeyID()
+			assert.NoError(t, err)
+			fileContent, _ := ioutil.ReadFile(tokenPath)
+			if test.refreshed {
+				assert.Equal(t, "ST$"+refreshedToken, keyId)
+				assert.Equal(t, refreshedToken, string(fileContent))
+			} else {
+				assert.Equal(t, "ST$"+token, keyId)
+				assert.Equal(t, token, string(fileContent))
+			}
+			assert.Equal(t, test.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestUnitSecurityTokenConfigurationProvider_expiresDuringApply(t *testing.T) {
+	var requests int32
+	token := testSessionToken(time.Now().Add(time.Hour))
+	refreshedToken := testSessionToken(time.Now().Add(2 * time.Hour))
+	tokenPath := writeSecurityTokenProfile(t, token)
+	startSecurityTokenRefreshStub(t, http.StatusOK, refreshedToken, &requests)
+
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	configProvider, err := GetSdkConfigProvider(schema.TestResourceDataRaw(t, SchemaMap(), testSecurityTokenResourceData()), clients)
+	assert.NoError(t, err)
+
+	// The token is replaced by one that is about to expire, later requests are signed with the refreshed token
+	expiringToken := testSessionToken(time.Now().Add(time.Minute))
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(expiringToken), 0600))
+	keyId, err := configProvider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+refreshedToken, keyId)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Once expired without a possible refresh, the error names the profile instead of the composing provider error
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(testSessionToken(time.Now().Add(-time.Minute))), 0600))
+	_, err = configProvider.KeyID()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oci session authenticate --profile-name SESSION")
+}
+
+func TestUnitSecurityTokenConfigurationProvider_readOnlyTokenFile(t *testing.T) {
+	var requests int32
+	token := testSessionToken(time.Now().Add(time.Minute))
+	refreshedToken := testSessionToken(time.Now().Add(time.Hour))
+	tokenPath := writeSecurityTokenProfile(t, token)
+	startSecurityTokenRefreshStub(t, http.StatusOK, refreshedToken, &requests)
+	assert.NoError(t, os.Chmod(tokenPath, 0400))
+	if ioutil.WriteFile(tokenPath, []byte(token), 0600) == nil {
+		// Permissions are not enforced for root
+		writeSecurityTokenFile = func(string, []byte, os.FileMode) error { return os.ErrPermission }
+		t.Cleanup(func() { writeSecurityTokenFile = ioutil.WriteFile })
+	}
+
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	configProvider, err := GetSdkConfigProvider(schema.TestResourceDataRaw(t, SchemaMap(), testSecurityTokenResourceData()), clients)
+	assert.NoError(t, err)
+
+	// The refreshed token can not be written back, requests are still signed with it instead of the stale file token
+	for i := 0; i < 2; i++ {
+		keyId, err := configProvider.KeyID()
+		assert.NoError(t, err)
+		assert.Equal(t, "ST$"+refreshedToken, keyId)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	fileContent, _ := ioutil.ReadFile(tokenPath)
+	assert.Equal(t, token, string(fileContent))
+}
+
+func TestUnitGetConfigFileProfileSetting(t *testing.T) {
+	tokenPath := writeSecurityTokenProfile(t, "token")
+	configPath := filepath.Join(os.Getenv("TF_HOME_OVERRIDE"), globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName)
+
+	value, err := getConfigFileProfileSetting(configPath, "SESSION", "security_token_file")
+	assert.NoError(t, err)
+	assert.Equal(t, tokenPath, value)
+
+	_, err = getConfigFileProfileSetting(configPath, "DEFAULT", "security_token_file")
+	assert.Error(t, err)
+}
@@ -0,0 +1,391 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	tf_provider "github.com/terraform-providers/terraform-provider-oci/internal/provider"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	DriftStatusInSync  = "in_sync"
+	DriftStatusDrifted = "drifted"
+	DriftStatusDeleted = "deleted"
+	DriftStatusError   = "error"
+	DriftStatusSkipped = "skipped"
+
+	sensitiveDriftValue = "(sensitive)"
+)
+
+type DriftCommandArgs struct {
+	StatePath    string
+	ReportPath   string
+	RetryTimeout *string
+	Parallelism  int
+}
+
+// DriftReport compares the resources of a state file with their live configuration in OCI
+type DriftReport struct {
+	StatePath string                 `json:"state_path"`
+	Resources []*ResourceDriftReport `json:"resources"`
+}
+
+// ResourceDriftReport is the drift of a resource instance of the state
+type ResourceDriftReport struct {
+	Address string           `json:"address"`
+	Id      string           `json:"id"`
+	Status  string           `json:"status"`
+	Error   string           `json:"error,omitempty"`
+	Drifts  []AttributeDrift `json:"drifts,omitempty"`
+}
+
+// AttributeDrift is an attribute whose live value differs from its value in the state. Attributes are named by
+// their flattened path, e.g. `freeform_tags.Department` or `cidr_blocks.0`. Missing values are nil.
+type AttributeDrift struct {
+	Attribute string  `json:"attribute"`
+	State     *string `json:"state"`
+	Live      *string `json:"live"`
+	// Computed is true for attributes set by OCI only, e.g. `state` or `time_created`
+	Computed bool `json:"computed"`
+}
+
+// driftState is the part of a Terraform state file in the v4 format used by drift detection
+type driftState struct {
+	Version   int                  `json:"version"`
+	Resources []driftStateResource `json:"resources"`
+}
+
+type driftStateResource struct {
+	Module    string               `json:"module,omitempty"`
+	Mode      string               `json:"mode"`
+	Type      string               `json:"type"`
+	Name      string               `json:"name"`
+	Instances []driftStateInstance `json:"instances"`
+}
+
+type driftStateInstance struct {
+	IndexKey      interface{}                `json:"index_key,omitempty"`
+	SchemaVersion int                        `json:"schema_version"`
+	Attributes    map[string]json.RawMessage `json:"attributes"`
+}
+
+// RunDriftCommand refreshes the resources of a state file with their Read functions and reports the attributes
+// that drifted from the state
+func RunDriftCommand(args *DriftCommandArgs) (err error, status Status) {
+	defer func() {
+		if r := recover(); r != nil {
+			utils.Logf("[ERROR] panic in RunDriftCommand, exiting with status %v", StatusFail)
+			debug.PrintStack()
+			err = errors.New("[ERROR] panic in RunDriftCommand: unknown error occurred in drift detection")
+			status = StatusFail
+		}
+	}()
+	resourcesMap = tf_provider.ResourcesMap()
+
+	if args.Parallelism < 1 {
+		return fmt.Errorf("[ERROR] invalid value for arument parallelism, specify a value >= 1"), StatusFail
+	}
+	state, err := readDriftState(args.StatePath)
+	if err != nil {
+		return err, StatusFail
+	}
+
+	r := &schema.Resource{
+		Schema: tf_provider.SchemaMap(),
+	}
+	d := r.Data(nil)
+	if err := readEnvironmentVars(d); err != nil {
+		return err, StatusFail
+	}
+	clients, err := getExportConfigVar(d)
+	if err != nil {
+		return err, StatusFail
+	}
+	if args.RetryTimeout != nil && *args.RetryTimeout != "" {
+		tfresource.LongRetryTime = *tfresource.GetTimeoutDuration(*args.RetryTimeout)
+		tfresource.ShortRetryTime = tfresource.LongRetryTime
+	}
+
+	report := detectDrift(state, clients.(*tf_client.OracleClients), args.Parallelism)
+	report.StatePath = args.StatePath
+	utils.Logln(report.String())
+
+	if args.ReportPath != "" {
+		content, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling drift report to JSON: %v", err), StatusFail
+		}
+		if err := ioutil.WriteFile(args.ReportPath, content, 0644); err != nil {
+			return err, StatusFail
+		}
+		utils.Logf("[INFO] Drift report written to json file at: %s", args.ReportPath)
+	}
+
+	for _, resource := range report.Resources {
+		if resource.Status == DriftStatusError {
+			return nil, StatusPartialSuccess
+		}
+	}
+	return nil, StatusSuccess
+}
+
+func readDriftState(path string) (*driftState, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to read state file %s: %v", path, err)
+	}
+	state := &driftState{}
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, fmt.Errorf("[ERROR] invalid state file %s: %v", path, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("[ERROR] unsupported version %d of state file %s, only version 4 written by Terraform 0.12 and later is supported", state.Version, path)
+	}
+	return state, nil
+}
+
+// detectDrift refreshes the managed resources of the provider in the state, in the order of the state
+func detectDrift(state *driftState, clients interface{}, parallelism int) *DriftReport {
+	report := &DriftReport{Resources: []*ResourceDriftReport{}}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		resourceSchema, supported := resourcesMap[resource.Type]
+		for _, instance := range resource.Instances {
+			resourceReport := &ResourceDriftReport{Address: resource.address(instance.IndexKey)}
+			report.Resources = append(report.Resources, resourceReport)
+			if !supported {
+				resourceReport.Status = DriftStatusSkipped
+				resourceReport.Error = fmt.Sprintf("resource type %s is not supported by this provider", resource.Type)
+				continue
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(instance driftStateInstance) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				resourceReport.detectDrift(resourceSchema, instance, clients)
+			}(instance)
+		}
+	}
+	wg.Wait()
+	return report
+}
+
+func (resource driftStateResource) address(indexKey interface{}) string {
+	address := fmt.Sprintf("%s.%s", resource.Type, resource.Name)
+	if resource.Module != "" {
+		address = fmt.Sprintf("%s.%s", resource.Module, address)
+	}
+	switch key := indexKey.(type) {
+	case string:
+		address = fmt.Sprintf("%s[%q]", address, key)
+	case float64:
+		address = fmt.Sprintf("%s[%d]", address, int(key))
+	}
+	return address
+}
+
+func (r *ResourceDriftReport) detectDrift(resourceSchema *schema.Resource, instance driftStateInstance, clients interface{}) {
+	defer func() {
+		if p := recover(); p != nil {
+			r.Status = DriftStatusError
+			r.Error = fmt.Sprintf("panic while refreshing the resource: %v", p)
+		}
+	}()
+
+	if instance.SchemaVersion != resourceSchema.SchemaVersion {
+		r.Status = DriftStatusSkipped
+		r.Error = fmt.Sprintf("the state has schema version %d of the resource instead of %d, refresh it with Terraform first", instance.SchemaVersion, resourceSchema.SchemaVersion)
+		return
+	}
+	instanceState, err := shimDriftInstanceState(resourceSchema, instance)
+	if err != nil {
+		r.Status = DriftStatusError
+		r.Error = err.Error()
+		return
+	}
+	r.Id = instanceState.ID
+
+	d := resourceSchema.Data(instanceState)
+	if err := tfresource.ReadResourceSchema(context.Background(), resourceSchema, d, clients); err != nil {
+		r.Status = DriftStatusError
+		r.Error = err.Error()
+		return
+	}
+	// The state of resources that no longer exist is voided by Read
+	if d.Id() == "" {
+		r.Status = DriftStatusDeleted
+		return
+	}
+
+	r.Drifts = attributeDrifts(resourceSchema, instanceState.Attributes, d.State().Attributes)
+	r.Status = DriftStatusInSync
+	if len(r.Drifts) > 0 {
+		r.Status = DriftStatusDrifted
+	}
+}
+
+// shimDriftInstanceState converts the attributes of an instance of the state to the state of the resource, ignoring
+// the attributes that are not in its schema anymore
+func shimDriftInstanceState(resourceSchema *schema.Resource, instance driftStateInstance) (*terraform.InstanceState, error) {
+	impliedType := resourceSchema.CoreConfigSchema().ImpliedType()
+	attributes := map[string]json.RawMessage{}
+	for name, value := range instance.Attributes {
+		if impliedType.HasAttribute(name) {
+			attributes[name] = value
+		}
+	}
+	content, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ctyjson.Unmarshal(content, impliedType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the attributes of the state: %v", err)
+	}
+	if value.IsNull() || !value.Type().IsObjectType() {
+		return nil, fmt.Errorf("the state has no attributes")
+	}
+	if id := value.GetAttr("id"); id.IsNull() || id.Type() != cty.String || id.AsString() == "" {
+		return nil, fmt.Errorf("the state has no id")
+	}
+	return resourceSchema.ShimInstanceStateFromValue(value)
+}
+
+// attributeDrifts compares the flattened attributes of the state and the refreshed resource. The counts of lists,
+// sets and maps are not compared, their elements are.
+func attributeDrifts(resourceSchema *schema.Resource, stateAttributes map[string]string, liveAttributes map[string]string) []AttributeDrift {
+	names := map[string]bool{}
+	for name := range stateAttributes {
+		names[name] = true
+	}
+	for name := range liveAttributes {
+		names[name] = true
+	}
+
+	drifts := []AttributeDrift{}
+	for name := range names {
+		if strings.HasSuffix(name, ".#") || strings.HasSuffix(name, ".%") {
+			continue
+		}
+		stateValue, inState := stateAttributes[name]
+		liveValue, isLive := liveAttributes[name]
+		if inState == isLive && stateValue == liveValue {
+			continue
+		}
+
+		drift := AttributeDrift{Attribute: name}
+		if attributes := attributeSchemas(resourceSchema, name); len(attributes) > 0 {
+			attribute := attributes[len(attributes)-1]
+			drift.Computed = attribute.Computed && !attribute.Optional && !attribute.Required
+			// The values nested in a sensitive block are sensitive too
+			for _, attribute := range attributes {
+				if attribute.Sensitive {
+					stateValue, liveValue = sensitiveDriftValue, sensitiveDriftValue
+					break
+				}
+			}
+		}
+		if inState {
+			drift.State = &stateValue
+		}
+		if isLive {
+			drift.Live = &liveValue
+		}
+		drifts = append(drifts, drift)
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Attribute < drifts[j].Attribute
+	})
+	return drifts
+}
+
+// attributeSchemas returns the schemas of the attributes along the path of a flattened attribute, e.g. those of
+// db_home, database and admin_password for db_home.0.database.0.admin_password. The keys of maps and the elements
+// of lists and sets of primitives are described by the schema of their attribute.
+func attributeSchemas(resourceSchema *schema.Resource, name string) []*schema.Schema {
+	result := []*schema.Schema{}
+	schemaMap := resourceSchema.Schema
+	parts := strings.Split(name, ".")
+	for i := 0; i < len(parts) && schemaMap != nil; i++ {
+		attribute, ok := schemaMap[parts[i]]
+		if !ok {
+			break
+		}
+		result = append(result, attribute)
+		schemaMap = nil
+		if elem, ok := attribute.Elem.(*schema.Resource); ok && (attribute.Type == schema.TypeList || attribute.Type == schema.TypeSet) {
+			schemaMap = elem.Schema
+			// Skips the index of the element of the block
+			i++
+		}
+	}
+	return result
+}
+
+// String formats the report for humans, with the attributes that drifted from the state by resource
+func (report *DriftReport) String() string {
+	builder := &strings.Builder{}
+	counts := map[string]int{}
+	for _, resource := range report.Resources {
+		counts[resource.Status]++
+		switch resource.Status {
+		case DriftStatusInSync:
+			continue
+		case DriftStatusDrifted:
+			fmt.Fprintf(builder, "%s (%s) has drifted:\n", resource.Address, resource.Id)
+			for _, drift := range resource.Drifts {
+				fmt.Fprintf(builder, "  %s\n", drift)
+			}
+		case DriftStatusDeleted:
+			fmt.Fprintf(builder, "%s (%s) has been deleted\n", resource.Address, resource.Id)
+		default:
+			fmt.Fprintf(builder, "%s was not checked: %s\n", resource.Address, resource.Error)
+		}
+	}
+	fmt.Fprintf(builder, "Drift summary: %d resources, %d in sync, %d drifted, %d deleted, %d errors, %d skipped",
+		len(report.Resources), counts[DriftStatusInSync], counts[DriftStatusDrifted], counts[DriftStatusDeleted], counts[DriftStatusError], counts[DriftStatusSkipped])
+	return builder.String()
+}
+
+func (drift AttributeDrift) String() string {
+	format := func(value *string) string {
+		if value == nil {
+			return "(none)"
+		}
+		if *value == sensitiveDriftValue {
+			return *value
+		}
+		return fmt.Sprintf("%q", *value)
+	}
+	result := fmt.Sprintf("~ %s: %s => %s", drift.Attribute, format(drift.State), format(drift.Live))
+	if drift.Computed {
+		result += " (computed)"
+	}
+	return result
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	tf_provider "github.com/terraform-providers/terraform-provider-oci/internal/provider"
+)
+
+// liveDriftResources are the resources in OCI read by the oci_test_drift resource, by id
+var liveDriftResources = map[string]map[string]interface{}{
+	"ocid1.drift.synced": {
+		"display_name":  "synced",
+		"freeform_tags": map[string]interface{}{"Department": "Finance"},
+		"state":         "AVAILABLE",
+	},
+	"ocid1.drift.drifted": {
+		"display_name":  "renamed",
+		"freeform_tags": map[string]interface{}{"Department": "Finance", "Owner": "ops"},
+		"state":         "UPDATING",
+		"secret":        "rotated",
+	},
+}
+
+func testDriftResource() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			if d.Id() == "ocid1.drift.error" {
+				return fmt.Errorf("service unavailable")
+			}
+			live, ok := liveDriftResources[d.Id()]
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			for name, value := range live {
+				if err := d.Set(name, value); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name":  {Type: schema.TypeString, Optional: true},
+			"freeform_tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"secret":        {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"state":         {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+const testDriftState = `{
+  "version": 4,
+  "terraform_version": "1.1.7",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "oci_test_drift",
+      "name": "synced",
+      "instances": [
+        {"schema_version": 0, "attributes": {"id": "ocid1.drift.synced", "display_name": "synced", "freeform_tags": {"Department": "Finance"}, "state": "AVAILABLE", "removed_attribute": "ignored"}}
+      ]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "oci_test_drift",
+      "name": "drifted",
+      "instances": [
+        {"index_key": 0, "schema_version": 0, "attributes": {"id": "ocid1.drift.drifted", "display_name": "drifted", "freeform_tags": {"Department": "Finance"}, "secret": "initial", "state": "AVAILABLE"}},
+        {"index_key": 1, "schema_version": 0, "attributes": {"id": "ocid1.drift.deleted", "display_name": "deleted"}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "oci_test_drift",
+      "name": "failed",
+      "instances": [
+        {"index_key": "a", "schema_version": 0, "attributes": {"id": "ocid1.drift.error"}},
+        {"index_key": "b", "schema_version": 1, "attributes": {"id": "ocid1.drift.upgraded"}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "other_provider_resource",
+      "name": "other",
+      "instances": [{"schema_version": 0, "attributes": {"id": "other"}}]
+    },
+    {
+      "mode": "data",
+      "type": "oci_test_drift",
+      "name": "ignored",
+      "instances": [{"schema_version": 0, "attributes": {"id": "ocid1.drift.synced"}}]
+    }
+  ]
+}`
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDetectDrift(t *testing.T) {
+	resourcesMap = tf_provider.ResourcesMap()
+	resourcesMap["oci_test_drift"] = testDriftResource()
+	defer delete(resourcesMap, "oci_test_drift")
+
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	assert.NoError(t, ioutil.WriteFile(statePath, []byte(testDriftState), 0644))
+	state, err := readDriftState(statePath)
+	assert.NoError(t, err)
+
+	report := detectDrift(state, nil, 2)
+	assert.Equal(t, []*ResourceDriftReport{
+		{Address: "oci_test_drift.synced", Id: "ocid1.drift.synced", Status: DriftStatusInSync, Drifts: []AttributeDrift{}},
+		{Address: "module.network.oci_test_drift.drifted[0]", Id: "ocid1.drift.drifted", Status: DriftStatusDrifted, Drifts: []AttributeDrift{
+			{Attribute: "display_name", State: stringPointer("drifted"), Live: stringPointer("renamed")},
+			{Attribute: "freeform_tags.Owner", Live: stringPointer("ops")},
+			{Attribute: "secret", State: stringPointer(sensitiveDriftValue), Live: stringPointer(sensitiveDriftValue)},
+			{Attribute: "state", State: stringPointer("AVAILABLE"), Live: stringPointer("UPDATING"), Computed: true},
+		}},
+		{Address: "module.network.oci_test_drift.drifted[1]", Id: "ocid1.drift.deleted", Status: DriftStatusDeleted},
+		{Address: `oci_test_drift.failed["a"]`, Id: "ocid1.drift.error", Status: DriftStatusError, Error: "service unavailable"},
+		{Address: `oci_test_drift.failed["b"]`, Status: DriftStatusSkipped, Error: "the state has schema version 1 of the resource instead of 0, refresh it with Terraform first"},
+		{Address: "other_provider_resource.other", Status: DriftStatusSkipped, Error: "resource type other_provider_resource is not supported by this provider"},
+	}, report.Resources)
+
+	lines := strings.Split(report.String(), "\n")
+	assert.Equal(t, []string{
+		"module.network.oci_test_drift.drifted[0] (ocid1.drift.drifted) has drifted:",
+		`  ~ display_name: "drifted" => "renamed"`,
+		`  ~ freeform_tags.Owner: (none) => "ops"`,
+		"  ~ secret: (sensitive) => (sensitive)",
+		`  ~ state: "AVAILABLE" => "UPDATING" (computed)`,
+		"module.network.oci_test_drift.drifted[1] (ocid1.drift.deleted) has been deleted",
+		`oci_test_drift.failed["a"] was not checked: service unavailable`,
+		`oci_test_drift.failed["b"] was not checked: the state has schema version 1 of the resource instead of 0, refresh it with Terraform first`,
+		"other_provider_resource.other was not checked: resource type other_provider_resource is not supported by this provider",
+		"Drift summary: 6 resources, 1 in sync, 1 drifted, 1 deleted, 1 errors, 2 skipped",
+	}, lines)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAttributeDrifts_sensitive(t *testing.T) {
+	resourceSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"db_home": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_password": {Type: schema.TypeString, Required: true, Sensitive: true},
+									"db_name":        {Type: schema.TypeString, Required: true},
+									"state":          {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ssh_keys": {
+				Type:      schema.TypeSet,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Resource{Schema: map[string]*schema.Schema{"public_key": {Type: schema.TypeString, Required: true}}},
+			},
+		},
+	}
+	drifts := attributeDrifts(resourceSchema, map[string]string{
+		"db_home.0.database.0.admin_password": "initial",
+		"db_home.0.database.0.db_name":        "db",
+		"db_home.0.database.0.state":          "AVAILABLE",
+		"ssh_keys.1234.public_key":            "ssh-rsa initial",
+	}, map[string]string{
+		"db_home.0.database.0.admin_password": "rotated",
+		"db_home.0.database.0.db_name":        "renamed",
+		"db_home.0.database.0.state":          "UPDATING",
+		"ssh_keys.1234.public_key":            "ssh-rsa rotated",
+	})
+	assert.Equal(t, []AttributeDrift{
+		{Attribute: "db_home.0.database.0.admin_password", State: stringPointer(sensitiveDriftValue), Live: stringPointer(sensitiveDriftValue)},
+		{Attribute: "db_home.0.database.0.db_name", State: stringPointer("db"), Live: stringPointer("renamed")},
+		{Attribute: "db_home.0.database.0.state", State: stringPointer("AVAILABLE"), Live: stringPointer("UPDATING"), Computed: true},
+		{Attribute: "ssh_keys.1234.public_key", State: stringPointer(sensitiveDriftValue), Live: stringPointer(sensitiveDriftValue)},
+	}, drifts)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRunDriftCommand(t *testing.T) {
+	resourcesMap = tf_provider.ResourcesMap()
+	resourcesMap["oci_test_drift"] = testDriftResource()
+	defer delete(resourcesMap, "oci_test_drift")
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	defer func() { getExportConfigVar = getExportConfig }()
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "terraform.tfstate")
+	reportPath := filepath.Join(dir, "drift.json")
+	assert.NoError(t, ioutil.WriteFile(statePath, []byte(testDriftState), 0644))
+
+	err, status := RunDriftCommand(&DriftCommandArgs{StatePath: statePath, ReportPath: reportPath, Parallelism: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusPartialSuccess, status, "resources that could not be refreshed should be a partial success")
+	content, err := ioutil.ReadFile(reportPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"address": "module.network.oci_test_drift.drifted[0]"`)
+
+	assert.NoError(t, ioutil.WriteFile(statePath, []byte(`{"version": 3, "modules": []}`), 0644))
+	err, status = RunDriftCommand(&DriftCommandArgs{StatePath: statePath, Parallelism: 1})
+	assert.EqualError(t, err, fmt.Sprintf("[ERROR] unsupported version 3 of state file %s, only version 4 written by Terraform 0.12 and later is supported", statePath))
+	assert.Equal(t, StatusFail, status)
+}
@@ -33,6 +33,7 @@ import (
 
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	tf_provider "github.com/terraform-providers/terraform-provider-oci/internal/provider"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
 	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
@@ -224,6 +225,11 @@ type ExportCommandArgs struct {
 	ExcludeServices              []string
 	IsExportWithRelatedResources bool
 	Parallelism                  int
+	CompartmentTree              bool
+	NativeState                  bool
+	OutputFormat                 OutputFormatEnum
+	Filters                      []string
+	Incremental                  bool
 }
 
 func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
@@ -243,6 +249,7 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 	}
 
 	tfHclVersion = *args.TFVersion
+	outputFormat = args.OutputFormat
 
 	r := &schema.Resource{
 		Schema: tf_provider.SchemaMap(),
@@ -297,13 +304,6 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 
 	sem = make(chan struct{}, args.Parallelism)
 
-	ctx, err := createResourceDiscoveryContext(clients.(*tf_client.OracleClients), args, tenancyOcid)
-	if err != nil {
-		utils.Logln(err.Error())
-		return err, StatusFail
-	}
-	args.finalizeServices(ctx)
-
 	/*
 		Setting retry timeout to a lower value for resource discovery
 		This is done to handle the 404 and 500 errors in case
@@ -317,6 +317,34 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 
 	utils.Logf("[INFO] resource discovery retry timeout duration set to %v", tfresource.ShortRetryTime)
 
+	if args.CompartmentTree {
+		modules, err := runCompartmentTreeExport(clients.(*tf_client.OracleClients), args, tenancyOcid)
+		status := StatusSuccess
+		for _, module := range modules {
+			if module.ctx == nil {
+				continue
+			}
+			utils.Logf("[INFO] Compartment %s was exported to module %s", module.compartmentId, module.name)
+			module.ctx.printSummary()
+			if len(module.ctx.errorList.errors) > 0 {
+				module.ctx.printErrors()
+				status = StatusPartialSuccess
+			}
+		}
+		if err != nil {
+			utils.Logln(err.Error())
+			return err, StatusFail
+		}
+		return nil, status
+	}
+
+	ctx, err := createResourceDiscoveryContext(clients.(*tf_client.OracleClients), args, tenancyOcid)
+	if err != nil {
+		utils.Logln(err.Error())
+		return err, StatusFail
+	}
+	args.finalizeServices(ctx)
+
 	if err := runExportCommand(ctx); err != nil {
 		utils.Logln(err.Error())
 		return err, StatusFail
@@ -380,10 +408,53 @@ func (args *ExportCommandArgs) validate() error {
 		return fmt.Errorf("[ERROR] output_path %s should be a directory", *args.OutputDir)
 	}
 
+	if args.OutputFormat == "" {
+		args.OutputFormat = OutputFormatHcl
+	}
+	if args.OutputFormat != OutputFormatHcl && args.OutputFormat != OutputFormatJson {
+		return fmt.Errorf("[ERROR] invalid output_format '%s', supported values: %s, %s", args.OutputFormat, OutputFormatHcl, OutputFormatJson)
+	}
+
+	if len(args.Filters) > 0 {
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] filter cannot be specified along with ids")
+		}
+		if _, err := parseResourceFilters(args.Filters); err != nil {
+			return err
+		}
+	}
+
+	if args.NativeState && !args.GenerateState {
+		return fmt.Errorf("[ERROR] native_state can only be specified along with generate_state")
+	}
+
+	if args.Incremental {
+		if !args.GenerateState {
+			return fmt.Errorf("[ERROR] incremental can only be specified along with generate_state")
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids cannot be specified along with incremental")
+		}
+		stateFile := fmt.Sprintf("%s%s%s", *args.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+		if _, err := os.Stat(stateFile); err != nil {
+			return fmt.Errorf("[ERROR] incremental requires the state file of a previous export at %s: %v", stateFile, err)
+		}
+	}
+
+	if args.CompartmentTree {
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state is not supported when exporting a compartment tree")
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids cannot be specified when exporting a compartment tree")
+		}
+	}
+
 	return nil
 }
 
 func getExportConfig(d *schema.ResourceData) (interface{}, error) {
+	tracing.Configure()
 	clients := &tf_client.OracleClients{
 		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
 		Configuration: make(map[string]string),
@@ -420,6 +491,18 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 	}
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
 	tf_client.ConfigureClientVar = configureClientWithUserAgent
+	clients.RateLimiters, err = tf_provider.BuildRateLimiters(d)
+	if err != nil {
+		return nil, err
+	}
+	clients.AuditLog, err = tf_provider.BuildAuditLog(d)
+	if err != nil {
+		return nil, err
+	}
+	clients.RetryConfigs, err = tf_provider.BuildRetryConfigs(d)
+	if err != nil {
+		return nil, err
+	}
 	err = tf_client.CreateSDKClients(clients, sdkConfigProvider, configureClientWithUserAgent)
 	if err != nil {
 		return nil, err
@@ -432,12 +515,96 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	utils.Logf("[INFO] Running export command\n")
 	utils.Logf("[INFO] parallelism: %d", ctx.Parallelism)
 	defer ctx.printSummary()
+	defer tracing.Flush()
 	exportStart := time.Now()
 	defer elapsed("entire export command", nil, 0)()
 	steps, err := getDiscoverResourceSteps(ctx)
 	if err != nil {
 		return err
 	}
+	discoverResources(ctx, steps)
+	if ctx.previousExport != nil {
+		ctx.compareWithPreviousExport(steps)
+	}
+
+	if ctx.GenerateState {
+		stateStart := time.Now()
+		if ctx.previousExport != nil {
+			if err := ctx.previousExport.removeGeneratedState(ctx); err != nil {
+				return err
+			}
+			// The previous state is kept if the state cannot be generated
+			defer ctx.previousExport.removeGeneratedState(ctx)
+		}
+		// Run import commands
+		if ctx.NativeState {
+			utils.Debug("[DEBUG] Generating state natively")
+			if err := generateNativeState(ctx, steps); err != nil {
+				return err
+			}
+		} else if ctx.Parallelism > 1 {
+			utils.Debug("[DEBUG] Generating state in parallel")
+			if err := generateStateParallel(ctx, steps); err != nil {
+				return err
+			}
+		} else {
+			utils.Debug("[DEBUG] Generating state sequentially")
+			if err := generateState(ctx, steps); err != nil {
+				return err
+			}
+		}
+
+		if ctx.previousExport != nil {
+			if err := ctx.previousExport.mergeState(ctx); err != nil {
+				return err
+			}
+			if err := ctx.previousExport.replaceState(ctx); err != nil {
+				return err
+			}
+		}
+
+		// remove invalid references from referenceMap for the resources with import error
+		if ctx.isImportError {
+			// lock not required for referenceMap as only 1 thread is running at this point
+			deleteInvalidReferences(referenceMap, ctx.discoveredResources)
+		}
+		timeForStateGeneration := time.Since(stateStart)
+		utils.Debugf("[DEBUG] state generation took %v\n", timeForStateGeneration)
+		ctx.timeTakenToGenerateState = timeForStateGeneration
+	}
+
+	if err := writeConfigurations(ctx, steps); err != nil {
+		return err
+	}
+
+	region, err := exportConfigProvider.Region()
+	if err != nil {
+		return err
+	}
+	vars["region"] = fmt.Sprintf("\"%s\"", region)
+
+	if err := generateProviderFile(ctx.OutputDir); err != nil {
+		return err
+	}
+
+	if err := generateVarsFile(vars, ctx.OutputDir); err != nil {
+		return err
+	}
+
+	if ctx.changeReport != nil {
+		if err := ctx.writeChangeReport(); err != nil {
+			return err
+		}
+	}
+
+	ctx.addMissingAttributesSummary()
+	ctx.timeTakenForEntireExport = time.Since(exportStart)
+	ctx.postValidate()
+	return nil
+}
+
+// discoverResources runs the discovery of the steps in parallel and waits for all of them to complete
+func discoverResources(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) {
 	discoveryStart := time.Now()
 	var discoverWg sync.WaitGroup
 	discoverWg.Add(len(steps))
@@ -491,32 +658,10 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	utils.Debugf("discovering resources for all services took %v\n", totalDiscoveryTime)
 	ctx.timeTakenToDiscover = totalDiscoveryTime
 	utils.Debug("[DEBUG] ~~~~~~ discover steps completed ~~~~~~")
+}
 
-	if ctx.GenerateState {
-		stateStart := time.Now()
-		// Run import commands
-		if ctx.Parallelism > 1 {
-			utils.Debug("[DEBUG] Generating state in parallel")
-			if err := generateStateParallel(ctx, steps); err != nil {
-				return err
-			}
-		} else {
-			utils.Debug("[DEBUG] Generating state sequentially")
-			if err := generateState(ctx, steps); err != nil {
-				return err
-			}
-		}
-
-		// remove invalid references from referenceMap for the resources with import error
-		if ctx.isImportError {
-			// lock not required for referenceMap as only 1 thread is running at this point
-			deleteInvalidReferences(referenceMap, ctx.discoveredResources)
-		}
-		timeForStateGeneration := time.Since(stateStart)
-		utils.Debugf("[DEBUG] state generation took %v\n", timeForStateGeneration)
-		ctx.timeTakenToGenerateState = timeForStateGeneration
-	}
-
+// writeConfigurations writes the configuration of the resources discovered by the steps in parallel
+func writeConfigurations(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) error {
 	// Reset discovered resources if already set by writeTmpConfigurationForImport
 	ctx.discoveredResources = make([]*OCIResource, 0)
 
@@ -568,21 +713,10 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 		utils.Logf("[ERROR] error writing final configuration for resources found: %s", err.Error())
 		return err
 	}
+	return nil
+}
 
-	region, err := exportConfigProvider.Region()
-	if err != nil {
-		return err
-	}
-	vars["region"] = fmt.Sprintf("\"%s\"", region)
-
-	if err := generateProviderFile(ctx.OutputDir); err != nil {
-		return err
-	}
-
-	if err := generateVarsFile(vars, ctx.OutputDir); err != nil {
-		return err
-	}
-
+func (ctx *resourceDiscoveryContext) addMissingAttributesSummary() {
 	if isMissingRequiredAttributes {
 		ctx.summaryStatements = append(ctx.summaryStatements, "")
 		ctx.summaryStatements = append(ctx.summaryStatements, globalvar.MissingRequiredAttributeWarning)
@@ -591,9 +725,6 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 			ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("%s: %s", key, strings.Join(value, ",")))
 		}
 	}
-	ctx.timeTakenForEntireExport = time.Since(exportStart)
-	ctx.postValidate()
-	return nil
 }
 
 /*
@@ -684,7 +815,7 @@ func generateStateParallel(ctx *resourceDiscoveryContext, steps []resourceDiscov
 	}
 
 	// Create final state file to write state
-	stateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	stateOutputFile := ctx.getStateOutputFile()
 
 	f, err := os.OpenFile(stateOutputFile, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, os.ModePerm)
 	if err != nil {
@@ -750,7 +881,7 @@ func generateState(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep)
 		return err
 	}
 
-	stateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	stateOutputFile := ctx.getStateOutputFile()
 	tmpStateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultTmpStateFile)
 	if err := os.RemoveAll(tmpStateOutputFile); err != nil {
 		utils.Logf("[WARN] unable to delete existing tmp state file %s", tmpStateOutputFile)
@@ -790,11 +921,13 @@ func importResource(ctx *resourceDiscoveryContext, resource *OCIResource, tmpSta
 		return
 	}
 
-	importId := resource.importId
-	if len(importId) == 0 {
-		importId = resource.id
+	if resource.isUnchanged {
+		utils.Logf("[INFO] skip importing '%s' since it has not changed since the previous export", resource.getTerraformReference())
+		return
 	}
 
+	importId := resource.getImportId()
+
 	importArgs := []tfexec.ImportOption{
 		tfexecConfigVar(*ctx.OutputDir),
 		tfexecStateVar(tmpStateOutputFile),
@@ -802,32 +935,36 @@ func importResource(ctx *resourceDiscoveryContext, resource *OCIResource, tmpSta
 	if importErr := ctxTerraformImportVar(ctx, context.Background(), resource.getTerraformReference(), importId, importArgs...); importErr != nil {
 		utils.Logf("[ERROR] terraform import command failed for resource '%s' at id '%s': %s", resource.getTerraformReference(), importId, importErr.Error())
 
-		// mark resource as errored so that it can be skipped while writing configurations
-		resource.isErrorResource = true
-
-		ctx.ctxLock.Lock()
-		ctx.isImportError = true
-		ctx.ctxLock.Unlock()
-
 		err := fmt.Errorf("[ERROR] terraform import command failed for resource '%s' at id '%s': %s Any references to this resource have been replaced with hard coded values in generated configurations", resource.getTerraformReference(), importId, importErr.Error())
+		ctx.addImportError(resource, err)
 
-		var rdError *ResourceDiscoveryError
-		if ctx.targetSpecificResources {
-			rdError = &ResourceDiscoveryError{
-				resource.terraformClass,
-				"",
-				err,
-				nil}
-		} else {
-			rdError = &ResourceDiscoveryError{
-				resource.terraformClass,
-				resource.parent.terraformName,
-				err,
-				nil}
-		}
-		ctx.addErrorToList(rdError)
+	}
+}
 
+// addImportError marks a resource that could not be imported as errored, so that it is skipped while writing
+// configurations and the references to it are replaced with hard coded values
+func (ctx *resourceDiscoveryContext) addImportError(resource *OCIResource, err error) {
+	resource.isErrorResource = true
+
+	ctx.ctxLock.Lock()
+	ctx.isImportError = true
+	ctx.ctxLock.Unlock()
+
+	var rdError *ResourceDiscoveryError
+	if ctx.targetSpecificResources {
+		rdError = &ResourceDiscoveryError{
+			resource.terraformClass,
+			"",
+			err,
+			nil}
+	} else {
+		rdError = &ResourceDiscoveryError{
+			resource.terraformClass,
+			resource.parent.terraformName,
+			err,
+			nil}
 	}
+	ctx.addErrorToList(rdError)
 }
 
 func getDiscoverResourceSteps(ctx *resourceDiscoveryContext) ([]resourceDiscoveryStep, error) {
@@ -955,6 +1092,13 @@ func findResources(ctx *resourceDiscoveryContext, root *OCIResource, resourceGra
 
 			for _, resource := range results {
 				//referenceMap[resource.id] = resource.getHclReferenceIdString()
+				ctx.restorePreviousName(resource)
+				if !ctx.isSelected(resource) {
+					// Resources excluded by the filters are omitted along with all their children
+					utils.Logf("[INFO] resource discovery: %s is excluded by the filters\n", resource.getTerraformReference())
+					resource.omitFromExport = true
+					continue
+				}
 				if ctx.expectedResourceIds != nil && len(ctx.expectedResourceIds) > 0 {
 					if _, shouldExport := ctx.expectedResourceIds[resource.id]; shouldExport {
 						resource.omitFromExport = false
@@ -979,55 +1123,36 @@ func findResources(ctx *resourceDiscoveryContext, root *OCIResource, resourceGra
 }
 
 func generateVarsFile(vars map[string]string, outputDir *string) error {
-	varsTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.VarsFile)
 	varsOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.VarsFile)
-	file, err := os.OpenFile(varsTmpFile, os.O_CREATE|os.O_RDWR, 0666)
-	if err != nil {
-		return err
-	}
 
-	for variable, defaultVal := range vars {
-		if defaultVal != "" {
-			_, _ = file.WriteString(fmt.Sprintf("variable %s { default = %s }\n", variable, defaultVal))
-		} else {
-			_, _ = file.WriteString(fmt.Sprintf("variable %s {}\n", variable))
-		}
+	variables := make([]string, 0, len(vars))
+	for variable := range vars {
+		variables = append(variables, variable)
 	}
+	sort.Strings(variables)
 
-	if err := file.Close(); err != nil {
-		return err
-	}
-
-	if err := os.Rename(varsTmpFile, varsOutputFile); err != nil {
-		return err
+	config := &configBody{}
+	for _, variable := range variables {
+		variableBlock := config.addBlock("variable", variable)
+		variableBlock.inline = true
+		if defaultVal := vars[variable]; defaultVal != "" {
+			variableBlock.body.addAttribute("default", referenceExpression(defaultVal))
+		}
 	}
 
-	return nil
+	_, err := writeConfigFile(config, varsOutputFile)
+	return err
 }
 
 func generateProviderFile(outputDir *string) error {
-	providerTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
 	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
-	file, err := os.OpenFile(providerTmpFile, os.O_CREATE|os.O_RDWR, 0666)
-	if err != nil {
-		return err
-	}
-
-	_, err = file.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tfHclVersion.getVarHclString("region")))
-	if err != nil {
-		_ = file.Close()
-		return err
-	}
-
-	if fErr := file.Close(); fErr != nil {
-		return fErr
-	}
 
-	if err := os.Rename(providerTmpFile, providerOutputFile); err != nil {
-		return err
-	}
+	config := &configBody{}
+	tfHclVersion.addTerraformBlock(config)
+	config.addBlock("provider", "oci").body.addAttribute("region", referenceExpression(tfHclVersion.getVarHclString("region")))
 
-	return nil
+	_, err := writeConfigFile(config, providerOutputFile)
+	return err
 }
 
 type OCIResource struct {
@@ -1035,9 +1160,10 @@ type OCIResource struct {
 	compartmentId    string
 	rawResource      interface{}
 	sourceAttributes map[string]interface{}
-	getHclStringFn   func(*strings.Builder, *OCIResource, map[string]string) error
+	getConfigFn      func(*configBody, *OCIResource, map[string]string) error
 	parent           *OCIResource
 	isErrorResource  bool
+	isUnchanged      bool // in an incremental export, the state of the previous export is kept for unchanged resources
 }
 
 type TerraformResource struct {
@@ -1061,7 +1187,23 @@ func (tr *TerraformResource) getTerraformReference() string {
 	return fmt.Sprintf("%s.%s", tr.terraformClass, tr.terraformName)
 }
 
-func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]interface{}, resourceSchema *schema.Resource, interpolationMap map[string]string, ociRes *OCIResource, attributePrefix string) error {
+func (tr *TerraformResource) getImportId() string {
+	if tr.importId != "" {
+		return tr.importId
+	}
+	return tr.id
+}
+
+// isImportable returns whether the resource is a resource of the provider that can be imported
+func (resource *OCIResource) isImportable() bool {
+	if resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource {
+		return false
+	}
+	resourceSchema, exists := resourcesMap[resource.terraformClass]
+	return exists && resourceSchema.Importer != nil
+}
+
+func getConfigFromMap(config *configBody, sourceAttributes map[string]interface{}, resourceSchema *schema.Resource, interpolationMap map[string]string, ociRes *OCIResource, attributePrefix string) error {
 	sortedKeys := make([]string, len(resourceSchema.Schema))
 	cnt := 0
 	for k := range resourceSchema.Schema {
@@ -1078,29 +1220,14 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 
 		if attributeVal, exists := sourceAttributes[tfAttribute]; exists {
 			switch v := attributeVal.(type) {
-			case InterpolationString:
-				if ok := failedResourceReferenceSet[v.resourceReference]; ok {
-					builder.WriteString(fmt.Sprintf("%s = %q\n", tfAttribute, v.value))
-				} else {
-					builder.WriteString(fmt.Sprintf("%s = %v\n", tfAttribute, v.interpolation))
-				}
-				continue
-			case string:
-				if varOverride, exists := interpolationMap[fmt.Sprintf("%v", v)]; exists {
-					v = varOverride
-					builder.WriteString(fmt.Sprintf("%s = %v\n", tfAttribute, v))
-				} else {
-					builder.WriteString(fmt.Sprintf("%s = %q\n", tfAttribute, escapeTFStrings(v)))
-				}
-				continue
-			case int, bool, float64:
-				builder.WriteString(fmt.Sprintf("%s = \"%v\"\n", tfAttribute, v))
+			case InterpolationString, string, int, bool, float64:
+				config.addAttribute(tfAttribute, getConfigExpression(v, interpolationMap))
 				continue
 			case []interface{}:
 				switch tfSchema.Type {
 				case schema.TypeString:
 					if tfAttribute == "delivery_policy" {
-						builder.WriteString(fmt.Sprintf("%s = %q\n", tfAttribute, parseDeliveryPolicy(v[0].(interface{}))))
+						config.addAttribute(tfAttribute, literalExpression(parseDeliveryPolicy(v[0].(interface{}))))
 						continue
 					}
 				case schema.TypeList, schema.TypeSet:
@@ -1108,44 +1235,30 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 					case *schema.Resource:
 						for i, item := range v {
 							if val := item.(map[string]interface{}); val != nil {
-								builder.WriteString(fmt.Sprintf("%s {\n", tfAttribute))
+								nestedBlock := config.addBlock(tfAttribute)
 								attributePrefixForRecursiveCall := attributePrefix
 								if attributePrefix == "" {
 									attributePrefixForRecursiveCall = fmt.Sprintf("%s[%d]", tfAttribute, i)
 								} else {
 									attributePrefixForRecursiveCall = fmt.Sprintf("%s.%s[%d]", attributePrefix, tfAttribute, i)
 								}
-								if err := getHCLStringFromMap(builder, val, elem, interpolationMap, ociRes, attributePrefixForRecursiveCall); err != nil {
+								if err := getConfigFromMap(&nestedBlock.body, val, elem, interpolationMap, ociRes, attributePrefixForRecursiveCall); err != nil {
 									return err
 								}
-								builder.WriteString("}\n")
 							}
 						}
 						continue
 					case *schema.Schema, schema.ValueType, InterpolationString:
-						builder.WriteString(fmt.Sprintf("%s = [\n", tfAttribute))
+						list := make([]configExpression, 0, len(v))
 						for _, item := range v {
 							switch trueListVal := item.(type) {
-							case InterpolationString:
-								if ok := failedResourceReferenceSet[trueListVal.resourceReference]; ok {
-									builder.WriteString(fmt.Sprintf("%s = %q\n", tfAttribute, trueListVal.value))
-								} else {
-									builder.WriteString(fmt.Sprintf("%s = %v\n", tfAttribute, trueListVal.interpolation))
-								}
-							case string:
-								if varOverride, exists := interpolationMap[fmt.Sprintf("%v", trueListVal)]; exists {
-									trueListVal = varOverride
-									builder.WriteString(fmt.Sprintf("%v,\n", trueListVal))
-								} else {
-									builder.WriteString(fmt.Sprintf("%q,\n", escapeTFStrings(trueListVal)))
-								}
-							case int, bool, float64:
-								builder.WriteString(fmt.Sprintf("\"%v\",\n", trueListVal))
+							case InterpolationString, string, int, bool, float64:
+								list = append(list, getConfigExpression(trueListVal, interpolationMap))
 							default:
 								return fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': List element type mismatch", tfAttribute, tfAttribute)
 							}
 						}
-						builder.WriteString("]\n")
+						config.addAttribute(tfAttribute, list)
 						continue
 					}
 
@@ -1155,46 +1268,32 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 				switch tfSchema.Type {
 				case schema.TypeList:
 					if nestedResource := tfSchema.Elem.(*schema.Resource); nestedResource != nil {
-						builder.WriteString(fmt.Sprintf("%s {\n", tfAttribute))
+						nestedBlock := config.addBlock(tfAttribute)
 						attributePrefixForRecursiveCall := attributePrefix
 						if attributePrefix == "" {
 							attributePrefixForRecursiveCall = tfAttribute
 						} else {
 							attributePrefixForRecursiveCall = attributePrefix + "." + tfAttribute
 						}
-						if err := getHCLStringFromMap(builder, v, nestedResource, interpolationMap, ociRes, attributePrefixForRecursiveCall); err != nil {
+						if err := getConfigFromMap(&nestedBlock.body, v, nestedResource, interpolationMap, ociRes, attributePrefixForRecursiveCall); err != nil {
 							return err
 						}
-						builder.WriteString("}\n")
 						continue
 					}
 					return fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': Nested resource type mismatch", tfAttribute, tfAttribute)
 				case schema.TypeMap:
-					builder.WriteString(fmt.Sprintf("%s = {\n", tfAttribute))
+					mapConfig := &configBody{}
 
 					keys := utils.GetSortedKeys(v)
 					for _, mapKey := range keys {
 						switch mapVal := v[mapKey].(type) {
-						case InterpolationString:
-							if ok := failedResourceReferenceSet[mapVal.resourceReference]; ok {
-								builder.WriteString(fmt.Sprintf("%s = %q\n", tfAttribute, mapVal.value))
-							} else {
-								builder.WriteString(fmt.Sprintf("%s = %v\n", tfAttribute, mapVal.interpolation))
-							}
-						case string:
-							if varOverride, exists := interpolationMap[fmt.Sprintf("%v", mapVal)]; exists {
-								mapVal = varOverride
-								builder.WriteString(fmt.Sprintf("\"%s\" = %v\n", mapKey, mapVal))
-							} else {
-								builder.WriteString(fmt.Sprintf("\"%s\" = %q\n", mapKey, escapeTFStrings(mapVal)))
-							}
-						case int, bool, float64:
-							builder.WriteString(fmt.Sprintf("\"%s\" = \"%v\"\n", mapKey, mapVal))
+						case InterpolationString, string, int, bool, float64:
+							mapConfig.addAttribute(mapKey, getConfigExpression(mapVal, interpolationMap))
 						default:
-							builder.WriteString(fmt.Sprintf("#%s = <<Placeholder due to complex map value>>\n", mapKey))
+							mapConfig.addComment(fmt.Sprintf("%s = <<Placeholder due to complex map value>>", mapKey))
 						}
 					}
-					builder.WriteString("}\n")
+					config.addAttribute(tfAttribute, mapConfig)
 					continue
 				default:
 					return fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': Source attribute is nested object but TF attribute is not", tfAttribute, tfAttribute)
@@ -1223,12 +1322,13 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 			if ociRes.terraformTypeInfo.defaultValuesForMissingAttributes == nil {
 				ociRes.terraformTypeInfo.defaultValuesForMissingAttributes = make(map[string]interface{})
 			}
+			var attribute *configAttribute
 			if tfAttributeVal, exists := ociRes.terraformTypeInfo.defaultValuesForMissingAttributes[tfAttribute]; exists {
-				builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, tfAttributeVal))
+				attribute = config.addAttribute(tfAttribute, literalExpression(fmt.Sprintf("%v", tfAttributeVal)))
 			} else {
-				builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, globalvar.PlaceholderValueForMissingAttribute))
+				attribute = config.addAttribute(tfAttribute, literalExpression(globalvar.PlaceholderValueForMissingAttribute))
 			}
-			builder.WriteString("\t#Required attribute not found in discovery, placeholder value set to avoid plan failure\n")
+			attribute.comment = "Required attribute not found in discovery, placeholder value set to avoid plan failure"
 			isMissingRequiredAttributes = true
 
 			/* Add missing required attribute to ignorableRequiredMissingAttributes to be generated in lifecycle ignore_changes */
@@ -1243,12 +1343,29 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 
 		} else if tfSchema.Optional {
 			utils.Logf("[INFO] Optional TF attribute '%s' not found in source\n", tfAttribute)
-			builder.WriteString(fmt.Sprintf("#%s = <<Optional value not found in discovery>>\n", tfAttribute))
+			config.addComment(fmt.Sprintf("%s = <<Optional value not found in discovery>>", tfAttribute))
 		}
 	}
 	return nil
 }
 
+// getConfigExpression returns the expression of a primitive value, a reference if the value is interpolated
+func getConfigExpression(value interface{}, interpolationMap map[string]string) configExpression {
+	switch v := value.(type) {
+	case InterpolationString:
+		if ok := failedResourceReferenceSet[v.resourceReference]; ok {
+			return literalExpression(v.value)
+		}
+		return referenceExpression(v.interpolation)
+	case string:
+		if varOverride, exists := interpolationMap[v]; exists {
+			return referenceExpression(varOverride)
+		}
+		return literalExpression(v)
+	}
+	return literalExpression(fmt.Sprintf("%v", value))
+}
+
 func (resource *OCIResource) hasFreeformTag(tagKey string) bool {
 	if freeformTags, exists := resource.sourceAttributes["freeform_tags"]; exists {
 		if freeformTagMap, ok := freeformTags.(map[string]interface{}); ok {
@@ -1273,7 +1390,7 @@ func (resource *OCIResource) hasDefinedTag(tagKey string, tagValue string) bool
 	return false
 }
 
-func (ociRes *OCIResource) getHCLString(builder *strings.Builder, interpolationMap map[string]string) error {
+func (ociRes *OCIResource) getConfig(config *configBody, interpolationMap map[string]string) error {
 	// Remove any potential cyclical references from the interpolation map
 	selfReference := ociRes.getTerraformReference()
 	resourceInterpolationMap := map[string]string{}
@@ -1283,38 +1400,33 @@ func (ociRes *OCIResource) getHCLString(builder *strings.Builder, interpolationM
 		}
 	}
 
-	if ociRes.getHclStringFn != nil {
-		return ociRes.getHclStringFn(builder, ociRes, resourceInterpolationMap)
+	if ociRes.getConfigFn != nil {
+		return ociRes.getConfigFn(config, ociRes, resourceInterpolationMap)
 	}
-	return getHclStringFromGenericMap(builder, ociRes, resourceInterpolationMap)
+	return getConfigFromGenericMap(config, ociRes, resourceInterpolationMap)
 }
 
-func getHclStringFromGenericMap(builder *strings.Builder, ociRes *OCIResource, interpolationMap map[string]string) error {
+func getConfigFromGenericMap(config *configBody, ociRes *OCIResource, interpolationMap map[string]string) error {
 	resourceSchema := resourcesMap[ociRes.terraformClass]
 
-	builder.WriteString(fmt.Sprintf("resource %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
-	if err := getHCLStringFromMap(builder, ociRes.sourceAttributes, resourceSchema, interpolationMap, ociRes, ""); err != nil {
+	resourceBlock := config.addBlock("resource", ociRes.terraformClass, ociRes.terraformName)
+	if err := getConfigFromMap(&resourceBlock.body, ociRes.sourceAttributes, resourceSchema, interpolationMap, ociRes, ""); err != nil {
 		return err
 	}
 
 	if ociRes.terraformTypeInfo != nil && len(ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes) > 0 {
-		builder.WriteString("\n# Required attributes that were not found in discovery have been added to " +
+		resourceBlock.body.addBlankLine()
+		resourceBlock.body.addComment(" Required attributes that were not found in discovery have been added to " +
 			"lifecycle ignore_changes")
-		builder.WriteString("\n# This is done to avoid terraform plan failure for the existing infrastructure")
-		builder.WriteString("\nlifecycle {\n" +
-			"ignore_changes = [")
+		resourceBlock.body.addComment(" This is done to avoid terraform plan failure for the existing infrastructure")
 
-		missingAttributes := make([]string, 0, len(ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes))
+		missingAttributes := make([]configExpression, 0, len(ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes))
 
 		for attribute := range ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes {
-			missingAttributes = append(missingAttributes, tfHclVersion.getReference(attribute))
+			missingAttributes = append(missingAttributes, traversalExpression(attribute))
 		}
-		builder.WriteString(strings.Join(missingAttributes, ","))
-
-		builder.WriteString("]\n" +
-			"}\n")
+		resourceBlock.body.addBlock("lifecycle").body.addAttribute("ignore_changes", missingAttributes)
 	}
-	builder.WriteString("}\n\n")
 
 	return nil
 }
@@ -1411,7 +1523,7 @@ func findResourcesGeneric(ctx *resourceDiscoveryContext, tfMeta *TerraformResour
 		}
 	}
 
-	if err := datasource.Read(d, clients); err != nil {
+	if err := tfresource.ReadResourceSchema(context.Background(), datasource, d, clients); err != nil {
 		return results, err
 	}
 
@@ -1495,7 +1607,7 @@ func findResourcesGeneric(ctx *resourceDiscoveryContext, tfMeta *TerraformResour
 					continue
 				}
 
-				if err = resourceSchema.Read(r, clients); err != nil {
+				if err = tfresource.ReadResourceSchema(context.Background(), resourceSchema, r, clients); err != nil {
 					rdError := &ResourceDiscoveryError{
 						tfMeta.resourceClass,
 						parent.terraformName,
@@ -1656,8 +1768,8 @@ func generateOciResourceFromResourceData(d *schema.ResourceData, rawResource int
 			terraformClass:    tfMeta.resourceClass,
 			terraformTypeInfo: tfMeta.TerraformResourceHints,
 		},
-		getHclStringFn: getHclStringFromGenericMap,
-		parent:         parent,
+		getConfigFn: getConfigFromGenericMap,
+		parent:      parent,
 	}
 
 	if tfMeta.getIdFn != nil {
@@ -1672,8 +1784,8 @@ func generateOciResourceFromResourceData(d *schema.ResourceData, rawResource int
 		resource.id = d.Id()
 	}
 
-	if tfMeta.getHCLStringOverrideFn != nil {
-		resource.getHclStringFn = tfMeta.getHCLStringOverrideFn
+	if tfMeta.getConfigOverrideFn != nil {
+		resource.getConfigFn = tfMeta.getConfigOverrideFn
 	}
 
 	return resource, nil
@@ -1693,7 +1805,7 @@ func getOciResource(d *schema.ResourceData, resourceSchema map[string]*schema.Sc
 			terraformClass:    resourceHint.resourceClass,
 			terraformTypeInfo: resourceHint,
 		},
-		getHclStringFn: getHclStringFromGenericMap,
+		getConfigFn: getConfigFromGenericMap,
 	}
 
 	if resourceId != "" {
@@ -1924,7 +2036,7 @@ func createTerraformStruct(args *ExportCommandArgs) (*tfexec.Terraform, string,
 		executableVersion := semver.MajorMinor(inputTfVersion)
 		configVersion := semver.MajorMinor("v" + tfHclVersion.toString())
 
-		if executableVersion < configVersion {
+		if semver.Compare(executableVersion, configVersion) < 0 {
 			return nil, terraformBinPath, fmt.Errorf("[ERROR] major and minor version of terraform CLI provided is not same as the generated configuration version, "+
 				"configuration version: %s, terraform CLI version: %s, please provide CLI version >= %s ", tfHclVersion.toString(), tfVersion.String(), tfHclVersion.toString())
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"path"
@@ -595,7 +596,7 @@ func readTestChildWith404Error(d *schema.ResourceData, m interface{}) error {
 	sync := &TestChildWith404ErrorResourceCrud{}
 	sync.D = d
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResourceContext(context.Background(), sync)
 }
 
 type TestChildWith404ErrorResourceCrud struct {
@@ -766,7 +767,7 @@ func TestUnitRunExportCommand_basic(t *testing.T) {
 		t.Fail()
 	}
 
-	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}}
+	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}, &TfHclVersion15{}}
 	for _, tfVersion := range tfHclVersions {
 		tfHclVersion = tfVersion
 		args := &ExportCommandArgs{
@@ -840,7 +841,7 @@ func TestUnitRunExportCommand_Parallel(t *testing.T) {
 		t.Fail()
 	}
 
-	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}}
+	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}, &TfHclVersion15{}}
 	for _, tfVersion := range tfHclVersions {
 		tfHclVersion = tfVersion
 		args := &ExportCommandArgs{
@@ -902,7 +903,7 @@ func TestUnitRunExportCommand_ParallelNegative(t *testing.T) {
 		t.Fail()
 	}
 
-	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}}
+	tfHclVersions := []TfHclVersion{&TfHclVersion11{}, &TfHclVersion12{}, &TfHclVersion15{}}
 	for _, tfVersion := range tfHclVersions {
 		tfHclVersion = tfVersion
 		args := &ExportCommandArgs{
@@ -1371,7 +1372,7 @@ func TestUnitGetHCLString_basic(t *testing.T) {
 	}
 
 	targetResourceOcid := getTestResourceId("child", len(childrenResources)-1)
-	testStringBuilder := &strings.Builder{}
+	testConfig := &configBody{}
 	var targetResource *OCIResource
 	for _, resource := range results {
 		if resource.id == targetResourceOcid {
@@ -1380,11 +1381,11 @@ func TestUnitGetHCLString_basic(t *testing.T) {
 		}
 	}
 
-	if err := targetResource.getHCLString(testStringBuilder, nil); err != nil {
+	if err := targetResource.getConfig(testConfig, nil); err != nil {
 		t.Logf("got error '%v' when trying to get HCL string", err)
 		t.Fail()
 	}
-	resultHcl := testStringBuilder.String()
+	resultHcl := testConfig.getHCLString()
 
 	expectedHclResult := `resource oci_test_child export_string3_child_2 {
 a_bool = "true"
@@ -1452,7 +1453,7 @@ func TestUnitGetHCLString_missingFields(t *testing.T) {
 	}
 
 	targetResourceOcid := getTestResourceId("child", len(childrenResources)-1)
-	testStringBuilder := &strings.Builder{}
+	testConfig := &configBody{}
 	var targetResource *OCIResource
 	for _, resource := range results {
 		if resource.id == targetResourceOcid {
@@ -1464,11 +1465,11 @@ func TestUnitGetHCLString_missingFields(t *testing.T) {
 	delete(targetResource.sourceAttributes, "compartment_id")
 	delete(targetResource.sourceAttributes, "a_string")
 	targetResource.sourceAttributes["a_map"] = nil
-	if err := targetResource.getHCLString(testStringBuilder, nil); err != nil {
+	if err := targetResource.getConfig(testConfig, nil); err != nil {
 		t.Logf("got error '%v' when trying to get HCL string", err)
 		t.Fail()
 	}
-	resultHcl := testStringBuilder.String()
+	resultHcl := testConfig.getHCLString()
 
 	if !strings.Contains(resultHcl, "compartment_id = \"<placeholder for missing required attribute>\"\t#Required") || !strings.Contains(resultHcl, "#a_string = <<Optional") {
 		t.Logf("expected 'Required' compartment_id to have a placeholder value with comment and 'Optional' a_string field to be commented out, but they weren't")
@@ -1498,7 +1499,7 @@ func TestUnitGetHCLString_interpolationMap(t *testing.T) {
 	}
 
 	targetResourceOcid := getTestResourceId("child", len(childrenResources)-1)
-	testStringBuilder := &strings.Builder{}
+	testConfig := &configBody{}
 	var targetResource *OCIResource
 	for _, resource := range results {
 		if resource.id == targetResourceOcid {
@@ -1509,11 +1510,11 @@ func TestUnitGetHCLString_interpolationMap(t *testing.T) {
 
 	// Test that ocids can be replaced with parent ID references
 	interpolationMap := map[string]string{targetResource.parent.id: targetResource.parent.getHclReferenceIdString()}
-	if err := targetResource.getHCLString(testStringBuilder, interpolationMap); err != nil {
+	if err := targetResource.getConfig(testConfig, interpolationMap); err != nil {
 		t.Logf("got error '%v' when trying to get HCL string", err)
 		t.Fail()
 	}
-	resultHcl := testStringBuilder.String()
+	resultHcl := testConfig.getHCLString()
 
 	if !strings.Contains(resultHcl, targetResource.parent.getHclReferenceIdString()) || strings.Contains(resultHcl, targetResource.parent.id) {
 		t.Logf("expected hcl to replace parent ocid '%s' with '%s', but it wasn't", targetResource.parent.id, targetResource.parent.getHclReferenceIdString())
@@ -1522,11 +1523,11 @@ func TestUnitGetHCLString_interpolationMap(t *testing.T) {
 
 	// Test that self-referencing IDs are ignored and do not show up in result hcl
 	interpolationMap = map[string]string{targetResource.parent.id: targetResource.getHclReferenceIdString()}
-	if err := targetResource.getHCLString(testStringBuilder, interpolationMap); err != nil {
+	if err := targetResource.getConfig(testConfig, interpolationMap); err != nil {
 		t.Logf("got error '%v' when trying to get HCL string", err)
 		t.Fail()
 	}
-	resultHcl = testStringBuilder.String()
+	resultHcl = testConfig.getHCLString()
 
 	if strings.Contains(resultHcl, targetResource.getHclReferenceIdString()) || !strings.Contains(resultHcl, targetResource.parent.id) {
 		t.Logf("expected hcl to avoid cyclical reference '%s' but found one", targetResource.getHclReferenceIdString())
@@ -2356,3 +2357,46 @@ func TestUnitDeleteInvalidReferencesWithReferenceResource(t *testing.T) {
 }
 
 */
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_importBlocks(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	tfHclVersion = &TfHclVersion15{TfHclVersion12: TfHclVersion12{Value: TfVersion15}}
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+	}
+	err, status := RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	content, err := ioutil.ReadFile(path.Join(outputDir, "compartment_testing.tf"))
+	assert.NoError(t, err)
+	config := string(content)
+	assert.Equal(t, len(parentResources)+len(childrenResources), strings.Count(config, "import {"), "each discovered resource should be imported")
+	for id := range parentResources {
+		assert.Regexp(t, fmt.Sprintf("import {\\n  to = oci_test_parent\\.[a-z0-9_]+\\n  id = \"%s\"\\n}", regexp.QuoteMeta(id)), config)
+	}
+	assert.NotContains(t, config, "${", "references should not be interpolated")
+
+	content, err = ioutil.ReadFile(path.Join(outputDir, "provider.tf"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "required_version = \">= 1.5.0\"")
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// compartmentModule is the Terraform module generated for one compartment of an exported compartment tree
+type compartmentModule struct {
+	compartmentId string
+	name          string // name of the module in the root module
+	source        string // path of the module relative to the output directory
+	parent        *compartmentModule
+	ctx           *resourceDiscoveryContext
+	steps         []resourceDiscoveryStep
+	referenceMap  map[string]string
+	vars          map[string]string
+	inputs        map[string]string // expressions of the root module assigned to the input variables of the module
+	outputs       map[string]string // values of the outputs of the module
+	directories   map[string]bool   // directories of the modules of the subcompartments
+}
+
+// compartmentModuleOutput is an OCID owned by a module, which other modules reference through one of its outputs
+type compartmentModuleOutput struct {
+	module *compartmentModule
+	output string
+	input  string // name of the input variable of the modules referencing the OCID
+}
+
+/*
+runCompartmentTreeExport exports the compartment of the arguments and all its subcompartments as one module per
+compartment, under directories mirroring the compartment hierarchy. The root module in the output directory calls
+every compartment module and wires the OCIDs that the resources of a compartment reference in other compartments,
+from the outputs of the modules owning them to the inputs of the modules referencing them.
+*/
+func runCompartmentTreeExport(clients *tf_client.OracleClients, args *ExportCommandArgs, tenancyOcid string) ([]*compartmentModule, error) {
+	utils.Logf("[INFO] Running export command for a compartment tree\n")
+	defer tracing.Flush()
+	defer elapsed("entire export command", nil, 0)()
+
+	rootCompartmentId := tenancyOcid
+	if args.CompartmentId != nil && *args.CompartmentId != "" {
+		rootCompartmentId = *args.CompartmentId
+	}
+	modules, err := getCompartmentModules(clients, rootCompartmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, module := range modules {
+		if err := module.discover(clients, args, tenancyOcid); err != nil {
+			return modules, err
+		}
+	}
+
+	linkCompartmentModules(modules)
+
+	for _, module := range modules {
+		if err := module.writeConfiguration(); err != nil {
+			return modules, err
+		}
+	}
+
+	if err := generateCompartmentTreeRootModule(modules, args.OutputDir); err != nil {
+		return modules, err
+	}
+	return modules, nil
+}
+
+// getCompartmentModules returns the modules of the compartment and of its active subcompartments, parents first
+func getCompartmentModules(clients *tf_client.OracleClients, compartmentId string) ([]*compartmentModule, error) {
+	response, err := identityClientGetCompartmentVar(clients, oci_identity.GetCompartmentRequest{
+		CompartmentId: &compartmentId,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: tfresource.GetRetryPolicy(true, "identity"),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] could not get compartment %s: %v", compartmentId, err)
+	}
+
+	names := map[string]int{}
+	root := newCompartmentModule(compartmentId, *response.Name, nil, names)
+	modules := []*compartmentModule{root}
+	if err := addSubcompartmentModules(clients, root, &modules, names); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+func addSubcompartmentModules(clients *tf_client.OracleClients, parent *compartmentModule, modules *[]*compartmentModule, names map[string]int) error {
+	request := oci_identity.ListCompartmentsRequest{
+		CompartmentId:  &parent.compartmentId,
+		LifecycleState: oci_identity.CompartmentLifecycleStateActive,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: tfresource.GetRetryPolicy(true, "identity"),
+		},
+	}
+
+	var subcompartments []oci_identity.Compartment
+	for {
+		response, err := identityClientListCompartmentsVar(clients, request)
+		if err != nil {
+			return fmt.Errorf("[ERROR] could not list the subcompartments of compartment %s: %v", parent.compartmentId, err)
+		}
+		subcompartments = append(subcompartments, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	sort.Slice(subcompartments, func(i, j int) bool {
+		return *subcompartments[i].Name < *subcompartments[j].Name
+	})
+
+	for _, subcompartment := range subcompartments {
+		module := newCompartmentModule(*subcompartment.Id, *subcompartment.Name, parent, names)
+		*modules = append(*modules, module)
+		if err := addSubcompartmentModules(clients, module, modules, names); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newCompartmentModule(compartmentId string, compartmentName string, parent *compartmentModule, names map[string]int) *compartmentModule {
+	name := getNormalizedTerraformName(compartmentName)
+	if count := names[name]; count > 0 {
+		names[name]++
+		name = fmt.Sprintf("%s_%d", name, count)
+	} else {
+		names[name] = 1
+	}
+
+	directory := strings.TrimPrefix(getNormalizedTerraformName(compartmentName), "export_")
+	source := path.Join(globalvar.CompartmentModulesDir, directory)
+	if parent != nil {
+		// Names like a.b and a-b normalize to the same directory, so siblings are suffixed like the module names
+		unique := directory
+		for count := 1; parent.directories[unique]; count++ {
+			unique = fmt.Sprintf("%s_%d", directory, count)
+		}
+		parent.directories[unique] = true
+		source = path.Join(parent.source, unique)
+	}
+
+	return &compartmentModule{
+		compartmentId: compartmentId,
+		name:          name,
+		source:        source,
+		parent:        parent,
+		inputs:        map[string]string{},
+		outputs:       map[string]string{},
+		directories:   map[string]bool{},
+	}
+}
+
+func (module *compartmentModule) outputDir(args *ExportCommandArgs) string {
+	return filepath.Join(*args.OutputDir, filepath.FromSlash(module.source))
+}
+
+// discover discovers the resources of the compartment of the module, with the references and variables of the
+// module kept apart from the ones of the other modules
+func (module *compartmentModule) discover(clients *tf_client.OracleClients, args *ExportCommandArgs, tenancyOcid string) error {
+	utils.Logf("[INFO] Discovering the resources of compartment %s for module %s", module.compartmentId, module.name)
+	exportStart := time.Now()
+
+	outputDir := module.outputDir(args)
+	if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+		return err
+	}
+	compartmentId := module.compartmentId
+	moduleArgs := *args
+	moduleArgs.CompartmentId = &compartmentId
+	moduleArgs.CompartmentName = nil
+	moduleArgs.OutputDir = &outputDir
+
+	referenceMap = map[string]string{}
+	vars = map[string]string{}
+	ctx, err := createResourceDiscoveryContext(clients, &moduleArgs, tenancyOcid)
+	if err != nil {
+		return err
+	}
+	moduleArgs.finalizeServices(ctx)
+	module.ctx = ctx
+
+	if module.steps, err = getDiscoverResourceSteps(ctx); err != nil {
+		return err
+	}
+	discoverResources(ctx, module.steps)
+
+	module.referenceMap = referenceMap
+	module.vars = vars
+	ctx.timeTakenForEntireExport = time.Since(exportStart)
+	return nil
+}
+
+/*
+linkCompartmentModules replaces the OCIDs that the resources of a module reference in another module with input
+variables of the module, assigned from outputs of the module owning the OCIDs. A module owns the OCIDs of the resources
+it exports and of its compartment.
+*/
+func linkCompartmentModules(modules []*compartmentModule) {
+	owners := map[string]compartmentModuleOutput{}
+	for _, module := range modules {
+		for _, step := range module.steps {
+			for _, resource := range step.getDiscoveredResources() {
+				if _, owned := owners[resource.id]; owned {
+					continue
+				}
+				if _, exists := module.referenceMap[resource.id]; !exists {
+					continue
+				}
+				output := fmt.Sprintf("%s_%s_id", resource.terraformClass, resource.terraformName)
+				owners[resource.id] = compartmentModuleOutput{module: module, output: output, input: output}
+			}
+		}
+	}
+	for _, module := range modules {
+		if _, owned := owners[module.compartmentId]; !owned {
+			owners[module.compartmentId] = compartmentModuleOutput{
+				module: module,
+				output: "compartment_ocid",
+				input:  fmt.Sprintf("%s_compartment_ocid", module.name),
+			}
+		}
+	}
+
+	for _, module := range modules {
+		for _, step := range module.steps {
+			for _, resource := range step.getDiscoveredResources() {
+				for _, value := range getStringValues(resource.sourceAttributes) {
+					if _, exists := module.referenceMap[value]; exists {
+						continue
+					}
+					owner, owned := owners[value]
+					if !owned || owner.module == module {
+						continue
+					}
+					owner.module.outputs[owner.output] = owner.module.referenceMap[value]
+					module.inputs[owner.input] = tfHclVersion.getDoubleExpHclString(fmt.Sprintf("module.%s", owner.module.name), owner.output)
+					module.referenceMap[value] = tfHclVersion.getVarHclString(owner.input)
+					module.vars[owner.input] = ""
+				}
+			}
+		}
+	}
+}
+
+// getStringValues returns the strings in the attributes of a resource, including the ones of nested blocks
+func getStringValues(value interface{}) []string {
+	var result []string
+	switch v := value.(type) {
+	case string:
+		result = append(result, v)
+	case []interface{}:
+		for _, item := range v {
+			result = append(result, getStringValues(item)...)
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			result = append(result, getStringValues(item)...)
+		}
+	}
+	return result
+}
+
+// writeConfiguration writes the configuration, variables and outputs of the module
+func (module *compartmentModule) writeConfiguration() error {
+	referenceMap = module.referenceMap
+	vars = module.vars
+	if err := writeConfigurations(module.ctx, module.steps); err != nil {
+		return err
+	}
+	if err := generateVarsFile(module.vars, module.ctx.OutputDir); err != nil {
+		return err
+	}
+	if err := generateOutputsFile(module.outputs, module.ctx.OutputDir); err != nil {
+		return err
+	}
+	module.ctx.addMissingAttributesSummary()
+	module.ctx.postValidate()
+	return nil
+}
+
+// addImportBlocks adds the import blocks of the resources of the module to the root module, addressed through the
+// module block
+func (module *compartmentModule) addImportBlocks(config *configBody) {
+	resources := make([]*OCIResource, 0, len(module.ctx.discoveredResources))
+	for _, resource := range module.ctx.discoveredResources {
+		if resource.isImportable() {
+			resources = append(resources, resource)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].getTerraformReference() < resources[j].getTerraformReference()
+	})
+	for _, resource := range resources {
+		address := fmt.Sprintf("module.%s.%s", module.name, resource.getTerraformReference())
+		tfHclVersion.addImportBlock(config, address, resource.getImportId())
+	}
+}
+
+func generateOutputsFile(outputs map[string]string, outputDir *string) error {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	config := &configBody{}
+	for _, name := range names {
+		config.addBlock("output", name).body.addAttribute("value", referenceExpression(outputs[name]))
+	}
+	_, err := writeConfigFile(config, filepath.Join(*outputDir, globalvar.OutputsFile))
+	return err
+}
+
+// generateCompartmentTreeRootModule writes the root module calling the compartment modules, with its provider and
+// variables
+func generateCompartmentTreeRootModule(modules []*compartmentModule, outputDir *string) error {
+	config := &configBody{}
+	config.addComment("# This configuration was generated by terraform-provider-oci")
+	config.addBlankLine()
+	for _, module := range modules {
+		if module.parent != nil {
+			config.addComment(fmt.Sprintf(" Subcompartment of %s", module.parent.name))
+		}
+		moduleBlock := config.addBlock("module", module.name)
+		moduleBlock.body.addAttribute("source", literalExpression(fmt.Sprintf("./%s", module.source)))
+		inputs := make([]string, 0, len(module.inputs))
+		for input := range module.inputs {
+			inputs = append(inputs, input)
+		}
+		sort.Strings(inputs)
+		for _, input := range inputs {
+			moduleBlock.body.addAttribute(input, referenceExpression(module.inputs[input]))
+		}
+	}
+	for _, module := range modules {
+		module.addImportBlocks(config)
+	}
+	if _, err := writeConfigFile(config, filepath.Join(*outputDir, globalvar.CompartmentModulesFile)); err != nil {
+		return err
+	}
+
+	region, err := exportConfigProvider.Region()
+	if err != nil {
+		return err
+	}
+	if err := generateProviderFile(outputDir); err != nil {
+		return err
+	}
+	return generateVarsFile(map[string]string{"region": fmt.Sprintf("\"%s\"", region)}, outputDir)
+}
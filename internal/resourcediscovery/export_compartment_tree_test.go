@@ -0,0 +1,174 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+)
+
+func getTestCompartmentModule(name string, compartmentId string, resources ...*OCIResource) *compartmentModule {
+	module := newCompartmentModule(compartmentId, name, nil, map[string]int{})
+	module.referenceMap = map[string]string{compartmentId: tfHclVersion.getVarHclString("compartment_ocid")}
+	module.vars = map[string]string{"compartment_ocid": fmt.Sprintf("%q", compartmentId)}
+	for _, resource := range resources {
+		module.referenceMap[resource.id] = resource.getHclReferenceIdString()
+	}
+	module.steps = []resourceDiscoveryStep{&resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: "core", discoveredResources: resources},
+	}}
+	return module
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitNewCompartmentModule_siblingDirectories(t *testing.T) {
+	names := map[string]int{}
+	root := newCompartmentModule("ocid1.tenancy.oc1..root", "root", nil, names)
+	first := newCompartmentModule("ocid1.compartment.oc1..first", "a.b", root, names)
+	second := newCompartmentModule("ocid1.compartment.oc1..second", "a-b", root, names)
+	nested := newCompartmentModule("ocid1.compartment.oc1..nested", "a.b", first, names)
+
+	assert.Equal(t, "export_a-b", first.name)
+	assert.Equal(t, "export_a-b_1", second.name)
+	assert.Equal(t, "export_a-b_2", nested.name)
+	assert.Equal(t, root.source+"/a-b", first.source)
+	assert.Equal(t, root.source+"/a-b_1", second.source)
+	assert.Equal(t, first.source+"/a-b", nested.source)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLinkCompartmentModules(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	subnet := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.subnet.network", terraformClass: "oci_core_subnet", terraformName: "export_subnet"},
+		sourceAttributes:  map[string]interface{}{"compartment_id": "ocid1.compartment.network"},
+	}
+	instance := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.instance.app", terraformClass: "oci_core_instance", terraformName: "export_instance"},
+		sourceAttributes: map[string]interface{}{
+			"compartment_id":      "ocid1.compartment.app",
+			"create_vnic_details": []interface{}{map[string]interface{}{"subnet_id": "ocid1.subnet.network"}},
+			"metadata":            map[string]interface{}{"network_compartment": "ocid1.compartment.network"},
+			"display_name":        "instance",
+		},
+	}
+	network := getTestCompartmentModule("network", "ocid1.compartment.network", subnet)
+	app := getTestCompartmentModule("app", "ocid1.compartment.app", instance)
+
+	linkCompartmentModules([]*compartmentModule{network, app})
+
+	assert.Equal(t, map[string]string{
+		"compartment_ocid":                 "var.compartment_ocid",
+		"oci_core_subnet_export_subnet_id": "oci_core_subnet.export_subnet.id",
+	}, network.outputs)
+	assert.Empty(t, network.inputs)
+	assert.Equal(t, map[string]string{
+		"export_network_compartment_ocid":  "module.export_network.compartment_ocid",
+		"oci_core_subnet_export_subnet_id": "module.export_network.oci_core_subnet_export_subnet_id",
+	}, app.inputs)
+	assert.Empty(t, app.outputs)
+	assert.Equal(t, "var.oci_core_subnet_export_subnet_id", app.referenceMap["ocid1.subnet.network"])
+	assert.Equal(t, "var.export_network_compartment_ocid", app.referenceMap["ocid1.compartment.network"])
+	assert.Equal(t, "var.compartment_ocid", app.referenceMap["ocid1.compartment.app"], "the compartment of a module should not be an input")
+	assert.Equal(t, "", app.vars["oci_core_subnet_export_subnet_id"], "inputs should be variables without a default")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_compartmentTree(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	listCompartments, getCompartment := identityClientListCompartmentsVar, identityClientGetCompartmentVar
+	defer func() {
+		identityClientListCompartmentsVar, identityClientGetCompartmentVar = listCompartments, getCompartment
+	}()
+
+	rootName, networkId, networkName := "root", "ocid1.compartment.network", "network"
+	identityClientGetCompartmentVar = func(clients *tf_client.OracleClients, request oci_identity.GetCompartmentRequest) (oci_identity.GetCompartmentResponse, error) {
+		return oci_identity.GetCompartmentResponse{Compartment: oci_identity.Compartment{Id: request.CompartmentId, Name: &rootName}}, nil
+	}
+	identityClientListCompartmentsVar = func(clients *tf_client.OracleClients, request oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error) {
+		assert.Equal(t, oci_identity.CompartmentLifecycleStateActive, request.LifecycleState)
+		if *request.CompartmentId == resourceDiscoveryTestCompartmentOcid {
+			return oci_identity.ListCompartmentsResponse{Items: []oci_identity.Compartment{{Id: &networkId, Name: &networkName}}}, nil
+		}
+		return oci_identity.ListCompartmentsResponse{}, nil
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId:   &compartmentId,
+		Services:        []string{"compartment_testing"},
+		OutputDir:       &outputDir,
+		TFVersion:       &tfHclVersion,
+		Parallelism:     1,
+		CompartmentTree: true,
+	}
+	err, status := RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	for _, file := range []string{"main.tf", "provider.tf", "vars.tf",
+		"compartments/root/compartment_testing.tf", "compartments/root/vars.tf", "compartments/root/outputs.tf",
+		"compartments/root/network/compartment_testing.tf", "compartments/root/network/vars.tf"} {
+		_, err := os.Stat(filepath.Join(outputDir, filepath.FromSlash(file)))
+		assert.NoError(t, err, "%s should be generated", file)
+	}
+	_, err = os.Stat(filepath.Join(outputDir, "compartments", "root", "provider.tf"))
+	assert.True(t, os.IsNotExist(err), "the compartment modules should use the provider of the root module")
+
+	content, err := ioutil.ReadFile(filepath.Join(outputDir, "main.tf"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "module export_root {\n  source = \"./compartments/root\"\n}")
+	assert.Contains(t, string(content), "# Subcompartment of export_root\nmodule export_network {\n  source = \"./compartments/root/network\"\n}")
+
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, "compartments", "root", "network", "vars.tf"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), fmt.Sprintf("variable compartment_ocid { default = \"%s\" }", networkId))
+
+	// Terraform only accepts import blocks in the root module
+	previousVersion := tfHclVersion
+	defer func() { tfHclVersion = previousVersion }()
+	tfHclVersion = &TfHclVersion15{TfHclVersion12: TfHclVersion12{Value: TfVersion15}}
+	outputDir = t.TempDir()
+	err, status = RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, "main.tf"))
+	assert.NoError(t, err)
+	for id := range parentResources {
+		assert.Regexp(t, fmt.Sprintf("import {\\n  to = module\\.export_root\\.oci_test_parent\\.[a-z0-9_]+\\n  id = \"%s\"\\n}", regexp.QuoteMeta(id)), string(content))
+		assert.Regexp(t, fmt.Sprintf("import {\\n  to = module\\.export_network\\.oci_test_parent\\.[a-z0-9_]+\\n  id = \"%s\"\\n}", regexp.QuoteMeta(id)), string(content))
+	}
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, "compartments", "root", "compartment_testing.tf"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "import {")
+
+	args.GenerateState = true
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] generate_state is not supported when exporting a compartment tree")
+	assert.Equal(t, StatusFail, status)
+}
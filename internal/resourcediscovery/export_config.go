@@ -0,0 +1,291 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hclwrite"
+)
+
+type OutputFormatEnum string
+
+const (
+	OutputFormatHcl  OutputFormatEnum = "hcl"
+	OutputFormatJson OutputFormatEnum = "json"
+)
+
+// outputFormat is the format of the generated configuration files
+var outputFormat = OutputFormatHcl
+
+// getFileName returns the name of a configuration file in the format, from its name in HCL
+func (format OutputFormatEnum) getFileName(hclFileName string) string {
+	if format == OutputFormatJson {
+		return hclFileName + ".json"
+	}
+	return hclFileName
+}
+
+// render returns the content of a configuration file in the format
+func (format OutputFormatEnum) render(config *configBody) ([]byte, error) {
+	if format == OutputFormatJson {
+		buffer := &bytes.Buffer{}
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(config.getJSON()); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}
+	return hclwrite.Format([]byte(config.getHCLString())), nil
+}
+
+// writeConfigFile writes a configuration file in the output format and returns the path of the file
+func writeConfigFile(config *configBody, hclOutputFile string) (string, error) {
+	outputFile := outputFormat.getFileName(hclOutputFile)
+	tmpOutputFile := fmt.Sprintf("%s.tmp", outputFile)
+
+	content, err := outputFormat.render(config)
+	if err != nil {
+		return outputFile, err
+	}
+	if err := ioutil.WriteFile(tmpOutputFile, content, 0666); err != nil {
+		return outputFile, err
+	}
+	return outputFile, os.Rename(tmpOutputFile, outputFile)
+}
+
+// configExpression is a value of the configuration, in the HCL and JSON syntaxes
+type configExpression struct {
+	hcl  string
+	json string
+}
+
+// literalExpression returns the expression of a literal string
+func literalExpression(value string) configExpression {
+	value = escapeTFStrings(value)
+	return configExpression{hcl: fmt.Sprintf("%q", value), json: value}
+}
+
+// referenceExpression returns the expression of a reference to another object of the configuration, from its HCL syntax
+func referenceExpression(hcl string) configExpression {
+	// The references of Terraform v0.11 are quoted interpolations, which are strings as is in JSON
+	if value, err := strconv.Unquote(hcl); err == nil {
+		return configExpression{hcl: hcl, json: value}
+	}
+	return configExpression{hcl: hcl, json: fmt.Sprintf("${%s}", hcl)}
+}
+
+// traversalExpression returns the expression of an attribute name, e.g. in lifecycle ignore_changes, which is not
+// interpolated in JSON
+func traversalExpression(traversal string) configExpression {
+	return configExpression{hcl: tfHclVersion.getReference(traversal), json: traversal}
+}
+
+// configBody is the body of a configuration file, a block or a map, with its attributes, blocks and comments in the
+// order they are written in HCL
+type configBody struct {
+	items []configItem
+}
+
+// configItem is one of an attribute, a block or a comment, a blank line if none is set
+type configItem struct {
+	attribute *configAttribute
+	block     *configBlock
+	comment   string
+}
+
+type configAttribute struct {
+	name    string
+	value   interface{} // configExpression, []configExpression or *configBody for maps
+	comment string      // written at the end of the line in HCL
+}
+
+type configBlock struct {
+	blockType string
+	labels    []string
+	body      configBody
+	inline    bool // whether the block is written on a single line in HCL
+}
+
+func (body *configBody) addAttribute(name string, value interface{}) *configAttribute {
+	attribute := &configAttribute{name: name, value: value}
+	body.items = append(body.items, configItem{attribute: attribute})
+	return attribute
+}
+
+func (body *configBody) addBlock(blockType string, labels ...string) *configBlock {
+	block := &configBlock{blockType: blockType, labels: labels}
+	body.items = append(body.items, configItem{block: block})
+	return block
+}
+
+// addComment adds a comment line, the comment is written after '#' in HCL
+func (body *configBody) addComment(comment string) {
+	body.items = append(body.items, configItem{comment: comment})
+}
+
+func (body *configBody) addBlankLine() {
+	body.items = append(body.items, configItem{})
+}
+
+// getHCLString returns the unformatted HCL of a configuration file, with a blank line after each block
+func (body *configBody) getHCLString() string {
+	builder := &strings.Builder{}
+	for _, item := range body.items {
+		writeHCLItem(builder, item, false)
+		if item.block != nil && !item.block.inline {
+			builder.WriteString("\n")
+		}
+	}
+	return builder.String()
+}
+
+func writeHCLItem(builder *strings.Builder, item configItem, isMap bool) {
+	switch {
+	case item.attribute != nil:
+		if isMap {
+			builder.WriteString(fmt.Sprintf("%q = ", item.attribute.name))
+		} else {
+			builder.WriteString(fmt.Sprintf("%s = ", item.attribute.name))
+		}
+		writeHCLValue(builder, item.attribute.value)
+		if item.attribute.comment != "" {
+			builder.WriteString(fmt.Sprintf("\t#%s", item.attribute.comment))
+		}
+		builder.WriteString("\n")
+	case item.block != nil:
+		item.block.writeHCL(builder)
+	case item.comment != "":
+		builder.WriteString(fmt.Sprintf("#%s\n", item.comment))
+	default:
+		builder.WriteString("\n")
+	}
+}
+
+func writeHCLValue(builder *strings.Builder, value interface{}) {
+	switch v := value.(type) {
+	case configExpression:
+		builder.WriteString(v.hcl)
+	case []configExpression:
+		builder.WriteString("[\n")
+		for _, item := range v {
+			builder.WriteString(fmt.Sprintf("%s,\n", item.hcl))
+		}
+		builder.WriteString("]")
+	case *configBody:
+		builder.WriteString("{\n")
+		for _, item := range v.items {
+			writeHCLItem(builder, item, true)
+		}
+		builder.WriteString("}")
+	}
+}
+
+func (block *configBlock) writeHCL(builder *strings.Builder) {
+	builder.WriteString(block.blockType)
+	for _, label := range block.labels {
+		builder.WriteString(fmt.Sprintf(" %s", label))
+	}
+
+	if !block.inline {
+		builder.WriteString(" {\n")
+		for _, item := range block.body.items {
+			writeHCLItem(builder, item, false)
+		}
+		builder.WriteString("}\n")
+		return
+	}
+
+	if len(block.body.items) == 0 {
+		builder.WriteString(" {}\n")
+		return
+	}
+	builder.WriteString(" {")
+	for _, item := range block.body.items {
+		if item.attribute != nil {
+			builder.WriteString(fmt.Sprintf(" %s = ", item.attribute.name))
+			writeHCLValue(builder, item.attribute.value)
+		}
+	}
+	builder.WriteString(" }\n")
+}
+
+/*
+getJSON returns the JSON object of a body in the JSON syntax of Terraform
+- blocks are nested under their type and labels, the blocks without labels are arrays of objects
+- comments are joined in the "//" property ignored by Terraform
+*/
+func (body *configBody) getJSON() map[string]interface{} {
+	result := map[string]interface{}{}
+	comments := []string{}
+	for _, item := range body.items {
+		switch {
+		case item.attribute != nil:
+			result[item.attribute.name] = getJSONValue(item.attribute.value)
+			if item.attribute.comment != "" {
+				comments = append(comments, fmt.Sprintf("%s: %s", item.attribute.name, item.attribute.comment))
+			}
+		case item.block != nil:
+			item.block.addJSON(result)
+		case item.comment != "":
+			comments = append(comments, strings.TrimSpace(strings.TrimLeft(item.comment, "#")))
+		}
+	}
+	if len(comments) > 0 {
+		result["//"] = strings.Join(comments, "\n")
+	}
+	return result
+}
+
+// getJSONValue returns the JSON value of an attribute, the comments of maps are not written since every property of a
+// map is a key
+func getJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case configExpression:
+		return v.json
+	case []configExpression:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = item.json
+		}
+		return result
+	case *configBody:
+		result := map[string]interface{}{}
+		for _, item := range v.items {
+			if item.attribute != nil {
+				result[item.attribute.name] = getJSONValue(item.attribute.value)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func (block *configBlock) addJSON(parent map[string]interface{}) {
+	body := block.body.getJSON()
+	if len(block.labels) == 0 {
+		blocks, _ := parent[block.blockType].([]interface{})
+		parent[block.blockType] = append(blocks, body)
+		return
+	}
+
+	object, key := parent, block.blockType
+	for _, label := range block.labels {
+		nested, ok := object[key].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			object[key] = nested
+		}
+		object, key = nested, label
+	}
+	object[key] = body
+}
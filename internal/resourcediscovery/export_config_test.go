@@ -0,0 +1,139 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitOutputFormat_render(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	rootResource := getRootCompartmentResource()
+
+	ctx := &resourceDiscoveryContext{}
+	results, err := findResources(ctx, rootResource, compartmentTestingResourceGraph)
+	assert.NoError(t, err)
+
+	targetResourceOcid := getTestResourceId("child", len(childrenResources)-1)
+	var targetResource *OCIResource
+	for _, resource := range results {
+		if resource.id == targetResourceOcid {
+			targetResource = resource
+			break
+		}
+	}
+	delete(targetResource.sourceAttributes, "compartment_id")
+	targetResource.sourceAttributes["a_map"].(map[string]interface{})["key0"] = map[string]interface{}{"complex": "value"}
+
+	config := &configBody{}
+	config.addComment("# This configuration was generated by terraform-provider-oci")
+	config.addBlankLine()
+	interpolationMap := map[string]string{targetResource.parent.id: targetResource.parent.getHclReferenceIdString()}
+	assert.NoError(t, targetResource.getConfig(config, interpolationMap))
+
+	hcl, err := OutputFormatHcl.render(config)
+	assert.NoError(t, err)
+	assert.Contains(t, string(hcl), "parent_id      = oci_test_parent.export_string3.id")
+	assert.Contains(t, string(hcl), "ignore_changes = [\n      compartment_id,\n    ]")
+
+	content, err := OutputFormatJson.render(config)
+	assert.NoError(t, err)
+	var result map[string]interface{}
+	assert.NoError(t, json.Unmarshal(content, &result))
+	assert.Equal(t, "This configuration was generated by terraform-provider-oci", result["//"])
+
+	resource := result["resource"].(map[string]interface{})["oci_test_child"].(map[string]interface{})[targetResource.terraformName].(map[string]interface{})
+	assert.Equal(t, "${oci_test_parent.export_string3.id}", resource["parent_id"], "references should be interpolated")
+	assert.Equal(t, "string3", resource["a_string"])
+	assert.Equal(t, "3", resource["a_int"], "primitives should be strings as in HCL")
+	assert.Equal(t, []interface{}{"string0", "string1", "string2"}, resource["a_list"])
+	assert.Equal(t, map[string]interface{}{"key1": "string1", "key2": "string2"}, resource["a_map"], "complex map values should be skipped")
+	assert.Len(t, resource["a_nested"], 3, "each nested block should be an object")
+	assert.Equal(t, "string1", resource["a_nested"].([]interface{})[1].(map[string]interface{})["nested_string"])
+	assert.Equal(t, globalvar.PlaceholderValueForMissingAttribute, resource["compartment_id"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"ignore_changes": []interface{}{"compartment_id"}}}, resource["lifecycle"])
+	assert.Contains(t, resource["//"], "compartment_id: Required attribute not found in discovery")
+
+	// Terraform v0.11 interpolations are the same in JSON
+	tfHclVersion = &TfHclVersion11{}
+	assert.Equal(t, "${var.compartment_ocid}", referenceExpression(tfHclVersion.getVarHclString("compartment_ocid")).json)
+	assert.Equal(t, "$${literal}", literalExpression("${literal}").json)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_jsonOutputFormat(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func() { outputFormat = OutputFormatHcl }()
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	tfHclVersion = &TfHclVersion15{TfHclVersion12: TfHclVersion12{Value: TfVersion15}}
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+		OutputFormat:  OutputFormatJson,
+	}
+	err, status := RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+	assert.ElementsMatch(t, []string{"compartment_testing.tf.json", "provider.tf.json", "vars.tf.json"}, names)
+
+	configs := map[string]map[string]interface{}{}
+	for _, name := range names {
+		content, err := ioutil.ReadFile(filepath.Join(outputDir, name))
+		assert.NoError(t, err)
+		var config map[string]interface{}
+		assert.NoError(t, json.Unmarshal(content, &config), "%s should be JSON", name)
+		configs[name] = config
+	}
+	resources := configs["compartment_testing.tf.json"]["resource"].(map[string]interface{})
+	assert.Len(t, resources["oci_test_parent"], len(parentResources))
+	assert.Len(t, resources["oci_test_child"], len(childrenResources))
+	for _, child := range resources["oci_test_child"].(map[string]interface{}) {
+		assert.Regexp(t, "^\\$\\{oci_test_parent\\.[a-z0-9_]+\\.id\\}$", child.(map[string]interface{})["parent_id"])
+	}
+	assert.Len(t, configs["compartment_testing.tf.json"]["import"], len(parentResources)+len(childrenResources))
+	assert.Equal(t, map[string]interface{}{"region": "${var.region}"}, configs["provider.tf.json"]["provider"].(map[string]interface{})["oci"])
+	assert.Equal(t, map[string]interface{}{"default": resourceDiscoveryTestCompartmentOcid}, configs["vars.tf.json"]["variable"].(map[string]interface{})["compartment_ocid"])
+
+	args.OutputFormat = "yaml"
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] invalid output_format 'yaml', supported values: hcl, json")
+	assert.Equal(t, StatusFail, status)
+	_, err = os.Stat(filepath.Join(outputDir, "compartment_testing.tf"))
+	assert.True(t, os.IsNotExist(err))
+}
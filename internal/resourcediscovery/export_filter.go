@@ -0,0 +1,180 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	filterOperatorEquals      = "="
+	filterOperatorNotEquals   = "!="
+	filterOperatorMatches     = "=~"
+	filterOperatorNotMatches  = "!~"
+	filterOperatorGreaterThan = ">"
+	filterOperatorLessThan    = "<"
+	filterOperatorAtLeast     = ">="
+	filterOperatorAtMost      = "<="
+)
+
+// <attribute>[.<map key>]<operator><value>, e.g. defined_tags.Ops.Team=payments
+var resourceFilterRegex = regexp.MustCompile(`^([a-z0-9_]+)(?:\.([^=!~<>]+))?(=~|!~|!=|>=|<=|=|>|<)(.*)$`)
+
+// Layouts of the times compared by filters, the times of the resources are written in the format of SDKTime.String()
+var resourceFilterTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// resourceFilter selects the discovered resources to export by the value of one of their attributes
+type resourceFilter struct {
+	attribute string
+	key       string // the key of a map attribute, e.g. 'Ops.Team' for the defined tag of 'defined_tags.Ops.Team'
+	operator  string
+	value     string
+	pattern   *regexp.Regexp
+}
+
+func parseResourceFilters(filters []string) ([]*resourceFilter, error) {
+	result := make([]*resourceFilter, 0, len(filters))
+	for _, filter := range filters {
+		subMatchAll := resourceFilterRegex.FindStringSubmatch(strings.TrimSpace(filter))
+		if len(subMatchAll) != 5 {
+			return nil, fmt.Errorf("[ERROR] invalid filter '%s', expected <attribute><operator><value> with one of the operators %s", filter,
+				strings.Join([]string{filterOperatorEquals, filterOperatorNotEquals, filterOperatorMatches, filterOperatorNotMatches,
+					filterOperatorGreaterThan, filterOperatorLessThan, filterOperatorAtLeast, filterOperatorAtMost}, ", "))
+		}
+
+		parsed := &resourceFilter{
+			attribute: subMatchAll[1],
+			key:       subMatchAll[2],
+			operator:  subMatchAll[3],
+			value:     subMatchAll[4],
+		}
+		if parsed.operator == filterOperatorMatches || parsed.operator == filterOperatorNotMatches {
+			pattern, err := regexp.Compile(parsed.value)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] invalid regular expression in filter '%s': %v", filter, err)
+			}
+			parsed.pattern = pattern
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+// appliesTo returns whether the resource has the attribute of the filter, the resources without it are not filtered
+func (filter *resourceFilter) appliesTo(resource *OCIResource) bool {
+	if resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource {
+		return false
+	}
+	resourceSchema, exists := resourcesMap[resource.terraformClass]
+	if !exists {
+		return false
+	}
+	_, exists = resourceSchema.Schema[filter.attribute]
+	return exists
+}
+
+func (filter *resourceFilter) matches(resource *OCIResource) bool {
+	value, exists := filter.getValue(resource)
+	if !exists {
+		return filter.operator == filterOperatorNotEquals || filter.operator == filterOperatorNotMatches
+	}
+
+	switch filter.operator {
+	case filterOperatorEquals:
+		return value == filter.value
+	case filterOperatorNotEquals:
+		return value != filter.value
+	case filterOperatorMatches:
+		return filter.pattern.MatchString(value)
+	case filterOperatorNotMatches:
+		return !filter.pattern.MatchString(value)
+	case filterOperatorGreaterThan:
+		return compareFilterValues(value, filter.value) > 0
+	case filterOperatorLessThan:
+		return compareFilterValues(value, filter.value) < 0
+	case filterOperatorAtLeast:
+		return compareFilterValues(value, filter.value) >= 0
+	case filterOperatorAtMost:
+		return compareFilterValues(value, filter.value) <= 0
+	}
+	return false
+}
+
+func (filter *resourceFilter) getValue(resource *OCIResource) (string, bool) {
+	value, exists := resource.sourceAttributes[filter.attribute]
+	if filter.key != "" {
+		mapValue, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		value, exists = mapValue[filter.key]
+	}
+	if !exists {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case InterpolationString:
+		return v.value, true
+	case string, int, bool, float64:
+		return fmt.Sprintf("%v", v), true
+	}
+	return "", false
+}
+
+// compareFilterValues compares the values as times if both are times, else as numbers if both are numbers, else as strings
+func compareFilterValues(value string, filterValue string) int {
+	if valueTime, ok := parseFilterTime(value); ok {
+		if filterTime, ok := parseFilterTime(filterValue); ok {
+			switch {
+			case valueTime.Before(filterTime):
+				return -1
+			case valueTime.After(filterTime):
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if valueNumber, err := strconv.ParseFloat(value, 64); err == nil {
+		if filterNumber, err := strconv.ParseFloat(filterValue, 64); err == nil {
+			switch {
+			case valueNumber < filterNumber:
+				return -1
+			case valueNumber > filterNumber:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	return strings.Compare(value, filterValue)
+}
+
+func parseFilterTime(value string) (time.Time, bool) {
+	for _, layout := range resourceFilterTimeLayouts {
+		if result, err := time.Parse(layout, value); err == nil {
+			return result, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// isSelected returns whether the resource matches all the filters of the export
+func (ctx *resourceDiscoveryContext) isSelected(resource *OCIResource) bool {
+	for _, filter := range ctx.filters {
+		if filter.appliesTo(resource) && !filter.matches(resource) {
+			return false
+		}
+	}
+	return true
+}
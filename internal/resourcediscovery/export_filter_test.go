@@ -0,0 +1,124 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitParseResourceFilters(t *testing.T) {
+	filters, err := parseResourceFilters([]string{"defined_tags.Ops.Team=payments", "display_name=~^prod-", "time_created>2024-01-01", " state!=INACTIVE "})
+	assert.NoError(t, err)
+	assert.Len(t, filters, 4)
+	assert.Equal(t, resourceFilter{attribute: "defined_tags", key: "Ops.Team", operator: filterOperatorEquals, value: "payments"}, *filters[0])
+	assert.Equal(t, "display_name", filters[1].attribute)
+	assert.Equal(t, filterOperatorMatches, filters[1].operator)
+	assert.NotNil(t, filters[1].pattern)
+	assert.Equal(t, resourceFilter{attribute: "time_created", operator: filterOperatorGreaterThan, value: "2024-01-01"}, *filters[2])
+	assert.Equal(t, resourceFilter{attribute: "state", operator: filterOperatorNotEquals, value: "INACTIVE"}, *filters[3])
+
+	for _, invalid := range []string{"display_name", "=payments", "Display_Name=prod", "display_name=~[prod"} {
+		_, err := parseResourceFilters([]string{invalid})
+		assert.Error(t, err, "filter '%s' should be invalid", invalid)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitResourceFilter_matches(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	resource := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.parent.prod", terraformClass: "oci_test_parent", terraformName: "export_prod_vcn"},
+		sourceAttributes: map[string]interface{}{
+			"display_name": "prod-vcn",
+			"a_map":        map[string]interface{}{"Ops.Team": "payments"},
+			"a_int":        12,
+			"time_created": "2024-03-01 10:00:00.123 +0000 UTC",
+		},
+	}
+	tests := []struct {
+		filter   string
+		selected bool
+	}{
+		{"display_name=prod-vcn", true},
+		{"display_name=dev-vcn", false},
+		{"display_name!=dev-vcn", true},
+		{"display_name=~^prod-", true},
+		{"display_name!~^prod-", false},
+		{"a_map.Ops.Team=payments", true},
+		{"a_map.Ops.Team=storage", false},
+		{"a_map.Ops.Owner=payments", false},
+		{"a_map.Ops.Owner!=payments", true},
+		{"a_int>9", true},
+		{"a_int<=9", false},
+		{"time_created>2024-01-01", true},
+		{"time_created<2024-03-01T10:00:00Z", false},
+		{"time_created>=2024-03-01T10:00:00.123Z", true},
+		{"a_string=missing", false},
+		{"a_string!=missing", true},
+		{"parent_id=not_in_the_schema", true},
+	}
+	for _, test := range tests {
+		filters, err := parseResourceFilters([]string{test.filter})
+		assert.NoError(t, err)
+		ctx := &resourceDiscoveryContext{filters: filters}
+		assert.Equal(t, test.selected, ctx.isSelected(resource), "unexpected selection with filter '%s'", test.filter)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFindResources_filters(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	filters, err := parseResourceFilters([]string{"display_name=~^string[12]$"})
+	assert.NoError(t, err)
+	ctx := &resourceDiscoveryContext{errorList: ErrorList{}, filters: filters}
+	results, err := findResources(ctx, getRootCompartmentResource(), compartmentTestingResourceGraph)
+	assert.NoError(t, err)
+
+	exported := map[string]bool{}
+	omitted := 0
+	for _, resource := range results {
+		if resource.omitFromExport {
+			assert.Equal(t, "oci_test_parent", resource.terraformClass)
+			omitted++
+		} else {
+			exported[resource.id] = true
+		}
+	}
+	assert.Equal(t, 2, omitted, "the parents not matching the filter should be omitted")
+	assert.Len(t, exported, 2+4, "only the children of the selected parents should be discovered")
+	for id, child := range childrenResources {
+		parentId := child["parent_id"].(string)
+		assert.Equal(t, exported[parentId], exported[id], "child %s should be exported along with its parent %s", id, parentId)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_filters(t *testing.T) {
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		OutputDir:     &outputDir,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+		Filters:       []string{"display_name=~["},
+	}
+	err, status := RunExportCommand(args)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid regular expression in filter 'display_name=~['")
+	assert.Equal(t, StatusFail, status)
+
+	args.Filters = []string{"display_name=prod"}
+	args.IDs = []string{"ocid1.parent.abcdefghiklmnop.0"}
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] filter cannot be specified along with ids")
+	assert.Equal(t, StatusFail, status)
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	ExportChangeAdded     = "added"
+	ExportChangeChanged   = "changed"
+	ExportChangeDeleted   = "deleted"
+	ExportChangeUnchanged = "unchanged"
+)
+
+// ExportChangeReport compares the resources discovered by an incremental export with the state of the previous export
+type ExportChangeReport struct {
+	PreviousStatePath string                  `json:"previous_state_path"`
+	Resources         []*ResourceChangeReport `json:"resources"`
+}
+
+// ResourceChangeReport is the change of a resource since the previous export. The changes of the attributes compare
+// the state of the previous export with the discovered resource.
+type ResourceChangeReport struct {
+	Address string           `json:"address"`
+	Id      string           `json:"id"`
+	Status  string           `json:"status"`
+	Changes []AttributeDrift `json:"changes,omitempty"`
+}
+
+// previousExport is the state of the previous export in the output path of an incremental export
+type previousExport struct {
+	statePath string
+	state     map[string]json.RawMessage
+	resources []*previousExportResource
+	byId      map[string]*previousExportResource // by terraform class and ID of the resources
+	names     map[string]bool                    // the terraform references of the resources
+}
+
+type previousExportResource struct {
+	terraformClass string
+	terraformName  string
+	id             string
+	attributes     map[string]string // the flattened attributes, nil if the state could not be decoded
+	raw            json.RawMessage
+	status         string
+}
+
+func readPreviousExport(statePath string) (*previousExport, error) {
+	state, err := readDriftState(statePath)
+	if err != nil {
+		return nil, err
+	}
+	// The resources of the state are kept as is for the resources that have not changed
+	content, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, err
+	}
+	result := &previousExport{
+		statePath: statePath,
+		resources: []*previousExportResource{},
+		byId:      map[string]*previousExportResource{},
+		names:     map[string]bool{},
+	}
+	var rawResources []json.RawMessage
+	if err := json.Unmarshal(content, &result.state); err != nil {
+		return nil, fmt.Errorf("[ERROR] invalid state file %s: %v", statePath, err)
+	}
+	if err := json.Unmarshal(result.state["resources"], &rawResources); err != nil || len(rawResources) != len(state.Resources) {
+		return nil, fmt.Errorf("[ERROR] invalid resources in state file %s", statePath)
+	}
+
+	for i, resource := range state.Resources {
+		resourceSchema, supported := resourcesMap[resource.Type]
+		if resource.Mode != "managed" || resource.Module != "" || len(resource.Instances) != 1 || !supported {
+			utils.Logf("[WARN] %s of the previous state was not generated by resource discovery and is not kept", resource.address(nil))
+			continue
+		}
+
+		previous := &previousExportResource{
+			terraformClass: resource.Type,
+			terraformName:  resource.Name,
+			raw:            rawResources[i],
+		}
+		if instanceState, err := shimDriftInstanceState(resourceSchema, resource.Instances[0]); err == nil {
+			previous.id = instanceState.ID
+			previous.attributes = instanceState.Attributes
+		} else {
+			utils.Logf("[WARN] unable to decode the previous state of %s, it will be imported again: %v", resource.address(nil), err)
+			json.Unmarshal(resource.Instances[0].Attributes["id"], &previous.id)
+		}
+		if previous.id == "" {
+			utils.Logf("[WARN] %s of the previous state has no id and is not kept", resource.address(nil))
+			continue
+		}
+
+		result.resources = append(result.resources, previous)
+		result.byId[fmt.Sprintf("%s:%s", previous.terraformClass, previous.id)] = previous
+		result.names[fmt.Sprintf("%s.%s", previous.terraformClass, previous.terraformName)] = true
+	}
+	return result, nil
+}
+
+// find returns the resource of the previous export with the ID or the import ID of a discovered resource
+func (export *previousExport) find(resource *OCIResource) *previousExportResource {
+	if previous, exists := export.byId[fmt.Sprintf("%s:%s", resource.terraformClass, resource.id)]; exists {
+		return previous
+	}
+	return export.byId[fmt.Sprintf("%s:%s", resource.terraformClass, resource.getImportId())]
+}
+
+/*
+restorePreviousName keeps the names of the resources in an incremental export
+- the resources of the previous export keep their previous name
+- the names of new resources are made unique if they are used by the previous export or by this export
+*/
+func (ctx *resourceDiscoveryContext) restorePreviousName(resource *OCIResource) {
+	if ctx.previousExport == nil {
+		return
+	}
+	if previous := ctx.previousExport.find(resource); previous != nil {
+		resource.terraformName = previous.terraformName
+		return
+	}
+
+	resourceNameCountLock.Lock()
+	defer resourceNameCountLock.Unlock()
+	name := resource.terraformName
+	for ctx.previousExport.names[fmt.Sprintf("%s.%s", resource.terraformClass, name)] || (name != resource.terraformName && resourceNameCount[name] > 0) {
+		// The suffix is counted like the generated names, so that it is not given to another resource of this export
+		count := resourceNameCount[resource.terraformName]
+		if count == 0 {
+			count = 1
+		}
+		resourceNameCount[resource.terraformName] = count + 1
+		name = fmt.Sprintf("%s_%d", resource.terraformName, count)
+	}
+	if _, exists := resourceNameCount[name]; !exists {
+		resourceNameCount[name] = 1
+	}
+	resource.terraformName = name
+}
+
+/*
+compareWithPreviousExport compares the discovered resources with the state of the previous export
+- the resources not in the previous state are added
+- the resources whose attributes differ from the previous state are changed
+- the resources of the previous state that are not discovered anymore are deleted
+The unchanged resources are not imported again, their state is kept from the previous export.
+*/
+func (ctx *resourceDiscoveryContext) compareWithPreviousExport(steps []resourceDiscoveryStep) {
+	ctx.changeReport = &ExportChangeReport{
+		PreviousStatePath: ctx.previousExport.statePath,
+		Resources:         []*ResourceChangeReport{},
+	}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			resourceSchema, exists := resourcesMap[resource.terraformClass]
+			if !exists || resourceSchema.Importer == nil || (resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource) {
+				continue
+			}
+
+			change := &ResourceChangeReport{Address: resource.getTerraformReference(), Id: resource.id, Status: ExportChangeAdded}
+			ctx.changeReport.Resources = append(ctx.changeReport.Resources, change)
+			previous := ctx.previousExport.find(resource)
+			if previous == nil {
+				continue
+			}
+
+			change.Status = ExportChangeChanged
+			if previous.attributes != nil {
+				d := getNativeResourceData(resourceSchema, resource, previous.id)
+				change.Changes = attributeDrifts(resourceSchema, discoveredAttributes(resource, previous.attributes), d.State().Attributes)
+				if len(change.Changes) == 0 {
+					change.Status = ExportChangeUnchanged
+					resource.isUnchanged = true
+				}
+			}
+			previous.status = change.Status
+		}
+	}
+
+	for _, previous := range ctx.previousExport.resources {
+		if previous.status == "" {
+			previous.status = ExportChangeDeleted
+			ctx.changeReport.Resources = append(ctx.changeReport.Resources, &ResourceChangeReport{
+				Address: fmt.Sprintf("%s.%s", previous.terraformClass, previous.terraformName),
+				Id:      previous.id,
+				Status:  ExportChangeDeleted,
+			})
+		}
+	}
+}
+
+// discoveredAttributes returns the attributes of the previous state that are discovered. The attributes of a resource that
+// are only returned by its Get operation are not in the summaries returned by its List operation, they are not changes.
+func discoveredAttributes(resource *OCIResource, attributes map[string]string) map[string]string {
+	result := map[string]string{}
+	for name, value := range attributes {
+		if _, discovered := resource.sourceAttributes[strings.SplitN(name, ".", 2)[0]]; discovered || name == "id" {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// getStateOutputFile returns the file the state is generated to. The state of an incremental export is generated to a
+// temporary file, so that the state of the previous export is kept until the state of the unchanged resources is merged.
+func (ctx *resourceDiscoveryContext) getStateOutputFile() string {
+	fileName := globalvar.DefaultStateFilename
+	if ctx.previousExport != nil {
+		fileName = globalvar.IncrementalStateFilename
+	}
+	return fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), fileName)
+}
+
+// removeGeneratedState removes the temporary state generated for an incremental export that has not been merged
+func (export *previousExport) removeGeneratedState(ctx *resourceDiscoveryContext) error {
+	stateOutputFile := ctx.getStateOutputFile()
+	if err := os.Remove(stateOutputFile); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("[ERROR] unable to delete the generated state file %s: %v", stateOutputFile, err)
+	}
+	return nil
+}
+
+// replaceState backs up the state of the previous export and replaces it with the merged state of the incremental export
+func (export *previousExport) replaceState(ctx *resourceDiscoveryContext) error {
+	backupFile := strings.TrimSuffix(export.statePath, globalvar.DefaultStateFilename) + globalvar.BackupStateFilename
+	content, err := ioutil.ReadFile(export.statePath)
+	if err != nil {
+		return fmt.Errorf("[ERROR] unable to read the previous state file %s: %v", export.statePath, err)
+	}
+	if err := ioutil.WriteFile(backupFile, content, 0644); err != nil {
+		return fmt.Errorf("[ERROR] unable to backup the previous state file %s: %v", export.statePath, err)
+	}
+	utils.Logf("[INFO] previous state backed up to file at: %s", backupFile)
+
+	if err := os.Rename(ctx.getStateOutputFile(), export.statePath); err != nil {
+		return fmt.Errorf("[ERROR] unable to replace the previous state file %s: %v", export.statePath, err)
+	}
+	utils.Logf("[INFO] state written to file at: %s", export.statePath)
+	return nil
+}
+
+// mergeState adds the state of the unchanged resources of the previous export to the state generated for the added
+// and changed resources. The lineage of the previous state is kept, so that the state is a new version of it.
+func (export *previousExport) mergeState(ctx *resourceDiscoveryContext) error {
+	stateOutputFile := ctx.getStateOutputFile()
+
+	state := make(map[string]json.RawMessage, len(export.state))
+	for key, value := range export.state {
+		state[key] = value
+	}
+	resources := []json.RawMessage{}
+	for _, previous := range export.resources {
+		if previous.status == ExportChangeUnchanged {
+			resources = append(resources, previous.raw)
+		}
+	}
+
+	if content, err := ioutil.ReadFile(stateOutputFile); err == nil {
+		generated := map[string]json.RawMessage{}
+		var generatedResources []json.RawMessage
+		if err := json.Unmarshal(content, &generated); err != nil {
+			return fmt.Errorf("[ERROR] invalid generated state file %s: %v", stateOutputFile, err)
+		}
+		if err := json.Unmarshal(generated["resources"], &generatedResources); err != nil {
+			return fmt.Errorf("[ERROR] invalid resources in generated state file %s: %v", stateOutputFile, err)
+		}
+		resources = append(resources, generatedResources...)
+		state["terraform_version"] = generated["terraform_version"]
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	var serial int64
+	json.Unmarshal(export.state["serial"], &serial)
+	state["serial"], _ = json.Marshal(serial + 1)
+	state["resources"], _ = json.Marshal(resources)
+
+	stateBytes, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(stateOutputFile, stateBytes, 0644); err != nil {
+		return fmt.Errorf("[ERROR] error writing state file at %s: %s", stateOutputFile, err.Error())
+	}
+	utils.Logf("[INFO] state of the unchanged resources merged to file at: %s", stateOutputFile)
+	return nil
+}
+
+// writeChangeReport writes the changes since the previous export to the output path and adds them to the summary
+func (ctx *resourceDiscoveryContext) writeChangeReport() error {
+	changesOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.ExportChangesFile)
+	content, err := json.MarshalIndent(ctx.changeReport, "", "  ")
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error marshalling change report to JSON: %v", err)
+	}
+	if err := ioutil.WriteFile(changesOutputFile, content, 0644); err != nil {
+		return err
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, "")
+	ctx.summaryStatements = append(ctx.summaryStatements, strings.Split(ctx.changeReport.String(), "\n")...)
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Changes since the previous export written to json file at: %s", changesOutputFile))
+	return nil
+}
+
+// String formats the report for humans, with the changed attributes by resource
+func (report *ExportChangeReport) String() string {
+	builder := &strings.Builder{}
+	counts := map[string]int{}
+	for _, resource := range report.Resources {
+		counts[resource.Status]++
+		switch resource.Status {
+		case ExportChangeAdded:
+			fmt.Fprintf(builder, "%s (%s) has been added\n", resource.Address, resource.Id)
+		case ExportChangeChanged:
+			fmt.Fprintf(builder, "%s (%s) has changed:\n", resource.Address, resource.Id)
+			for _, change := range resource.Changes {
+				fmt.Fprintf(builder, "  %s\n", change)
+			}
+		case ExportChangeDeleted:
+			fmt.Fprintf(builder, "%s (%s) has been deleted\n", resource.Address, resource.Id)
+		}
+	}
+	fmt.Fprintf(builder, "Incremental export summary: %d resources, %d added, %d changed, %d deleted, %d unchanged",
+		len(report.Resources), counts[ExportChangeAdded], counts[ExportChangeChanged], counts[ExportChangeDeleted], counts[ExportChangeUnchanged])
+	return builder.String()
+}
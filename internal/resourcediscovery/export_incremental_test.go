@@ -0,0 +1,343 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_incremental(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: true,
+		NativeState:   true,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+	}
+	err, status := RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	// Change the previous state: rename a parent, change another one, forget a child and add a deleted resource
+	stateFile := filepath.Join(outputDir, globalvar.DefaultStateFilename)
+	content, err := ioutil.ReadFile(stateFile)
+	assert.NoError(t, err)
+	previousState := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(content, &previousState))
+	resources := []interface{}{}
+	var addedChildId string
+	for _, item := range previousState["resources"].([]interface{}) {
+		resource := item.(map[string]interface{})
+		attributes := resource["instances"].([]interface{})[0].(map[string]interface{})["attributes"].(map[string]interface{})
+		switch attributes["id"] {
+		case getTestResourceId("parent", 0):
+			resource["name"] = "export_renamed"
+		case getTestResourceId("parent", 1):
+			attributes["a_string"] = "previous"
+		case getTestResourceId("parent", 2):
+			deleted := map[string]interface{}{}
+			content, _ := json.Marshal(resource)
+			json.Unmarshal(content, &deleted)
+			deleted["name"] = "export_deleted"
+			deleted["instances"].([]interface{})[0].(map[string]interface{})["attributes"].(map[string]interface{})["id"] = "ocid1.parent.deleted"
+			resources = append(resources, deleted)
+		}
+		if resource["type"] == "oci_test_child" && addedChildId == "" {
+			addedChildId = attributes["id"].(string)
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	previousState["resources"] = resources
+	content, err = json.Marshal(previousState)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(stateFile, content, 0644))
+
+	// Re-export as a new run of the command
+	resourceNameCount = map[string]int{}
+	args.Incremental = true
+	err, status = RunExportCommand(args)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, status)
+
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, globalvar.ExportChangesFile))
+	assert.NoError(t, err)
+	report := &ExportChangeReport{}
+	assert.NoError(t, json.Unmarshal(content, report))
+	assert.Equal(t, stateFile, report.PreviousStatePath)
+	assert.Len(t, report.Resources, len(parentResources)+len(childrenResources)+1)
+	changes := map[string]*ResourceChangeReport{}
+	counts := map[string]int{}
+	for _, resource := range report.Resources {
+		changes[resource.Id] = resource
+		counts[resource.Status]++
+	}
+	assert.Equal(t, ExportChangeUnchanged, changes[getTestResourceId("parent", 0)].Status)
+	assert.Equal(t, "oci_test_parent.export_renamed", changes[getTestResourceId("parent", 0)].Address, "the previous name should be kept")
+	assert.Equal(t, ExportChangeChanged, changes[getTestResourceId("parent", 1)].Status)
+	assert.Equal(t, "a_string", changes[getTestResourceId("parent", 1)].Changes[0].Attribute)
+	assert.Equal(t, "previous", *changes[getTestResourceId("parent", 1)].Changes[0].State)
+	assert.Equal(t, ExportChangeAdded, changes[addedChildId].Status)
+	assert.Equal(t, ExportChangeDeleted, changes["ocid1.parent.deleted"].Status)
+	assert.Equal(t, "oci_test_parent.export_deleted", changes["ocid1.parent.deleted"].Address)
+	assert.Equal(t, len(parentResources)+len(childrenResources)-2, counts[ExportChangeUnchanged])
+
+	config, err := ioutil.ReadFile(filepath.Join(outputDir, "compartment_testing.tf"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(config), `resource oci_test_parent export_renamed {`)
+	assert.NotContains(t, string(config), "export_deleted")
+
+	// The state has the unchanged resources of the previous state and the imported added and changed resources
+	state, err := readDriftState(stateFile)
+	assert.NoError(t, err)
+	assert.Len(t, state.Resources, len(parentResources)+len(childrenResources))
+	names := map[string]bool{}
+	for _, resource := range state.Resources {
+		names[resource.address(nil)] = true
+	}
+	assert.True(t, names["oci_test_parent.export_renamed"])
+	assert.False(t, names["oci_test_parent.export_deleted"])
+	currentState := map[string]interface{}{}
+	content, err = ioutil.ReadFile(stateFile)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(content, &currentState))
+	assert.Equal(t, previousState["lineage"], currentState["lineage"])
+	assert.Equal(t, previousState["serial"].(float64)+1, currentState["serial"])
+	_, err = os.Stat(filepath.Join(outputDir, globalvar.BackupStateFilename))
+	assert.NoError(t, err, "the previous state should be backed up")
+	_, err = os.Stat(filepath.Join(outputDir, globalvar.IncrementalStateFilename))
+	assert.True(t, os.IsNotExist(err), "the generated state should replace the previous state")
+
+	args.GenerateState = false
+	args.NativeState = false
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] incremental can only be specified along with generate_state")
+	assert.Equal(t, StatusFail, status)
+
+	args.GenerateState = true
+	args.IDs = []string{getTestResourceId("parent", 0)}
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] ids cannot be specified along with incremental")
+	assert.Equal(t, StatusFail, status)
+
+	args.IDs = nil
+	emptyDir := t.TempDir()
+	args.OutputDir = &emptyDir
+	err, status = RunExportCommand(args)
+	assert.Error(t, err, "the state of a previous export should be required")
+	assert.Equal(t, StatusFail, status)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRestorePreviousName(t *testing.T) {
+	resourceNameCount = map[string]int{}
+	defer func() { resourceNameCount = map[string]int{} }()
+	ctx := &resourceDiscoveryContext{previousExport: &previousExport{
+		byId:  map[string]*previousExportResource{"oci_test_parent:ocid1.parent.previous": {terraformClass: "oci_test_parent", terraformName: "export_a", id: "ocid1.parent.previous"}},
+		names: map[string]bool{"oci_test_parent.export_a": true},
+	}}
+	nameSchema := map[string]*schema.Schema{"display_name": {Type: schema.TypeString}}
+	discover := func(id string) *OCIResource {
+		resource := &OCIResource{TerraformResource: TerraformResource{id: id, terraformClass: "oci_test_parent"}}
+		name, err := generateTerraformNameFromResource(map[string]interface{}{"display_name": "a"}, nameSchema)
+		assert.NoError(t, err)
+		resource.terraformName = name
+		return resource
+	}
+
+	added := discover("ocid1.parent.added")
+	assert.Equal(t, "export_a", added.terraformName)
+	generated := discover("ocid1.parent.generated")
+	assert.Equal(t, "export_a_1", generated.terraformName)
+	ctx.restorePreviousName(added)
+	assert.Equal(t, "export_a_2", added.terraformName, "the name of the previous export and the names of this export should not be reused")
+	ctx.restorePreviousName(generated)
+	assert.Equal(t, "export_a_1", generated.terraformName)
+
+	later := discover("ocid1.parent.later")
+	ctx.restorePreviousName(later)
+	assert.Equal(t, "export_a_3", later.terraformName, "the restored names should not be generated again")
+	previous := discover("ocid1.parent.previous")
+	ctx.restorePreviousName(previous)
+	assert.Equal(t, "export_a", previous.terraformName)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCompareWithPreviousExport_importedState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	// The state imported by terraform has the attributes returned by Get, a_nested is not in the summaries of children
+	stateFile := filepath.Join(t.TempDir(), globalvar.DefaultStateFilename)
+	importedState := `{
+  "version": 4,
+  "terraform_version": "0.13.0",
+  "serial": 1,
+  "lineage": "3c5a9c1e-5d7e-4b9c-a4f2-1f6a8d2b7c11",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "oci_test_child",
+      "name": "export_child",
+      "provider": "provider[\"registry.terraform.io/hashicorp/oci\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "a_bool": true,
+            "a_float": 1.5,
+            "a_int": 1,
+            "a_list": ["a"],
+            "a_map": {"key1": "value1"},
+            "a_nested": [{"nested_bool": true, "nested_float": 2.5, "nested_int": 2, "nested_string": "nested"}],
+            "a_set": ["b"],
+            "a_string": "string",
+            "compartment_id": "ocid1.compartment.unchanged",
+            "id": "ocid1.child.unchanged",
+            "parent_id": "ocid1.parent.1",
+            "state": "AVAILABLE",
+            "time_created": "2021-01-01T00:00:00.000Z"
+          },
+          "private": "bnVsbA=="
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "oci_test_child",
+      "name": "export_child_1",
+      "provider": "provider[\"registry.terraform.io/hashicorp/oci\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "a_map": {"key1": "value1", "key2": "value2"},
+            "a_nested": [{"nested_bool": false, "nested_float": 0, "nested_int": 0, "nested_string": "nested"}],
+            "a_string": "previous",
+            "compartment_id": "ocid1.compartment.changed",
+            "id": "ocid1.child.changed",
+            "parent_id": "ocid1.parent.1",
+            "state": "AVAILABLE"
+          },
+          "private": "bnVsbA=="
+        }
+      ]
+    }
+  ]
+}`
+	assert.NoError(t, ioutil.WriteFile(stateFile, []byte(importedState), 0644))
+	previous, err := readPreviousExport(stateFile)
+	assert.NoError(t, err)
+
+	unchanged := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.child.unchanged", terraformClass: "oci_test_child", terraformName: "export_child"},
+		sourceAttributes: map[string]interface{}{
+			"a_bool":         true,
+			"a_float":        1.5,
+			"a_int":          1,
+			"a_list":         []interface{}{"a"},
+			"a_map":          map[string]interface{}{"key1": "value1"},
+			"a_set":          []interface{}{"b"},
+			"a_string":       "string",
+			"compartment_id": "ocid1.compartment.unchanged",
+			"parent_id":      "ocid1.parent.1",
+			"state":          "AVAILABLE",
+		},
+	}
+	changed := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.child.changed", terraformClass: "oci_test_child", terraformName: "export_child_1"},
+		sourceAttributes: map[string]interface{}{
+			"a_map":          map[string]interface{}{"key1": "value1"},
+			"a_string":       "string",
+			"compartment_id": "ocid1.compartment.changed",
+			"parent_id":      "ocid1.parent.1",
+			"state":          "AVAILABLE",
+		},
+	}
+	ctx := &resourceDiscoveryContext{previousExport: previous}
+	ctx.compareWithPreviousExport([]resourceDiscoveryStep{&resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: "testing", discoveredResources: []*OCIResource{unchanged, changed}},
+	}})
+
+	assert.Len(t, ctx.changeReport.Resources, 2)
+	assert.Equal(t, ExportChangeUnchanged, ctx.changeReport.Resources[0].Status, "the attributes that are not in the summary should not be changes")
+	assert.Empty(t, ctx.changeReport.Resources[0].Changes)
+	assert.True(t, unchanged.isUnchanged)
+	assert.Equal(t, ExportChangeChanged, ctx.changeReport.Resources[1].Status)
+	attributes := []string{}
+	for _, change := range ctx.changeReport.Resources[1].Changes {
+		attributes = append(attributes, change.Attribute)
+	}
+	assert.Equal(t, []string{"a_map.key2", "a_string"}, attributes, "only the discovered attributes should be compared")
+	assert.False(t, changed.isUnchanged)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitMergeState_previousStateKept(t *testing.T) {
+	outputDir := t.TempDir()
+	stateFile := filepath.Join(outputDir, globalvar.DefaultStateFilename)
+	previousContent := []byte(`{"version": 4, "serial": 3, "lineage": "lineage", "resources": []}`)
+	assert.NoError(t, ioutil.WriteFile(stateFile, previousContent, 0644))
+	previous, err := readPreviousExport(stateFile)
+	assert.NoError(t, err)
+	ctx := &resourceDiscoveryContext{ExportCommandArgs: &ExportCommandArgs{OutputDir: &outputDir}, previousExport: previous}
+	generatedFile := filepath.Join(outputDir, globalvar.IncrementalStateFilename)
+	assert.Equal(t, generatedFile, ctx.getStateOutputFile())
+
+	// A generated state that cannot be merged does not replace the previous state
+	assert.NoError(t, ioutil.WriteFile(generatedFile, []byte(`{"resources": "invalid"}`), 0644))
+	assert.Error(t, previous.mergeState(ctx))
+	assert.NoError(t, previous.removeGeneratedState(ctx))
+	content, err := ioutil.ReadFile(stateFile)
+	assert.NoError(t, err)
+	assert.Equal(t, previousContent, content, "the previous state should be kept")
+	_, err = os.Stat(filepath.Join(outputDir, globalvar.BackupStateFilename))
+	assert.True(t, os.IsNotExist(err), "the previous state should not be backed up")
+	_, err = os.Stat(generatedFile)
+	assert.True(t, os.IsNotExist(err), "the generated state should be removed")
+
+	assert.NoError(t, ioutil.WriteFile(generatedFile, []byte(`{"terraform_version": "0.13.0", "resources": []}`), 0644))
+	assert.NoError(t, previous.mergeState(ctx))
+	assert.NoError(t, previous.replaceState(ctx))
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, globalvar.BackupStateFilename))
+	assert.NoError(t, err)
+	assert.Equal(t, previousContent, content, "the previous state should be backed up")
+	state, err := readDriftState(stateFile)
+	assert.NoError(t, err)
+	assert.Empty(t, state.Resources)
+	content, err = ioutil.ReadFile(stateFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"serial": 4`)
+	_, err = os.Stat(generatedFile)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	// The native state is written in the format of Terraform 0.13, the first version addressing providers by source
+	nativeStateTerraformVersion = "0.13.0"
+	nativeStateProvider         = `provider["registry.terraform.io/hashicorp/oci"]`
+)
+
+// nativeState is a Terraform state file in the v4 format
+type nativeState struct {
+	Version          int                    `json:"version"`
+	TerraformVersion string                 `json:"terraform_version"`
+	Serial           int64                  `json:"serial"`
+	Lineage          string                 `json:"lineage"`
+	Outputs          map[string]interface{} `json:"outputs"`
+	Resources        []nativeStateResource  `json:"resources"`
+}
+
+type nativeStateResource struct {
+	Mode      string                `json:"mode"`
+	Type      string                `json:"type"`
+	Name      string                `json:"name"`
+	Provider  string                `json:"provider"`
+	Instances []nativeStateInstance `json:"instances"`
+}
+
+type nativeStateInstance struct {
+	SchemaVersion int             `json:"schema_version"`
+	Attributes    json.RawMessage `json:"attributes"`
+	Private       []byte          `json:"private,omitempty"`
+}
+
+/*
+generateNativeState is used if the state is generated without the terraform CLI
+- builds the state of each discovered resource from the attributes read during discovery, with the schema of the resource
+- runs the importer of each resource, as terraform import does, for the resources whose import ID is parsed into attributes
+- writes the state file in the v4 format
+*/
+func generateNativeState(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	defer elapsed("generating state natively", nil, 0)()
+
+	lineage, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	state := &nativeState{
+		Version:          4,
+		TerraformVersion: nativeStateTerraformVersion,
+		Serial:           1,
+		Lineage:          lineage,
+		Outputs:          map[string]interface{}{},
+		Resources:        []nativeStateResource{},
+	}
+
+	ctx.discoveredResources = []*OCIResource{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			ctx.discoveredResources = append(ctx.discoveredResources, resource)
+
+			stateResource, err := getNativeStateResource(ctx, resource)
+			if err != nil {
+				utils.Logf("[ERROR] unable to generate the state of resource '%s': %s", resource.getTerraformReference(), err.Error())
+				ctx.addImportError(resource, fmt.Errorf("[ERROR] unable to generate the state of resource '%s' at id '%s': %s Any references to this resource have been replaced with hard coded values in generated configurations", resource.getTerraformReference(), resource.getImportId(), err.Error()))
+				continue
+			}
+			if stateResource != nil {
+				state.Resources = append(state.Resources, *stateResource)
+			}
+		}
+	}
+
+	if len(state.Resources) == 0 {
+		utils.Logf("[INFO] ~~~~~~ no resources were imported to the state file ~~~~~~")
+		return nil
+	}
+
+	stateOutputFile := ctx.getStateOutputFile()
+	stateBytes, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(stateOutputFile, stateBytes, 0644); err != nil {
+		return fmt.Errorf("[ERROR] error writing state file at %s: %s", stateOutputFile, err.Error())
+	}
+	utils.Logf("[INFO] state written to file at: %s", stateOutputFile)
+	return nil
+}
+
+// getNativeStateResource returns the state of a discovered resource, nil if the resource cannot be imported
+func getNativeStateResource(ctx *resourceDiscoveryContext, resource *OCIResource) (*nativeStateResource, error) {
+	utils.Logf("[INFO] ===> Generating the state of resource '%s'", resource.getTerraformReference())
+
+	resourceSchema, exists := resourcesMap[resource.terraformClass]
+	if !exists {
+		utils.Logf("[INFO] skip importing '%s' since it is not a Terraform OCI resource", resource.getTerraformReference())
+		return nil, nil
+	}
+	if resourceSchema.Importer == nil {
+		utils.Logf("[WARN] unable to import '%s' because import is not supported for '%s'", resource.getTerraformReference(), resource.terraformClass)
+		return nil, nil
+	}
+
+	if resource.isUnchanged {
+		utils.Logf("[INFO] skip importing '%s' since it has not changed since the previous export", resource.getTerraformReference())
+		return nil, nil
+	}
+
+	d := getNativeResourceData(resourceSchema, resource, resource.getImportId())
+	imported := []*schema.ResourceData{d}
+	var err error
+	if resourceSchema.Importer.StateContext != nil {
+		imported, err = resourceSchema.Importer.StateContext(context.Background(), d, ctx.clients)
+	} else if resourceSchema.Importer.State != nil {
+		imported, err = resourceSchema.Importer.State(d, ctx.clients)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("the importer of '%s' returned no resource", resource.terraformClass)
+	}
+
+	instanceState := imported[0].State()
+	if instanceState == nil || instanceState.ID == "" {
+		return nil, fmt.Errorf("the importer of '%s' voided the state", resource.terraformClass)
+	}
+	instanceState.Attributes["id"] = instanceState.ID
+
+	impliedType := resourceSchema.CoreConfigSchema().ImpliedType()
+	value, err := instanceState.AttrsAsObjectValue(impliedType)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := ctyjson.Marshal(value, impliedType)
+	if err != nil {
+		return nil, err
+	}
+	var private []byte
+	if len(instanceState.Meta) > 0 {
+		if private, err = json.Marshal(instanceState.Meta); err != nil {
+			return nil, err
+		}
+	}
+
+	return &nativeStateResource{
+		Mode:     "managed",
+		Type:     resource.terraformClass,
+		Name:     resource.terraformName,
+		Provider: nativeStateProvider,
+		Instances: []nativeStateInstance{{
+			SchemaVersion: resourceSchema.SchemaVersion,
+			Attributes:    attributes,
+			Private:       private,
+		}},
+	}, nil
+}
+
+// getNativeResourceData returns the data of a discovered resource in the schema of the resource, with the given ID
+func getNativeResourceData(resourceSchema *schema.Resource, resource *OCIResource, id string) *schema.ResourceData {
+	d := resourceSchema.Data(nil)
+	d.SetId(id)
+	for name, value := range resource.sourceAttributes {
+		if _, exists := resourceSchema.Schema[name]; !exists {
+			continue
+		}
+		if err := d.Set(name, getNativeStateValue(value)); err != nil {
+			utils.Debugf("[DEBUG] attribute '%s' of '%s' is not written to the state: %v", name, resource.getTerraformReference(), err)
+		}
+	}
+	return d
+}
+
+// getNativeStateValue returns the value of an attribute with the references to other resources resolved
+func getNativeStateValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case InterpolationString:
+		return v.value
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = getNativeStateValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = getNativeStateValue(item)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// testCompositeResource is a resource whose import ID is parsed into its attributes by its importer
+func testCompositeResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 4 || parts[0] != "parents" || parts[2] != "composites" {
+					return nil, fmt.Errorf("invalid import id %s", d.Id())
+				}
+				d.Set("parent_id", parts[1])
+				d.Set("name", parts[3])
+				d.SetId(fmt.Sprintf("%s/%s", parts[1], parts[3]))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		SchemaVersion: 2,
+		Schema: map[string]*schema.Schema{
+			"parent_id":  {Type: schema.TypeString, Required: true},
+			"name":       {Type: schema.TypeString, Required: true},
+			"size":       {Type: schema.TypeInt, Optional: true},
+			"backend_id": {Type: schema.TypeString, Optional: true},
+			"tags":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGenerateNativeState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	resourcesMap["oci_test_composite"] = testCompositeResource()
+	defer delete(resourcesMap, "oci_test_composite")
+	sem = make(chan struct{}, 1)
+
+	ctx := getTestCtx()
+	outputDir := t.TempDir()
+	ctx.OutputDir = &outputDir
+	ctx.Services = []string{"compartment_testing"}
+	ctx.GenerateState = true
+	ctx.NativeState = true
+	steps, err := getDiscoverResourceSteps(ctx)
+	assert.NoError(t, err)
+	discoverResources(ctx, steps)
+
+	root := getRootCompartmentResource()
+	composite := &OCIResource{
+		TerraformResource: TerraformResource{id: "composite", importId: "parents/ocid1.parent.abcdefghiklmnop.0/composites/first", terraformClass: "oci_test_composite", terraformName: "export_first"},
+		sourceAttributes: map[string]interface{}{
+			"size":       2,
+			"backend_id": InterpolationString{resourceReference: "oci_test_parent.export_parent", interpolation: "oci_test_parent.export_parent.id", value: "ocid1.parent.abcdefghiklmnop.1"},
+			"tags":       []interface{}{"blue", "green"},
+			"unknown":    "not in the schema",
+		},
+		parent: root,
+	}
+	invalid := &OCIResource{
+		TerraformResource: TerraformResource{id: "invalid", terraformClass: "oci_test_composite", terraformName: "export_invalid"},
+		parent:            root,
+	}
+	unsupported := &OCIResource{
+		TerraformResource: TerraformResource{id: "unsupported", terraformClass: "oci_test_unsupported", terraformName: "export_unsupported"},
+		parent:            root,
+	}
+	steps = append(steps, &resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+		ctx: ctx, name: "composites", discoveredResources: []*OCIResource{composite, invalid, unsupported},
+	}})
+
+	assert.NoError(t, generateNativeState(ctx, steps))
+	assert.False(t, composite.isErrorResource)
+	assert.True(t, invalid.isErrorResource, "a resource that cannot be imported should be skipped in the configuration")
+	assert.True(t, ctx.isImportError)
+	assert.Len(t, ctx.errorList.errors, 1)
+	assert.Len(t, ctx.discoveredResources, len(parentResources)+len(childrenResources)+3)
+
+	// Decode the state as Terraform does, through the UpgradeResourceState of the GRPC server of the provider
+	content, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.DefaultStateFilename))
+	assert.NoError(t, err)
+	var state nativeState
+	assert.NoError(t, json.Unmarshal(content, &state))
+	assert.Len(t, state.Resources, len(parentResources)+len(childrenResources)+1)
+	server := schema.NewGRPCProviderServer(&schema.Provider{ResourcesMap: resourcesMap})
+	for _, resource := range state.Resources {
+		assert.Equal(t, "managed", resource.Mode)
+		assert.Len(t, resource.Instances, 1)
+		resourceSchema := resourcesMap[resource.Type]
+		instance := resource.Instances[0]
+		assert.Equal(t, resourceSchema.SchemaVersion, instance.SchemaVersion)
+
+		upgraded, err := server.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+			TypeName: resource.Type,
+			Version:  int64(instance.SchemaVersion),
+			RawState: &tfprotov5.RawState{JSON: instance.Attributes},
+		})
+		assert.NoError(t, err)
+		for _, diagnostic := range upgraded.Diagnostics {
+			assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, diagnostic.Severity, "the state of %s.%s should be upgraded: %s: %s", resource.Type, resource.Name, diagnostic.Summary, diagnostic.Detail)
+		}
+		value, err := ctymsgpack.Unmarshal(upgraded.UpgradedState.MsgPack, resourceSchema.CoreConfigSchema().ImpliedType())
+		assert.NoError(t, err)
+		instanceState, err := resourceSchema.ShimInstanceStateFromValue(value)
+		assert.NoError(t, err, "the state of %s.%s should be decoded", resource.Type, resource.Name)
+
+		// The SDK reads the meta of the resource, e.g. its schema version and timeouts, from the private state
+		var meta map[string]interface{}
+		assert.NoError(t, json.Unmarshal(instance.Private, &meta), "the private state of %s.%s should be the meta of the resource", resource.Type, resource.Name)
+		assert.Equal(t, strconv.Itoa(instance.SchemaVersion), meta["schema_version"], "the meta of %s.%s should have the schema version of the state", resource.Type, resource.Name)
+
+		switch resource.Type {
+		case "oci_test_composite":
+			assert.Equal(t, "ocid1.parent.abcdefghiklmnop.0/first", instanceState.ID)
+			assert.Equal(t, "ocid1.parent.abcdefghiklmnop.0", instanceState.Attributes["parent_id"])
+			assert.Equal(t, "first", instanceState.Attributes["name"])
+			assert.Equal(t, "2", instanceState.Attributes["size"])
+			assert.Equal(t, "ocid1.parent.abcdefghiklmnop.1", instanceState.Attributes["backend_id"], "references should be resolved to their values")
+			assert.Equal(t, "2", instanceState.Attributes["tags.#"])
+		case "oci_test_parent":
+			expected := parentResources[instanceState.ID]
+			assert.NotNil(t, expected, "unexpected parent %s", instanceState.ID)
+			assert.Equal(t, expected["a_string"], instanceState.Attributes["a_string"])
+			assert.Equal(t, fmt.Sprint(expected["a_int"]), instanceState.Attributes["a_int"])
+			assert.Equal(t, fmt.Sprint(len(expected["a_nested"].([]interface{}))), instanceState.Attributes["a_nested.#"])
+			assert.Equal(t, resourceDiscoveryTestActiveLifecycle, instanceState.Attributes["state"])
+		case "oci_test_child":
+			expected := childrenResources[instanceState.ID]
+			assert.NotNil(t, expected, "unexpected child %s", instanceState.ID)
+			assert.Equal(t, expected["parent_id"], instanceState.Attributes["parent_id"])
+		default:
+			t.Errorf("unexpected resource type %s in the state", resource.Type)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_nativeState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		if varName == globalvar.TerraformBinPathName {
+			return filepath.Join(t.TempDir(), "missing", "terraform")
+		}
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir := t.TempDir()
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: true,
+		NativeState:   true,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+	}
+	err, status := RunExportCommand(args)
+	assert.NoError(t, err, "the terraform CLI should not be needed")
+	assert.Equal(t, StatusSuccess, status)
+	for _, file := range []string{"compartment_testing.tf", globalvar.DefaultStateFilename} {
+		_, err := os.Stat(filepath.Join(outputDir, file))
+		assert.NoError(t, err, "%s should be generated", file)
+	}
+
+	args.GenerateState = false
+	err, status = RunExportCommand(args)
+	assert.EqualError(t, err, "[ERROR] native_state can only be specified along with generate_state")
+	assert.Equal(t, StatusFail, status)
+}
@@ -23,11 +23,11 @@ import (
 
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
 
-	"github.com/hashicorp/hcl2/hclwrite"
 )
 
 var isInitDone bool
@@ -53,7 +53,7 @@ type TerraformResourceHints struct {
 	findResourcesOverrideFn func(*resourceDiscoveryContext, *TerraformResourceAssociation, *OCIResource, *TerraformResourceGraph) ([]*OCIResource, error)
 
 	// Hints to help with generating HCL representation from this resource
-	getHCLStringOverrideFn func(*strings.Builder, *OCIResource, map[string]string) error // Custom function for generating HCL syntax for the resource
+	getConfigOverrideFn func(*configBody, *OCIResource, map[string]string) error // Custom function for generating the configuration of the resource
 
 	// Hints for adding default value to HCL representation for attributes not found in resource discovery
 	defaultValuesForMissingAttributes map[string]interface{}
@@ -103,6 +103,9 @@ type resourceDiscoveryContext struct {
 	terraform                   *tfexec.Terraform
 	clients                     *tf_client.OracleClients
 	expectedResourceIds         map[string]bool
+	filters                     []*resourceFilter
+	previousExport              *previousExport
+	changeReport                *ExportChangeReport
 	tenancyOcid                 string
 	discoveredResources         []*OCIResource
 	summaryStatements           []string
@@ -250,6 +253,19 @@ func createResourceDiscoveryContext(clients *tf_client.OracleClients, args *Expo
 		referenceMap[*result.CompartmentId] = tfHclVersion.getVarHclString("compartment_ocid")
 	}
 
+	filters, err := parseResourceFilters(args.Filters)
+	if err != nil {
+		return result, err
+	}
+	result.filters = filters
+
+	if args.Incremental {
+		stateFile := fmt.Sprintf("%s%s%s", *args.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+		if result.previousExport, err = readPreviousExport(stateFile); err != nil {
+			return result, err
+		}
+	}
+
 	result.expectedResourceIds = convertStringSliceToSet(args.IDs, true)
 
 	re := regexp.MustCompile(`oci_([^:]+):(.+$)`)
@@ -261,8 +277,8 @@ func createResourceDiscoveryContext(clients *tf_client.OracleClients, args *Expo
 			break
 		}
 	}
-	// validate terraform version and initialize terraform for import - only required if generating state file
-	if args.GenerateState {
+	// validate terraform version and initialize terraform for import - only required if generating state file with the terraform CLI
+	if args.GenerateState && !args.NativeState {
 		if tf, terraformCLIPath, err := createTerraformStruct(args); err != nil {
 			return result, err
 		} else {
@@ -389,7 +405,7 @@ func (r *resourceDiscoveryBaseStep) writeTmpState() error {
 			for _, res := range resources {
 				fileName := tmpStateOutputFilePrefix + fmt.Sprint(chunkIndex)
 				importResource(r.ctx, res, fileName)
-				if res.terraformTypeInfo != nil && !res.terraformTypeInfo.isDataSource {
+				if res.terraformTypeInfo != nil && !res.terraformTypeInfo.isDataSource && !res.isUnchanged {
 					isAllDataSourceLock.Lock()
 					isAllDataSources = false
 					isAllDataSourceLock.Unlock()
@@ -402,7 +418,8 @@ func (r *resourceDiscoveryBaseStep) writeTmpState() error {
 	}
 	// wait for all chunks to finish importing resources
 	importWg.Wait()
-	// The found resource only include the data sources (ADs and namespaces) that resource discovery adds
+	// The found resource only include the data sources (ADs and namespaces) that resource discovery adds, or the
+	// resources whose state is kept from the previous export
 	if isAllDataSources {
 		return nil
 	}
@@ -419,21 +436,16 @@ func (r *resourceDiscoveryBaseStep) writeTmpState() error {
 func (r *resourceDiscoveryBaseStep) writeTmpConfigurationForImport() error {
 	defer elapsed(fmt.Sprintf("writing temp configuration for %d %s resources", len(r.getDiscoveredResources()), r.name), nil, 0)()
 	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-
-	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
-	if err != nil {
-		return err
-	}
 
-	// Build the HCL config
-	builder := &strings.Builder{}
-	builder.WriteString("## This is tmp config to run import for resources\n\n")
+	// Build the config
+	config := &configBody{}
+	config.addComment("# This is tmp config to run import for resources")
+	config.addBlankLine()
 	for _, resource := range r.discoveredResources {
 		if resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource {
-			builder.WriteString(fmt.Sprintf("data %s %s {}\n\n", resource.terraformClass, resource.terraformName))
+			config.addBlock("data", resource.terraformClass, resource.terraformName)
 		} else {
-			builder.WriteString(fmt.Sprintf("resource %s %s {}\n\n", resource.terraformClass, resource.terraformName))
+			config.addBlock("resource", resource.terraformClass, resource.terraformName)
 		}
 
 		r.ctx.ctxLock.Lock()
@@ -441,38 +453,21 @@ func (r *resourceDiscoveryBaseStep) writeTmpConfigurationForImport() error {
 		r.ctx.ctxLock.Unlock()
 	}
 
-	_, err = file.WriteString(string(builder.String()))
-	if err != nil {
-		_ = file.Close()
-		return err
-	}
-
-	if fErr := file.Close(); fErr != nil {
-		return fErr
-	}
-
-	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
-		return err
-	}
-	return nil
+	_, err := writeConfigFile(config, configOutputFile)
+	return err
 }
 
 func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	defer elapsed(fmt.Sprintf("writing actual configuration for %d %s resources", len(r.getDiscoveredResources()), r.name), nil, 0)()
 	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-
-	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
-	if err != nil {
-		return err
-	}
 
-	// Build the HCL config
+	// Build the config
 	// Note that we still build a TF file even if no resources were discovered for this TF file.
 	// A user may run this command multiple times and may see stale resources if we don't overwrite the file with
 	// an empty one.
-	builder := &strings.Builder{}
-	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	config := &configBody{}
+	config.addComment("# This configuration was generated by terraform-provider-oci")
+	config.addBlankLine()
 
 	exportedResourceCount := 0
 	for _, resource := range r.discoveredResources {
@@ -480,10 +475,13 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 		// Skip writing the config for resources for which import command failed
 		if !resource.isErrorResource {
 			utils.Logf("[INFO] ===> Generating resource '%s'", resource.getTerraformReference())
-			if err := resource.getHCLString(builder, referenceMap); err != nil {
-				_ = file.Close()
+			if err := resource.getConfig(config, referenceMap); err != nil {
 				return err
 			}
+			// Terraform only accepts import blocks in the root module, where the compartment tree export writes them
+			if resource.isImportable() && !r.ctx.CompartmentTree {
+				tfHclVersion.addImportBlock(config, resource.getTerraformReference(), resource.getImportId())
+			}
 
 			if resource.terraformTypeInfo != nil && len(resource.terraformTypeInfo.ignorableRequiredMissingAttributes) > 0 {
 				attributes := make([]string, 0, len(resource.terraformTypeInfo.ignorableRequiredMissingAttributes))
@@ -510,20 +508,8 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 		}
 	}
 
-	// Format the HCL config
-	formattedString := hclwrite.Format([]byte(builder.String()))
-
-	_, err = file.WriteString(string(formattedString))
+	configOutputFile, err := writeConfigFile(config, configOutputFile)
 	if err != nil {
-		_ = file.Close()
-		return err
-	}
-
-	if fErr := file.Close(); fErr != nil {
-		return fErr
-	}
-
-	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
 		return err
 	}
 
@@ -641,14 +627,14 @@ func (r *resourceDiscoveryWithTargetIds) discover() error {
 
 		utils.Logf("===> Finding resource with ID '%s' and type '%s'", resourceId, resourceClass)
 		resourceSchema, exists := resourcesMap[resourceClass]
-		if !exists || resourceSchema.Read == nil {
+		if !exists || !tfresource.HasReadFunction(resourceSchema) {
 			utils.Logf("[WARN] No valid resource schema could be found. Skipping.")
 			continue
 		}
 
 		d := resourceSchema.Data(nil)
 		d.SetId(resourceId)
-		if err := resourceSchema.Read(d, r.ctx.clients); err != nil {
+		if err := tfresource.ReadResourceSchema(context.Background(), resourceSchema, d, r.ctx.clients); err != nil {
 			utils.Logf("[WARN] Unable to read resource due to error: %v", err)
 			continue
 		}
@@ -786,7 +772,7 @@ func init() {
 	exportIdentityAvailabilityDomainHints.resourceAbbreviation = "ad"
 	exportIdentityAvailabilityDomainHints.alwaysExportable = true
 	exportIdentityAvailabilityDomainHints.processDiscoveredResourcesFn = processAvailabilityDomains
-	exportIdentityAvailabilityDomainHints.getHCLStringOverrideFn = getAvailabilityDomainHCLDatasource
+	exportIdentityAvailabilityDomainHints.getConfigOverrideFn = getAvailabilityDomainConfigDatasource
 	exportIdentityAuthenticationPolicyHints.processDiscoveredResourcesFn = processIdentityAuthenticationPolicies
 	exportIdentityTagHints.findResourcesOverrideFn = findIdentityTags
 	exportIdentityTagHints.processDiscoveredResourcesFn = processTagDefinitions
@@ -794,7 +780,7 @@ func init() {
 	exportLoggingLogHints.getIdFn = getLogId
 
 	exportObjectStorageNamespaceHints.processDiscoveredResourcesFn = processObjectStorageNamespace
-	exportObjectStorageNamespaceHints.getHCLStringOverrideFn = getObjectStorageNamespaceHCLDatasource
+	exportObjectStorageNamespaceHints.getConfigOverrideFn = getObjectStorageNamespaceConfigDatasource
 	exportObjectStorageNamespaceHints.alwaysExportable = true
 	exportObjectStorageObjectHints.requireResourceRefresh = true
 	exportObjectStoragePreauthenticatedRequestHints.processDiscoveredResourcesFn = processObjectStoragePreauthenticatedRequest
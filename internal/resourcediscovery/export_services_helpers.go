@@ -107,8 +107,8 @@ func findDnsRrset(ctx *resourceDiscoveryContext, tfMeta *TerraformResourceAssoci
 				terraformClass: tfMeta.resourceClass,
 				terraformName:  fmt.Sprintf("%s_%s", parent.parent.terraformName, *record.RecordHash),
 			},
-			getHclStringFn: getHclStringFromGenericMap,
-			parent:         parent,
+			getConfigFn: getConfigFromGenericMap,
+			parent:      parent,
 		}
 		resources = append(resources, resource)
 	}
@@ -391,25 +391,23 @@ func processObjectStorageNamespace(ctx *resourceDiscoveryContext, resources []*O
 	return resources, nil
 }
 
-func getAvailabilityDomainHCLDatasource(builder *strings.Builder, ociRes *OCIResource, varMap map[string]string) error {
-	builder.WriteString(fmt.Sprintf("data %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
+func getAvailabilityDomainConfigDatasource(config *configBody, ociRes *OCIResource, varMap map[string]string) error {
+	datasourceBlock := config.addBlock("data", ociRes.terraformClass, ociRes.terraformName)
 
-	builder.WriteString(fmt.Sprintf("compartment_id = %v\n", varMap[ociRes.compartmentId]))
+	datasourceBlock.body.addAttribute("compartment_id", referenceExpression(varMap[ociRes.compartmentId]))
 
 	adIndex, ok := ociRes.sourceAttributes["index"]
 	if !ok {
 		return fmt.Errorf("[ERROR] no index found for availability domain '%s'", ociRes.getTerraformReference())
 	}
-	builder.WriteString(fmt.Sprintf("ad_number = \"%v\"\n", adIndex.(int)))
-	builder.WriteString("}\n")
+	datasourceBlock.body.addAttribute("ad_number", literalExpression(fmt.Sprintf("%v", adIndex.(int))))
 
 	return nil
 }
 
-func getObjectStorageNamespaceHCLDatasource(builder *strings.Builder, ociRes *OCIResource, varMap map[string]string) error {
-	builder.WriteString(fmt.Sprintf("data %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
-	builder.WriteString(fmt.Sprintf("compartment_id = %v\n", varMap[ociRes.compartmentId]))
-	builder.WriteString("}\n")
+func getObjectStorageNamespaceConfigDatasource(config *configBody, ociRes *OCIResource, varMap map[string]string) error {
+	datasourceBlock := config.addBlock("data", ociRes.terraformClass, ociRes.terraformName)
+	datasourceBlock.body.addAttribute("compartment_id", referenceExpression(varMap[ociRes.compartmentId]))
 
 	return nil
 }
@@ -711,8 +709,8 @@ func findNetworkLoadBalancerListeners(ctx *resourceDiscoveryContext, tfMeta *Ter
 				terraformClass: tfMeta.resourceClass,
 				terraformName:  fmt.Sprintf("%s_%s", parent.parent.terraformName, listenerName),
 			},
-			getHclStringFn: getHclStringFromGenericMap,
-			parent:         parent,
+			getConfigFn: getConfigFromGenericMap,
+			parent:      parent,
 		}
 
 		if !parent.omitFromExport {
@@ -804,8 +802,8 @@ func findIdentityTags(ctx *resourceDiscoveryContext, tfMeta *TerraformResourceAs
 				id:             d.Id(),
 				terraformClass: tfMeta.resourceClass,
 			},
-			getHclStringFn: getHclStringFromGenericMap,
-			parent:         parent,
+			getConfigFn: getConfigFromGenericMap,
+			parent:      parent,
 		}
 
 		if resource.terraformName, err = generateTerraformNameFromResource(resource.sourceAttributes, tagResource.Schema); err != nil {
@@ -862,8 +860,8 @@ func findLoadBalancerListeners(ctx *resourceDiscoveryContext, tfMeta *TerraformR
 				terraformClass: tfMeta.resourceClass,
 				terraformName:  fmt.Sprintf("%s_%s", parent.parent.terraformName, listenerName),
 			},
-			getHclStringFn: getHclStringFromGenericMap,
-			parent:         parent,
+			getConfigFn: getConfigFromGenericMap,
+			parent:      parent,
 		}
 
 		if !parent.omitFromExport {
@@ -937,8 +935,8 @@ func findLogAnalyticsObjectCollectionRules(ctx *resourceDiscoveryContext, tfMeta
 				id:             d.Id(),
 				terraformClass: tfMeta.resourceClass,
 			},
-			getHclStringFn: getHclStringFromGenericMap,
-			parent:         parent,
+			getConfigFn: getConfigFromGenericMap,
+			parent:      parent,
 		}
 
 		if resource.terraformName, err = generateTerraformNameFromResource(resource.sourceAttributes, logAnalyticsObjectCollectionRuleResource.Schema); err != nil {
@@ -11,6 +11,7 @@ type TfVersionEnum string
 const (
 	TfVersion11 TfVersionEnum = "0.11"
 	TfVersion12 TfVersionEnum = "0.12"
+	TfVersion15 TfVersionEnum = "1.5"
 )
 
 type TfHclVersion interface {
@@ -20,6 +21,8 @@ type TfHclVersion interface {
 	getDataSourceHclString(string, string) string
 	getSingleExpHclString(string) string
 	getDoubleExpHclString(string, string) string
+	addImportBlock(*configBody, string, string)
+	addTerraformBlock(*configBody)
 }
 
 type TfHclVersion11 struct {
@@ -50,6 +53,12 @@ func (tfversion *TfHclVersion11) getDoubleExpHclString(expString1 string, expStr
 	return fmt.Sprintf("\"${%s.%s}\"", expString1, expString2)
 }
 
+func (tfversion *TfHclVersion11) addImportBlock(config *configBody, address string, importId string) {
+}
+
+func (tfversion *TfHclVersion11) addTerraformBlock(config *configBody) {
+}
+
 type TfHclVersion12 struct {
 	Value TfVersionEnum
 }
@@ -77,3 +86,33 @@ func (tfversion *TfHclVersion12) getSingleExpHclString(expString string) string
 func (tfversion *TfHclVersion12) getDoubleExpHclString(expString1 string, expString2 string) string {
 	return fmt.Sprintf("%s.%s", expString1, expString2)
 }
+
+func (tfversion *TfHclVersion12) addImportBlock(config *configBody, address string, importId string) {
+}
+
+func (tfversion *TfHclVersion12) addTerraformBlock(config *configBody) {
+}
+
+// TfHclVersion15 generates configurations for Terraform 1.5 and later, with the expressions of Terraform 0.12 and an
+// import block adopting each discovered resource
+type TfHclVersion15 struct {
+	TfHclVersion12
+}
+
+func (tfversion *TfHclVersion15) toString() string {
+	return "1.5"
+}
+
+func (tfversion *TfHclVersion15) addImportBlock(config *configBody, address string, importId string) {
+	importBlock := config.addBlock("import")
+	importBlock.body.addAttribute("to", traversalExpression(address))
+	importBlock.body.addAttribute("id", literalExpression(importId))
+}
+
+func (tfversion *TfHclVersion15) addTerraformBlock(config *configBody) {
+	terraformBlock := config.addBlock("terraform")
+	terraformBlock.body.addAttribute("required_version", literalExpression(">= 1.5.0"))
+	provider := &configBody{}
+	provider.addAttribute("source", literalExpression("hashicorp/oci"))
+	terraformBlock.body.addBlock("required_providers").body.addAttribute("oci", provider)
+}
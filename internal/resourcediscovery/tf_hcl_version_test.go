@@ -5,6 +5,8 @@ package resourcediscovery
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // issue-routing-tag: terraform/default
@@ -395,3 +397,34 @@ func TestUnitTfHclVersion12_getDoubleExpHclString(t *testing.T) {
 		})
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitTfHclVersion15_getHclStrings(t *testing.T) {
+	tfversion := &TfHclVersion15{TfHclVersion12: TfHclVersion12{Value: TfVersion15}}
+	assert.Equal(t, "1.5", tfversion.toString())
+	assert.Equal(t, "reference", tfversion.getReference("reference"))
+	assert.Equal(t, "var.variableName", tfversion.getVarHclString("variableName"))
+	assert.Equal(t, "data.oci_core_images.export_images", tfversion.getDataSourceHclString("oci_core_images", "export_images"))
+	assert.Equal(t, "exp", tfversion.getSingleExpHclString("exp"))
+	assert.Equal(t, "exp1.exp2", tfversion.getDoubleExpHclString("exp1", "exp2"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitTfHclVersion15_addImportBlock(t *testing.T) {
+	tfHclVersion = &TfHclVersion15{TfHclVersion12: TfHclVersion12{Value: TfVersion15}}
+	config := &configBody{}
+	tfHclVersion.addImportBlock(config, "oci_core_vcn.export_vcn", "ocid1.vcn.oc1")
+	tfHclVersion.addImportBlock(config, "oci_test.export_test", "id/${value}")
+	assert.Equal(t, "import {\nto = oci_core_vcn.export_vcn\nid = \"ocid1.vcn.oc1\"\n}\n\nimport {\nto = oci_test.export_test\nid = \"id/$${value}\"\n}\n\n", config.getHCLString())
+
+	config = &configBody{}
+	tfHclVersion.addTerraformBlock(config)
+	assert.Contains(t, config.getHCLString(), "required_version = \">= 1.5.0\"")
+
+	for _, legacy := range []TfHclVersion{&TfHclVersion11{Value: TfVersion11}, &TfHclVersion12{Value: TfVersion12}} {
+		config = &configBody{}
+		legacy.addImportBlock(config, "oci_core_vcn.export_vcn", "ocid1.vcn.oc1")
+		legacy.addTerraformBlock(config)
+		assert.Empty(t, config.items, "import blocks are not supported before Terraform 1.5")
+	}
+}
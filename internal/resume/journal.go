@@ -0,0 +1,148 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Journal is a file of the creations that were accepted but not saved to the state yet, by create key. Unlike the
+// private state, which Terraform only saves once the create returns, the journal is written as soon as OCI accepts a
+// creation, so that the next apply finds the resource of an apply that crashed or was killed.
+type Journal struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// JournalVar is the journal of the provider, nil if creations are not journaled
+var JournalVar *Journal
+
+var (
+	journals      = map[string]*Journal{}
+	journalsMutex sync.Mutex
+)
+
+type createKeyContextKey struct{}
+
+// GetJournal returns the journal in the file at path. Provider configurations that use the same path share the same
+// journal.
+func GetJournal(path string) *Journal {
+	journalsMutex.Lock()
+	defer journalsMutex.Unlock()
+
+	if journal, ok := journals[path]; ok {
+		return journal
+	}
+	journal := &Journal{path: path}
+	journals[path] = journal
+	return journal
+}
+
+// WithCreateKey returns a context whose creation is journaled under key
+func WithCreateKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, createKeyContextKey{}, key)
+}
+
+// CreateKeyFromContext returns the key under which the creation of the context is journaled, empty if it is not
+func CreateKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(createKeyContextKey{}).(string)
+	return key
+}
+
+// Get returns the creation journaled under key
+func (j *Journal) Get(key string) (Creation, bool) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	creations, err := j.read()
+	if err != nil {
+		log.Printf("[WARN] unable to read the journal of resource creations: %v", err)
+		return Creation{}, false
+	}
+	creation, ok := creations[key]
+	return creation, ok
+}
+
+// Put journals the creation under key
+func (j *Journal) Put(key string, creation Creation) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	creations, err := j.read()
+	if err != nil {
+		return err
+	}
+	creations[key] = creation
+	return j.write(creations)
+}
+
+// Delete forgets the creation journaled under key, once the resource is saved to the state or could not be created
+func (j *Journal) Delete(key string) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	creations, err := j.read()
+	if err == nil {
+		if _, ok := creations[key]; !ok {
+			return
+		}
+		delete(creations, key)
+		err = j.write(creations)
+	}
+	if err != nil {
+		log.Printf("[WARN] unable to remove '%s' from the journal of resource creations: %v", key, err)
+	}
+}
+
+func (j *Journal) read() (map[string]Creation, error) {
+	creations := map[string]Creation{}
+	content, err := ioutil.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return creations, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &creations); err != nil {
+		return nil, fmt.Errorf("invalid journal %s: %v", j.path, err)
+	}
+	return creations, nil
+}
+
+// write replaces the journal file, so that a crash while writing does not corrupt it. The file is removed once
+// there are no creations left.
+func (j *Journal) write(creations map[string]Creation) error {
+	if len(creations) == 0 {
+		if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	content, err := json.MarshalIndent(creations, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(j.path), filepath.Base(j.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(content)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), j.path)
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resume
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// PrivateStateKey is the key of the pending creation in the private state of a resource
+const PrivateStateKey = "oci_pending_creation"
+
+type pendingContextKey struct{}
+
+// Pending is the creation of a resource that was still in progress when its create returned, along with the retry
+// token drawn for the creation when it was planned. It is read from the private state of the resource before the
+// operations on the resource and written back to it afterwards.
+type Pending struct {
+	mutex      sync.Mutex
+	entry      *Entry
+	retryToken string
+}
+
+// Creation is a creation accepted by OCI with its retry token, as saved to the private state and the journal
+type Creation struct {
+	Entry
+	RetryToken string `json:"retry_token,omitempty"`
+}
+
+// NewPending returns the pending creation saved in private, the JSON private state of a resource
+func NewPending(private []byte) *Pending {
+	pending := &Pending{}
+	if len(private) == 0 {
+		return pending
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(private, &values); err != nil {
+		return pending
+	}
+	if value, ok := values[PrivateStateKey]; ok {
+		var creation Creation
+		if err := json.Unmarshal(value, &creation); err != nil {
+			return pending
+		}
+		if creation.Entry != (Entry{}) {
+			pending.entry = &creation.Entry
+		}
+		pending.retryToken = creation.RetryToken
+	}
+	return pending
+}
+
+// WithPending returns a context whose operations read and write the pending creation
+func WithPending(ctx context.Context, pending *Pending) context.Context {
+	return context.WithValue(ctx, pendingContextKey{}, pending)
+}
+
+// PendingFromContext returns the pending creation of the context, nil if the operation has no private state
+func PendingFromContext(ctx context.Context) *Pending {
+	pending, _ := ctx.Value(pendingContextKey{}).(*Pending)
+	return pending
+}
+
+// Entry returns the pending creation, if any
+func (p *Pending) Entry() (Entry, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.entry == nil {
+		return Entry{}, false
+	}
+	return *p.entry, true
+}
+
+// Set saves the creation in progress
+func (p *Pending) Set(entry Entry) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.entry = &entry
+}
+
+// RetryToken returns the retry token of the creation, empty if none was drawn
+func (p *Pending) RetryToken() string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.retryToken
+}
+
+// SetRetryToken sets the retry token of the creation
+func (p *Pending) SetRetryToken(token string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.retryToken = token
+}
+
+// Clear removes the pending creation and its retry token once the resource is created
+func (p *Pending) Clear() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.entry = nil
+	p.retryToken = ""
+}
+
+// WritePrivate returns the JSON private state private with the pending creation, or without it once it is cleared.
+// An empty private state is returned unchanged, as the resource is not saved to the state.
+func (p *Pending) WritePrivate(private []byte) ([]byte, error) {
+	if len(private) == 0 {
+		return private, nil
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(private, &values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = map[string]json.RawMessage{}
+	}
+	p.mutex.Lock()
+	creation := Creation{RetryToken: p.retryToken}
+	if p.entry != nil {
+		creation.Entry = *p.entry
+	}
+	p.mutex.Unlock()
+	if creation == (Creation{}) {
+		delete(values, PrivateStateKey)
+		return json.Marshal(values)
+	}
+	value, err := json.Marshal(creation)
+	if err != nil {
+		return nil, err
+	}
+	values[PrivateStateKey] = value
+	return json.Marshal(values)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package resume records the creation of a resource accepted by OCI while the resource is being created. Terraform
+// only saves a resource once its creation returns, so a creation interrupted after OCI accepted it is otherwise lost,
+// and the next apply creates the resource a second time. The creation is journaled to a file as soon as OCI accepts
+// it, so that the next apply adopts the resource of an apply that crashed or was killed. The CRUD helpers also save
+// the recorded resource to the partial state of a cancelled creation, with its work request in the private state of
+// the resource, and the next read waits for the work request before the resource is used.
+package resume
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Entry is a creation accepted by OCI, with the work request creating the resource and the OCID of the resource when
+// they are known
+type Entry struct {
+	WorkRequestId string `json:"work_request_id,omitempty"`
+	Identifier    string `json:"id,omitempty"`
+}
+
+type recorderContextKey struct{}
+
+// Recorder records the creation of a resource once the first request made with its context is accepted, and journals
+// it under the create key with the retry token of the creation
+type Recorder struct {
+	journal    *Journal
+	key        string
+	retryToken string
+
+	mutex    sync.Mutex
+	entry    Entry
+	recorded bool
+}
+
+// NewRecorder returns a recorder of a creation, which is not journaled if journal is nil
+func NewRecorder(journal *Journal, key string, retryToken string) *Recorder {
+	return &Recorder{journal: journal, key: key, retryToken: retryToken}
+}
+
+// WithRecorder returns a context whose accepted requests are recorded by the recorder
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, recorder)
+}
+
+// RecorderFromContext returns the recorder of the context, nil if its requests are not recorded
+func RecorderFromContext(ctx context.Context) *Recorder {
+	recorder, _ := ctx.Value(recorderContextKey{}).(*Recorder)
+	return recorder
+}
+
+// Record records an accepted request with the work request it started or the OCID of the resource it created. Only
+// the first accepted request is recorded, as it is the one creating the resource.
+func (r *Recorder) Record(workRequestId string, identifier string) {
+	if workRequestId == "" && identifier == "" {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.recorded {
+		return
+	}
+	r.recorded = true
+	r.entry = Entry{
+		WorkRequestId: workRequestId,
+		Identifier:    identifier,
+	}
+	if r.journal == nil {
+		return
+	}
+	if err := r.journal.Put(r.key, Creation{Entry: r.entry, RetryToken: r.retryToken}); err != nil {
+		log.Printf("[WARN] unable to journal the creation of '%s': %v", r.key, err)
+	}
+}
+
+// Entry returns the recorded creation, if any
+func (r *Recorder) Entry() (Entry, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.entry, r.recorded
+}
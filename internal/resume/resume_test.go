@@ -0,0 +1,128 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resume
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	journal := GetJournal(path)
+	assert.Same(t, journal, GetJournal(path), "journals should be shared by path")
+
+	assert.Empty(t, CreateKeyFromContext(context.Background()))
+	assert.Equal(t, "oci_core_vcn,a", CreateKeyFromContext(WithCreateKey(context.Background(), "oci_core_vcn,a")))
+
+	_, ok := journal.Get("oci_core_vcn,a")
+	assert.False(t, ok)
+
+	assert.NoError(t, journal.Put("oci_core_vcn,a", Creation{Entry: Entry{Identifier: "ocid1.vcn.a"}, RetryToken: "token-a"}))
+	assert.NoError(t, journal.Put("oci_core_vcn,b", Creation{Entry: Entry{WorkRequestId: "ocid1.workrequest.b"}}))
+	creation, ok := GetJournal(path).Get("oci_core_vcn,a")
+	assert.True(t, ok)
+	assert.Equal(t, Creation{Entry: Entry{Identifier: "ocid1.vcn.a"}, RetryToken: "token-a"}, creation)
+
+	journal.Delete("oci_core_vcn,a")
+	journal.Delete("oci_core_vcn,unknown")
+	_, ok = journal.Get("oci_core_vcn,a")
+	assert.False(t, ok)
+	assert.FileExists(t, path)
+
+	journal.Delete("oci_core_vcn,b")
+	assert.NoFileExists(t, path, "the journal should be removed once empty")
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	assert.Error(t, journal.Put("oci_core_vcn,a", Creation{}))
+	_, ok = journal.Get("oci_core_vcn,a")
+	assert.False(t, ok)
+}
+
+func TestUnitRecorder_journal(t *testing.T) {
+	journal := GetJournal(filepath.Join(t.TempDir(), "journal.json"))
+	recorder := NewRecorder(journal, "oci_core_vcn,a", "token")
+
+	recorder.Record("ocid1.workrequest.a", "ocid1.vcn.a")
+	recorder.Record("ocid1.workrequest.b", "ocid1.routetable.b")
+	creation, ok := journal.Get("oci_core_vcn,a")
+	assert.True(t, ok, "the creation should be journaled as soon as it is accepted")
+	assert.Equal(t, Creation{Entry: Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"}, RetryToken: "token"}, creation)
+}
+
+func TestUnitRecorder(t *testing.T) {
+	recorder := NewRecorder(nil, "", "")
+
+	assert.Nil(t, RecorderFromContext(context.Background()))
+	assert.Same(t, recorder, RecorderFromContext(WithRecorder(context.Background(), recorder)))
+
+	recorder.Record("", "")
+	_, ok := recorder.Entry()
+	assert.False(t, ok, "requests without a work request or resource should not be recorded")
+
+	recorder.Record("ocid1.workrequest.a", "ocid1.vcn.a")
+	recorder.Record("ocid1.workrequest.b", "ocid1.routetable.b")
+	entry, ok := recorder.Entry()
+	assert.True(t, ok)
+	assert.Equal(t, Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"}, entry, "only the first accepted request should be recorded")
+}
+
+func TestUnitRetryTokens(t *testing.T) {
+	token := NewRetryToken()
+	assert.Len(t, token, 64)
+	assert.NotEqual(t, token, NewRetryToken(), "every creation should draw a token of its own")
+
+	tokens := NewRetryTokens("token")
+	assert.Nil(t, RetryTokensFromContext(context.Background()))
+	assert.Same(t, tokens, RetryTokensFromContext(WithRetryTokens(context.Background(), tokens)))
+
+	assert.Equal(t, "token", tokens.Next(), "the first request should use the token of the creation")
+	second := tokens.Next()
+	assert.Len(t, second, 64)
+	assert.NotEqual(t, "token", second)
+
+	next := NewRetryTokens("token")
+	next.Next()
+	assert.Equal(t, second, next.Next(), "a retried creation should get the same tokens for the same creation token")
+}
+
+func TestUnitPending(t *testing.T) {
+	assert.Nil(t, PendingFromContext(context.Background()))
+
+	pending := NewPending([]byte(`{"schema_version":"0"}`))
+	_, ok := pending.Entry()
+	assert.False(t, ok)
+	assert.Same(t, pending, PendingFromContext(WithPending(context.Background(), pending)))
+
+	pending.Set(Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"})
+	private, err := pending.WritePrivate([]byte(`{"schema_version":"0"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0","oci_pending_creation":{"work_request_id":"ocid1.workrequest.a","id":"ocid1.vcn.a"}}`, string(private))
+
+	entry, ok := NewPending(private).Entry()
+	assert.True(t, ok, "the pending creation should be read from the private state")
+	assert.Equal(t, Entry{WorkRequestId: "ocid1.workrequest.a", Identifier: "ocid1.vcn.a"}, entry)
+
+	pending.Clear()
+	private, err = pending.WritePrivate(private)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0"}`, string(private))
+
+	private, err = pending.WritePrivate(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, private, "resources that are not in the state should have no private state")
+
+	pending.SetRetryToken("token")
+	private, err = pending.WritePrivate([]byte(`{"schema_version":"0"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0","oci_pending_creation":{"retry_token":"token"}}`, string(private))
+	planned := NewPending(private)
+	_, ok = planned.Entry()
+	assert.False(t, ok, "a planned creation should not be resumed")
+	assert.Equal(t, "token", planned.RetryToken(), "the retry token of the creation should be read from the private state")
+}
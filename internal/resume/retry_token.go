@@ -0,0 +1,67 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resume
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// retryTokenBytes is the number of random bytes of a retry token, OCI accepts tokens of up to 64 characters
+const retryTokenBytes = 32
+
+type retryTokensContextKey struct{}
+
+// NewRetryToken draws the random retry token of a creation
+func NewRetryToken() string {
+	token := make([]byte, retryTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(token)
+}
+
+// RetryTokens are the retry tokens of the requests made while creating a resource, derived from the token of the
+// creation so that the requests of a creation get the same tokens when it is retried or resumed
+type RetryTokens struct {
+	token string
+
+	mutex sync.Mutex
+	count int
+}
+
+func NewRetryTokens(token string) *RetryTokens {
+	return &RetryTokens{token: token}
+}
+
+// WithRetryTokens returns a context whose requests use the retry tokens
+func WithRetryTokens(ctx context.Context, tokens *RetryTokens) context.Context {
+	return context.WithValue(ctx, retryTokensContextKey{}, tokens)
+}
+
+// RetryTokensFromContext returns the retry tokens of the context, nil if its requests use the tokens of the SDK
+func RetryTokensFromContext(ctx context.Context) *RetryTokens {
+	tokens, _ := ctx.Value(retryTokensContextKey{}).(*RetryTokens)
+	return tokens
+}
+
+// Next returns the retry token of the next request of the creation. The first request uses the token of the creation,
+// the subsequent requests get tokens derived from it in the order they are made. The SDK retries a request with the
+// token set on it.
+func (t *RetryTokens) Next() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	token := t.token
+	if t.count > 0 {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", t.token, t.count)))
+		token = hex.EncodeToString(sum[:])
+	}
+	t.count++
+	return token
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func AiAnomalyDetectionAiPrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionAiPrivateEndpoint,
-		Read:     readAiAnomalyDetectionAiPrivateEndpoint,
-		Update:   updateAiAnomalyDetectionAiPrivateEndpoint,
-		Delete:   deleteAiAnomalyDetectionAiPrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionAiPrivateEndpoint,
+		ReadContext:   readAiAnomalyDetectionAiPrivateEndpoint,
+		UpdateContext: updateAiAnomalyDetectionAiPrivateEndpoint,
+		DeleteContext: deleteAiAnomalyDetectionAiPrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -102,37 +103,37 @@ func AiAnomalyDetectionAiPrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AiAnomalyDetectionAiPrivateEndpointResourceCrud struct {
@@ -213,28 +214,29 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) getAiPrivateEndpointFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	aiPrivateEndpointId, err := aiPrivateEndpointWaitForWorkRequest(workId, "aiprivateendpoint",
+	aiPrivateEndpointId, err := aiPrivateEndpointWaitForWorkRequest(s.Context(), workId, "aiprivateendpoint",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, aiPrivateEndpointId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -274,9 +276,9 @@ func aiPrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func aiPrivateEndpointWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = aiPrivateEndpointWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ai_anomaly_detection.GetWorkRequestResponse{}
@@ -293,7 +295,7 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -305,7 +307,7 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -322,14 +324,14 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -357,9 +359,9 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AiPrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -413,15 +415,15 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
@@ -430,16 +432,16 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AiPrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := aiPrivateEndpointWaitForWorkRequest(workId, "aiprivateendpoint",
+	_, delWorkRequestErr := aiPrivateEndpointWaitForWorkRequest(s.Context(), workId, "aiprivateendpoint",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -545,13 +547,13 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) updateCompartment(comp
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.ChangeAiPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAiPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
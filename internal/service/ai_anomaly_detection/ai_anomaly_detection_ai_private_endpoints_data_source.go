@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v61/aianomalydetection"
 )
 
 func AiAnomalyDetectionAiPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiAnomalyDetectionAiPrivateEndpoints,
+		ReadContext: readAiAnomalyDetectionAiPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func AiAnomalyDetectionAiPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readAiAnomalyDetectionAiPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionAiPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_anomaly_detection.AnomalyDetectionClient
 	Res    *oci_ai_anomaly_detection.ListAiPrivateEndpointsResponse
 }
@@ -92,9 +93,9 @@ func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ai_anomaly_detection.AiPrivateEndpointLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListAiPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListAiPrivateEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAiPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListAiPrivateEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
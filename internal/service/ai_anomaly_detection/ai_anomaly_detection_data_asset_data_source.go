@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v61/aianomalydetection"
 )
@@ -22,16 +23,16 @@ func AiAnomalyDetectionDataAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiAnomalyDetectionDataAssetResource(), fieldMap, readSingularAiAnomalyDetectionDataAsset)
 }
 
-func readSingularAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiAnomalyDetectionDataAssetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_anomaly_detection.AnomalyDetectionClient
 	Res    *oci_ai_anomaly_detection.GetDataAssetResponse
 }
@@ -48,9 +49,9 @@ func (s *AiAnomalyDetectionDataAssetDataSourceCrud) Get() error {
 		request.DataAssetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
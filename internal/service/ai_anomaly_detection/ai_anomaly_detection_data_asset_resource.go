@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func AiAnomalyDetectionDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionDataAsset,
-		Read:     readAiAnomalyDetectionDataAsset,
-		Update:   updateAiAnomalyDetectionDataAsset,
-		Delete:   deleteAiAnomalyDetectionDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionDataAsset,
+		ReadContext:   readAiAnomalyDetectionDataAsset,
+		UpdateContext: updateAiAnomalyDetectionDataAsset,
+		DeleteContext: deleteAiAnomalyDetectionDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -282,37 +283,37 @@ func AiAnomalyDetectionDataAssetResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AiAnomalyDetectionDataAssetResourceCrud struct {
@@ -397,9 +398,10 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -414,9 +416,9 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DataAssetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -462,9 +464,9 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -479,9 +481,9 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataAssetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(s.Context(), request)
 	return err
 }
 
@@ -881,14 +883,14 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) updateCompartment(compartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DataAssetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeDataAssetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDataAssetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,11 +27,11 @@ func AiAnomalyDetectionModelResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionModel,
-		Read:     readAiAnomalyDetectionModel,
-		Update:   updateAiAnomalyDetectionModel,
-		Delete:   deleteAiAnomalyDetectionModel,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionModel,
+		ReadContext:   readAiAnomalyDetectionModel,
+		UpdateContext: updateAiAnomalyDetectionModel,
+		DeleteContext: deleteAiAnomalyDetectionModel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -235,37 +236,37 @@ func AiAnomalyDetectionModelResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AiAnomalyDetectionModelResourceCrud struct {
@@ -349,28 +350,29 @@ func (s *AiAnomalyDetectionModelResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *AiAnomalyDetectionModelResourceCrud) getModelFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	modelId, err := modelWaitForWorkRequest(workId, "model",
+	modelId, err := modelWaitForWorkRequest(s.Context(), workId, "model",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -410,9 +412,9 @@ func modelWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func modelWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = modelWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ai_anomaly_detection.GetWorkRequestResponse{}
@@ -430,7 +432,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -442,7 +444,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -459,14 +461,14 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionModelWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionModelWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionModelWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionModelWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -494,9 +496,9 @@ func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -542,15 +544,15 @@ func (s *AiAnomalyDetectionModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
@@ -559,16 +561,16 @@ func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteModel(context.Background(), request)
+	response, err := s.Client.DeleteModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := modelWaitForWorkRequest(workId, "model",
+	_, delWorkRequestErr := modelWaitForWorkRequest(s.Context(), workId, "model",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -825,14 +827,14 @@ func (s *AiAnomalyDetectionModelResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ModelId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v61/aianomalydetection"
 )
 
 func AiAnomalyDetectionModelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiAnomalyDetectionModels,
+		ReadContext: readAiAnomalyDetectionModels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func AiAnomalyDetectionModelsDataSource() *schema.Resource {
 	}
 }
 
-func readAiAnomalyDetectionModels(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionModels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiAnomalyDetectionModelsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_anomaly_detection.AnomalyDetectionClient
 	Res    *oci_ai_anomaly_detection.ListModelsResponse
 }
@@ -92,9 +93,9 @@ func (s *AiAnomalyDetectionModelsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ai_anomaly_detection.ModelLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListModels(context.Background(), request)
+	response, err := s.Client.ListModels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AiAnomalyDetectionModelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModels(context.Background(), request)
+		listResponse, err := s.Client.ListModels(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func AiAnomalyDetectionProjectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionProject,
-		Read:     readAiAnomalyDetectionProject,
-		Update:   updateAiAnomalyDetectionProject,
-		Delete:   deleteAiAnomalyDetectionProject,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionProject,
+		ReadContext:   readAiAnomalyDetectionProject,
+		UpdateContext: updateAiAnomalyDetectionProject,
+		DeleteContext: deleteAiAnomalyDetectionProject,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -84,37 +85,37 @@ func AiAnomalyDetectionProjectResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AiAnomalyDetectionProjectResourceCrud struct {
@@ -182,9 +183,10 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,13 +199,13 @@ func (s *AiAnomalyDetectionProjectResourceCrud) getProjectFromWorkRequest(workId
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	projectId, err := aiAnomalyDetectionProjectWaitForWorkRequest(workId, "ai_anomaly_detection",
+	projectId, err := aiAnomalyDetectionProjectWaitForWorkRequest(s.Context(), workId, "ai_anomaly_detection",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, projectId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -243,9 +245,9 @@ func aiAnomalyDetectionProjectWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func aiAnomalyDetectionProjectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = aiAnomalyDetectionProjectWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ai_anomaly_detection.GetWorkRequestResponse{}
@@ -262,7 +264,7 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -274,7 +276,7 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -291,14 +293,14 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionProjectWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionProjectWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionProjectWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionProjectWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -326,9 +328,9 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -374,9 +376,9 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -391,16 +393,16 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteProject(context.Background(), request)
+	response, err := s.Client.DeleteProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := aiAnomalyDetectionProjectWaitForWorkRequest(workId, "projects",
+	_, delWorkRequestErr := aiAnomalyDetectionProjectWaitForWorkRequest(s.Context(), workId, "projects",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -492,14 +494,14 @@ func (s *AiAnomalyDetectionProjectResourceCrud) updateCompartment(compartment in
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ProjectId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeProjectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
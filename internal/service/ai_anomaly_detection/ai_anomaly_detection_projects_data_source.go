@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v61/aianomalydetection"
 )
 
 func AiAnomalyDetectionProjectsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiAnomalyDetectionProjects,
+		ReadContext: readAiAnomalyDetectionProjects,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func AiAnomalyDetectionProjectsDataSource() *schema.Resource {
 	}
 }
 
-func readAiAnomalyDetectionProjects(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionProjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiAnomalyDetectionProjectsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_anomaly_detection.AnomalyDetectionClient
 	Res    *oci_ai_anomaly_detection.ListProjectsResponse
 }
@@ -83,9 +84,9 @@ func (s *AiAnomalyDetectionProjectsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ai_anomaly_detection.ProjectLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListProjects(context.Background(), request)
+	response, err := s.Client.ListProjects(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *AiAnomalyDetectionProjectsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProjects(context.Background(), request)
+		listResponse, err := s.Client.ListProjects(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v61/aivision"
 
@@ -22,16 +23,16 @@ func AiVisionModelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiVisionModelResource(), fieldMap, readSingularAiVisionModel)
 }
 
-func readSingularAiVisionModel(d *schema.ResourceData, m interface{}) error {
+func readSingularAiVisionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiVisionModelDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_vision.AIServiceVisionClient
 	Res    *oci_ai_vision.GetModelResponse
 }
@@ -48,9 +49,9 @@ func (s *AiVisionModelDataSourceCrud) Get() error {
 		request.ModelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_vision")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
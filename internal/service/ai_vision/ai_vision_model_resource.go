@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,10 +32,10 @@ func AiVisionModelResource() *schema.Resource {
 			Update: &tfresource.TwentyMinutes,
 			Delete: &tfresource.TwentyMinutes,
 		},
-		Create: createAiVisionModel,
-		Read:   readAiVisionModel,
-		Update: updateAiVisionModel,
-		Delete: deleteAiVisionModel,
+		CreateContext: createAiVisionModel,
+		ReadContext:   readAiVisionModel,
+		UpdateContext: updateAiVisionModel,
+		DeleteContext: deleteAiVisionModel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -305,37 +306,37 @@ func AiVisionModelResource() *schema.Resource {
 	}
 }
 
-func createAiVisionModel(d *schema.ResourceData, m interface{}) error {
+func createAiVisionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAiVisionModel(d *schema.ResourceData, m interface{}) error {
+func readAiVisionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAiVisionModel(d *schema.ResourceData, m interface{}) error {
+func updateAiVisionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAiVisionModel(d *schema.ResourceData, m interface{}) error {
+func deleteAiVisionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AiVisionModelResourceCrud struct {
@@ -460,28 +461,29 @@ func (s *AiVisionModelResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision"), oci_ai_vision.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision"), oci_ai_vision.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *AiVisionModelResourceCrud) getModelFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_vision.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	modelId, err := modelWaitForWorkRequest(workId, "model",
+	modelId, err := modelWaitForWorkRequest(s.Context(), workId, "model",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_vision.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -521,9 +523,9 @@ func modelWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_vision.ActionTypeEnum,
+func modelWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_vision.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_vision.AIServiceVisionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_vision")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ai_vision")
 	retryPolicy.ShouldRetryOperation = modelWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ai_vision.GetWorkRequestResponse{}
@@ -540,7 +542,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_visio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_vision.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -552,7 +554,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_visio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -569,14 +571,14 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_visio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_vision.OperationStatusFailed || response.Status == oci_ai_vision.OperationStatusCanceled {
-		return nil, getErrorFromAiVisionModelWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiVisionModelWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiVisionModelWorkRequest(client *oci_ai_vision.AIServiceVisionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_vision.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiVisionModelWorkRequest(ctx context.Context, client *oci_ai_vision.AIServiceVisionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_vision.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_vision.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -604,9 +606,9 @@ func (s *AiVisionModelResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -652,15 +654,15 @@ func (s *AiVisionModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision"), oci_ai_vision.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision"), oci_ai_vision.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AiVisionModelResourceCrud) Delete() error {
@@ -669,16 +671,16 @@ func (s *AiVisionModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision")
 
-	response, err := s.Client.DeleteModel(context.Background(), request)
+	response, err := s.Client.DeleteModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := modelWaitForWorkRequest(workId, "model",
+	_, delWorkRequestErr := modelWaitForWorkRequest(s.Context(), workId, "model",
 		oci_ai_vision.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -966,14 +968,14 @@ func (s *AiVisionModelResourceCrud) updateCompartment(compartment interface{}) e
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ModelId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ai_vision")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v61/aivision"
 
@@ -15,7 +16,7 @@ import (
 
 func AiVisionModelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiVisionModels,
+		ReadContext: readAiVisionModels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func AiVisionModelsDataSource() *schema.Resource {
 	}
 }
 
-func readAiVisionModels(d *schema.ResourceData, m interface{}) error {
+func readAiVisionModels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiVisionModelsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_vision.AIServiceVisionClient
 	Res    *oci_ai_vision.ListModelsResponse
 }
@@ -101,9 +102,9 @@ func (s *AiVisionModelsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ai_vision.ModelLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_vision")
 
-	response, err := s.Client.ListModels(context.Background(), request)
+	response, err := s.Client.ListModels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *AiVisionModelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModels(context.Background(), request)
+		listResponse, err := s.Client.ListModels(s.Context(), request)
 		if err != nil {
 			return err
 		}
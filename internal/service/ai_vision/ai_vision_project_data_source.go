@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v61/aivision"
 
@@ -22,16 +23,16 @@ func AiVisionProjectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiVisionProjectResource(), fieldMap, readSingularAiVisionProject)
 }
 
-func readSingularAiVisionProject(d *schema.ResourceData, m interface{}) error {
+func readSingularAiVisionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionProjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiVisionProjectDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_vision.AIServiceVisionClient
 	Res    *oci_ai_vision.GetProjectResponse
 }
@@ -48,9 +49,9 @@ func (s *AiVisionProjectDataSourceCrud) Get() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_vision")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v61/aivision"
 
@@ -15,7 +16,7 @@ import (
 
 func AiVisionProjectsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiVisionProjects,
+		ReadContext: readAiVisionProjects,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func AiVisionProjectsDataSource() *schema.Resource {
 	}
 }
 
-func readAiVisionProjects(d *schema.ResourceData, m interface{}) error {
+func readAiVisionProjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionProjectsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AiVisionProjectsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ai_vision.AIServiceVisionClient
 	Res    *oci_ai_vision.ListProjectsResponse
 }
@@ -92,9 +93,9 @@ func (s *AiVisionProjectsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ai_vision.ProjectLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ai_vision")
 
-	response, err := s.Client.ListProjects(context.Background(), request)
+	response, err := s.Client.ListProjects(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AiVisionProjectsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProjects(context.Background(), request)
+		listResponse, err := s.Client.ListProjects(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v61/analytics"
 )
@@ -22,16 +23,16 @@ func AnalyticsAnalyticsInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AnalyticsAnalyticsInstanceResource(), fieldMap, readSingularAnalyticsAnalyticsInstance)
 }
 
-func readSingularAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_analytics.AnalyticsClient
 	Res    *oci_analytics.GetAnalyticsInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *AnalyticsAnalyticsInstanceDataSourceCrud) Get() error {
 		request.AnalyticsInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v61/analytics"
 )
@@ -26,16 +27,16 @@ func AnalyticsAnalyticsInstancePrivateAccessChannelDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(AnalyticsAnalyticsInstancePrivateAccessChannelResource(), fieldMap, readSingularAnalyticsAnalyticsInstancePrivateAccessChannel)
 }
 
-func readSingularAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func readSingularAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_analytics.AnalyticsClient
 	Res    *oci_analytics.GetPrivateAccessChannelResponse
 }
@@ -57,9 +58,9 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) Get() err
 		request.PrivateAccessChannelKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "analytics")
 
-	response, err := s.Client.GetPrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.GetPrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,10 +33,10 @@ func AnalyticsAnalyticsInstancePrivateAccessChannelResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h0m"),
 			Delete: tfresource.GetTimeoutDuration("2h0m"),
 		},
-		Create: createAnalyticsAnalyticsInstancePrivateAccessChannel,
-		Read:   readAnalyticsAnalyticsInstancePrivateAccessChannel,
-		Update: updateAnalyticsAnalyticsInstancePrivateAccessChannel,
-		Delete: deleteAnalyticsAnalyticsInstancePrivateAccessChannel,
+		CreateContext: createAnalyticsAnalyticsInstancePrivateAccessChannel,
+		ReadContext:   readAnalyticsAnalyticsInstancePrivateAccessChannel,
+		UpdateContext: updateAnalyticsAnalyticsInstancePrivateAccessChannel,
+		DeleteContext: deleteAnalyticsAnalyticsInstancePrivateAccessChannel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"analytics_instance_id": {
@@ -100,37 +101,37 @@ func AnalyticsAnalyticsInstancePrivateAccessChannelResource() *schema.Resource {
 	}
 }
 
-func createAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func createAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func readAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func updateAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func deleteAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud struct {
@@ -192,19 +193,20 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Create() er
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.CreatePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.CreatePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	returnError := s.getAnalyticsInstancePrivateAccessChannelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultPrivateAccessChannelCreated, s.D.Timeout(schema.TimeoutCreate))
+	returnError := s.getAnalyticsInstancePrivateAccessChannelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultPrivateAccessChannelCreated, s.D.Timeout(schema.TimeoutCreate))
 	getWorkRequestRequest := oci_analytics.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workId
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-	workRequestResponse, _ := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
+	workRequestResponse, _ := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	return returnError
 }
@@ -213,13 +215,13 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) getAnalytic
 	actionTypeEnum oci_analytics.WorkRequestActionResultEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	analyticsInstanceId, err := analyticsInstancePrivateAccessChannelWaitForWorkRequest(workId, "analytics",
+	analyticsInstanceId, err := analyticsInstancePrivateAccessChannelWaitForWorkRequest(s.Context(), workId, "analytics",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -235,9 +237,9 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) getAnalytic
 	request := oci_analytics.GetAnalyticsInstanceRequest{}
 	request.AnalyticsInstanceId = analyticsInstanceId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,9 +283,9 @@ func analyticsInstancePrivateAccessChannelWorkRequestShouldRetryFunc(timeout tim
 	}
 }
 
-func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
+func analyticsInstancePrivateAccessChannelWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_analytics.AnalyticsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "analytics")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "analytics")
 	retryPolicy.ShouldRetryOperation = analyticsInstancePrivateAccessChannelWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_analytics.GetWorkRequestResponse{}
@@ -300,7 +302,7 @@ func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entity
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -312,7 +314,7 @@ func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entity
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -329,14 +331,14 @@ func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entity
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_analytics.WorkRequestStatusFailed || response.Status == oci_analytics.WorkRequestStatusCanceled {
-		return nil, getErrorFromAnalyticsAnalyticsInstancePrivateAccessChannelWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAnalyticsAnalyticsInstancePrivateAccessChannelWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAnalyticsAnalyticsInstancePrivateAccessChannelWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAnalyticsAnalyticsInstancePrivateAccessChannelWorkRequest(ctx context.Context, client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -368,9 +370,9 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Get() error
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetPrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.GetPrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -397,9 +399,9 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Update() er
 	getRequest := oci_analytics.GetPrivateAccessChannelRequest{}
 	getRequest.AnalyticsInstanceId = request.AnalyticsInstanceId
 	getRequest.PrivateAccessChannelKey = request.PrivateAccessChannelKey
-	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	getResponse, err := s.Client.GetPrivateAccessChannel(context.Background(), getRequest)
+	getResponse, err := s.Client.GetPrivateAccessChannel(s.Context(), getRequest)
 	if err != nil {
 		return err
 	}
@@ -444,15 +446,15 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Update() er
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdatePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.UpdatePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAnalyticsInstancePrivateAccessChannelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultPrivateAccessChannelUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAnalyticsInstancePrivateAccessChannelFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultPrivateAccessChannelUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Delete() error {
@@ -468,16 +470,16 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Delete() er
 		request.PrivateAccessChannelKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeletePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.DeletePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := analyticsInstancePrivateAccessChannelWaitForWorkRequest(workId, "analytics",
+	_, delWorkRequestErr := analyticsInstancePrivateAccessChannelWaitForWorkRequest(s.Context(), workId, "analytics",
 		oci_analytics.WorkRequestActionResultPrivateAccessChannelDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,10 +32,10 @@ func AnalyticsAnalyticsInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createAnalyticsAnalyticsInstance,
-		Read:   readAnalyticsAnalyticsInstance,
-		Update: updateAnalyticsAnalyticsInstance,
-		Delete: deleteAnalyticsAnalyticsInstance,
+		CreateContext: createAnalyticsAnalyticsInstance,
+		ReadContext:   readAnalyticsAnalyticsInstance,
+		UpdateContext: updateAnalyticsAnalyticsInstance,
+		DeleteContext: deleteAnalyticsAnalyticsInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"capacity": {
@@ -226,9 +227,10 @@ func AnalyticsAnalyticsInstanceResource() *schema.Resource {
 	}
 }
 
-func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func createAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 	var powerOff = false
 	if powerState, ok := sync.D.GetOkExists("state"); ok {
@@ -238,13 +240,13 @@ func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if powerOff {
 		if err := sync.StopAnalyticsInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
 	}
@@ -252,17 +254,18 @@ func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 
 }
 
-func readAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func readAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func updateAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
 	powerOn, powerOff := false, false
@@ -278,18 +281,18 @@ func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 
 	if powerOn {
 		if err := sync.StartAnalyticsInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if powerOff {
 		if err := sync.StopAnalyticsInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
 	}
@@ -297,13 +300,13 @@ func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func deleteAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func deleteAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AnalyticsAnalyticsInstanceResourceCrud struct {
@@ -411,28 +414,29 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.CreateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.CreateAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *AnalyticsAnalyticsInstanceResourceCrud) getAnalyticsInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_analytics.WorkRequestActionResultEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	analyticsInstanceId, err := analyticsInstanceWaitForWorkRequest(workId, "analytics",
+	analyticsInstanceId, err := analyticsInstanceWaitForWorkRequest(s.Context(), workId, "analytics",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -477,9 +481,9 @@ func analyticsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
+func analyticsInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_analytics.AnalyticsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "analytics")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "analytics")
 	retryPolicy.ShouldRetryOperation = analyticsInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_analytics.GetWorkRequestResponse{}
@@ -496,7 +500,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -508,7 +512,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -525,14 +529,14 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_analytics.WorkRequestStatusFailed || response.Status == oci_analytics.WorkRequestStatusCanceled {
-		return nil, getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAnalyticsAnalyticsInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(ctx context.Context, client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -560,9 +564,9 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AnalyticsInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -612,9 +616,9 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 		request.LicenseType = oci_analytics.LicenseTypeEnum(licenseType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.UpdateAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -636,15 +640,15 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 			scaleRequest.AnalyticsInstanceId = &id
 			scaleRequest.Capacity = &tmp
 
-			scaleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-			scaleResponse, err := s.Client.ScaleAnalyticsInstance(context.Background(), scaleRequest)
+			scaleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
+			scaleResponse, err := s.Client.ScaleAnalyticsInstance(s.Context(), scaleRequest)
 
 			if err != nil {
 				return err
 			}
 
 			workId := scaleResponse.OpcWorkRequestId
-			return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultScaled, s.D.Timeout(schema.TimeoutUpdate))
+			return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultScaled, s.D.Timeout(schema.TimeoutUpdate))
 		}
 	}
 
@@ -657,16 +661,16 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AnalyticsInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeleteAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.DeleteAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := analyticsInstanceWaitForWorkRequest(workId, "analytics",
+	_, delWorkRequestErr := analyticsInstanceWaitForWorkRequest(s.Context(), workId, "analytics",
 		oci_analytics.WorkRequestActionResultDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -741,15 +745,15 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StartAnalyticsInstance() error
 	idTmp := s.D.Id()
 	request.AnalyticsInstanceId = &idTmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StartAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StartAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_analytics.AnalyticsInstanceLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance() error {
@@ -758,15 +762,15 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance() error {
 	idTmp := s.D.Id()
 	request.AnalyticsInstanceId = &idTmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StopAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StopAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_analytics.AnalyticsInstanceLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AnalyticsAnalyticsInstanceResourceCrud) mapToCapacity(fieldKeyFormat string) (oci_analytics.Capacity, error) {
@@ -945,13 +949,13 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) updateCompartment(compartment i
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.ChangeAnalyticsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAnalyticsInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCompartmentChanged, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCompartmentChanged, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v61/analytics"
 )
 
 func AnalyticsAnalyticsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAnalyticsAnalyticsInstances,
+		ReadContext: readAnalyticsAnalyticsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"capacity_type": {
@@ -47,16 +48,16 @@ func AnalyticsAnalyticsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readAnalyticsAnalyticsInstances(d *schema.ResourceData, m interface{}) error {
+func readAnalyticsAnalyticsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_analytics.AnalyticsClient
 	Res    *oci_analytics.ListAnalyticsInstancesResponse
 }
@@ -90,9 +91,9 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_analytics.ListAnalyticsInstancesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "analytics")
 
-	response, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+	response, err := s.Client.ListAnalyticsInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +102,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListAnalyticsInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
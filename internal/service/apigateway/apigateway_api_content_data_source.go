@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -17,7 +18,7 @@ import (
 
 func ApigatewayApiContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiContent,
+		ReadContext: readSingularApigatewayApiContent,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -32,16 +33,16 @@ func ApigatewayApiContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiContent(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayApiContentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.GetApiContentResponse
 }
@@ -58,9 +59,9 @@ func (s *ApigatewayApiContentDataSourceCrud) Get() error {
 		request.ApiId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetApiContent(context.Background(), request)
+	response, err := s.Client.GetApiContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
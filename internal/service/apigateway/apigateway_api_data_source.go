@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -22,16 +23,16 @@ func ApigatewayApiDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewayApiResource(), fieldMap, readSingularApigatewayApi)
 }
 
-func readSingularApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayApiDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.GetApiResponse
 }
@@ -48,9 +49,9 @@ func (s *ApigatewayApiDataSourceCrud) Get() error {
 		request.ApiId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetApi(context.Background(), request)
+	response, err := s.Client.GetApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v61/apigateway"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
@@ -18,7 +19,7 @@ import (
 
 func ApigatewayApiDeploymentSpecificationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiDeploymentSpecification,
+		ReadContext: readSingularApigatewayApiDeploymentSpecification,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -1127,16 +1128,16 @@ func ApigatewayApiDeploymentSpecificationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiDeploymentSpecification(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiDeploymentSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiDeploymentSpecificationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayApiDeploymentSpecificationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.GetApiDeploymentSpecificationResponse
 }
@@ -1153,9 +1154,9 @@ func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) Get() error {
 		request.ApiId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetApiDeploymentSpecification(context.Background(), request)
+	response, err := s.Client.GetApiDeploymentSpecification(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func ApigatewayApiResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApigatewayApi,
-		Read:     readApigatewayApi,
-		Update:   updateApigatewayApi,
-		Delete:   deleteApigatewayApi,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApigatewayApi,
+		ReadContext:   readApigatewayApi,
+		UpdateContext: updateApigatewayApi,
+		DeleteContext: deleteApigatewayApi,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -108,40 +109,40 @@ func ApigatewayApiResource() *schema.Resource {
 	}
 }
 
-func createApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func createApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func readApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func updateApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func deleteApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ApigatewayApiResourceCrud struct {
@@ -210,32 +211,33 @@ func (s *ApigatewayApiResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateApi(context.Background(), request)
+	response, err := s.Client.CreateApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	return apiWaitForValidation(response.Id, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	return apiWaitForValidation(s.Context(), response.Id, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 }
 
 func (s *ApigatewayApiResourceCrud) getApiFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apigateway.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	apiId, err := apiWaitForWorkRequest(workId, "api",
+	apiId, err := apiWaitForWorkRequest(s.Context(), workId, "api",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, apiId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(s.Context(),
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -275,9 +277,9 @@ func apiWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_comm
 	}
 }
 
-func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
+func apiWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.WorkRequestsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = apiWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_apigateway.GetWorkRequestResponse{}
@@ -294,7 +296,7 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -306,7 +308,7 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -321,14 +323,14 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apigateway.WorkRequestStatusFailed || response.Status == oci_apigateway.WorkRequestStatusCanceled {
-		return nil, getErrorFromApigatewayApiWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApigatewayApiWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApigatewayApiWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApigatewayApiWorkRequest(ctx context.Context, client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -356,9 +358,9 @@ func (s *ApigatewayApiResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ApiId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetApi(context.Background(), request)
+	response, err := s.Client.GetApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -404,19 +406,19 @@ func (s *ApigatewayApiResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateApi(context.Background(), request)
+	response, err := s.Client.UpdateApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
-	return apiWaitForValidation(&apiId, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
+	return apiWaitForValidation(s.Context(), &apiId, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 }
 
 func (s *ApigatewayApiResourceCrud) Delete() error {
@@ -425,16 +427,16 @@ func (s *ApigatewayApiResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApiId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteApi(context.Background(), request)
+	response, err := s.Client.DeleteApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := apiWaitForWorkRequest(workId, "api",
+	_, delWorkRequestErr := apiWaitForWorkRequest(s.Context(), workId, "api",
 		oci_apigateway.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -550,15 +552,15 @@ func (s *ApigatewayApiResourceCrud) updateCompartment(compartment interface{}) e
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeApiCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApiCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func apiResourceShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -581,8 +583,8 @@ func apiResourceShouldRetryFunc(timeout time.Duration) func(response oci_common.
 	}
 }
 
-func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.ApiGatewayClient) error {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
+func apiWaitForValidation(ctx context.Context, apiId *string, timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.ApiGatewayClient) error {
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = apiResourceShouldRetryFunc(timeout)
 
 	response := oci_apigateway.GetApiResponse{}
@@ -600,7 +602,7 @@ func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetr
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetApi(context.Background(),
+			response, err = client.GetApi(ctx,
 				oci_apigateway.GetApiRequest{
 					ApiId: apiId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -612,7 +614,7 @@ func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetr
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return e
 	}
 	return nil
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayApiValidationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiValidation,
+		ReadContext: readSingularApigatewayApiValidation,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -82,16 +83,16 @@ func ApigatewayApiValidationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiValidation(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiValidation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiValidationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayApiValidationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.GetApiValidationsResponse
 }
@@ -108,9 +109,9 @@ func (s *ApigatewayApiValidationDataSourceCrud) Get() error {
 		request.ApiId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetApiValidations(context.Background(), request)
+	response, err := s.Client.GetApiValidations(s.Context(), request)
 	if err != nil {
 		return err
 	}
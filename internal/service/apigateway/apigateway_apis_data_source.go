@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayApisDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApigatewayApis,
+		ReadContext: readApigatewayApis,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func ApigatewayApisDataSource() *schema.Resource {
 	}
 }
 
-func readApigatewayApis(d *schema.ResourceData, m interface{}) error {
+func readApigatewayApis(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApisDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayApisDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.ListApisResponse
 }
@@ -83,9 +84,9 @@ func (s *ApigatewayApisDataSourceCrud) Get() error {
 		request.LifecycleState = oci_apigateway.ApiSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.ListApis(context.Background(), request)
+	response, err := s.Client.ListApis(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *ApigatewayApisDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApis(context.Background(), request)
+		listResponse, err := s.Client.ListApis(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayCertificatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApigatewayCertificates,
+		ReadContext: readApigatewayCertificates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func ApigatewayCertificatesDataSource() *schema.Resource {
 	}
 }
 
-func readApigatewayCertificates(d *schema.ResourceData, m interface{}) error {
+func readApigatewayCertificates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayCertificatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayCertificatesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.ApiGatewayClient
 	Res    *oci_apigateway.ListCertificatesResponse
 }
@@ -83,9 +84,9 @@ func (s *ApigatewayCertificatesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_apigateway.CertificateLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *ApigatewayCertificatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificates(context.Background(), request)
+		listResponse, err := s.Client.ListCertificates(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -22,16 +23,16 @@ func ApigatewayDeploymentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewayDeploymentResource(), fieldMap, readSingularApigatewayDeployment)
 }
 
-func readSingularApigatewayDeployment(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayDeploymentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DeploymentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayDeploymentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.DeploymentClient
 	Res    *oci_apigateway.GetDeploymentResponse
 }
@@ -48,9 +49,9 @@ func (s *ApigatewayDeploymentDataSourceCrud) Get() error {
 		request.DeploymentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
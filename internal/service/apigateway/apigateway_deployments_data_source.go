@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayDeploymentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApigatewayDeployments,
+		ReadContext: readApigatewayDeployments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,16 +45,16 @@ func ApigatewayDeploymentsDataSource() *schema.Resource {
 	}
 }
 
-func readApigatewayDeployments(d *schema.ResourceData, m interface{}) error {
+func readApigatewayDeployments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayDeploymentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DeploymentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayDeploymentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.DeploymentClient
 	Res    *oci_apigateway.ListDeploymentsResponse
 }
@@ -84,9 +85,9 @@ func (s *ApigatewayDeploymentsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_apigateway.DeploymentLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	listResponse, err := s.Client.ListDeployments(context.Background(), request)
+	listResponse, err := s.Client.ListDeployments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *ApigatewayDeploymentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployments(s.Context(), request)
 		if err != nil {
 			return err
 		}
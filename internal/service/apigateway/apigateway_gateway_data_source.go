@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -22,16 +23,16 @@ func ApigatewayGatewayDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewayGatewayResource(), fieldMap, readSingularApigatewayGateway)
 }
 
-func readSingularApigatewayGateway(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewayDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayGatewayDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.GatewayClient
 	Res    *oci_apigateway.GetGatewayResponse
 }
@@ -48,9 +49,9 @@ func (s *ApigatewayGatewayDataSourceCrud) Get() error {
 		request.GatewayId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.GetGateway(context.Background(), request)
+	response, err := s.Client.GetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v61/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApigatewayGateways,
+		ReadContext: readApigatewayGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -44,16 +45,16 @@ func ApigatewayGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readApigatewayGateways(d *schema.ResourceData, m interface{}) error {
+func readApigatewayGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApigatewayGatewaysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apigateway.GatewayClient
 	Res    *oci_apigateway.ListGatewaysResponse
 }
@@ -84,9 +85,9 @@ func (s *ApigatewayGatewaysDataSourceCrud) Get() error {
 		request.LifecycleState = oci_apigateway.GatewayLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apigateway")
 
-	response, err := s.Client.ListGateways(context.Background(), request)
+	response, err := s.Client.ListGateways(s.Context(), request)
 	if err != nil {
 		return err
 	}
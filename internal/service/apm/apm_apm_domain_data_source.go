@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm "github.com/oracle/oci-go-sdk/v61/apmcontrolplane"
 )
@@ -22,16 +23,16 @@ func ApmApmDomainDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmApmDomainResource(), fieldMap, readSingularApmApmDomain)
 }
 
-func readSingularApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func readSingularApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmApmDomainDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm.ApmDomainClient
 	Res    *oci_apm.GetApmDomainResponse
 }
@@ -48,9 +49,9 @@ func (s *ApmApmDomainDataSourceCrud) Get() error {
 		request.ApmDomainId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func ApmApmDomainResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmApmDomain,
-		Read:     readApmApmDomain,
-		Update:   updateApmApmDomain,
-		Delete:   deleteApmApmDomain,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmApmDomain,
+		ReadContext:   readApmApmDomain,
+		UpdateContext: updateApmApmDomain,
+		DeleteContext: deleteApmApmDomain,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func ApmApmDomainResource() *schema.Resource {
 	}
 }
 
-func createApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func createApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func readApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func updateApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func deleteApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ApmApmDomainResourceCrud struct {
@@ -190,22 +191,23 @@ func (s *ApmApmDomainResourceCrud) Create() error {
 		request.IsFreeTier = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.CreateApmDomain(context.Background(), request)
+	response, err := s.Client.CreateApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *ApmApmDomainResourceCrud) getApmDomainFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apm.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	apmDomainId, err := apmDomainWaitForWorkRequest(workId, "apmDomain",
+	apmDomainId, err := apmDomainWaitForWorkRequest(s.Context(), workId, "apmDomain",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -239,9 +241,9 @@ func apmDomainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.ActionTypesEnum,
+func apmDomainWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apm.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apm.ApmDomainClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apm")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "apm")
 	retryPolicy.ShouldRetryOperation = apmDomainWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_apm.GetWorkRequestResponse{}
@@ -258,7 +260,7 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apm.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -270,7 +272,7 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -287,14 +289,14 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apm.OperationStatusFailed || response.Status == oci_apm.OperationStatusCanceled {
-		return nil, getErrorFromApmControlPlaneWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApmControlPlaneWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApmControlPlaneWorkRequest(client *oci_apm.ApmDomainClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apm.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApmControlPlaneWorkRequest(ctx context.Context, client *oci_apm.ApmDomainClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apm.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apm.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -322,9 +324,9 @@ func (s *ApmApmDomainResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ApmDomainId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -370,15 +372,15 @@ func (s *ApmApmDomainResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.UpdateApmDomain(context.Background(), request)
+	response, err := s.Client.UpdateApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *ApmApmDomainResourceCrud) Delete() error {
@@ -387,16 +389,16 @@ func (s *ApmApmDomainResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApmDomainId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.DeleteApmDomain(context.Background(), request)
+	response, err := s.Client.DeleteApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := apmDomainWaitForWorkRequest(workId, "apmDomain",
+	_, delWorkRequestErr := apmDomainWaitForWorkRequest(s.Context(), workId, "apmDomain",
 		oci_apm.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -450,13 +452,13 @@ func (s *ApmApmDomainResourceCrud) updateCompartment(compartment interface{}) er
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.ChangeApmDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApmDomainCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm "github.com/oracle/oci-go-sdk/v61/apmcontrolplane"
 )
 
 func ApmApmDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmApmDomains,
+		ReadContext: readApmApmDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func ApmApmDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readApmApmDomains(d *schema.ResourceData, m interface{}) error {
+func readApmApmDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmApmDomainsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm.ApmDomainClient
 	Res    *oci_apm.ListApmDomainsResponse
 }
@@ -74,9 +75,9 @@ func (s *ApmApmDomainsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_apm.ListApmDomainsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm")
 
-	response, err := s.Client.ListApmDomains(context.Background(), request)
+	response, err := s.Client.ListApmDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *ApmApmDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApmDomains(context.Background(), request)
+		listResponse, err := s.Client.ListApmDomains(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm "github.com/oracle/oci-go-sdk/v61/apmcontrolplane"
 )
 
 func ApmDataKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmDataKeys,
+		ReadContext: readApmDataKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -55,16 +56,16 @@ func ApmDataKeysDataSource() *schema.Resource {
 	}
 }
 
-func readApmDataKeys(d *schema.ResourceData, m interface{}) error {
+func readApmDataKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmDataKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmDataKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm.ApmDomainClient
 	Res    *oci_apm.ListDataKeysResponse
 }
@@ -85,9 +86,9 @@ func (s *ApmDataKeysDataSourceCrud) Get() error {
 		request.DataKeyType = oci_apm.ListDataKeysDataKeyTypeEnum(dataKeyType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm")
 
-	response, err := s.Client.ListDataKeys(context.Background(), request)
+	response, err := s.Client.ListDataKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
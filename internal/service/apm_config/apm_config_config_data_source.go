@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_config "github.com/oracle/oci-go-sdk/v61/apmconfig"
 )
@@ -27,16 +28,16 @@ func ApmConfigConfigDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmConfigConfigResource(), fieldMap, readSingularApmConfigConfig)
 }
 
-func readSingularApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmConfigConfigDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_config.ConfigClient
 	Res    *oci_apm_config.Config
 }
@@ -65,9 +66,9 @@ func (s *ApmConfigConfigDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
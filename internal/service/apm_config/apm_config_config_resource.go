@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func ApmConfigConfigResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmConfigConfig,
-		Read:     readApmConfigConfig,
-		Update:   updateApmConfigConfig,
-		Delete:   deleteApmConfigConfig,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmConfigConfig,
+		ReadContext:   readApmConfigConfig,
+		UpdateContext: updateApmConfigConfig,
+		DeleteContext: deleteApmConfigConfig,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -211,37 +212,37 @@ func ApmConfigConfigResource() *schema.Resource {
 	}
 }
 
-func createApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func createApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func readApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func updateApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func deleteApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ApmConfigConfigResourceCrud struct {
@@ -263,9 +264,10 @@ func (s *ApmConfigConfigResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.CreateConfig(context.Background(), request)
+	response, err := s.Client.CreateConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -285,9 +287,9 @@ func (s *ApmConfigConfigResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,9 +305,9 @@ func (s *ApmConfigConfigResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.UpdateConfig(context.Background(), request)
+	response, err := s.Client.UpdateConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -327,9 +329,9 @@ func (s *ApmConfigConfigResourceCrud) Delete() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_config")
 
-	_, err := s.Client.DeleteConfig(context.Background(), request)
+	_, err := s.Client.DeleteConfig(s.Context(), request)
 	return err
 }
 
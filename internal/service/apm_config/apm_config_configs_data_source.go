@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_config "github.com/oracle/oci-go-sdk/v61/apmconfig"
 )
 
 func ApmConfigConfigsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmConfigConfigs,
+		ReadContext: readApmConfigConfigs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -48,16 +49,16 @@ func ApmConfigConfigsDataSource() *schema.Resource {
 	}
 }
 
-func readApmConfigConfigs(d *schema.ResourceData, m interface{}) error {
+func readApmConfigConfigs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmConfigConfigsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_config.ConfigClient
 	Res    *oci_apm_config.ListConfigsResponse
 }
@@ -84,9 +85,9 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_config")
 
-	response, err := s.Client.ListConfigs(context.Background(), request)
+	response, err := s.Client.ListConfigs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigs(context.Background(), request)
+		listResponse, err := s.Client.ListConfigs(s.Context(), request)
 		if err != nil {
 			return err
 		}
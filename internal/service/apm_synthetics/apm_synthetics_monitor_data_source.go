@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 )
@@ -27,16 +28,16 @@ func ApmSyntheticsMonitorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmSyntheticsMonitorResource(), fieldMap, readSingularApmSyntheticsMonitor)
 }
 
-func readSingularApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsMonitorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.GetMonitorResponse
 }
@@ -65,9 +66,9 @@ func (s *ApmSyntheticsMonitorDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func ApmSyntheticsMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmSyntheticsMonitor,
-		Read:     readApmSyntheticsMonitor,
-		Update:   updateApmSyntheticsMonitor,
-		Delete:   deleteApmSyntheticsMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmSyntheticsMonitor,
+		ReadContext:   readApmSyntheticsMonitor,
+		UpdateContext: updateApmSyntheticsMonitor,
+		DeleteContext: deleteApmSyntheticsMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -428,37 +429,37 @@ func ApmSyntheticsMonitorResource() *schema.Resource {
 	}
 }
 
-func createApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func createApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func updateApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ApmSyntheticsMonitorResourceCrud struct {
@@ -577,9 +578,10 @@ func (s *ApmSyntheticsMonitorResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateMonitor(context.Background(), request)
+	response, err := s.Client.CreateMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -599,9 +601,9 @@ func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -719,9 +721,9 @@ func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateMonitor(context.Background(), request)
+	response, err := s.Client.UpdateMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -745,9 +747,9 @@ func (s *ApmSyntheticsMonitorResourceCrud) Delete() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteMonitor(context.Background(), request)
+	_, err := s.Client.DeleteMonitor(s.Context(), request)
 	return err
 }
 
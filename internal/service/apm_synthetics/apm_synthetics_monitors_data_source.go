@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -16,7 +17,7 @@ import (
 
 func ApmSyntheticsMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsMonitors,
+		ReadContext: readApmSyntheticsMonitors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -57,16 +58,16 @@ func ApmSyntheticsMonitorsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsMonitors(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsMonitors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsMonitorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.ListMonitorsResponse
 }
@@ -108,9 +109,9 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 		request.Status = oci_apm_synthetics.ListMonitorsStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.ListMonitors(context.Background(), request)
+	response, err := s.Client.ListMonitors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -119,7 +120,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListMonitors(s.Context(), request)
 		if err != nil {
 			return err
 		}
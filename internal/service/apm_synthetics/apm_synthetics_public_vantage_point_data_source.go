@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsPublicVantagePointDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmSyntheticsPublicVantagePoint,
+		ReadContext: readSingularApmSyntheticsPublicVantagePoint,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -92,16 +93,16 @@ func ApmSyntheticsPublicVantagePointDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmSyntheticsPublicVantagePoint(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsPublicVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsPublicVantagePointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsPublicVantagePointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.ListPublicVantagePointsResponse
 }
@@ -128,9 +129,9 @@ func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.ListPublicVantagePoints(context.Background(), request)
+	response, err := s.Client.ListPublicVantagePoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
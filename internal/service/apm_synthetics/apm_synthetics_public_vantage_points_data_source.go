@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsPublicVantagePointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsPublicVantagePoints,
+		ReadContext: readApmSyntheticsPublicVantagePoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -106,16 +107,16 @@ func ApmSyntheticsPublicVantagePointsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsPublicVantagePoints(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsPublicVantagePoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsPublicVantagePointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsPublicVantagePointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.ListPublicVantagePointsResponse
 }
@@ -142,9 +143,9 @@ func (s *ApmSyntheticsPublicVantagePointsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.ListPublicVantagePoints(context.Background(), request)
+	response, err := s.Client.ListPublicVantagePoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -153,7 +154,7 @@ func (s *ApmSyntheticsPublicVantagePointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicVantagePoints(context.Background(), request)
+		listResponse, err := s.Client.ListPublicVantagePoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
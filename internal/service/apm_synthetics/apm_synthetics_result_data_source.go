@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsResultDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmSyntheticsResult,
+		ReadContext: readSingularApmSyntheticsResult,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -75,16 +76,16 @@ func ApmSyntheticsResultDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmSyntheticsResult(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsResultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsResultDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.GetMonitorResultResponse
 }
@@ -126,9 +127,9 @@ func (s *ApmSyntheticsResultDataSourceCrud) Get() error {
 		request.VantagePoint = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.GetMonitorResult(context.Background(), request)
+	response, err := s.Client.GetMonitorResult(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -27,16 +28,16 @@ func ApmSyntheticsScriptDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmSyntheticsScriptResource(), fieldMap, readSingularApmSyntheticsScript)
 }
 
-func readSingularApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsScriptDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.GetScriptResponse
 }
@@ -64,9 +65,9 @@ func (s *ApmSyntheticsScriptDataSourceCrud) Get() error {
 			log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 		}
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
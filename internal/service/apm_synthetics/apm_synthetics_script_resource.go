@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func ApmSyntheticsScriptResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmSyntheticsScript,
-		Read:     readApmSyntheticsScript,
-		Update:   updateApmSyntheticsScript,
-		Delete:   deleteApmSyntheticsScript,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmSyntheticsScript,
+		ReadContext:   readApmSyntheticsScript,
+		UpdateContext: updateApmSyntheticsScript,
+		DeleteContext: deleteApmSyntheticsScript,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -175,37 +176,37 @@ func ApmSyntheticsScriptResource() *schema.Resource {
 	}
 }
 
-func createApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func createApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func updateApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func deleteApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ApmSyntheticsScriptResourceCrud struct {
@@ -275,9 +276,10 @@ func (s *ApmSyntheticsScriptResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateScript(context.Background(), request)
+	response, err := s.Client.CreateScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -297,9 +299,9 @@ func (s *ApmSyntheticsScriptResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -374,9 +376,9 @@ func (s *ApmSyntheticsScriptResourceCrud) Update() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateScript(context.Background(), request)
+	response, err := s.Client.UpdateScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -405,9 +407,9 @@ func (s *ApmSyntheticsScriptResourceCrud) Delete() error {
 			log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 		}
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteScript(context.Background(), request)
+	_, err := s.Client.DeleteScript(s.Context(), request)
 	return err
 }
 
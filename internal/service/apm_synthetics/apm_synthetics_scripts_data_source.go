@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v61/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsScriptsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsScripts,
+		ReadContext: readApmSyntheticsScripts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -48,16 +49,16 @@ func ApmSyntheticsScriptsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsScripts(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsScripts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ApmSyntheticsScriptsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_apm_synthetics.ApmSyntheticClient
 	Res    *oci_apm_synthetics.ListScriptsResponse
 }
@@ -84,9 +85,9 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "apm_synthetics")
 
-	response, err := s.Client.ListScripts(context.Background(), request)
+	response, err := s.Client.ListScripts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScripts(context.Background(), request)
+		listResponse, err := s.Client.ListScripts(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -21,10 +22,10 @@ import (
 
 func AppmgmtControlMonitorPluginManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAppmgmtControlMonitorPluginManagement,
-		Read:     readAppmgmtControlMonitorPluginManagement,
-		Delete:   deleteAppmgmtControlMonitorPluginManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAppmgmtControlMonitorPluginManagement,
+		ReadContext:   readAppmgmtControlMonitorPluginManagement,
+		DeleteContext: deleteAppmgmtControlMonitorPluginManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"monitored_instance_id": {
@@ -60,23 +61,23 @@ func AppmgmtControlMonitorPluginManagementResource() *schema.Resource {
 	}
 }
 
-func createAppmgmtControlMonitorPluginManagement(d *schema.ResourceData, m interface{}) error {
+func createAppmgmtControlMonitorPluginManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitorPluginManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAppmgmtControlMonitorPluginManagement(d *schema.ResourceData, m interface{}) error {
+func readAppmgmtControlMonitorPluginManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitorPluginManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteAppmgmtControlMonitorPluginManagement(d *schema.ResourceData, m interface{}) error {
+func deleteAppmgmtControlMonitorPluginManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//N/A - once deactivate endpoint will be implemented/public, use it here
 	return nil
 }
@@ -100,22 +101,22 @@ func (s *AppmgmtControlMonitorPluginManagementResourceCrud) Create() error {
 		request.MonitoredInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "appmgmt_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "appmgmt_control")
 
-	response, err := s.Client.ActivateMonitoringPlugin(context.Background(), request)
+	response, err := s.Client.ActivateMonitoringPlugin(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMonitorPluginManagementFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "appmgmt_control"), oci_appmgmt_control.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMonitorPluginManagementFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "appmgmt_control"), oci_appmgmt_control.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *AppmgmtControlMonitorPluginManagementResourceCrud) getMonitorPluginManagementFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_appmgmt_control.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	monitorPluginManagementId, err := monitorPluginManagementWaitForWorkRequest(workId, "monitoredInstance",
+	monitorPluginManagementId, err := monitorPluginManagementWaitForWorkRequest(s.Context(), workId, "monitoredInstance",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -149,9 +150,9 @@ func monitorPluginManagementWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, action oci_appmgmt_control.ActionTypeEnum,
+func monitorPluginManagementWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_appmgmt_control.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_appmgmt_control.AppmgmtControlClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "appmgmt_control")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "appmgmt_control")
 	retryPolicy.ShouldRetryOperation = monitorPluginManagementWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_appmgmt_control.GetWorkRequestResponse{}
@@ -168,7 +169,7 @@ func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_appmgmt_control.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -180,7 +181,7 @@ func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -197,14 +198,14 @@ func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_appmgmt_control.OperationStatusFailed || response.Status == oci_appmgmt_control.OperationStatusCanceled {
-		return nil, getErrorFromAppmgmtControlMonitorPluginManagementWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAppmgmtControlMonitorPluginManagementWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAppmgmtControlMonitorPluginManagementWorkRequest(client *oci_appmgmt_control.AppmgmtControlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_appmgmt_control.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAppmgmtControlMonitorPluginManagementWorkRequest(ctx context.Context, client *oci_appmgmt_control.AppmgmtControlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_appmgmt_control.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_appmgmt_control.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -232,9 +233,9 @@ func (s *AppmgmtControlMonitorPluginManagementResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MonitoredInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "appmgmt_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "appmgmt_control")
 
-	response, err := s.Client.GetMonitoredInstance(context.Background(), request)
+	response, err := s.Client.GetMonitoredInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_appmgmt_control "github.com/oracle/oci-go-sdk/v61/appmgmtcontrol"
 )
 
 func AppmgmtControlMonitoredInstanceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularAppmgmtControlMonitoredInstance,
+		ReadContext: readSingularAppmgmtControlMonitoredInstance,
 		Schema: map[string]*schema.Schema{
 			"monitored_instance_id": {
 				Type:     schema.TypeString,
@@ -62,16 +63,16 @@ func AppmgmtControlMonitoredInstanceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularAppmgmtControlMonitoredInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularAppmgmtControlMonitoredInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitoredInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AppmgmtControlMonitoredInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_appmgmt_control.AppmgmtControlClient
 	Res    *oci_appmgmt_control.GetMonitoredInstanceResponse
 }
@@ -88,9 +89,9 @@ func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) Get() error {
 		request.MonitoredInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "appmgmt_control")
 
-	response, err := s.Client.GetMonitoredInstance(context.Background(), request)
+	response, err := s.Client.GetMonitoredInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_appmgmt_control "github.com/oracle/oci-go-sdk/v61/appmgmtcontrol"
 )
 
 func AppmgmtControlMonitoredInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAppmgmtControlMonitoredInstances,
+		ReadContext: readAppmgmtControlMonitoredInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -87,16 +88,16 @@ func AppmgmtControlMonitoredInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readAppmgmtControlMonitoredInstances(d *schema.ResourceData, m interface{}) error {
+func readAppmgmtControlMonitoredInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitoredInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AppmgmtControlMonitoredInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_appmgmt_control.AppmgmtControlClient
 	Res    *oci_appmgmt_control.ListMonitoredInstancesResponse
 }
@@ -118,9 +119,9 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "appmgmt_control")
 
-	response, err := s.Client.ListMonitoredInstances(context.Background(), request)
+	response, err := s.Client.ListMonitoredInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitoredInstances(context.Background(), request)
+		listResponse, err := s.Client.ListMonitoredInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
@@ -16,11 +17,11 @@ import (
 
 func ArtifactsContainerConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerConfiguration,
-		Read:     readArtifactsContainerConfiguration,
-		Update:   updateArtifactsContainerConfiguration,
-		Delete:   deleteArtifactsContainerConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerConfiguration,
+		ReadContext:   readArtifactsContainerConfiguration,
+		UpdateContext: updateArtifactsContainerConfiguration,
+		DeleteContext: deleteArtifactsContainerConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -43,31 +44,31 @@ func ArtifactsContainerConfigurationResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -95,9 +96,9 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Create() error {
 		request.IsRepositoryCreatedOnFirstPush = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,9 +115,9 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerConfiguration(context.Background(), request)
+	response, err := s.Client.GetContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -138,9 +139,9 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Update() error {
 		request.IsRepositoryCreatedOnFirstPush = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -16,7 +17,7 @@ import (
 
 func ArtifactsContainerImageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularArtifactsContainerImage,
+		ReadContext: readSingularArtifactsContainerImage,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:     schema.TypeString,
@@ -129,16 +130,16 @@ func ArtifactsContainerImageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularArtifactsContainerImage(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerImageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.GetContainerImageResponse
 }
@@ -155,9 +156,9 @@ func (s *ArtifactsContainerImageDataSourceCrud) Get() error {
 		request.ImageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.GetContainerImage(context.Background(), request)
+	response, err := s.Client.GetContainerImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
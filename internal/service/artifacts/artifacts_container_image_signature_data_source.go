@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -22,16 +23,16 @@ func ArtifactsContainerImageSignatureDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsContainerImageSignatureResource(), fieldMap, readSingularArtifactsContainerImageSignature)
 }
 
-func readSingularArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerImageSignatureDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.GetContainerImageSignatureResponse
 }
@@ -48,9 +49,9 @@ func (s *ArtifactsContainerImageSignatureDataSourceCrud) Get() error {
 		request.ImageSignatureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.GetContainerImageSignature(context.Background(), request)
+	response, err := s.Client.GetContainerImageSignature(s.Context(), request)
 	if err != nil {
 		return err
 	}
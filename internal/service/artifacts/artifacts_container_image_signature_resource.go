@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
@@ -19,10 +20,10 @@ func ArtifactsContainerImageSignatureResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerImageSignature,
-		Read:     readArtifactsContainerImageSignature,
-		Delete:   deleteArtifactsContainerImageSignature,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerImageSignature,
+		ReadContext:   readArtifactsContainerImageSignature,
+		DeleteContext: deleteArtifactsContainerImageSignature,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -80,29 +81,29 @@ func ArtifactsContainerImageSignatureResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ArtifactsContainerImageSignatureResourceCrud struct {
@@ -153,9 +154,10 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Create() error {
 		request.SigningAlgorithm = oci_artifacts.CreateContainerImageSignatureDetailsSigningAlgorithmEnum(signingAlgorithm.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerImageSignature(context.Background(), request)
+	response, err := s.Client.CreateContainerImageSignature(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,9 +172,9 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
 	imageSignatureId := s.D.Id()
 	request.ImageSignatureId = &imageSignatureId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerImageSignature(context.Background(), request)
+	response, err := s.Client.GetContainerImageSignature(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,9 +189,9 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ImageSignatureId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerImageSignature(context.Background(), request)
+	_, err := s.Client.DeleteContainerImageSignature(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsContainerImageSignaturesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerImageSignatures,
+		ReadContext: readArtifactsContainerImageSignatures,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -81,16 +82,16 @@ func ArtifactsContainerImageSignaturesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerImageSignatures(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImageSignatures(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignaturesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerImageSignaturesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.ListContainerImageSignaturesResponse
 }
@@ -151,9 +152,9 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 		request.SigningAlgorithm = oci_artifacts.ListContainerImageSignaturesSigningAlgorithmEnum(signingAlgorithm.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+	response, err := s.Client.ListContainerImageSignatures(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImageSignatures(s.Context(), request)
 		if err != nil {
 			return err
 		}
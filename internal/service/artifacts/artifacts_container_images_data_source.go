@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsContainerImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerImages,
+		ReadContext: readArtifactsContainerImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -190,16 +191,16 @@ func ArtifactsContainerImagesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerImages(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerImagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.ListContainerImagesResponse
 }
@@ -256,9 +257,9 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.ListContainerImages(context.Background(), request)
+	response, err := s.Client.ListContainerImages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +268,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImages(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImages(s.Context(), request)
 		if err != nil {
 			return err
 		}
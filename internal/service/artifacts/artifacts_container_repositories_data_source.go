@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -16,7 +17,7 @@ import (
 
 func ArtifactsContainerRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerRepositories,
+		ReadContext: readArtifactsContainerRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -86,16 +87,16 @@ func ArtifactsContainerRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerRepositories(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerRepositoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.ListContainerRepositoriesResponse
 }
@@ -137,9 +138,9 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 		request.LifecycleState = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.ListContainerRepositories(context.Background(), request)
+	response, err := s.Client.ListContainerRepositories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListContainerRepositories(s.Context(), request)
 		if err != nil {
 			return err
 		}
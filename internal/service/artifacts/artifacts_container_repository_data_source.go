@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -23,16 +24,16 @@ func ArtifactsContainerRepositoryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsContainerRepositoryResource(), fieldMap, readSingularArtifactsContainerRepository)
 }
 
-func readSingularArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsContainerRepositoryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.GetContainerRepositoryResponse
 }
@@ -49,9 +50,9 @@ func (s *ArtifactsContainerRepositoryDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.GetContainerRepository(context.Background(), request)
+	response, err := s.Client.GetContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
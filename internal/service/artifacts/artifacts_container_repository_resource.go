@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
@@ -21,11 +22,11 @@ func ArtifactsContainerRepositoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerRepository,
-		Read:     readArtifactsContainerRepository,
-		Update:   updateArtifactsContainerRepository,
-		Delete:   deleteArtifactsContainerRepository,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerRepository,
+		ReadContext:   readArtifactsContainerRepository,
+		UpdateContext: updateArtifactsContainerRepository,
+		DeleteContext: deleteArtifactsContainerRepository,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -111,37 +112,37 @@ func ArtifactsContainerRepositoryResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ArtifactsContainerRepositoryResourceCrud struct {
@@ -211,9 +212,10 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerRepository(context.Background(), request)
+	response, err := s.Client.CreateContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,9 +230,9 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerRepository(context.Background(), request)
+	response, err := s.Client.GetContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -275,9 +277,9 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerRepository(context.Background(), request)
+	response, err := s.Client.UpdateContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,9 +294,9 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerRepository(context.Background(), request)
+	_, err := s.Client.DeleteContainerRepository(s.Context(), request)
 	return err
 }
 
@@ -435,14 +437,14 @@ func (s *ArtifactsContainerRepositoryResourceCrud) updateCompartment(compartment
 	tmp := s.D.Id()
 	changeCompartmentRequest.RepositoryId = &tmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeContainerRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerRepositoryCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
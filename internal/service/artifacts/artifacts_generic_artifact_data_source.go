@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -23,16 +24,16 @@ func ArtifactsGenericArtifactDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsGenericArtifactResource(), fieldMap, readSingularArtifactsGenericArtifact)
 }
 
-func readSingularArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsGenericArtifactDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.GetGenericArtifactResponse
 }
@@ -49,9 +50,9 @@ func (s *ArtifactsGenericArtifactDataSourceCrud) Get() error {
 		request.ArtifactId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
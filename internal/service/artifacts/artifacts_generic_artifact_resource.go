@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
@@ -17,11 +18,11 @@ import (
 
 func ArtifactsGenericArtifactResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsGenericArtifact,
-		Read:     readArtifactsGenericArtifact,
-		Update:   updateArtifactsGenericArtifact,
-		Delete:   deleteArtifactsGenericArtifact,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsGenericArtifact,
+		ReadContext:   readArtifactsGenericArtifact,
+		UpdateContext: updateArtifactsGenericArtifact,
+		DeleteContext: deleteArtifactsGenericArtifact,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"artifact_id": {
@@ -86,37 +87,37 @@ func ArtifactsGenericArtifactResource() *schema.Resource {
 	}
 }
 
-func createArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func createArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func readArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ArtifactsGenericArtifactResourceCrud struct {
@@ -172,9 +173,9 @@ func (s *ArtifactsGenericArtifactResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -191,9 +192,9 @@ func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
 		request.ArtifactId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -222,9 +223,9 @@ func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +242,9 @@ func (s *ArtifactsGenericArtifactResourceCrud) Delete() error {
 		request.ArtifactId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteGenericArtifact(context.Background(), request)
+	_, err := s.Client.DeleteGenericArtifact(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsGenericArtifactsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsGenericArtifacts,
+		ReadContext: readArtifactsGenericArtifacts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"artifact_path": {
@@ -68,16 +69,16 @@ func ArtifactsGenericArtifactsDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsGenericArtifacts(d *schema.ResourceData, m interface{}) error {
+func readArtifactsGenericArtifacts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsGenericArtifactsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.ListGenericArtifactsResponse
 }
@@ -129,9 +130,9 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.ListGenericArtifacts(context.Background(), request)
+	response, err := s.Client.ListGenericArtifacts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGenericArtifacts(context.Background(), request)
+		listResponse, err := s.Client.ListGenericArtifacts(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsRepositories,
+		ReadContext: readArtifactsRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func ArtifactsRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsRepositories(d *schema.ResourceData, m interface{}) error {
+func readArtifactsRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ArtifactsRepositoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_artifacts.ArtifactsClient
 	Res    *oci_artifacts.ListRepositoriesResponse
 }
@@ -102,9 +103,9 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 		request.LifecycleState = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "artifacts")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v61/artifacts"
@@ -22,11 +23,11 @@ func ArtifactsRepositoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsRepository,
-		Read:     readArtifactsRepository,
-		Update:   updateArtifactsRepository,
-		Delete:   deleteArtifactsRepository,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsRepository,
+		ReadContext:   readArtifactsRepository,
+		UpdateContext: updateArtifactsRepository,
+		DeleteContext: deleteArtifactsRepository,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -81,37 +82,37 @@ func ArtifactsRepositoryResource() *schema.Resource {
 	}
 }
 
-func createArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func createArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func readArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ArtifactsRepositoryResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *ArtifactsRepositoryResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateRepository(context.Background(), request)
+	response, err := s.Client.CreateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,9 +174,9 @@ func (s *ArtifactsRepositoryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,9 +201,9 @@ func (s *ArtifactsRepositoryResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateRepository(context.Background(), request)
+	response, err := s.Client.UpdateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *ArtifactsRepositoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteRepository(context.Background(), request)
+	_, err := s.Client.DeleteRepository(s.Context(), request)
 	return err
 }
 
@@ -398,14 +400,14 @@ func (s *ArtifactsRepositoryResourceCrud) updateCompartment(compartment interfac
 	idTmp := s.D.Id()
 	changeCompartmentRequest.RepositoryId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRepositoryCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_audit "github.com/oracle/oci-go-sdk/v61/audit"
 )
@@ -23,16 +24,16 @@ func AuditConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AuditConfigurationResource(), fieldMap, readSingularAuditConfiguration)
 }
 
-func readSingularAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AuditConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_audit.AuditClient
 	Res    *oci_audit.GetConfigurationResponse
 }
@@ -49,9 +50,9 @@ func (s *AuditConfigurationDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_audit "github.com/oracle/oci-go-sdk/v61/audit"
@@ -17,11 +18,11 @@ import (
 
 func AuditConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAuditConfiguration,
-		Read:     readAuditConfiguration,
-		Update:   updateAuditConfiguration,
-		Delete:   deleteAuditConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAuditConfiguration,
+		ReadContext:   readAuditConfiguration,
+		UpdateContext: updateAuditConfiguration,
+		DeleteContext: deleteAuditConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -41,31 +42,31 @@ func AuditConfigurationResource() *schema.Resource {
 	}
 }
 
-func createAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func createAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func readAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -93,9 +94,9 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -117,21 +118,23 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 		request.RetentionPeriodDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "audit")
 
-	_, err := s.Client.UpdateConfiguration(context.Background(), request)
+	_, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	// Workaround: Sleep for some time before polling the.Configuration. Because Update happens asynchronously, polling too
 	// soon may result in service returning stale.Configuration values.
-	time.Sleep(time.Second * 5)
+	if err := tfresource.SleepContext(s.Context(), time.Second*5); err != nil {
+		return err
+	}
 
 	// Requests to Update the retention policy may succeed instantly but may not see the actual Update take effect
 	// until minutes later. Add polling here to return only when the change has taken effect.
 	retentionPolicyFunc := func() bool { return *s.Res.RetentionPeriodDays == *request.RetentionPeriodDays }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AuditConfigurationResourceCrud) SetData() error {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_audit "github.com/oracle/oci-go-sdk/v61/audit"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -18,7 +19,7 @@ import (
 
 func AuditAuditEventsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAuditAuditEvents,
+		ReadContext: readAuditAuditEvents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -33,6 +34,7 @@ func AuditAuditEventsDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_results": tfresource.DataSourceMaxResultsSchema(),
 			"audit_events": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -280,18 +282,20 @@ func AuditAuditEventsDataSource() *schema.Resource {
 	}
 }
 
-func readAuditAuditEvents(d *schema.ResourceData, m interface{}) error {
+func readAuditAuditEvents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditAuditEventsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AuditAuditEventsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_audit.AuditClient
 	Res    *oci_audit.ListEventsResponse
+	// The events of Res converted by ListDataSourceItemsInPartitions, which SetData does not need to convert again
+	auditEvents []map[string]interface{}
 }
 
 func (s *AuditAuditEventsDataSourceCrud) VoidState() {
@@ -322,24 +326,46 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 		request.StartTime = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "audit")
+
+	// ListEvents does not support a page size, the limit is ignored
+	listPage := func(request oci_audit.ListEventsRequest) tfresource.ListPageFunc {
+		return func(ctx context.Context, page *string, limit *int) ([]interface{}, *string, error) {
+			pageRequest := request
+			pageRequest.Page = page
+			listResponse, err := s.Client.ListEvents(ctx, pageRequest)
+			if err != nil {
+				return nil, nil, err
+			}
+			items := make([]interface{}, 0, len(listResponse.Items))
+			for _, item := range listResponse.Items {
+				items = append(items, item)
+			}
+			return items, listResponse.OpcNextPage, nil
+		}
+	}
+	toMap := func(item interface{}) map[string]interface{} {
+		return auditEventToMap(item.(oci_audit.AuditEvent))
+	}
+
+	// The events of consecutive time windows are listed concurrently, the end time of a window is exclusive
+	partitions := []tfresource.ListPageFunc{}
+	for _, window := range auditEventsTimeWindows(request.StartTime, request.EndTime) {
+		windowRequest := request
+		windowRequest.StartTime = window[0]
+		windowRequest.EndTime = window[1]
+		partitions = append(partitions, listPage(windowRequest))
+	}
 
-	response, err := s.Client.ListEvents(context.Background(), request)
+	items, auditEvents, err := tfresource.ListDataSourceItemsInPartitions(s.Context(), s.D, AuditAuditEventsDataSource().Schema["audit_events"].Elem.(*schema.Resource).Schema, partitions, toMap)
 	if err != nil {
 		return err
 	}
+	s.auditEvents = auditEvents
 
-	s.Res = &response
-	request.Page = s.Res.OpcNextPage
-
-	for request.Page != nil {
-		listResponse, err := s.Client.ListEvents(context.Background(), request)
-		if err != nil {
-			return err
-		}
-
-		s.Res.Items = append(s.Res.Items, listResponse.Items...)
-		request.Page = listResponse.OpcNextPage
+	s.Res = &oci_audit.ListEventsResponse{}
+	for _, item := range items {
+		s.Res.Items = append(s.Res.Items, item.(oci_audit.AuditEvent))
 	}
 
 	return nil
@@ -351,57 +377,89 @@ func (s *AuditAuditEventsDataSourceCrud) SetData() error {
 	}
 
 	s.D.SetId(tfresource.GenerateDataSourceHashID("AuditAuditEventsDataSource-", AuditAuditEventsDataSource(), s.D))
-	resources := []map[string]interface{}{}
+	resources := s.auditEvents
+	if resources == nil {
+		resources = []map[string]interface{}{}
+		for _, r := range s.Res.Items {
+			resources = append(resources, auditEventToMap(r))
+		}
+	}
 
-	for _, r := range s.Res.Items {
-		auditEvent := map[string]interface{}{}
+	if err := s.D.Set("audit_events", resources); err != nil {
+		return err
+	}
 
-		if r.CloudEventsVersion != nil {
-			auditEvent["cloud_events_version"] = *r.CloudEventsVersion
-		}
+	return nil
+}
 
-		if r.ContentType != nil {
-			auditEvent["content_type"] = *r.ContentType
-		}
+const (
+	auditEventsMaxTimeWindows = 4
+	auditEventsMinTimeWindow  = time.Hour
+)
 
-		if r.Data != nil {
-			auditEvent["data"] = []interface{}{dataToMap(r.Data)}
-		} else {
-			auditEvent["data"] = nil
+// auditEventsTimeWindows splits the time range of the events into up to auditEventsMaxTimeWindows windows of at least
+// auditEventsMinTimeWindow
+func auditEventsTimeWindows(startTime *oci_common.SDKTime, endTime *oci_common.SDKTime) [][2]*oci_common.SDKTime {
+	if startTime == nil || endTime == nil || !endTime.After(startTime.Time) {
+		return [][2]*oci_common.SDKTime{{startTime, endTime}}
+	}
+	count := int(endTime.Sub(startTime.Time) / auditEventsMinTimeWindow)
+	if count < 1 {
+		count = 1
+	} else if count > auditEventsMaxTimeWindows {
+		count = auditEventsMaxTimeWindows
+	}
+	window := endTime.Sub(startTime.Time) / time.Duration(count)
+	windows := make([][2]*oci_common.SDKTime, 0, count)
+	for i := 0; i < count; i++ {
+		windowStart := &oci_common.SDKTime{Time: startTime.Add(time.Duration(i) * window)}
+		windowEnd := &oci_common.SDKTime{Time: startTime.Add(time.Duration(i+1) * window)}
+		if i == count-1 {
+			windowEnd = endTime
 		}
+		windows = append(windows, [2]*oci_common.SDKTime{windowStart, windowEnd})
+	}
+	return windows
+}
 
-		if r.EventId != nil {
-			auditEvent["event_id"] = *r.EventId
-		}
+func auditEventToMap(r oci_audit.AuditEvent) map[string]interface{} {
+	auditEvent := map[string]interface{}{}
 
-		if r.EventTime != nil {
-			auditEvent["event_time"] = r.EventTime.String()
-		}
+	if r.CloudEventsVersion != nil {
+		auditEvent["cloud_events_version"] = *r.CloudEventsVersion
+	}
 
-		if r.EventType != nil {
-			auditEvent["event_type"] = *r.EventType
-		}
+	if r.ContentType != nil {
+		auditEvent["content_type"] = *r.ContentType
+	}
 
-		if r.EventTypeVersion != nil {
-			auditEvent["event_type_version"] = *r.EventTypeVersion
-		}
+	if r.Data != nil {
+		auditEvent["data"] = []interface{}{dataToMap(r.Data)}
+	} else {
+		auditEvent["data"] = nil
+	}
 
-		if r.Source != nil {
-			auditEvent["source"] = *r.Source
-		}
+	if r.EventId != nil {
+		auditEvent["event_id"] = *r.EventId
+	}
 
-		resources = append(resources, auditEvent)
+	if r.EventTime != nil {
+		auditEvent["event_time"] = r.EventTime.String()
 	}
 
-	if f, fOk := s.D.GetOkExists("filter"); fOk {
-		resources = tfresource.ApplyFilters(f.(*schema.Set), resources, AuditAuditEventsDataSource().Schema["audit_events"].Elem.(*schema.Resource).Schema)
+	if r.EventType != nil {
+		auditEvent["event_type"] = *r.EventType
 	}
 
-	if err := s.D.Set("audit_events", resources); err != nil {
-		return err
+	if r.EventTypeVersion != nil {
+		auditEvent["event_type_version"] = *r.EventTypeVersion
 	}
 
-	return nil
+	if r.Source != nil {
+		auditEvent["source"] = *r.Source
+	}
+
+	return auditEvent
 }
 
 func dataToMap(obj *oci_audit.Data) map[string]interface{} {
@@ -6,6 +6,7 @@ package autoscaling
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v61/autoscaling"
 
@@ -22,16 +23,16 @@ func AutoScalingAutoScalingConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AutoScalingAutoScalingConfigurationResource(), fieldMap, readSingularAutoScalingAutoScalingConfiguration)
 }
 
-func readSingularAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AutoScalingAutoScalingConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_auto_scaling.AutoScalingClient
 	Res    *oci_auto_scaling.GetAutoScalingConfigurationResponse
 }
@@ -48,9 +49,9 @@ func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) Get() error {
 		request.AutoScalingConfigurationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAutoScalingAutoScalingConfiguration,
-		Read:     readAutoScalingAutoScalingConfiguration,
-		Update:   updateAutoScalingAutoScalingConfiguration,
-		Delete:   deleteAutoScalingAutoScalingConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAutoScalingAutoScalingConfiguration,
+		ReadContext:   readAutoScalingAutoScalingConfiguration,
+		UpdateContext: updateAutoScalingAutoScalingConfiguration,
+		DeleteContext: deleteAutoScalingAutoScalingConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"auto_scaling_resources": {
@@ -363,37 +364,37 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 	}
 }
 
-func createAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func createAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AutoScalingAutoScalingConfigurationResourceCrud struct {
@@ -470,9 +471,10 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.CreateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.CreateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -487,9 +489,9 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutoScalingConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -549,9 +551,9 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 		return s.Get()
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -566,9 +568,9 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutoScalingConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.DeleteAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteAutoScalingConfiguration(s.Context(), request)
 	return err
 }
 
@@ -1160,14 +1162,14 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(comp
 	changeCompartmentRequest.ChangeCompartmentDetails = oci_auto_scaling.ChangeAutoScalingCompartmentDetails{}
 	changeCompartmentRequest.ChangeCompartmentDetails.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package autoscaling
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v61/autoscaling"
 
@@ -15,7 +16,7 @@ import (
 
 func AutoScalingAutoScalingConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAutoScalingAutoScalingConfigurations,
+		ReadContext: readAutoScalingAutoScalingConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func AutoScalingAutoScalingConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readAutoScalingAutoScalingConfigurations(d *schema.ResourceData, m interface{}) error {
+func readAutoScalingAutoScalingConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AutoScalingAutoScalingConfigurationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_auto_scaling.AutoScalingClient
 	Res    *oci_auto_scaling.ListAutoScalingConfigurationsResponse
 }
@@ -66,9 +67,9 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "auto_scaling")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
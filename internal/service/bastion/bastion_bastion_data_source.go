@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v61/bastion"
 )
@@ -22,16 +23,16 @@ func BastionBastionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BastionBastionResource(), fieldMap, readSingularBastionBastion)
 }
 
-func readSingularBastionBastion(d *schema.ResourceData, m interface{}) error {
+func readSingularBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BastionBastionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bastion.BastionClient
 	Res    *oci_bastion.GetBastionResponse
 }
@@ -48,9 +49,9 @@ func (s *BastionBastionDataSourceCrud) Get() error {
 		request.BastionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bastion")
 
-	response, err := s.Client.GetBastion(context.Background(), request)
+	response, err := s.Client.GetBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func BastionBastionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBastionBastion,
-		Read:     readBastionBastion,
-		Update:   updateBastionBastion,
-		Delete:   deleteBastionBastion,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBastionBastion,
+		ReadContext:   readBastionBastion,
+		UpdateContext: updateBastionBastion,
+		DeleteContext: deleteBastionBastion,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bastion_type": {
@@ -133,37 +134,37 @@ func BastionBastionResource() *schema.Resource {
 	}
 }
 
-func createBastionBastion(d *schema.ResourceData, m interface{}) error {
+func createBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBastionBastion(d *schema.ResourceData, m interface{}) error {
+func readBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBastionBastion(d *schema.ResourceData, m interface{}) error {
+func updateBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBastionBastion(d *schema.ResourceData, m interface{}) error {
+func deleteBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BastionBastionResourceCrud struct {
@@ -272,22 +273,23 @@ func (s *BastionBastionResourceCrud) Create() error {
 		request.TargetSubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.CreateBastion(context.Background(), request)
+	response, err := s.Client.CreateBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBastionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBastionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *BastionBastionResourceCrud) getBastionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bastion.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bastionId, err := bastionWaitForWorkRequest(workId, "bastion",
+	bastionId, err := bastionWaitForWorkRequest(s.Context(), workId, "bastion",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -321,9 +323,9 @@ func bastionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastion.ActionTypeEnum,
+func bastionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bastion.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bastion.BastionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bastion")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "bastion")
 	retryPolicy.ShouldRetryOperation = bastionWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_bastion.GetWorkRequestResponse{}
@@ -340,7 +342,7 @@ func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bastion.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -352,7 +354,7 @@ func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -369,14 +371,14 @@ func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bastion.OperationStatusFailed || response.Status == oci_bastion.OperationStatusCanceled {
-		return nil, getErrorFromBastionBastionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBastionBastionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBastionBastionWorkRequest(client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBastionBastionWorkRequest(ctx context.Context, client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bastion.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -404,9 +406,9 @@ func (s *BastionBastionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BastionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.GetBastion(context.Background(), request)
+	response, err := s.Client.GetBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -473,15 +475,15 @@ func (s *BastionBastionResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.UpdateBastion(context.Background(), request)
+	response, err := s.Client.UpdateBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBastionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBastionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BastionBastionResourceCrud) Delete() error {
@@ -490,16 +492,16 @@ func (s *BastionBastionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BastionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.DeleteBastion(context.Background(), request)
+	response, err := s.Client.DeleteBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bastionWaitForWorkRequest(workId, "bastion", oci_bastion.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	_, delWorkRequestErr := bastionWaitForWorkRequest(s.Context(), workId, "bastion", oci_bastion.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
@@ -580,14 +582,14 @@ func (s *BastionBastionResourceCrud) updateCompartment(compartment interface{})
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	_, err := s.Client.ChangeBastionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBastionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
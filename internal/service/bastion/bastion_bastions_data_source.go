@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v61/bastion"
 )
 
 func BastionBastionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBastionBastions,
+		ReadContext: readBastionBastions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bastion_id": {
@@ -43,16 +44,16 @@ func BastionBastionsDataSource() *schema.Resource {
 	}
 }
 
-func readBastionBastions(d *schema.ResourceData, m interface{}) error {
+func readBastionBastions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BastionBastionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bastion.BastionClient
 	Res    *oci_bastion.ListBastionsResponse
 }
@@ -83,9 +84,9 @@ func (s *BastionBastionsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bastion")
 
-	response, err := s.Client.ListBastions(context.Background(), request)
+	response, err := s.Client.ListBastions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *BastionBastionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBastions(context.Background(), request)
+		listResponse, err := s.Client.ListBastions(s.Context(), request)
 		if err != nil {
 			return err
 		}
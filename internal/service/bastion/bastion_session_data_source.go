@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v61/bastion"
 )
@@ -22,16 +23,16 @@ func BastionSessionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BastionSessionResource(), fieldMap, readSingularBastionSession)
 }
 
-func readSingularBastionSession(d *schema.ResourceData, m interface{}) error {
+func readSingularBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BastionSessionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bastion.BastionClient
 	Res    *oci_bastion.GetSessionResponse
 }
@@ -48,9 +49,9 @@ func (s *BastionSessionDataSourceCrud) Get() error {
 		request.SessionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
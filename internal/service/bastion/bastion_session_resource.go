@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func BastionSessionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBastionSession,
-		Read:     readBastionSession,
-		Update:   updateBastionSession,
-		Delete:   deleteBastionSession,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBastionSession,
+		ReadContext:   readBastionSession,
+		UpdateContext: updateBastionSession,
+		DeleteContext: deleteBastionSession,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bastion_id": {
@@ -170,37 +171,37 @@ func BastionSessionResource() *schema.Resource {
 	}
 }
 
-func createBastionSession(d *schema.ResourceData, m interface{}) error {
+func createBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBastionSession(d *schema.ResourceData, m interface{}) error {
+func readBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBastionSession(d *schema.ResourceData, m interface{}) error {
+func updateBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBastionSession(d *schema.ResourceData, m interface{}) error {
+func deleteBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BastionSessionResourceCrud struct {
@@ -282,22 +283,23 @@ func (s *BastionSessionResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.CreateSession(context.Background(), request)
+	response, err := s.Client.CreateSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSessionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSessionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *BastionSessionResourceCrud) getSessionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bastion.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sessionId, err := sessionWaitForWorkRequest(workId, "session",
+	sessionId, err := sessionWaitForWorkRequest(s.Context(), workId, "session",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -331,9 +333,9 @@ func sessionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastion.ActionTypeEnum,
+func sessionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bastion.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bastion.BastionClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bastion")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "bastion")
 	retryPolicy.ShouldRetryOperation = sessionWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_bastion.GetWorkRequestResponse{}
@@ -350,7 +352,7 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bastion.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -362,7 +364,7 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -380,14 +382,14 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 	log.Printf("[DEBUG] identifier is %v status is %v \n", identifier, response.Status)
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bastion.OperationStatusFailed || response.Status == oci_bastion.OperationStatusCanceled {
-		return nil, getErrorFromBastionSessionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBastionSessionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBastionSessionWorkRequest(client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBastionSessionWorkRequest(ctx context.Context, client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bastion.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -415,9 +417,9 @@ func (s *BastionSessionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -437,9 +439,9 @@ func (s *BastionSessionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.UpdateSession(context.Background(), request)
+	response, err := s.Client.UpdateSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -454,16 +456,16 @@ func (s *BastionSessionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.DeleteSession(context.Background(), request)
+	response, err := s.Client.DeleteSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := sessionWaitForWorkRequest(workId, "session",
+	_, delWorkRequestErr := sessionWaitForWorkRequest(s.Context(), workId, "session",
 		oci_bastion.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
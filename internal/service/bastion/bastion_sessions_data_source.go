@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v61/bastion"
 )
 
 func BastionSessionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBastionSessions,
+		ReadContext: readBastionSessions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bastion_id": {
@@ -43,16 +44,16 @@ func BastionSessionsDataSource() *schema.Resource {
 	}
 }
 
-func readBastionSessions(d *schema.ResourceData, m interface{}) error {
+func readBastionSessions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BastionSessionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bastion.BastionClient
 	Res    *oci_bastion.ListSessionsResponse
 }
@@ -83,9 +84,9 @@ func (s *BastionSessionsDataSourceCrud) Get() error {
 		request.SessionLifecycleState = oci_bastion.ListSessionsSessionLifecycleStateEnum(sessionLifecycleState.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bastion")
 
-	response, err := s.Client.ListSessions(context.Background(), request)
+	response, err := s.Client.ListSessions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *BastionSessionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSessions(context.Background(), request)
+		listResponse, err := s.Client.ListSessions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
@@ -26,16 +27,16 @@ func BdsAutoScalingConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsAutoScalingConfigurationResource(), fieldMap, readSingularBdsAutoScalingConfiguration)
 }
 
-func readSingularBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsAutoScalingConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.GetAutoScalingConfigurationResponse
 }
@@ -57,9 +58,9 @@ func (s *BdsAutoScalingConfigurationDataSourceCrud) Get() error {
 		request.BdsInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,11 +28,11 @@ func BdsAutoScalingConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBdsAutoScalingConfiguration,
-		Read:     readBdsAutoScalingConfiguration,
-		Update:   updateBdsAutoScalingConfiguration,
-		Delete:   deleteBdsAutoScalingConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBdsAutoScalingConfiguration,
+		ReadContext:   readBdsAutoScalingConfiguration,
+		UpdateContext: updateBdsAutoScalingConfiguration,
+		DeleteContext: deleteBdsAutoScalingConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bds_instance_id": {
@@ -160,36 +161,36 @@ func BdsAutoScalingConfigurationResource() *schema.Resource {
 	}
 }
 
-func createBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func createBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BdsAutoScalingConfigurationResourceCrud struct {
@@ -265,22 +266,23 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.AddAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *BdsAutoScalingConfigurationResourceCrud) getAutoScalingConfigurationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	compartmentId, err := autoScalingConfigurationWaitForWorkRequest(workId, "bds",
+	compartmentId, err := autoScalingConfigurationWaitForWorkRequest(s.Context(), workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -330,9 +332,9 @@ func autoScalingConfigurationWorkRequestShouldRetryFunc(timeout time.Duration) f
 	}
 }
 
-func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func autoScalingConfigurationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = autoScalingConfigurationWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_bds.GetWorkRequestResponse{}
@@ -349,7 +351,7 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -361,7 +363,7 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -372,14 +374,14 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	if compartmentId == nil {
-		return nil, getErrorFromBdsAutoScalingConfigurationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsAutoScalingConfigurationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return compartmentId, nil
 }
 
-func getErrorFromBdsAutoScalingConfigurationWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsAutoScalingConfigurationWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -418,9 +420,9 @@ func (s *BdsAutoScalingConfigurationResourceCrud) List(compartmentId *string) (*
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -449,9 +451,9 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -497,15 +499,15 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsAutoScalingConfigurationResourceCrud) Delete() error {
@@ -524,9 +526,9 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Delete() error {
 		request.ClusterAdminPassword = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	_, err := s.Client.RemoveAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.RemoveAutoScalingConfiguration(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
 
 func BdsAutoScalingConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsAutoScalingConfigurations,
+		ReadContext: readBdsAutoScalingConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_instance_id": {
@@ -43,16 +44,16 @@ func BdsAutoScalingConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readBdsAutoScalingConfigurations(d *schema.ResourceData, m interface{}) error {
+func readBdsAutoScalingConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsAutoScalingConfigurationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.ListAutoScalingConfigurationsResponse
 }
@@ -79,9 +80,9 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -90,7 +91,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
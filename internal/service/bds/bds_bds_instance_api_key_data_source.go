@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
@@ -26,16 +27,16 @@ func BdsBdsInstanceApiKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsBdsInstanceApiKeyResource(), fieldMap, readSingularBdsBdsInstanceApiKey)
 }
 
-func readSingularBdsBdsInstanceApiKey(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsBdsInstanceApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceApiKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstanceApiKeyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.GetBdsApiKeyResponse
 }
@@ -57,9 +58,9 @@ func (s *BdsBdsInstanceApiKeyDataSourceCrud) Get() error {
 		request.BdsInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.GetBdsApiKey(context.Background(), request)
+	response, err := s.Client.GetBdsApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
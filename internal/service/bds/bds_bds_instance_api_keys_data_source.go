@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
 
 func BdsBdsInstanceApiKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstanceApiKeys,
+		ReadContext: readBdsBdsInstanceApiKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_instance_id": {
@@ -43,16 +44,16 @@ func BdsBdsInstanceApiKeysDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstanceApiKeys(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstanceApiKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceApiKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstanceApiKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.ListBdsApiKeysResponse
 }
@@ -83,9 +84,9 @@ func (s *BdsBdsInstanceApiKeysDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.ListBdsApiKeys(context.Background(), request)
+	response, err := s.Client.ListBdsApiKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *BdsBdsInstanceApiKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBdsApiKeys(context.Background(), request)
+		listResponse, err := s.Client.ListBdsApiKeys(s.Context(), request)
 		if err != nil {
 			return err
 		}
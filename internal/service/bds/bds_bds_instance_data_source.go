@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
@@ -22,16 +23,16 @@ func BdsBdsInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsBdsInstanceResource(), fieldMap, readSingularBdsBdsInstance)
 }
 
-func readSingularBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.GetBdsInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *BdsBdsInstanceDataSourceCrud) Get() error {
 		request.BdsInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package bds
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 
@@ -26,16 +27,16 @@ func BdsBdsInstanceMetastoreConfigDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsBdsInstanceMetastoreConfigResource(), fieldMap, readSingularBdsBdsInstanceMetastoreConfig)
 }
 
-func readSingularBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstanceMetastoreConfigDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.GetBdsMetastoreConfigurationResponse
 }
@@ -57,9 +58,9 @@ func (s *BdsBdsInstanceMetastoreConfigDataSourceCrud) Get() error {
 		request.MetastoreConfigId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.GetBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.GetBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
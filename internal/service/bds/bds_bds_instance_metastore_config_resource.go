@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,11 +28,11 @@ func BdsBdsInstanceMetastoreConfigResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBdsBdsInstanceMetastoreConfig,
-		Read:     readBdsBdsInstanceMetastoreConfig,
-		Update:   updateBdsBdsInstanceMetastoreConfig,
-		Delete:   deleteBdsBdsInstanceMetastoreConfig,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBdsBdsInstanceMetastoreConfig,
+		ReadContext:   readBdsBdsInstanceMetastoreConfig,
+		UpdateContext: updateBdsBdsInstanceMetastoreConfig,
+		DeleteContext: deleteBdsBdsInstanceMetastoreConfig,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bds_api_key_id": {
@@ -93,37 +94,37 @@ func BdsBdsInstanceMetastoreConfigResource() *schema.Resource {
 	}
 }
 
-func createBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func createBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func updateBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func deleteBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BdsBdsInstanceMetastoreConfigResourceCrud struct {
@@ -195,22 +196,23 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Create() error {
 		request.MetastoreId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.CreateBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *BdsBdsInstanceMetastoreConfigResourceCrud) getBdsInstanceMetastoreConfigFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bdsInstanceMetastoreConfigId, err := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	bdsInstanceMetastoreConfigId, err := bdsInstanceMetastoreConfigWaitForWorkRequest(s.Context(), workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -244,9 +246,9 @@ func bdsInstanceMetastoreConfigWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func bdsInstanceMetastoreConfigWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = bdsInstanceMetastoreConfigWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_bds.GetWorkRequestResponse{}
@@ -263,7 +265,7 @@ func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -275,7 +277,7 @@ func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -296,14 +298,14 @@ func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bds.OperationStatusFailed || response.Status == oci_bds.OperationStatusCanceled {
-		return nil, getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -344,9 +346,9 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.GetBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -394,15 +396,15 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Update() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete() error {
@@ -416,16 +418,16 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete() error {
 		request.BdsInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.DeleteBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	_, delWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(s.Context(), workId, "bds",
 		oci_bds.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -512,15 +514,15 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) activateBdsInstanceMetastore
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.ActivateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.ActivateBdsMetastoreConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, updateWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	_, updateWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(s.Context(), workId, "bds",
 		oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	if updateWorkRequestErr != nil {
 		return updateWorkRequestErr
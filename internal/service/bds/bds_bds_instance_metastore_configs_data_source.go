@@ -6,6 +6,7 @@ package bds
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 
@@ -15,7 +16,7 @@ import (
 
 func BdsBdsInstanceMetastoreConfigsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstanceMetastoreConfigs,
+		ReadContext: readBdsBdsInstanceMetastoreConfigs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_api_key_id": {
@@ -51,16 +52,16 @@ func BdsBdsInstanceMetastoreConfigsDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstanceMetastoreConfigs(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstanceMetastoreConfigs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstanceMetastoreConfigsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.ListBdsMetastoreConfigurationsResponse
 }
@@ -100,9 +101,9 @@ func (s *BdsBdsInstanceMetastoreConfigsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_bds.BdsMetastoreConfigurationLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.ListBdsMetastoreConfigurations(context.Background(), request)
+	response, err := s.Client.ListBdsMetastoreConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *BdsBdsInstanceMetastoreConfigsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBdsMetastoreConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListBdsMetastoreConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,10 +33,10 @@ func BdsBdsInstanceResource() *schema.Resource {
 			Update: &tfresource.ThreeHours,
 			Delete: &tfresource.ThreeHours,
 		},
-		Create: createBdsBdsInstance,
-		Read:   readBdsBdsInstance,
-		Update: updateBdsBdsInstance,
-		Delete: deleteBdsBdsInstance,
+		CreateContext: createBdsBdsInstance,
+		ReadContext:   readBdsBdsInstance,
+		UpdateContext: updateBdsBdsInstance,
+		DeleteContext: deleteBdsBdsInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cluster_admin_password": {
@@ -447,9 +448,10 @@ func BdsBdsInstanceResource() *schema.Resource {
 	}
 }
 
-func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func createBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
 	cloudSqlRequest := oci_bds.AddCloudSqlRequest{}
@@ -463,24 +465,24 @@ func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
 				tmp := blockVolumeSizeInGBs.(string)
 				tmpInt64, err := strconv.ParseInt(tmp, 10, 64)
 				if err != nil {
-					return fmt.Errorf("unable to convert blockVolumeSizeInGBs string: %s to an int64 and encountered error: %v", tmp, err)
+					return tfresource.ToDiagnostics(sync, fmt.Errorf("unable to convert blockVolumeSizeInGBs string: %s to an int64 and encountered error: %v", tmp, err))
 				}
 				cloudSqlRequest.BlockVolumeSizeInGBs = &tmpInt64
 			} else {
-				return fmt.Errorf("block_volume_size_in_gbs is required in cloud_sql_details")
+				return tfresource.ToDiagnostics(sync, fmt.Errorf("block_volume_size_in_gbs is required in cloud_sql_details"))
 			}
 
 			if shape, ok := sync.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "shape")); ok {
 				tmp := shape.(string)
 				cloudSqlRequest.Shape = &tmp
 			} else {
-				return fmt.Errorf("shape is required in cloud_sql_details")
+				return tfresource.ToDiagnostics(sync, fmt.Errorf("shape is required in cloud_sql_details"))
 			}
 		}
 	}
 
-	if err := tfresource.CreateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.CreateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if cloudSql {
@@ -491,36 +493,36 @@ func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
 			cloudSqlRequest.ClusterAdminPassword = &tmp
 		}
 		if err := sync.addCloudSql(cloudSqlRequest); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 	}
 	return nil
 }
 
-func readBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func updateBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func deleteBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BdsBdsInstanceResourceCrud struct {
@@ -717,22 +719,23 @@ func (s *BdsBdsInstanceResourceCrud) Create() error {
 
 	request.Nodes = createNodeDetails
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsInstance(context.Background(), request)
+	response, err := s.Client.CreateBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) getBdsInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bdsInstanceId, err := bdsInstanceWaitForWorkRequest(workId, "bds",
+	bdsInstanceId, err := bdsInstanceWaitForWorkRequest(s.Context(), workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -766,9 +769,9 @@ func bdsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func bdsInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = bdsInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_bds.GetWorkRequestResponse{}
@@ -785,7 +788,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -797,7 +800,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -814,14 +817,14 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bds.OperationStatusFailed || response.Status == oci_bds.OperationStatusCanceled {
-		return nil, getErrorFromBdsBdsInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsBdsInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBdsBdsInstanceWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsBdsInstanceWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -849,9 +852,9 @@ func (s *BdsBdsInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BdsInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1010,13 +1013,13 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 			tmp := s.D.Id()
 			changeShapeRequest.BdsInstanceId = &tmp
 
-			response, err := s.Client.ChangeShape(context.Background(), changeShapeRequest)
+			response, err := s.Client.ChangeShape(s.Context(), changeShapeRequest)
 			if err != nil {
 				return err
 			}
 
 			workId := response.OpcWorkRequestId
-			err = s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			err = s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -1045,15 +1048,15 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateBdsInstance(context.Background(), request)
+	response, err := s.Client.UpdateBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) Delete() error {
@@ -1062,16 +1065,16 @@ func (s *BdsBdsInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BdsInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsInstance(context.Background(), request)
+	response, err := s.Client.DeleteBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bdsInstanceWaitForWorkRequest(workId, "bds",
+	_, delWorkRequestErr := bdsInstanceWaitForWorkRequest(s.Context(), workId, "bds",
 		oci_bds.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1402,14 +1405,14 @@ func (s *BdsBdsInstanceResourceCrud) updateCompartment(compartment interface{})
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.ChangeBdsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBdsInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(id string, clusterAdminPassword interface{}, blockVolumeSizeInGBs int64) error {
@@ -1422,14 +1425,14 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(id string, cluster
 
 	addBlockStorageRequest.BlockVolumeSizeInGBs = &blockVolumeSizeInGBs
 
-	addBlockStorageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	addBlockStorageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddBlockStorage(context.Background(), addBlockStorageRequest)
+	response, err := s.Client.AddBlockStorage(s.Context(), addBlockStorageRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPassword interface{}, numberOfWorker int) error {
@@ -1442,32 +1445,32 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPas
 
 	addWorkerNodesRequest.NumberOfWorkerNodes = &numberOfWorker
 
-	addWorkerNodesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
+	addWorkerNodesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddWorkerNodes(context.Background(), addWorkerNodesRequest)
+	response, err := s.Client.AddWorkerNodes(s.Context(), addWorkerNodesRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) addCloudSql(request oci_bds.AddCloudSqlRequest) error {
-	response, err := s.Client.AddCloudSql(context.Background(), request)
+	response, err := s.Client.AddCloudSql(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BdsBdsInstanceResourceCrud) deleteCloudSql(request oci_bds.RemoveCloudSqlRequest) error {
-	response, err := s.Client.RemoveCloudSql(context.Background(), request)
+	response, err := s.Client.RemoveCloudSql(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func PopulateNodeTemplate(obj oci_bds.Node, nodeMap map[string]map[string]interface{}) {
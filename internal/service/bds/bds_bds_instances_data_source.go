@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v61/bds"
 )
 
 func BdsBdsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstances,
+		ReadContext: readBdsBdsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func BdsBdsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstances(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BdsBdsInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_bds.BdsClient
 	Res    *oci_bds.ListBdsInstancesResponse
 }
@@ -75,9 +76,9 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_bds.BdsInstanceLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "bds")
 
-	response, err := s.Client.ListBdsInstances(context.Background(), request)
+	response, err := s.Client.ListBdsInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBdsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListBdsInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v61/blockchain"
 )
@@ -22,16 +23,16 @@ func BlockchainBlockchainPlatformDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BlockchainBlockchainPlatformResource(), fieldMap, readSingularBlockchainBlockchainPlatform)
 }
 
-func readSingularBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func readSingularBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_blockchain.BlockchainPlatformClient
 	Res    *oci_blockchain.GetBlockchainPlatformResponse
 }
@@ -48,9 +49,9 @@ func (s *BlockchainBlockchainPlatformDataSourceCrud) Get() error {
 		request.BlockchainPlatformId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v61/blockchain"
 )
 
 func BlockchainBlockchainPlatformPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBlockchainBlockchainPlatformPatches,
+		ReadContext: readBlockchainBlockchainPlatformPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"blockchain_platform_id": {
@@ -76,16 +77,16 @@ func BlockchainBlockchainPlatformPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readBlockchainBlockchainPlatformPatches(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatformPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformPatchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_blockchain.BlockchainPlatformClient
 	Res    *oci_blockchain.ListBlockchainPlatformPatchesResponse
 }
@@ -102,9 +103,9 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 		request.BlockchainPlatformId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "blockchain")
 
-	response, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+	response, err := s.Client.ListBlockchainPlatformPatches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+		listResponse, err := s.Client.ListBlockchainPlatformPatches(s.Context(), request)
 		if err != nil {
 			return err
 		}
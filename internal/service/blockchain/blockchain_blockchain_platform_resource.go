@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func BlockchainBlockchainPlatformResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createBlockchainBlockchainPlatform,
-		Read:   readBlockchainBlockchainPlatform,
-		Update: updateBlockchainBlockchainPlatform,
-		Delete: deleteBlockchainBlockchainPlatform,
+		CreateContext: createBlockchainBlockchainPlatform,
+		ReadContext:   readBlockchainBlockchainPlatform,
+		UpdateContext: updateBlockchainBlockchainPlatform,
+		DeleteContext: deleteBlockchainBlockchainPlatform,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -327,37 +328,37 @@ func BlockchainBlockchainPlatformResource() *schema.Resource {
 	}
 }
 
-func createBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func createBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func updateBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func deleteBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BlockchainBlockchainPlatformResourceCrud struct {
@@ -459,15 +460,16 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Create() error {
 		request.PlatformVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.CreateBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -488,13 +490,13 @@ func (s *BlockchainBlockchainPlatformResourceCrud) getBlockchainPlatformFromWork
 	actionTypeEnum oci_blockchain.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	blockchainPlatformId, err := blockchainPlatformWaitForWorkRequest(workId, "instance",
+	blockchainPlatformId, err := blockchainPlatformWaitForWorkRequest(s.Context(), workId, "instance",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -534,9 +536,9 @@ func blockchainPlatformWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
+func blockchainPlatformWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_blockchain.BlockchainPlatformClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "blockchain")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "blockchain")
 	retryPolicy.ShouldRetryOperation = blockchainPlatformWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_blockchain.GetWorkRequestResponse{}
@@ -553,7 +555,7 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -565,7 +567,7 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -583,15 +585,15 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if response.Status == oci_blockchain.WorkRequestStatusFailed {
-		errorMessage := getErrorFromBlockchainPlatformWorkRequest(client, wId, retryPolicy, entityType, action)
+		errorMessage := getErrorFromBlockchainPlatformWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromBlockchainPlatformWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBlockchainPlatformWorkRequest(ctx context.Context, client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -619,9 +621,9 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BlockchainPlatformId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -666,7 +668,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 			}
 			request.Replicas = &tmp
 		}
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(s.Context(), s, request); err != nil {
 			return err
 		}
 		request.Replicas = nil
@@ -675,7 +677,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 	if storageSizeInTBs, ok := s.D.GetOkExists("storage_size_in_tbs"); ok && s.D.HasChange("storage_size_in_tbs") {
 		tmp := storageSizeInTBs.(float64)
 		request.StorageSizeInTBs = &tmp
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(s.Context(), s, request); err != nil {
 			return err
 		}
 		request.StorageSizeInTBs = nil
@@ -684,7 +686,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 	if totalOcpuCapacity, ok := s.D.GetOkExists("total_ocpu_capacity"); ok && s.D.HasChange("total_ocpu_capacity") {
 		tmp := totalOcpuCapacity.(int)
 		request.TotalOcpuCapacity = &tmp
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(s.Context(), s, request); err != nil {
 			return err
 		}
 		request.TotalOcpuCapacity = nil
@@ -692,7 +694,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 
 	if loadBalancerShape, ok := s.D.GetOkExists("load_balancer_shape"); ok && s.D.HasChange("load_balancer_shape") {
 		request.LoadBalancerShape = oci_blockchain.BlockchainPlatformLoadBalancerShapeEnum(loadBalancerShape.(string))
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(s.Context(), s, request); err != nil {
 			return err
 		}
 	}
@@ -714,15 +716,15 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.UpdateBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
@@ -731,16 +733,16 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BlockchainPlatformId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.DeleteBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.DeleteBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := blockchainPlatformWaitForWorkRequest(workId, "instance",
+	_, delWorkRequestErr := blockchainPlatformWaitForWorkRequest(s.Context(), workId, "instance",
 		oci_blockchain.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1029,13 +1031,13 @@ func (s *BlockchainBlockchainPlatformResourceCrud) updateCompartment(compartment
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.ChangeBlockchainPlatformCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBlockchainPlatformCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
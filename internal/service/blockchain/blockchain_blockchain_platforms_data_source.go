@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v61/blockchain"
 )
 
 func BlockchainBlockchainPlatformsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBlockchainBlockchainPlatforms,
+		ReadContext: readBlockchainBlockchainPlatforms,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func BlockchainBlockchainPlatformsDataSource() *schema.Resource {
 	}
 }
 
-func readBlockchainBlockchainPlatforms(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatforms(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_blockchain.BlockchainPlatformClient
 	Res    *oci_blockchain.ListBlockchainPlatformsResponse
 }
@@ -83,9 +84,9 @@ func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_blockchain.BlockchainPlatformLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "blockchain")
 
-	response, err := s.Client.ListBlockchainPlatforms(context.Background(), request)
+	response, err := s.Client.ListBlockchainPlatforms(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockchainPlatforms(context.Background(), request)
+		listResponse, err := s.Client.ListBlockchainPlatforms(s.Context(), request)
 		if err != nil {
 			return err
 		}
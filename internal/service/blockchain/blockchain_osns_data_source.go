@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v61/blockchain"
 )
 
 func BlockchainOsnsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBlockchainOsns,
+		ReadContext: readBlockchainOsns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"blockchain_platform_id": {
@@ -44,16 +45,16 @@ func BlockchainOsnsDataSource() *schema.Resource {
 	}
 }
 
-func readBlockchainOsns(d *schema.ResourceData, m interface{}) error {
+func readBlockchainOsns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainOsnsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BlockchainOsnsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_blockchain.BlockchainPlatformClient
 	Res    *oci_blockchain.ListOsnsResponse
 }
@@ -75,9 +76,9 @@ func (s *BlockchainOsnsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "blockchain")
 
-	response, err := s.Client.ListOsns(context.Background(), request)
+	response, err := s.Client.ListOsns(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *BlockchainOsnsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOsns(context.Background(), request)
+		listResponse, err := s.Client.ListOsns(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v61/blockchain"
 )
@@ -26,16 +27,16 @@ func BlockchainPeerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BlockchainPeerResource(), fieldMap, readSingularBlockchainPeer)
 }
 
-func readSingularBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func readSingularBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BlockchainPeerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_blockchain.BlockchainPlatformClient
 	Res    *oci_blockchain.GetPeerResponse
 }
@@ -57,9 +58,9 @@ func (s *BlockchainPeerDataSourceCrud) Get() error {
 		request.PeerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "blockchain")
 
-	response, err := s.Client.GetPeer(context.Background(), request)
+	response, err := s.Client.GetPeer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -31,16 +31,16 @@ func blockchainPlatformComputeShapeDiffSuppressFunction(key string, old string,
 	return upperCaseOldValue == upperCaseNewValue
 }
 
-func sendUpdateBlockchainPlatformRequest(s *BlockchainBlockchainPlatformResourceCrud, request oci_blockchain.UpdateBlockchainPlatformRequest) error {
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
+func sendUpdateBlockchainPlatformRequest(ctx context.Context, s *BlockchainBlockchainPlatformResourceCrud, request oci_blockchain.UpdateBlockchainPlatformRequest) error {
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(ctx, s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.UpdateBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicyContext(ctx, s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
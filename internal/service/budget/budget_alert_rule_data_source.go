@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v61/budget"
 
@@ -26,16 +27,16 @@ func BudgetAlertRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BudgetAlertRuleResource(), fieldMap, readSingularBudgetAlertRule)
 }
 
-func readSingularBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func readSingularBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BudgetAlertRuleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_budget.BudgetClient
 	Res    *oci_budget.GetAlertRuleResponse
 }
@@ -57,9 +58,9 @@ func (s *BudgetAlertRuleDataSourceCrud) Get() error {
 		request.BudgetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func BudgetAlertRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBudgetAlertRule,
-		Read:     readBudgetAlertRule,
-		Update:   updateBudgetAlertRule,
-		Delete:   deleteBudgetAlertRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBudgetAlertRule,
+		ReadContext:   readBudgetAlertRule,
+		UpdateContext: updateBudgetAlertRule,
+		DeleteContext: deleteBudgetAlertRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"budget_id": {
@@ -105,37 +106,37 @@ func BudgetAlertRuleResource() *schema.Resource {
 	}
 }
 
-func createBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func createBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func readBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func updateBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func deleteBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BudgetAlertRuleResourceCrud struct {
@@ -220,9 +221,10 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 		request.Type = oci_budget.AlertTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateAlertRule(context.Background(), request)
+	response, err := s.Client.CreateAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -250,9 +252,9 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -317,9 +319,9 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 		request.Type = oci_budget.AlertTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateAlertRule(context.Background(), request)
+	response, err := s.Client.UpdateAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -339,9 +341,9 @@ func (s *BudgetAlertRuleResourceCrud) Delete() error {
 		request.BudgetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteAlertRule(context.Background(), request)
+	_, err := s.Client.DeleteAlertRule(s.Context(), request)
 	return err
 }
 
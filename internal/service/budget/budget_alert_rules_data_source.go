@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v61/budget"
 
@@ -15,7 +16,7 @@ import (
 
 func BudgetAlertRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBudgetAlertRules,
+		ReadContext: readBudgetAlertRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"budget_id": {
@@ -39,16 +40,16 @@ func BudgetAlertRulesDataSource() *schema.Resource {
 	}
 }
 
-func readBudgetAlertRules(d *schema.ResourceData, m interface{}) error {
+func readBudgetAlertRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BudgetAlertRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_budget.BudgetClient
 	Res    *oci_budget.ListAlertRulesResponse
 }
@@ -74,9 +75,9 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_budget.ListAlertRulesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "budget")
 
-	response, err := s.Client.ListAlertRules(context.Background(), request)
+	response, err := s.Client.ListAlertRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlertRules(context.Background(), request)
+		listResponse, err := s.Client.ListAlertRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v61/budget"
 
@@ -22,16 +23,16 @@ func BudgetBudgetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BudgetBudgetResource(), fieldMap, readSingularBudgetBudget)
 }
 
-func readSingularBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func readSingularBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BudgetBudgetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_budget.BudgetClient
 	Res    *oci_budget.GetBudgetResponse
 }
@@ -48,9 +49,9 @@ func (s *BudgetBudgetDataSourceCrud) Get() error {
 		request.BudgetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
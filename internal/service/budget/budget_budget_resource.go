@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v61/budget"
 
@@ -18,11 +19,11 @@ func BudgetBudgetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBudgetBudget,
-		Read:     readBudgetBudget,
-		Update:   updateBudgetBudget,
-		Delete:   deleteBudgetBudget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBudgetBudget,
+		ReadContext:   readBudgetBudget,
+		UpdateContext: updateBudgetBudget,
+		DeleteContext: deleteBudgetBudget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"amount": {
@@ -130,37 +131,37 @@ func BudgetBudgetResource() *schema.Resource {
 	}
 }
 
-func createBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func createBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func readBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func updateBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func deleteBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type BudgetBudgetResourceCrud struct {
@@ -258,9 +259,10 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateBudget(context.Background(), request)
+	response, err := s.Client.CreateBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -275,9 +277,9 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BudgetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,9 +330,9 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 		request.ResetPeriod = oci_budget.ResetPeriodEnum(resetPeriod.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateBudget(context.Background(), request)
+	response, err := s.Client.UpdateBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,9 +347,9 @@ func (s *BudgetBudgetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BudgetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteBudget(context.Background(), request)
+	_, err := s.Client.DeleteBudget(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v61/budget"
 
@@ -15,7 +16,7 @@ import (
 
 func BudgetBudgetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBudgetBudgets,
+		ReadContext: readBudgetBudgets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func BudgetBudgetsDataSource() *schema.Resource {
 	}
 }
 
-func readBudgetBudgets(d *schema.ResourceData, m interface{}) error {
+func readBudgetBudgets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type BudgetBudgetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_budget.BudgetClient
 	Res    *oci_budget.ListBudgetsResponse
 }
@@ -82,9 +83,9 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 		request.TargetType = oci_budget.ListBudgetsTargetTypeEnum(targetType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "budget")
 
-	response, err := s.Client.ListBudgets(context.Background(), request)
+	response, err := s.Client.ListBudgets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBudgets(context.Background(), request)
+		listResponse, err := s.Client.ListBudgets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementAssociation,
+		ReadContext: readSingularCertificatesManagementAssociation,
 		Schema: map[string]*schema.Schema{
 			"association_id": {
 				Type:     schema.TypeString,
@@ -54,16 +55,16 @@ func CertificatesManagementAssociationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCertificatesManagementAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.GetAssociationResponse
 }
@@ -80,9 +81,9 @@ func (s *CertificatesManagementAssociationDataSourceCrud) Get() error {
 		request.AssociationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.GetAssociation(context.Background(), request)
+	response, err := s.Client.GetAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementAssociations,
+		ReadContext: readCertificatesManagementAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"associated_resource_id": {
@@ -107,16 +108,16 @@ func CertificatesManagementAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementAssociations(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListAssociationsResponse
 }
@@ -157,9 +158,9 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListAssociations(context.Background(), request)
+	response, err := s.Client.ListAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
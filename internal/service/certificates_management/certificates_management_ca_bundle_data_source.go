@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
@@ -22,16 +23,16 @@ func CertificatesManagementCaBundleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CertificatesManagementCaBundleResource(), fieldMap, readSingularCertificatesManagementCaBundle)
 }
 
-func readSingularCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCaBundleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.GetCaBundleResponse
 }
@@ -48,9 +49,9 @@ func (s *CertificatesManagementCaBundleDataSourceCrud) Get() error {
 		request.CaBundleId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
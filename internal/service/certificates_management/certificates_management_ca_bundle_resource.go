@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
@@ -19,11 +20,11 @@ func CertificatesManagementCaBundleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCaBundle,
-		Read:     readCertificatesManagementCaBundle,
-		Update:   updateCertificatesManagementCaBundle,
-		Delete:   deleteCertificatesManagementCaBundle,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCaBundle,
+		ReadContext:   readCertificatesManagementCaBundle,
+		UpdateContext: updateCertificatesManagementCaBundle,
+		DeleteContext: deleteCertificatesManagementCaBundle,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ca_bundle_pem": {
@@ -77,37 +78,37 @@ func CertificatesManagementCaBundleResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CertificatesManagementCaBundleResourceCrud struct {
@@ -192,9 +193,10 @@ func (s *CertificatesManagementCaBundleResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCaBundle(context.Background(), request)
+	response, err := s.Client.CreateCaBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,9 +211,9 @@ func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CaBundleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -258,9 +260,9 @@ func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
 	}
 
 	if request.CaBundlePem != nil || request.DefinedTags != nil || request.Description != nil || request.FreeformTags != nil {
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-		response, err := s.Client.UpdateCaBundle(context.Background(), request)
+		response, err := s.Client.UpdateCaBundle(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -277,9 +279,9 @@ func (s *CertificatesManagementCaBundleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CaBundleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.DeleteCaBundle(context.Background(), request)
+	_, err := s.Client.DeleteCaBundle(s.Context(), request)
 	return err
 }
 
@@ -366,9 +368,9 @@ func (s *CertificatesManagementCaBundleResourceCrud) updateCompartment(compartme
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCaBundleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCaBundleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCaBundlesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCaBundles,
+		ReadContext: readCertificatesManagementCaBundles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ca_bundle_id": {
@@ -56,16 +57,16 @@ func CertificatesManagementCaBundlesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCaBundles(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCaBundles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundlesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCaBundlesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListCaBundlesResponse
 }
@@ -96,9 +97,9 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_certificates_management.ListCaBundlesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListCaBundles(context.Background(), request)
+	response, err := s.Client.ListCaBundles(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -107,7 +108,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCaBundles(context.Background(), request)
+		listResponse, err := s.Client.ListCaBundles(s.Context(), request)
 		if err != nil {
 			return err
 		}
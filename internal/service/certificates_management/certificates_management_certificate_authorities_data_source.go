@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthoritiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateAuthorities,
+		ReadContext: readCertificatesManagementCertificateAuthorities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_authority_id": {
@@ -62,16 +63,16 @@ func CertificatesManagementCertificateAuthoritiesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificateAuthorities(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthorities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthoritiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthoritiesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListCertificateAuthoritiesResponse
 }
@@ -107,9 +108,9 @@ func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get() error
 		request.LifecycleState = oci_certificates_management.ListCertificateAuthoritiesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListCertificateAuthorities(context.Background(), request)
+	response, err := s.Client.ListCertificateAuthorities(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateAuthorities(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateAuthorities(s.Context(), request)
 		if err != nil {
 			return err
 		}
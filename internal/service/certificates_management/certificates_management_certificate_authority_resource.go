@@ -15,6 +15,7 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func CertificatesManagementCertificateAuthorityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCertificateAuthority,
-		Read:     readCertificatesManagementCertificateAuthority,
-		Update:   updateCertificatesManagementCertificateAuthority,
-		Delete:   deleteCertificatesManagementCertificateAuthority,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCertificateAuthority,
+		ReadContext:   readCertificatesManagementCertificateAuthority,
+		UpdateContext: updateCertificatesManagementCertificateAuthority,
+		DeleteContext: deleteCertificatesManagementCertificateAuthority,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_authority_config": {
@@ -543,36 +544,36 @@ func CertificatesManagementCertificateAuthorityResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CertificatesManagementCertificateAuthorityResourceCrud struct {
@@ -698,9 +699,10 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create() error
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.CreateCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -715,9 +717,9 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CertificateAuthorityId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthority(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -779,7 +781,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
 	// only update if request has updates
 	if request.CertificateAuthorityConfig != nil || request.CertificateRevocationListDetails != nil ||
@@ -787,7 +789,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 		tmp := s.D.Id()
 		request.CertificateAuthorityId = &tmp
 
-		response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+		response, err := s.Client.UpdateCertificateAuthority(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -830,9 +832,9 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) UpdateRules() e
 			return nil
 		}
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.UpdateCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -852,11 +854,11 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Delete() error
 		request.TimeOfDeletion = &oci_common.SDKTime{Time: tmpTime}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 	tmp := s.D.Id()
 	request.CertificateAuthorityId = &tmp
 
-	_, err := s.Client.ScheduleCertificateAuthorityDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateAuthorityDeletion(s.Context(), request)
 	return err
 }
 
@@ -1414,9 +1416,9 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) updateCompartme
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateAuthorityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateAuthorityCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthorityVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementCertificateAuthorityVersion,
+		ReadContext: readSingularCertificatesManagementCertificateAuthorityVersion,
 		Schema: map[string]*schema.Schema{
 			"certificate_authority_id": {
 				Type:     schema.TypeString,
@@ -126,16 +127,16 @@ func CertificatesManagementCertificateAuthorityVersionDataSource() *schema.Resou
 	}
 }
 
-func readSingularCertificatesManagementCertificateAuthorityVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCertificateAuthorityVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthorityVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.GetCertificateAuthorityVersionResponse
 }
@@ -161,9 +162,9 @@ func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) Get()
 		request.CertificateAuthorityVersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthorityVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthorityVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
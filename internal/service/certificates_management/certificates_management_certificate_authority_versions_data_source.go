@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthorityVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateAuthorityVersions,
+		ReadContext: readCertificatesManagementCertificateAuthorityVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_authority_id": {
@@ -128,16 +129,16 @@ func CertificatesManagementCertificateAuthorityVersionsDataSource() *schema.Reso
 	}
 }
 
-func readCertificatesManagementCertificateAuthorityVersions(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthorityVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthorityVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListCertificateAuthorityVersionsResponse
 }
@@ -163,9 +164,9 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 		request.VersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateAuthorityVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +175,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateAuthorityVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
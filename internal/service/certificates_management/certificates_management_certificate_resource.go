@@ -16,6 +16,7 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -27,11 +28,11 @@ func CertificatesManagementCertificateResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCertificate,
-		Read:     readCertificatesManagementCertificate,
-		Update:   updateCertificatesManagementCertificate,
-		Delete:   deleteCertificatesManagementCertificate,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCertificate,
+		ReadContext:   readCertificatesManagementCertificate,
+		UpdateContext: updateCertificatesManagementCertificate,
+		DeleteContext: deleteCertificatesManagementCertificate,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_config": {
@@ -602,36 +603,36 @@ func CertificatesManagementCertificateResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CertificatesManagementCertificateResourceCrud struct {
@@ -741,9 +742,10 @@ func (s *CertificatesManagementCertificateResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -758,9 +760,9 @@ func (s *CertificatesManagementCertificateResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -818,14 +820,14 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
 	// only update if request has updates
 	if request.CertificateConfig != nil || request.FreeformTags != nil || request.Description != nil || request.DefinedTags != nil {
 		tmp := s.D.Id()
 		request.CertificateId = &tmp
 
-		response, err := s.Client.UpdateCertificate(context.Background(), request)
+		response, err := s.Client.UpdateCertificate(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -869,9 +871,9 @@ func (s *CertificatesManagementCertificateResourceCrud) UpdateRules() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -891,11 +893,11 @@ func (s *CertificatesManagementCertificateResourceCrud) Delete() error {
 		request.TimeOfDeletion = &oci_common.SDKTime{Time: tmpTime}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 
-	_, err := s.Client.ScheduleCertificateDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateDeletion(s.Context(), request)
 	return err
 }
 
@@ -1389,9 +1391,9 @@ func (s *CertificatesManagementCertificateResourceCrud) updateCompartment(compar
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
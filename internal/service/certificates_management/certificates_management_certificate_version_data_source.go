@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementCertificateVersion,
+		ReadContext: readSingularCertificatesManagementCertificateVersion,
 		Schema: map[string]*schema.Schema{
 			"certificate_id": {
 				Type:     schema.TypeString,
@@ -126,16 +127,16 @@ func CertificatesManagementCertificateVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCertificatesManagementCertificateVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCertificateVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificateVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.GetCertificateVersionResponse
 }
@@ -161,9 +162,9 @@ func (s *CertificatesManagementCertificateVersionDataSourceCrud) Get() error {
 		request.CertificateVersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.GetCertificateVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
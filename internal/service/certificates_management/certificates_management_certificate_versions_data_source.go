@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateVersions,
+		ReadContext: readCertificatesManagementCertificateVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -149,16 +150,16 @@ func CertificatesManagementCertificateVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificateVersions(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificateVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListCertificateVersionsResponse
 }
@@ -184,9 +185,9 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 		request.VersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListCertificateVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
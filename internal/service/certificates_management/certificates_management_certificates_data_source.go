@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v61/certificatesmanagement"
 )
 
 func CertificatesManagementCertificatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificates,
+		ReadContext: readCertificatesManagementCertificates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -62,16 +63,16 @@ func CertificatesManagementCertificatesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificates(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CertificatesManagementCertificatesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_certificates_management.CertificatesManagementClient
 	Res    *oci_certificates_management.ListCertificatesResponse
 }
@@ -107,9 +108,9 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_certificates_management.ListCertificatesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "certificates_management")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificates(context.Background(), request)
+		listResponse, err := s.Client.ListCertificates(s.Context(), request)
 		if err != nil {
 			return err
 		}
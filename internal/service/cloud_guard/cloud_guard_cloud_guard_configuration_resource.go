@@ -12,6 +12,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
@@ -21,11 +22,11 @@ import (
 
 func CloudGuardCloudGuardConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardCloudGuardConfiguration,
-		Read:     readCloudGuardCloudGuardConfiguration,
-		Update:   updateCloudGuardCloudGuardConfiguration,
-		Delete:   deleteCloudGuardCloudGuardConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardCloudGuardConfiguration,
+		ReadContext:   readCloudGuardCloudGuardConfiguration,
+		UpdateContext: updateCloudGuardCloudGuardConfiguration,
+		DeleteContext: deleteCloudGuardCloudGuardConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -53,31 +54,31 @@ func CloudGuardCloudGuardConfigurationResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -114,9 +115,10 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create() error {
 		request.Status = oci_cloud_guard.CloudGuardStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -139,9 +141,9 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,9 +174,9 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update() error {
 		request.Status = oci_cloud_guard.CloudGuardStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func CloudGuardDataMaskRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardDataMaskRule,
-		Read:     readCloudGuardDataMaskRule,
-		Update:   updateCloudGuardDataMaskRule,
-		Delete:   deleteCloudGuardDataMaskRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardDataMaskRule,
+		ReadContext:   readCloudGuardDataMaskRule,
+		UpdateContext: updateCloudGuardDataMaskRule,
+		DeleteContext: deleteCloudGuardDataMaskRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -137,37 +138,37 @@ func CloudGuardDataMaskRuleResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CloudGuardDataMaskRuleResourceCrud struct {
@@ -272,9 +273,10 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDataMaskRule(context.Background(), request)
+	response, err := s.Client.CreateDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -289,9 +291,9 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DataMaskRuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDataMaskRule(context.Background(), request)
+	response, err := s.Client.GetDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -361,9 +363,9 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDataMaskRule(context.Background(), request)
+	response, err := s.Client.UpdateDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -378,9 +380,9 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataMaskRuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDataMaskRule(context.Background(), request)
+	_, err := s.Client.DeleteDataMaskRule(s.Context(), request)
 	return err
 }
 
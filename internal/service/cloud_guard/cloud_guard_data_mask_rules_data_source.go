@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardDataMaskRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardDataMaskRules,
+		ReadContext: readCloudGuardDataMaskRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -68,16 +69,16 @@ func CloudGuardDataMaskRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardDataMaskRules(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataMaskRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardDataMaskRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.ListDataMaskRulesResponse
 }
@@ -126,9 +127,9 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 		request.TargetType = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.ListDataMaskRules(context.Background(), request)
+	response, err := s.Client.ListDataMaskRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +138,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataMaskRules(context.Background(), request)
+		listResponse, err := s.Client.ListDataMaskRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
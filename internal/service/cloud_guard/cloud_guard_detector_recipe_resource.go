@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
@@ -21,11 +22,11 @@ func CloudGuardDetectorRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardDetectorRecipe,
-		Read:     readCloudGuardDetectorRecipe,
-		Update:   updateCloudGuardDetectorRecipe,
-		Delete:   deleteCloudGuardDetectorRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardDetectorRecipe,
+		ReadContext:   readCloudGuardDetectorRecipe,
+		UpdateContext: updateCloudGuardDetectorRecipe,
+		DeleteContext: deleteCloudGuardDetectorRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -460,37 +461,37 @@ func CloudGuardDetectorRecipeResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CloudGuardDetectorRecipeResourceCrud struct {
@@ -580,9 +581,10 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Create() error {
 		request.SourceDetectorRecipeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.CreateDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -597,9 +599,9 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DetectorRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDetectorRecipe(context.Background(), request)
+	response, err := s.Client.GetDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -662,9 +664,9 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.UpdateDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -679,9 +681,9 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DetectorRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDetectorRecipe(context.Background(), request)
+	_, err := s.Client.DeleteDetectorRecipe(s.Context(), request)
 	return err
 }
 
@@ -1036,14 +1038,14 @@ func (s *CloudGuardDetectorRecipeResourceCrud) updateCompartment(compartment int
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DetectorRecipeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeDetectorRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDetectorRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -22,16 +23,16 @@ func CloudGuardManagedListDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardManagedListResource(), fieldMap, readSingularCloudGuardManagedList)
 }
 
-func readSingularCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardManagedListDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.GetManagedListResponse
 }
@@ -48,9 +49,9 @@ func (s *CloudGuardManagedListDataSourceCrud) Get() error {
 		request.ManagedListId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
@@ -19,11 +20,11 @@ func CloudGuardManagedListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardManagedList,
-		Read:     readCloudGuardManagedList,
-		Update:   updateCloudGuardManagedList,
-		Delete:   deleteCloudGuardManagedList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardManagedList,
+		ReadContext:   readCloudGuardManagedList,
+		UpdateContext: updateCloudGuardManagedList,
+		DeleteContext: deleteCloudGuardManagedList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -109,37 +110,37 @@ func CloudGuardManagedListResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CloudGuardManagedListResourceCrud struct {
@@ -229,9 +230,10 @@ func (s *CloudGuardManagedListResourceCrud) Create() error {
 		request.SourceManagedListId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateManagedList(context.Background(), request)
+	response, err := s.Client.CreateManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,9 +248,9 @@ func (s *CloudGuardManagedListResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ManagedListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -307,9 +309,9 @@ func (s *CloudGuardManagedListResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateManagedList(context.Background(), request)
+	response, err := s.Client.UpdateManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -324,9 +326,9 @@ func (s *CloudGuardManagedListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteManagedList(context.Background(), request)
+	_, err := s.Client.DeleteManagedList(s.Context(), request)
 	return err
 }
 
@@ -453,14 +455,14 @@ func (s *CloudGuardManagedListResourceCrud) updateCompartment(compartment interf
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ManagedListId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeManagedListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
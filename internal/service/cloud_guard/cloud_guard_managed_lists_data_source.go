@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardManagedListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardManagedLists,
+		ReadContext: readCloudGuardManagedLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -64,16 +65,16 @@ func CloudGuardManagedListsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardManagedLists(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardManagedLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardManagedListsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.ListManagedListsResponse
 }
@@ -117,9 +118,9 @@ func (s *CloudGuardManagedListsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_cloud_guard.ListManagedListsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.ListManagedLists(context.Background(), request)
+	response, err := s.Client.ListManagedLists(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +129,7 @@ func (s *CloudGuardManagedListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedLists(context.Background(), request)
+		listResponse, err := s.Client.ListManagedLists(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
@@ -20,11 +21,11 @@ func CloudGuardResponderRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardResponderRecipe,
-		Read:     readCloudGuardResponderRecipe,
-		Update:   updateCloudGuardResponderRecipe,
-		Delete:   deleteCloudGuardResponderRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardResponderRecipe,
+		ReadContext:   readCloudGuardResponderRecipe,
+		UpdateContext: updateCloudGuardResponderRecipe,
+		DeleteContext: deleteCloudGuardResponderRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -322,37 +323,37 @@ func CloudGuardResponderRecipeResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CloudGuardResponderRecipeResourceCrud struct {
@@ -442,9 +443,10 @@ func (s *CloudGuardResponderRecipeResourceCrud) Create() error {
 		request.SourceResponderRecipeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateResponderRecipe(context.Background(), request)
+	response, err := s.Client.CreateResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -459,9 +461,9 @@ func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ResponderRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetResponderRecipe(context.Background(), request)
+	response, err := s.Client.GetResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -524,9 +526,9 @@ func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateResponderRecipe(context.Background(), request)
+	response, err := s.Client.UpdateResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -541,9 +543,9 @@ func (s *CloudGuardResponderRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ResponderRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteResponderRecipe(context.Background(), request)
+	_, err := s.Client.DeleteResponderRecipe(s.Context(), request)
 	return err
 }
 
@@ -794,14 +796,14 @@ func (s *CloudGuardResponderRecipeResourceCrud) updateCompartment(compartment in
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ResponderRecipeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeResponderRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResponderRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
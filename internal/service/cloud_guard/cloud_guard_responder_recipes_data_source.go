@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardResponderRecipesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardResponderRecipes,
+		ReadContext: readCloudGuardResponderRecipes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -60,16 +61,16 @@ func CloudGuardResponderRecipesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardResponderRecipes(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardResponderRecipes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardResponderRecipesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.ListResponderRecipesResponse
 }
@@ -109,9 +110,9 @@ func (s *CloudGuardResponderRecipesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_cloud_guard.ListResponderRecipesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.ListResponderRecipes(context.Background(), request)
+	response, err := s.Client.ListResponderRecipes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *CloudGuardResponderRecipesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResponderRecipes(context.Background(), request)
+		listResponse, err := s.Client.ListResponderRecipes(s.Context(), request)
 		if err != nil {
 			return err
 		}
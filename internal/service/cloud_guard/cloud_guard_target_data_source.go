@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -22,16 +23,16 @@ func CloudGuardTargetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardTargetResource(), fieldMap, readSingularCloudGuardTarget)
 }
 
-func readSingularCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardTargetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.GetTargetResponse
 }
@@ -48,9 +49,9 @@ func (s *CloudGuardTargetDataSourceCrud) Get() error {
 		request.TargetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.GetTarget(context.Background(), request)
+	response, err := s.Client.GetTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
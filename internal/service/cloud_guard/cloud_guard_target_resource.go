@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
@@ -20,11 +21,11 @@ func CloudGuardTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardTarget,
-		Read:     readCloudGuardTarget,
-		Update:   updateCloudGuardTarget,
-		Delete:   deleteCloudGuardTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardTarget,
+		ReadContext:   readCloudGuardTarget,
+		UpdateContext: updateCloudGuardTarget,
+		DeleteContext: deleteCloudGuardTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -788,37 +789,37 @@ func CloudGuardTargetResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CloudGuardTargetResourceCrud struct {
@@ -933,9 +934,10 @@ func (s *CloudGuardTargetResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateTarget(context.Background(), request)
+	response, err := s.Client.CreateTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -950,9 +952,9 @@ func (s *CloudGuardTargetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.TargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetTarget(context.Background(), request)
+	response, err := s.Client.GetTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1022,9 +1024,9 @@ func (s *CloudGuardTargetResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateTarget(context.Background(), request)
+	response, err := s.Client.UpdateTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1039,9 +1041,9 @@ func (s *CloudGuardTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteTarget(context.Background(), request)
+	_, err := s.Client.DeleteTarget(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v61/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardTargets,
+		ReadContext: readCloudGuardTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -56,16 +57,16 @@ func CloudGuardTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardTargets(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CloudGuardTargetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_cloud_guard.CloudGuardClient
 	Res    *oci_cloud_guard.ListTargetsResponse
 }
@@ -100,9 +101,9 @@ func (s *CloudGuardTargetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_cloud_guard.ListTargetsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "cloud_guard")
 
-	response, err := s.Client.ListTargets(context.Background(), request)
+	response, err := s.Client.ListTargets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *CloudGuardTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTargets(context.Background(), request)
+		listResponse, err := s.Client.ListTargets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v61/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAgentPluginDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularComputeinstanceagentInstanceAgentPlugin,
+		ReadContext: readSingularComputeinstanceagentInstanceAgentPlugin,
 		Schema: map[string]*schema.Schema{
 			"instanceagent_id": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func ComputeinstanceagentInstanceAgentPluginDataSource() *schema.Resource {
 	}
 }
 
-func readSingularComputeinstanceagentInstanceAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func readSingularComputeinstanceagentInstanceAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAgentPluginDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAgentPluginDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_computeinstanceagent.PluginClient
 	Res    *oci_computeinstanceagent.GetInstanceAgentPluginResponse
 }
@@ -86,9 +87,9 @@ func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) Get() error {
 		request.PluginName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "computeinstanceagent")
 
-	response, err := s.Client.GetInstanceAgentPlugin(context.Background(), request)
+	response, err := s.Client.GetInstanceAgentPlugin(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v61/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAgentPluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readComputeinstanceagentInstanceAgentPlugins,
+		ReadContext: readComputeinstanceagentInstanceAgentPlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"instanceagent_id": {
@@ -67,16 +68,16 @@ func ComputeinstanceagentInstanceAgentPluginsDataSource() *schema.Resource {
 	}
 }
 
-func readComputeinstanceagentInstanceAgentPlugins(d *schema.ResourceData, m interface{}) error {
+func readComputeinstanceagentInstanceAgentPlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAgentPluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAgentPluginsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_computeinstanceagent.PluginClient
 	Res    *oci_computeinstanceagent.ListInstanceAgentPluginsResponse
 }
@@ -107,9 +108,9 @@ func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) Get() error {
 		request.Status = oci_computeinstanceagent.ListInstanceAgentPluginsStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceAgentPlugins(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v61/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readComputeinstanceagentInstanceAvailablePlugins,
+		ReadContext: readComputeinstanceagentInstanceAvailablePlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -67,16 +68,16 @@ func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
 	}
 }
 
-func readComputeinstanceagentInstanceAvailablePlugins(d *schema.ResourceData, m interface{}) error {
+func readComputeinstanceagentInstanceAvailablePlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginconfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_computeinstanceagent.PluginconfigClient
 	Res    *oci_computeinstanceagent.ListInstanceagentAvailablePluginsResponse
 }
@@ -108,9 +109,9 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error
 		request.OsVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceagentAvailablePlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceagentAvailablePlugins(s.Context(), request)
 	if err != nil {
 		return err
 	}
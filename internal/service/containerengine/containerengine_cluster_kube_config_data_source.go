@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 
@@ -17,7 +18,7 @@ import (
 
 func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineClusterKubeConfig,
+		ReadContext: readSingularContainerengineClusterKubeConfig,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -44,16 +45,16 @@ func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineClusterKubeConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineClusterKubeConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterKubeConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineClusterKubeConfigDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *[]byte
 }
@@ -84,9 +85,9 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
 		request.TokenVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.CreateKubeconfig(context.Background(), request)
+	response, err := s.Client.CreateKubeconfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
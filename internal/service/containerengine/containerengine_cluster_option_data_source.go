@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineClusterOptionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineClusterOption,
+		ReadContext: readSingularContainerengineClusterOption,
 		Schema: map[string]*schema.Schema{
 			"cluster_option_id": {
 				Type:     schema.TypeString,
@@ -37,16 +38,16 @@ func ContainerengineClusterOptionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineClusterOption(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineClusterOption(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterOptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineClusterOptionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.GetClusterOptionsResponse
 }
@@ -68,9 +69,9 @@ func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.GetClusterOptions(context.Background(), request)
+	response, err := s.Client.GetClusterOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,10 +33,10 @@ func ContainerengineClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createContainerengineCluster,
-		Read:   readContainerengineCluster,
-		Update: updateContainerengineCluster,
-		Delete: deleteContainerengineCluster,
+		CreateContext: createContainerengineCluster,
+		ReadContext:   readContainerengineCluster,
+		UpdateContext: updateContainerengineCluster,
+		DeleteContext: deleteContainerengineCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -410,37 +411,37 @@ func ContainerengineClusterResource() *schema.Resource {
 	}
 }
 
-func createContainerengineCluster(d *schema.ResourceData, m interface{}) error {
+func createContainerengineCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readContainerengineCluster(d *schema.ResourceData, m interface{}) error {
+func readContainerengineCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateContainerengineCluster(d *schema.ResourceData, m interface{}) error {
+func updateContainerengineCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteContainerengineCluster(d *schema.ResourceData, m interface{}) error {
+func deleteContainerengineCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ContainerengineClusterResourceCrud struct {
@@ -551,16 +552,17 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.CreateCluster(context.Background(), request)
+	response, err := s.Client.CreateCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 
-	clusterID, err := clusterWaitForWorkRequest(workId, "cluster",
+	clusterID, err := clusterWaitForWorkRequest(s.Context(), workId, "cluster",
 		oci_containerengine.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -570,15 +572,15 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 
 			delReq := oci_containerengine.DeleteClusterRequest{}
 			delReq.ClusterId = clusterID
-			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-			delRes, delErr := s.Client.DeleteCluster(context.Background(), delReq)
+			delRes, delErr := s.Client.DeleteCluster(s.Context(), delReq)
 			if delErr != nil {
 				return err
 			}
 			delWorkRequest := delRes.OpcWorkRequestId
 
-			_, delErr = clusterWaitForWorkRequest(delWorkRequest, "cluster",
+			_, delErr = clusterWaitForWorkRequest(s.Context(), delWorkRequest, "cluster",
 				oci_containerengine.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 			if delErr != nil {
 				log.Printf("[DEBUG] cleanup delWorkRequest failed with the error: %v\n", delErr)
@@ -589,8 +591,8 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 
 	requestGet := oci_containerengine.GetClusterRequest{}
 	requestGet.ClusterId = clusterID
-	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
+	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
+	responseGet, err := s.Client.GetCluster(s.Context(), requestGet)
 	if err != nil {
 		return err
 	}
@@ -601,7 +603,7 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 
 func (s *ContainerengineClusterResourceCrud) getClusterFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_containerengine.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
-	clusterId, err := clusterWaitForWorkRequest(workId, "cluster",
+	clusterId, err := clusterWaitForWorkRequest(s.Context(), workId, "cluster",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -635,9 +637,9 @@ func clusterWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func clusterWaitForWorkRequest(wId *string, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum,
+func clusterWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_containerengine.ContainerEngineClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "containerengine")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "containerengine")
 	retryPolicy.ShouldRetryOperation = clusterWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_containerengine.GetWorkRequestResponse{}
@@ -654,7 +656,7 @@ func clusterWaitForWorkRequest(wId *string, entityType string, action oci_contai
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_containerengine.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -671,7 +673,7 @@ func clusterWaitForWorkRequest(wId *string, entityType string, action oci_contai
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -688,14 +690,14 @@ func clusterWaitForWorkRequest(wId *string, entityType string, action oci_contai
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_containerengine.WorkRequestStatusFailed || response.Status == oci_containerengine.WorkRequestStatusCanceled {
-		return nil, getErrorFromContainerengineClusterWorkRequest(client, wId, response.CompartmentId, retryPolicy, entityType, action)
+		return nil, getErrorFromContainerengineClusterWorkRequest(ctx, client, wId, response.CompartmentId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromContainerengineClusterWorkRequest(client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromContainerengineClusterWorkRequest(ctx context.Context, client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_containerengine.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			CompartmentId: compartmentId,
@@ -724,9 +726,9 @@ func (s *ContainerengineClusterResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetCluster(context.Background(), request)
+	response, err := s.Client.GetCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -801,15 +803,15 @@ func (s *ContainerengineClusterResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.UpdateCluster(context.Background(), request)
+	response, err := s.Client.UpdateCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getClusterFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getClusterFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 func (s *ContainerengineClusterResourceCrud) updateClusterEndpointConfig(clusterID string, endpointConfig interface{}) error {
 	request := oci_containerengine.UpdateClusterEndpointConfigRequest{}
@@ -823,13 +825,13 @@ func (s *ContainerengineClusterResourceCrud) updateClusterEndpointConfig(cluster
 		request.UpdateClusterEndpointConfigDetails = tmp
 	}
 
-	response, err := s.Client.UpdateClusterEndpointConfig(context.Background(), request)
+	response, err := s.Client.UpdateClusterEndpointConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workID := response.OpcWorkRequestId
-	return s.getClusterFromWorkRequest(workID, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getClusterFromWorkRequest(workID, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *ContainerengineClusterResourceCrud) migrateClusterToNativeVCN(clusterID string, endpointConfig interface{}) error {
@@ -845,13 +847,13 @@ func (s *ContainerengineClusterResourceCrud) migrateClusterToNativeVCN(clusterID
 		request.ClusterMigrateToNativeVcnDetails = tmp
 	}
 
-	response, err := s.Client.ClusterMigrateToNativeVcn(context.Background(), request)
+	response, err := s.Client.ClusterMigrateToNativeVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workID := response.OpcWorkRequestId
-	return s.getClusterFromWorkRequest(workID, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getClusterFromWorkRequest(workID, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *ContainerengineClusterResourceCrud) Delete() error {
@@ -860,16 +862,16 @@ func (s *ContainerengineClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.DeleteCluster(context.Background(), request)
+	response, err := s.Client.DeleteCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := clusterWaitForWorkRequest(workId, "cluster",
+	_, delWorkRequestErr := clusterWaitForWorkRequest(s.Context(), workId, "cluster",
 		oci_containerengine.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineClusters,
+		ReadContext: readContainerengineClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -42,16 +43,16 @@ func ContainerengineClustersDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineClusters(d *schema.ResourceData, m interface{}) error {
+func readContainerengineClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineClustersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.ListClustersResponse
 }
@@ -81,9 +82,9 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 		request.LifecycleState = enumStates
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.ListClusters(context.Background(), request)
+	response, err := s.Client.ListClusters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusters(context.Background(), request)
+		listResponse, err := s.Client.ListClusters(s.Context(), request)
 		if err != nil {
 			return err
 		}
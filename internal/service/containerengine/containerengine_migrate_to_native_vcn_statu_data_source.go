@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineMigrateToNativeVcnStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineMigrateToNativeVcnStatus,
+		ReadContext: readSingularContainerengineMigrateToNativeVcnStatus,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func ContainerengineMigrateToNativeVcnStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineMigrateToNativeVcnStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineMigrateToNativeVcnStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineMigrateToNativeVcnStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineMigrateToNativeVcnStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.GetClusterMigrateToNativeVcnStatusResponse
 }
@@ -60,9 +61,9 @@ func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) Get() error {
 		request.ClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(context.Background(), request)
+	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
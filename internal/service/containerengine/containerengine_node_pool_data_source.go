@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
@@ -22,16 +23,16 @@ func ContainerengineNodePoolDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ContainerengineNodePoolResource(), fieldMap, readSingularContainerengineNodePool)
 }
 
-func readSingularContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineNodePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineNodePoolDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.GetNodePoolResponse
 }
@@ -48,9 +49,9 @@ func (s *ContainerengineNodePoolDataSourceCrud) Get() error {
 		request.NodePoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineNodePoolOptionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineNodePoolOption,
+		ReadContext: readSingularContainerengineNodePoolOption,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -76,16 +77,16 @@ func ContainerengineNodePoolOptionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineNodePoolOption(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineNodePoolOption(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolOptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineNodePoolOptionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.GetNodePoolOptionsResponse
 }
@@ -107,9 +108,9 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
 		request.NodePoolOptionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.GetNodePoolOptions(context.Background(), request)
+	response, err := s.Client.GetNodePoolOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -36,10 +37,10 @@ func ContainerengineNodePoolResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("50m"),
 			Delete: tfresource.GetTimeoutDuration("50m"),
 		},
-		Create: createContainerengineNodePool,
-		Read:   readContainerengineNodePool,
-		Update: updateContainerengineNodePool,
-		Delete: deleteContainerengineNodePool,
+		CreateContext: createContainerengineNodePool,
+		ReadContext:   readContainerengineNodePool,
+		UpdateContext: updateContainerengineNodePool,
+		DeleteContext: deleteContainerengineNodePool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cluster_id": {
@@ -412,37 +413,37 @@ func ContainerengineNodePoolResource() *schema.Resource {
 	}
 }
 
-func createContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
+func createContainerengineNodePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
+func readContainerengineNodePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
+func updateContainerengineNodePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
+func deleteContainerengineNodePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ContainerengineNodePoolResourceCrud struct {
@@ -584,16 +585,17 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.CreateNodePool(context.Background(), request)
+	response, err := s.Client.CreateNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 
-	nodePoolID, err := nodePoolWaitForWorkRequest(workId, "nodepool",
+	nodePoolID, err := nodePoolWaitForWorkRequest(s.Context(), workId, "nodepool",
 		oci_containerengine.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -603,15 +605,15 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 
 			delReq := oci_containerengine.DeleteNodePoolRequest{}
 			delReq.NodePoolId = nodePoolID
-			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-			delRes, delErr := s.Client.DeleteNodePool(context.Background(), delReq)
+			delRes, delErr := s.Client.DeleteNodePool(s.Context(), delReq)
 			if delErr != nil {
 				return err
 			}
 			delWorkRequest := delRes.OpcWorkRequestId
 
-			_, delErr = nodePoolWaitForWorkRequest(delWorkRequest, "nodepool",
+			_, delErr = nodePoolWaitForWorkRequest(s.Context(), delWorkRequest, "nodepool",
 				oci_containerengine.WorkRequestResourceActionTypeDeleted,
 				s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 			if delErr != nil {
@@ -623,8 +625,8 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 
 	requestGet := oci_containerengine.GetNodePoolRequest{}
 	requestGet.NodePoolId = nodePoolID
-	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
+	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
+	responseGet, err := s.Client.GetNodePool(s.Context(), requestGet)
 	if err != nil {
 		return err
 	}
@@ -634,7 +636,7 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 
 func (s *ContainerengineNodePoolResourceCrud) getNodePoolFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_containerengine.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
-	nodePoolId, err := nodePoolWaitForWorkRequest(workId, "nodepool",
+	nodePoolId, err := nodePoolWaitForWorkRequest(s.Context(), workId, "nodepool",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -668,9 +670,9 @@ func nodePoolWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum,
+func nodePoolWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_containerengine.ContainerEngineClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "containerengine")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "containerengine")
 	retryPolicy.ShouldRetryOperation = nodePoolWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_containerengine.GetWorkRequestResponse{}
@@ -687,7 +689,7 @@ func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_conta
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_containerengine.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -704,7 +706,7 @@ func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_conta
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -721,19 +723,19 @@ func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_conta
 
 	// The workrequest may have failed, check for errors if identifier is not found.
 	if identifier == nil {
-		return nil, getErrorFromContainerengineNodePoolWorkRequest(client, wId, response.CompartmentId, retryPolicy, entityType, action)
+		return nil, getErrorFromContainerengineNodePoolWorkRequest(ctx, client, wId, response.CompartmentId, retryPolicy, entityType, action)
 	}
 
 	// The workrequest may have failed, check for errors if work failed or got cancelled
 	if response.Status == oci_containerengine.WorkRequestStatusFailed || response.Status == oci_containerengine.WorkRequestStatusCanceled {
-		return identifier, getErrorFromContainerengineNodePoolWorkRequest(client, wId, response.CompartmentId, retryPolicy, entityType, action)
+		return identifier, getErrorFromContainerengineNodePoolWorkRequest(ctx, client, wId, response.CompartmentId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromContainerengineNodePoolWorkRequest(client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromContainerengineNodePoolWorkRequest(ctx context.Context, client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_containerengine.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			CompartmentId: compartmentId,
@@ -762,9 +764,9 @@ func (s *ContainerengineNodePoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NodePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -886,15 +888,15 @@ func (s *ContainerengineNodePoolResourceCrud) Update() error {
 		request.SubnetIds = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.UpdateNodePool(context.Background(), request)
+	response, err := s.Client.UpdateNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNodePoolFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNodePoolFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine"), oci_containerengine.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *ContainerengineNodePoolResourceCrud) Delete() error {
@@ -903,16 +905,16 @@ func (s *ContainerengineNodePoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NodePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.DeleteNodePool(context.Background(), request)
+	response, err := s.Client.DeleteNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := nodePoolWaitForWorkRequest(workId, "nodepool",
+	_, delWorkRequestErr := nodePoolWaitForWorkRequest(s.Context(), workId, "nodepool",
 		oci_containerengine.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineNodePoolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineNodePools,
+		ReadContext: readContainerengineNodePools,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cluster_id": {
@@ -39,16 +40,16 @@ func ContainerengineNodePoolsDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineNodePools(d *schema.ResourceData, m interface{}) error {
+func readContainerengineNodePools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineNodePoolsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.ListNodePoolsResponse
 }
@@ -75,9 +76,9 @@ func (s *ContainerengineNodePoolsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.ListNodePools(context.Background(), request)
+	response, err := s.Client.ListNodePools(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *ContainerengineNodePoolsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNodePools(context.Background(), request)
+		listResponse, err := s.Client.ListNodePools(s.Context(), request)
 		if err != nil {
 			return err
 		}
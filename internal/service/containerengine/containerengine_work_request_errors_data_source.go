@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineWorkRequestErrorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequestErrors,
+		ReadContext: readContainerengineWorkRequestErrors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func ContainerengineWorkRequestErrorsDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequestErrors(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequestErrors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestErrorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineWorkRequestErrorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.ListWorkRequestErrorsResponse
 }
@@ -86,9 +87,9 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
 		request.WorkRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListWorkRequestErrors(s.Context(), request)
 	if err != nil {
 		return err
 	}
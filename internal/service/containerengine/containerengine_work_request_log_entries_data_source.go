@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineWorkRequestLogEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequestLogEntries,
+		ReadContext: readContainerengineWorkRequestLogEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func ContainerengineWorkRequestLogEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequestLogEntries(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequestLogEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestLogEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineWorkRequestLogEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.ListWorkRequestLogsResponse
 }
@@ -82,9 +83,9 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
 		request.WorkRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListWorkRequestLogs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v61/containerengine"
 )
 
 func ContainerengineWorkRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequests,
+		ReadContext: readContainerengineWorkRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cluster_id": {
@@ -115,16 +116,16 @@ func ContainerengineWorkRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequests(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ContainerengineWorkRequestsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_containerengine.ContainerEngineClient
 	Res    *oci_containerengine.ListWorkRequestsResponse
 }
@@ -164,9 +165,9 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 		request.Status = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "containerengine")
 
-	response, err := s.Client.ListWorkRequests(context.Background(), request)
+	response, err := s.Client.ListWorkRequests(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +176,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListWorkRequests(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreAppCatalogListingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreAppCatalogListing,
+		ReadContext: readSingularCoreAppCatalogListing,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -54,16 +55,16 @@ func CoreAppCatalogListingDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreAppCatalogListing(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreAppCatalogListing(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreAppCatalogListingDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetAppCatalogListingResponse
 }
@@ -80,9 +81,9 @@ func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetAppCatalogListing(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListing(s.Context(), request)
 	if err != nil {
 		return err
 	}
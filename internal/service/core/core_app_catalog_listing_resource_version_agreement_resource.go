@@ -10,16 +10,17 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAppCatalogListingResourceVersionAgreement,
-		Read:     readAppCatalogListingResourceVersionAgreement,
-		Delete:   deleteAppCatalogListingResourceVersionAgreement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAppCatalogListingResourceVersionAgreement,
+		ReadContext:   readAppCatalogListingResourceVersionAgreement,
+		DeleteContext: deleteAppCatalogListingResourceVersionAgreement,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -52,29 +53,29 @@ func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	}
 }
 
-func createAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func createAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func readAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type AppCatalogListingResourceVersionAgreementResourceCrud struct {
@@ -101,9 +102,9 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 		request.ResourceVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetAppCatalogListingAgreements(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingAgreements(s.Context(), request)
 	if err != nil {
 		return err
 	}
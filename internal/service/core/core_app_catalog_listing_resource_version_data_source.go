@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreAppCatalogListingResourceVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreAppCatalogListingResourceVersion,
+		ReadContext: readSingularCoreAppCatalogListingResourceVersion,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -70,16 +71,16 @@ func CoreAppCatalogListingResourceVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreAppCatalogListingResourceVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreAppCatalogListingResourceVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingResourceVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreAppCatalogListingResourceVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetAppCatalogListingResourceVersionResponse
 }
@@ -101,9 +102,9 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
 		request.ResourceVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetAppCatalogListingResourceVersion(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingResourceVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogListingResourceVersions,
+		ReadContext: readCoreAppCatalogListingResourceVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"listing_id": {
@@ -83,16 +84,16 @@ func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogListingResourceVersions(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogListingResourceVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingResourceVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreAppCatalogListingResourceVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListAppCatalogListingResourceVersionsResponse
 }
@@ -109,9 +110,9 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListingResourceVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreAppCatalogListingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogListings,
+		ReadContext: readCoreAppCatalogListings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -79,16 +80,16 @@ func CoreAppCatalogListingsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogListings(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogListings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreAppCatalogListingsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListAppCatalogListingsResponse
 }
@@ -115,9 +116,9 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 		request.PublisherType = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListAppCatalogListings(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListings(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogListings(s.Context(), request)
 		if err != nil {
 			return err
 		}
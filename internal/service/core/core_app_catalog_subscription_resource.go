@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -26,10 +27,10 @@ func CoreAppCatalogSubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreAppCatalogSubscription,
-		Read:     readCoreAppCatalogSubscription,
-		Delete:   deleteCoreAppCatalogSubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreAppCatalogSubscription,
+		ReadContext:   readCoreAppCatalogSubscription,
+		DeleteContext: deleteCoreAppCatalogSubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -96,29 +97,29 @@ func CoreAppCatalogSubscriptionResource() *schema.Resource {
 	}
 }
 
-func createCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func createCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func deleteCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreAppCatalogSubscriptionResourceCrud struct {
@@ -173,16 +174,17 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
 		request.TimeRetrieved = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.CreateAppCatalogSubscription(context.Background(), request)
+	_, err := s.Client.CreateAppCatalogSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res != nil && s.Res.TimeCreated != nil }
 	compositeId := getSubscriptionCompositeId(*request.CompartmentId, *request.ListingId, *request.ListingResourceVersion)
 	s.D.SetId(compositeId)
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
@@ -196,9 +198,9 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 		ListingId:     &listingId,
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,7 +225,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	for !isFound && response.OpcNextPage != nil {
 		request.Page = response.OpcNextPage
-		response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		response, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -259,14 +261,14 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
 	request.CompartmentId = &compartmentId
 	request.ListingId = &listingId
 	request.ResourceVersion = &listingResourceVersion
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.DeleteAppCatalogSubscription(context.Background(), request)
+	_, err = s.Client.DeleteAppCatalogSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res == nil }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
 }
 
 func (s *CoreAppCatalogSubscriptionResourceCrud) SetData() error {
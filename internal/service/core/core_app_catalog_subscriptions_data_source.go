@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogSubscriptions,
+		ReadContext: readCoreAppCatalogSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreAppCatalogSubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListAppCatalogSubscriptionsResponse
 }
@@ -66,9 +67,9 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
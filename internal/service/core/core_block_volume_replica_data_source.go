@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBlockVolumeReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreBlockVolumeReplica,
+		ReadContext: readSingularCoreBlockVolumeReplica,
 		Schema: map[string]*schema.Schema{
 			"block_volume_replica_id": {
 				Type:     schema.TypeString,
@@ -73,16 +74,16 @@ func CoreBlockVolumeReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreBlockVolumeReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBlockVolumeReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBlockVolumeReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBlockVolumeReplicaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetBlockVolumeReplicaResponse
 }
@@ -99,9 +100,9 @@ func (s *CoreBlockVolumeReplicaDataSourceCrud) Get() error {
 		request.BlockVolumeReplicaId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetBlockVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBlockVolumeReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBlockVolumeReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBlockVolumeReplicas,
+		ReadContext: readCoreBlockVolumeReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -102,16 +103,16 @@ func CoreBlockVolumeReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBlockVolumeReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreBlockVolumeReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBlockVolumeReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBlockVolumeReplicasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListBlockVolumeReplicasResponse
 }
@@ -142,9 +143,9 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.BlockVolumeReplicaLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBlockVolumeReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -153,7 +154,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBlockVolumeReplicas(s.Context(), request)
 		if err != nil {
 			return err
 		}
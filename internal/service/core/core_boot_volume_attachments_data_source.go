@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumeAttachments,
+		ReadContext: readCoreBootVolumeAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -93,16 +94,16 @@ func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumeAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumeAttachmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListBootVolumeAttachmentsResponse
 }
@@ -134,9 +135,9 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -145,7 +146,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
@@ -23,16 +24,16 @@ func CoreBootVolumeBackupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreBootVolumeBackupResource(), fieldMap, readSingularCoreBootVolumeBackup)
 }
 
-func readSingularCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumeBackupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetBootVolumeBackupResponse
 }
@@ -49,9 +50,9 @@ func (s *CoreBootVolumeBackupDataSourceCrud) Get() error {
 		request.BootVolumeBackupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
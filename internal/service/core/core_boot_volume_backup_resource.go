@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
@@ -23,11 +24,11 @@ func CoreBootVolumeBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreBootVolumeBackup,
-		Read:     readCoreBootVolumeBackup,
-		Update:   updateCoreBootVolumeBackup,
-		Delete:   deleteCoreBootVolumeBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreBootVolumeBackup,
+		ReadContext:   readCoreBootVolumeBackup,
+		UpdateContext: updateCoreBootVolumeBackup,
+		DeleteContext: deleteCoreBootVolumeBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"boot_volume_id": {
@@ -149,23 +150,24 @@ func CoreBootVolumeBackupResource() *schema.Resource {
 	}
 }
 
-func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func createCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
 		err = sync.updateCompartment(compartment)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
@@ -181,32 +183,32 @@ func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func updateCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreBootVolumeBackupResourceCrud struct {
@@ -254,7 +256,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Create() error {
 			return err
 		}
 		s.D.SetId(*s.Res.Id)
-		err = tfresource.WaitForResourceCondition(s, func() bool { return s.Res.LifecycleState == oci_core.BootVolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
+		err = tfresource.WaitForResourceConditionContext(s.Context(), s, func() bool { return s.Res.LifecycleState == oci_core.BootVolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -303,9 +305,9 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup() error {
 		request.Type = oci_core.CreateBootVolumeBackupDetailsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -355,7 +357,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 		copyBootVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyBootVolumeBackup(context.Background(), copyBootVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyBootVolumeBackup(s.Context(), copyBootVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -365,7 +367,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 	s.Res = &response.BootVolumeBackup
 
 	if workRequestId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.workRequestClient, workRequestId, "bootVolumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.workRequestClient, workRequestId, "bootVolumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -380,9 +382,9 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BootVolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -435,9 +437,9 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 		return nil
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,9 +454,9 @@ func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteBootVolumeBackup(s.Context(), request)
 	return err
 }
 
@@ -531,14 +533,14 @@ func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(compartment interfa
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBootVolumeBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumeBackups,
+		ReadContext: readCoreBootVolumeBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"boot_volume_id": {
@@ -48,16 +49,16 @@ func CoreBootVolumeBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumeBackups(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumeBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListBootVolumeBackupsResponse
 }
@@ -93,9 +94,9 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.BootVolumeBackupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListBootVolumeBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
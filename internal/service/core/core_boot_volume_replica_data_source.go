@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBootVolumeReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreBootVolumeReplica,
+		ReadContext: readSingularCoreBootVolumeReplica,
 		Schema: map[string]*schema.Schema{
 			"boot_volume_replica_id": {
 				Type:     schema.TypeString,
@@ -77,16 +78,16 @@ func CoreBootVolumeReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreBootVolumeReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBootVolumeReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumeReplicaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetBootVolumeReplicaResponse
 }
@@ -103,9 +104,9 @@ func (s *CoreBootVolumeReplicaDataSourceCrud) Get() error {
 		request.BootVolumeReplicaId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetBootVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBootVolumeReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
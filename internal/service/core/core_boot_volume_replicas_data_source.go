@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBootVolumeReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumeReplicas,
+		ReadContext: readCoreBootVolumeReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -106,16 +107,16 @@ func CoreBootVolumeReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumeReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumeReplicasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListBootVolumeReplicasResponse
 }
@@ -146,9 +147,9 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.BootVolumeReplicaLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBootVolumeReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -157,7 +158,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeReplicas(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreBootVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreBootVolume,
-		Read:     readCoreBootVolume,
-		Update:   updateCoreBootVolume,
-		Delete:   deleteCoreBootVolume,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreBootVolume,
+		ReadContext:   readCoreBootVolume,
+		UpdateContext: updateCoreBootVolume,
+		DeleteContext: deleteCoreBootVolume,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -195,37 +196,37 @@ func CoreBootVolumeResource() *schema.Resource {
 	}
 }
 
-func createCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func createCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func updateCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func deleteCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreBootVolumeResourceCrud struct {
@@ -367,9 +368,10 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolume(context.Background(), request)
+	response, err := s.Client.CreateBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -384,9 +386,9 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BootVolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -465,9 +467,9 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 		tmp := s.D.Get("kms_key_id").(string)
 		keyUpdateRequest.KmsKeyId = &tmp
 
-		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateBootVolumeKmsKey(s.Context(), keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -491,9 +493,9 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolume(context.Background(), request)
+	response, err := s.Client.UpdateBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -508,9 +510,9 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolume(context.Background(), request)
+	_, err := s.Client.DeleteBootVolume(s.Context(), request)
 	return err
 }
 
@@ -596,7 +598,7 @@ func (s *CoreBootVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(s.Context(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -715,14 +717,14 @@ func (s *CoreBootVolumeResourceCrud) updateCompartment(compartment interface{})
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreBootVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumes,
+		ReadContext: readCoreBootVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -40,16 +41,16 @@ func CoreBootVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumes(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreBootVolumesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListBootVolumesResponse
 }
@@ -76,9 +77,9 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 		request.VolumeGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListBootVolumes(context.Background(), request)
+	response, err := s.Client.ListBootVolumes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreByoipAllocatedRangesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreByoipAllocatedRanges,
+		ReadContext: readCoreByoipAllocatedRanges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"byoip_range_id": {
@@ -60,16 +61,16 @@ func CoreByoipAllocatedRangesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreByoipAllocatedRanges(d *schema.ResourceData, m interface{}) error {
+func readCoreByoipAllocatedRanges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipAllocatedRangesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreByoipAllocatedRangesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListByoipAllocatedRangesResponse
 }
@@ -86,9 +87,9 @@ func (s *CoreByoipAllocatedRangesDataSourceCrud) Get() error {
 		request.ByoipRangeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListByoipAllocatedRanges(context.Background(), request)
+	response, err := s.Client.ListByoipAllocatedRanges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *CoreByoipAllocatedRangesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListByoipAllocatedRanges(context.Background(), request)
+		listResponse, err := s.Client.ListByoipAllocatedRanges(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreByoipRangeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreByoipRange,
+		ReadContext: readSingularCoreByoipRange,
 		Schema: map[string]*schema.Schema{
 			"byoip_range_id": {
 				Type:     schema.TypeString,
@@ -76,16 +77,16 @@ func CoreByoipRangeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreByoipRange(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipRangeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreByoipRangeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetByoipRangeResponse
 }
@@ -102,9 +103,9 @@ func (s *CoreByoipRangeDataSourceCrud) Get() error {
 		request.ByoipRangeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetByoipRange(context.Background(), request)
+	response, err := s.Client.GetByoipRange(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreByoipRangesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreByoipRanges,
+		ReadContext: readCoreByoipRanges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -112,16 +113,16 @@ func CoreByoipRangesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreByoipRanges(d *schema.ResourceData, m interface{}) error {
+func readCoreByoipRanges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipRangesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreByoipRangesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListByoipRangesResponse
 }
@@ -148,9 +149,9 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 		request.LifecycleState = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListByoipRanges(context.Background(), request)
+	response, err := s.Client.ListByoipRanges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -159,7 +160,7 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListByoipRanges(context.Background(), request)
+		listResponse, err := s.Client.ListByoipRanges(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
@@ -22,16 +23,16 @@ func CoreClusterNetworkDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreClusterNetworkResource(), fieldMap, readSingularCoreClusterNetwork)
 }
 
-func readSingularCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreClusterNetworkDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.GetClusterNetworkResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreClusterNetworkDataSourceCrud) Get() error {
 		request.ClusterNetworkId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
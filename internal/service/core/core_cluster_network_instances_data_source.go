@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreClusterNetworkInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreClusterNetworkInstances,
+		ReadContext: readCoreClusterNetworkInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cluster_network_id": {
@@ -121,16 +122,16 @@ func CoreClusterNetworkInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreClusterNetworkInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetworkInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreClusterNetworkInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.ListClusterNetworkInstancesResponse
 }
@@ -157,9 +158,9 @@ func (s *CoreClusterNetworkInstancesDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListClusterNetworkInstances(context.Background(), request)
+	response, err := s.Client.ListClusterNetworkInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *CoreClusterNetworkInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusterNetworkInstances(context.Background(), request)
+		listResponse, err := s.Client.ListClusterNetworkInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
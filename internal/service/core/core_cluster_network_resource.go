@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
@@ -22,11 +23,11 @@ func CoreClusterNetworkResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreClusterNetwork,
-		Read:     readCoreClusterNetwork,
-		Update:   updateCoreClusterNetwork,
-		Delete:   deleteCoreClusterNetwork,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreClusterNetwork,
+		ReadContext:   readCoreClusterNetwork,
+		UpdateContext: updateCoreClusterNetwork,
+		DeleteContext: deleteCoreClusterNetwork,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -270,37 +271,37 @@ func CoreClusterNetworkResource() *schema.Resource {
 	}
 }
 
-func createCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func createCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func updateCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func deleteCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreClusterNetworkResourceCrud struct {
@@ -393,9 +394,10 @@ func (s *CoreClusterNetworkResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -410,9 +412,9 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ClusterNetworkId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -470,9 +472,9 @@ func (s *CoreClusterNetworkResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -487,9 +489,9 @@ func (s *CoreClusterNetworkResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ClusterNetworkId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateClusterNetwork(context.Background(), request)
+	_, err := s.Client.TerminateClusterNetwork(s.Context(), request)
 	return err
 }
 
@@ -750,14 +752,14 @@ func (s *CoreClusterNetworkResourceCrud) updateCompartment(compartment interface
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeClusterNetworkCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeClusterNetworkCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
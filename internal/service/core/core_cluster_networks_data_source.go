@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreClusterNetworksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreClusterNetworks,
+		ReadContext: readCoreClusterNetworks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreClusterNetworksDataSource() *schema.Resource {
 	}
 }
 
-func readCoreClusterNetworks(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetworks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreClusterNetworksDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.ListClusterNetworksResponse
 }
@@ -74,9 +75,9 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.ClusterNetworkSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListClusterNetworks(context.Background(), request)
+	response, err := s.Client.ListClusterNetworks(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusterNetworks(context.Background(), request)
+		listResponse, err := s.Client.ListClusterNetworks(s.Context(), request)
 		if err != nil {
 			return err
 		}
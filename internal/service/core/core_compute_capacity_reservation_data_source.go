@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
@@ -23,16 +24,16 @@ func CoreComputeCapacityReservationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreComputeCapacityReservationResource(), fieldMap, readSingularCoreComputeCapacityReservation)
 }
 
-func readSingularCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeCapacityReservationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetComputeCapacityReservationResponse
 }
@@ -49,9 +50,9 @@ func (s *CoreComputeCapacityReservationDataSourceCrud) Get() error {
 		request.CapacityReservationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.GetComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreComputeCapacityReservationInstanceShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeCapacityReservationInstanceShapes,
+		ReadContext: readCoreComputeCapacityReservationInstanceShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,16 +56,16 @@ func CoreComputeCapacityReservationInstanceShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeCapacityReservationInstanceShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservationInstanceShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationInstanceShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeCapacityReservationInstanceShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeCapacityReservationInstanceShapesResponse
 }
@@ -91,9 +92,9 @@ func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get() error
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservationInstanceShapes(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservationInstanceShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservationInstanceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservationInstanceShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreComputeCapacityReservationInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeCapacityReservationInstances,
+		ReadContext: readCoreComputeCapacityReservationInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -88,16 +89,16 @@ func CoreComputeCapacityReservationInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeCapacityReservationInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservationInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeCapacityReservationInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeCapacityReservationInstancesResponse
 }
@@ -124,9 +125,9 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservationInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -135,7 +136,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservationInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
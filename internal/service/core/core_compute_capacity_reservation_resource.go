@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
@@ -22,11 +23,11 @@ func CoreComputeCapacityReservationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreComputeCapacityReservation,
-		Read:     readCoreComputeCapacityReservation,
-		Update:   updateCoreComputeCapacityReservation,
-		Delete:   deleteCoreComputeCapacityReservation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreComputeCapacityReservation,
+		ReadContext:   readCoreComputeCapacityReservation,
+		UpdateContext: updateCoreComputeCapacityReservation,
+		DeleteContext: deleteCoreComputeCapacityReservation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -148,40 +149,40 @@ func CoreComputeCapacityReservationResource() *schema.Resource {
 	}
 }
 
-func createCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func createCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func updateCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func deleteCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreComputeCapacityReservationResourceCrud struct {
@@ -273,9 +274,10 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 		request.IsDefaultReservation = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.CreateComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +286,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 	if workId == nil {
 		return fmt.Errorf("CreateComputeCapacityReservation response.OpcWorkRequestId was nil")
 	}
-	identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+	identifier, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 	if identifier != nil {
 		s.D.SetId(*identifier)
 	}
@@ -301,9 +303,9 @@ func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CapacityReservationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.GetComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -366,16 +368,16 @@ func (s *CoreComputeCapacityReservationResourceCrud) Update() error {
 		request.IsDefaultReservation = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.UpdateComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -390,16 +392,16 @@ func (s *CoreComputeCapacityReservationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CapacityReservationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.DeleteComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -561,16 +563,16 @@ func (s *CoreComputeCapacityReservationResourceCrud) updateCompartment(compartme
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeComputeCapacityReservationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeComputeCapacityReservationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreComputeCapacityReservationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeCapacityReservations,
+		ReadContext: readCoreComputeCapacityReservations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -44,16 +45,16 @@ func CoreComputeCapacityReservationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeCapacityReservations(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeCapacityReservationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeCapacityReservationsResponse
 }
@@ -84,9 +85,9 @@ func (s *CoreComputeCapacityReservationsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.ComputeCapacityReservationLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservations(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CoreComputeCapacityReservationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservations(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreComputeGlobalImageCapabilitySchemaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreComputeGlobalImageCapabilitySchema,
+		ReadContext: readSingularCoreComputeGlobalImageCapabilitySchema,
 		Schema: map[string]*schema.Schema{
 			"compute_global_image_capability_schema_id": {
 				Type:     schema.TypeString,
@@ -52,16 +53,16 @@ func CoreComputeGlobalImageCapabilitySchemaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreComputeGlobalImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeGlobalImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetComputeGlobalImageCapabilitySchemaResponse
 }
@@ -78,9 +79,9 @@ func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) Get() error {
 		request.ComputeGlobalImageCapabilitySchemaId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
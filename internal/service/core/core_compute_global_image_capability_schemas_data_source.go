@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreComputeGlobalImageCapabilitySchemasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeGlobalImageCapabilitySchemas,
+		ReadContext: readCoreComputeGlobalImageCapabilitySchemas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -73,16 +74,16 @@ func CoreComputeGlobalImageCapabilitySchemasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeGlobalImageCapabilitySchemas(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeGlobalImageCapabilitySchemas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeGlobalImageCapabilitySchemasResponse
 }
@@ -104,9 +105,9 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +116,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeGlobalImageCapabilitySchemasVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreComputeGlobalImageCapabilitySchemasVersion,
+		ReadContext: readSingularCoreComputeGlobalImageCapabilitySchemasVersion,
 		Schema: map[string]*schema.Schema{
 			"compute_global_image_capability_schema_id": {
 				Type:     schema.TypeString,
@@ -47,16 +48,16 @@ func CoreComputeGlobalImageCapabilitySchemasVersionDataSource() *schema.Resource
 	}
 }
 
-func readSingularCoreComputeGlobalImageCapabilitySchemasVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeGlobalImageCapabilitySchemasVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetComputeGlobalImageCapabilitySchemaVersionResponse
 }
@@ -78,9 +79,9 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) Get() err
 		request.ComputeGlobalImageCapabilitySchemaVersionName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
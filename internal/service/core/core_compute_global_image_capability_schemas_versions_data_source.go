@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeGlobalImageCapabilitySchemasVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeGlobalImageCapabilitySchemasVersions,
+		ReadContext: readCoreComputeGlobalImageCapabilitySchemasVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compute_global_image_capability_schema_id": {
@@ -64,16 +65,16 @@ func CoreComputeGlobalImageCapabilitySchemasVersionsDataSource() *schema.Resourc
 	}
 }
 
-func readCoreComputeGlobalImageCapabilitySchemasVersions(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeGlobalImageCapabilitySchemasVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeGlobalImageCapabilitySchemaVersionsResponse
 }
@@ -95,9 +96,9 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -27,16 +28,16 @@ func CoreComputeImageCapabilitySchemaDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreComputeImageCapabilitySchemaResource(), fieldMap, readSingularCoreComputeImageCapabilitySchema)
 }
 
-func readSingularCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeImageCapabilitySchemaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetComputeImageCapabilitySchemaResponse
 }
@@ -61,9 +62,9 @@ func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) Get() error {
 		request.IsMergeEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
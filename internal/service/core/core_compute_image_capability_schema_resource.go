@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func CoreComputeImageCapabilitySchemaResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreComputeImageCapabilitySchema,
-		Read:     readCoreComputeImageCapabilitySchema,
-		Update:   updateCoreComputeImageCapabilitySchema,
-		Delete:   deleteCoreComputeImageCapabilitySchema,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreComputeImageCapabilitySchema,
+		ReadContext:   readCoreComputeImageCapabilitySchema,
+		UpdateContext: updateCoreComputeImageCapabilitySchema,
+		DeleteContext: deleteCoreComputeImageCapabilitySchema,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -82,37 +83,37 @@ func CoreComputeImageCapabilitySchemaResource() *schema.Resource {
 	}
 }
 
-func createCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func createCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func updateCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func deleteCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreComputeImageCapabilitySchemaResourceCrud struct {
@@ -169,9 +170,10 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create() error {
 		request.SchemaData = schemaData
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.CreateComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -191,9 +193,9 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
 		request.IsMergeEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,9 +244,9 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
 		request.SchemaData = schemaData
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.UpdateComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,9 +261,9 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ComputeImageCapabilitySchemaId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteComputeImageCapabilitySchema(context.Background(), request)
+	_, err := s.Client.DeleteComputeImageCapabilitySchema(s.Context(), request)
 	return err
 }
 
@@ -312,14 +314,14 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) updateCompartment(compart
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ComputeImageCapabilitySchemaId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeImageCapabilitySchemasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeImageCapabilitySchemas,
+		ReadContext: readCoreComputeImageCapabilitySchemas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreComputeImageCapabilitySchemasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeImageCapabilitySchemas(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeImageCapabilitySchemas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreComputeImageCapabilitySchemasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListComputeImageCapabilitySchemasResponse
 }
@@ -75,9 +76,9 @@ func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get() error {
 		request.ImageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListComputeImageCapabilitySchemas(context.Background(), request)
+	response, err := s.Client.ListComputeImageCapabilitySchemas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeImageCapabilitySchemas(context.Background(), request)
+		listResponse, err := s.Client.ListComputeImageCapabilitySchemas(s.Context(), request)
 		if err != nil {
 			return err
 		}
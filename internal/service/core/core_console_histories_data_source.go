@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreConsoleHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreConsoleHistories,
+		ReadContext: readCoreConsoleHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -43,16 +44,16 @@ func CoreConsoleHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreConsoleHistories(d *schema.ResourceData, m interface{}) error {
+func readCoreConsoleHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreConsoleHistoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListConsoleHistoriesResponse
 }
@@ -83,9 +84,9 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.ConsoleHistoryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListConsoleHistories(context.Background(), request)
+	response, err := s.Client.ListConsoleHistories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConsoleHistories(context.Background(), request)
+		listResponse, err := s.Client.ListConsoleHistories(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreConsoleHistoryContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreConsoleHistoryContent,
+		ReadContext: readSingularCoreConsoleHistoryContent,
 		Schema: map[string]*schema.Schema{
 			"console_history_id": {
 				Type:     schema.TypeString,
@@ -38,16 +39,16 @@ func CoreConsoleHistoryContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreConsoleHistoryContent(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreConsoleHistoryContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreConsoleHistoryContentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetConsoleHistoryContentResponse
 }
@@ -74,9 +75,9 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 		request.Offset = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetConsoleHistoryContent(context.Background(), request)
+	response, err := s.Client.GetConsoleHistoryContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreConsoleHistoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreConsoleHistory,
-		Read:     readCoreConsoleHistory,
-		Update:   updateCoreConsoleHistory,
-		Delete:   deleteCoreConsoleHistory,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreConsoleHistory,
+		ReadContext:   readCoreConsoleHistory,
+		UpdateContext: updateCoreConsoleHistory,
+		DeleteContext: deleteCoreConsoleHistory,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -73,37 +74,37 @@ func CoreConsoleHistoryResource() *schema.Resource {
 	}
 }
 
-func createCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func createCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func readCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func updateCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func deleteCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreConsoleHistoryResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CaptureConsoleHistory(context.Background(), request)
+	response, err := s.Client.CaptureConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,9 +174,9 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleHistoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetConsoleHistory(context.Background(), request)
+	response, err := s.Client.GetConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,9 +208,9 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
+	response, err := s.Client.UpdateConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,9 +225,9 @@ func (s *CoreConsoleHistoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleHistoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
+	_, err := s.Client.DeleteConsoleHistory(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCpeDeviceShapeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreCpeDeviceShape,
+		ReadContext: readSingularCoreCpeDeviceShape,
 		Schema: map[string]*schema.Schema{
 			"cpe_device_shape_id": {
 				Type:     schema.TypeString,
@@ -76,16 +77,16 @@ func CoreCpeDeviceShapeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreCpeDeviceShape(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCpeDeviceShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeDeviceShapeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCpeDeviceShapeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetCpeDeviceShapeResponse
 }
@@ -102,9 +103,9 @@ func (s *CoreCpeDeviceShapeDataSourceCrud) Get() error {
 		request.CpeDeviceShapeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetCpeDeviceShape(context.Background(), request)
+	response, err := s.Client.GetCpeDeviceShape(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCpeDeviceShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCpeDeviceShapes,
+		ReadContext: readCoreCpeDeviceShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cpe_device_shapes": {
@@ -64,16 +65,16 @@ func CoreCpeDeviceShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCpeDeviceShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreCpeDeviceShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeDeviceShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCpeDeviceShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListCpeDeviceShapesResponse
 }
@@ -85,9 +86,9 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) VoidState() {
 func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
 	request := oci_core.ListCpeDeviceShapesRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+	response, err := s.Client.ListCpeDeviceShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListCpeDeviceShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
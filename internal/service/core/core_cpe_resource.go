@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreCpeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCpe,
-		Read:     readCoreCpe,
-		Update:   updateCoreCpe,
-		Delete:   deleteCoreCpe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCpe,
+		ReadContext:   readCoreCpe,
+		UpdateContext: updateCoreCpe,
+		DeleteContext: deleteCoreCpe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -70,37 +71,37 @@ func CoreCpeResource() *schema.Resource {
 	}
 }
 
-func createCoreCpe(d *schema.ResourceData, m interface{}) error {
+func createCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreCpe(d *schema.ResourceData, m interface{}) error {
+func readCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreCpe(d *schema.ResourceData, m interface{}) error {
+func updateCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreCpe(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreCpeResourceCrud struct {
@@ -149,9 +150,10 @@ func (s *CoreCpeResourceCrud) Create() error {
 		request.IpAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCpe(context.Background(), request)
+	response, err := s.Client.CreateCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -166,9 +168,9 @@ func (s *CoreCpeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CpeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCpe(context.Background(), request)
+	response, err := s.Client.GetCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -214,9 +216,9 @@ func (s *CoreCpeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCpe(context.Background(), request)
+	response, err := s.Client.UpdateCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,9 +233,9 @@ func (s *CoreCpeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CpeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCpe(context.Background(), request)
+	_, err := s.Client.DeleteCpe(s.Context(), request)
 	return err
 }
 
@@ -276,14 +278,14 @@ func (s *CoreCpeResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.CpeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCpeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCpeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreCrossConnectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreCrossConnectResource(), fieldMap, readSingularCoreCrossConnect)
 }
 
-func readSingularCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetCrossConnectResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreCrossConnectDataSourceCrud) Get() error {
 		request.CrossConnectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func CoreCrossConnectGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCrossConnectGroup,
-		Read:     readCoreCrossConnectGroup,
-		Update:   updateCoreCrossConnectGroup,
-		Delete:   deleteCoreCrossConnectGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCrossConnectGroup,
+		ReadContext:   readCoreCrossConnectGroup,
+		UpdateContext: updateCoreCrossConnectGroup,
+		DeleteContext: deleteCoreCrossConnectGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -134,37 +135,37 @@ func CoreCrossConnectGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreCrossConnectGroupResourceCrud struct {
@@ -257,9 +258,10 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.CreateCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,9 +276,9 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CrossConnectGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -333,9 +335,9 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -350,9 +352,9 @@ func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnectGroup(s.Context(), request)
 	return err
 }
 
@@ -507,14 +509,14 @@ func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(compartment interf
 	idTmp := s.D.Id()
 	changeCompartmentRequest.CrossConnectGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnectGroups,
+		ReadContext: readCoreCrossConnectGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreCrossConnectGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnectGroups(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListCrossConnectGroupsResponse
 }
@@ -74,9 +75,9 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.CrossConnectGroupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListCrossConnectGroups(context.Background(), request)
+	response, err := s.Client.ListCrossConnectGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectGroups(context.Background(), request)
+		listResponse, err := s.Client.ListCrossConnectGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
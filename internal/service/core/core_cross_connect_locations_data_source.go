@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectLocationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnectLocations,
+		ReadContext: readCoreCrossConnectLocations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func CoreCrossConnectLocationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnectLocations(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectLocations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectLocationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectLocationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListCrossConnectLocationsResponse
 }
@@ -73,9 +74,9 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+	response, err := s.Client.ListCrossConnectLocations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+		listResponse, err := s.Client.ListCrossConnectLocations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectPortSpeedShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnectPortSpeedShapes,
+		ReadContext: readCoreCrossConnectPortSpeedShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func CoreCrossConnectPortSpeedShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnectPortSpeedShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectPortSpeedShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectPortSpeedShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectPortSpeedShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListCrossconnectPortSpeedShapesResponse
 }
@@ -73,9 +74,9 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+	response, err := s.Client.ListCrossconnectPortSpeedShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreCrossConnectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCrossConnect,
-		Read:     readCoreCrossConnect,
-		Update:   updateCoreCrossConnect,
-		Delete:   deleteCoreCrossConnect,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCrossConnect,
+		ReadContext:   readCoreCrossConnect,
+		UpdateContext: updateCoreCrossConnect,
+		DeleteContext: deleteCoreCrossConnect,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,48 +175,48 @@ func CoreCrossConnectResource() *schema.Resource {
 	}
 }
 
-func createCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func createCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	// Issue an Update if 'is_active' is set to true
 	if _, ok := sync.D.GetOkExists("is_active"); ok {
 		log.Printf("[DEBUG] CrossConnect resource is set to be active, calling 'Update' for the resource")
-		return tfresource.UpdateResource(d, sync)
+		return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func readCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func updateCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreCrossConnectResourceCrud struct {
@@ -340,9 +341,10 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 		request.PortSpeedShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnect(context.Background(), request)
+	response, err := s.Client.CreateCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -357,9 +359,9 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CrossConnectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -425,9 +427,9 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -442,9 +444,9 @@ func (s *CoreCrossConnectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnect(s.Context(), request)
 	return err
 }
 
@@ -651,14 +653,14 @@ func (s *CoreCrossConnectResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.CrossConnectId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
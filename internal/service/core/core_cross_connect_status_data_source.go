@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreCrossConnectStatus,
+		ReadContext: readSingularCoreCrossConnectStatus,
 		Schema: map[string]*schema.Schema{
 			"cross_connect_id": {
 				Type:     schema.TypeString,
@@ -42,16 +43,16 @@ func CoreCrossConnectStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreCrossConnectStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCrossConnectStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetCrossConnectStatusResponse
 }
@@ -68,9 +69,9 @@ func (s *CoreCrossConnectStatusDataSourceCrud) Get() error {
 		request.CrossConnectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetCrossConnectStatus(context.Background(), request)
+	response, err := s.Client.GetCrossConnectStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
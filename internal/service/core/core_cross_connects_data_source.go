@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnects,
+		ReadContext: readCoreCrossConnects,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func CoreCrossConnectsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnects(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreCrossConnectsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListCrossConnectsResponse
 }
@@ -83,9 +84,9 @@ func (s *CoreCrossConnectsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.CrossConnectLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListCrossConnects(context.Background(), request)
+	response, err := s.Client.ListCrossConnects(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreCrossConnectsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnects(context.Background(), request)
+		listResponse, err := s.Client.ListCrossConnects(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreDedicatedVmHostDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreDedicatedVmHostResource(), fieldMap, readSingularCoreDedicatedVmHost)
 }
 
-func readSingularCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreDedicatedVmHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDedicatedVmHostDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetDedicatedVmHostResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreDedicatedVmHostDataSourceCrud) Get() error {
 		request.DedicatedVmHostId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.GetDedicatedVmHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostInstanceShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHostInstanceShapes,
+		ReadContext: readCoreDedicatedVmHostInstanceShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,16 +56,16 @@ func CoreDedicatedVmHostInstanceShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHostInstanceShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHostInstanceShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostInstanceShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDedicatedVmHostInstanceShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListDedicatedVmHostInstanceShapesResponse
 }
@@ -91,9 +92,9 @@ func (s *CoreDedicatedVmHostInstanceShapesDataSourceCrud) Get() error {
 		request.DedicatedVmHostShape = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostInstanceShapes(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostInstanceShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreDedicatedVmHostInstanceShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostInstanceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostInstanceShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHostShapes,
+		ReadContext: readCoreDedicatedVmHostShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,16 +56,16 @@ func CoreDedicatedVmHostShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHostShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHostShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDedicatedVmHostShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListDedicatedVmHostShapesResponse
 }
@@ -91,9 +92,9 @@ func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get() error {
 		request.InstanceShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostShapes(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
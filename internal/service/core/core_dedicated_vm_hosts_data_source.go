@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHosts,
+		ReadContext: readCoreDedicatedVmHosts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,16 +56,16 @@ func CoreDedicatedVmHostsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHosts(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHosts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDedicatedVmHostsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListDedicatedVmHostsResponse
 }
@@ -110,9 +111,9 @@ func (s *CoreDedicatedVmHostsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.ListDedicatedVmHostsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDedicatedVmHosts(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHosts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *CoreDedicatedVmHostsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHosts(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHosts(s.Context(), request)
 		if err != nil {
 			return err
 		}
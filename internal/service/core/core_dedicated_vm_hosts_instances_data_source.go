@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHostsInstances,
+		ReadContext: readCoreDedicatedVmHostsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -67,16 +68,16 @@ func CoreDedicatedVmHostsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHostsInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHostsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDedicatedVmHostsInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListDedicatedVmHostInstancesResponse
 }
@@ -103,9 +104,9 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 		request.DedicatedVmHostId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDhcpOptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDhcpOptionsList,
+		ReadContext: readCoreDhcpOptionsList,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func CoreDhcpOptionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDhcpOptionsList(d *schema.ResourceData, m interface{}) error {
+func readCoreDhcpOptionsList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDhcpOptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDhcpOptionsResponse
 }
@@ -83,9 +84,9 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDhcpOptions(context.Background(), request)
+	response, err := s.Client.ListDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDhcpOptions(context.Background(), request)
+		listResponse, err := s.Client.ListDhcpOptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
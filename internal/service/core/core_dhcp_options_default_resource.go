@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func DefaultCoreDhcpOptionsResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreDhcpOptionsResource())
 
-	defaultResourceSchema.Create = createDefaultDhcpOptions
-	defaultResourceSchema.Delete = deleteDefaultDhcpOptions
+	defaultResourceSchema.CreateContext = createDefaultDhcpOptions
+	defaultResourceSchema.DeleteContext = deleteDefaultDhcpOptions
 
 	return defaultResourceSchema
 }
@@ -29,20 +30,20 @@ type DefaultDhcpOptionsResourceCrud struct {
 	CoreDhcpOptionsResourceCrud
 }
 
-func createDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func createDefaultDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func deleteDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 func (s *DefaultDhcpOptionsResourceCrud) Create() error {
@@ -71,9 +72,9 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 		},
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func CoreDhcpOptionsResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDhcpOptions,
-		Read:     readCoreDhcpOptions,
-		Update:   updateCoreDhcpOptions,
-		Delete:   deleteCoreDhcpOptions,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDhcpOptions,
+		ReadContext:   readCoreDhcpOptions,
+		UpdateContext: updateCoreDhcpOptions,
+		DeleteContext: deleteCoreDhcpOptions,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -131,37 +132,37 @@ func CoreDhcpOptionsResource() *schema.Resource {
 	}
 }
 
-func createCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func createCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func readCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func updateCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDhcpOptionsResourceCrud struct {
@@ -251,9 +252,10 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDhcpOptions(context.Background(), request)
+	response, err := s.Client.CreateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,8 +266,8 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 	updateRequest := oci_core.UpdateDhcpOptionsRequest{}
 	updateRequest.DhcpId = s.Res.Id
 	updateRequest.Options = request.Options
-	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	updateResponse, err := s.Client.UpdateDhcpOptions(context.Background(), updateRequest)
+	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	updateResponse, err := s.Client.UpdateDhcpOptions(s.Context(), updateRequest)
 	if err != nil {
 		log.Printf("[ERROR] Could not perform an Update right after the Create of the dhcpOptions: %v", err)
 	}
@@ -280,9 +282,9 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DhcpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDhcpOptions(context.Background(), request)
+	response, err := s.Client.GetDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,9 +347,9 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -362,9 +364,9 @@ func (s *CoreDhcpOptionsResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DhcpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
+	_, err := s.Client.DeleteDhcpOptions(s.Context(), request)
 	return err
 }
 
@@ -512,14 +514,14 @@ func (s *CoreDhcpOptionsResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DhcpId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeDhcpOptionsCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDhcpOptionsCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
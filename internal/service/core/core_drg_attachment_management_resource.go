@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,11 +23,11 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgAttachmentManagement,
-		Read:     readCoreDrgAttachmentManagement,
-		Update:   updateCoreDrgAttachmentManagement,
-		Delete:   deleteCoreDrgAttachmentManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgAttachmentManagement,
+		ReadContext:   readCoreDrgAttachmentManagement,
+		UpdateContext: updateCoreDrgAttachmentManagement,
+		DeleteContext: deleteCoreDrgAttachmentManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"attachment_type": {
@@ -152,50 +153,52 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	err := sync.Get()
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
-	return updateCoreDrgAttachmentManagement(d, m)
+	return updateCoreDrgAttachmentManagement(ctx, d, m)
 }
 
-func readCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok &&
 		sync.D.HasChange("remove_export_drg_route_distribution_trigger") {
 		err := sync.removeExportDrgRouteDistribution()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgAttachmentManagementResourceCrud struct {
@@ -238,9 +241,9 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Get() error {
 		request.NetworkId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -308,9 +311,9 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -449,9 +452,9 @@ func (s *CoreDrgAttachmentManagementResourceCrud) removeExportDrgRouteDistributi
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
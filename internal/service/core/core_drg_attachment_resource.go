@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func CoreDrgAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgAttachment,
-		Read:     readCoreDrgAttachment,
-		Update:   updateCoreDrgAttachment,
-		Delete:   deleteCoreDrgAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgAttachment,
+		ReadContext:   readCoreDrgAttachment,
+		UpdateContext: updateCoreDrgAttachment,
+		DeleteContext: deleteCoreDrgAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
@@ -143,60 +144,62 @@ func CoreDrgAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok {
 		err := sync.removeExportDrgRouteDistribution()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	if _, ok := sync.D.GetOkExists("export_drg_route_distribution_id"); ok && sync.D.HasChange("export_drg_route_distribution_id") {
-		return sync.Update()
+		return tfresource.ToDiagnostics(sync, sync.Update())
 	}
 
 	return nil
 }
 
-func readCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok &&
 		sync.D.HasChange("remove_export_drg_route_distribution_trigger") {
 		err := sync.removeExportDrgRouteDistribution()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgAttachmentResourceCrud struct {
@@ -285,9 +288,10 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgAttachment(context.Background(), request)
+	response, err := s.Client.CreateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,9 +307,9 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgAttachment(context.Background(), request)
+	response, err := s.Client.GetDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -365,9 +369,9 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -382,9 +386,9 @@ func (s *CoreDrgAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
+	_, err := s.Client.DeleteDrgAttachment(s.Context(), request)
 	return err
 }
 
@@ -537,9 +541,9 @@ func (s *CoreDrgAttachmentResourceCrud) removeExportDrgRouteDistribution() error
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgAttachments,
+		ReadContext: readCoreDrgAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"attachment_type": {
@@ -59,16 +60,16 @@ func CoreDrgAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgAttachmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDrgAttachmentsResponse
 }
@@ -118,9 +119,9 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListDrgAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
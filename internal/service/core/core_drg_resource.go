@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreDrgResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrg,
-		Read:     readCoreDrg,
-		Update:   updateCoreDrg,
-		Delete:   deleteCoreDrg,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrg,
+		ReadContext:   readCoreDrg,
+		UpdateContext: updateCoreDrg,
+		DeleteContext: deleteCoreDrg,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -104,40 +105,40 @@ func CoreDrgResource() *schema.Resource {
 	}
 }
 
-func createCoreDrg(d *schema.ResourceData, m interface{}) error {
+func createCoreDrg(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreDrg(d *schema.ResourceData, m interface{}) error {
+func readCoreDrg(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrg(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrg(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrg(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrg(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgResourceCrud struct {
@@ -202,9 +203,10 @@ func (s *CoreDrgResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrg(context.Background(), request)
+	response, err := s.Client.CreateDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -219,9 +221,9 @@ func (s *CoreDrgResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DrgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrg(context.Background(), request)
+	response, err := s.Client.GetDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +233,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 	statusRequest := oci_core.GetDrgRedundancyStatusRequest{}
 	statusRequest.DrgId = &tmp
 
-	if redundancyStatusResponse, err := s.Client.GetDrgRedundancyStatus(context.Background(), statusRequest); err == nil {
+	if redundancyStatusResponse, err := s.Client.GetDrgRedundancyStatus(s.Context(), statusRequest); err == nil {
 		s.RedundancyStatus = &redundancyStatusResponse.DrgRedundancyStatus
 	}
 
@@ -281,9 +283,9 @@ func (s *CoreDrgResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrg(context.Background(), request)
+	response, err := s.Client.UpdateDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -298,9 +300,9 @@ func (s *CoreDrgResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrg(context.Background(), request)
+	_, err := s.Client.DeleteDrg(s.Context(), request)
 	return err
 }
 
@@ -403,21 +405,23 @@ func (s *CoreDrgResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DrgId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeDrgCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDrgCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	// Workaround: Sleep for some time before polling the configuration. Because Update happens asynchronously, polling too
 	// soon may result in service returning stale configuration values.
-	time.Sleep(time.Second * 20)
+	if err := tfresource.SleepContext(s.Context(), time.Second*20); err != nil {
+		return err
+	}
 	request := oci_core.GetDrgRequest{}
 	tmp := s.D.Id()
 	request.DrgId = &tmp
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	_, err = s.Client.GetDrg(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	_, err = s.Client.GetDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
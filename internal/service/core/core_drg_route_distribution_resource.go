@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreDrgRouteDistributionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteDistribution,
-		Read:     readCoreDrgRouteDistribution,
-		Update:   updateCoreDrgRouteDistribution,
-		Delete:   deleteCoreDrgRouteDistribution,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteDistribution,
+		ReadContext:   readCoreDrgRouteDistribution,
+		UpdateContext: updateCoreDrgRouteDistribution,
+		DeleteContext: deleteCoreDrgRouteDistribution,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"distribution_type": {
@@ -74,37 +75,37 @@ func CoreDrgRouteDistributionResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgRouteDistributionResourceCrud struct {
@@ -171,9 +172,10 @@ func (s *CoreDrgRouteDistributionResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -188,9 +190,9 @@ func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DrgRouteDistributionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.GetDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -222,9 +224,9 @@ func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,9 +241,9 @@ func (s *CoreDrgRouteDistributionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgRouteDistributionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgRouteDistribution(context.Background(), request)
+	_, err := s.Client.DeleteDrgRouteDistribution(s.Context(), request)
 	return err
 }
 
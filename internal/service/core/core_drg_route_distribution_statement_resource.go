@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func CoreDrgRouteDistributionStatementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteDistributionStatement,
-		Read:     readCoreDrgRouteDistributionStatement,
-		Update:   updateCoreDrgRouteDistributionStatement,
-		Delete:   deleteCoreDrgRouteDistributionStatement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteDistributionStatement,
+		ReadContext:   readCoreDrgRouteDistributionStatement,
+		UpdateContext: updateCoreDrgRouteDistributionStatement,
+		DeleteContext: deleteCoreDrgRouteDistributionStatement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_route_distribution_id": {
@@ -83,33 +84,33 @@ func CoreDrgRouteDistributionStatementResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgRouteDistributionStatementResourceCrud struct {
@@ -160,9 +161,9 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Create() error {
 	tmp := []oci_core.AddDrgRouteDistributionStatementDetails{statement}
 	request.Statements = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.AddDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,9 +200,9 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	} else {
 		log.Printf("[WARN] !!! Get() unable to parse current ID: %s", s.D.Id())
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +212,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -269,8 +270,8 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Update() error {
 	tmp := []oci_core.UpdateDrgRouteDistributionStatementDetails{statement}
 	request.Statements = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateDrgRouteDistributionStatements(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	response, err := s.Client.UpdateDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to Update distribution statements, error: %v", err)
 	}
@@ -302,8 +303,8 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Delete() error {
 	tmp := []string{statementId}
 	request.StatementIds = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err = s.Client.RemoveDrgRouteDistributionStatements(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	_, err = s.Client.RemoveDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
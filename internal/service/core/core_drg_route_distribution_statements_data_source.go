@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteDistributionStatementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteDistributionStatements,
+		ReadContext: readCoreDrgRouteDistributionStatements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"drg_route_distribution_id": {
@@ -76,16 +77,16 @@ func CoreDrgRouteDistributionStatementsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteDistributionStatements(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributionStatements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgRouteDistributionStatementsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDrgRouteDistributionStatementsResponse
 }
@@ -102,9 +103,9 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 		request.DrgRouteDistributionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteDistributionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteDistributions,
+		ReadContext: readCoreDrgRouteDistributions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -39,16 +40,16 @@ func CoreDrgRouteDistributionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteDistributions(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgRouteDistributionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDrgRouteDistributionsResponse
 }
@@ -74,9 +75,9 @@ func (s *CoreDrgRouteDistributionsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.DrgRouteDistributionLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributions(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreDrgRouteDistributionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributions(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributions(s.Context(), request)
 		if err != nil {
 			return err
 		}
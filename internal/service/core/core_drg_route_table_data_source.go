@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreDrgRouteTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreDrgRouteTableResource(), fieldMap, readSingularCoreDrgRouteTable)
 }
 
-func readSingularCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgRouteTableDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetDrgRouteTableResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreDrgRouteTableDataSourceCrud) Get() error {
 		request.DrgRouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetDrgRouteTable(context.Background(), request)
+	response, err := s.Client.GetDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreDrgRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteTable,
-		Read:     readCoreDrgRouteTable,
-		Update:   updateCoreDrgRouteTable,
-		Delete:   deleteCoreDrgRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteTable,
+		ReadContext:   readCoreDrgRouteTable,
+		UpdateContext: updateCoreDrgRouteTable,
+		DeleteContext: deleteCoreDrgRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
@@ -82,56 +83,58 @@ func CoreDrgRouteTableResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if _, ok := sync.D.GetOkExists("remove_import_trigger"); ok {
 		err := sync.RemoveImportRouteDistribution()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	return nil
 }
 
-func readCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_import_trigger"); ok &&
 		sync.D.HasChange("remove_import_trigger") {
 		err := sync.RemoveImportRouteDistribution()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreDrgRouteTableResourceCrud struct {
@@ -204,9 +207,10 @@ func (s *CoreDrgRouteTableResourceCrud) Create() error {
 		request.IsEcmpEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -221,9 +225,9 @@ func (s *CoreDrgRouteTableResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DrgRouteTableId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteTable(context.Background(), request)
+	response, err := s.Client.GetDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,9 +271,9 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 		request.IsEcmpEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,9 +288,9 @@ func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution() error {
 	tmp := s.D.Id()
 	request.DrgRouteTableId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveImportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveImportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -307,15 +311,15 @@ func (s *CoreDrgRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgRouteTableId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+	response, err := s.Client.DeleteDrgRouteTable(s.Context(), request)
 	if response.HTTPResponse().StatusCode == 400 {
 		retryDeleteFunc := func() bool {
-			response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+			response, err := s.Client.DeleteDrgRouteTable(s.Context(), request)
 			return err == nil && response.HTTPResponse().StatusCode == 200
 		}
-		return tfresource.WaitForResourceCondition(s, retryDeleteFunc, s.D.Timeout(schema.TimeoutDelete))
+		return tfresource.WaitForResourceConditionContext(s.Context(), s, retryDeleteFunc, s.D.Timeout(schema.TimeoutDelete))
 	}
 	return err
 }
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreDrgRouteTableRouteRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteTableRouteRule,
-		Read:     readCoreDrgRouteTableRouteRule,
-		Update:   updateCoreDrgRouteTableRouteRuleResource,
-		Delete:   deleteCoreDrgRouteTableRouteRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteTableRouteRule,
+		ReadContext:   readCoreDrgRouteTableRouteRule,
+		UpdateContext: updateCoreDrgRouteTableRouteRuleResource,
+		DeleteContext: deleteCoreDrgRouteTableRouteRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_route_table_id": {
@@ -75,33 +76,33 @@ func CoreDrgRouteTableRouteRuleResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
-func updateCoreDrgRouteTableRouteRuleResource(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteTableRouteRuleResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
 type CoreDrgRouteTableRouteRuleResourceCrud struct {
@@ -142,9 +143,10 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Create() error {
 	tmp := []oci_core.AddDrgRouteRuleDetails{addDrgRouteRuleDetails}
 	request.RouteRules = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteRules(context.Background(), request)
+	response, err := s.Client.AddDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -227,9 +229,9 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 		log.Printf("[WARN] !!! Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListDrgRouteRules(context.Background(), request)
+	response, err := s.Client.ListDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +240,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteRules(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -273,8 +275,8 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Delete() error {
 	tmp := []string{drgRouteRuleId}
 	request.RouteRuleIds = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	_, err = s.Client.RemoveDrgRouteRules(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	_, err = s.Client.RemoveDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,8 +314,8 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Update() error {
 	tmp := []oci_core.UpdateDrgRouteRuleDetails{updateDrgRouteTableDetails}
 	request.RouteRules = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateDrgRouteRules(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	response, err := s.Client.UpdateDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to Update route rules, error: %v", err)
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteTableRouteRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteTableRouteRules,
+		ReadContext: readCoreDrgRouteTableRouteRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"drg_route_table_id": {
@@ -80,16 +81,16 @@ func CoreDrgRouteTableRouteRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteTableRouteRules(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTableRouteRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgRouteTableRouteRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDrgRouteRulesResponse
 }
@@ -110,9 +111,9 @@ func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get() error {
 		request.RouteType = oci_core.ListDrgRouteRulesRouteTypeEnum(routeType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgRouteRules(context.Background(), request)
+	response, err := s.Client.ListDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteRules(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
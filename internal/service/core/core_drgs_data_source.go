@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgs,
+		ReadContext: readCoreDrgs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,16 +32,16 @@ func CoreDrgsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgs(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreDrgsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListDrgsResponse
 }
@@ -57,9 +58,9 @@ func (s *CoreDrgsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListDrgs(context.Background(), request)
+	response, err := s.Client.ListDrgs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreDrgsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgs(context.Background(), request)
+		listResponse, err := s.Client.ListDrgs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreFastConnectProviderService,
+		ReadContext: readSingularCoreFastConnectProviderService,
 		Schema: map[string]*schema.Schema{
 			"provider_service_id": {
 				Type:     schema.TypeString,
@@ -73,16 +74,16 @@ func CoreFastConnectProviderServiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreFastConnectProviderService(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreFastConnectProviderService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreFastConnectProviderServiceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetFastConnectProviderServiceResponse
 }
@@ -99,9 +100,9 @@ func (s *CoreFastConnectProviderServiceDataSourceCrud) Get() error {
 		request.ProviderServiceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetFastConnectProviderService(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderService(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServiceKeyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreFastConnectProviderServiceKey,
+		ReadContext: readSingularCoreFastConnectProviderServiceKey,
 		Schema: map[string]*schema.Schema{
 			"provider_service_id": {
 				Type:     schema.TypeString,
@@ -42,16 +43,16 @@ func CoreFastConnectProviderServiceKeyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreFastConnectProviderServiceKey(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreFastConnectProviderServiceKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServiceKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreFastConnectProviderServiceKeyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetFastConnectProviderServiceKeyResponse
 }
@@ -73,9 +74,9 @@ func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get() error {
 		request.ProviderServiceKeyName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetFastConnectProviderServiceKey(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderServiceKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreFastConnectProviderServices,
+		ReadContext: readCoreFastConnectProviderServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -90,16 +91,16 @@ func CoreFastConnectProviderServicesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreFastConnectProviderServices(d *schema.ResourceData, m interface{}) error {
+func readCoreFastConnectProviderServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreFastConnectProviderServicesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListFastConnectProviderServicesResponse
 }
@@ -116,9 +117,9 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderServices(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderServices(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -23,16 +24,16 @@ func CoreImageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreImageResource(), fieldMap, readSingularCoreImage)
 }
 
-func readSingularCoreImage(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreImageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetImageResponse
 }
@@ -49,9 +50,9 @@ func (s *CoreImageDataSourceCrud) Get() error {
 		request.ImageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,10 +30,10 @@ func CoreImageResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createCoreImage,
-		Read:   readCoreImage,
-		Update: updateCoreImage,
-		Delete: deleteCoreImage,
+		CreateContext: createCoreImage,
+		ReadContext:   readCoreImage,
+		UpdateContext: updateCoreImage,
+		DeleteContext: deleteCoreImage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -231,40 +232,40 @@ func CoreImageResource() *schema.Resource {
 	}
 }
 
-func createCoreImage(d *schema.ResourceData, m interface{}) error {
+func createCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreImage(d *schema.ResourceData, m interface{}) error {
+func readCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreImage(d *schema.ResourceData, m interface{}) error {
+func updateCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreImage(d *schema.ResourceData, m interface{}) error {
+func deleteCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreImageResourceCrud struct {
@@ -349,9 +350,10 @@ func (s *CoreImageResourceCrud) Create() error {
 		request.LaunchMode = oci_core.CreateImageDetailsLaunchModeEnum(launchMode.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateImage(context.Background(), request)
+	response, err := s.Client.CreateImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -360,7 +362,7 @@ func (s *CoreImageResourceCrud) Create() error {
 	s.Res = &response.Image
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "image", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "image", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -378,9 +380,9 @@ func (s *CoreImageResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,9 +423,9 @@ func (s *CoreImageResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateImage(context.Background(), request)
+	response, err := s.Client.UpdateImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -438,9 +440,9 @@ func (s *CoreImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteImage(context.Background(), request)
+	_, err := s.Client.DeleteImage(s.Context(), request)
 	return err
 }
 
@@ -592,14 +594,14 @@ func (s *CoreImageResourceCrud) updateCompartment(compartment interface{}) error
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ImageId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeImageCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreImageShapeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreImageShape,
+		ReadContext: readSingularCoreImageShape,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:     schema.TypeString,
@@ -76,16 +77,16 @@ func CoreImageShapeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreImageShape(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreImageShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageShapeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreImageShapeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetImageShapeCompatibilityEntryResponse
 }
@@ -107,9 +108,9 @@ func (s *CoreImageShapeDataSourceCrud) Get() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetImageShapeCompatibilityEntry(context.Background(), request)
+	response, err := s.Client.GetImageShapeCompatibilityEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
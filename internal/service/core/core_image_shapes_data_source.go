@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreImageShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreImageShapes,
+		ReadContext: readCoreImageShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"image_id": {
@@ -89,16 +90,16 @@ func CoreImageShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreImageShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreImageShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreImageShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListImageShapeCompatibilityEntriesResponse
 }
@@ -115,9 +116,9 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 		request.ImageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+	response, err := s.Client.ListImageShapeCompatibilityEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
@@ -17,7 +18,7 @@ import (
 
 func CoreImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreImages,
+		ReadContext: readCoreImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -69,16 +70,16 @@ func CoreImagesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreImages(d *schema.ResourceData, m interface{}) error {
+func readCoreImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreImagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListImagesResponse
 }
@@ -127,9 +128,9 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 		request.SortOrder = oci_core.ListImagesSortOrderEnum(sortOrder.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListImages(context.Background(), request)
+	response, err := s.Client.ListImages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImages(context.Background(), request)
+		listResponse, err := s.Client.ListImages(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreInstanceConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreInstanceConfigurationResource(), fieldMap, readSingularCoreInstanceConfiguration)
 }
 
-func readSingularCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.GetInstanceConfigurationResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreInstanceConfigurationDataSourceCrud) Get() error {
 		request.InstanceConfigurationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstanceConfiguration,
-		Read:     readCoreInstanceConfiguration,
-		Update:   updateCoreInstanceConfiguration,
-		Delete:   deleteCoreInstanceConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstanceConfiguration,
+		ReadContext:   readCoreInstanceConfiguration,
+		UpdateContext: updateCoreInstanceConfiguration,
+		DeleteContext: deleteCoreInstanceConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -961,37 +962,37 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 	}
 }
 
-func createCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func createCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInstanceConfigurationResourceCrud struct {
@@ -1012,9 +1013,10 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.CreateInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1029,9 +1031,9 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1072,9 +1074,9 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1089,9 +1091,9 @@ func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConfiguration(s.Context(), request)
 	return err
 }
 
@@ -2537,14 +2539,14 @@ func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment in
 	idTmp := s.D.Id()
 	changeCompartmentRequest.InstanceConfigurationId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstanceConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstanceConfigurations,
+		ReadContext: readCoreInstanceConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,16 +32,16 @@ func CoreInstanceConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstanceConfigurations(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceConfigurationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.ListInstanceConfigurationsResponse
 }
@@ -57,9 +58,9 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+	response, err := s.Client.ListInstanceConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListInstanceConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
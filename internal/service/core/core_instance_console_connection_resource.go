@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreInstanceConsoleConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstanceConsoleConnection,
-		Read:     readCoreInstanceConsoleConnection,
-		Update:   updateCoreInstanceConsoleConnection,
-		Delete:   deleteCoreInstanceConsoleConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstanceConsoleConnection,
+		ReadContext:   readCoreInstanceConsoleConnection,
+		UpdateContext: updateCoreInstanceConsoleConnection,
+		DeleteContext: deleteCoreInstanceConsoleConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -81,37 +82,37 @@ func CoreInstanceConsoleConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInstanceConsoleConnectionResourceCrud struct {
@@ -174,9 +175,10 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 		request.PublicKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -191,9 +193,9 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -220,9 +222,9 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,9 +239,9 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConsoleConnection(s.Context(), request)
 	return err
 }
 
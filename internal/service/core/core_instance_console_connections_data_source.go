@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceConsoleConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstanceConsoleConnections,
+		ReadContext: readCoreInstanceConsoleConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func CoreInstanceConsoleConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstanceConsoleConnections(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConsoleConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceConsoleConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListInstanceConsoleConnectionsResponse
 }
@@ -66,9 +67,9 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+	response, err := s.Client.ListInstanceConsoleConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+		listResponse, err := s.Client.ListInstanceConsoleConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
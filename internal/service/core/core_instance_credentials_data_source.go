@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceCredentialDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstanceCredential,
+		ReadContext: readSingularCoreInstanceCredential,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func CoreInstanceCredentialDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstanceCredential(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceCredentialDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceCredentialDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetWindowsInstanceInitialCredentialsResponse
 }
@@ -60,9 +61,9 @@ func (s *CoreInstanceCredentialDataSourceCrud) Get() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetWindowsInstanceInitialCredentials(context.Background(), request)
+	response, err := s.Client.GetWindowsInstanceInitialCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
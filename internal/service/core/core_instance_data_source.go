@@ -8,6 +8,7 @@ import (
 
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -24,14 +25,14 @@ func CoreInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreInstanceResource(), fieldMap, readSingularCoreInstance)
 }
 
-func readSingularCoreInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.BlockStorageClient = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceDataSourceCrud struct {
@@ -50,9 +51,9 @@ func (s *CoreInstanceDataSourceCrud) Get() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
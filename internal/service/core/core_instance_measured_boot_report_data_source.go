@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceMeasuredBootReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstanceMeasuredBootReport,
+		ReadContext: readSingularCoreInstanceMeasuredBootReport,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -93,16 +94,16 @@ func CoreInstanceMeasuredBootReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstanceMeasuredBootReport(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceMeasuredBootReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceMeasuredBootReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstanceMeasuredBootReportDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.GetMeasuredBootReportResponse
 }
@@ -119,9 +120,9 @@ func (s *CoreInstanceMeasuredBootReportDataSourceCrud) Get() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetMeasuredBootReport(context.Background(), request)
+	response, err := s.Client.GetMeasuredBootReport(s.Context(), request)
 	if err != nil {
 		return err
 	}
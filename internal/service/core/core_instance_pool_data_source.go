@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreInstancePoolDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreInstancePoolResource(), fieldMap, readSingularCoreInstancePool)
 }
 
-func readSingularCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstancePoolDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.GetInstancePoolResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreInstancePoolDataSourceCrud) Get() error {
 		request.InstancePoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -25,10 +26,10 @@ func CoreInstancePoolInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstancePoolInstance,
-		Read:     readCoreInstancePoolInstance,
-		Delete:   deleteCoreInstancePoolInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstancePoolInstance,
+		ReadContext:   readCoreInstancePoolInstance,
+		DeleteContext: deleteCoreInstancePoolInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -131,30 +132,30 @@ func CoreInstancePoolInstanceResource() *schema.Resource {
 	}
 }
 
-func createCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func createCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInstancePoolInstanceResourceCrud struct {
@@ -200,9 +201,10 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 		request.InstancePoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.AttachInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +213,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 	s.Res = &response.InstancePoolInstance
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -233,9 +235,9 @@ func (s *CoreInstancePoolInstanceResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.GetInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,16 +266,16 @@ func (s *CoreInstancePoolInstanceResourceCrud) Delete() error {
 		request.IsAutoTerminate = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DetachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.DetachInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstancePoolInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstancePoolInstances,
+		ReadContext: readCoreInstancePoolInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreInstancePoolInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstancePoolInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePoolInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstancePoolInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.ListInstancePoolInstancesResponse
 }
@@ -75,9 +76,9 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 		request.InstancePoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+	response, err := s.Client.ListInstancePoolInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+		listResponse, err := s.Client.ListInstancePoolInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstancePoolLoadBalancerAttachmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstancePoolLoadBalancerAttachment,
+		ReadContext: readSingularCoreInstancePoolLoadBalancerAttachment,
 		Schema: map[string]*schema.Schema{
 			"instance_pool_id": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func CoreInstancePoolLoadBalancerAttachmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstancePoolLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstancePoolLoadBalancerAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolLoadBalancerAttachmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstancePoolLoadBalancerAttachmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.GetInstancePoolLoadBalancerAttachmentResponse
 }
@@ -81,9 +82,9 @@ func (s *CoreInstancePoolLoadBalancerAttachmentDataSourceCrud) Get() error {
 		request.InstancePoolLoadBalancerAttachmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetInstancePoolLoadBalancerAttachment(context.Background(), request)
+	response, err := s.Client.GetInstancePoolLoadBalancerAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -35,10 +36,10 @@ func CoreInstancePoolResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createCoreInstancePool,
-		Read:   readCoreInstancePool,
-		Update: updateCoreInstancePool,
-		Delete: deleteCoreInstancePool,
+		CreateContext: createCoreInstancePool,
+		ReadContext:   readCoreInstancePool,
+		UpdateContext: updateCoreInstancePool,
+		DeleteContext: deleteCoreInstancePool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -194,37 +195,37 @@ func CoreInstancePoolResource() *schema.Resource {
 	}
 }
 
-func createCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func createCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInstancePoolResourceCrud struct {
@@ -351,16 +352,17 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 		request.Size = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstancePool(context.Background(), request)
+	response, err := s.Client.CreateInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.InstancePool
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -372,17 +374,17 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 	case instancePoolRunningState:
 		startRequest := oci_core.StartInstancePoolRequest{}
 		startRequest.InstancePoolId = instancePoolId
-		startRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		startRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		startResponse, err := s.Client.StartInstancePool(context.Background(), startRequest)
+		startResponse, err := s.Client.StartInstancePool(s.Context(), startRequest)
 
 		return &startResponse.InstancePool, err
 	case instancePoolStoppedState:
 		stopRequest := oci_core.StopInstancePoolRequest{}
 		stopRequest.InstancePoolId = instancePoolId
-		stopRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		stopRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		stopResponse, err := s.Client.StopInstancePool(context.Background(), stopRequest)
+		stopResponse, err := s.Client.StopInstancePool(s.Context(), stopRequest)
 
 		return &stopResponse.InstancePool, err
 	default:
@@ -397,9 +399,9 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstancePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -476,9 +478,9 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 		request.Size = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstancePool(context.Background(), request)
+	response, err := s.Client.UpdateInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +488,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 	s.Res = &response.InstancePool
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -515,9 +517,9 @@ func (s *CoreInstancePoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstancePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstancePool(context.Background(), request)
+	_, err := s.Client.TerminateInstancePool(s.Context(), request)
 	return err
 }
 
@@ -792,14 +794,14 @@ func (s *CoreInstancePoolResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.InstancePoolId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstancePoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstancePoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -854,9 +856,9 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 	if operation == "attach" {
 		attachLoadBalancerRequest := oci_core.AttachLoadBalancerRequest{}
 		attachLoadBalancerRequest.InstancePoolId = &id
-		attachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		attachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		attachLoadBalancerRequest.AttachLoadBalancerDetails = newLoadBalancer
-		_, err := s.Client.AttachLoadBalancer(context.Background(), attachLoadBalancerRequest)
+		_, err := s.Client.AttachLoadBalancer(s.Context(), attachLoadBalancerRequest)
 
 		if err != nil {
 			return err
@@ -872,10 +874,10 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 	if operation == "detach" {
 		detachLoadBalancerRequest := oci_core.DetachLoadBalancerRequest{}
 		detachLoadBalancerRequest.LoadBalancerId = oldLoadbalancer.LoadBalancerId
-		detachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		detachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		detachLoadBalancerRequest.InstancePoolId = &id
 		detachLoadBalancerRequest.BackendSetName = oldLoadbalancer.BackendSetName
-		_, err := s.Client.DetachLoadBalancer(context.Background(), detachLoadBalancerRequest)
+		_, err := s.Client.DetachLoadBalancer(s.Context(), detachLoadBalancerRequest)
 
 		if err != nil {
 			return err
@@ -919,7 +921,7 @@ func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(poolId *stri
 		Refresh: func() (interface{}, string, error) {
 			var err error
 
-			response, err = s.Client.GetInstancePool(context.Background(),
+			response, err = s.Client.GetInstancePool(s.Context(),
 				oci_core.GetInstancePoolRequest{
 					InstancePoolId: poolId,
 				})
@@ -940,7 +942,7 @@ func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(poolId *stri
 		Timeout: s.D.Timeout(schema.TimeoutUpdate),
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(s.Context()); e != nil {
 		return &response.InstancePool, e
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstancePoolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstancePools,
+		ReadContext: readCoreInstancePools,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreInstancePoolsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstancePools(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstancePoolsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeManagementClient
 	Res    *oci_core.ListInstancePoolsResponse
 }
@@ -74,9 +75,9 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.InstancePoolSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInstancePools(context.Background(), request)
+	response, err := s.Client.ListInstancePools(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePools(context.Background(), request)
+		listResponse, err := s.Client.ListInstancePools(s.Context(), request)
 		if err != nil {
 			return err
 		}
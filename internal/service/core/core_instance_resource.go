@@ -18,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -36,10 +37,10 @@ func CoreInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("45m"),
 			Delete: tfresource.GetTimeoutDuration("75m"),
 		},
-		Create: createCoreInstance,
-		Read:   readCoreInstance,
-		Update: updateCoreInstance,
-		Delete: deleteCoreInstance,
+		CreateContext: createCoreInstance,
+		ReadContext:   readCoreInstance,
+		UpdateContext: updateCoreInstance,
+		DeleteContext: deleteCoreInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -662,7 +663,7 @@ func CoreInstanceResource() *schema.Resource {
 	}
 }
 
-func createCoreInstance(d *schema.ResourceData, m interface{}) error {
+func createCoreInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
@@ -678,37 +679,38 @@ func createCoreInstance(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResourceUsingHybridPolling(sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceUsingHybridPollingContext(ctx, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
-	return powerOffIfNeeded(sync.D, sync, powerOff)
+	return tfresource.ToDiagnostics(sync, powerOffIfNeeded(ctx, sync.D, sync, powerOff))
 }
 
-func powerOffIfNeeded(d *schema.ResourceData, sync *CoreInstanceResourceCrud, powerOff bool) error {
+func powerOffIfNeeded(ctx context.Context, d *schema.ResourceData, sync *CoreInstanceResourceCrud, powerOff bool) error {
 
 	if powerOff {
 		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
 			return err
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ReadResourceContext(ctx, sync)
 	}
 	return nil
 }
 
-func readCoreInstance(d *schema.ResourceData, m interface{}) error {
+func readCoreInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.BlockStorageClient = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
@@ -728,24 +730,24 @@ func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
 
 	if powerOn {
 		if err := sync.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_core.InstanceLifecycleStateRunning)
 	}
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	// switch to power off
 	if powerOff {
 		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_core.InstanceLifecycleStateStopped)
 	}
 	return nil
 }
 
-func deleteCoreInstance(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
@@ -754,7 +756,7 @@ func deleteCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInstanceResourceCrud struct {
@@ -871,7 +873,9 @@ func (s *CoreInstanceResourceCrud) Create() error {
 		tmp := dedicatedVmHostId.(string)
 		request.DedicatedVmHostId = &tmp
 		//@codegen: Adding wait to ensure that the DVH is available
-		time.Sleep(1 * time.Minute)
+		if err := tfresource.SleepContext(s.Context(), 1*time.Minute); err != nil {
+			return err
+		}
 	}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -1004,9 +1008,10 @@ func (s *CoreInstanceResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.LaunchInstance(context.Background(), request)
+	response, err := s.Client.LaunchInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1015,7 +1020,7 @@ func (s *CoreInstanceResourceCrud) Create() error {
 
 	s.Res = &response.Instance
 
-	workRequestErr := tfresource.ResourceRefreshForHybridPolling(s.WorkRequestClient, workRequestId, "instance", oci_work_requests.WorkRequestResourceActionTypeCreated, s.DisableNotFoundRetries, s.D, s)
+	workRequestErr := tfresource.ResourceRefreshForHybridPollingContext(s.Context(), s.WorkRequestClient, workRequestId, "instance", oci_work_requests.WorkRequestResourceActionTypeCreated, s.DisableNotFoundRetries, s.D, s)
 	if workRequestErr != nil {
 		return workRequestErr
 	}
@@ -1029,9 +1034,9 @@ func (s *CoreInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1140,9 +1145,9 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		request.Metadata = tfresource.ObjectMapToStringMap(metadata.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstance(context.Background(), request)
+	response, err := s.Client.UpdateInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1178,11 +1183,11 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		VnicId:            vnic.Id,
 		UpdateVnicDetails: updateVnicDetails,
 		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+			RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 		},
 	}
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), vnicOpts)
+	_, err = s.VirtualNetworkClient.UpdateVnic(s.Context(), vnicOpts)
 
 	if err != nil {
 		log.Printf("[ERROR] Primary VNIC could not be updated during instance Update: %q (Instance ID: \"%v\", State: %q)", err, s.Res.Id, s.Res.LifecycleState)
@@ -1199,15 +1204,15 @@ func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceAction
 	tmp := s.D.Id()
 	request.InstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.InstanceAction(context.Background(), request)
+	_, err := s.Client.InstanceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 
 }
 
@@ -1227,9 +1232,9 @@ func (s *CoreInstanceResourceCrud) Delete() error {
 		request.PreserveDataVolumes = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstance(context.Background(), request)
+	_, err := s.Client.TerminateInstance(s.Context(), request)
 	return err
 }
 
@@ -1647,10 +1652,10 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 
 		if assignPublicIpBoolVal {
 
-			listPrivateIpsResponse, err := s.VirtualNetworkClient.ListPrivateIps(context.Background(), oci_core.ListPrivateIpsRequest{
+			listPrivateIpsResponse, err := s.VirtualNetworkClient.ListPrivateIps(s.Context(), oci_core.ListPrivateIpsRequest{
 				VnicId: vnic.Id,
 				RequestMetadata: common.RequestMetadata{
-					RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+					RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 				},
 			})
 
@@ -1660,14 +1665,14 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 
 			for _, privateIp := range listPrivateIpsResponse.Items {
 				if strings.EqualFold(*privateIp.IpAddress, *vnic.PrivateIp) {
-					_, err = s.VirtualNetworkClient.CreatePublicIp(context.Background(), oci_core.CreatePublicIpRequest{
+					_, err = s.VirtualNetworkClient.CreatePublicIp(s.Context(), oci_core.CreatePublicIpRequest{
 						CreatePublicIpDetails: oci_core.CreatePublicIpDetails{
 							CompartmentId: vnic.CompartmentId,
 							Lifetime:      oci_core.CreatePublicIpDetailsLifetimeEphemeral,
 							PrivateIpId:   privateIp.Id,
 						},
 						RequestMetadata: common.RequestMetadata{
-							RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+							RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 						},
 					})
 					return err
@@ -1677,20 +1682,20 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 			return fmt.Errorf("unable to assign Ephemeral public ip for the vnic private ip: %s", *vnic.PrivateIp)
 
 		} else {
-			publicIpByIpAddressResponse, err := s.VirtualNetworkClient.GetPublicIpByIpAddress(context.Background(), oci_core.GetPublicIpByIpAddressRequest{
+			publicIpByIpAddressResponse, err := s.VirtualNetworkClient.GetPublicIpByIpAddress(s.Context(), oci_core.GetPublicIpByIpAddressRequest{
 				GetPublicIpByIpAddressDetails: oci_core.GetPublicIpByIpAddressDetails{
 					IpAddress: vnic.PublicIp,
 				},
 				RequestMetadata: common.RequestMetadata{
-					RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+					RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 				},
 			})
 
 			if err == nil {
-				_, err = s.VirtualNetworkClient.DeletePublicIp(context.Background(), oci_core.DeletePublicIpRequest{
+				_, err = s.VirtualNetworkClient.DeletePublicIp(s.Context(), oci_core.DeletePublicIpRequest{
 					PublicIpId: publicIpByIpAddressResponse.Id,
 					RequestMetadata: common.RequestMetadata{
-						RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+						RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 					},
 				})
 
@@ -2247,13 +2252,13 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 		CompartmentId: s.Res.CompartmentId,
 		InstanceId:    s.Res.Id,
 		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+			RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 		},
 	}
 	var attachments []oci_core.VnicAttachment
 
 	for {
-		result, err := s.Client.ListVnicAttachments(context.Background(), request)
+		result, err := s.Client.ListVnicAttachments(s.Context(), request)
 		if err != nil {
 			return nil, err
 		}
@@ -2275,10 +2280,10 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 			request := oci_core.GetVnicRequest{
 				VnicId: attachment.VnicId,
 				RequestMetadata: common.RequestMetadata{
-					RetryPolicy: tfresource.GetRetryPolicy(true, "core"),
+					RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), true, "core"),
 				},
 			}
-			response, _ := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+			response, _ := s.VirtualNetworkClient.GetVnic(s.Context(), request)
 			vnic := &response.Vnic
 
 			// Ignore errors on GetVnic, since we might not have permissions to view some secondary VNICs.
@@ -2297,11 +2302,11 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 		CompartmentId:      s.Res.CompartmentId,
 		InstanceId:         s.Res.Id,
 		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+			RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 		},
 	}
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -2318,10 +2323,10 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 	bootVolumeRequest := oci_core.GetBootVolumeRequest{
 		BootVolumeId: bootVolumeId,
 		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+			RetryPolicy: tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core"),
 		},
 	}
-	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(context.Background(), bootVolumeRequest)
+	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(s.Context(), bootVolumeRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -2410,9 +2415,9 @@ func (s *CoreInstanceResourceCrud) updateCompartment(compartment interface{}) er
 	idTmp := s.D.Id()
 	changeCompartmentRequest.InstanceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -2445,21 +2450,21 @@ func (s *CoreInstanceResourceCrud) updateBootVolumeSizeInGbs(bootVolumeSizeInGBs
 	bootVolumeSizeInGBsTmp := bootVolumeSizeInGBs.(int64)
 	changeBootVolumeDetailsRequest.SizeInGBs = &bootVolumeSizeInGBsTmp
 
-	changeBootVolumeDetailsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeBootVolumeDetailsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.BlockStorageClient.UpdateBootVolume(context.Background(), changeBootVolumeDetailsRequest)
+	response, err := s.BlockStorageClient.UpdateBootVolume(s.Context(), changeBootVolumeDetailsRequest)
 	if err != nil {
 		return err
 	}
 
-	_, err = waitForBootVolumeIfItIsUpdating(response.Id, s.BlockStorageClient, s.D.Timeout(schema.TimeoutUpdate))
+	_, err = waitForBootVolumeIfItIsUpdating(s.Context(), response.Id, s.BlockStorageClient, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForBootVolumeIfItIsUpdating(bootVolumeID *string, client *oci_core.BlockstorageClient, timeout time.Duration) (*oci_core.GetBootVolumeResponse, error) {
+func waitForBootVolumeIfItIsUpdating(ctx context.Context, bootVolumeID *string, client *oci_core.BlockstorageClient, timeout time.Duration) (*oci_core.GetBootVolumeResponse, error) {
 	getBootVolumeRequest := oci_core.GetBootVolumeRequest{}
 
 	getBootVolumeRequest.BootVolumeId = bootVolumeID
@@ -2474,7 +2479,7 @@ func waitForBootVolumeIfItIsUpdating(bootVolumeID *string, client *oci_core.Bloc
 	}
 
 	getBootVolumeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyWithAdditionalRetryCondition(timeout, bootVolumeUpdating, "core")
-	getBootVolumeResponse, err := client.GetBootVolume(context.Background(), getBootVolumeRequest)
+	getBootVolumeResponse, err := client.GetBootVolume(ctx, getBootVolumeRequest)
 	return &getBootVolumeResponse, err
 }
 
@@ -2536,14 +2541,14 @@ func (s *CoreInstanceResourceCrud) updateOptionsViaWorkRequest() error {
 	idTmp := s.D.Id()
 	request.InstanceId = &idTmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstance(context.Background(), request)
+	response, err := s.Client.UpdateInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "instance", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "instance", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
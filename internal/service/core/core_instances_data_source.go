@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstances,
+		ReadContext: readCoreInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -39,6 +40,8 @@ func CoreInstancesDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"max_results": tfresource.DataSourceMaxResultsSchema(),
+			"page_size":   tfresource.DataSourcePageSizeSchema(),
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -48,18 +51,20 @@ func CoreInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInstancesDataSourceCrud struct {
 	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListInstancesResponse
+	// The instances of Res converted by ListDataSourceItems, which SetData does not need to convert again
+	instances []map[string]interface{}
 }
 
 func (s *CoreInstancesDataSourceCrud) VoidState() {
@@ -93,24 +98,35 @@ func (s *CoreInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.InstanceLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInstances(context.Background(), request)
+	listPage := func(ctx context.Context, page *string, limit *int) ([]interface{}, *string, error) {
+		pageRequest := request
+		pageRequest.Page = page
+		pageRequest.Limit = limit
+		listResponse, err := s.Client.ListInstances(ctx, pageRequest)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := make([]interface{}, 0, len(listResponse.Items))
+		for _, item := range listResponse.Items {
+			items = append(items, item)
+		}
+		return items, listResponse.OpcNextPage, nil
+	}
+	toMap := func(item interface{}) map[string]interface{} {
+		return instanceSummaryToMap(item.(oci_core.Instance))
+	}
+
+	items, instances, err := tfresource.ListDataSourceItems(s.Context(), s.D, CoreInstancesDataSource().Schema["instances"].Elem.(*schema.Resource).Schema, listPage, toMap)
 	if err != nil {
 		return err
 	}
+	s.instances = instances
 
-	s.Res = &response
-	request.Page = s.Res.OpcNextPage
-
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstances(context.Background(), request)
-		if err != nil {
-			return err
-		}
-
-		s.Res.Items = append(s.Res.Items, listResponse.Items...)
-		request.Page = listResponse.OpcNextPage
+	s.Res = &oci_core.ListInstancesResponse{}
+	for _, item := range items {
+		s.Res.Items = append(s.Res.Items, item.(oci_core.Instance))
 	}
 
 	return nil
@@ -122,151 +138,153 @@ func (s *CoreInstancesDataSourceCrud) SetData() error {
 	}
 
 	s.D.SetId(tfresource.GenerateDataSourceHashID("CoreInstancesDataSource-", CoreInstancesDataSource(), s.D))
-	resources := []map[string]interface{}{}
-
-	for _, r := range s.Res.Items {
-		instance := map[string]interface{}{
-			"compartment_id": *r.CompartmentId,
+	resources := s.instances
+	if resources == nil {
+		resources = []map[string]interface{}{}
+		for _, r := range s.Res.Items {
+			resources = append(resources, instanceSummaryToMap(r))
 		}
+	}
 
-		if r.AgentConfig != nil {
-			instance["agent_config"] = []interface{}{InstanceAgentConfigToMap(r.AgentConfig)}
-		} else {
-			instance["agent_config"] = nil
-		}
+	if err := s.D.Set("instances", resources); err != nil {
+		return err
+	}
 
-		if r.AvailabilityConfig != nil {
-			instance["availability_config"] = []interface{}{InstanceAvailabilityConfigToMap(r.AvailabilityConfig)}
-		} else {
-			instance["availability_config"] = nil
-		}
+	return nil
+}
 
-		if r.AvailabilityDomain != nil {
-			instance["availability_domain"] = *r.AvailabilityDomain
-		}
+func instanceSummaryToMap(r oci_core.Instance) map[string]interface{} {
+	instance := map[string]interface{}{
+		"compartment_id": *r.CompartmentId,
+	}
 
-		if r.CapacityReservationId != nil {
-			instance["capacity_reservation_id"] = *r.CapacityReservationId
-		}
+	if r.AgentConfig != nil {
+		instance["agent_config"] = []interface{}{InstanceAgentConfigToMap(r.AgentConfig)}
+	} else {
+		instance["agent_config"] = nil
+	}
 
-		if r.DedicatedVmHostId != nil {
-			instance["dedicated_vm_host_id"] = *r.DedicatedVmHostId
-		}
+	if r.AvailabilityConfig != nil {
+		instance["availability_config"] = []interface{}{InstanceAvailabilityConfigToMap(r.AvailabilityConfig)}
+	} else {
+		instance["availability_config"] = nil
+	}
 
-		if r.DefinedTags != nil {
-			instance["defined_tags"] = tfresource.DefinedTagsToMap(r.DefinedTags)
-		}
+	if r.AvailabilityDomain != nil {
+		instance["availability_domain"] = *r.AvailabilityDomain
+	}
 
-		if r.DisplayName != nil {
-			instance["display_name"] = *r.DisplayName
-		}
+	if r.CapacityReservationId != nil {
+		instance["capacity_reservation_id"] = *r.CapacityReservationId
+	}
 
-		if r.ExtendedMetadata != nil {
-			instance["extended_metadata"] = convertNestedMapToFlatMap(r.ExtendedMetadata)
-		}
+	if r.DedicatedVmHostId != nil {
+		instance["dedicated_vm_host_id"] = *r.DedicatedVmHostId
+	}
 
-		if r.FaultDomain != nil {
-			instance["fault_domain"] = *r.FaultDomain
-		}
+	if r.DefinedTags != nil {
+		instance["defined_tags"] = tfresource.DefinedTagsToMap(r.DefinedTags)
+	}
 
-		instance["freeform_tags"] = r.FreeformTags
+	if r.DisplayName != nil {
+		instance["display_name"] = *r.DisplayName
+	}
 
-		if r.Id != nil {
-			instance["id"] = *r.Id
-		}
+	if r.ExtendedMetadata != nil {
+		instance["extended_metadata"] = convertNestedMapToFlatMap(r.ExtendedMetadata)
+	}
 
-		if r.ImageId != nil {
-			instance["image"] = *r.ImageId
-		}
+	if r.FaultDomain != nil {
+		instance["fault_domain"] = *r.FaultDomain
+	}
 
-		if r.InstanceOptions != nil {
-			instance["instance_options"] = []interface{}{InstanceOptionsToMap(r.InstanceOptions)}
-		} else {
-			instance["instance_options"] = nil
-		}
+	instance["freeform_tags"] = r.FreeformTags
 
-		if r.IpxeScript != nil {
-			instance["ipxe_script"] = *r.IpxeScript
-		}
+	if r.Id != nil {
+		instance["id"] = *r.Id
+	}
 
-		instance["launch_mode"] = r.LaunchMode
+	if r.ImageId != nil {
+		instance["image"] = *r.ImageId
+	}
 
-		if r.LaunchOptions != nil {
-			instance["launch_options"] = []interface{}{LaunchOptionsToMap(r.LaunchOptions)}
-		} else {
-			instance["launch_options"] = nil
-		}
+	if r.InstanceOptions != nil {
+		instance["instance_options"] = []interface{}{InstanceOptionsToMap(r.InstanceOptions)}
+	} else {
+		instance["instance_options"] = nil
+	}
 
-		if r.Metadata != nil {
-			instance["metadata"] = r.Metadata
-		}
+	if r.IpxeScript != nil {
+		instance["ipxe_script"] = *r.IpxeScript
+	}
 
-		if r.PlatformConfig != nil {
-			platformConfigArray := []interface{}{}
-			if platformConfigMap := PlatformConfigToMap(&r.PlatformConfig); platformConfigMap != nil {
-				platformConfigArray = append(platformConfigArray, platformConfigMap)
-			}
-			instance["platform_config"] = platformConfigArray
-		} else {
-			instance["platform_config"] = nil
-		}
+	instance["launch_mode"] = r.LaunchMode
 
-		if r.PreemptibleInstanceConfig != nil {
-			instance["preemptible_instance_config"] = []interface{}{PreemptibleInstanceConfigDetailsToMap(r.PreemptibleInstanceConfig)}
-		} else {
-			instance["preemptible_instance_config"] = nil
-		}
+	if r.LaunchOptions != nil {
+		instance["launch_options"] = []interface{}{LaunchOptionsToMap(r.LaunchOptions)}
+	} else {
+		instance["launch_options"] = nil
+	}
 
-		if r.Region != nil {
-			instance["region"] = *r.Region
-		}
+	if r.Metadata != nil {
+		instance["metadata"] = r.Metadata
+	}
 
-		if r.Shape != nil {
-			instance["shape"] = *r.Shape
+	if r.PlatformConfig != nil {
+		platformConfigArray := []interface{}{}
+		if platformConfigMap := PlatformConfigToMap(&r.PlatformConfig); platformConfigMap != nil {
+			platformConfigArray = append(platformConfigArray, platformConfigMap)
 		}
+		instance["platform_config"] = platformConfigArray
+	} else {
+		instance["platform_config"] = nil
+	}
 
-		if r.ShapeConfig != nil {
-			instance["shape_config"] = []interface{}{InstanceShapeConfigToMap(r.ShapeConfig)}
-		} else {
-			instance["shape_config"] = nil
-		}
+	if r.PreemptibleInstanceConfig != nil {
+		instance["preemptible_instance_config"] = []interface{}{PreemptibleInstanceConfigDetailsToMap(r.PreemptibleInstanceConfig)}
+	} else {
+		instance["preemptible_instance_config"] = nil
+	}
 
-		if r.SourceDetails != nil {
-			sourceDetailsArray := []interface{}{}
-			if sourceDetailsMap := InstanceSourceDetailsToMap(&r.SourceDetails, nil, nil); sourceDetailsMap != nil {
-				sourceDetailsArray = append(sourceDetailsArray, sourceDetailsMap)
-			}
-			instance["source_details"] = sourceDetailsArray
-		} else {
-			instance["source_details"] = nil
-		}
+	if r.Region != nil {
+		instance["region"] = *r.Region
+	}
 
-		instance["state"] = r.LifecycleState
+	if r.Shape != nil {
+		instance["shape"] = *r.Shape
+	}
 
-		if r.SystemTags != nil {
-			instance["system_tags"] = tfresource.SystemTagsToMap(r.SystemTags)
-		}
+	if r.ShapeConfig != nil {
+		instance["shape_config"] = []interface{}{InstanceShapeConfigToMap(r.ShapeConfig)}
+	} else {
+		instance["shape_config"] = nil
+	}
 
-		if r.TimeCreated != nil {
-			instance["time_created"] = r.TimeCreated.String()
+	if r.SourceDetails != nil {
+		sourceDetailsArray := []interface{}{}
+		if sourceDetailsMap := InstanceSourceDetailsToMap(&r.SourceDetails, nil, nil); sourceDetailsMap != nil {
+			sourceDetailsArray = append(sourceDetailsArray, sourceDetailsMap)
 		}
+		instance["source_details"] = sourceDetailsArray
+	} else {
+		instance["source_details"] = nil
+	}
 
-		if r.TimeMaintenanceRebootDue != nil {
-			instance["time_maintenance_reboot_due"] = r.TimeMaintenanceRebootDue.String()
-		}
+	instance["state"] = r.LifecycleState
 
-		resources = append(resources, instance)
+	if r.SystemTags != nil {
+		instance["system_tags"] = tfresource.SystemTagsToMap(r.SystemTags)
 	}
 
-	if f, fOk := s.D.GetOkExists("filter"); fOk {
-		resources = tfresource.ApplyFilters(f.(*schema.Set), resources, CoreInstancesDataSource().Schema["instances"].Elem.(*schema.Resource).Schema)
+	if r.TimeCreated != nil {
+		instance["time_created"] = r.TimeCreated.String()
 	}
 
-	if err := s.D.Set("instances", resources); err != nil {
-		return err
+	if r.TimeMaintenanceRebootDue != nil {
+		instance["time_maintenance_reboot_due"] = r.TimeMaintenanceRebootDue.String()
 	}
 
-	return nil
+	return instance
 }
 
 func convertNestedMapToFlatMap(m map[string]interface{}) map[string]string {
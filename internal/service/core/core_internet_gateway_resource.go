@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreInternetGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInternetGateway,
-		Read:     readCoreInternetGateway,
-		Update:   updateCoreInternetGateway,
-		Delete:   deleteCoreInternetGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInternetGateway,
+		ReadContext:   readCoreInternetGateway,
+		UpdateContext: updateCoreInternetGateway,
+		DeleteContext: deleteCoreInternetGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -74,37 +75,37 @@ func CoreInternetGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreInternetGatewayResourceCrud struct {
@@ -177,9 +178,10 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInternetGateway(context.Background(), request)
+	response, err := s.Client.CreateInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,9 +196,9 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInternetGateway(context.Background(), request)
+	response, err := s.Client.GetInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,9 +244,9 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
+	response, err := s.Client.UpdateInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,9 +261,9 @@ func (s *CoreInternetGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
+	_, err := s.Client.DeleteInternetGateway(s.Context(), request)
 	return err
 }
 
@@ -306,14 +308,14 @@ func (s *CoreInternetGatewayResourceCrud) updateCompartment(compartment interfac
 	idTmp := s.D.Id()
 	changeCompartmentRequest.IgId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInternetGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInternetGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInternetGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInternetGateways,
+		ReadContext: readCoreInternetGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func CoreInternetGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInternetGateways(d *schema.ResourceData, m interface{}) error {
+func readCoreInternetGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreInternetGatewaysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListInternetGatewaysResponse
 }
@@ -83,9 +84,9 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListInternetGateways(context.Background(), request)
+	response, err := s.Client.ListInternetGateways(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInternetGateways(context.Background(), request)
+		listResponse, err := s.Client.ListInternetGateways(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpsecAlgorithmDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpsecAlgorithm,
+		ReadContext: readSingularCoreIpsecAlgorithm,
 		Schema: map[string]*schema.Schema{
 			// Computed
 			"allowed_phase_one_parameters": {
@@ -155,16 +156,16 @@ func CoreIpsecAlgorithmDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpsecAlgorithm(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpsecAlgorithm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecAlgorithmDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpsecAlgorithmDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetAllowedIkeIPSecParametersResponse
 }
@@ -176,9 +177,9 @@ func (s *CoreIpsecAlgorithmDataSourceCrud) VoidState() {
 func (s *CoreIpsecAlgorithmDataSourceCrud) Get() error {
 	request := oci_core.GetAllowedIkeIPSecParametersRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetAllowedIkeIPSecParameters(context.Background(), request)
+	response, err := s.Client.GetAllowedIkeIPSecParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionDeviceConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionDeviceConfig,
+		ReadContext: readSingularCoreIpSecConnectionDeviceConfig,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -60,16 +61,16 @@ func CoreIpSecConnectionDeviceConfigDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionDeviceConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionDeviceConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionDeviceConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpSecConnectionDeviceConfigDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetIPSecConnectionDeviceConfigResponse
 }
@@ -86,9 +87,9 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
 		request.IpscId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceConfig(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
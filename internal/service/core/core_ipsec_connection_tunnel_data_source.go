@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionTunnelDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionTunnel,
+		ReadContext: readSingularCoreIpSecConnectionTunnel,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -130,15 +131,15 @@ func CoreIpSecConnectionTunnelDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionTunnel(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionTunnel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpSecConnectionTunnelDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetIPSecConnectionTunnelResponse
 }
@@ -160,9 +161,9 @@ func (s *CoreIpSecConnectionTunnelDataSourceCrud) Get() error {
 		request.TunnelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnel(s.Context(), request)
 	if err != nil {
 		return err
 	}
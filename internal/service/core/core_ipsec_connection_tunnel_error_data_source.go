@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpsecConnectionTunnelErrorDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpsecConnectionTunnelError,
+		ReadContext: readSingularCoreIpsecConnectionTunnelError,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func CoreIpsecConnectionTunnelErrorDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpsecConnectionTunnelError(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpsecConnectionTunnelError(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecConnectionTunnelErrorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpsecConnectionTunnelErrorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetIPSecConnectionTunnelErrorResponse
 }
@@ -81,9 +82,9 @@ func (s *CoreIpsecConnectionTunnelErrorDataSourceCrud) Get() error {
 		request.TunnelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnelError(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnelError(s.Context(), request)
 	if err != nil {
 		return err
 	}
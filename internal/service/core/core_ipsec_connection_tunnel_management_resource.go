@@ -12,17 +12,18 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
 func CoreIpSecConnectionTunnelManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpSecConnectionTunnelManagement,
-		Read:     readCoreIpSecConnectionTunnelManagement,
-		Update:   updateCoreIpSecConnectionTunnelManagement,
-		Delete:   deleteCoreIpSecConnectionTunnelManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpSecConnectionTunnelManagement,
+		ReadContext:   readCoreIpSecConnectionTunnelManagement,
+		UpdateContext: updateCoreIpSecConnectionTunnelManagement,
+		DeleteContext: deleteCoreIpSecConnectionTunnelManagement,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -296,34 +297,34 @@ func CoreIpSecConnectionTunnelManagementResource() *schema.Resource {
 	}
 }
 
-func createCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func createCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreIpSecConnectionTunnelManagementResourceCrud struct {
@@ -379,9 +380,9 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 		request.TunnelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -394,7 +395,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 
 	secretRequest.TunnelId = request.TunnelId
 
-	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(context.Background(), secretRequest)
+	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(s.Context(), secretRequest)
 	if err != nil {
 		return err
 	}
@@ -420,13 +421,13 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 				tmp := tunnelId.(string)
 				secretUpdateRequest.TunnelId = &tmp
 			}
-			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(context.Background(), secretUpdateRequest)
+			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(s.Context(), secretUpdateRequest)
 			if err != nil {
 				return err
 			}
 
 			retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_core.IpSecConnectionTunnelLifecycleStateAvailable }
-			if conditionErr := tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); conditionErr != nil {
+			if conditionErr := tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); conditionErr != nil {
 				return conditionErr
 			}
 		}
@@ -594,8 +595,8 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateIPSecConnectionTunnel(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	response, err := s.Client.UpdateIPSecConnectionTunnel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreIpsecConnectionTunnelRoutesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpsecConnectionTunnelRoutes,
+		ReadContext: readCoreIpsecConnectionTunnelRoutes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"advertiser": {
@@ -71,16 +72,16 @@ func CoreIpsecConnectionTunnelRoutesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpsecConnectionTunnelRoutes(d *schema.ResourceData, m interface{}) error {
+func readCoreIpsecConnectionTunnelRoutes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecConnectionTunnelRoutesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpsecConnectionTunnelRoutesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListIPSecConnectionTunnelRoutesResponse
 }
@@ -106,9 +107,9 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 		request.TunnelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelRoutes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +118,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(s.Context(), request)
 		if err != nil {
 			return err
 		}
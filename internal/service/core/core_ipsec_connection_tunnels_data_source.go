@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreIpSecConnectionTunnelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpSecConnectionTunnels,
+		ReadContext: readCoreIpSecConnectionTunnels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -290,16 +291,16 @@ func CoreIpSecConnectionTunnelsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpSecConnectionTunnels(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnectionTunnels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpSecConnectionTunnelsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListIPSecConnectionTunnelsResponse
 }
@@ -316,9 +317,9 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 		request.IpscId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnels(s.Context(), request)
 		if err != nil {
 			return err
 		}
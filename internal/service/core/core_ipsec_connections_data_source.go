@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpSecConnections,
+		ReadContext: readCoreIpSecConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func CoreIpSecConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpSecConnections(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpSecConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListIPSecConnectionsResponse
 }
@@ -75,9 +76,9 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 		request.DrgId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListIPSecConnections(context.Background(), request)
+	response, err := s.Client.ListIPSecConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnections(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreIpSecConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpSecConnection,
-		Read:     readCoreIpSecConnection,
-		Update:   updateCoreIpSecConnection,
-		Delete:   deleteCoreIpSecConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpSecConnection,
+		ReadContext:   readCoreIpSecConnection,
+		UpdateContext: updateCoreIpSecConnection,
+		DeleteContext: deleteCoreIpSecConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -91,37 +92,37 @@ func CoreIpSecConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreIpSecConnectionResourceCrud struct {
@@ -227,9 +228,10 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 		request.StaticRoutes = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIPSecConnection(context.Background(), request)
+	response, err := s.Client.CreateIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,9 +246,9 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIPSecConnection(context.Background(), request)
+	response, err := s.Client.GetIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -308,9 +310,9 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 		request.StaticRoutes = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -325,9 +327,9 @@ func (s *CoreIpSecConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
+	_, err := s.Client.DeleteIPSecConnection(s.Context(), request)
 	return err
 }
 
@@ -380,14 +382,14 @@ func (s *CoreIpSecConnectionResourceCrud) updateCompartment(compartment interfac
 	tmp := s.D.Id()
 	changeCompartmentRequest.IpscId = &tmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeIPSecConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeIPSecConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
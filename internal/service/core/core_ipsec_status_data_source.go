@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionDeviceStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionDeviceStatus,
+		ReadContext: readSingularCoreIpSecConnectionDeviceStatus,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -64,16 +65,16 @@ func CoreIpSecConnectionDeviceStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionDeviceStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionDeviceStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionDeviceStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpSecConnectionDeviceStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetIPSecConnectionDeviceStatusResponse
 }
@@ -90,9 +91,9 @@ func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get() error {
 		request.IpscId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceStatus(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
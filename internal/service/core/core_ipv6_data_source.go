@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreIpv6DataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreIpv6Resource(), fieldMap, readSingularCoreIpv6)
 }
 
-func readSingularCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6DataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreIpv6DataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetIpv6Response
 }
@@ -48,9 +49,9 @@ func (s *CoreIpv6DataSourceCrud) Get() error {
 		request.Ipv6Id = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetIpv6(context.Background(), request)
+	response, err := s.Client.GetIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreIpv6Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpv6,
-		Read:     readCoreIpv6,
-		Update:   updateCoreIpv6,
-		Delete:   deleteCoreIpv6,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpv6,
+		ReadContext:   readCoreIpv6,
+		UpdateContext: updateCoreIpv6,
+		DeleteContext: deleteCoreIpv6,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"vnic_id": {
@@ -78,37 +79,37 @@ func CoreIpv6Resource() *schema.Resource {
 	}
 }
 
-func createCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func createCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func readCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreIpv6ResourceCrud struct {
@@ -176,9 +177,10 @@ func (s *CoreIpv6ResourceCrud) Create() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIpv6(context.Background(), request)
+	response, err := s.Client.CreateIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -193,9 +195,9 @@ func (s *CoreIpv6ResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.Ipv6Id = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIpv6(context.Background(), request)
+	response, err := s.Client.GetIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -232,9 +234,9 @@ func (s *CoreIpv6ResourceCrud) Update() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIpv6(context.Background(), request)
+	response, err := s.Client.UpdateIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,9 +251,9 @@ func (s *CoreIpv6ResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.Ipv6Id = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIpv6(context.Background(), request)
+	_, err := s.Client.DeleteIpv6(s.Context(), request)
 	return err
 }
 
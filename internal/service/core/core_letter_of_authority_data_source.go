@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreLetterOfAuthorityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreLetterOfAuthority,
+		ReadContext: readSingularCoreLetterOfAuthority,
 		Schema: map[string]*schema.Schema{
 			"cross_connect_id": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func CoreLetterOfAuthorityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreLetterOfAuthority(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreLetterOfAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLetterOfAuthorityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreLetterOfAuthorityDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetCrossConnectLetterOfAuthorityResponse
 }
@@ -76,9 +77,9 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
 		request.CrossConnectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetCrossConnectLetterOfAuthority(context.Background(), request)
+	response, err := s.Client.GetCrossConnectLetterOfAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
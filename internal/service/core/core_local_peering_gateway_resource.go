@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreLocalPeeringGateway,
-		Read:     readCoreLocalPeeringGateway,
-		Update:   updateCoreLocalPeeringGateway,
-		Delete:   deleteCoreLocalPeeringGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreLocalPeeringGateway,
+		ReadContext:   readCoreLocalPeeringGateway,
+		UpdateContext: updateCoreLocalPeeringGateway,
+		DeleteContext: deleteCoreLocalPeeringGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -109,42 +110,43 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectLocalPeeringGateway()
+	return tfresource.ToDiagnostics(sync, sync.ConnectLocalPeeringGateway())
 }
 
-func readCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreLocalPeeringGatewayResourceCrud struct {
@@ -195,9 +197,9 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		connectRequest.LocalPeeringGatewayId = s.Res.Id
 
-		connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.ConnectLocalPeeringGateways(context.Background(), connectRequest)
+		_, err := s.Client.ConnectLocalPeeringGateways(s.Context(), connectRequest)
 		if err != nil {
 			// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 			s.D.Set("peer_id", "")
@@ -211,7 +213,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		request.RequestMetadata.RetryPolicy = getLocalPeeringGatewayRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-		response, getError := s.Client.GetLocalPeeringGateway(context.Background(), request)
+		response, getError := s.Client.GetLocalPeeringGateway(s.Context(), request)
 		if getError != nil {
 			log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 			return getError
@@ -264,9 +266,10 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.CreateLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,9 +284,9 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.LocalPeeringGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.GetLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,9 +332,9 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.UpdateLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -346,9 +349,9 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LocalPeeringGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
+	_, err := s.Client.DeleteLocalPeeringGateway(s.Context(), request)
 	return err
 }
 
@@ -413,14 +416,14 @@ func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.LocalPeeringGatewayId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreLocalPeeringGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreLocalPeeringGateways,
+		ReadContext: readCoreLocalPeeringGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func CoreLocalPeeringGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readCoreLocalPeeringGateways(d *schema.ResourceData, m interface{}) error {
+func readCoreLocalPeeringGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreLocalPeeringGatewaysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListLocalPeeringGatewaysResponse
 }
@@ -66,9 +67,9 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+	response, err := s.Client.ListLocalPeeringGateways(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+		listResponse, err := s.Client.ListLocalPeeringGateways(s.Context(), request)
 		if err != nil {
 			return err
 		}
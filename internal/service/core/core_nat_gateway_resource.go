@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreNatGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNatGateway,
-		Read:     readCoreNatGateway,
-		Update:   updateCoreNatGateway,
-		Delete:   deleteCoreNatGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNatGateway,
+		ReadContext:   readCoreNatGateway,
+		UpdateContext: updateCoreNatGateway,
+		DeleteContext: deleteCoreNatGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -84,37 +85,37 @@ func CoreNatGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreNatGatewayResourceCrud struct {
@@ -192,9 +193,10 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNatGateway(context.Background(), request)
+	response, err := s.Client.CreateNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,9 +211,9 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,9 +259,9 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNatGateway(context.Background(), request)
+	response, err := s.Client.UpdateNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,9 +276,9 @@ func (s *CoreNatGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNatGateway(context.Background(), request)
+	_, err := s.Client.DeleteNatGateway(s.Context(), request)
 	return err
 }
 
@@ -329,14 +331,14 @@ func (s *CoreNatGatewayResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.NatGatewayId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNatGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNatGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreNetworkSecurityGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNetworkSecurityGroup,
-		Read:     readCoreNetworkSecurityGroup,
-		Update:   updateCoreNetworkSecurityGroup,
-		Delete:   deleteCoreNetworkSecurityGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNetworkSecurityGroup,
+		ReadContext:   readCoreNetworkSecurityGroup,
+		UpdateContext: updateCoreNetworkSecurityGroup,
+		DeleteContext: deleteCoreNetworkSecurityGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -69,37 +70,37 @@ func CoreNetworkSecurityGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreNetworkSecurityGroupResourceCrud struct {
@@ -167,9 +168,10 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.CreateNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,9 +186,9 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NetworkSecurityGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.GetNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -227,9 +229,9 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NetworkSecurityGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,9 +246,9 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkSecurityGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNetworkSecurityGroup(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSecurityGroup(s.Context(), request)
 	return err
 }
 
@@ -287,14 +289,14 @@ func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(compartment int
 	idTmp := s.D.Id()
 	changeCompartmentRequest.NetworkSecurityGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
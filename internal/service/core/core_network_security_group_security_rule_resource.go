@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNetworkSecurityGroupSecurityRule,
-		Read:     readCoreNetworkSecurityGroupSecurityRule,
-		Update:   updateCoreNetworkSecurityGroupSecurityRule,
-		Delete:   deleteCoreNetworkSecurityGroupSecurityRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNetworkSecurityGroupSecurityRule,
+		ReadContext:   readCoreNetworkSecurityGroupSecurityRule,
+		UpdateContext: updateCoreNetworkSecurityGroupSecurityRule,
+		DeleteContext: deleteCoreNetworkSecurityGroupSecurityRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"network_security_group_id": {
@@ -239,32 +240,32 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 	}
 }
 
-func createCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func createCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func updateCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreSecurityRuleResourceCrud struct {
@@ -362,9 +363,9 @@ func (s *CoreSecurityRuleResourceCrud) Create() error {
 	tmp := []oci_core.AddSecurityRuleDetails{addSecurityRuleDetails}
 	request.SecurityRules = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -396,9 +397,9 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -407,7 +408,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -514,8 +515,8 @@ func (s *CoreSecurityRuleResourceCrud) Update() error {
 	tmp := []oci_core.UpdateSecurityRuleDetails{updateSecurityRuleDetails}
 	request.SecurityRules = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to update security rules, error: %v", err)
 	}
@@ -537,8 +538,8 @@ func (s *CoreSecurityRuleResourceCrud) Delete() error {
 	tmp := []string{s.D.Id()}
 	request.SecurityRuleIds = tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
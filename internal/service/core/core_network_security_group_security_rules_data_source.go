@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupSecurityRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroupSecurityRules,
+		ReadContext: readCoreNetworkSecurityGroupSecurityRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"direction": {
@@ -218,16 +219,16 @@ func CoreNetworkSecurityGroupSecurityRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroupSecurityRules(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupSecurityRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupSecurityRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupSecurityRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListNetworkSecurityGroupSecurityRulesResponse
 }
@@ -248,9 +249,9 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 		request.NetworkSecurityGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +260,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
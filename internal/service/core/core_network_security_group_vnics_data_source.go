@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupVnicsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroupVnics,
+		ReadContext: readCoreNetworkSecurityGroupVnics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_security_group_id": {
@@ -51,16 +52,16 @@ func CoreNetworkSecurityGroupVnicsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroupVnics(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupVnics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupVnicsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupVnicsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListNetworkSecurityGroupVnicsResponse
 }
@@ -77,9 +78,9 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 		request.NetworkSecurityGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupVnics(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroups,
+		ReadContext: readCoreNetworkSecurityGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func CoreNetworkSecurityGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroups(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListNetworkSecurityGroupsResponse
 }
@@ -92,9 +93,9 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 		request.VlanId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
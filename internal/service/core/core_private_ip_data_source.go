@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CorePrivateIpDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CorePrivateIpResource(), fieldMap, readSingularCorePrivateIp)
 }
 
-func readSingularCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func readSingularCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CorePrivateIpDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetPrivateIpResponse
 }
@@ -48,9 +49,9 @@ func (s *CorePrivateIpDataSourceCrud) Get() error {
 		request.PrivateIpId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
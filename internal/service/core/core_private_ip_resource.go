@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePrivateIpResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePrivateIp,
-		Read:     readCorePrivateIp,
-		Update:   updateCorePrivateIp,
-		Delete:   deleteCorePrivateIp,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePrivateIp,
+		ReadContext:   readCorePrivateIp,
+		UpdateContext: updateCorePrivateIp,
+		DeleteContext: deleteCorePrivateIp,
 		Schema: map[string]*schema.Schema{
 			// Required
 
@@ -99,37 +100,37 @@ func CorePrivateIpResource() *schema.Resource {
 	}
 }
 
-func createCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func createCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func readCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func updateCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func deleteCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CorePrivateIpResourceCrud struct {
@@ -183,9 +184,10 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePrivateIp(context.Background(), request)
+	response, err := s.Client.CreatePrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,9 +202,9 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PrivateIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,9 +246,9 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
+	response, err := s.Client.UpdatePrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -261,9 +263,9 @@ func (s *CorePrivateIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePrivateIp(context.Background(), request)
+	_, err := s.Client.DeletePrivateIp(s.Context(), request)
 	return err
 }
 
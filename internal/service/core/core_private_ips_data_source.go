@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePrivateIpsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePrivateIps,
+		ReadContext: readCorePrivateIps,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ip_address": {
@@ -43,16 +44,16 @@ func CorePrivateIpsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePrivateIps(d *schema.ResourceData, m interface{}) error {
+func readCorePrivateIps(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CorePrivateIpsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListPrivateIpsResponse
 }
@@ -84,9 +85,9 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListPrivateIps(context.Background(), request)
+	response, err := s.Client.ListPrivateIps(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateIps(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateIps(s.Context(), request)
 		if err != nil {
 			return err
 		}
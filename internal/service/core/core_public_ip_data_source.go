@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,8 +17,8 @@ import (
 
 func CorePublicIpDataSource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Read:     readSingularCorePublicIp,
+		Timeouts:    tfresource.DefaultTimeout,
+		ReadContext: readSingularCorePublicIp,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -92,16 +93,16 @@ func CorePublicIpDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func readSingularCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CorePublicIpDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.PublicIp
 }
@@ -131,9 +132,9 @@ func (s *CorePublicIpDataSourceCrud) getById(id string) error {
 	request := oci_core.GetPublicIpRequest{}
 	request.PublicIpId = &id
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -148,8 +149,8 @@ func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error
 		tmp := privateIpId.(string)
 		request.PrivateIpId = &tmp
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByPrivateIpId(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	response, err := s.Client.GetPublicIpByPrivateIpId(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -166,8 +167,8 @@ func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
 		request.IpAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByIpAddress(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
+	response, err := s.Client.GetPublicIpByIpAddress(s.Context(), request)
 
 	if err != nil {
 		return err
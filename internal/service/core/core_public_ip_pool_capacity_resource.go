@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,10 +25,10 @@ func PublicIpPoolCapacityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createPublicIpPoolCapacity,
-		Read:     readPublicIpPoolCapacity,
-		Delete:   deletePublicIpPoolCapacity,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createPublicIpPoolCapacity,
+		ReadContext:   readPublicIpPoolCapacity,
+		DeleteContext: deletePublicIpPoolCapacity,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"public_ip_pool_id": {
@@ -49,29 +50,29 @@ func PublicIpPoolCapacityResource() *schema.Resource {
 	}
 }
 
-func createPublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func createPublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readPublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func readPublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deletePublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func deletePublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CorePublicIpPoolCapacityResourceCrud struct {
@@ -109,9 +110,10 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Create() error {
 		request.CidrBlock = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddPublicIpPoolCapacity(context.Background(), request)
+	response, err := s.Client.AddPublicIpPoolCapacity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -130,9 +132,9 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Get() error {
 
 	request.PublicIpPoolId = &publicIpPoolId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -183,9 +185,9 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Delete() error {
 		request.CidrBlock = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.RemovePublicIpPoolCapacity(context.Background(), request)
+	_, err := s.Client.RemovePublicIpPoolCapacity(s.Context(), request)
 	return err
 }
 
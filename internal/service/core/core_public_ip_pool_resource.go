@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePublicIpPoolResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePublicIpPool,
-		Read:     readCorePublicIpPool,
-		Update:   updateCorePublicIpPool,
-		Delete:   deleteCorePublicIpPool,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePublicIpPool,
+		ReadContext:   readCorePublicIpPool,
+		UpdateContext: updateCorePublicIpPool,
+		DeleteContext: deleteCorePublicIpPool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -71,37 +72,37 @@ func CorePublicIpPoolResource() *schema.Resource {
 	}
 }
 
-func createCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func createCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func updateCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func deleteCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CorePublicIpPoolResourceCrud struct {
@@ -162,9 +163,10 @@ func (s *CorePublicIpPoolResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIpPool(context.Background(), request)
+	response, err := s.Client.CreatePublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -179,9 +181,9 @@ func (s *CorePublicIpPoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PublicIpPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -222,9 +224,9 @@ func (s *CorePublicIpPoolResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PublicIpPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIpPool(context.Background(), request)
+	response, err := s.Client.UpdatePublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,9 +241,9 @@ func (s *CorePublicIpPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicIpPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIpPool(context.Background(), request)
+	_, err := s.Client.DeletePublicIpPool(s.Context(), request)
 	return err
 }
 
@@ -310,14 +312,14 @@ func (s *CorePublicIpPoolResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.PublicIpPoolId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpPoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
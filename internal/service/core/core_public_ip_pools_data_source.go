@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePublicIpPoolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePublicIpPools,
+		ReadContext: readCorePublicIpPools,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"byoip_range_id": {
@@ -48,16 +49,16 @@ func CorePublicIpPoolsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePublicIpPools(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIpPools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CorePublicIpPoolsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListPublicIpPoolsResponse
 }
@@ -84,9 +85,9 @@ func (s *CorePublicIpPoolsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListPublicIpPools(context.Background(), request)
+	response, err := s.Client.ListPublicIpPools(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CorePublicIpPoolsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIpPools(context.Background(), request)
+		listResponse, err := s.Client.ListPublicIpPools(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePublicIpResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePublicIp,
-		Read:     readCorePublicIp,
-		Update:   updateCorePublicIp,
-		Delete:   deleteCorePublicIp,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePublicIp,
+		ReadContext:   readCorePublicIp,
+		UpdateContext: updateCorePublicIp,
+		DeleteContext: deleteCorePublicIp,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -99,37 +100,37 @@ func CorePublicIpResource() *schema.Resource {
 	}
 }
 
-func createCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func createCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func updateCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func deleteCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CorePublicIpResourceCrud struct {
@@ -225,9 +226,10 @@ func (s *CorePublicIpResourceCrud) Create() error {
 		request.PublicIpPoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIp(context.Background(), request)
+	response, err := s.Client.CreatePublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,9 +244,9 @@ func (s *CorePublicIpResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -295,9 +297,9 @@ func (s *CorePublicIpResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIp(context.Background(), request)
+	response, err := s.Client.UpdatePublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,9 +314,9 @@ func (s *CorePublicIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIp(context.Background(), request)
+	_, err := s.Client.DeletePublicIp(s.Context(), request)
 	return err
 }
 
@@ -377,14 +379,14 @@ func (s *CorePublicIpResourceCrud) updateCompartment(compartment interface{}) er
 	idTmp := s.D.Id()
 	changeCompartmentRequest.PublicIpId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
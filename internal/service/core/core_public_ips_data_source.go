@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePublicIpsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePublicIps,
+		ReadContext: readCorePublicIps,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,16 +48,16 @@ func CorePublicIpsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePublicIps(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIps(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CorePublicIpsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListPublicIpsResponse
 }
@@ -91,9 +92,9 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 		request.Scope = oci_core.ListPublicIpsScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListPublicIps(context.Background(), request)
+	response, err := s.Client.ListPublicIps(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIps(context.Background(), request)
+		listResponse, err := s.Client.ListPublicIps(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreRemotePeeringConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreRemotePeeringConnection,
-		Read:     readCoreRemotePeeringConnection,
-		Update:   updateCoreRemotePeeringConnection,
-		Delete:   deleteCoreRemotePeeringConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreRemotePeeringConnection,
+		ReadContext:   readCoreRemotePeeringConnection,
+		UpdateContext: updateCoreRemotePeeringConnection,
+		DeleteContext: deleteCoreRemotePeeringConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -100,43 +101,44 @@ func CoreRemotePeeringConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectRemotePeeringConnection()
+	return tfresource.ToDiagnostics(sync, sync.ConnectRemotePeeringConnection())
 }
 
-func readCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreRemotePeeringConnectionResourceCrud struct {
@@ -196,9 +198,9 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 		connectRequest.PeerRegionName = &tmp
 	}
 
-	connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ConnectRemotePeeringConnections(context.Background(), connectRequest)
+	_, err := s.Client.ConnectRemotePeeringConnections(s.Context(), connectRequest)
 	if err != nil {
 		// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 		s.D.Set("peer_id", "")
@@ -212,7 +214,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	request.RequestMetadata.RetryPolicy = getRemotePeeringConnectionRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-	response, getError := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, getError := s.Client.GetRemotePeeringConnection(s.Context(), request)
 	if getError != nil {
 		log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 		return getError
@@ -260,9 +262,10 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.CreateRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -277,9 +280,9 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.GetRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,9 +323,9 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.UpdateRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,9 +340,9 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
+	_, err := s.Client.DeleteRemotePeeringConnection(s.Context(), request)
 	return err
 }
 
@@ -421,14 +424,14 @@ func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(compartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.RemotePeeringConnectionId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreRemotePeeringConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreRemotePeeringConnections,
+		ReadContext: readCoreRemotePeeringConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func CoreRemotePeeringConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreRemotePeeringConnections(d *schema.ResourceData, m interface{}) error {
+func readCoreRemotePeeringConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreRemotePeeringConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListRemotePeeringConnectionsResponse
 }
@@ -66,9 +67,9 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get() error {
 		request.DrgId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListRemotePeeringConnections(context.Background(), request)
+	response, err := s.Client.ListRemotePeeringConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRemotePeeringConnections(context.Background(), request)
+		listResponse, err := s.Client.ListRemotePeeringConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
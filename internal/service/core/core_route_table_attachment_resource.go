@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -21,10 +22,10 @@ func CoreRouteTableAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createRouteTableAttachment,
-		Delete:   deleteRouteTableAttachment,
-		Read:     readRouteTableAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createRouteTableAttachment,
+		DeleteContext: deleteRouteTableAttachment,
+		ReadContext:   readRouteTableAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subnet_id": {
@@ -41,29 +42,29 @@ func CoreRouteTableAttachmentResource() *schema.Resource {
 	}
 }
 
-func createRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func createRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func deleteRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
-func readRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func readRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type RouteTableAttachmentResourceCrud struct {
@@ -104,8 +105,8 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	response, err := s.Client.UpdateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -122,8 +123,8 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	}
 	request := oci_core.GetSubnetRequest{}
 	request.SubnetId = &subnetId
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
+	response, err := s.Client.GetSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -135,12 +136,12 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 func (s *RouteTableAttachmentResourceCrud) Delete() error {
 
 	var subnetIdStr = s.D.Get("subnet_id").(string)
-	var retryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	var retryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
 	subnetRequest := oci_core.GetSubnetRequest{}
 	subnetRequest.SubnetId = &subnetIdStr
 	subnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	subnetResponse, err := s.Client.GetSubnet(context.Background(), subnetRequest)
+	subnetResponse, err := s.Client.GetSubnet(s.Context(), subnetRequest)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	vcnRequest := oci_core.GetVcnRequest{}
 	vcnRequest.VcnId = subnetResponse.VcnId
 	vcnRequest.RequestMetadata.RetryPolicy = retryPolicy
-	vcnResponse, err := s.Client.GetVcn(context.Background(), vcnRequest)
+	vcnResponse, err := s.Client.GetVcn(s.Context(), vcnRequest)
 	if err != nil {
 		return err
 	}
@@ -157,7 +158,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	updateSubnetRequest.RouteTableId = vcnResponse.DefaultRouteTableId
 	updateSubnetRequest.SubnetId = &subnetIdStr
 	updateSubnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	updateSubnetResponse, err := s.Client.UpdateSubnet(context.Background(), updateSubnetRequest)
+	updateSubnetResponse, err := s.Client.UpdateSubnet(s.Context(), updateSubnetRequest)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func DefaultCoreRouteTableResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreRouteTableResource())
 
-	defaultResourceSchema.Create = createDefaultRouteTable
-	defaultResourceSchema.Delete = deleteDefaultRouteTable
+	defaultResourceSchema.CreateContext = createDefaultRouteTable
+	defaultResourceSchema.DeleteContext = deleteDefaultRouteTable
 
 	return defaultResourceSchema
 }
@@ -29,20 +30,20 @@ type DefaultRouteTableResourceCrud struct {
 	CoreRouteTableResourceCrud
 }
 
-func createDefaultRouteTable(d *schema.ResourceData, m interface{}) error {
+func createDefaultRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func deleteDefaultRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 func (s *DefaultRouteTableResourceCrud) Create() error {
@@ -66,9 +67,9 @@ func (s *DefaultRouteTableResourceCrud) reset() error {
 
 	request.RouteRules = []oci_core.RouteRule{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
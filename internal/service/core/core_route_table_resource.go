@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreRouteTable,
-		Read:     readCoreRouteTable,
-		Update:   updateCoreRouteTable,
-		Delete:   deleteCoreRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreRouteTable,
+		ReadContext:   readCoreRouteTable,
+		UpdateContext: updateCoreRouteTable,
+		DeleteContext: deleteCoreRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -114,37 +115,37 @@ func CoreRouteTableResource() *schema.Resource {
 	}
 }
 
-func createCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func createCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func readCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreRouteTableResourceCrud struct {
@@ -229,9 +230,10 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRouteTable(context.Background(), request)
+	response, err := s.Client.CreateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,9 +248,9 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRouteTable(context.Background(), request)
+	response, err := s.Client.GetRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -306,9 +308,9 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -323,9 +325,9 @@ func (s *CoreRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRouteTable(context.Background(), request)
+	_, err := s.Client.DeleteRouteTable(s.Context(), request)
 	return err
 }
 
@@ -481,14 +483,14 @@ func (s *CoreRouteTableResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.RtId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRouteTableCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRouteTableCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func CoreDefaultSecurityListResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreSecurityListResource())
 
-	defaultResourceSchema.Create = createDefaultSecurityList
-	defaultResourceSchema.Delete = deleteDefaultSecurityList
+	defaultResourceSchema.CreateContext = createDefaultSecurityList
+	defaultResourceSchema.DeleteContext = deleteDefaultSecurityList
 
 	return defaultResourceSchema
 }
@@ -29,20 +30,20 @@ type DefaultSecurityListResourceCrud struct {
 	CoreSecurityListResourceCrud
 }
 
-func createDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
+func createDefaultSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func deleteDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 func (s *DefaultSecurityListResourceCrud) Create() error {
@@ -68,9 +69,9 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 
 	request.EgressSecurityRules = []oci_core.EgressSecurityRule{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreSecurityListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreSecurityList,
-		Read:     readCoreSecurityList,
-		Update:   updateCoreSecurityList,
-		Delete:   deleteCoreSecurityList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreSecurityList,
+		ReadContext:   readCoreSecurityList,
+		UpdateContext: updateCoreSecurityList,
+		DeleteContext: deleteCoreSecurityList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -397,37 +398,37 @@ func CoreSecurityListResource() *schema.Resource {
 	}
 }
 
-func createCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func createCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func readCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func updateCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func deleteCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreSecurityListResourceCrud struct {
@@ -529,9 +530,10 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSecurityList(context.Background(), request)
+	response, err := s.Client.CreateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -546,9 +548,9 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSecurityList(context.Background(), request)
+	response, err := s.Client.GetSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -623,9 +625,9 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -640,9 +642,9 @@ func (s *CoreSecurityListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteSecurityList(context.Background(), request)
+	_, err := s.Client.DeleteSecurityList(s.Context(), request)
 	return err
 }
 
@@ -1225,14 +1227,14 @@ func (s *CoreSecurityListResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SecurityListId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSecurityListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreServiceGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreServiceGateway,
-		Read:     readCoreServiceGateway,
-		Update:   updateCoreServiceGateway,
-		Delete:   deleteCoreServiceGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreServiceGateway,
+		ReadContext:   readCoreServiceGateway,
+		UpdateContext: updateCoreServiceGateway,
+		DeleteContext: deleteCoreServiceGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -103,37 +104,37 @@ func CoreServiceGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreServiceGatewayResourceCrud struct {
@@ -224,9 +225,10 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateServiceGateway(context.Background(), request)
+	response, err := s.Client.CreateServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +243,9 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetServiceGateway(context.Background(), request)
+	response, err := s.Client.GetServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,9 +314,9 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
+	response, err := s.Client.UpdateServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,9 +331,9 @@ func (s *CoreServiceGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
+	_, err := s.Client.DeleteServiceGateway(s.Context(), request)
 	return err
 }
 
@@ -419,14 +421,14 @@ func (s *CoreServiceGatewayResourceCrud) updateCompartment(compartment interface
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ServiceGatewayId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeServiceGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -20,10 +21,10 @@ import (
 
 func CoreShapeResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreShape,
-		Read:     readCoreShape,
-		Delete:   deleteCoreShape,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreShape,
+		ReadContext:   readCoreShape,
+		DeleteContext: deleteCoreShape,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -45,29 +46,29 @@ func CoreShapeResource() *schema.Resource {
 	}
 }
 
-func createCoreShape(d *schema.ResourceData, m interface{}) error {
+func createCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreShape(d *schema.ResourceData, m interface{}) error {
+func readCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreShape(d *schema.ResourceData, m interface{}) error {
+func deleteCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreShapeResourceCrud struct {
@@ -94,9 +95,9 @@ func (s *CoreShapeResourceCrud) Create() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddImageShapeCompatibilityEntry(context.Background(), request)
+	response, err := s.Client.AddImageShapeCompatibilityEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -117,9 +118,9 @@ func (s *CoreShapeResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -147,9 +148,9 @@ func (s *CoreShapeResourceCrud) Delete() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.RemoveImageShapeCompatibilityEntry(context.Background(), request)
+	_, err = s.Client.RemoveImageShapeCompatibilityEntry(s.Context(), request)
 	return err
 }
 
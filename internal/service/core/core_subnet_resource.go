@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func CoreSubnetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreSubnet,
-		Read:     readCoreSubnet,
-		Update:   updateCoreSubnet,
-		Delete:   deleteCoreSubnet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreSubnet,
+		ReadContext:   readCoreSubnet,
+		UpdateContext: updateCoreSubnet,
+		DeleteContext: deleteCoreSubnet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
@@ -144,37 +145,37 @@ func CoreSubnetResource() *schema.Resource {
 	}
 }
 
-func createCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func createCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func readCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func updateCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func deleteCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreSubnetResourceCrud struct {
@@ -301,9 +302,10 @@ func (s *CoreSubnetResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSubnet(context.Background(), request)
+	response, err := s.Client.CreateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -318,9 +320,9 @@ func (s *CoreSubnetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -398,9 +400,9 @@ func (s *CoreSubnetResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -415,9 +417,9 @@ func (s *CoreSubnetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, globalvar.CoreService, globalvar.SubnetService, globalvar.DeleteResource)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, globalvar.CoreService, globalvar.SubnetService, globalvar.DeleteResource)
 
-	_, err := s.Client.DeleteSubnet(context.Background(), request)
+	_, err := s.Client.DeleteSubnet(s.Context(), request)
 	return err
 }
 
@@ -512,14 +514,14 @@ func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) erro
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SubnetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSubnetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubnetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreTunnelSecurityAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreTunnelSecurityAssociations,
+		ReadContext: readCoreTunnelSecurityAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -63,16 +64,16 @@ func CoreTunnelSecurityAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreTunnelSecurityAssociations(d *schema.ResourceData, m interface{}) error {
+func readCoreTunnelSecurityAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreTunnelSecurityAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreTunnelSecurityAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListIPSecConnectionTunnelSecurityAssociationsResponse
 }
@@ -94,9 +95,9 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 		request.TunnelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -105,7 +106,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVcnDnsResolverAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVcnDnsResolverAssociation,
+		ReadContext: readSingularCoreVcnDnsResolverAssociation,
 		Schema: map[string]*schema.Schema{
 			"vcn_id": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func CoreVcnDnsResolverAssociationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVcnDnsResolverAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVcnDnsResolverAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnDnsResolverAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVcnDnsResolverAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetVcnDnsResolverAssociationResponse
 }
@@ -60,9 +61,9 @@ func (s *CoreVcnDnsResolverAssociationDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVcnDnsResolverAssociation(context.Background(), request)
+	response, err := s.Client.GetVcnDnsResolverAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func CoreVcnResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVcn,
-		Read:     readCoreVcn,
-		Update:   updateCoreVcn,
-		Delete:   deleteCoreVcn,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVcn,
+		ReadContext:   readCoreVcn,
+		UpdateContext: updateCoreVcn,
+		DeleteContext: deleteCoreVcn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -114,37 +115,37 @@ func CoreVcnResource() *schema.Resource {
 	}
 }
 
-func createCoreVcn(d *schema.ResourceData, m interface{}) error {
+func createCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVcn(d *schema.ResourceData, m interface{}) error {
+func readCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
+func updateCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVcnResourceCrud struct {
@@ -182,6 +183,8 @@ func (s *CoreVcnResourceCrud) DeletedTarget() []string {
 	}
 }
 
+func (s *CoreVcnResourceCrud) ResumableCreate() {}
+
 func (s *CoreVcnResourceCrud) Create() error {
 	request := oci_core.CreateVcnRequest{}
 
@@ -235,9 +238,10 @@ func (s *CoreVcnResourceCrud) Create() error {
 		request.IsIpv6Enabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVcn(context.Background(), request)
+	response, err := s.Client.CreateVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,9 +256,9 @@ func (s *CoreVcnResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -278,9 +282,9 @@ func (s *CoreVcnResourceCrud) Update() error {
 		enableIPv6Request := oci_core.AddIpv6VcnCidrRequest{}
 		tmp := s.D.Id()
 		enableIPv6Request.VcnId = &tmp
-		enableIPv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		enableIPv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(s.Context(), enableIPv6Request)
 		if err != nil {
 			return err
 		}
@@ -318,9 +322,9 @@ func (s *CoreVcnResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVcn(context.Background(), request)
+	response, err := s.Client.UpdateVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -335,9 +339,9 @@ func (s *CoreVcnResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVcn(context.Background(), request)
+	_, err := s.Client.DeleteVcn(s.Context(), request)
 	return err
 }
 
@@ -408,14 +412,14 @@ func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VcnId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVcnCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -445,9 +449,9 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		addVcnCidrRequest := oci_core.AddVcnCidrRequest{}
 		idTmp := s.D.Id()
 		addVcnCidrRequest.VcnId = &idTmp
-		addVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		addVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		addVcnCidrRequest.CidrBlock = &newCidr
-		_, err := s.Client.AddVcnCidr(context.Background(), addVcnCidrRequest)
+		_, err := s.Client.AddVcnCidr(s.Context(), addVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -456,9 +460,9 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		removeVcnCidrRequest := oci_core.RemoveVcnCidrRequest{}
 		idTmp := s.D.Id()
 		removeVcnCidrRequest.VcnId = &idTmp
-		removeVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		removeVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		removeVcnCidrRequest.CidrBlock = &oldCidr
-		_, err := s.Client.RemoveVcnCidr(context.Background(), removeVcnCidrRequest)
+		_, err := s.Client.RemoveVcnCidr(s.Context(), removeVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -467,10 +471,10 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		modifyVcnCidrRequest := oci_core.ModifyVcnCidrRequest{}
 		idTmp := s.D.Id()
 		modifyVcnCidrRequest.VcnId = &idTmp
-		modifyVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		modifyVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		modifyVcnCidrRequest.OriginalCidrBlock = &oldCidr
 		modifyVcnCidrRequest.NewCidrBlock = &newCidr
-		_, err := s.Client.ModifyVcnCidr(context.Background(), modifyVcnCidrRequest)
+		_, err := s.Client.ModifyVcnCidr(s.Context(), modifyVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVirtualCircuitBandwidthShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuitBandwidthShapes,
+		ReadContext: readCoreVirtualCircuitBandwidthShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"provider_service_id": {
@@ -47,16 +48,16 @@ func CoreVirtualCircuitBandwidthShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuitBandwidthShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuitBandwidthShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitBandwidthShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVirtualCircuitBandwidthShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListFastConnectProviderVirtualCircuitBandwidthShapesResponse
 }
@@ -73,9 +74,9 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 		request.ProviderServiceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
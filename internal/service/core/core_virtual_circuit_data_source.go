@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -23,16 +24,16 @@ func CoreVirtualCircuitDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVirtualCircuitResource(), fieldMap, readSingularCoreVirtualCircuit)
 }
 
-func readSingularCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVirtualCircuitDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetVirtualCircuitResponse
 }
@@ -49,9 +50,9 @@ func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
 		request.VirtualCircuitId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVirtualCircuitPublicPrefixesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuitPublicPrefixes,
+		ReadContext: readCoreVirtualCircuitPublicPrefixes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"verification_state": {
@@ -51,16 +52,16 @@ func CoreVirtualCircuitPublicPrefixesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuitPublicPrefixes(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuitPublicPrefixes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitPublicPrefixesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVirtualCircuitPublicPrefixesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListVirtualCircuitPublicPrefixesResponse
 }
@@ -81,9 +82,9 @@ func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get() error {
 		request.VirtualCircuitId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuitPublicPrefixes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreVirtualCircuitResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVirtualCircuit,
-		Read:     readCoreVirtualCircuit,
-		Update:   updateCoreVirtualCircuit,
-		Delete:   deleteCoreVirtualCircuit,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVirtualCircuit,
+		ReadContext:   readCoreVirtualCircuit,
+		UpdateContext: updateCoreVirtualCircuit,
+		DeleteContext: deleteCoreVirtualCircuit,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -227,37 +228,37 @@ func CoreVirtualCircuitResource() *schema.Resource {
 	}
 }
 
-func createCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func createCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func updateCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVirtualCircuitResourceCrud struct {
@@ -435,9 +436,10 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 		request.Type = oci_core.CreateVirtualCircuitDetailsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.CreateVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,9 +454,9 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -462,10 +464,10 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	s.Res = &response.VirtualCircuit
 
 	ppRequest := oci_core.ListVirtualCircuitPublicPrefixesRequest{}
-	ppRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	ppRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 	ppRequest.VirtualCircuitId = request.VirtualCircuitId
 
-	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), ppRequest)
+	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(s.Context(), ppRequest)
 	if ppErr != nil {
 		return ppErr
 	}
@@ -497,7 +499,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 				return err
 			}
 
-			if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+			if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 				return waitErr
 			}
 		}
@@ -595,9 +597,9 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.UpdateVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -641,15 +643,15 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 	// And, wait for the Update to complete before proceeding for subsequent updates if state is PROVISIONING
 	if len(publicPrefixesToAdd) > 0 {
 		addRequest := oci_core.BulkAddVirtualCircuitPublicPrefixesRequest{}
-		addRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		addRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		addRequest.PublicPrefixes = publicPrefixesToAdd
 		addRequest.VirtualCircuitId = &virtualCircuitId
-		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(context.Background(), addRequest)
+		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(s.Context(), addRequest)
 		if addErr != nil {
 			return fmt.Errorf("failed to add public prefixes, error: %v", addErr)
 		}
 
-		if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -658,15 +660,15 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 	// And, wait for the Update to complete before proceeding for subsequent updates if state is PROVISIONING
 	if len(publicPrefixesToDelete) > 0 {
 		deleteRequest := oci_core.BulkDeleteVirtualCircuitPublicPrefixesRequest{}
-		deleteRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		deleteRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 		deleteRequest.PublicPrefixes = publicPrefixesToDelete
 		deleteRequest.VirtualCircuitId = &virtualCircuitId
-		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(context.Background(), deleteRequest)
+		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(s.Context(), deleteRequest)
 		if deleteErr != nil {
 			return fmt.Errorf("failed to delete public prefixes, error: %v", deleteErr)
 		}
 
-		if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -680,9 +682,9 @@ func (s *CoreVirtualCircuitResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
+	_, err := s.Client.DeleteVirtualCircuit(s.Context(), request)
 	return err
 }
 
@@ -919,14 +921,14 @@ func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VirtualCircuitId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVirtualCircuitCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVirtualCircuitCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
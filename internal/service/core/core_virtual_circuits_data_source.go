@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVirtualCircuitsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuits,
+		ReadContext: readCoreVirtualCircuits,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func CoreVirtualCircuitsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuits(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuits(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVirtualCircuitsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.ListVirtualCircuitsResponse
 }
@@ -75,9 +76,9 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.VirtualCircuitLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVirtualCircuits(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuits(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVirtualCircuits(context.Background(), request)
+		listResponse, err := s.Client.ListVirtualCircuits(s.Context(), request)
 		if err != nil {
 			return err
 		}
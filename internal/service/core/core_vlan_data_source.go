@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -22,16 +23,16 @@ func CoreVlanDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVlanResource(), fieldMap, readSingularCoreVlan)
 }
 
-func readSingularCoreVlan(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVlanDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetVlanResponse
 }
@@ -48,9 +49,9 @@ func (s *CoreVlanDataSourceCrud) Get() error {
 		request.VlanId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVlan(context.Background(), request)
+	response, err := s.Client.GetVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
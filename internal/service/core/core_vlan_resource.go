@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreVlanResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVlan,
-		Read:     readCoreVlan,
-		Update:   updateCoreVlan,
-		Delete:   deleteCoreVlan,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVlan,
+		ReadContext:   readCoreVlan,
+		UpdateContext: updateCoreVlan,
+		DeleteContext: deleteCoreVlan,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
@@ -100,37 +101,37 @@ func CoreVlanResource() *schema.Resource {
 	}
 }
 
-func createCoreVlan(d *schema.ResourceData, m interface{}) error {
+func createCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVlan(d *schema.ResourceData, m interface{}) error {
+func readCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVlan(d *schema.ResourceData, m interface{}) error {
+func updateCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVlan(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVlanResourceCrud struct {
@@ -232,9 +233,10 @@ func (s *CoreVlanResourceCrud) Create() error {
 		request.VlanTag = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVlan(context.Background(), request)
+	response, err := s.Client.CreateVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,9 +251,9 @@ func (s *CoreVlanResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VlanId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVlan(context.Background(), request)
+	response, err := s.Client.GetVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -316,9 +318,9 @@ func (s *CoreVlanResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VlanId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVlan(context.Background(), request)
+	response, err := s.Client.UpdateVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -333,9 +335,9 @@ func (s *CoreVlanResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VlanId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVlan(context.Background(), request)
+	_, err := s.Client.DeleteVlan(s.Context(), request)
 	return err
 }
 
@@ -398,14 +400,14 @@ func (s *CoreVlanResourceCrud) updateCompartment(compartment interface{}) error
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VlanId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVlanCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVlanCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVnicAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVnicAttachment,
-		Read:     readCoreVnicAttachment,
-		Update:   updateCoreVnicAttachment,
-		Delete:   deleteCoreVnicAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVnicAttachment,
+		ReadContext:   readCoreVnicAttachment,
+		UpdateContext: updateCoreVnicAttachment,
+		DeleteContext: deleteCoreVnicAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"create_vnic_details": {
@@ -181,41 +182,41 @@ func CoreVnicAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func updateCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVnicAttachmentResourceCrud struct {
@@ -283,9 +284,10 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 		request.NicIndex = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVnic(context.Background(), request)
+	response, err := s.Client.AttachVnic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,9 +328,9 @@ func (s *CoreVnicAttachmentResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), request)
+	_, err = s.VirtualNetworkClient.UpdateVnic(s.Context(), request)
 	return err
 }
 
@@ -338,9 +340,9 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VnicAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVnicAttachment(context.Background(), request)
+	response, err := s.Client.GetVnicAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -355,9 +357,9 @@ func (s *CoreVnicAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VnicAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVnic(context.Background(), request)
+	_, err := s.Client.DetachVnic(s.Context(), request)
 	return err
 }
 
@@ -408,7 +410,7 @@ func (s *CoreVnicAttachmentResourceCrud) SetData() error {
 	request := oci_core.GetVnicRequest{}
 	request.VnicId = s.Res.VnicId
 
-	response, err := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetVnic(s.Context(), request)
 	if err != nil {
 		// VNIC might not be found when attaching or detaching; or if it is in a different compartment
 		if request.VnicId != nil {
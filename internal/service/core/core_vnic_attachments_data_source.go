@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVnicAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVnicAttachments,
+		ReadContext: readCoreVnicAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -43,16 +44,16 @@ func CoreVnicAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVnicAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreVnicAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVnicAttachmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListVnicAttachmentsResponse
 }
@@ -84,9 +85,9 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVnicAttachments(context.Background(), request)
+	response, err := s.Client.ListVnicAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVnicAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVnicAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVnicDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVnic,
+		ReadContext: readSingularCoreVnic,
 		Schema: map[string]*schema.Schema{
 			"vnic_id": {
 				Type:     schema.TypeString,
@@ -95,16 +96,16 @@ func CoreVnicDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVnic(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVnic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVnicDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.VirtualNetworkClient
 	Res    *oci_core.GetVnicResponse
 }
@@ -121,9 +122,9 @@ func (s *CoreVnicDataSourceCrud) Get() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVnic(context.Background(), request)
+	response, err := s.Client.GetVnic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,10 +24,10 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeAttachment,
-		Read:     readCoreVolumeAttachment,
-		Delete:   deleteCoreVolumeAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeAttachment,
+		ReadContext:   readCoreVolumeAttachment,
+		DeleteContext: deleteCoreVolumeAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"attachment_type": {
@@ -172,29 +173,29 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeAttachmentResourceCrud struct {
@@ -240,9 +241,10 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVolume(context.Background(), request)
+	response, err := s.Client.AttachVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,9 +259,9 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+	response, err := s.Client.GetVolumeAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,9 +276,9 @@ func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVolume(context.Background(), request)
+	_, err := s.Client.DetachVolume(s.Context(), request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeAttachments,
+		ReadContext: readCoreVolumeAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -44,16 +45,16 @@ func CoreVolumeAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeAttachmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.ComputeClient
 	Res    *oci_core.ListVolumeAttachmentsResponse
 }
@@ -85,9 +86,9 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 		request.VolumeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListVolumeAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVolumeBackupPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeBackupPolicies,
+		ReadContext: readCoreVolumeBackupPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,16 +32,16 @@ func CoreVolumeBackupPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeBackupPolicies(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeBackupPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumeBackupPoliciesResponse
 }
@@ -57,9 +58,9 @@ func (s *CoreVolumeBackupPoliciesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeBackupPolicies(context.Background(), request)
+	response, err := s.Client.ListVolumeBackupPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreVolumeBackupPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeBackupPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeBackupPolicies(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,10 +20,10 @@ func CoreVolumeBackupPolicyAssignmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeBackupPolicyAssignment,
-		Read:     readCoreVolumeBackupPolicyAssignment,
-		Delete:   deleteCoreVolumeBackupPolicyAssignment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeBackupPolicyAssignment,
+		ReadContext:   readCoreVolumeBackupPolicyAssignment,
+		DeleteContext: deleteCoreVolumeBackupPolicyAssignment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"asset_id": {
@@ -47,29 +48,29 @@ func CoreVolumeBackupPolicyAssignmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeBackupPolicyAssignmentResourceCrud struct {
@@ -96,9 +97,9 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 		request.PolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicyAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -113,9 +114,9 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PolicyAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -130,9 +131,9 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(s.Context(), request)
 	return err
 }
 
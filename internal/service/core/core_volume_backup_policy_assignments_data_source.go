@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVolumeBackupPolicyAssignmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeBackupPolicyAssignments,
+		ReadContext: readCoreVolumeBackupPolicyAssignments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"asset_id": {
@@ -31,16 +32,16 @@ func CoreVolumeBackupPolicyAssignmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeBackupPolicyAssignments(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicyAssignments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeBackupPolicyAssignmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetVolumeBackupPolicyAssetAssignmentResponse
 }
@@ -57,9 +58,9 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 		request.AssetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(s.Context(), request)
 		if err != nil {
 			return err
 		}
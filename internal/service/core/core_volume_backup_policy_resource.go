@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVolumeBackupPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeBackupPolicy,
-		Read:     readCoreVolumeBackupPolicy,
-		Update:   updateCoreVolumeBackupPolicy,
-		Delete:   deleteCoreVolumeBackupPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeBackupPolicy,
+		ReadContext:   readCoreVolumeBackupPolicy,
+		UpdateContext: updateCoreVolumeBackupPolicy,
+		DeleteContext: deleteCoreVolumeBackupPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -126,37 +127,37 @@ func CoreVolumeBackupPolicyResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeBackupPolicyResourceCrud struct {
@@ -218,9 +219,10 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,9 +237,9 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,9 +294,9 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -309,9 +311,9 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicy(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicy(s.Context(), request)
 	return err
 }
 
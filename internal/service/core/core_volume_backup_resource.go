@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreVolumeBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeBackup,
-		Read:     readCoreVolumeBackup,
-		Update:   updateCoreVolumeBackup,
-		Delete:   deleteCoreVolumeBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeBackup,
+		ReadContext:   readCoreVolumeBackup,
+		UpdateContext: updateCoreVolumeBackup,
+		DeleteContext: deleteCoreVolumeBackup,
 		Schema: map[string]*schema.Schema{
 			// Optional
 			"volume_id": {
@@ -154,23 +155,24 @@ func CoreVolumeBackupResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
 		err = sync.updateCompartment(compartment)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
@@ -186,32 +188,32 @@ func createCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeBackupResourceCrud struct {
@@ -312,7 +314,7 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 		copyVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeBackup(context.Background(), copyVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeBackup(s.Context(), copyVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -322,14 +324,14 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 	s.Res = &response.VolumeBackup
 
 	if workRequestId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.workRequestClient, workRequestId, "volumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.workRequestClient, workRequestId, "volumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
 	s.D.SetId(*s.Res.Id)
-	err = tfresource.WaitForResourceCondition(s, func() bool { return s.Res.LifecycleState == oci_core.VolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
+	err = tfresource.WaitForResourceConditionContext(s.Context(), s, func() bool { return s.Res.LifecycleState == oci_core.VolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -366,9 +368,9 @@ func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup() error {
 		request.VolumeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,9 +385,9 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,9 +428,9 @@ func (s *CoreVolumeBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,9 +445,9 @@ func (s *CoreVolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackup(s.Context(), request)
 	return err
 }
 
@@ -526,14 +528,14 @@ func (s *CoreVolumeBackupResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VolumeBackupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
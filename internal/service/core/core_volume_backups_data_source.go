@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeBackups,
+		ReadContext: readCoreVolumeBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func CoreVolumeBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeBackups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumeBackupsResponse
 }
@@ -93,9 +94,9 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 		request.VolumeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeBackups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
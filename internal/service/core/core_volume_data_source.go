@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -23,16 +24,16 @@ func CoreVolumeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVolumeResource(), fieldMap, readSingularCoreVolume)
 }
 
-func readSingularCoreVolume(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetVolumeResponse
 }
@@ -49,9 +50,9 @@ func (s *CoreVolumeDataSourceCrud) Get() error {
 		request.VolumeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVolumeGroupBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeGroupBackup,
-		Read:     readCoreVolumeGroupBackup,
-		Update:   updateCoreVolumeGroupBackup,
-		Delete:   deleteCoreVolumeGroupBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeGroupBackup,
+		ReadContext:   readCoreVolumeGroupBackup,
+		UpdateContext: updateCoreVolumeGroupBackup,
+		DeleteContext: deleteCoreVolumeGroupBackup,
 		Schema: map[string]*schema.Schema{
 			"volume_group_id": {
 				Type:          schema.TypeString,
@@ -149,23 +150,24 @@ func CoreVolumeGroupBackupResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
 	// Issue logged with service team for `Create` not supporting non-default compartment_id
 	// Remove custom code after issue is fixed.
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	if ok && compartment != *sync.Res.CompartmentId {
 		err = sync.updateCompartment(compartment)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
@@ -181,29 +183,29 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeGroupBackupResourceCrud struct {
@@ -251,7 +253,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Create() error {
 			return err
 		}
 		s.D.SetId(*s.Res.Id)
-		err = tfresource.WaitForResourceCondition(s, func() bool { return s.Res.LifecycleState == oci_core.VolumeGroupBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
+		err = tfresource.WaitForResourceConditionContext(s.Context(), s, func() bool { return s.Res.LifecycleState == oci_core.VolumeGroupBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -305,9 +307,9 @@ func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup() error {
 		request.VolumeGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -357,7 +359,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy() error
 		copyVolumeGroupBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(context.Background(), copyVolumeGroupBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(s.Context(), copyVolumeGroupBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -372,9 +374,9 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -425,9 +427,9 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -442,9 +444,9 @@ func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroupBackup(s.Context(), request)
 	return err
 }
 
@@ -519,14 +521,14 @@ func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interf
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VolumeGroupBackupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
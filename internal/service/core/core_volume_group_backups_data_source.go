@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroupBackups,
+		ReadContext: readCoreVolumeGroupBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroupBackups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeGroupBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumeGroupBackupsResponse
 }
@@ -76,9 +77,9 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 		request.VolumeGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroupBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
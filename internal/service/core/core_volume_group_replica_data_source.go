@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVolumeGroupReplica,
+		ReadContext: readSingularCoreVolumeGroupReplica,
 		Schema: map[string]*schema.Schema{
 			"volume_group_replica_id": {
 				Type:     schema.TypeString,
@@ -86,16 +87,16 @@ func CoreVolumeGroupReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVolumeGroupReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVolumeGroupReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeGroupReplicaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.GetVolumeGroupReplicaResponse
 }
@@ -112,9 +113,9 @@ func (s *CoreVolumeGroupReplicaDataSourceCrud) Get() error {
 		request.VolumeGroupReplicaId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.GetVolumeGroupReplica(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroupReplicas,
+		ReadContext: readCoreVolumeGroupReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -115,16 +116,16 @@ func CoreVolumeGroupReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroupReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeGroupReplicasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumeGroupReplicasResponse
 }
@@ -155,9 +156,9 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.VolumeGroupReplicaLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroupReplicas(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreVolumeGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeGroup,
-		Read:     readCoreVolumeGroup,
-		Update:   updateCoreVolumeGroup,
-		Delete:   deleteCoreVolumeGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeGroup,
+		ReadContext:   readCoreVolumeGroup,
+		UpdateContext: updateCoreVolumeGroup,
+		DeleteContext: deleteCoreVolumeGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -189,37 +190,37 @@ func CoreVolumeGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeGroupResourceCrud struct {
@@ -320,9 +321,10 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -338,9 +340,9 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,9 +428,9 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,9 +445,9 @@ func (s *CoreVolumeGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroup(s.Context(), request)
 	return err
 }
 
@@ -645,14 +647,14 @@ func (s *CoreVolumeGroupResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VolumeGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
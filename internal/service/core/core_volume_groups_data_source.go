@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroups,
+		ReadContext: readCoreVolumeGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -44,16 +45,16 @@ func CoreVolumeGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumeGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumeGroupsResponse
 }
@@ -84,9 +85,9 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.VolumeGroupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumeGroups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolume,
-		Read:     readCoreVolume,
-		Update:   updateCoreVolume,
-		Delete:   deleteCoreVolume,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolume,
+		ReadContext:   readCoreVolume,
+		UpdateContext: updateCoreVolume,
+		DeleteContext: deleteCoreVolume,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -203,37 +204,37 @@ func CoreVolumeResource() *schema.Resource {
 	}
 }
 
-func createCoreVolume(d *schema.ResourceData, m interface{}) error {
+func createCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readCoreVolume(d *schema.ResourceData, m interface{}) error {
+func readCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateCoreVolume(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteCoreVolume(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type CoreVolumeResourceCrud struct {
@@ -394,9 +395,10 @@ func (s *CoreVolumeResourceCrud) Create() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolume(context.Background(), request)
+	response, err := s.Client.CreateVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -411,9 +413,9 @@ func (s *CoreVolumeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -489,9 +491,9 @@ func (s *CoreVolumeResourceCrud) Update() error {
 		tmp := s.D.Get("kms_key_id").(string)
 		keyUpdateRequest.KmsKeyId = &tmp
 
-		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateVolumeKmsKey(s.Context(), keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -518,9 +520,9 @@ func (s *CoreVolumeResourceCrud) Update() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolume(context.Background(), request)
+	response, err := s.Client.UpdateVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -535,9 +537,9 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolume(context.Background(), request)
+	_, err := s.Client.DeleteVolume(s.Context(), request)
 	return err
 }
 
@@ -619,7 +621,7 @@ func (s *CoreVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(s.Context(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -738,14 +740,14 @@ func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) erro
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VolumeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumes,
+		ReadContext: readCoreVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -48,16 +49,16 @@ func CoreVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumes(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type CoreVolumesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_core.BlockstorageClient
 	Res    *oci_core.ListVolumesResponse
 }
@@ -93,9 +94,9 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 		request.VolumeGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "core")
 
-	response, err := s.Client.ListVolumes(context.Background(), request)
+	response, err := s.Client.ListVolumes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListVolumes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -5,11 +5,9 @@ package core
 
 import (
 	"context"
-	"fmt"
 	"net"
 	"strings"
 
-	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -71,12 +69,12 @@ func LaunchOptionsToMap(obj *oci_core.LaunchOptions) map[string]interface{} {
 	return result
 }
 
-func getBackupPolicyId(assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
+func getBackupPolicyId(ctx context.Context, assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
 	request := oci_core.GetVolumeBackupPolicyAssetAssignmentRequest{}
 	request.AssetId = assetId
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(ctx, false, "core")
 
-	response, err := client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -91,14 +89,8 @@ func getBackupPolicyId(assetId *string, client *oci_core.BlockstorageClient) (*s
 
 func (s *CoreVolumeBackupResourceCrud) createBlockStorageSourceRegionClient(region string) error {
 	if s.SourceRegionClient == nil {
-		sourceBlockStorageClient, err := oci_core.NewBlockstorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
-		if err != nil {
-			return fmt.Errorf("cannot Create client for the source region: %v", err)
-		}
-		err = tf_client.ConfigureClientVar(&sourceBlockStorageClient.BaseClient)
-		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
-		}
+		// copy the client of the provider, so that the source region client is configured the same way
+		sourceBlockStorageClient := *s.Client
 		s.SourceRegionClient = &sourceBlockStorageClient
 	}
 	s.SourceRegionClient.SetRegion(region)
@@ -108,14 +100,8 @@ func (s *CoreVolumeBackupResourceCrud) createBlockStorageSourceRegionClient(regi
 
 func (s *CoreVolumeGroupBackupResourceCrud) createBlockStorageSourceRegionClient(region string) error {
 	if s.SourceRegionClient == nil {
-		sourceBlockStorageClient, err := oci_core.NewBlockstorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
-		if err != nil {
-			return fmt.Errorf("cannot Create client for the source region: %v", err)
-		}
-		err = tf_client.ConfigureClientVar(&sourceBlockStorageClient.BaseClient)
-		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
-		}
+		// copy the client of the provider, so that the source region client is configured the same way
+		sourceBlockStorageClient := *s.Client
 		s.SourceRegionClient = &sourceBlockStorageClient
 	}
 	s.SourceRegionClient.SetRegion(region)
@@ -125,14 +111,8 @@ func (s *CoreVolumeGroupBackupResourceCrud) createBlockStorageSourceRegionClient
 
 func (s *CoreBootVolumeBackupResourceCrud) createBlockStorageSourceRegionClient(region string) error {
 	if s.SourceRegionClient == nil {
-		sourceBlockStorageClient, err := oci_core.NewBlockstorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
-		if err != nil {
-			return fmt.Errorf("cannot Create client for the source region: %v", err)
-		}
-		err = tf_client.ConfigureClientVar(&sourceBlockStorageClient.BaseClient)
-		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
-		}
+		// copy the client of the provider, so that the source region client is configured the same way
+		sourceBlockStorageClient := *s.Client
 		s.SourceRegionClient = &sourceBlockStorageClient
 	}
 	s.SourceRegionClient.SetRegion(region)
@@ -6,6 +6,7 @@ package data_connectivity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 
@@ -15,7 +16,7 @@ import (
 
 func DataConnectivityRegistriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistries,
+		ReadContext: readDataConnectivityRegistries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func DataConnectivityRegistriesDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistries(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.ListRegistriesResponse
 }
@@ -92,9 +93,9 @@ func (s *DataConnectivityRegistriesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_data_connectivity.RegistryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.ListRegistries(context.Background(), request)
+	response, err := s.Client.ListRegistries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DataConnectivityRegistriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRegistries(context.Background(), request)
+		listResponse, err := s.Client.ListRegistries(s.Context(), request)
 		if err != nil {
 			return err
 		}
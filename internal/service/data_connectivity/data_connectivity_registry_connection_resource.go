@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -26,11 +27,11 @@ func DataConnectivityRegistryConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryConnection,
-		Read:     readDataConnectivityRegistryConnection,
-		Update:   updateDataConnectivityRegistryConnection,
-		Delete:   deleteDataConnectivityRegistryConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryConnection,
+		ReadContext:   readDataConnectivityRegistryConnection,
+		UpdateContext: updateDataConnectivityRegistryConnection,
+		DeleteContext: deleteDataConnectivityRegistryConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -537,37 +538,37 @@ func DataConnectivityRegistryConnectionResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataConnectivityRegistryConnectionResourceCrud struct {
@@ -697,9 +698,10 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Create() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -729,9 +731,9 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -856,9 +858,9 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Update() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -880,9 +882,9 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Delete() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteConnection(context.Background(), request)
+	_, err := s.Client.DeleteConnection(s.Context(), request)
 	return err
 }
 
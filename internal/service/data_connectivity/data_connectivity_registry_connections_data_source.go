@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
 
 func DataConnectivityRegistryConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryConnections,
+		ReadContext: readDataConnectivityRegistryConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"data_asset_key": {
@@ -63,16 +64,16 @@ func DataConnectivityRegistryConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryConnections(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.ListConnectionsResponse
 }
@@ -121,9 +122,9 @@ func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.ListConnections(context.Background(), request)
+	response, err := s.Client.ListConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +133,7 @@ func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnections(context.Background(), request)
+		listResponse, err := s.Client.ListConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
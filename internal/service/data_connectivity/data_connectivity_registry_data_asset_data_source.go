@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
@@ -26,16 +27,16 @@ func DataConnectivityRegistryDataAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryDataAssetResource(), fieldMap, readSingularDataConnectivityRegistryDataAsset)
 }
 
-func readSingularDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryDataAssetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.GetDataAssetResponse
 }
@@ -57,9 +58,9 @@ func (s *DataConnectivityRegistryDataAssetDataSourceCrud) Get() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -30,11 +31,11 @@ func DataConnectivityRegistryDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryDataAsset,
-		Read:     readDataConnectivityRegistryDataAsset,
-		Update:   updateDataConnectivityRegistryDataAsset,
-		Delete:   deleteDataConnectivityRegistryDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryDataAsset,
+		ReadContext:   readDataConnectivityRegistryDataAsset,
+		UpdateContext: updateDataConnectivityRegistryDataAsset,
+		DeleteContext: deleteDataConnectivityRegistryDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -1050,37 +1051,37 @@ func DataConnectivityRegistryDataAssetResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataConnectivityRegistryDataAssetResourceCrud struct {
@@ -1208,9 +1209,10 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Create() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1240,9 +1242,9 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1365,9 +1367,9 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Update() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1389,9 +1391,9 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Delete() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(s.Context(), request)
 	return err
 }
 
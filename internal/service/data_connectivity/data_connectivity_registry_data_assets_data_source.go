@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
 
 func DataConnectivityRegistryDataAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryDataAssets,
+		ReadContext: readDataConnectivityRegistryDataAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"endpoint_ids": {
@@ -91,16 +92,16 @@ func DataConnectivityRegistryDataAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryDataAssets(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryDataAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryDataAssetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.ListDataAssetsResponse
 }
@@ -196,9 +197,9 @@ func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +208,7 @@ func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
@@ -22,16 +23,16 @@ func DataConnectivityRegistryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryResource(), fieldMap, readSingularDataConnectivityRegistry)
 }
 
-func readSingularDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.GetRegistryResponse
 }
@@ -48,9 +49,9 @@ func (s *DataConnectivityRegistryDataSourceCrud) Get() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.GetRegistry(context.Background(), request)
+	response, err := s.Client.GetRegistry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func DataConnectivityRegistryFolderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryFolder,
-		Read:     readDataConnectivityRegistryFolder,
-		Update:   updateDataConnectivityRegistryFolder,
-		Delete:   deleteDataConnectivityRegistryFolder,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryFolder,
+		ReadContext:   readDataConnectivityRegistryFolder,
+		UpdateContext: updateDataConnectivityRegistryFolder,
+		DeleteContext: deleteDataConnectivityRegistryFolder,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -1119,37 +1120,37 @@ func DataConnectivityRegistryFolderResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataConnectivityRegistryFolderResourceCrud struct {
@@ -1222,9 +1223,10 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Create() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateFolder(context.Background(), request)
+	response, err := s.Client.CreateFolder(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1254,9 +1256,9 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetFolder(context.Background(), request)
+	response, err := s.Client.GetFolder(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1329,9 +1331,9 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Update() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateFolder(context.Background(), request)
+	response, err := s.Client.UpdateFolder(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1353,9 +1355,9 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Delete() error {
 		request.RegistryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteFolder(context.Background(), request)
+	_, err := s.Client.DeleteFolder(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
 
 func DataConnectivityRegistryFoldersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryFolders,
+		ReadContext: readDataConnectivityRegistryFolders,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"favorites_query_param": {
@@ -59,16 +60,16 @@ func DataConnectivityRegistryFoldersDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryFolders(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryFolders(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFoldersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryFoldersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.ListFoldersResponse
 }
@@ -112,9 +113,9 @@ func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.ListFolders(context.Background(), request)
+	response, err := s.Client.ListFolders(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -123,7 +124,7 @@ func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFolders(context.Background(), request)
+		listResponse, err := s.Client.ListFolders(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DataConnectivityRegistryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistry,
-		Read:     readDataConnectivityRegistry,
-		Update:   updateDataConnectivityRegistry,
-		Delete:   deleteDataConnectivityRegistry,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistry,
+		ReadContext:   readDataConnectivityRegistry,
+		UpdateContext: updateDataConnectivityRegistry,
+		DeleteContext: deleteDataConnectivityRegistry,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -86,37 +87,37 @@ func DataConnectivityRegistryResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataConnectivityRegistryResourceCrud struct {
@@ -184,22 +185,23 @@ func (s *DataConnectivityRegistryResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateRegistry(context.Background(), request)
+	response, err := s.Client.CreateRegistry(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataConnectivityRegistryResourceCrud) getRegistryFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_connectivity.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	registryId, err := registryWaitForWorkRequest(workId, "dcmsregistry",
+	registryId, err := registryWaitForWorkRequest(s.Context(), workId, "dcmsregistry",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -233,9 +235,9 @@ func registryWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum,
+func registryWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_connectivity.DataConnectivityManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_connectivity")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_connectivity")
 	retryPolicy.ShouldRetryOperation = registryWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_connectivity.GetWorkRequestResponse{}
@@ -252,7 +254,7 @@ func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_connectivity.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -264,7 +266,7 @@ func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -281,14 +283,14 @@ func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_connectivity.WorkRequestStatusFailed || response.Status == oci_data_connectivity.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataConnectivityRegistryWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataConnectivityRegistryWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataConnectivityRegistryWorkRequest(client *oci_data_connectivity.DataConnectivityManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataConnectivityRegistryWorkRequest(ctx context.Context, client *oci_data_connectivity.DataConnectivityManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_connectivity.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -316,9 +318,9 @@ func (s *DataConnectivityRegistryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RegistryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetRegistry(context.Background(), request)
+	response, err := s.Client.GetRegistry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -364,15 +366,15 @@ func (s *DataConnectivityRegistryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RegistryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateRegistry(context.Background(), request)
+	response, err := s.Client.UpdateRegistry(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataConnectivityRegistryResourceCrud) Delete() error {
@@ -386,16 +388,16 @@ func (s *DataConnectivityRegistryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RegistryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.DeleteRegistry(context.Background(), request)
+	response, err := s.Client.DeleteRegistry(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := registryWaitForWorkRequest(workId, "dcmsregistry",
+	_, delWorkRequestErr := registryWaitForWorkRequest(s.Context(), workId, "dcmsregistry",
 		oci_data_connectivity.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -495,13 +497,13 @@ func (s *DataConnectivityRegistryResourceCrud) updateCompartment(compartment int
 	idTmp := s.D.Id()
 	changeCompartmentRequest.RegistryId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.ChangeRegistryCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeRegistryCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
 
 func DataConnectivityRegistryTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataConnectivityRegistryType,
+		ReadContext: readSingularDataConnectivityRegistryType,
 		Schema: map[string]*schema.Schema{
 			"fields": {
 				Type:     schema.TypeList,
@@ -86,16 +87,16 @@ func DataConnectivityRegistryTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataConnectivityRegistryType(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryTypeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.GetTypeResponse
 }
@@ -130,9 +131,9 @@ func (s *DataConnectivityRegistryTypeDataSourceCrud) Get() error {
 		request.TypeKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.GetType(context.Background(), request)
+	response, err := s.Client.GetType(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v61/dataconnectivity"
 )
 
 func DataConnectivityRegistryTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryTypes,
+		ReadContext: readDataConnectivityRegistryTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -113,16 +114,16 @@ func DataConnectivityRegistryTypesDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryTypes(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataConnectivityRegistryTypesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_connectivity.DataConnectivityManagementClient
 	Res    *oci_data_connectivity.ListTypesResponse
 }
@@ -149,9 +150,9 @@ func (s *DataConnectivityRegistryTypesDataSourceCrud) Get() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_connectivity")
 
-	response, err := s.Client.ListTypes(context.Background(), request)
+	response, err := s.Client.ListTypes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -160,7 +161,7 @@ func (s *DataConnectivityRegistryTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTypes(context.Background(), request)
+		listResponse, err := s.Client.ListTypes(s.Context(), request)
 		if err != nil {
 			return err
 		}
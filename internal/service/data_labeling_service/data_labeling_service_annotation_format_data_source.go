@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v61/datalabelingservice"
 )
 
 func DataLabelingServiceAnnotationFormatDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataLabelingServiceAnnotationFormat,
+		ReadContext: readSingularDataLabelingServiceAnnotationFormat,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -43,16 +44,16 @@ func DataLabelingServiceAnnotationFormatDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataLabelingServiceAnnotationFormat(d *schema.ResourceData, m interface{}) error {
+func readSingularDataLabelingServiceAnnotationFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceAnnotationFormatDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataLabelingServiceAnnotationFormatDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_labeling_service.DataLabelingManagementClient
 	Res    *oci_data_labeling_service.ListAnnotationFormatsResponse
 }
@@ -69,9 +70,9 @@ func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(s.Context(), request)
 	if err != nil {
 		return err
 	}
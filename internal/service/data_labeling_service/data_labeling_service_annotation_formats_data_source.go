@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v61/datalabelingservice"
 )
 
 func DataLabelingServiceAnnotationFormatsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataLabelingServiceAnnotationFormats,
+		ReadContext: readDataLabelingServiceAnnotationFormats,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func DataLabelingServiceAnnotationFormatsDataSource() *schema.Resource {
 	}
 }
 
-func readDataLabelingServiceAnnotationFormats(d *schema.ResourceData, m interface{}) error {
+func readDataLabelingServiceAnnotationFormats(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceAnnotationFormatsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataLabelingServiceAnnotationFormatsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_labeling_service.DataLabelingManagementClient
 	Res    *oci_data_labeling_service.ListAnnotationFormatsResponse
 }
@@ -77,9 +78,9 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnnotationFormats(context.Background(), request)
+		listResponse, err := s.Client.ListAnnotationFormats(s.Context(), request)
 		if err != nil {
 			return err
 		}
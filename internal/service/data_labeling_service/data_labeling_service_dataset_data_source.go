@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v61/datalabelingservice"
 )
@@ -22,16 +23,16 @@ func DataLabelingServiceDatasetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataLabelingServiceDatasetResource(), fieldMap, readSingularDataLabelingServiceDataset)
 }
 
-func readSingularDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func readSingularDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataLabelingServiceDatasetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_labeling_service.DataLabelingManagementClient
 	Res    *oci_data_labeling_service.GetDatasetResponse
 }
@@ -48,9 +49,9 @@ func (s *DataLabelingServiceDatasetDataSourceCrud) Get() error {
 		request.DatasetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DataLabelingServiceDatasetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataLabelingServiceDataset,
-		Read:     readDataLabelingServiceDataset,
-		Update:   updateDataLabelingServiceDataset,
-		Delete:   deleteDataLabelingServiceDataset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataLabelingServiceDataset,
+		ReadContext:   readDataLabelingServiceDataset,
+		UpdateContext: updateDataLabelingServiceDataset,
+		DeleteContext: deleteDataLabelingServiceDataset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"annotation_format": {
@@ -205,37 +206,37 @@ func DataLabelingServiceDatasetResource() *schema.Resource {
 	}
 }
 
-func createDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func createDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func readDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func updateDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func deleteDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataLabelingServiceDatasetResourceCrud struct {
@@ -358,22 +359,23 @@ func (s *DataLabelingServiceDatasetResourceCrud) Create() error {
 		request.LabelingInstructions = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.CreateDataset(context.Background(), request)
+	response, err := s.Client.CreateDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataLabelingServiceDatasetResourceCrud) getDatasetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_labeling_service.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	datasetId, err := datasetWaitForWorkRequest(workId, "dataset",
+	datasetId, err := datasetWaitForWorkRequest(s.Context(), workId, "dataset",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -407,9 +409,9 @@ func datasetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_labeling_service.ActionTypeEnum,
+func datasetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_labeling_service.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_labeling_service.DataLabelingManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_labeling_service")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_labeling_service")
 	retryPolicy.ShouldRetryOperation = datasetWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_labeling_service.GetWorkRequestResponse{}
@@ -426,7 +428,7 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_labeling_service.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -438,7 +440,7 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -455,14 +457,14 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_labeling_service.OperationStatusFailed || response.Status == oci_data_labeling_service.OperationStatusCanceled {
-		return nil, getErrorFromDataLabelingServiceDatasetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataLabelingServiceDatasetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataLabelingServiceDatasetWorkRequest(client *oci_data_labeling_service.DataLabelingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_labeling_service.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataLabelingServiceDatasetWorkRequest(ctx context.Context, client *oci_data_labeling_service.DataLabelingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_labeling_service.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_labeling_service.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -490,9 +492,9 @@ func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatasetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -561,9 +563,9 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 		request.LabelingInstructions = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.UpdateDataset(context.Background(), request)
+	response, err := s.Client.UpdateDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -578,16 +580,16 @@ func (s *DataLabelingServiceDatasetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatasetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.DeleteDataset(context.Background(), request)
+	response, err := s.Client.DeleteDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := datasetWaitForWorkRequest(workId, "dataset",
+	_, delWorkRequestErr := datasetWaitForWorkRequest(s.Context(), workId, "dataset",
 		oci_data_labeling_service.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -920,15 +922,15 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateCompartment(compartment i
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DatasetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.ChangeDatasetCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatasetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, newLabelSet oci_data_labeling_service.LabelSet) error {
@@ -952,16 +954,16 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		idTmp := s.D.Id()
 		addDatasetLabelsRequest.DatasetId = &idTmp
 
-		addDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+		addDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 		addDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffAdd}
 
-		response, err := s.Client.AddDatasetLabels(context.Background(), addDatasetLabelsRequest)
+		response, err := s.Client.AddDatasetLabels(s.Context(), addDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
-		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -986,16 +988,16 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		idTmp := s.D.Id()
 		removeDatasetLabelsRequest.DatasetId = &idTmp
 
-		removeDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
+		removeDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service")
 		removeDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffRemove}
 
-		response, err := s.Client.RemoveDatasetLabels(context.Background(), removeDatasetLabelsRequest)
+		response, err := s.Client.RemoveDatasetLabels(s.Context(), removeDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
-		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v61/datalabelingservice"
 )
 
 func DataLabelingServiceDatasetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataLabelingServiceDatasets,
+		ReadContext: readDataLabelingServiceDatasets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"annotation_format": {
@@ -56,16 +57,16 @@ func DataLabelingServiceDatasetsDataSource() *schema.Resource {
 	}
 }
 
-func readDataLabelingServiceDatasets(d *schema.ResourceData, m interface{}) error {
+func readDataLabelingServiceDatasets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataLabelingServiceDatasetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_labeling_service.DataLabelingManagementClient
 	Res    *oci_data_labeling_service.ListDatasetsResponse
 }
@@ -101,9 +102,9 @@ func (s *DataLabelingServiceDatasetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_data_labeling_service.DatasetLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_labeling_service")
 
-	response, err := s.Client.ListDatasets(context.Background(), request)
+	response, err := s.Client.ListDatasets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DataLabelingServiceDatasetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatasets(context.Background(), request)
+		listResponse, err := s.Client.ListDatasets(s.Context(), request)
 		if err != nil {
 			return err
 		}
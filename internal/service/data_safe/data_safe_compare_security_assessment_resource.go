@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeCompareSecurityAssessmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeCompareSecurityAssessment,
-		Read:     readDataSafeCompareSecurityAssessment,
-		Delete:   deleteDataSafeCompareSecurityAssessment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeCompareSecurityAssessment,
+		ReadContext:   readDataSafeCompareSecurityAssessment,
+		DeleteContext: deleteDataSafeCompareSecurityAssessment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"comparison_security_assessment_id": {
@@ -48,19 +49,19 @@ func DataSafeCompareSecurityAssessmentResource() *schema.Resource {
 	}
 }
 
-func createDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func createDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeCompareSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func readDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -88,9 +89,9 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get() error {
 		request.SecurityAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,22 +113,23 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Create() error {
 		request.SecurityAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CompareSecurityAssessment(context.Background(), request)
+	response, err := s.Client.CompareSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getCompareSecurityAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getCompareSecurityAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataSafeCompareSecurityAssessmentResourceCrud) getCompareSecurityAssessmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	compareSecurityAssessmentId, err := compareSecurityAssessmentWaitForWorkRequest(workId, "securityassessment",
+	compareSecurityAssessmentId, err := compareSecurityAssessmentWaitForWorkRequest(s.Context(), workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -161,9 +163,9 @@ func compareSecurityAssessmentWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func compareSecurityAssessmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = compareSecurityAssessmentWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_safe.GetWorkRequestResponse{}
@@ -178,7 +180,7 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -190,7 +192,7 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -207,14 +209,14 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeCompareUserAssessmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeCompareUserAssessment,
-		Read:     readDataSafeCompareUserAssessment,
-		Delete:   deleteDataSafeCompareUserAssessment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeCompareUserAssessment,
+		ReadContext:   readDataSafeCompareUserAssessment,
+		DeleteContext: deleteDataSafeCompareUserAssessment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"comparison_user_assessment_id": {
@@ -48,19 +49,19 @@ func DataSafeCompareUserAssessmentResource() *schema.Resource {
 	}
 }
 
-func createDataSafeCompareUserAssessment(d *schema.ResourceData, m interface{}) error {
+func createDataSafeCompareUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeCompareUserAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataSafeCompareUserAssessment(d *schema.ResourceData, m interface{}) error {
+func readDataSafeCompareUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeCompareUserAssessment(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeCompareUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -88,9 +89,9 @@ func (s *DataSafeCompareUserAssessmentResourceCrud) Get() error {
 		request.UserAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetUserAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetUserAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,22 +113,23 @@ func (s *DataSafeCompareUserAssessmentResourceCrud) Create() error {
 		request.UserAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CompareUserAssessment(context.Background(), request)
+	response, err := s.Client.CompareUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getCompareUserAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getCompareUserAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataSafeCompareUserAssessmentResourceCrud) getCompareUserAssessmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	compareUserAssessmentId, err := compareUserAssessmentWaitForWorkRequest(workId, "userassessment",
+	compareUserAssessmentId, err := compareUserAssessmentWaitForWorkRequest(s.Context(), workId, "userassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -161,9 +163,9 @@ func compareUserAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func
 	}
 }
 
-func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func compareUserAssessmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = compareUserAssessmentWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_safe.GetWorkRequestResponse{}
@@ -180,7 +182,7 @@ func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -192,7 +194,7 @@ func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -209,14 +211,14 @@ func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, act
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeCompareUserAssessmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeCompareUserAssessmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeCompareUserAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeCompareUserAssessmentWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
@@ -22,16 +23,16 @@ func DataSafeDataSafeConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeDataSafeConfigurationResource(), fieldMap, readSingularDataSafeDataSafeConfiguration)
 }
 
-func readSingularDataSafeDataSafeConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeDataSafeConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafeConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeDataSafeConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.GetDataSafeConfigurationResponse
 }
@@ -48,9 +49,9 @@ func (s *DataSafeDataSafeConfigurationDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.GetDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
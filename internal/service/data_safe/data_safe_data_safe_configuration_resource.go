@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -22,11 +23,11 @@ import (
 
 func DataSafeDataSafeConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeDataSafeConfiguration,
-		Read:     readDataSafeDataSafeConfiguration,
-		Update:   updateDataSafeDataSafeConfiguration,
-		Delete:   deleteDataSafeDataSafeConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeDataSafeConfiguration,
+		ReadContext:   readDataSafeDataSafeConfiguration,
+		UpdateContext: updateDataSafeDataSafeConfiguration,
+		DeleteContext: deleteDataSafeDataSafeConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"is_enabled": {
@@ -58,31 +59,31 @@ func DataSafeDataSafeConfigurationResource() *schema.Resource {
 	}
 }
 
-func createDataSafeDataSafeConfiguration(d *schema.ResourceData, m interface{}) error {
+func createDataSafeDataSafeConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafeConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataSafeDataSafeConfiguration(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDataSafeConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafeConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataSafeDataSafeConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeDataSafeConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafeConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataSafeDataSafeConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeDataSafeConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -134,22 +135,22 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Create() error {
 		request.IsEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.EnableDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.EnableDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafeConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDataSafeConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataSafeDataSafeConfigurationResourceCrud) getDataSafeConfigurationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dataSafeConfigurationId, err := dataSafeConfigurationWaitForWorkRequest(workId, "configuration",
+	dataSafeConfigurationId, err := dataSafeConfigurationWaitForWorkRequest(s.Context(), workId, "configuration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -184,9 +185,9 @@ func dataSafeConfigurationWorkRequestShouldRetryFunc(timeout time.Duration) func
 	}
 }
 
-func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func dataSafeConfigurationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = dataSafeConfigurationWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_safe.GetWorkRequestResponse{}
@@ -201,7 +202,7 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -213,7 +214,7 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -229,13 +230,13 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 	}
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeDataSafeConfigurationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeDataSafeConfigurationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 	return identifier, nil
 }
 
-func getErrorFromDataSafeDataSafeConfigurationWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeDataSafeConfigurationWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -265,9 +266,9 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.GetDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -289,15 +290,15 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Update() error {
 		request.IsEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.EnableDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.EnableDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafeConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSafeConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataSafeDataSafeConfigurationResourceCrud) SetData() error {
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
@@ -22,16 +23,16 @@ func DataSafeDataSafePrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeDataSafePrivateEndpointResource(), fieldMap, readSingularDataSafeDataSafePrivateEndpoint)
 }
 
-func readSingularDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeDataSafePrivateEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.GetDataSafePrivateEndpointResponse
 }
@@ -48,9 +49,9 @@ func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) Get() error {
 		request.DataSafePrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
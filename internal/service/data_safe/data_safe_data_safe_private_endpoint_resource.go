@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func DataSafeDataSafePrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeDataSafePrivateEndpoint,
-		Read:     readDataSafeDataSafePrivateEndpoint,
-		Update:   updateDataSafeDataSafePrivateEndpoint,
-		Delete:   deleteDataSafeDataSafePrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeDataSafePrivateEndpoint,
+		ReadContext:   readDataSafeDataSafePrivateEndpoint,
+		UpdateContext: updateDataSafeDataSafePrivateEndpoint,
+		DeleteContext: deleteDataSafeDataSafePrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -112,37 +113,37 @@ func DataSafeDataSafePrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataSafeDataSafePrivateEndpointResourceCrud struct {
@@ -239,22 +240,23 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataSafeDataSafePrivateEndpointResourceCrud) getDataSafePrivateEndpointFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dataSafePrivateEndpointId, err := dataSafePrivateEndpointWaitForWorkRequest(workId, "datasafeprivateendpoints",
+	dataSafePrivateEndpointId, err := dataSafePrivateEndpointWaitForWorkRequest(s.Context(), workId, "datasafeprivateendpoints",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -289,9 +291,9 @@ func dataSafePrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func dataSafePrivateEndpointWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = dataSafePrivateEndpointWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_safe.GetWorkRequestResponse{}
@@ -306,7 +308,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -318,7 +320,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -335,14 +337,14 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -370,9 +372,9 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DataSafePrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -432,15 +434,15 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
@@ -449,16 +451,16 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataSafePrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := dataSafePrivateEndpointWaitForWorkRequest(workId, "datasafeprivateendpoints",
+	_, delWorkRequestErr := dataSafePrivateEndpointWaitForWorkRequest(s.Context(), workId, "datasafeprivateendpoints",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -530,13 +532,13 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) updateCompartment(compartm
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DataSafePrivateEndpointId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datasafeprivateendpoints"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datasafeprivateendpoints"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
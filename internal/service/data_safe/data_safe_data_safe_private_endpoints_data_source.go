@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeDataSafePrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeDataSafePrivateEndpoints,
+		ReadContext: readDataSafeDataSafePrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -51,16 +52,16 @@ func DataSafeDataSafePrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeDataSafePrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDataSafePrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeDataSafePrivateEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListDataSafePrivateEndpointsResponse
 }
@@ -100,9 +101,9 @@ func (s *DataSafeDataSafePrivateEndpointsDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListDataSafePrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDataSafePrivateEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DataSafeDataSafePrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataSafePrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDataSafePrivateEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeListUserGrantsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeListUserGrants,
+		ReadContext: readDataSafeListUserGrants,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"depth_level": {
@@ -91,16 +92,16 @@ func DataSafeListUserGrantsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeListUserGrants(d *schema.ResourceData, m interface{}) error {
+func readDataSafeListUserGrants(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeListUserGrantsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeListUserGrantsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListGrantsResponse
 }
@@ -157,9 +158,9 @@ func (s *DataSafeListUserGrantsDataSourceCrud) Get() error {
 		request.UserKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListGrants(context.Background(), request)
+	response, err := s.Client.ListGrants(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *DataSafeListUserGrantsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGrants(context.Background(), request)
+		listResponse, err := s.Client.ListGrants(s.Context(), request)
 		if err != nil {
 			return err
 		}
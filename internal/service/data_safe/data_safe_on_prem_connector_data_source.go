@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
@@ -22,16 +23,16 @@ func DataSafeOnPremConnectorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeOnPremConnectorResource(), fieldMap, readSingularDataSafeOnPremConnector)
 }
 
-func readSingularDataSafeOnPremConnector(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeOnPremConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeOnPremConnectorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeOnPremConnectorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.GetOnPremConnectorResponse
 }
@@ -48,9 +49,9 @@ func (s *DataSafeOnPremConnectorDataSourceCrud) Get() error {
 		request.OnPremConnectorId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetOnPremConnector(context.Background(), request)
+	response, err := s.Client.GetOnPremConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeOnPremConnectorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeOnPremConnectors,
+		ReadContext: readDataSafeOnPremConnectors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -51,16 +52,16 @@ func DataSafeOnPremConnectorsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeOnPremConnectors(d *schema.ResourceData, m interface{}) error {
+func readDataSafeOnPremConnectors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeOnPremConnectorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeOnPremConnectorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListOnPremConnectorsResponse
 }
@@ -100,9 +101,9 @@ func (s *DataSafeOnPremConnectorsDataSourceCrud) Get() error {
 		request.OnPremConnectorLifecycleState = oci_data_safe.ListOnPremConnectorsOnPremConnectorLifecycleStateEnum(onPremConnectorLifecycleState.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListOnPremConnectors(context.Background(), request)
+	response, err := s.Client.ListOnPremConnectors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DataSafeOnPremConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOnPremConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListOnPremConnectors(s.Context(), request)
 		if err != nil {
 			return err
 		}
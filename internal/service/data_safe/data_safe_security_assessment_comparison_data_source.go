@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeSecurityAssessmentComparisonDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeSecurityAssessmentComparison,
+		ReadContext: readSingularDataSafeSecurityAssessmentComparison,
 		Schema: map[string]*schema.Schema{
 			"comparison_security_assessment_id": {
 				Type:     schema.TypeString,
@@ -1239,16 +1240,16 @@ func DataSafeSecurityAssessmentComparisonDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeSecurityAssessmentComparison(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeSecurityAssessmentComparison(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentComparisonDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentComparisonDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.GetSecurityAssessmentComparisonResponse
 }
@@ -1270,9 +1271,9 @@ func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) Get() error {
 		request.SecurityAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeSecurityAssessmentFindingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSecurityAssessmentFindings,
+		ReadContext: readDataSafeSecurityAssessmentFindings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -115,16 +116,16 @@ func DataSafeSecurityAssessmentFindingsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSecurityAssessmentFindings(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSecurityAssessmentFindings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentFindingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentFindingsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListFindingsResponse
 }
@@ -159,9 +160,9 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 		request.Severity = oci_data_safe.ListFindingsSeverityEnum(severity.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListFindings(context.Background(), request)
+	response, err := s.Client.ListFindings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFindings(context.Background(), request)
+		listResponse, err := s.Client.ListFindings(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeSecurityAssessmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSecurityAssessments,
+		ReadContext: readDataSafeSecurityAssessments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -81,16 +82,16 @@ func DataSafeSecurityAssessmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSecurityAssessments(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSecurityAssessments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListSecurityAssessmentsResponse
 }
@@ -169,9 +170,9 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 		request.Type = oci_data_safe.ListSecurityAssessmentsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListSecurityAssessments(context.Background(), request)
+	response, err := s.Client.ListSecurityAssessments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecurityAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListSecurityAssessments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeSetSecurityAssessmentBaselineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeSetSecurityAssessmentBaseline,
-		Read:     readDataSafeSetSecurityAssessmentBaseline,
-		Delete:   deleteDataSafeSetSecurityAssessmentBaseline,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeSetSecurityAssessmentBaseline,
+		ReadContext:   readDataSafeSetSecurityAssessmentBaseline,
+		DeleteContext: deleteDataSafeSetSecurityAssessmentBaseline,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"security_assessment_id": {
@@ -52,19 +53,19 @@ func DataSafeSetSecurityAssessmentBaselineResource() *schema.Resource {
 	}
 }
 
-func createDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func createDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSetSecurityAssessmentBaselineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -102,15 +103,16 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Create() error {
 		request.SecurityAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSetSecurityAssessmentBaselineFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSetSecurityAssessmentBaselineFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
@@ -135,9 +137,9 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
 		request.SecurityAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -150,7 +152,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) getSetSecurityAssess
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	setSecurityAssessmentBaselineId, err := setSecurityAssessmentBaselineWaitForWorkRequest(workId, "securityassessment",
+	setSecurityAssessmentBaselineId, err := setSecurityAssessmentBaselineWaitForWorkRequest(s.Context(), workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -184,9 +186,9 @@ func setSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout time.Durati
 	}
 }
 
-func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func setSecurityAssessmentBaselineWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = setSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_data_safe.GetWorkRequestResponse{}
@@ -201,7 +203,7 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -213,7 +215,7 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -230,14 +232,14 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
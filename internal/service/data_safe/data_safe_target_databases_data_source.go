@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeTargetDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabases,
+		ReadContext: readDataSafeTargetDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -59,16 +60,16 @@ func DataSafeTargetDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabases(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeTargetDatabasesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListTargetDatabasesResponse
 }
@@ -116,9 +117,9 @@ func (s *DataSafeTargetDatabasesDataSourceCrud) Get() error {
 		request.TargetDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListTargetDatabases(context.Background(), request)
+	response, err := s.Client.ListTargetDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *DataSafeTargetDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTargetDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListTargetDatabases(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
 )
 
 func DataSafeUserAssessmentComparisonDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeUserAssessmentComparison,
+		ReadContext: readSingularDataSafeUserAssessmentComparison,
 		Schema: map[string]*schema.Schema{
 			"comparison_user_assessment_id": {
 				Type:     schema.TypeString,
@@ -82,12 +83,12 @@ func DataSafeUserAssessmentComparisonDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeUserAssessmentComparison(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeUserAssessmentComparison(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentComparisonDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeUserAssessmentComparisonDataSourceCrud struct {
@@ -115,9 +116,9 @@ func (s *DataSafeUserAssessmentComparisonDataSourceCrud) Get() error {
 		request.UserAssessmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.GetUserAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetUserAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
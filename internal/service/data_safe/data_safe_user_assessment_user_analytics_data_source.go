@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeUserAssessmentUserAnalyticsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessmentUserAnalytics,
+		ReadContext: readDataSafeUserAssessmentUserAnalytics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -115,16 +116,16 @@ func DataSafeUserAssessmentUserAnalyticsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessmentUserAnalytics(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessmentUserAnalytics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentUserAnalyticsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeUserAssessmentUserAnalyticsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListUserAnalyticsResponse
 }
@@ -228,9 +229,9 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListUserAnalytics(context.Background(), request)
+	response, err := s.Client.ListUserAnalytics(s.Context(), request)
 	fmt.Println("response in get ****************", response)
 
 	if err != nil {
@@ -242,7 +243,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAnalytics(context.Background(), request)
+		listResponse, err := s.Client.ListUserAnalytics(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeUserAssessmentUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessmentUsers,
+		ReadContext: readDataSafeUserAssessmentUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -151,16 +152,16 @@ func DataSafeUserAssessmentUsersDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessmentUsers(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessmentUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeUserAssessmentUsersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListUsersResponse
 }
@@ -264,9 +265,9 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(s.Context(), request)
 		if err != nil {
 			return err
 		}
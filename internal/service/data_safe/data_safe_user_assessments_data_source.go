@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v61/datasafe"
@@ -19,7 +20,7 @@ import (
 
 func DataSafeUserAssessmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessments,
+		ReadContext: readDataSafeUserAssessments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -103,16 +104,16 @@ func DataSafeUserAssessmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessments(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataSafeUserAssessmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_data_safe.DataSafeClient
 	Res    *oci_data_safe.ListUserAssessmentsResponse
 }
@@ -191,9 +192,9 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 		request.Type = oci_data_safe.ListUserAssessmentsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "data_safe")
 
-	response, err := s.Client.ListUserAssessments(context.Background(), request)
+	response, err := s.Client.ListUserAssessments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListUserAssessments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -26,16 +27,16 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationDataSource() *schema
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousContainerDatabaseDataguardAssociationResource(), fieldMap, readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation)
 }
 
-func readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetAutonomousContainerDatabaseDataguardAssociationResponse
 }
@@ -57,9 +58,9 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud)
 		request.AutonomousContainerDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
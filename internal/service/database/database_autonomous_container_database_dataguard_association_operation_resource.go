@@ -11,6 +11,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -21,9 +22,9 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResource()
 			Create: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
-		Read:   readDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
-		Delete: deleteDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
+		CreateContext: createDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
+		ReadContext:   readDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
+		DeleteContext: deleteDatabaseAutonomousContainerDatabaseDataguardAssociationOperation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_container_database_dataguard_association_id": {
@@ -54,25 +55,25 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 	return fmt.Sprint(utils.GetStringHashcode(s.D.Get("autonomous_container_database_id").(string)))
 }
 
-func createDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousContainerDatabaseDataguardAssociationOperation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -110,15 +111,15 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			switchoverRequest := oci_database.SwitchoverAutonomousContainerDatabaseDataguardAssociationRequest{}
 			switchoverRequest.AutonomousContainerDatabaseDataguardAssociationId = &dataguardAssociationId
 			switchoverRequest.AutonomousContainerDatabaseId = &tmpId
-			switchoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+			switchoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 			switchoverRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.SwitchoverAutonomousContainerDatabaseDataguardAssociation(context.Background(), switchoverRequest)
+			response, err := s.Client.SwitchoverAutonomousContainerDatabaseDataguardAssociation(s.Context(), switchoverRequest)
 			if err != nil {
 				return err
 			}
 			workId := response.OpcWorkRequestId
 			if workId != nil {
-				_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+				_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 				if err != nil {
 					return err
 				}
@@ -128,15 +129,15 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			failoverRequest := oci_database.FailoverAutonomousContainerDatabaseDataguardAssociationRequest{}
 			failoverRequest.AutonomousContainerDatabaseDataguardAssociationId = &dataguardAssociationId
 			failoverRequest.AutonomousContainerDatabaseId = &tmpId
-			failoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+			failoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 			failoverRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.FailoverAutonomousContainerDatabaseDataguardAssociation(context.Background(), failoverRequest)
+			response, err := s.Client.FailoverAutonomousContainerDatabaseDataguardAssociation(s.Context(), failoverRequest)
 			if err != nil {
 				return err
 			}
 			workId := response.OpcWorkRequestId
 			if workId != nil {
-				_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+				_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 				if err != nil {
 					return err
 				}
@@ -146,15 +147,15 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			reinstateRequest := oci_database.ReinstateAutonomousContainerDatabaseDataguardAssociationRequest{}
 			reinstateRequest.AutonomousContainerDatabaseDataguardAssociationId = &dataguardAssociationId
 			reinstateRequest.AutonomousContainerDatabaseId = &tmpId
-			reinstateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+			reinstateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 			reinstateRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.ReinstateAutonomousContainerDatabaseDataguardAssociation(context.Background(), reinstateRequest)
+			response, err := s.Client.ReinstateAutonomousContainerDatabaseDataguardAssociation(s.Context(), reinstateRequest)
 			if err != nil {
 				return err
 			}
 			workId := response.OpcWorkRequestId
 			if workId != nil {
-				_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+				_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 				if err != nil {
 					return err
 				}
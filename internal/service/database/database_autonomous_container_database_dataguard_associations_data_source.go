@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousContainerDatabaseDataguardAssociations,
+		ReadContext: readDatabaseAutonomousContainerDatabaseDataguardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_container_database_id": {
@@ -31,16 +32,16 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSource() *schem
 	}
 }
 
-func readDatabaseAutonomousContainerDatabaseDataguardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabaseDataguardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousContainerDatabaseDataguardAssociationsResponse
 }
@@ -57,9 +58,9 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 		request.AutonomousContainerDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
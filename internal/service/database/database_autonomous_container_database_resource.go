@@ -12,6 +12,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -27,10 +28,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousContainerDatabase,
-		Read:   readDatabaseAutonomousContainerDatabase,
-		Update: updateDatabaseAutonomousContainerDatabase,
-		Delete: deleteDatabaseAutonomousContainerDatabase,
+		CreateContext: createDatabaseAutonomousContainerDatabase,
+		ReadContext:   readDatabaseAutonomousContainerDatabase,
+		UpdateContext: updateDatabaseAutonomousContainerDatabase,
+		DeleteContext: deleteDatabaseAutonomousContainerDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -550,59 +551,61 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok {
 		err := sync.RotateContainerDatabaseEncryptionKey()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	return nil
 }
 
-func readDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok && sync.D.HasChange("rotate_key_trigger") {
 		err := sync.RotateContainerDatabaseEncryptionKey()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseResourceCrud struct {
@@ -805,15 +808,16 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create() error {
 		request.StandbyMaintenanceBufferInDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -829,9 +833,9 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousContainerDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -903,16 +907,16 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 		request.StandbyMaintenanceBufferInDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -928,9 +932,9 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousContainerDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateAutonomousContainerDatabase(context.Background(), request)
+	_, err := s.Client.TerminateAutonomousContainerDatabase(s.Context(), request)
 	return err
 }
 
@@ -1325,14 +1329,14 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) updateCompartment(comp
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -1349,15 +1353,15 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) RotateContainerDatabas
 	tmp := s.D.Id()
 	request.AutonomousContainerDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
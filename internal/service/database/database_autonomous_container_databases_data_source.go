@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousContainerDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousContainerDatabases,
+		ReadContext: readDatabaseAutonomousContainerDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_exadata_infrastructure_id": {
@@ -63,16 +64,16 @@ func DatabaseAutonomousContainerDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousContainerDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabasesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousContainerDatabasesResponse
 }
@@ -127,9 +128,9 @@ func (s *DatabaseAutonomousContainerDatabasesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousContainerDatabaseSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousContainerDatabases(context.Background(), request)
+	response, err := s.Client.ListAutonomousContainerDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *DatabaseAutonomousContainerDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousContainerDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousContainerDatabases(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousContainerPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousContainerPatches,
+		ReadContext: readDatabaseAutonomousContainerPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_container_database_id": {
@@ -83,16 +84,16 @@ func DatabaseAutonomousContainerPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousContainerPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousContainerPatchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListContainerDatabasePatchesResponse
 }
@@ -114,9 +115,9 @@ func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListContainerDatabasePatches(context.Background(), request)
+	response, err := s.Client.ListContainerDatabasePatches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerDatabasePatches(context.Background(), request)
+		listResponse, err := s.Client.ListContainerDatabasePatches(s.Context(), request)
 		if err != nil {
 			return err
 		}
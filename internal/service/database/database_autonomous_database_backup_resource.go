@@ -12,6 +12,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -22,10 +23,10 @@ func DatabaseAutonomousDatabaseBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousDatabaseBackup,
-		Read:     readDatabaseAutonomousDatabaseBackup,
-		Delete:   deleteDatabaseAutonomousDatabaseBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousDatabaseBackup,
+		ReadContext:   readDatabaseAutonomousDatabaseBackup,
+		DeleteContext: deleteDatabaseAutonomousDatabaseBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_database_id": {
@@ -102,25 +103,25 @@ func DatabaseAutonomousDatabaseBackupResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -173,16 +174,17 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabaseBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -198,9 +200,9 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
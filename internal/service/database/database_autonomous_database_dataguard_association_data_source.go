@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousDatabaseDataguardAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousDatabaseDataguardAssociation,
+		ReadContext: readSingularDatabaseAutonomousDatabaseDataguardAssociation,
 		Schema: map[string]*schema.Schema{
 			"autonomous_database_dataguard_association_id": {
 				Type:     schema.TypeString,
@@ -74,16 +75,16 @@ func DatabaseAutonomousDatabaseDataguardAssociationDataSource() *schema.Resource
 	}
 }
 
-func readSingularDatabaseAutonomousDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetAutonomousDatabaseDataguardAssociationResponse
 }
@@ -105,9 +106,9 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud) Get() err
 		request.AutonomousDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseDataguardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousDatabaseDataguardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabaseDataguardAssociations,
+		ReadContext: readDatabaseAutonomousDatabaseDataguardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_database_id": {
@@ -103,16 +104,16 @@ func DatabaseAutonomousDatabaseDataguardAssociationsDataSource() *schema.Resourc
 	}
 }
 
-func readDatabaseAutonomousDatabaseDataguardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseDataguardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousDatabaseDataguardAssociationsResponse
 }
@@ -129,9 +130,9 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get() er
 		request.AutonomousDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseDataguardAssociations(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseDataguardAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseDataguardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseDataguardAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -22,16 +23,16 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementDataSource() *schema.Reso
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousDatabaseInstanceWalletManagementResource(), fieldMap, readSingularDatabaseAutonomousDatabaseInstanceWalletManagement)
 }
 
-func readSingularDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.AutonomousDatabaseWallet
 }
@@ -48,9 +49,9 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud) Get()
 		request.AutonomousDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -21,10 +22,10 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementResource() *schema.Resour
 			Update: tfresource.GetTimeoutDuration("20m"),
 			Delete: tfresource.GetTimeoutDuration("20m"),
 		},
-		Create: createDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Read:   readDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Update: updateDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Delete: deleteDatabaseAutonomousDatabaseInstanceWalletManagement,
+		CreateContext: createDatabaseAutonomousDatabaseInstanceWalletManagement,
+		ReadContext:   readDatabaseAutonomousDatabaseInstanceWalletManagement,
+		UpdateContext: updateDatabaseAutonomousDatabaseInstanceWalletManagement,
+		DeleteContext: deleteDatabaseAutonomousDatabaseInstanceWalletManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_database_id": {
@@ -52,31 +53,31 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementResource() *schema.Resour
 	}
 }
 
-func createDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -140,9 +141,9 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get() e
 		request.AutonomousDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -164,14 +165,14 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update(
 		request.ShouldRotate = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 	return s.Get()
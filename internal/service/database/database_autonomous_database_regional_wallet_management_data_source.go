@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -18,16 +19,16 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementDataSource() *schema.Reso
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousDatabaseRegionalWalletManagementResource(), fieldMap, readSingularDatabaseAutonomousDatabaseRegionalWalletManagement)
 }
 
-func readSingularDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.AutonomousDatabaseWallet
 }
@@ -39,9 +40,9 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) VoidS
 func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) Get() error {
 	request := oci_database.GetAutonomousDatabaseRegionalWalletRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
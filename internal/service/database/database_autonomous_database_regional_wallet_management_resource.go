@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -21,10 +22,10 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementResource() *schema.Resour
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Read:   readDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Update: updateDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Delete: deleteDatabaseAutonomousDatabaseRegionalWalletManagement,
+		CreateContext: createDatabaseAutonomousDatabaseRegionalWalletManagement,
+		ReadContext:   readDatabaseAutonomousDatabaseRegionalWalletManagement,
+		UpdateContext: updateDatabaseAutonomousDatabaseRegionalWalletManagement,
+		DeleteContext: deleteDatabaseAutonomousDatabaseRegionalWalletManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 
@@ -48,34 +49,34 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementResource() *schema.Resour
 	}
 }
 
-func createDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -133,9 +134,9 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Create(
 func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Get() error {
 	request := oci_database.GetAutonomousDatabaseRegionalWalletRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -152,14 +153,14 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Update(
 		request.ShouldRotate = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 	return s.Get()
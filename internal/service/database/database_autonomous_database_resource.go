@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousDatabase,
-		Read:   readDatabaseAutonomousDatabase,
-		Update: updateDatabaseAutonomousDatabase,
-		Delete: deleteDatabaseAutonomousDatabase,
+		CreateContext: createDatabaseAutonomousDatabase,
+		ReadContext:   readDatabaseAutonomousDatabase,
+		UpdateContext: updateDatabaseAutonomousDatabase,
+		DeleteContext: deleteDatabaseAutonomousDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -734,9 +735,10 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
@@ -773,14 +775,14 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		configDatabaseManagementStatus = oci_database.AutonomousDatabaseDatabaseManagementStatusEnum((databaseManagementStatus.(string)))
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok {
 		err := sync.RotateAutonomousDatabaseEncryptionKey()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
@@ -789,21 +791,21 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		if raw.(bool) {
 			err := sync.ShrinkAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable)
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
 
 	if isInactiveRequest {
-		return inactiveAutonomousDatabaseIfNeeded(d, sync)
+		return tfresource.ToDiagnostics(sync, inactiveAutonomousDatabaseIfNeeded(ctx, d, sync))
 	}
 
 	if configDataSafeStatus == oci_database.AutonomousDatabaseDataSafeStatusRegistered {
 		err := sync.updateDataSafeStatus(sync.D.Id(), oci_database.AutonomousDatabaseDataSafeStatusRegistered)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 	}
 
 	if configOpenMode == oci_database.UpdateAutonomousDatabaseDetailsOpenModeOnly || configPermissionLevel == oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelRestricted {
@@ -815,47 +817,48 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		}
 		err := sync.updateOpenModeAndPermission(sync.D.Id(), configOpenMode, configPermissionLevel)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 	}
 
 	if configDatabaseManagementStatus == oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled {
 		err := sync.updateAutonomousDatabaseManagementStatus(sync.D.Id(), oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 	}
 
 	if configOperationsInsightsStatus == oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled {
 		err := sync.updateOperationsInsightsStatus(sync.D.Id(), oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 	}
 
 	return nil
 }
 
-func readDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
 	err := sync.validateSwitchoverDatabase()
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if _, ok := sync.D.GetOkExists("is_shrink_only"); ok && sync.D.HasChange("is_shrink_only") {
@@ -863,7 +866,7 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		if !oldRaw.(bool) && newRaw.(bool) {
 			err = sync.ShrinkAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable)
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
@@ -883,44 +886,44 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 
 	if stateActive {
 		if err := sync.StartAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateAvailable); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok && sync.D.HasChange("rotate_key_trigger") {
 		err := sync.RotateAutonomousDatabaseEncryptionKey()
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if stateInactive {
 		if err := sync.StopAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	return nil
 }
 
-func deleteDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseAutonomousDatabaseResourceCrud struct {
@@ -986,16 +989,17 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1011,9 +1015,9 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1291,16 +1295,16 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1316,9 +1320,9 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousDatabase(s.Context(), request)
 	return err
 }
 
@@ -2818,15 +2822,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment i
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeAutonomousDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAutonomousDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -2843,15 +2847,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 			request.PdbAdminPassword = &tmp
 		}
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(s.Context(), request)
 
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -2864,15 +2868,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 			request.PdbAdminPassword = &tmp
 		}
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(s.Context(), request)
 
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -2891,15 +2895,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(dbVersion strin
 	tmp := s.D.Id()
 	changeDbVersionRequest.AutonomousDatabaseId = &tmp
 
-	changeDbVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeDbVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeDbVersionRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), changeDbVersionRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -2931,15 +2935,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(nsgIds []string) e
 	tmp := s.D.Id()
 	changeNsgIdsRequest.AutonomousDatabaseId = &tmp
 
-	changeNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeNsgIdsRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), changeNsgIdsRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3010,16 +3014,16 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.SwitchoverAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.SwitchoverAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3034,13 +3038,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 		request := oci_database.EnableAutonomousDatabaseOperationsInsightsRequest{}
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3051,13 +3055,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 		request := oci_database.DisableAutonomousDatabaseOperationsInsightsRequest{}
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3074,13 +3078,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 		request := oci_database.EnableAutonomousDatabaseManagementRequest{}
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseManagement(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+		response, err := s.Client.EnableAutonomousDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3090,13 +3094,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 	case oci_database.AutonomousDatabaseDatabaseManagementStatusNotEnabled:
 		request := oci_database.DisableAutonomousDatabaseManagementRequest{}
 		request.AutonomousDatabaseId = &autonomousDatabaseId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseManagement(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+		response, err := s.Client.DisableAutonomousDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3107,11 +3111,11 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 	}
 }
 
-func inactiveAutonomousDatabaseIfNeeded(d *schema.ResourceData, sync *DatabaseAutonomousDatabaseResourceCrud) error {
+func inactiveAutonomousDatabaseIfNeeded(ctx context.Context, d *schema.ResourceData, sync *DatabaseAutonomousDatabaseResourceCrud) error {
 	if err := sync.StopAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResourceContext(ctx, sync)
 }
 
 func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
@@ -3120,14 +3124,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(state o
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StartAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StartAutonomousDatabase(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
@@ -3136,14 +3140,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(state oc
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StopAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StopAutonomousDatabase(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
@@ -3152,14 +3156,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(state
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.ShrinkAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.ShrinkAutonomousDatabase(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(autonomousDatabaseId string, openMode oci_database.UpdateAutonomousDatabaseDetailsOpenModeEnum, permissionLevel oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum) error {
@@ -3188,16 +3192,16 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(aut
 			updateRequest.PermissionLevel = oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum(permissionLevel.(string))
 		}
 	}
-	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	updateResponse, err := s.Client.UpdateAutonomousDatabase(context.Background(), updateRequest)
+	updateResponse, err := s.Client.UpdateAutonomousDatabase(s.Context(), updateRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := updateResponse.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3215,15 +3219,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncrypt
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3240,20 +3244,20 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ConfigureAutonomousDatabaseVaul
 
 	request.AutonomousDatabaseId = &autonomousDatabaseId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
 	request.KmsKeyId = &kmsKeyId
 
 	request.VaultId = &vautlId
 
-	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(context.Background(), request)
+	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
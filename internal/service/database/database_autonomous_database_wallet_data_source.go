@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -18,7 +19,7 @@ import (
 
 func DatabaseAutonomousDatabaseWalletDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousDatabaseWallet,
+		ReadContext: readSingularDatabaseAutonomousDatabaseWallet,
 		Schema: map[string]*schema.Schema{
 			"autonomous_database_id": {
 				Type:       schema.TypeString,
@@ -50,16 +51,16 @@ func DatabaseAutonomousDatabaseWalletDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseWalletDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseWalletDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *[]byte
 }
@@ -85,9 +86,9 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
 		request.Password = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousDatabasesClonesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabasesClones,
+		ReadContext: readDatabaseAutonomousDatabasesClones,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_database_id": {
@@ -604,16 +605,16 @@ func DatabaseAutonomousDatabasesClonesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDatabasesClones(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabasesClones(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabasesClonesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDatabasesClonesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousDatabaseClonesResponse
 }
@@ -648,9 +649,9 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousDatabaseSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseClones(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -659,7 +660,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseClones(s.Context(), request)
 		if err != nil {
 			return err
 		}
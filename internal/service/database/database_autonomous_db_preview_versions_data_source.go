@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousDbPreviewVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDbPreviewVersions,
+		ReadContext: readDatabaseAutonomousDbPreviewVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func DatabaseAutonomousDbPreviewVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDbPreviewVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDbPreviewVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDbPreviewVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDbPreviewVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousDbPreviewVersionsResponse
 }
@@ -85,9 +86,9 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbPreviewVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousDbVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDbVersions,
+		ReadContext: readDatabaseAutonomousDbVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -75,16 +76,16 @@ func DatabaseAutonomousDbVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDbVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDbVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDbVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousDbVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousDbVersionsResponse
 }
@@ -105,9 +106,9 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 		request.DbWorkload = oci_database.AutonomousDatabaseSummaryDbWorkloadEnum(dbWorkload.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +117,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousExadataInfrastructureOcpuDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousExadataInfrastructureOcpu,
+		ReadContext: readSingularDatabaseAutonomousExadataInfrastructureOcpu,
 		Schema: map[string]*schema.Schema{
 			"autonomous_exadata_infrastructure_id": {
 				Type:     schema.TypeString,
@@ -55,16 +56,16 @@ func DatabaseAutonomousExadataInfrastructureOcpuDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousExadataInfrastructureOcpu(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousExadataInfrastructureOcpu(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetExadataInfrastructureOcpusResponse
 }
@@ -81,9 +82,9 @@ func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) Get() error
 		request.AutonomousExadataInfrastructureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetExadataInfrastructureOcpus(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructureOcpus(s.Context(), request)
 	if err != nil {
 		return err
 	}
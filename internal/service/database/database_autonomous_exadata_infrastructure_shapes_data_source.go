@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousExadataInfrastructureShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousExadataInfrastructureShapes,
+		ReadContext: readDatabaseAutonomousExadataInfrastructureShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -67,16 +68,16 @@ func DatabaseAutonomousExadataInfrastructureShapesDataSource() *schema.Resource
 	}
 }
 
-func readDatabaseAutonomousExadataInfrastructureShapes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousExadataInfrastructureShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListAutonomousExadataInfrastructureShapesResponse
 }
@@ -98,9 +99,9 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
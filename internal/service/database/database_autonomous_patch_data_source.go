@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseAutonomousPatchDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousPatch,
+		ReadContext: readSingularDatabaseAutonomousPatch,
 		Schema: map[string]*schema.Schema{
 			"autonomous_patch_id": {
 				Type:     schema.TypeString,
@@ -62,16 +63,16 @@ func DatabaseAutonomousPatchDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousPatch(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousPatchDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseAutonomousPatchDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetAutonomousPatchResponse
 }
@@ -88,9 +89,9 @@ func (s *DatabaseAutonomousPatchDataSourceCrud) Get() error {
 		request.AutonomousPatchId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetAutonomousPatch(context.Background(), request)
+	response, err := s.Client.GetAutonomousPatch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -19,11 +20,11 @@ func DatabaseAutonomousVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousVmCluster,
-		Read:     readDatabaseAutonomousVmCluster,
-		Update:   updateDatabaseAutonomousVmCluster,
-		Delete:   deleteDatabaseAutonomousVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousVmCluster,
+		ReadContext:   readDatabaseAutonomousVmCluster,
+		UpdateContext: updateDatabaseAutonomousVmCluster,
+		DeleteContext: deleteDatabaseAutonomousVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -127,37 +128,37 @@ func DatabaseAutonomousVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseAutonomousVmClusterResourceCrud struct {
@@ -258,9 +259,10 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Create() error {
 		request.VmClusterNetworkId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -275,9 +277,9 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -317,9 +319,9 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
 		request.LicenseModel = oci_database.UpdateAutonomousVmClusterDetailsLicenseModelEnum(licenseModel.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -334,9 +336,9 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousVmCluster(s.Context(), request)
 	return err
 }
 
@@ -427,14 +429,14 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) updateCompartment(compartment
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func DatabaseBackupDestinationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseBackupDestination,
-		Read:     readDatabaseBackupDestination,
-		Update:   updateDatabaseBackupDestination,
-		Delete:   deleteDatabaseBackupDestination,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseBackupDestination,
+		ReadContext:   readDatabaseBackupDestination,
+		UpdateContext: updateDatabaseBackupDestination,
+		DeleteContext: deleteDatabaseBackupDestination,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -181,40 +182,40 @@ func DatabaseBackupDestinationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func createDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseBackupDestinationResourceCrud struct {
@@ -256,9 +257,10 @@ func (s *DatabaseBackupDestinationResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackupDestination(context.Background(), request)
+	response, err := s.Client.CreateBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -273,9 +275,9 @@ func (s *DatabaseBackupDestinationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BackupDestinationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackupDestination(context.Background(), request)
+	response, err := s.Client.GetBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -339,9 +341,9 @@ func (s *DatabaseBackupDestinationResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateBackupDestination(context.Background(), request)
+	response, err := s.Client.UpdateBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -356,9 +358,9 @@ func (s *DatabaseBackupDestinationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupDestinationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackupDestination(context.Background(), request)
+	_, err := s.Client.DeleteBackupDestination(s.Context(), request)
 	return err
 }
 
@@ -646,16 +648,16 @@ func (s *DatabaseBackupDestinationResourceCrud) updateCompartment(compartment in
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeBackupDestinationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBackupDestinationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "backupDestination", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "backupDestination", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
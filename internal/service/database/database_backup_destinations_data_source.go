@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseBackupDestinationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseBackupDestinations,
+		ReadContext: readDatabaseBackupDestinations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func DatabaseBackupDestinationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseBackupDestinations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackupDestinations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseBackupDestinationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListBackupDestinationResponse
 }
@@ -66,9 +67,9 @@ func (s *DatabaseBackupDestinationsDataSourceCrud) Get() error {
 		request.Type = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListBackupDestination(context.Background(), request)
+	response, err := s.Client.ListBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *DatabaseBackupDestinationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackupDestination(context.Background(), request)
+		listResponse, err := s.Client.ListBackupDestination(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -25,9 +26,9 @@ func DatabaseBackupResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createDatabaseBackup,
-		Read:   readDatabaseBackup,
-		Delete: deleteDatabaseBackup,
+		CreateContext: createDatabaseBackup,
+		ReadContext:   readDatabaseBackup,
+		DeleteContext: deleteDatabaseBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database_id": {
@@ -96,29 +97,29 @@ func DatabaseBackupResource() *schema.Resource {
 	}
 }
 
-func createDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseBackupResourceCrud struct {
@@ -170,9 +171,10 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,9 +189,9 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,9 +206,9 @@ func (s *DatabaseBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(s.Context(), request)
 	return err
 }
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseBackups,
+		ReadContext: readDatabaseBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -36,16 +37,16 @@ func DatabaseBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseBackups(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListBackupsResponse
 }
@@ -67,9 +68,9 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 		request.DatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -78,7 +79,7 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
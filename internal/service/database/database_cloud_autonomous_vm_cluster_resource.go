@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func DatabaseCloudAutonomousVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseCloudAutonomousVmCluster,
-		Read:     readDatabaseCloudAutonomousVmCluster,
-		Update:   updateDatabaseCloudAutonomousVmCluster,
-		Delete:   deleteDatabaseCloudAutonomousVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseCloudAutonomousVmCluster,
+		ReadContext:   readDatabaseCloudAutonomousVmCluster,
+		UpdateContext: updateDatabaseCloudAutonomousVmCluster,
+		DeleteContext: deleteDatabaseCloudAutonomousVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloud_exadata_infrastructure_id": {
@@ -161,14 +162,15 @@ func DatabaseCloudAutonomousVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if rotateOrdsCertsTrigger, ok := sync.D.GetOkExists("rotate_ords_certs_trigger"); ok {
@@ -176,7 +178,7 @@ func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 		if tmp {
 			err := sync.RotateAutonomousCloudVmClusterOrdsCerts()
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
@@ -186,7 +188,7 @@ func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 		if tmp {
 			err := sync.RotateAutonomousCloudVmClusterSslCerts()
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
@@ -194,17 +196,18 @@ func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func readDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
@@ -213,7 +216,7 @@ func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 		if tmp {
 			err := sync.RotateAutonomousCloudVmClusterOrdsCerts()
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
@@ -223,22 +226,22 @@ func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 		if tmp {
 			err := sync.RotateAutonomousCloudVmClusterSslCerts()
 			if err != nil {
-				return err
+				return tfresource.ToDiagnostics(sync, err)
 			}
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseCloudAutonomousVmClusterResourceCrud struct {
@@ -335,9 +338,10 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
+	tfresource.SetCreateRetryToken(s.Context(), &request)
 	request.RequestMetadata.RetryPolicy = nil //tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -346,7 +350,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -364,9 +368,9 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CloudAutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -430,9 +434,9 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -440,7 +444,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -455,16 +459,16 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CloudAutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.DeleteCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -586,16 +590,16 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(compart
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -609,15 +613,15 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 	tmp := s.D.Id()
 	request.CloudAutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -631,15 +635,15 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 	tmp := s.D.Id()
 	request.CloudAutonomousVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
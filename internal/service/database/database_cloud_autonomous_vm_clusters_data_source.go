@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseCloudAutonomousVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudAutonomousVmClusters,
+		ReadContext: readDatabaseCloudAutonomousVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,16 +48,16 @@ func DatabaseCloudAutonomousVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudAutonomousVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudAutonomousVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseCloudAutonomousVmClustersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListCloudAutonomousVmClustersResponse
 }
@@ -92,9 +93,9 @@ func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.CloudAutonomousVmClusterSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListCloudAutonomousVmClusters(context.Background(), request)
+	response, err := s.Client.ListCloudAutonomousVmClusters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudAutonomousVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListCloudAutonomousVmClusters(s.Context(), request)
 		if err != nil {
 			return err
 		}
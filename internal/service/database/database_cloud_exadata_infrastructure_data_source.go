@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -22,16 +23,16 @@ func DatabaseCloudExadataInfrastructureDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseCloudExadataInfrastructureResource(), fieldMap, readSingularDatabaseCloudExadataInfrastructure)
 }
 
-func readSingularDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseCloudExadataInfrastructureDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetCloudExadataInfrastructureResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseCloudExadataInfrastructureDataSourceCrud) Get() error {
 		request.CloudExadataInfrastructureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -25,10 +26,10 @@ func DatabaseCloudExadataInfrastructureResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseCloudExadataInfrastructure,
-		Read:   readDatabaseCloudExadataInfrastructure,
-		Update: updateDatabaseCloudExadataInfrastructure,
-		Delete: deleteDatabaseCloudExadataInfrastructure,
+		CreateContext: createDatabaseCloudExadataInfrastructure,
+		ReadContext:   readDatabaseCloudExadataInfrastructure,
+		UpdateContext: updateDatabaseCloudExadataInfrastructure,
+		DeleteContext: deleteDatabaseCloudExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -224,37 +225,37 @@ func DatabaseCloudExadataInfrastructureResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseCloudExadataInfrastructureResourceCrud struct {
@@ -378,9 +379,10 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create() error {
 		request.StorageCount = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -395,9 +397,9 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CloudExadataInfrastructureId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -476,9 +478,9 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
 		request.StorageCount = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -498,9 +500,9 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete() error {
 		request.IsDeleteVmClusters = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteCloudExadataInfrastructure(s.Context(), request)
 	return err
 }
 
@@ -713,14 +715,14 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) updateCompartment(compa
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseCloudExadataInfrastructuresDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudExadataInfrastructures,
+		ReadContext: readDatabaseCloudExadataInfrastructures,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func DatabaseCloudExadataInfrastructuresDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudExadataInfrastructures(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudExadataInfrastructures(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructuresDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseCloudExadataInfrastructuresDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListCloudExadataInfrastructuresResponse
 }
@@ -74,9 +75,9 @@ func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.CloudExadataInfrastructureSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListCloudExadataInfrastructures(context.Background(), request)
+	response, err := s.Client.ListCloudExadataInfrastructures(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudExadataInfrastructures(context.Background(), request)
+		listResponse, err := s.Client.ListCloudExadataInfrastructures(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -25,10 +26,10 @@ func DatabaseCloudVmClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseCloudVmCluster,
-		Read:   readDatabaseCloudVmCluster,
-		Update: updateDatabaseCloudVmCluster,
-		Delete: deleteDatabaseCloudVmCluster,
+		CreateContext: createDatabaseCloudVmCluster,
+		ReadContext:   readDatabaseCloudVmCluster,
+		UpdateContext: updateDatabaseCloudVmCluster,
+		DeleteContext: deleteDatabaseCloudVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"backup_subnet_id": {
@@ -299,37 +300,37 @@ func DatabaseCloudVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseCloudVmClusterResourceCrud struct {
@@ -534,9 +535,10 @@ func (s *DatabaseCloudVmClusterResourceCrud) Create() error {
 		request.TimeZone = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -551,9 +553,9 @@ func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CloudVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -667,9 +669,9 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 		request.StorageSizeInGBs = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -684,9 +686,9 @@ func (s *DatabaseCloudVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CloudVmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteCloudVmCluster(s.Context(), request)
 	return err
 }
 
@@ -855,14 +857,14 @@ func (s *DatabaseCloudVmClusterResourceCrud) updateCompartment(compartment inter
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseCloudVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudVmClusters,
+		ReadContext: readDatabaseCloudVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cloud_exadata_infrastructure_id": {
@@ -44,16 +45,16 @@ func DatabaseCloudVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseCloudVmClustersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListCloudVmClustersResponse
 }
@@ -84,9 +85,9 @@ func (s *DatabaseCloudVmClustersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.CloudVmClusterSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListCloudVmClusters(context.Background(), request)
+	response, err := s.Client.ListCloudVmClusters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *DatabaseCloudVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListCloudVmClusters(s.Context(), request)
 		if err != nil {
 			return err
 		}
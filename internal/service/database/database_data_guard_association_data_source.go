@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -26,16 +27,16 @@ func DatabaseDataGuardAssociationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDataGuardAssociationResource(), fieldMap, readSingularDatabaseDataGuardAssociation)
 }
 
-func readSingularDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDataGuardAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetDataGuardAssociationResponse
 }
@@ -57,9 +58,9 @@ func (s *DatabaseDataGuardAssociationDataSourceCrud) Get() error {
 		request.DatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
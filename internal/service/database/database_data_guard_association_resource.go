@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,10 +29,10 @@ func DatabaseDataGuardAssociationResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDataGuardAssociation,
-		Read:   readDatabaseDataGuardAssociation,
-		Update: updateDatabaseDataGuardAssociation,
-		Delete: deleteDatabaseDataGuardAssociation,
+		CreateContext: createDatabaseDataGuardAssociation,
+		ReadContext:   readDatabaseDataGuardAssociation,
+		UpdateContext: updateDatabaseDataGuardAssociation,
+		DeleteContext: deleteDatabaseDataGuardAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"creation_type": {
@@ -203,36 +204,36 @@ func DatabaseDataGuardAssociationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseDataGuardAssociationResourceCrud struct {
@@ -287,9 +288,10 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.CreateDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -309,9 +311,9 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 		request.DatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -349,9 +351,9 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
 		request.TransportType = oci_database.UpdateDataGuardAssociationDetailsTransportTypeEnum(transportType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -638,16 +640,16 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		}
 
 		deleteDBrequest.DatabaseId = standbyDatabaseId
-		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDatabase(context.Background(), deleteDBrequest); err != nil {
+		if _, err = s.Client.DeleteDatabase(s.Context(), deleteDBrequest); err != nil {
 			return fmt.Errorf("failed to delete the standby database")
 		}
 
 		getDatabaseRequest := oci_database.GetDatabaseRequest{}
 		getDatabaseRequest.DatabaseId = standbyDatabaseId
 		getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseToTerminateRetryPolicy(2 * time.Hour)
-		getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+		getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 
 		if getDatabaseResponse.LifecycleState == oci_database.DatabaseLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby database could not be deleted")
@@ -673,8 +675,8 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 
 		request := oci_database.TerminateDbSystemRequest{}
 		request.DbSystemId = standbyDbSystemId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		_, err := s.Client.TerminateDbSystem(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+		_, err := s.Client.TerminateDbSystem(s.Context(), request)
 		if err != nil {
 			return fmt.Errorf("could not delete standby DB System to delete the data guard association: %v", err)
 		}
@@ -682,7 +684,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		getDbSystemRequest := oci_database.GetDbSystemRequest{}
 		getDbSystemRequest.DbSystemId = standbyDbSystemId
 		getDbSystemRequest.RequestMetadata.RetryPolicy = waitForDbSystemToTerminateRetryPolicy(2 * time.Hour)
-		getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+		getDbSystemResponse, err := s.Client.GetDbSystem(s.Context(), getDbSystemRequest)
 
 		if getDbSystemResponse.LifecycleState == oci_database.DbSystemLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the dbSystem could not be deleted")
@@ -706,16 +708,16 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		}
 
 		deleteDBrequest.DatabaseId = standbyDatabaseId
-		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDatabase(context.Background(), deleteDBrequest); err != nil {
+		if _, err = s.Client.DeleteDatabase(s.Context(), deleteDBrequest); err != nil {
 			return fmt.Errorf("failed to delete the standby database")
 		}
 
 		getDatabaseRequest := oci_database.GetDatabaseRequest{}
 		getDatabaseRequest.DatabaseId = standbyDatabaseId
 		getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseToTerminateRetryPolicy(2 * time.Hour)
-		getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+		getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 
 		if getDatabaseResponse.LifecycleState == oci_database.DatabaseLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby database could not be deleted")
@@ -739,9 +741,9 @@ func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(dat
 
 	request.DatabaseId = databaseId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -761,9 +763,9 @@ func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(dbH
 
 	request.DbHomeId = dbHomeId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
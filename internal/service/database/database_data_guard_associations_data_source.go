@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDataGuardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDataGuardAssociations,
+		ReadContext: readDatabaseDataGuardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -31,16 +32,16 @@ func DatabaseDataGuardAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDataGuardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDataGuardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDataGuardAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDataGuardAssociationsResponse
 }
@@ -57,9 +58,9 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 		request.DatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+	response, err := s.Client.ListDataGuardAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListDataGuardAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDatabasePdbConversionHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabasePdbConversionHistoryEntries,
+		ReadContext: readDatabaseDatabasePdbConversionHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -91,16 +92,16 @@ func DatabaseDatabasePdbConversionHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabasePdbConversionHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabasePdbConversionHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListPdbConversionHistoryEntriesResponse
 }
@@ -125,9 +126,9 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 		request.LifecycleState = oci_database.PdbConversionHistoryEntrySummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListPdbConversionHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +137,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListPdbConversionHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDatabasePdbConversionHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDatabasePdbConversionHistoryEntry,
+		ReadContext: readSingularDatabaseDatabasePdbConversionHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"database_id": {
 				Type:     schema.TypeString,
@@ -70,16 +71,16 @@ func DatabaseDatabasePdbConversionHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDatabasePdbConversionHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabasePdbConversionHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetPdbConversionHistoryEntryResponse
 }
@@ -101,9 +102,9 @@ func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) Get() error {
 		request.PdbConversionHistoryEntryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetPdbConversionHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetPdbConversionHistoryEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
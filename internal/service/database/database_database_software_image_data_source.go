@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -22,16 +23,16 @@ func DatabaseDatabaseSoftwareImageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDatabaseSoftwareImageResource(), fieldMap, readSingularDatabaseDatabaseSoftwareImage)
 }
 
-func readSingularDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabaseSoftwareImageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetDatabaseSoftwareImageResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseDatabaseSoftwareImageDataSourceCrud) Get() error {
 		request.DatabaseSoftwareImageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.GetDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -24,10 +25,10 @@ func DatabaseDatabaseSoftwareImageResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createDatabaseDatabaseSoftwareImage,
-		Read:   readDatabaseDatabaseSoftwareImage,
-		Update: updateDatabaseDatabaseSoftwareImage,
-		Delete: deleteDatabaseDatabaseSoftwareImage,
+		CreateContext: createDatabaseDatabaseSoftwareImage,
+		ReadContext:   readDatabaseDatabaseSoftwareImage,
+		UpdateContext: updateDatabaseDatabaseSoftwareImage,
+		DeleteContext: deleteDatabaseDatabaseSoftwareImage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -131,37 +132,37 @@ func DatabaseDatabaseSoftwareImageResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseDatabaseSoftwareImageResourceCrud struct {
@@ -267,16 +268,17 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Create() error {
 		request.SourceDbHomeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.CreateDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DatabaseSoftwareImage
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -289,9 +291,9 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatabaseSoftwareImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.GetDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,15 +334,15 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	// This Update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -354,9 +356,9 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseSoftwareImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteDatabaseSoftwareImage(context.Background(), request)
+	_, err := s.Client.DeleteDatabaseSoftwareImage(s.Context(), request)
 	return err
 }
 
@@ -425,14 +427,14 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) updateCompartment(compartmen
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DatabaseSoftwareImageId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDatabaseSoftwareImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabaseSoftwareImages,
+		ReadContext: readDatabaseDatabaseSoftwareImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func DatabaseDatabaseSoftwareImagesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabaseSoftwareImages(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseSoftwareImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabaseSoftwareImagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDatabaseSoftwareImagesResponse
 }
@@ -99,9 +100,9 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.DatabaseSoftwareImageSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+	response, err := s.Client.ListDatabaseSoftwareImages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseSoftwareImages(s.Context(), request)
 		if err != nil {
 			return err
 		}
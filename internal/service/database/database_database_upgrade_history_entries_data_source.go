@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDatabaseUpgradeHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabaseUpgradeHistoryEntries,
+		ReadContext: readDatabaseDatabaseUpgradeHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -95,16 +96,16 @@ func DatabaseDatabaseUpgradeHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabaseUpgradeHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseUpgradeHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDatabaseUpgradeHistoryEntriesResponse
 }
@@ -129,9 +130,9 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 		request.UpgradeAction = oci_database.DatabaseUpgradeHistoryEntrySummaryActionEnum(upgradeAction.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDatabaseUpgradeHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDatabaseUpgradeHistoryEntry,
+		ReadContext: readSingularDatabaseDatabaseUpgradeHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"database_id": {
 				Type:     schema.TypeString,
@@ -74,16 +75,16 @@ func DatabaseDatabaseUpgradeHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDatabaseUpgradeHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabaseUpgradeHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetDatabaseUpgradeHistoryEntryResponse
 }
@@ -105,9 +106,9 @@ func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) Get() error {
 		request.UpgradeHistoryEntryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,10 +23,10 @@ import (
 
 func DatabaseDatabaseUpgradeResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseDatabaseUpgrade,
-		Read:     readDatabaseDatabaseUpgrade,
-		Delete:   deleteDatabaseDatabaseUpgrade,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseDatabaseUpgrade,
+		ReadContext:   readDatabaseDatabaseUpgrade,
+		DeleteContext: deleteDatabaseDatabaseUpgrade,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"action": {
@@ -259,24 +260,24 @@ func DatabaseDatabaseUpgradeResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -327,9 +328,9 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpgradeDatabase(context.Background(), request)
+	response, err := s.Client.UpgradeDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +343,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
 }
 
 func (s *DatabaseDatabaseUpgradeResourceCrud) getDatabaseUpgradeFromWorkRequest(workId *string, actionTypeEnum oci_work_requests.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
-	databaseUpgradeId, err := tfresource.WaitForWorkRequest(s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
+	databaseUpgradeId, err := tfresource.WaitForWorkRequestContext(s.Context(), s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
 	log.Printf("[DEBUG] WaitForWorkRequest finished. databaseUpgradeId: %v err: %v for workId: %v, actionTypeEnum: %v\n", *databaseUpgradeId, err, *workId, actionTypeEnum)
 	if err != nil {
 		log.Printf("[ERROR] Database upgrade operation failed, attempting to cancel the workrequest: %v for identifier: %v\n", *workId, databaseUpgradeId)
@@ -452,9 +453,9 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbHomePatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbHomePatchHistoryEntries,
+		ReadContext: readDatabaseDbHomePatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_home_id": {
@@ -67,16 +68,16 @@ func DatabaseDbHomePatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbHomePatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHomePatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomePatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbHomePatchHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbHomePatchHistoryEntriesResponse
 }
@@ -93,9 +94,9 @@ func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get() error {
 		request.DbHomeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbHomePatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbHomePatchHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomePatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomePatchHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbHomePatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbHomePatches,
+		ReadContext: readDatabaseDbHomePatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_home_id": {
@@ -74,16 +75,16 @@ func DatabaseDbHomePatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbHomePatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHomePatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomePatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbHomePatchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbHomePatchesResponse
 }
@@ -100,9 +101,9 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 		request.DbHomeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbHomePatches(context.Background(), request)
+	response, err := s.Client.ListDbHomePatches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomePatches(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomePatches(s.Context(), request)
 		if err != nil {
 			return err
 		}
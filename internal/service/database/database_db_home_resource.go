@@ -15,6 +15,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -33,10 +34,10 @@ func DatabaseDbHomeResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDbHome,
-		Read:   readDatabaseDbHome,
-		Update: updateDatabaseDbHome,
-		Delete: deleteDatabaseDbHome,
+		CreateContext: createDatabaseDbHome,
+		ReadContext:   readDatabaseDbHome,
+		UpdateContext: updateDatabaseDbHome,
+		DeleteContext: deleteDatabaseDbHome,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database": {
@@ -363,32 +364,32 @@ func DatabaseDbHomeResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseDbHomeResourceCrud struct {
@@ -451,16 +452,17 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 	// The underlying db system or vm cluster may be in an updating state. So keep retrying the CreateDbHome.
 	createDbHomeRetryDurationFn := tfresource.GetDbHomeRetryDurationFunction(s.D.Timeout(schema.TimeoutCreate))
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", createDbHomeRetryDurationFn)
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database", createDbHomeRetryDurationFn)
 
-	response, err := s.Client.CreateDbHome(context.Background(), request)
+	response, err := s.Client.CreateDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -482,9 +484,9 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DbHomeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -516,7 +518,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
 		updateDbHomeRequest.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
-	updateDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	updateDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
 	if oneOffPatches, ok := s.D.GetOkExists("one_off_patches"); ok {
 		interfaces := oneOffPatches.([]interface{})
@@ -527,14 +529,14 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 			}
 		}
 	}
-	response, err := s.Client.UpdateDbHome(context.Background(), updateDbHomeRequest)
+	response, err := s.Client.UpdateDbHome(s.Context(), updateDbHomeRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -567,15 +569,15 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId = response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -586,7 +588,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -615,14 +617,14 @@ func (s *DatabaseDbHomeResourceCrud) Delete() error {
 	// Special override to ensure that DeleteDbHome retries for the duration of the Terraform configured Create timeout
 	// The underlying db system or vm cluster may be in an updating state. So keep retrying it.
 	deleteDbHomeRetryDurationFn := tfresource.GetDbHomeRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDbHomeRetryDurationFn)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database", deleteDbHomeRetryDurationFn)
 
 	dbErr := s.deleteNestedDB()
 	if dbErr != nil {
 		log.Printf("[WARN] Could not delete nested database in DbHome. Will proceed to delete dbHome: %v", dbErr)
 	}
 
-	_, err := s.Client.DeleteDbHome(context.Background(), request)
+	_, err := s.Client.DeleteDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1123,13 +1125,13 @@ func (s *DatabaseDbHomeResourceCrud) populateTopLevelPolymorphicCreateDbHomeRequ
 	return nil
 }
 
-func updateDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
 func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
@@ -1149,8 +1151,8 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 	listDBRequest.DbHomeId = &dbHomeIdStr
 	listDBRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDBRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
-	listDBRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDBRequest)
+	listDBRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+	listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDBRequest)
 	if err != nil {
 		return err
 	}
@@ -1177,13 +1179,13 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 		request.PerformFinalBackup = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteDatabase(context.Background(), request)
+	response, err := s.Client.DeleteDatabase(s.Context(), request)
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1199,8 +1201,8 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	listDatabasesRequest.DbHomeId = s.Res.Id
 	listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
-	listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+	listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+	listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDatabasesRequest)
 	if err != nil {
 		return err
 	}
@@ -1212,8 +1214,8 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
-	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbHomesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbHomes,
+		ReadContext: readDatabaseDbHomes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backup_id": {
@@ -55,16 +56,16 @@ func DatabaseDbHomesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbHomes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHomes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbHomesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbHomesResponse
 }
@@ -110,9 +111,9 @@ func (s *DatabaseDbHomesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbHomes(context.Background(), request)
+	response, err := s.Client.ListDbHomes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *DatabaseDbHomesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomes(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomes(s.Context(), request)
 		if err != nil {
 			return err
 		}
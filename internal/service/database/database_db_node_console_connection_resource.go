@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -24,10 +25,10 @@ func DatabaseDbNodeConsoleConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseDbNodeConsoleConnection,
-		Read:     readDatabaseDbNodeConsoleConnection,
-		Delete:   deleteDatabaseDbNodeConsoleConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseDbNodeConsoleConnection,
+		ReadContext:   readDatabaseDbNodeConsoleConnection,
+		DeleteContext: deleteDatabaseDbNodeConsoleConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_node_id": {
@@ -64,29 +65,29 @@ func DatabaseDbNodeConsoleConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseDbNodeConsoleConnectionResourceCrud struct {
@@ -137,16 +138,17 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Create() error {
 		request.PublicKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ConsoleConnection
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -172,9 +174,9 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,9 +201,9 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteConsoleConnection(s.Context(), request)
 	return err
 }
 
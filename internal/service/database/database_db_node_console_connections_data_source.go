@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseDbNodeConsoleConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbNodeConsoleConnections,
+		ReadContext: readDatabaseDbNodeConsoleConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_node_id": {
@@ -31,16 +32,16 @@ func DatabaseDbNodeConsoleConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbNodeConsoleConnections(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbNodeConsoleConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbNodeConsoleConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListConsoleConnectionsResponse
 }
@@ -57,9 +58,9 @@ func (s *DatabaseDbNodeConsoleConnectionsDataSourceCrud) Get() error {
 		request.DbNodeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListConsoleConnections(context.Background(), request)
+	response, err := s.Client.ListConsoleConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
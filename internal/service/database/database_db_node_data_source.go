@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseDbNodeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDbNode,
+		ReadContext: readSingularDatabaseDbNode,
 		Schema: map[string]*schema.Schema{
 			"db_node_id": {
 				Type:     schema.TypeString,
@@ -106,16 +107,16 @@ func DatabaseDbNodeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDbNode(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbNode(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbNodeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetDbNodeResponse
 }
@@ -132,9 +133,9 @@ func (s *DatabaseDbNodeDataSourceCrud) Get() error {
 		request.DbNodeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetDbNode(context.Background(), request)
+	response, err := s.Client.GetDbNode(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbNodesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbNodes,
+		ReadContext: readDatabaseDbNodes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -143,16 +144,16 @@ func DatabaseDbNodesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbNodes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbNodes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbNodesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbNodesResponse
 }
@@ -188,9 +189,9 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbNodes(context.Background(), request)
+	response, err := s.Client.ListDbNodes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbNodes(context.Background(), request)
+		listResponse, err := s.Client.ListDbNodes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbServerDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDbServer,
+		ReadContext: readSingularDatabaseDbServer,
 		Schema: map[string]*schema.Schema{
 			"db_server_id": {
 				Type:     schema.TypeString,
@@ -127,16 +128,16 @@ func DatabaseDbServerDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDbServer(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbServer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbServerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbServerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetDbServerResponse
 }
@@ -158,9 +159,9 @@ func (s *DatabaseDbServerDataSourceCrud) Get() error {
 		request.ExadataInfrastructureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetDbServer(context.Background(), request)
+	response, err := s.Client.GetDbServer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbServersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbServers,
+		ReadContext: readDatabaseDbServers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -156,16 +157,16 @@ func DatabaseDbServersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbServers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbServers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbServersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbServersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbServersResponse
 }
@@ -196,9 +197,9 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.DbServerSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbServers(context.Background(), request)
+	response, err := s.Client.ListDbServers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +208,7 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbServers(context.Background(), request)
+		listResponse, err := s.Client.ListDbServers(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbSystemPatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemPatchHistoryEntries,
+		ReadContext: readDatabaseDbSystemPatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_id": {
@@ -67,16 +68,16 @@ func DatabaseDbSystemPatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemPatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemPatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemPatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbSystemPatchHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbSystemPatchHistoryEntriesResponse
 }
@@ -93,9 +94,9 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatchHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
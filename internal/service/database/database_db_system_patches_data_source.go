@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbSystemPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemPatches,
+		ReadContext: readDatabaseDbSystemPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_id": {
@@ -74,16 +75,16 @@ func DatabaseDbSystemPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbSystemPatchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbSystemPatchesResponse
 }
@@ -100,9 +101,9 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbSystemPatches(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatches(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemPatches(s.Context(), request)
 		if err != nil {
 			return err
 		}
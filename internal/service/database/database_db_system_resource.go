@@ -15,6 +15,8 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -32,10 +34,10 @@ func DatabaseDbSystemResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDbSystem,
-		Read:   readDatabaseDbSystem,
-		Update: updateDatabaseDbSystem,
-		Delete: deleteDatabaseDbSystem,
+		CreateContext: createDatabaseDbSystem,
+		ReadContext:   readDatabaseDbSystem,
+		UpdateContext: updateDatabaseDbSystem,
+		DeleteContext: deleteDatabaseDbSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -844,41 +846,41 @@ func DatabaseDbSystemResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return createDBSystemResource(d, sync)
+	return tfresource.ToDiagnostics(sync, createDBSystemResource(ctx, d, sync))
 }
 
-func readDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseDbSystemResourceCrud struct {
@@ -941,6 +943,8 @@ func (s *DatabaseDbSystemResourceCrud) UpdatedTarget() []string {
 	}
 }
 
+func (s *DatabaseDbSystemResourceCrud) ResumableCreate() {}
+
 func (s *DatabaseDbSystemResourceCrud) Create() error {
 	request := oci_database.LaunchDbSystemRequest{}
 	err := s.populateTopLevelPolymorphicLaunchDbSystemRequest(&request)
@@ -948,9 +952,10 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchDbSystem(context.Background(), request)
+	response, err := s.Client.LaunchDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -959,7 +964,7 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -968,6 +973,8 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 	err = s.getDbHomeInfo()
 	if err != nil {
 		log.Printf("[WARN] Could not get info about the first DbHome in the dbSystem: %v", err)
+		s.AddWarning("Could not get info about the first DbHome in the dbSystem",
+			fmt.Sprintf("The db_home attributes were not refreshed: %v", err), cty.GetAttrPath("db_home"))
 	}
 
 	return nil
@@ -979,9 +986,9 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -991,6 +998,8 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 	err = s.getDbHomeInfo()
 	if err != nil {
 		log.Printf("[WARN] Could not get info about the first DbHome in the dbSystem: %v", err)
+		s.AddWarning("Could not get info about the first DbHome in the dbSystem",
+			fmt.Sprintf("The db_home attributes were not refreshed: %v", err), cty.GetAttrPath("db_home"))
 	}
 
 	return nil
@@ -1102,16 +1111,16 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1120,7 +1129,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	s.Res = &response.DbSystem
 
 	// Check lifecycle state of db system
-	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
+	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Context(), s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		err = s.SetData()
 		if err != nil {
@@ -1145,9 +1154,9 @@ func (s *DatabaseDbSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateDbSystem(context.Background(), request)
+	_, err := s.Client.TerminateDbSystem(s.Context(), request)
 	return err
 }
 
@@ -2789,14 +2798,14 @@ func (s *DatabaseDbSystemResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DbSystemId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDbSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDbSystemCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -2869,8 +2878,8 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDbHomeRequest.DbSystemId = s.Res.Id
 			listDbHomeRequest.SortBy = oci_database.ListDbHomesSortByTimecreated
 			listDbHomeRequest.SortOrder = oci_database.ListDbHomesSortOrderAsc
-			listDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDbHomeResponse, err := s.Client.ListDbHomes(context.Background(), listDbHomeRequest)
+			listDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+			listDbHomeResponse, err := s.Client.ListDbHomes(s.Context(), listDbHomeRequest)
 			if err != nil {
 				return err
 			}
@@ -2889,8 +2898,8 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	}
 	getDbHomeRequest := oci_database.GetDbHomeRequest{}
 	getDbHomeRequest.DbHomeId = dbHomeId
-	getDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	getDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+	getDbHomeResponse, err := s.Client.GetDbHome(s.Context(), getDbHomeRequest)
 	if err != nil {
 		return err
 	}
@@ -2911,8 +2920,8 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDatabasesRequest.DbHomeId = getDbHomeResponse.DbHome.Id
 			listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 			listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
-			listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+			listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+			listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDatabasesRequest)
 			if err != nil {
 				return err
 			}
@@ -2929,8 +2938,8 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
-	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -2949,7 +2958,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	return nil
 }
 
-func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.DatabaseClient, timeout time.Duration) (*oci_database.GetDbSystemResponse, error) {
+func waitForDbSystemIfItIsUpdating(ctx context.Context, dbSystemID *string, client *oci_database.DatabaseClient, timeout time.Duration) (*oci_database.GetDbSystemResponse, error) {
 	getDbSystemRequest := oci_database.GetDbSystemRequest{}
 
 	getDbSystemRequest.DbSystemId = dbSystemID
@@ -2964,7 +2973,7 @@ func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.Data
 	}
 
 	getDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyWithAdditionalRetryCondition(timeout, dbSystemUpdating, "database")
-	getDbSystemResponse, err := client.GetDbSystem(context.Background(), getDbSystemRequest)
+	getDbSystemResponse, err := client.GetDbSystem(ctx, getDbSystemRequest)
 	return &getDbSystemResponse, err
 }
 
@@ -2984,15 +2993,15 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 		return err
 	}
 
-	updateDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), updateDatabaseRequest)
+	updateDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(s.Context(), updateDatabaseRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := updateDatabaseResponse.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3003,7 +3012,7 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -3023,16 +3032,16 @@ func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(dbSystemId stri
 	request.LicenseModel = oci_database.UpdateDbSystemDetailsLicenseModelEnum(licenseModel.(string))
 
 	request.DbSystemId = &dbSystemId
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3040,7 +3049,7 @@ func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(dbSystemId stri
 
 	s.Res = &response.DbSystem
 
-	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
+	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Context(), s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		err = s.SetData()
 		if err != nil {
@@ -3232,10 +3241,18 @@ func (s *DatabaseDbSystemResourceCrud) DatabaseToMap(obj *oci_database.Database)
 	return result
 }
 
-func createDBSystemResource(d *schema.ResourceData, sync tfresource.ResourceCreator) error {
-	if e := sync.Create(); e != nil {
+func createDBSystemResource(ctx context.Context, d *schema.ResourceData, sync tfresource.ResourceCreator) error {
+	if contextAware, ok := sync.(tfresource.ContextAwareResource); ok {
+		contextAware.SetContext(ctx)
+	}
+
+	interrupted, e := tfresource.CreateOrResume(ctx, d, sync)
+	if e != nil {
 		return tfresource.HandleError(sync, e)
 	}
+	if interrupted {
+		return nil
+	}
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
@@ -3251,11 +3268,14 @@ func createDBSystemResource(d *schema.ResourceData, sync tfresource.ResourceCrea
 		}
 	}
 	if stateful, ok := sync.(tfresource.StatefullyCreatedResource); ok {
-		if e := tfresource.WaitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := tfresource.WaitForStateRefreshContext(ctx, stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
 				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
 			}
+			if stateful.State() != tfresource.FAILED && tfresource.SaveInterruptedCreation(ctx, d, sync, sync.ID()) {
+				return nil
+			}
 			return e
 		}
 	}
@@ -3264,9 +3284,12 @@ func createDBSystemResource(d *schema.ResourceData, sync tfresource.ResourceCrea
 	if e := sync.SetData(); e != nil {
 		return e
 	}
+	tfresource.ClearPendingCreation(ctx)
 
 	if ew, waitOK := sync.(tfresource.ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if err := tfresource.SleepContext(ctx, ew.ExtraWaitPostCreateDelete()); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbSystemShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemShapes,
+		ReadContext: readDatabaseDbSystemShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -128,16 +129,16 @@ func DatabaseDbSystemShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemShapes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbSystemShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbSystemShapesResponse
 }
@@ -159,9 +160,9 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbSystemShapes(context.Background(), request)
+	response, err := s.Client.ListDbSystemShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
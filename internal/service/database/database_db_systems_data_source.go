@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystems,
+		ReadContext: readDatabaseDbSystems,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,16 +48,16 @@ func DatabaseDbSystemsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystems(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystems(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbSystemsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbSystemsResponse
 }
@@ -92,9 +93,9 @@ func (s *DatabaseDbSystemsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.DbSystemSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbSystems(context.Background(), request)
+	response, err := s.Client.ListDbSystems(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DatabaseDbSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystems(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystems(s.Context(), request)
 		if err != nil {
 			return err
 		}
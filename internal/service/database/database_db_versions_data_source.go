@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseDbVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbVersions,
+		ReadContext: readDatabaseDbVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,16 +80,16 @@ func DatabaseDbVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseDbVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListDbVersionsResponse
 }
@@ -129,9 +130,9 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 		request.StorageManagement = oci_database.DbSystemOptionsStorageManagementEnum(storageManagement.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListDbVersions(context.Background(), request)
+	response, err := s.Client.ListDbVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbVersions(context.Background(), request)
+		listResponse, err := s.Client.ListDbVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
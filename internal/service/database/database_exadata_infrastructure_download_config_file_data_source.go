@@ -12,13 +12,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseExadataInfrastructureDownloadConfigFileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseExadataInfrastructureDownloadConfigFile,
+		ReadContext: readSingularDatabaseExadataInfrastructureDownloadConfigFile,
 		Schema: map[string]*schema.Schema{
 			"base64_encode_content": {
 				Type:     schema.TypeBool,
@@ -38,16 +39,16 @@ func DatabaseExadataInfrastructureDownloadConfigFileDataSource() *schema.Resourc
 	}
 }
 
-func readSingularDatabaseExadataInfrastructureDownloadConfigFile(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExadataInfrastructureDownloadConfigFile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.DownloadExadataInfrastructureConfigFileResponse
 }
@@ -64,9 +65,9 @@ func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) Get() er
 		request.ExadataInfrastructureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.DownloadExadataInfrastructureConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadExadataInfrastructureConfigFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -24,11 +25,11 @@ func DatabaseExadataInfrastructureResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExadataInfrastructure,
-		Read:     readDatabaseExadataInfrastructure,
-		Update:   updateDatabaseExadataInfrastructure,
-		Delete:   deleteDatabaseExadataInfrastructure,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExadataInfrastructure,
+		ReadContext:   readDatabaseExadataInfrastructure,
+		UpdateContext: updateDatabaseExadataInfrastructure,
+		DeleteContext: deleteDatabaseExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_network_cidr": {
@@ -333,41 +334,41 @@ func DatabaseExadataInfrastructureResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseExadataInfrastructureResourceCrud struct {
@@ -574,16 +575,17 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Create() error {
 		request.TimeZone = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ExadataInfrastructure
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -605,9 +607,9 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExadataInfrastructureId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -745,7 +747,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		request.TimeZone = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
 	if s.D.Get("state").(string) == string(oci_database.ExadataInfrastructureLifecycleStateRequiresActivation) ||
 		s.D.Get("additional_storage_count").(int) > 0 {
@@ -761,14 +763,14 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		}
 	}
 
-	response, err := s.Client.UpdateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ExadataInfrastructure
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -781,9 +783,9 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExadataInfrastructureId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteExadataInfrastructure(s.Context(), request)
 	return err
 }
 
@@ -1164,14 +1166,14 @@ func (s *DatabaseExadataInfrastructureResourceCrud) updateCompartment(compartmen
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ExadataInfrastructureId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExadataInfrastructureCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -1191,16 +1193,16 @@ func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructur
 
 	request.ExadataInfrastructureId = &exadataInfrastructureId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ActivateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.ActivateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return nil, err
 		}
@@ -11,6 +11,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -20,11 +21,11 @@ func DatabaseExadataInfrastructureStorageResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   updateDatabaseExadataInfrastructureStorage,
-		Read:     readDatabaseExadataInfrastructure,
-		Update:   updateDatabaseExadataInfrastructureStorage,
-		Delete:   deleteDatabaseExadataInfrastructure,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: updateDatabaseExadataInfrastructureStorage,
+		ReadContext:   readDatabaseExadataInfrastructure,
+		UpdateContext: updateDatabaseExadataInfrastructureStorage,
+		DeleteContext: deleteDatabaseExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_network_cidr": {
@@ -304,13 +305,13 @@ func DatabaseExadataInfrastructureStorageResource() *schema.Resource {
 	}
 }
 
-func updateDatabaseExadataInfrastructureStorage(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExadataInfrastructureStorage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureStorageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
 type DatabaseExadataInfrastructureStorageResourceCrud struct {
@@ -333,16 +334,16 @@ func (s *DatabaseExadataInfrastructureStorageResourceCrud) Update() error {
 		request.ExadataInfrastructureId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.AddStorageCapacityExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.AddStorageCapacityExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -17,11 +18,11 @@ import (
 
 func DatabaseExternalContainerDatabaseManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalContainerDatabaseManagement,
-		Update:   updateDatabaseExternalContainerDatabaseManagement,
-		Read:     readDatabaseExternalContainerDatabaseManagement,
-		Delete:   deleteDatabaseExternalContainerDatabaseManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalContainerDatabaseManagement,
+		UpdateContext: updateDatabaseExternalContainerDatabaseManagement,
+		ReadContext:   readDatabaseExternalContainerDatabaseManagement,
+		DeleteContext: deleteDatabaseExternalContainerDatabaseManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"external_container_database_id": {
@@ -51,31 +52,31 @@ func DatabaseExternalContainerDatabaseManagementResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExternalContainerDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalContainerDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.Res = &DatabaseExternalContainerDatabaseManagementResponse{}
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func updateDatabaseExternalContainerDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalContainerDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.Res = &DatabaseExternalContainerDatabaseManagementResponse{}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseExternalContainerDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalContainerDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseExternalContainerDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalContainerDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
@@ -83,7 +84,7 @@ func deleteDatabaseExternalContainerDatabaseManagement(d *schema.ResourceData, m
 	sync.Res = &DatabaseExternalContainerDatabaseManagementResponse{}
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseExternalContainerDatabaseManagementResponse struct {
@@ -127,16 +128,17 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Create() error
 		if licenseModel, ok := s.D.GetOkExists("license_model"); ok {
 			request.EnableExternalContainerDatabaseDatabaseManagementDetails.LicenseModel = oci_database.EnableExternalContainerDatabaseDatabaseManagementDetailsLicenseModelEnum(licenseModel.(string))
 		}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		tfresource.SetCreateRetryToken(s.Context(), &request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
@@ -152,16 +154,17 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Create() error
 		request.ExternalContainerDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -194,16 +197,16 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Update() error
 			request.EnableExternalContainerDatabaseDatabaseManagementDetails.LicenseModel = oci_database.EnableExternalContainerDatabaseDatabaseManagementDetailsLicenseModelEnum(licenseModel.(string))
 		}
 
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
@@ -219,16 +222,16 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Update() error
 		request.ExternalContainerDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -255,16 +258,16 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Delete() error
 		request.ExternalContainerDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
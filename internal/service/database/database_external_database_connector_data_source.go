@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -23,16 +24,16 @@ func DatabaseExternalDatabaseConnectorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseExternalDatabaseConnectorResource(), fieldMap, readSingularDatabaseExternalDatabaseConnector)
 }
 
-func readSingularDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseExternalDatabaseConnectorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetExternalDatabaseConnectorResponse
 }
@@ -49,9 +50,9 @@ func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) Get() error {
 		request.ExternalDatabaseConnectorId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.GetExternalDatabaseConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
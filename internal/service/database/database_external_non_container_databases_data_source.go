@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseExternalNonContainerDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseExternalNonContainerDatabases,
+		ReadContext: readDatabaseExternalNonContainerDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func DatabaseExternalNonContainerDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseExternalNonContainerDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalNonContainerDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseExternalNonContainerDatabasesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListExternalNonContainerDatabasesResponse
 }
@@ -74,9 +75,9 @@ func (s *DatabaseExternalNonContainerDatabasesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.ExternalDatabaseBaseLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListExternalNonContainerDatabases(context.Background(), request)
+	response, err := s.Client.ListExternalNonContainerDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DatabaseExternalNonContainerDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExternalNonContainerDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListExternalNonContainerDatabases(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseFlexComponentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseFlexComponents,
+		ReadContext: readDatabaseFlexComponents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -67,16 +68,16 @@ func DatabaseFlexComponentsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseFlexComponents(d *schema.ResourceData, m interface{}) error {
+func readDatabaseFlexComponents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseFlexComponentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseFlexComponentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListFlexComponentsResponse
 }
@@ -98,9 +99,9 @@ func (s *DatabaseFlexComponentsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListFlexComponents(context.Background(), request)
+	response, err := s.Client.ListFlexComponents(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DatabaseFlexComponentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFlexComponents(context.Background(), request)
+		listResponse, err := s.Client.ListFlexComponents(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseGiVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseGiVersions,
+		ReadContext: readDatabaseGiVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func DatabaseGiVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseGiVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseGiVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseGiVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseGiVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListGiVersionsResponse
 }
@@ -78,9 +79,9 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 		request.Shape = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListGiVersions(context.Background(), request)
+	response, err := s.Client.ListGiVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +90,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGiVersions(context.Background(), request)
+		listResponse, err := s.Client.ListGiVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
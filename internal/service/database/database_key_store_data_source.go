@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -22,16 +23,16 @@ func DatabaseKeyStoreDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseKeyStoreResource(), fieldMap, readSingularDatabaseKeyStore)
 }
 
-func readSingularDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseKeyStoreDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetKeyStoreResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseKeyStoreDataSourceCrud) Get() error {
 		request.KeyStoreId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetKeyStore(context.Background(), request)
+	response, err := s.Client.GetKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DatabaseKeyStoreResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseKeyStore,
-		Read:     readDatabaseKeyStore,
-		Update:   updateDatabaseKeyStore,
-		Delete:   deleteDatabaseKeyStore,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseKeyStore,
+		ReadContext:   readDatabaseKeyStore,
+		UpdateContext: updateDatabaseKeyStore,
+		DeleteContext: deleteDatabaseKeyStore,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -136,37 +137,37 @@ func DatabaseKeyStoreResource() *schema.Resource {
 	}
 }
 
-func createDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func createDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func readDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseKeyStoreResourceCrud struct {
@@ -236,9 +237,10 @@ func (s *DatabaseKeyStoreResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateKeyStore(context.Background(), request)
+	response, err := s.Client.CreateKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -253,9 +255,9 @@ func (s *DatabaseKeyStoreResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.KeyStoreId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetKeyStore(context.Background(), request)
+	response, err := s.Client.GetKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -302,9 +304,9 @@ func (s *DatabaseKeyStoreResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateKeyStore(context.Background(), request)
+	response, err := s.Client.UpdateKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -319,9 +321,9 @@ func (s *DatabaseKeyStoreResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.KeyStoreId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteKeyStore(context.Background(), request)
+	_, err := s.Client.DeleteKeyStore(s.Context(), request)
 	return err
 }
 
@@ -464,14 +466,14 @@ func (s *DatabaseKeyStoreResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.KeyStoreId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeKeyStoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyStoreCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
@@ -23,16 +24,16 @@ func DatabaseMaintenanceRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMaintenanceRunResource(), fieldMap, readSingularDatabaseMaintenanceRun)
 }
 
-func readSingularDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMaintenanceRunDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetMaintenanceRunResponse
 }
@@ -49,9 +50,9 @@ func (s *DatabaseMaintenanceRunDataSourceCrud) Get() error {
 		request.MaintenanceRunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
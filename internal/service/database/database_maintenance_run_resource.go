@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -21,11 +22,11 @@ func DatabaseMaintenanceRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMaintenanceRun,
-		Read:     readDatabaseMaintenanceRun,
-		Update:   updateDatabaseMaintenanceRun,
-		Delete:   deleteDatabaseMaintenanceRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMaintenanceRun,
+		ReadContext:   readDatabaseMaintenanceRun,
+		UpdateContext: updateDatabaseMaintenanceRun,
+		DeleteContext: deleteDatabaseMaintenanceRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"maintenance_run_id": {
@@ -196,31 +197,31 @@ func DatabaseMaintenanceRunResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -322,9 +323,9 @@ func (s *DatabaseMaintenanceRunResourceCrud) Create() error {
 		request.TimeScheduled = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	response, err := s.Client.UpdateMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -344,9 +345,9 @@ func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
 	}
 	request.MaintenanceRunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -409,20 +410,22 @@ func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
 		request.TimeScheduled = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	_, err := s.Client.UpdateMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	// Workaround: Sleep for some time before polling the configuration. Because Update happens asynchronously, polling too
 	// soon may result in service returning stale configuration values.
-	time.Sleep(time.Second * 10)
+	if err := tfresource.SleepContext(s.Context(), time.Second*10); err != nil {
+		return err
+	}
 
 	// Requests to Update may succeed instantly but may not see the actual Update take effect
 	// until minutes later. Add polling here to return only when the change has taken effect.
 	maintenanceRunUpdatePatchingModeFunc := func() bool { return s.Res.LifecycleState != oci_database.MaintenanceRunLifecycleStateUpdating }
-	return tfresource.WaitForResourceCondition(s, maintenanceRunUpdatePatchingModeFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, maintenanceRunUpdatePatchingModeFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseMaintenanceRunResourceCrud) SetData() error {
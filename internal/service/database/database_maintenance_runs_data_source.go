@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseMaintenanceRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMaintenanceRuns,
+		ReadContext: readDatabaseMaintenanceRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -52,16 +53,16 @@ func DatabaseMaintenanceRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMaintenanceRuns(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMaintenanceRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMaintenanceRunsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListMaintenanceRunsResponse
 }
@@ -100,9 +101,9 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 		request.TargetResourceType = oci_database.MaintenanceRunSummaryTargetResourceTypeEnum(targetResourceType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+	response, err := s.Client.ListMaintenanceRuns(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+		listResponse, err := s.Client.ListMaintenanceRuns(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -5,7 +5,7 @@ package database
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
@@ -16,10 +16,10 @@ import (
 
 func DatabaseMigrationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigration,
-		Read:     readDatabaseMigration,
-		Delete:   deleteDatabaseMigration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigration,
+		ReadContext:   readDatabaseMigration,
+		DeleteContext: deleteDatabaseMigration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -68,20 +68,20 @@ func DatabaseMigrationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -105,9 +105,10 @@ func (s *DatabaseMigrationResourceCrud) Create() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.MigrateExadataDbSystemResourceModel(context.Background(), request)
+	response, err := s.Client.MigrateExadataDbSystemResourceModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
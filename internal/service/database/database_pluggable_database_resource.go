@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -19,11 +20,11 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabase,
-		Read:     readDatabasePluggableDatabase,
-		Update:   updateDatabasePluggableDatabase,
-		Delete:   deleteDatabasePluggableDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabase,
+		ReadContext:   readDatabasePluggableDatabase,
+		UpdateContext: updateDatabasePluggableDatabase,
+		DeleteContext: deleteDatabasePluggableDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"container_database_id": {
@@ -127,37 +128,37 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabasePluggableDatabaseResourceCrud struct {
@@ -235,9 +236,10 @@ func (s *DatabasePluggableDatabaseResourceCrud) Create() error {
 		request.TdeWalletPassword = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.CreatePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,9 +254,9 @@ func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PluggableDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,9 +283,9 @@ func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PluggableDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.UpdatePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -298,9 +300,9 @@ func (s *DatabasePluggableDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PluggableDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeletePluggableDatabase(context.Background(), request)
+	_, err := s.Client.DeletePluggableDatabase(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabasePluggableDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabasePluggableDatabases,
+		ReadContext: readDatabasePluggableDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func DatabasePluggableDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabasePluggableDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabasePluggableDatabasesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListPluggableDatabasesResponse
 }
@@ -83,9 +84,9 @@ func (s *DatabasePluggableDatabasesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.PluggableDatabaseSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListPluggableDatabases(context.Background(), request)
+	response, err := s.Client.ListPluggableDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabasePluggableDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPluggableDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListPluggableDatabases(s.Context(), request)
 		if err != nil {
 			return err
 		}
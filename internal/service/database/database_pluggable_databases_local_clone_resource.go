@@ -5,10 +5,10 @@ package database
 
 import (
 	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -16,10 +16,10 @@ import (
 
 func DatabasePluggableDatabasesLocalCloneResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabasesLocalClone,
-		Read:     readDatabasePluggableDatabasesLocalClone,
-		Delete:   deleteDatabasePluggableDatabasesLocalClone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabasesLocalClone,
+		ReadContext:   readDatabasePluggableDatabasesLocalClone,
+		DeleteContext: deleteDatabasePluggableDatabasesLocalClone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloned_pdb_name": {
@@ -128,19 +128,19 @@ func DatabasePluggableDatabasesLocalCloneResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesLocalCloneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -207,9 +207,10 @@ func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) Create() error {
 		request.TargetTdeWalletPassword = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LocalClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.LocalClonePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,10 +5,10 @@ package database
 
 import (
 	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -16,10 +16,10 @@ import (
 
 func DatabasePluggableDatabasesRemoteCloneResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabasesRemoteClone,
-		Read:     readDatabasePluggableDatabasesRemoteClone,
-		Delete:   deleteDatabasePluggableDatabasesRemoteClone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabasesRemoteClone,
+		ReadContext:   readDatabasePluggableDatabasesRemoteClone,
+		DeleteContext: deleteDatabasePluggableDatabasesRemoteClone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloned_pdb_name": {
@@ -139,19 +139,19 @@ func DatabasePluggableDatabasesRemoteCloneResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesRemoteCloneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -228,9 +228,10 @@ func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) Create() error {
 		request.TargetTdeWalletPassword = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoteClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.RemoteClonePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -20,10 +21,10 @@ func DatabaseVmClusterAddVirtualMachineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmClusterAddVirtualMachine,
-		Read:     readDatabaseVmClusterAddVirtualMachine,
-		Delete:   deleteDatabaseVmClusterAddVirtualMachine,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmClusterAddVirtualMachine,
+		ReadContext:   readDatabaseVmClusterAddVirtualMachine,
+		DeleteContext: deleteDatabaseVmClusterAddVirtualMachine,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_servers": {
@@ -159,19 +160,19 @@ func DatabaseVmClusterAddVirtualMachineResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmClusterAddVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmClusterAddVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterAddVirtualMachineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseVmClusterAddVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterAddVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseVmClusterAddVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmClusterAddVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -235,9 +236,10 @@ func (s *DatabaseVmClusterAddVirtualMachineResourceCrud) Create() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.AddVirtualMachineToVmCluster(context.Background(), request)
+	response, err := s.Client.AddVirtualMachineToVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,13 +12,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterNetworkDownloadConfigFileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterNetworkDownloadConfigFile,
+		ReadContext: readSingularDatabaseVmClusterNetworkDownloadConfigFile,
 		Schema: map[string]*schema.Schema{
 			"base64_encode_content": {
 				Type:     schema.TypeBool,
@@ -42,16 +43,16 @@ func DatabaseVmClusterNetworkDownloadConfigFileDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterNetworkDownloadConfigFile(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterNetworkDownloadConfigFile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.DownloadVmClusterNetworkConfigFileResponse
 }
@@ -73,9 +74,9 @@ func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) Get() error {
 		request.VmClusterNetworkId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.DownloadVmClusterNetworkConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadVmClusterNetworkConfigFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
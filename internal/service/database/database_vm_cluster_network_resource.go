@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -26,11 +27,11 @@ func DatabaseVmClusterNetworkResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmClusterNetwork,
-		Read:     readDatabaseVmClusterNetwork,
-		Update:   updateDatabaseVmClusterNetwork,
-		Delete:   deleteDatabaseVmClusterNetwork,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmClusterNetwork,
+		ReadContext:   readDatabaseVmClusterNetwork,
+		UpdateContext: updateDatabaseVmClusterNetwork,
+		DeleteContext: deleteDatabaseVmClusterNetwork,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -211,37 +212,37 @@ func DatabaseVmClusterNetworkResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseVmClusterNetworkResourceCrud struct {
@@ -394,16 +395,17 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.VmClusterNetwork
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -439,9 +441,9 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -545,16 +547,16 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.VmClusterNetwork
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -582,9 +584,9 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VmClusterNetworkId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmClusterNetwork(context.Background(), request)
+	_, err := s.Client.DeleteVmClusterNetwork(s.Context(), request)
 	return err
 }
 
@@ -941,9 +943,9 @@ func (s *DatabaseVmClusterNetworkResourceCrud) validateVmClusterNetwork(vmCluste
 
 	request.VmClusterNetworkId = &vmClusterNetworkId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ValidateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.ValidateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterNetworksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterNetworks,
+		ReadContext: readDatabaseVmClusterNetworks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func DatabaseVmClusterNetworksDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterNetworks(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterNetworks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterNetworksDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListVmClusterNetworksResponse
 }
@@ -83,9 +84,9 @@ func (s *DatabaseVmClusterNetworksDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.VmClusterNetworkSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListVmClusterNetworks(context.Background(), request)
+	response, err := s.Client.ListVmClusterNetworks(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseVmClusterNetworksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterNetworks(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterNetworks(s.Context(), request)
 		if err != nil {
 			return err
 		}
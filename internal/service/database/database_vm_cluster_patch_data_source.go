@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterPatchDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterPatch,
+		ReadContext: readSingularDatabaseVmClusterPatch,
 		Schema: map[string]*schema.Schema{
 			"patch_id": {
 				Type:     schema.TypeString,
@@ -61,16 +62,16 @@ func DatabaseVmClusterPatchDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterPatch(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterPatchDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetVmClusterPatchResponse
 }
@@ -92,9 +93,9 @@ func (s *DatabaseVmClusterPatchDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetVmClusterPatch(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatch(s.Context(), request)
 	if err != nil {
 		return err
 	}
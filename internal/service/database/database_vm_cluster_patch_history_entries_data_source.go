@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterPatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterPatchHistoryEntries,
+		ReadContext: readDatabaseVmClusterPatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"vm_cluster_id": {
@@ -67,16 +68,16 @@ func DatabaseVmClusterPatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterPatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterPatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterPatchHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListVmClusterPatchHistoryEntriesResponse
 }
@@ -93,9 +94,9 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterPatchHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterPatchHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterPatchHistoryEntry,
+		ReadContext: readSingularDatabaseVmClusterPatchHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"patch_history_entry_id": {
 				Type:     schema.TypeString,
@@ -54,16 +55,16 @@ func DatabaseVmClusterPatchHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterPatchHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterPatchHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterPatchHistoryEntryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetVmClusterPatchHistoryEntryResponse
 }
@@ -85,9 +86,9 @@ func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetVmClusterPatchHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatchHistoryEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterPatches,
+		ReadContext: readDatabaseVmClusterPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"vm_cluster_id": {
@@ -74,16 +75,16 @@ func DatabaseVmClusterPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterPatchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListVmClusterPatchesResponse
 }
@@ -100,9 +101,9 @@ func (s *DatabaseVmClusterPatchesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListVmClusterPatches(context.Background(), request)
+	response, err := s.Client.ListVmClusterPatches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseVmClusterPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterPatches(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterPatches(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 )
 
 func DatabaseVmClusterRecommendedNetworkDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterRecommendedNetwork,
+		ReadContext: readSingularDatabaseVmClusterRecommendedNetwork,
 		Schema: map[string]*schema.Schema{
 
 			// Required
@@ -215,16 +216,16 @@ func DatabaseVmClusterRecommendedNetworkDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterRecommendedNetwork(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterRecommendedNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterRecommendedNetworkDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterRecommendedNetworkDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GenerateRecommendedVmClusterNetworkResponse
 }
@@ -316,9 +317,9 @@ func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) Get() error {
 		request.ScanListenerPortTcpSsl = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GenerateRecommendedVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GenerateRecommendedVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -20,10 +21,10 @@ func DatabaseVmClusterRemoveVirtualMachineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmClusterRemoveVirtualMachine,
-		Read:     readDatabaseVmClusterRemoveVirtualMachine,
-		Delete:   deleteDatabaseVmClusterRemoveVirtualMachine,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmClusterRemoveVirtualMachine,
+		ReadContext:   readDatabaseVmClusterRemoveVirtualMachine,
+		DeleteContext: deleteDatabaseVmClusterRemoveVirtualMachine,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_servers": {
@@ -151,19 +152,19 @@ func DatabaseVmClusterRemoveVirtualMachineResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterRemoveVirtualMachineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -227,9 +228,10 @@ func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) Create() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoveVirtualMachineFromVmCluster(context.Background(), request)
+	response, err := s.Client.RemoveVirtualMachineFromVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
@@ -20,11 +21,11 @@ func DatabaseVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmCluster,
-		Read:     readDatabaseVmCluster,
-		Update:   updateDatabaseVmCluster,
-		Delete:   deleteDatabaseVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmCluster,
+		ReadContext:   readDatabaseVmCluster,
+		UpdateContext: updateDatabaseVmCluster,
+		DeleteContext: deleteDatabaseVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,40 +175,40 @@ func DatabaseVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseVmClusterResourceCrud struct {
@@ -376,9 +377,10 @@ func (s *DatabaseVmClusterResourceCrud) Create() error {
 		request.VmClusterNetworkId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmCluster(context.Background(), request)
+	response, err := s.Client.CreateVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,9 +395,9 @@ func (s *DatabaseVmClusterResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmCluster(context.Background(), request)
+	response, err := s.Client.GetVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -478,9 +480,9 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -495,9 +497,9 @@ func (s *DatabaseVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VmClusterId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteVmCluster(s.Context(), request)
 	return err
 }
 
@@ -609,16 +611,16 @@ func (s *DatabaseVmClusterResourceCrud) updateCompartment(compartment interface{
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VmClusterId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "vmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandlingContext(s.Context(), s.WorkRequestClient, workId, "vmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterUpdate,
+		ReadContext: readSingularDatabaseVmClusterUpdate,
 		Schema: map[string]*schema.Schema{
 			"update_id": {
 				Type:     schema.TypeString,
@@ -65,16 +66,16 @@ func DatabaseVmClusterUpdateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetVmClusterUpdateResponse
 }
@@ -96,9 +97,9 @@ func (s *DatabaseVmClusterUpdateDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetVmClusterUpdate(context.Background(), request)
+	response, err := s.Client.GetVmClusterUpdate(s.Context(), request)
 	if err != nil {
 		return err
 	}
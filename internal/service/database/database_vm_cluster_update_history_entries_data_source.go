@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterUpdateHistoryEntries,
+		ReadContext: readDatabaseVmClusterUpdateHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"state": {
@@ -79,16 +80,16 @@ func DatabaseVmClusterUpdateHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterUpdateHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterUpdateHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListVmClusterUpdateHistoryEntriesResponse
 }
@@ -113,9 +114,9 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdateHistoryEntries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterUpdateHistoryEntry,
+		ReadContext: readSingularDatabaseVmClusterUpdateHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"update_history_entry_id": {
 				Type:     schema.TypeString,
@@ -58,16 +59,16 @@ func DatabaseVmClusterUpdateHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterUpdateHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterUpdateHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateHistoryEntryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.GetVmClusterUpdateHistoryEntryResponse
 }
@@ -89,9 +90,9 @@ func (s *DatabaseVmClusterUpdateHistoryEntryDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.GetVmClusterUpdateHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetVmClusterUpdateHistoryEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v61/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterUpdates,
+		ReadContext: readDatabaseVmClusterUpdates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"state": {
@@ -86,16 +87,16 @@ func DatabaseVmClusterUpdatesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterUpdates(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterUpdates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseVmClusterUpdatesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database.DatabaseClient
 	Res    *oci_database.ListVmClusterUpdatesResponse
 }
@@ -120,9 +121,9 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 		request.VmClusterId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database")
 
-	response, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdates(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdates(s.Context(), request)
 		if err != nil {
 			return err
 		}
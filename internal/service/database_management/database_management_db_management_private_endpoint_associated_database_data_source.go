@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase,
+		ReadContext: readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSource()
 	}
 }
 
-func readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListAssociatedDatabasesResponse
 }
@@ -90,9 +91,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSour
 		request.DbManagementPrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+	response, err := s.Client.ListAssociatedDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases,
+		ReadContext: readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -72,16 +73,16 @@ func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSource(
 	}
 }
 
-func readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListAssociatedDatabasesResponse
 }
@@ -103,9 +104,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSou
 		request.DbManagementPrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+	response, err := s.Client.ListAssociatedDatabases(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSou
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListAssociatedDatabases(s.Context(), request)
 		if err != nil {
 			return err
 		}
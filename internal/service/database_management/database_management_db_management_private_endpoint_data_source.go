@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
@@ -22,16 +23,16 @@ func DatabaseManagementDbManagementPrivateEndpointDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseManagementDbManagementPrivateEndpointResource(), fieldMap, readSingularDatabaseManagementDbManagementPrivateEndpoint)
 }
 
-func readSingularDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.GetDbManagementPrivateEndpointResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) Get() erro
 		request.DbManagementPrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
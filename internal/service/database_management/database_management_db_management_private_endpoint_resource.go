@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func DatabaseManagementDbManagementPrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementDbManagementPrivateEndpoint,
-		Read:     readDatabaseManagementDbManagementPrivateEndpoint,
-		Update:   updateDatabaseManagementDbManagementPrivateEndpoint,
-		Delete:   deleteDatabaseManagementDbManagementPrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementDbManagementPrivateEndpoint,
+		ReadContext:   readDatabaseManagementDbManagementPrivateEndpoint,
+		UpdateContext: updateDatabaseManagementDbManagementPrivateEndpoint,
+		DeleteContext: deleteDatabaseManagementDbManagementPrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -89,37 +90,37 @@ func DatabaseManagementDbManagementPrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointResourceCrud struct {
@@ -199,22 +200,23 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Create() err
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.CreateDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDbManagementPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management"), oci_database_management.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDbManagementPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management"), oci_database_management.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) getDbManagementPrivateEndpointFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_database_management.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dbManagementPrivateEndpointId, err := dbManagementPrivateEndpointWaitForWorkRequest(workId, "dbmgmtprivateendpoint",
+	dbManagementPrivateEndpointId, err := dbManagementPrivateEndpointWaitForWorkRequest(s.Context(), workId, "dbmgmtprivateendpoint",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -249,9 +251,9 @@ func dbManagementPrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration
 	}
 }
 
-func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action oci_database_management.WorkRequestResourceActionTypeEnum,
+func dbManagementPrivateEndpointWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_database_management.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_database_management.DbManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "database_management")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "database_management")
 	retryPolicy.ShouldRetryOperation = dbManagementPrivateEndpointWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_database_management.GetWorkRequestResponse{}
@@ -268,7 +270,7 @@ func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_database_management.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -280,7 +282,7 @@ func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType strin
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -298,14 +300,14 @@ func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType strin
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_database_management.WorkRequestStatusFailed || response.Status == oci_database_management.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatabaseManagementDbManagementPrivateEndpointWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatabaseManagementDbManagementPrivateEndpointWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatabaseManagementDbManagementPrivateEndpointWorkRequest(client *oci_database_management.DbManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_management.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatabaseManagementDbManagementPrivateEndpointWorkRequest(ctx context.Context, client *oci_database_management.DbManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_management.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_database_management.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -333,9 +335,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Get() error
 	tmp := s.D.Id()
 	request.DbManagementPrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,9 +385,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Update() err
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.UpdateDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -400,16 +402,16 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Delete() err
 	tmp := s.D.Id()
 	request.DbManagementPrivateEndpointId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.DeleteDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := dbManagementPrivateEndpointWaitForWorkRequest(workId, "dbmgmtprivateendpoint",
+	_, delWorkRequestErr := dbManagementPrivateEndpointWaitForWorkRequest(s.Context(), workId, "dbmgmtprivateendpoint",
 		oci_database_management.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -503,14 +505,14 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) updateCompar
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DbManagementPrivateEndpointId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	_, err := s.Client.ChangeDbManagementPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDbManagementPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
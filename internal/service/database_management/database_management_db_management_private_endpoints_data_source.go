@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementDbManagementPrivateEndpoints,
+		ReadContext: readDatabaseManagementDbManagementPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DatabaseManagementDbManagementPrivateEndpointsDataSource() *schema.Resource
 	}
 }
 
-func readDatabaseManagementDbManagementPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementDbManagementPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListDbManagementPrivateEndpointsResponse
 }
@@ -101,9 +102,9 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDbManagementPrivateEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementJobExecutionsStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementJobExecutionsStatus,
+		ReadContext: readSingularDatabaseManagementJobExecutionsStatus,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -71,16 +72,16 @@ func DatabaseManagementJobExecutionsStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementJobExecutionsStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementJobExecutionsStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementJobExecutionsStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementJobExecutionsStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.SummarizeJobExecutionsStatusesResponse
 }
@@ -127,9 +128,9 @@ func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) Get() error {
 		request.StartTime = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementJobExecutionsStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementJobExecutionsStatuses,
+		ReadContext: readDatabaseManagementJobExecutionsStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,16 +80,16 @@ func DatabaseManagementJobExecutionsStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementJobExecutionsStatuses(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementJobExecutionsStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementJobExecutionsStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementJobExecutionsStatusesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.SummarizeJobExecutionsStatusesResponse
 }
@@ -135,9 +136,9 @@ func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) Get() error {
 		request.StartTime = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(s.Context(), request)
 	if err != nil {
 		return err
 	}
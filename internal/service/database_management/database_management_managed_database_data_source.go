@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabase,
+		ReadContext: readSingularDatabaseManagementManagedDatabase,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -100,16 +101,16 @@ func DatabaseManagementManagedDatabaseDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.GetManagedDatabaseResponse
 }
@@ -126,9 +127,9 @@ func (s *DatabaseManagementManagedDatabaseDataSourceCrud) Get() error {
 		request.ManagedDatabaseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetManagedDatabase(context.Background(), request)
+	response, err := s.Client.GetManagedDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
@@ -22,16 +23,16 @@ func DatabaseManagementManagedDatabaseGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseManagementManagedDatabaseGroupResource(), fieldMap, readSingularDatabaseManagementManagedDatabaseGroup)
 }
 
-func readSingularDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.GetManagedDatabaseGroupResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) Get() error {
 		request.ManagedDatabaseGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
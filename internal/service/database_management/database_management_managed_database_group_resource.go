@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
@@ -23,11 +24,11 @@ func DatabaseManagementManagedDatabaseGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementManagedDatabaseGroup,
-		Read:     readDatabaseManagementManagedDatabaseGroup,
-		Update:   updateDatabaseManagementManagedDatabaseGroup,
-		Delete:   deleteDatabaseManagementManagedDatabaseGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementManagedDatabaseGroup,
+		ReadContext:   readDatabaseManagementManagedDatabaseGroup,
+		UpdateContext: updateDatabaseManagementManagedDatabaseGroup,
+		DeleteContext: deleteDatabaseManagementManagedDatabaseGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -121,37 +122,37 @@ func managedDatabaseHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func createDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupResourceCrud struct {
@@ -223,9 +224,10 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.CreateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -266,7 +268,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) addManagedDatabaseT
 	request := oci_database_management.AddManagedDatabaseToManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.AddManagedDatabaseToManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -277,7 +279,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeManagedDataba
 	request := oci_database_management.RemoveManagedDatabaseFromManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.RemoveManagedDatabaseFromManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -289,9 +291,9 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) GetManagedDatabaseG
 	request := oci_database_management.GetManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -306,9 +308,9 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -349,9 +351,9 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.UpdateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -422,14 +424,14 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
 	mderr := s.removeAllManagedDatabases(request.ManagedDatabaseGroupId)
 	if mderr != nil {
 		return mderr
 	}
 
-	_, err := s.Client.DeleteManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedDatabaseGroup(s.Context(), request)
 	return err
 }
 
@@ -538,14 +540,14 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateCompartment(c
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ManagedDatabaseGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseGroups,
+		ReadContext: readDatabaseManagementManagedDatabaseGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func DatabaseManagementManagedDatabaseGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseGroups(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListManagedDatabaseGroupsResponse
 }
@@ -92,9 +93,9 @@ func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database_management.ListManagedDatabaseGroupsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListManagedDatabaseGroups(context.Background(), request)
+	response, err := s.Client.ListManagedDatabaseGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedDatabaseGroups(context.Background(), request)
+		listResponse, err := s.Client.ListManagedDatabaseGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
@@ -18,7 +19,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -106,16 +107,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSource() *schema.R
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTasksResponse
 }
@@ -157,9 +158,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Ge
 		request.TimeLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(s.Context(), request)
 	if err != nil {
 		return err
 	}
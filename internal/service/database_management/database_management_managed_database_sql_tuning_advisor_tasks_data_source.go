@@ -11,6 +11,7 @@ import (
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
@@ -18,7 +19,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -114,16 +115,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSource() *schema.
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTasksResponse
 }
@@ -165,9 +166,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 		request.TimeLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +177,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision,
 		Schema: map[string]*schema.Schema{
 			"execution_id": {
 				Type:     schema.TypeString,
@@ -92,16 +93,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsCom
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.GetExecutionPlanStatsComparisionResponse
 }
@@ -145,9 +146,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStat
 		request.SqlTuningAdvisorTaskId = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetExecutionPlanStatsComparision(context.Background(), request)
+	response, err := s.Client.GetExecutionPlanStatsComparision(s.Context(), request)
 	if err != nil {
 		return err
 	}
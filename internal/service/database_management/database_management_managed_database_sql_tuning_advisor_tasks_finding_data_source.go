@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding,
 		Schema: map[string]*schema.Schema{
 			"begin_exec_id": {
 				Type:     schema.TypeString,
@@ -137,16 +138,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource() *
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTaskFindingsResponse
 }
@@ -208,9 +209,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource
 		request.StatsHashFilter = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"begin_exec_id": {
@@ -145,16 +146,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSource()
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTaskFindingsResponse
 }
@@ -216,9 +217,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 		request.StatsHashFilter = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(s.Context(), request)
 		if err != nil {
 			return err
 		}
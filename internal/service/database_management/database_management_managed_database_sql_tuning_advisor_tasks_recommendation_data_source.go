@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation,
 		Schema: map[string]*schema.Schema{
 			"execution_id": {
 				Type:     schema.TypeString,
@@ -89,16 +90,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSou
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTaskRecommendationsResponse
 }
@@ -142,9 +143,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDat
 		request.SqlTuningAdvisorTaskId = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(s.Context(), request)
 	if err != nil {
 		return err
 	}
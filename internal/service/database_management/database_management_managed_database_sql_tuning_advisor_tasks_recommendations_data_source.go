@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"execution_id": {
@@ -97,16 +98,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSo
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.ListSqlTuningAdvisorTaskRecommendationsResponse
 }
@@ -150,9 +151,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 		request.SqlTuningAdvisorTaskId = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +162,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan,
 		Schema: map[string]*schema.Schema{
 			"attribute": {
 				Type:     schema.TypeString,
@@ -169,16 +170,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataS
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.GetSqlExecutionPlanResponse
 }
@@ -217,9 +218,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanD
 		request.SqlTuningAdvisorTaskId = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetSqlExecutionPlan(context.Background(), request)
+	response, err := s.Client.GetSqlExecutionPlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
@@ -19,7 +20,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport,
 		Schema: map[string]*schema.Schema{
 			"begin_exec_id_greater_than_or_equal_to": {
 				Type:     schema.TypeString,
@@ -284,16 +285,16 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSour
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.SqlTuningClient
 	Res    *oci_database_management.GetSqlTuningAdvisorTaskSummaryReportResponse
 }
@@ -357,9 +358,9 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportData
 		request.TimeLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(context.Background(), request)
+	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,16 +68,16 @@ func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSource() *s
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListConsumerGroupPrivilegesResponse
 }
@@ -103,9 +104,9 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceC
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListConsumerGroupPrivileges(context.Background(), request)
+	response, err := s.Client.ListConsumerGroupPrivileges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceC
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConsumerGroupPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListConsumerGroupPrivileges(s.Context(), request)
 		if err != nil {
 			return err
 		}
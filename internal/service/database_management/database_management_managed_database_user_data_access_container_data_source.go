@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataAccessContainerDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -51,16 +52,16 @@ func DatabaseManagementManagedDatabaseUserDataAccessContainerDataSource() *schem
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListDataAccessContainersResponse
 }
@@ -87,9 +88,9 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud)
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataAccessContainersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserDataAccessContainers,
+		ReadContext: readDatabaseManagementManagedDatabaseUserDataAccessContainers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -59,16 +60,16 @@ func DatabaseManagementManagedDatabaseUserDataAccessContainersDataSource() *sche
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserDataAccessContainers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserDataAccessContainers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListDataAccessContainersResponse
 }
@@ -95,9 +96,9 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAccessContainers(context.Background(), request)
+		listResponse, err := s.Client.ListDataAccessContainers(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUser,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUser,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -126,16 +127,16 @@ func DatabaseManagementManagedDatabaseUserDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUser(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.GetUserResponse
 }
@@ -157,9 +158,9 @@ func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) Get() error {
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -83,16 +84,16 @@ func DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSource() *schema.Re
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListObjectPrivilegesResponse
 }
@@ -119,9 +120,9 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Get
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserObjectPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabaseUserObjectPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -91,16 +92,16 @@ func DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSource() *schema.R
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserObjectPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserObjectPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListObjectPrivilegesResponse
 }
@@ -127,9 +128,9 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListObjectPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListObjectPrivileges(s.Context(), request)
 		if err != nil {
 			return err
 		}
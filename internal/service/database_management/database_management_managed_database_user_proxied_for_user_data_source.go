@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserProxiedForUserDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserProxiedForUser,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserProxiedForUser,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func DatabaseManagementManagedDatabaseUserProxiedForUserDataSource() *schema.Res
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserProxiedForUser(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserProxiedForUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListProxiedForUsersResponse
 }
@@ -95,9 +96,9 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Get(
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListProxiedForUsers(context.Background(), request)
+	response, err := s.Client.ListProxiedForUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserProxiedForUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserProxiedForUsers,
+		ReadContext: readDatabaseManagementManagedDatabaseUserProxiedForUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,16 +68,16 @@ func DatabaseManagementManagedDatabaseUserProxiedForUsersDataSource() *schema.Re
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserProxiedForUsers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserProxiedForUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListProxiedForUsersResponse
 }
@@ -103,9 +104,9 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListProxiedForUsers(context.Background(), request)
+	response, err := s.Client.ListProxiedForUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProxiedForUsers(context.Background(), request)
+		listResponse, err := s.Client.ListProxiedForUsers(s.Context(), request)
 		if err != nil {
 			return err
 		}
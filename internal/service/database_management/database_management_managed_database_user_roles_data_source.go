@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserRolesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserRoles,
+		ReadContext: readDatabaseManagementManagedDatabaseUserRoles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -79,16 +80,16 @@ func DatabaseManagementManagedDatabaseUserRolesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserRoles(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserRoles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserRolesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserRolesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListRolesResponse
 }
@@ -115,9 +116,9 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRoles(context.Background(), request)
+		listResponse, err := s.Client.ListRoles(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,16 +10,17 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesChangeDatabaseParameterResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementManagedDatabasesChangeDatabaseParameter,
-		Read:     readDatabaseManagementManagedDatabasesChangeDatabaseParameter,
-		Delete:   deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		ReadContext:   readDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		DeleteContext: deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"credentials": {
@@ -107,19 +108,19 @@ func DatabaseManagementManagedDatabasesChangeDatabaseParameterResource() *schema
 	}
 }
 
-func createDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -179,9 +180,10 @@ func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud)
 		request.Scope = oci_database_management.ParameterScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.ChangeDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ChangeDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesDatabaseParameterDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesDatabaseParameter,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesDatabaseParameter,
 		Schema: map[string]*schema.Schema{
 			"is_allowed_values_included": {
 				Type:     schema.TypeBool,
@@ -180,16 +181,16 @@ func DatabaseManagementManagedDatabasesDatabaseParameterDataSource() *schema.Res
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListDatabaseParametersResponse
 }
@@ -220,9 +221,9 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud) Get(
 		request.Source = oci_database_management.ListDatabaseParametersSourceEnum(source.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ListDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesDatabaseParametersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesDatabaseParameters,
+		ReadContext: readDatabaseManagementManagedDatabasesDatabaseParameters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"is_allowed_values_included": {
@@ -193,16 +194,16 @@ func DatabaseManagementManagedDatabasesDatabaseParametersDataSource() *schema.Re
 	}
 }
 
-func readDatabaseManagementManagedDatabasesDatabaseParameters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesDatabaseParameters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListDatabaseParametersResponse
 }
@@ -233,9 +234,9 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Get
 		request.Source = oci_database_management.ListDatabaseParametersSourceEnum(source.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ListDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
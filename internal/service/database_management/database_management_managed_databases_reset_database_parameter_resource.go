@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
@@ -17,10 +18,10 @@ import (
 
 func DatabaseManagementManagedDatabasesResetDatabaseParameterResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementManagedDatabasesResetDatabaseParameter,
-		Read:     readDatabaseManagementManagedDatabasesResetDatabaseParameter,
-		Delete:   deleteDatabaseManagementManagedDatabasesResetDatabaseParameter,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementManagedDatabasesResetDatabaseParameter,
+		ReadContext:   readDatabaseManagementManagedDatabasesResetDatabaseParameter,
+		DeleteContext: deleteDatabaseManagementManagedDatabasesResetDatabaseParameter,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"credentials": {
@@ -86,19 +87,19 @@ func DatabaseManagementManagedDatabasesResetDatabaseParameterResource() *schema.
 	}
 }
 
-func createDatabaseManagementManagedDatabasesResetDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementManagedDatabasesResetDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesResetDatabaseParameterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseManagementManagedDatabasesResetDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesResetDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseManagementManagedDatabasesResetDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementManagedDatabasesResetDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -150,9 +151,10 @@ func (s *DatabaseManagementManagedDatabasesResetDatabaseParameterResourceCrud) C
 		request.Scope = oci_database_management.ParameterScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.ResetDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ResetDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
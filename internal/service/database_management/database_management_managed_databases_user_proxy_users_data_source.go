@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserProxyUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesUserProxyUsers,
+		ReadContext: readDatabaseManagementManagedDatabasesUserProxyUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,16 +68,16 @@ func DatabaseManagementManagedDatabasesUserProxyUsersDataSource() *schema.Resour
 	}
 }
 
-func readDatabaseManagementManagedDatabasesUserProxyUsers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesUserProxyUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListProxyUsersResponse
 }
@@ -103,9 +104,9 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListProxyUsers(context.Background(), request)
+	response, err := s.Client.ListProxyUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProxyUsers(context.Background(), request)
+		listResponse, err := s.Client.ListProxyUsers(s.Context(), request)
 		if err != nil {
 			return err
 		}
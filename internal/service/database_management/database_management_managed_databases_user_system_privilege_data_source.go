@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -63,16 +64,16 @@ func DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSource() *schema.R
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListSystemPrivilegesResponse
 }
@@ -99,9 +100,9 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Ge
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSystemPrivileges(context.Background(), request)
+	response, err := s.Client.ListSystemPrivileges(s.Context(), request)
 	if err != nil {
 		return err
 	}
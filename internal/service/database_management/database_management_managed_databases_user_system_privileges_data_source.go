@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v61/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesUserSystemPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabasesUserSystemPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -71,16 +72,16 @@ func DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSource() *schema.
 	}
 }
 
-func readDatabaseManagementManagedDatabasesUserSystemPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesUserSystemPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_management.DbManagementClient
 	Res    *oci_database_management.ListSystemPrivilegesResponse
 }
@@ -107,9 +108,9 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) G
 		request.UserName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_management")
 
-	response, err := s.Client.ListSystemPrivileges(context.Background(), request)
+	response, err := s.Client.ListSystemPrivileges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) G
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSystemPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListSystemPrivileges(s.Context(), request)
 		if err != nil {
 			return err
 		}
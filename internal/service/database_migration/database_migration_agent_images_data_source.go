@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
 
 func DatabaseMigrationAgentImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationAgentImages,
+		ReadContext: readDatabaseMigrationAgentImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"agent_image_collection": {
@@ -56,16 +57,16 @@ func DatabaseMigrationAgentImagesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationAgentImages(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgentImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationAgentImagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.ListAgentImagesResponse
 }
@@ -77,9 +78,9 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) VoidState() {
 func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
 	request := oci_database_migration.ListAgentImagesRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.ListAgentImages(context.Background(), request)
+	response, err := s.Client.ListAgentImages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListAgentImages(s.Context(), request)
 		if err != nil {
 			return err
 		}
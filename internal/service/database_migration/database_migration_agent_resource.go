@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
@@ -19,11 +20,11 @@ func DatabaseMigrationAgentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigrationAgent,
-		Read:     readDatabaseMigrationAgent,
-		Update:   updateDatabaseMigrationAgent,
-		Delete:   deleteDatabaseMigrationAgent,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigrationAgent,
+		ReadContext:   readDatabaseMigrationAgent,
+		UpdateContext: updateDatabaseMigrationAgent,
+		DeleteContext: deleteDatabaseMigrationAgent,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agent_id": {
@@ -98,37 +99,37 @@ func DatabaseMigrationAgentResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseMigrationAgentResourceCrud struct {
@@ -211,9 +212,10 @@ func (s *DatabaseMigrationAgentResourceCrud) Create() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,9 +230,9 @@ func (s *DatabaseMigrationAgentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AgentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetAgent(context.Background(), request)
+	response, err := s.Client.GetAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -291,9 +293,9 @@ func (s *DatabaseMigrationAgentResourceCrud) Update() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -308,9 +310,9 @@ func (s *DatabaseMigrationAgentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AgentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteAgent(context.Background(), request)
+	_, err := s.Client.DeleteAgent(s.Context(), request)
 	return err
 }
 
@@ -421,14 +423,14 @@ func (s *DatabaseMigrationAgentResourceCrud) updateCompartment(compartment inter
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeAgentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAgentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
 
 func DatabaseMigrationAgentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationAgents,
+		ReadContext: readDatabaseMigrationAgents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func DatabaseMigrationAgentsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationAgents(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationAgentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.ListAgentsResponse
 }
@@ -83,9 +84,9 @@ func (s *DatabaseMigrationAgentsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database_migration.ListAgentsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.ListAgents(context.Background(), request)
+	response, err := s.Client.ListAgents(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseMigrationAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgents(context.Background(), request)
+		listResponse, err := s.Client.ListAgents(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
@@ -22,16 +23,16 @@ func DatabaseMigrationConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMigrationConnectionResource(), fieldMap, readSingularDatabaseMigrationConnection)
 }
 
-func readSingularDatabaseMigrationConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationConnectionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.GetConnectionResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseMigrationConnectionDataSourceCrud) Get() error {
 		request.ConnectionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
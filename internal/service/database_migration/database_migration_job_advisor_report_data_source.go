@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
 
 func DatabaseMigrationJobAdvisorReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseMigrationJobAdvisorReport,
+		ReadContext: readSingularDatabaseMigrationJobAdvisorReport,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:     schema.TypeString,
@@ -88,16 +89,16 @@ func DatabaseMigrationJobAdvisorReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseMigrationJobAdvisorReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationJobAdvisorReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobAdvisorReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationJobAdvisorReportDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.GetAdvisorReportResponse
 }
@@ -114,9 +115,9 @@ func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) Get() error {
 		request.JobId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.GetAdvisorReport(context.Background(), request)
+	response, err := s.Client.GetAdvisorReport(s.Context(), request)
 	if err != nil {
 		return err
 	}
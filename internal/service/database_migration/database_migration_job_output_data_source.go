@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
 
 func DatabaseMigrationJobOutputDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseMigrationJobOutput,
+		ReadContext: readSingularDatabaseMigrationJobOutput,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:     schema.TypeString,
@@ -43,16 +44,16 @@ func DatabaseMigrationJobOutputDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseMigrationJobOutput(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationJobOutput(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobOutputDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationJobOutputDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.ListJobOutputsResponse
 }
@@ -69,9 +70,9 @@ func (s *DatabaseMigrationJobOutputDataSourceCrud) Get() error {
 		request.JobId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.ListJobOutputs(context.Background(), request)
+	response, err := s.Client.ListJobOutputs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
@@ -19,11 +20,11 @@ func DatabaseMigrationJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigrationJob,
-		Read:     readDatabaseMigrationJob,
-		Update:   updateDatabaseMigrationJob,
-		Delete:   deleteDatabaseMigrationJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigrationJob,
+		ReadContext:   readDatabaseMigrationJob,
+		UpdateContext: updateDatabaseMigrationJob,
+		DeleteContext: deleteDatabaseMigrationJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"job_id": {
@@ -211,37 +212,37 @@ func DatabaseMigrationJobResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseMigrationJobResourceCrud struct {
@@ -301,8 +302,8 @@ func (s *DatabaseMigrationJobResourceCrud) Create() error {
 		tmp := jobId.(string)
 		request.JobId = &tmp
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -317,9 +318,9 @@ func (s *DatabaseMigrationJobResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,9 +352,9 @@ func (s *DatabaseMigrationJobResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -368,9 +369,9 @@ func (s *DatabaseMigrationJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(s.Context(), request)
 	return err
 }
 
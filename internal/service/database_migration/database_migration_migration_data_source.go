@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
@@ -22,16 +23,16 @@ func DatabaseMigrationMigrationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMigrationMigrationResource(), fieldMap, readSingularDatabaseMigrationMigration)
 }
 
-func readSingularDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationMigrationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.GetMigrationResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseMigrationMigrationDataSourceCrud) Get() error {
 		request.MigrationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v61/databasemigration"
 )
 
 func DatabaseMigrationMigrationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationMigrations,
+		ReadContext: readDatabaseMigrationMigrations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func DatabaseMigrationMigrationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationMigrations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationMigrations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseMigrationMigrationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_migration.DatabaseMigrationClient
 	Res    *oci_database_migration.ListMigrationsResponse
 }
@@ -91,9 +92,9 @@ func (s *DatabaseMigrationMigrationsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database_migration.ListMigrationsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_migration")
 
-	response, err := s.Client.ListMigrations(context.Background(), request)
+	response, err := s.Client.ListMigrations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *DatabaseMigrationMigrationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMigrations(context.Background(), request)
+		listResponse, err := s.Client.ListMigrations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
@@ -23,16 +24,16 @@ func DatabaseToolsDatabaseToolsConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseToolsDatabaseToolsConnectionResource(), fieldMap, readSingularDatabaseToolsDatabaseToolsConnection)
 }
 
-func readSingularDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsConnectionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.DatabaseToolsConnection //Res *oci_database_tools.GetDatabaseToolsConnectionResponse
 }
@@ -49,9 +50,9 @@ func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) Get() error {
 		request.DatabaseToolsConnectionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
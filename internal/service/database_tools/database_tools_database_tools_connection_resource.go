@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DatabaseToolsDatabaseToolsConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseToolsDatabaseToolsConnection,
-		Read:     readDatabaseToolsDatabaseToolsConnection,
-		Update:   updateDatabaseToolsDatabaseToolsConnection,
-		Delete:   deleteDatabaseToolsDatabaseToolsConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseToolsDatabaseToolsConnection,
+		ReadContext:   readDatabaseToolsDatabaseToolsConnection,
+		UpdateContext: updateDatabaseToolsDatabaseToolsConnection,
+		DeleteContext: deleteDatabaseToolsDatabaseToolsConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -242,37 +243,37 @@ func DatabaseToolsDatabaseToolsConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func createDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatabaseToolsDatabaseToolsConnectionResourceCrud struct {
@@ -318,22 +319,23 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.CreateDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.CreateDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) getDatabaseToolsConnectionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_database_tools.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	databaseToolsConnectionId, err := databaseToolsConnectionWaitForWorkRequest(workId, "databasetoolsconnection", // ""database_tools", // Rashik Bhasin indicates that it should match the entityType in the Work request Response
+	databaseToolsConnectionId, err := databaseToolsConnectionWaitForWorkRequest(s.Context(), workId, "databasetoolsconnection", // ""database_tools", // Rashik Bhasin indicates that it should match the entityType in the Work request Response
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -367,9 +369,9 @@ func databaseToolsConnectionWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, action oci_database_tools.ActionTypeEnum,
+func databaseToolsConnectionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_database_tools.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_database_tools.DatabaseToolsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "database_tools")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "database_tools")
 	retryPolicy.ShouldRetryOperation = databaseToolsConnectionWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_database_tools.GetWorkRequestResponse{}
@@ -386,7 +388,7 @@ func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_database_tools.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -398,7 +400,7 @@ func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -415,14 +417,14 @@ func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_database_tools.OperationStatusFailed || response.Status == oci_database_tools.OperationStatusCanceled {
-		return nil, getErrorFromDatabaseToolsDatabaseToolsConnectionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatabaseToolsDatabaseToolsConnectionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatabaseToolsDatabaseToolsConnectionWorkRequest(client *oci_database_tools.DatabaseToolsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_tools.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatabaseToolsDatabaseToolsConnectionWorkRequest(ctx context.Context, client *oci_database_tools.DatabaseToolsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_tools.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_database_tools.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -450,9 +452,9 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatabaseToolsConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -477,15 +479,15 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.UpdateDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Delete() error {
@@ -494,16 +496,16 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseToolsConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.DeleteDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := databaseToolsConnectionWaitForWorkRequest(workId, "databasetoolsconnection",
+	_, delWorkRequestErr := databaseToolsConnectionWaitForWorkRequest(s.Context(), workId, "databasetoolsconnection",
 		oci_database_tools.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1480,13 +1482,13 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) updateCompartment(com
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DatabaseToolsConnectionId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.ChangeDatabaseToolsConnectionCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseToolsConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseToolsConnectionFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "database_tools"), oci_database_tools.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
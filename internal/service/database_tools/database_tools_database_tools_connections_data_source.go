@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseToolsDatabaseToolsConnections,
+		ReadContext: readDatabaseToolsDatabaseToolsConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func DatabaseToolsDatabaseToolsConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseToolsDatabaseToolsConnections(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.ListDatabaseToolsConnectionsResponse
 }
@@ -106,9 +107,9 @@ func (s *DatabaseToolsDatabaseToolsConnectionsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsConnections(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +118,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsConnections(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsEndpointServiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseToolsDatabaseToolsEndpointService,
+		ReadContext: readSingularDatabaseToolsDatabaseToolsEndpointService,
 		Schema: map[string]*schema.Schema{
 			"database_tools_endpoint_service_id": {
 				Type:     schema.TypeString,
@@ -73,16 +74,16 @@ func DatabaseToolsDatabaseToolsEndpointServiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseToolsDatabaseToolsEndpointService(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseToolsDatabaseToolsEndpointService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.GetDatabaseToolsEndpointServiceResponse
 }
@@ -99,9 +100,9 @@ func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) Get() error {
 		request.DatabaseToolsEndpointServiceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsEndpointService(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsEndpointService(s.Context(), request)
 	if err != nil {
 		return err
 	}
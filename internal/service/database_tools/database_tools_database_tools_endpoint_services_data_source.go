@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsEndpointServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseToolsDatabaseToolsEndpointServices,
+		ReadContext: readDatabaseToolsDatabaseToolsEndpointServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -110,16 +111,16 @@ func DatabaseToolsDatabaseToolsEndpointServicesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseToolsDatabaseToolsEndpointServices(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsEndpointServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.ListDatabaseToolsEndpointServicesResponse
 }
@@ -150,9 +151,9 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database_tools.ListDatabaseToolsEndpointServicesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsEndpointServices(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +162,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
@@ -22,16 +23,16 @@ func DatabaseToolsDatabaseToolsPrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseToolsDatabaseToolsPrivateEndpointResource(), fieldMap, readSingularDatabaseToolsDatabaseToolsPrivateEndpoint)
 }
 
-func readSingularDatabaseToolsDatabaseToolsPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseToolsDatabaseToolsPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsPrivateEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.GetDatabaseToolsPrivateEndpointResponse
 }
@@ -48,9 +49,9 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointDataSourceCrud) Get() error {
 		request.DatabaseToolsPrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v61/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseToolsDatabaseToolsPrivateEndpoints,
+		ReadContext: readDatabaseToolsDatabaseToolsPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DatabaseToolsDatabaseToolsPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseToolsDatabaseToolsPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_database_tools.DatabaseToolsClient
 	Res    *oci_database_tools.ListDatabaseToolsPrivateEndpointsResponse
 }
@@ -101,9 +102,9 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
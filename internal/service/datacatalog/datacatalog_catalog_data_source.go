@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
@@ -22,16 +23,16 @@ func DatacatalogCatalogDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogCatalogResource(), fieldMap, readSingularDatacatalogCatalog)
 }
 
-func readSingularDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogCatalogDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.GetCatalogResponse
 }
@@ -48,9 +49,9 @@ func (s *DatacatalogCatalogDataSourceCrud) Get() error {
 		request.CatalogId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
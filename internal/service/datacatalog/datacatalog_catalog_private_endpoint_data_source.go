@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
@@ -22,16 +23,16 @@ func DatacatalogCatalogPrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogCatalogPrivateEndpointResource(), fieldMap, readSingularDatacatalogCatalogPrivateEndpoint)
 }
 
-func readSingularDatacatalogCatalogPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogCatalogPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogCatalogPrivateEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.GetCatalogPrivateEndpointResponse
 }
@@ -48,9 +49,9 @@ func (s *DatacatalogCatalogPrivateEndpointDataSourceCrud) Get() error {
 		request.CatalogPrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.GetCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetCatalogPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
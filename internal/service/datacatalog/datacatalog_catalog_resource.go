@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DatacatalogCatalogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogCatalog,
-		Read:     readDatacatalogCatalog,
-		Update:   updateDatacatalogCatalog,
-		Delete:   deleteDatacatalogCatalog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogCatalog,
+		ReadContext:   readDatacatalogCatalog,
+		UpdateContext: updateDatacatalogCatalog,
+		DeleteContext: deleteDatacatalogCatalog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -98,37 +99,37 @@ func DatacatalogCatalogResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatacatalogCatalogResourceCrud struct {
@@ -191,15 +192,16 @@ func (s *DatacatalogCatalogResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateCatalog(context.Background(), request)
+	response, err := s.Client.CreateCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -232,7 +234,7 @@ func (s *DatacatalogCatalogResourceCrud) getCatalogFromWorkRequest(workId *strin
 	actionTypeEnum oci_datacatalog.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	catalogId, err := catalogWaitForWorkRequest(workId, "catalog",
+	catalogId, err := catalogWaitForWorkRequest(s.Context(), workId, "catalog",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -266,9 +268,9 @@ func catalogWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func catalogWaitForWorkRequest(wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
+func catalogWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_datacatalog.DataCatalogClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "datacatalog")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "datacatalog")
 	retryPolicy.ShouldRetryOperation = catalogWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_datacatalog.GetWorkRequestResponse{}
@@ -285,7 +287,7 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -297,7 +299,7 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -314,14 +316,14 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_datacatalog.WorkRequestStatusFailed || response.Status == oci_datacatalog.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatacatalogCatalogWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatacatalogCatalogWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatacatalogCatalogWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatacatalogCatalogWorkRequest(ctx context.Context, client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -349,9 +351,9 @@ func (s *DatacatalogCatalogResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CatalogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -434,9 +436,9 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateCatalog(context.Background(), request)
+	response, err := s.Client.UpdateCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -451,7 +453,7 @@ func (s *DatacatalogCatalogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CatalogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
 	// Need to detach all Private Endpoints before delete Catalog
 	if attachedCatalogPrivateEndpoints, ok := s.D.GetOkExists("attached_catalog_private_endpoints"); ok {
@@ -471,14 +473,14 @@ func (s *DatacatalogCatalogResourceCrud) Delete() error {
 		}
 	}
 
-	response, err := s.Client.DeleteCatalog(context.Background(), request)
+	response, err := s.Client.DeleteCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := catalogWaitForWorkRequest(workId, "catalog",
+	_, delWorkRequestErr := catalogWaitForWorkRequest(s.Context(), workId, "catalog",
 		oci_datacatalog.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -539,16 +541,16 @@ func (s *DatacatalogCatalogResourceCrud) attachCatalogPrivateEndpoints(attachCat
 		request := oci_datacatalog.AttachCatalogPrivateEndpointRequest{}
 		request.CatalogPrivateEndpointId = &attachCatalogPrivateEndpoint
 		request.CatalogId = &catalogId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.AttachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.AttachCatalogPrivateEndpoint(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 
 		// Wait until Update finishes
-		_, err = catalogWaitForWorkRequest(workId, "catalog",
+		_, err = catalogWaitForWorkRequest(s.Context(), workId, "catalog",
 			oci_datacatalog.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	}
 	return nil
@@ -560,16 +562,16 @@ func (s *DatacatalogCatalogResourceCrud) detachCatalogPrivateEndpoints(detachCat
 		request := oci_datacatalog.DetachCatalogPrivateEndpointRequest{}
 		request.CatalogPrivateEndpointId = &detachCatalogPrivateEndpoint
 		request.CatalogId = &catalogId
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.DetachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.DetachCatalogPrivateEndpoint(s.Context(), request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, err = catalogWaitForWorkRequest(workId, "catalog",
+		_, err = catalogWaitForWorkRequest(s.Context(), workId, "catalog",
 			oci_datacatalog.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	}
 	return nil
@@ -584,13 +586,13 @@ func (s *DatacatalogCatalogResourceCrud) updateCompartment(compartment interface
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.ChangeCatalogCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCatalogCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "catalog"), oci_datacatalog.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "catalog"), oci_datacatalog.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
 
 func DatacatalogCatalogTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatacatalogCatalogType,
+		ReadContext: readSingularDatacatalogCatalogType,
 		Schema: map[string]*schema.Schema{
 			"catalog_id": {
 				Type:     schema.TypeString,
@@ -84,16 +85,16 @@ func DatacatalogCatalogTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatacatalogCatalogType(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogCatalogType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogCatalogTypeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.GetTypeResponse
 }
@@ -129,9 +130,9 @@ func (s *DatacatalogCatalogTypeDataSourceCrud) Get() error {
 		request.TypeKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.GetType(context.Background(), request)
+	response, err := s.Client.GetType(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
 
 func DatacatalogCatalogTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogCatalogTypes,
+		ReadContext: readDatacatalogCatalogTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -110,16 +111,16 @@ func DatacatalogCatalogTypesDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogCatalogTypes(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogCatalogTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogCatalogTypesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.ListTypesResponse
 }
@@ -184,9 +185,9 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 		request.TypeCategory = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.ListTypes(context.Background(), request)
+	response, err := s.Client.ListTypes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTypes(context.Background(), request)
+		listResponse, err := s.Client.ListTypes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
 
 func DatacatalogCatalogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogCatalogs,
+		ReadContext: readDatacatalogCatalogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func DatacatalogCatalogsDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogCatalogs(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogCatalogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogCatalogsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.ListCatalogsResponse
 }
@@ -74,9 +75,9 @@ func (s *DatacatalogCatalogsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_datacatalog.ListCatalogsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.ListCatalogs(context.Background(), request)
+	response, err := s.Client.ListCatalogs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DatacatalogCatalogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCatalogs(context.Background(), request)
+		listResponse, err := s.Client.ListCatalogs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
@@ -24,11 +25,11 @@ func DatacatalogConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogConnection,
-		Read:     readDatacatalogConnection,
-		Update:   updateDatacatalogConnection,
-		Delete:   deleteDatacatalogConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogConnection,
+		ReadContext:   readDatacatalogConnection,
+		UpdateContext: updateDatacatalogConnection,
+		DeleteContext: deleteDatacatalogConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"catalog_id": {
@@ -117,37 +118,37 @@ func DatacatalogConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatacatalogConnectionResourceCrud struct {
@@ -234,9 +235,10 @@ func (s *DatacatalogConnectionResourceCrud) Create() error {
 		request.TypeKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,9 +285,9 @@ func (s *DatacatalogConnectionResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,9 +343,9 @@ func (s *DatacatalogConnectionResourceCrud) Update() error {
 		request.Properties = convertedProperties
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -373,9 +375,9 @@ func (s *DatacatalogConnectionResourceCrud) Delete() error {
 		request.DataAssetKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteConnection(context.Background(), request)
+	_, err := s.Client.DeleteConnection(s.Context(), request)
 	return err
 }
 
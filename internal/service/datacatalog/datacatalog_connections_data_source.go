@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
@@ -17,7 +18,7 @@ import (
 
 func DatacatalogConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogConnections,
+		ReadContext: readDatacatalogConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -99,16 +100,16 @@ func DatacatalogConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogConnections(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogConnectionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.ListConnectionsResponse
 }
@@ -202,9 +203,9 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 		request.UpdatedById = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.ListConnections(context.Background(), request)
+	response, err := s.Client.ListConnections(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +214,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnections(context.Background(), request)
+		listResponse, err := s.Client.ListConnections(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
@@ -24,11 +25,11 @@ func DatacatalogDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogDataAsset,
-		Read:     readDatacatalogDataAsset,
-		Update:   updateDatacatalogDataAsset,
-		Delete:   deleteDatacatalogDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogDataAsset,
+		ReadContext:   readDatacatalogDataAsset,
+		UpdateContext: updateDatacatalogDataAsset,
+		DeleteContext: deleteDatacatalogDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"catalog_id": {
@@ -102,37 +103,37 @@ func DatacatalogDataAssetResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatacatalogDataAssetResourceCrud struct {
@@ -201,9 +202,10 @@ func (s *DatacatalogDataAssetResourceCrud) Create() error {
 		request.TypeKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,9 +246,9 @@ func (s *DatacatalogDataAssetResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,9 +286,9 @@ func (s *DatacatalogDataAssetResourceCrud) Update() error {
 		request.Properties = convertedProperties
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -308,9 +310,9 @@ func (s *DatacatalogDataAssetResourceCrud) Delete() error {
 		request.DataAssetKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(s.Context(), request)
 	return err
 }
 
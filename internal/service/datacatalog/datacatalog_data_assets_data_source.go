@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
@@ -17,7 +18,7 @@ import (
 
 func DatacatalogDataAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogDataAssets,
+		ReadContext: readDatacatalogDataAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -79,16 +80,16 @@ func DatacatalogDataAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogDataAssets(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogDataAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogDataAssetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.ListDataAssetsResponse
 }
@@ -169,9 +170,9 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 		request.UpdatedById = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(s.Context(), request)
 		if err != nil {
 			return err
 		}
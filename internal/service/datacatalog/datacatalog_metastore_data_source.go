@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v61/datacatalog"
 )
@@ -22,16 +23,16 @@ func DatacatalogMetastoreDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogMetastoreResource(), fieldMap, readSingularDatacatalogMetastore)
 }
 
-func readSingularDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatacatalogMetastoreDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datacatalog.DataCatalogClient
 	Res    *oci_datacatalog.GetMetastoreResponse
 }
@@ -48,9 +49,9 @@ func (s *DatacatalogMetastoreDataSourceCrud) Get() error {
 		request.MetastoreId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datacatalog")
 
-	response, err := s.Client.GetMetastore(context.Background(), request)
+	response, err := s.Client.GetMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DatacatalogMetastoreResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogMetastore,
-		Read:     readDatacatalogMetastore,
-		Update:   updateDatacatalogMetastore,
-		Delete:   deleteDatacatalogMetastore,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogMetastore,
+		ReadContext:   readDatacatalogMetastore,
+		UpdateContext: updateDatacatalogMetastore,
+		DeleteContext: deleteDatacatalogMetastore,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func DatacatalogMetastoreResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatacatalogMetastoreResourceCrud struct {
@@ -190,15 +191,16 @@ func (s *DatacatalogMetastoreResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateMetastore(context.Background(), request)
+	response, err := s.Client.CreateMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getMetastoreFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getMetastoreFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -209,7 +211,7 @@ func (s *DatacatalogMetastoreResourceCrud) getMetastoreFromWorkRequest(workId *s
 	actionTypeEnum oci_datacatalog.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	catalogId, err := catalogMetastoreWaitForWorkRequest(workId, "metastore",
+	catalogId, err := catalogMetastoreWaitForWorkRequest(s.Context(), workId, "metastore",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -220,9 +222,9 @@ func (s *DatacatalogMetastoreResourceCrud) getMetastoreFromWorkRequest(workId *s
 	return s.Get()
 }
 
-func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
+func catalogMetastoreWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_datacatalog.DataCatalogClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "datacatalog")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "datacatalog")
 	retryPolicy.ShouldRetryOperation = catalogMetastoreWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_datacatalog.GetWorkRequestResponse{}
@@ -239,7 +241,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -251,7 +253,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -268,7 +270,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_datacatalog.WorkRequestStatusFailed || response.Status == oci_datacatalog.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatacatalogCatalogMetastoreWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatacatalogCatalogMetastoreWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -297,8 +299,8 @@ func catalogMetastoreWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func getErrorFromDatacatalogCatalogMetastoreWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatacatalogCatalogMetastoreWorkRequest(ctx context.Context, client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -326,9 +328,9 @@ func (s *DatacatalogMetastoreResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MetastoreId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetMetastore(context.Background(), request)
+	response, err := s.Client.GetMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -369,9 +371,9 @@ func (s *DatacatalogMetastoreResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MetastoreId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateMetastore(context.Background(), request)
+	response, err := s.Client.UpdateMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,9 +388,9 @@ func (s *DatacatalogMetastoreResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MetastoreId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteMetastore(context.Background(), request)
+	_, err := s.Client.DeleteMetastore(s.Context(), request)
 	return err
 }
 
@@ -441,9 +443,9 @@ func (s *DatacatalogMetastoreResourceCrud) updateCompartment(compartment interfa
 	idTmp := s.D.Id()
 	changeCompartmentRequest.MetastoreId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.ChangeMetastoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMetastoreCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
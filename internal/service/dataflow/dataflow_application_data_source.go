@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
@@ -22,16 +23,16 @@ func DataflowApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataflowApplicationResource(), fieldMap, readSingularDataflowApplication)
 }
 
-func readSingularDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowApplicationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.GetApplicationResponse
 }
@@ -48,9 +49,9 @@ func (s *DataflowApplicationDataSourceCrud) Get() error {
 		request.ApplicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
@@ -20,11 +21,11 @@ func DataflowApplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataflowApplication,
-		Read:     readDataflowApplication,
-		Update:   updateDataflowApplication,
-		Delete:   deleteDataflowApplication,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataflowApplication,
+		ReadContext:   readDataflowApplication,
+		UpdateContext: updateDataflowApplication,
+		DeleteContext: deleteDataflowApplication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -180,37 +181,37 @@ func DataflowApplicationResource() *schema.Resource {
 	}
 }
 
-func createDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func createDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func readDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func updateDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func deleteDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataflowApplicationResourceCrud struct {
@@ -376,9 +377,10 @@ func (s *DataflowApplicationResourceCrud) Create() error {
 		request.WarehouseBucketUri = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,9 +395,9 @@ func (s *DataflowApplicationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -539,9 +541,9 @@ func (s *DataflowApplicationResourceCrud) Update() error {
 		request.WarehouseBucketUri = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -556,9 +558,9 @@ func (s *DataflowApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(s.Context(), request)
 	return err
 }
 
@@ -703,14 +705,14 @@ func (s *DataflowApplicationResourceCrud) updateCompartment(compartment interfac
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
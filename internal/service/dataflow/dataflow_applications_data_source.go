@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
 
 func DataflowApplicationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowApplications,
+		ReadContext: readDataflowApplications,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func DataflowApplicationsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowApplications(d *schema.ResourceData, m interface{}) error {
+func readDataflowApplications(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowApplicationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.ListApplicationsResponse
 }
@@ -93,9 +94,9 @@ func (s *DataflowApplicationsDataSourceCrud) Get() error {
 		request.SparkVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.ListApplications(context.Background(), request)
+	response, err := s.Client.ListApplications(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DataflowApplicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApplications(context.Background(), request)
+		listResponse, err := s.Client.ListApplications(s.Context(), request)
 		if err != nil {
 			return err
 		}
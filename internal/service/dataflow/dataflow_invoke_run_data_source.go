@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
@@ -23,16 +24,16 @@ func DataflowInvokeRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataflowInvokeRunResource(), fieldMap, readSingularDataflowInvokeRun)
 }
 
-func readSingularDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowInvokeRunDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.GetRunResponse
 }
@@ -49,9 +50,9 @@ func (s *DataflowInvokeRunDataSourceCrud) Get() error {
 		request.RunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
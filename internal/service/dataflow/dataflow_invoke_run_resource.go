@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
@@ -21,11 +22,11 @@ func DataflowInvokeRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataflowInvokeRun,
-		Read:     readDataflowInvokeRun,
-		Update:   updateDataflowInvokeRun,
-		Delete:   deleteDataflowInvokeRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataflowInvokeRun,
+		ReadContext:   readDataflowInvokeRun,
+		UpdateContext: updateDataflowInvokeRun,
+		DeleteContext: deleteDataflowInvokeRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -254,37 +255,37 @@ func DataflowInvokeRunResource() *schema.Resource {
 	}
 }
 
-func createDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func createDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func readDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func updateDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func deleteDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataflowInvokeRunResourceCrud struct {
@@ -460,9 +461,10 @@ func (s *DataflowInvokeRunResourceCrud) Create() error {
 		request.WarehouseBucketUri = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateRun(context.Background(), request)
+	response, err := s.Client.CreateRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -477,9 +479,9 @@ func (s *DataflowInvokeRunResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -515,9 +517,9 @@ func (s *DataflowInvokeRunResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateRun(context.Background(), request)
+	response, err := s.Client.UpdateRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -532,9 +534,9 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	getRequest.RunId = &tmp
 
-	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	getResponse, err := s.Client.GetRun(context.Background(), getRequest)
+	getResponse, err := s.Client.GetRun(s.Context(), getRequest)
 	if err != nil {
 		return err
 	}
@@ -547,9 +549,9 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 	tmp = s.D.Id()
 	request.RunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	_, err = s.Client.DeleteRun(context.Background(), request)
+	_, err = s.Client.DeleteRun(s.Context(), request)
 	return err
 }
 
@@ -717,14 +719,14 @@ func (s *DataflowInvokeRunResourceCrud) updateCompartment(compartment interface{
 		changeCompartmentRequest.RunId = &runId
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRunCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
@@ -18,7 +19,7 @@ import (
 
 func DataflowInvokeRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowInvokeRuns,
+		ReadContext: readDataflowInvokeRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"application_id": {
@@ -58,16 +59,16 @@ func DataflowInvokeRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowInvokeRuns(d *schema.ResourceData, m interface{}) error {
+func readDataflowInvokeRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowInvokeRunsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.ListRunsResponse
 }
@@ -116,9 +117,9 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 		request.TimeCreatedGreaterThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.ListRuns(context.Background(), request)
+	response, err := s.Client.ListRuns(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRuns(context.Background(), request)
+		listResponse, err := s.Client.ListRuns(s.Context(), request)
 		if err != nil {
 			return err
 		}
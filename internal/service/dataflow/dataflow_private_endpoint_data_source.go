@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
@@ -22,16 +23,16 @@ func DataflowPrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataflowPrivateEndpointResource(), fieldMap, readSingularDataflowPrivateEndpoint)
 }
 
-func readSingularDataflowPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowPrivateEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.GetPrivateEndpointResponse
 }
@@ -48,9 +49,9 @@ func (s *DataflowPrivateEndpointDataSourceCrud) Get() error {
 		request.PrivateEndpointId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.GetPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
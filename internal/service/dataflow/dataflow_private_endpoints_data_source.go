@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
 
 func DataflowPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowPrivateEndpoints,
+		ReadContext: readDataflowPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DataflowPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDataflowPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowPrivateEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.ListPrivateEndpointsResponse
 }
@@ -101,9 +102,9 @@ func (s *DataflowPrivateEndpointsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_dataflow.ListPrivateEndpointsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListPrivateEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DataflowPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -12,13 +12,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
 
 func DataflowRunLogDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataflowRunLog,
+		ReadContext: readSingularDataflowRunLog,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -46,16 +47,16 @@ func DataflowRunLogDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataflowRunLog(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowRunLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowRunLogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowRunLogDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.GetRunLogResponse
 }
@@ -77,9 +78,9 @@ func (s *DataflowRunLogDataSourceCrud) Get() error {
 		request.RunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.GetRunLog(context.Background(), request)
+	response, err := s.Client.GetRunLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v61/dataflow"
 )
 
 func DataflowRunLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowRunLogs,
+		ReadContext: readDataflowRunLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"run_id": {
@@ -64,16 +65,16 @@ func DataflowRunLogsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowRunLogs(d *schema.ResourceData, m interface{}) error {
+func readDataflowRunLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowRunLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataflowRunLogsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataflow.DataFlowClient
 	Res    *oci_dataflow.ListRunLogsResponse
 }
@@ -90,9 +91,9 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 		request.RunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataflow")
 
-	response, err := s.Client.ListRunLogs(context.Background(), request)
+	response, err := s.Client.ListRunLogs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +102,7 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRunLogs(context.Background(), request)
+		listResponse, err := s.Client.ListRunLogs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataintegration "github.com/oracle/oci-go-sdk/v61/dataintegration"
 )
@@ -22,16 +23,16 @@ func DataintegrationWorkspaceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataintegrationWorkspaceResource(), fieldMap, readSingularDataintegrationWorkspace)
 }
 
-func readSingularDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func readSingularDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataintegrationWorkspaceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataintegration.DataIntegrationClient
 	Res    *oci_dataintegration.GetWorkspaceResponse
 }
@@ -48,9 +49,9 @@ func (s *DataintegrationWorkspaceDataSourceCrud) Get() error {
 		request.WorkspaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataintegration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataintegration")
 
-	response, err := s.Client.GetWorkspace(context.Background(), request)
+	response, err := s.Client.GetWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
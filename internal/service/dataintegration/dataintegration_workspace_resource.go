@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,10 +32,10 @@ func DataintegrationWorkspaceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createDataintegrationWorkspace,
-		Read:   readDataintegrationWorkspace,
-		Update: updateDataintegrationWorkspace,
-		Delete: deleteDataintegrationWorkspace,
+		CreateContext: createDataintegrationWorkspace,
+		ReadContext:   readDataintegrationWorkspace,
+		UpdateContext: updateDataintegrationWorkspace,
+		DeleteContext: deleteDataintegrationWorkspace,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -125,37 +126,37 @@ func DataintegrationWorkspaceResource() *schema.Resource {
 	}
 }
 
-func createDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func createDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func readDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func updateDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func deleteDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DataintegrationWorkspaceResourceCrud struct {
@@ -249,22 +250,23 @@ func (s *DataintegrationWorkspaceResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.CreateWorkspace(context.Background(), request)
+	response, err := s.Client.CreateWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DataintegrationWorkspaceResourceCrud) getWorkspaceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_dataintegration.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	workspaceId, err := workspaceWaitForWorkRequest(workId, "disworkspace",
+	workspaceId, err := workspaceWaitForWorkRequest(s.Context(), workId, "disworkspace",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -300,9 +302,9 @@ func workspaceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_dataintegration.WorkRequestResourceActionTypeEnum,
+func workspaceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_dataintegration.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_dataintegration.DataIntegrationClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "dataintegration")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "dataintegration")
 	retryPolicy.ShouldRetryOperation = workspaceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_dataintegration.GetWorkRequestResponse{}
@@ -319,7 +321,7 @@ func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_data
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_dataintegration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -331,7 +333,7 @@ func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_data
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -348,14 +350,14 @@ func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_data
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_dataintegration.WorkRequestStatusFailed || response.Status == oci_dataintegration.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataintegrationWorkspaceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataintegrationWorkspaceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataintegrationWorkspaceWorkRequest(client *oci_dataintegration.DataIntegrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_dataintegration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataintegrationWorkspaceWorkRequest(ctx context.Context, client *oci_dataintegration.DataIntegrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_dataintegration.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_dataintegration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -383,9 +385,9 @@ func (s *DataintegrationWorkspaceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.WorkspaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.GetWorkspace(context.Background(), request)
+	response, err := s.Client.GetWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -431,15 +433,15 @@ func (s *DataintegrationWorkspaceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WorkspaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.UpdateWorkspace(context.Background(), request)
+	response, err := s.Client.UpdateWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataintegrationWorkspaceResourceCrud) Delete() error {
@@ -462,16 +464,16 @@ func (s *DataintegrationWorkspaceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WorkspaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.DeleteWorkspace(context.Background(), request)
+	response, err := s.Client.DeleteWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := workspaceWaitForWorkRequest(workId, "disworkspace",
+	_, delWorkRequestErr := workspaceWaitForWorkRequest(s.Context(), workId, "disworkspace",
 		oci_dataintegration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -541,13 +543,13 @@ func (s *DataintegrationWorkspaceResourceCrud) updateCompartment(compartment int
 	idTmp := s.D.Id()
 	changeCompartmentRequest.WorkspaceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.ChangeCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWorkspaceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dataintegration"), oci_dataintegration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
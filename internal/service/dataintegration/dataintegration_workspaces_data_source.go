@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataintegration "github.com/oracle/oci-go-sdk/v61/dataintegration"
 )
 
 func DataintegrationWorkspacesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataintegrationWorkspaces,
+		ReadContext: readDataintegrationWorkspaces,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func DataintegrationWorkspacesDataSource() *schema.Resource {
 	}
 }
 
-func readDataintegrationWorkspaces(d *schema.ResourceData, m interface{}) error {
+func readDataintegrationWorkspaces(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspacesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DataintegrationWorkspacesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dataintegration.DataIntegrationClient
 	Res    *oci_dataintegration.ListWorkspacesResponse
 }
@@ -74,9 +75,9 @@ func (s *DataintegrationWorkspacesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_dataintegration.WorkspaceLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataintegration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dataintegration")
 
-	response, err := s.Client.ListWorkspaces(context.Background(), request)
+	response, err := s.Client.ListWorkspaces(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DataintegrationWorkspacesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkspaces(context.Background(), request)
+		listResponse, err := s.Client.ListWorkspaces(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package datascience
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 
@@ -15,7 +16,7 @@ import (
 
 func DatascienceFastLaunchJobConfigsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceFastLaunchJobConfigs,
+		ReadContext: readDatascienceFastLaunchJobConfigs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -63,16 +64,16 @@ func DatascienceFastLaunchJobConfigsDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceFastLaunchJobConfigs(d *schema.ResourceData, m interface{}) error {
+func readDatascienceFastLaunchJobConfigs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceFastLaunchJobConfigsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceFastLaunchJobConfigsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.ListFastLaunchJobConfigsResponse
 }
@@ -89,9 +90,9 @@ func (s *DatascienceFastLaunchJobConfigsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.ListFastLaunchJobConfigs(context.Background(), request)
+	response, err := s.Client.ListFastLaunchJobConfigs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -100,7 +101,7 @@ func (s *DatascienceFastLaunchJobConfigsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastLaunchJobConfigs(context.Background(), request)
+		listResponse, err := s.Client.ListFastLaunchJobConfigs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
@@ -22,16 +23,16 @@ func DatascienceJobDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceJobResource(), fieldMap, readSingularDatascienceJob)
 }
 
-func readSingularDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceJobDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.GetJobResponse
 }
@@ -48,9 +49,9 @@ func (s *DatascienceJobDataSourceCrud) Get() error {
 		request.JobId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
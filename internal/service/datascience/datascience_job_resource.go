@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func DatascienceJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceJob,
-		Read:     readDatascienceJob,
-		Update:   updateDatascienceJob,
-		Delete:   deleteDatascienceJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceJob,
+		ReadContext:   readDatascienceJob,
+		UpdateContext: updateDatascienceJob,
+		DeleteContext: deleteDatascienceJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -249,47 +250,48 @@ func DatascienceJobResource() *schema.Resource {
 	}
 }
 
-func createDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func createDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if _, ok := d.GetOkExists("job_artifact"); ok {
 		if e := sync.CreateArtifact(); e != nil {
-			return e
+			return tfresource.ToDiagnostics(sync, e)
 		}
 	}
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func readDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type HeadJobArtifact struct {
@@ -402,9 +404,10 @@ func (s *DatascienceJobResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJob(context.Background(), request)
+	response, err := s.Client.CreateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -419,9 +422,9 @@ func (s *DatascienceJobResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -487,9 +490,9 @@ func (s *DatascienceJobResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -509,9 +512,9 @@ func (s *DatascienceJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(s.Context(), request)
 	return err
 }
 
@@ -774,9 +777,9 @@ func (s *DatascienceJobResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.JobId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -812,9 +815,9 @@ func (s *DatascienceJobResourceCrud) CreateArtifact() error {
 
 	request.JobId = s.Res.Id
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateJobArtifact(context.Background(), request)
+	_, err := s.Client.CreateJobArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -827,9 +830,9 @@ func (s *DatascienceJobResourceCrud) GetArtifactHead() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "datascience")
 
-	response, err := s.Client.HeadJobArtifact(context.Background(), request)
+	response, err := s.Client.HeadJobArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
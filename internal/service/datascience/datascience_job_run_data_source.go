@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
@@ -22,16 +23,16 @@ func DatascienceJobRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceJobRunResource(), fieldMap, readSingularDatascienceJobRun)
 }
 
-func readSingularDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceJobRunDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.GetJobRunResponse
 }
@@ -48,9 +49,9 @@ func (s *DatascienceJobRunDataSourceCrud) Get() error {
 		request.JobRunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
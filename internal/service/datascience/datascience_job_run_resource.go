@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DatascienceJobRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceJobRun,
-		Read:     readDatascienceJobRun,
-		Update:   updateDatascienceJobRun,
-		Delete:   deleteDatascienceJobRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceJobRun,
+		ReadContext:   readDatascienceJobRun,
+		UpdateContext: updateDatascienceJobRun,
+		DeleteContext: deleteDatascienceJobRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -238,37 +239,37 @@ func DatascienceJobRunResource() *schema.Resource {
 	}
 }
 
-func createDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func createDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatascienceJobRunResourceCrud struct {
@@ -385,9 +386,10 @@ func (s *DatascienceJobRunResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJobRun(context.Background(), request)
+	response, err := s.Client.CreateJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -402,9 +404,9 @@ func (s *DatascienceJobRunResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.JobRunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -445,9 +447,9 @@ func (s *DatascienceJobRunResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.JobRunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJobRun(context.Background(), request)
+	response, err := s.Client.UpdateJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -462,9 +464,9 @@ func (s *DatascienceJobRunResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobRunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJobRun(context.Background(), request)
+	_, err := s.Client.DeleteJobRun(s.Context(), request)
 	return err
 }
 
@@ -632,9 +634,9 @@ func (s *DatascienceJobRunResourceCrud) updateCompartment(compartment interface{
 	idTmp := s.D.Id()
 	changeCompartmentRequest.JobRunId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobRunCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
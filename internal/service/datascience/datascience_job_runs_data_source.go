@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
 
 func DatascienceJobRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceJobRuns,
+		ReadContext: readDatascienceJobRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func DatascienceJobRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceJobRuns(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJobRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceJobRunsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.ListJobRunsResponse
 }
@@ -101,9 +102,9 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_datascience.ListJobRunsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.ListJobRuns(context.Background(), request)
+	response, err := s.Client.ListJobRuns(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJobRuns(context.Background(), request)
+		listResponse, err := s.Client.ListJobRuns(s.Context(), request)
 		if err != nil {
 			return err
 		}
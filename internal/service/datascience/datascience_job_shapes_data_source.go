@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
 
 func DatascienceJobShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceJobShapes,
+		ReadContext: readDatascienceJobShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func DatascienceJobShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceJobShapes(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJobShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceJobShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.ListJobShapesResponse
 }
@@ -81,9 +82,9 @@ func (s *DatascienceJobShapesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.ListJobShapes(context.Background(), request)
+	response, err := s.Client.ListJobShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *DatascienceJobShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJobShapes(context.Background(), request)
+		listResponse, err := s.Client.ListJobShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
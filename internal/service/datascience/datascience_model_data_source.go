@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
@@ -22,16 +23,16 @@ func DatascienceModelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceModelResource(), fieldMap, readSingularDatascienceModel)
 }
 
-func readSingularDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceModelDataSourceCrud struct {
-	D               *schema.ResourceData
+	tfresource.BaseCrud
 	Client          *oci_datascience.DataScienceClient
 	Res             *oci_datascience.GetModelResponse
 	ArtifactHeadRes *HeadModelArtifact
@@ -49,9 +50,9 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 		request.ModelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -64,9 +65,9 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 		headModelArtifactRequest.ModelId = &tmp
 	}
 
-	headModelArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	headModelArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	headModelArtifactResponse, err := s.Client.HeadModelArtifact(context.Background(), headModelArtifactRequest)
+	headModelArtifactResponse, err := s.Client.HeadModelArtifact(s.Context(), headModelArtifactRequest)
 	if err != nil {
 		return err
 	}
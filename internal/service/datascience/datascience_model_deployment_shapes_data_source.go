@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
 
 func DatascienceModelDeploymentShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceModelDeploymentShapes,
+		ReadContext: readDatascienceModelDeploymentShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func DatascienceModelDeploymentShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceModelDeploymentShapes(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModelDeploymentShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceModelDeploymentShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.ListModelDeploymentShapesResponse
 }
@@ -77,9 +78,9 @@ func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.ListModelDeploymentShapes(context.Background(), request)
+	response, err := s.Client.ListModelDeploymentShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModelDeploymentShapes(context.Background(), request)
+		listResponse, err := s.Client.ListModelDeploymentShapes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
@@ -22,16 +23,16 @@ func DatascienceModelProvenanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceModelProvenanceResource(), fieldMap, readSingularDatascienceModelProvenance)
 }
 
-func readSingularDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceModelProvenanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.GetModelProvenanceResponse
 }
@@ -48,9 +49,9 @@ func (s *DatascienceModelProvenanceDataSourceCrud) Get() error {
 		request.ModelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.GetModelProvenance(context.Background(), request)
+	response, err := s.Client.GetModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
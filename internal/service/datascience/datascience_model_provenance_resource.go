@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
@@ -24,11 +25,11 @@ func DatascienceModelProvenanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceModelProvenance,
-		Read:     readDatascienceModelProvenance,
-		Update:   updateDatascienceModelProvenance,
-		Delete:   deleteDatascienceModelProvenance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceModelProvenance,
+		ReadContext:   readDatascienceModelProvenance,
+		UpdateContext: updateDatascienceModelProvenance,
+		DeleteContext: deleteDatascienceModelProvenance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"model_id": {
@@ -74,31 +75,31 @@ func DatascienceModelProvenanceResource() *schema.Resource {
 	}
 }
 
-func createDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func createDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -151,9 +152,10 @@ func (s *DatascienceModelProvenanceResourceCrud) Create() error {
 		request.TrainingScript = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModelProvenance(context.Background(), request)
+	response, err := s.Client.CreateModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -177,9 +179,9 @@ func (s *DatascienceModelProvenanceResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModelProvenance(context.Background(), request)
+	response, err := s.Client.GetModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -226,9 +228,9 @@ func (s *DatascienceModelProvenanceResourceCrud) Update() error {
 		request.TrainingScript = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModelProvenance(context.Background(), request)
+	response, err := s.Client.UpdateModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v61/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func DatascienceModelResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceModel,
-		Read:     readDatascienceModel,
-		Update:   updateDatascienceModel,
-		Delete:   deleteDatascienceModel,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceModel,
+		ReadContext:   readDatascienceModel,
+		UpdateContext: updateDatascienceModel,
+		DeleteContext: deleteDatascienceModel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"artifact_content_length": {
@@ -203,9 +204,10 @@ func DatascienceModelResource() *schema.Resource {
 	}
 }
 
-func createDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func createDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
 	var deactivateModel = false
@@ -216,33 +218,34 @@ func createDatascienceModel(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 	if deactivateModel {
 		if e := sync.DeactivateModel(); e != nil {
-			return e
+			return tfresource.ToDiagnostics(sync, e)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateInactive)
 	}
 	if e := sync.CreateArtifact(); e != nil {
-		return e
+		return tfresource.ToDiagnostics(sync, e)
 	}
 	sync.D.Set("empty_model", false)
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func readDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
 	// Activate/Deactivate Model
@@ -265,30 +268,30 @@ func updateDatascienceModel(d *schema.ResourceData, m interface{}) error {
 
 	if deactivate {
 		if err := sync.DeactivateModel(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateInactive)
 	}
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if activate {
 		if err := sync.ActivateModel(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateActive)
 	}
 	return nil
 }
 
-func deleteDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type HeadModelArtifact struct {
@@ -409,9 +412,10 @@ func (s *DatascienceModelResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,9 +430,9 @@ func (s *DatascienceModelResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -518,9 +522,9 @@ func (s *DatascienceModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -535,9 +539,9 @@ func (s *DatascienceModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteModel(context.Background(), request)
+	_, err := s.Client.DeleteModel(s.Context(), request)
 	return err
 }
 
@@ -624,7 +628,7 @@ func (s *DatascienceModelResourceCrud) mapToMetadata(fieldKeyFormat string) (oci
 }
 
 /*
-  The New Mapping method is created for DefinedMetadataList to pass null values explicitly for 'category' and 'description'.
+The New Mapping method is created for DefinedMetadataList to pass null values explicitly for 'category' and 'description'.
 */
 func (s *DatascienceModelResourceCrud) mapToMetadataDefined(fieldKeyFormat string) (oci_datascience.Metadata, error) {
 	result := oci_datascience.Metadata{}
@@ -673,14 +677,14 @@ func (s *DatascienceModelResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ModelId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -693,15 +697,15 @@ func (s *DatascienceModelResourceCrud) ActivateModel() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateModel(context.Background(), request)
+	_, err := s.Client.ActivateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatascienceModelResourceCrud) DeactivateModel() error {
@@ -710,15 +714,15 @@ func (s *DatascienceModelResourceCrud) DeactivateModel() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateModel(context.Background(), request)
+	_, err := s.Client.DeactivateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatascienceModelResourceCrud) CreateArtifact() error {
@@ -750,9 +754,9 @@ func (s *DatascienceModelResourceCrud) CreateArtifact() error {
 
 	request.ModelId = s.Res.Id
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateModelArtifact(context.Background(), request)
+	_, err := s.Client.CreateModelArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -765,9 +769,9 @@ func (s *DatascienceModelResourceCrud) GetArtifactHead() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "datascience")
 
-	response, err := s.Client.HeadModelArtifact(context.Background(), request)
+	response, err := s.Client.HeadModelArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
@@ -22,16 +23,16 @@ func DatascienceNotebookSessionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceNotebookSessionResource(), fieldMap, readSingularDatascienceNotebookSession)
 }
 
-func readSingularDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceNotebookSessionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.GetNotebookSessionResponse
 }
@@ -48,9 +49,9 @@ func (s *DatascienceNotebookSessionDataSourceCrud) Get() error {
 		request.NotebookSessionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.GetNotebookSession(context.Background(), request)
+	response, err := s.Client.GetNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
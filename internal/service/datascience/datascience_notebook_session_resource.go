@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,11 +23,11 @@ func DatascienceNotebookSessionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceNotebookSession,
-		Read:     readDatascienceNotebookSession,
-		Update:   updateDatascienceNotebookSession,
-		Delete:   deleteDatascienceNotebookSession,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceNotebookSession,
+		ReadContext:   readDatascienceNotebookSession,
+		UpdateContext: updateDatascienceNotebookSession,
+		DeleteContext: deleteDatascienceNotebookSession,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -208,9 +209,10 @@ func DatascienceNotebookSessionResource() *schema.Resource {
 	}
 }
 
-func createDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func createDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
 	var deactivateNotebookSession = false
@@ -221,29 +223,30 @@ func createDatascienceNotebookSession(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 	if deactivateNotebookSession {
 		if e := sync.DeactivateNotebookSession(); e != nil {
-			return e
+			return tfresource.ToDiagnostics(sync, e)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateInactive)
 	}
 	return nil
 }
 
-func readDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func readDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
 	// Activate/Deactivate NotebookSession
@@ -266,30 +269,30 @@ func updateDatascienceNotebookSession(d *schema.ResourceData, m interface{}) err
 
 	if deactivate {
 		if err := sync.DeactivateNotebookSession(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateInactive)
 	}
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if activate {
 		if err := sync.ActivateNotebookSession(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateActive)
 	}
 	return nil
 }
 
-func deleteDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DatascienceNotebookSessionResourceCrud struct {
@@ -379,9 +382,10 @@ func (s *DatascienceNotebookSessionResourceCrud) Create() error {
 		request.ProjectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateNotebookSession(context.Background(), request)
+	response, err := s.Client.CreateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -396,9 +400,9 @@ func (s *DatascienceNotebookSessionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetNotebookSession(context.Background(), request)
+	response, err := s.Client.GetNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -450,9 +454,9 @@ func (s *DatascienceNotebookSessionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateNotebookSession(context.Background(), request)
+	response, err := s.Client.UpdateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -467,9 +471,9 @@ func (s *DatascienceNotebookSessionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteNotebookSession(context.Background(), request)
+	_, err := s.Client.DeleteNotebookSession(s.Context(), request)
 	return err
 }
 
@@ -672,14 +676,14 @@ func (s *DatascienceNotebookSessionResourceCrud) updateCompartment(compartment i
 	idTmp := s.D.Id()
 	changeCompartmentRequest.NotebookSessionId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeNotebookSessionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNotebookSessionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -692,15 +696,15 @@ func (s *DatascienceNotebookSessionResourceCrud) ActivateNotebookSession() error
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateNotebookSession(context.Background(), request)
+	_, err := s.Client.ActivateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.NotebookSessionLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession() error {
@@ -709,13 +713,13 @@ func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession() err
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateNotebookSession(context.Background(), request)
+	_, err := s.Client.DeactivateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.NotebookSessionLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
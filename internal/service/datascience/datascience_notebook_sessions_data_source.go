@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v61/datascience"
 )
 
 func DatascienceNotebookSessionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceNotebookSessions,
+		ReadContext: readDatascienceNotebookSessions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func DatascienceNotebookSessionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceNotebookSessions(d *schema.ResourceData, m interface{}) error {
+func readDatascienceNotebookSessions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DatascienceNotebookSessionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_datascience.DataScienceClient
 	Res    *oci_datascience.ListNotebookSessionsResponse
 }
@@ -101,9 +102,9 @@ func (s *DatascienceNotebookSessionsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_datascience.ListNotebookSessionsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "datascience")
 
-	response, err := s.Client.ListNotebookSessions(context.Background(), request)
+	response, err := s.Client.ListNotebookSessions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatascienceNotebookSessionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNotebookSessions(context.Background(), request)
+		listResponse, err := s.Client.ListNotebookSessions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
@@ -22,16 +23,16 @@ func DevopsBuildPipelineDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsBuildPipelineResource(), fieldMap, readSingularDevopsBuildPipeline)
 }
 
-func readSingularDevopsBuildPipeline(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsBuildPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildPipelineDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetBuildPipelineResponse
 }
@@ -48,9 +49,9 @@ func (s *DevopsBuildPipelineDataSourceCrud) Get() error {
 		request.BuildPipelineId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetBuildPipeline(context.Background(), request)
+	response, err := s.Client.GetBuildPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
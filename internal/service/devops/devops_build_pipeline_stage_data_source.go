@@ -11,6 +11,7 @@ import (
 
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
@@ -24,16 +25,16 @@ func DevopsBuildPipelineStageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsBuildPipelineStageResource(), fieldMap, readSingularDevopsBuildPipelineStage)
 }
 
-func readSingularDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildPipelineStageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetBuildPipelineStageResponse
 }
@@ -50,9 +51,9 @@ func (s *DevopsBuildPipelineStageDataSourceCrud) Get() error {
 		request.BuildPipelineStageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.GetBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
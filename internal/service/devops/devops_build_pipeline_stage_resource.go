@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DevopsBuildPipelineStageResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsBuildPipelineStage,
-		Read:     readDevopsBuildPipelineStage,
-		Update:   updateDevopsBuildPipelineStage,
-		Delete:   deleteDevopsBuildPipelineStage,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsBuildPipelineStage,
+		ReadContext:   readDevopsBuildPipelineStage,
+		UpdateContext: updateDevopsBuildPipelineStage,
+		DeleteContext: deleteDevopsBuildPipelineStage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"build_pipeline_id": {
@@ -304,37 +305,37 @@ func DevopsBuildPipelineStageResource() *schema.Resource {
 	}
 }
 
-func createDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func createDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func updateDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DevopsBuildPipelineStageResourceCrud struct {
@@ -380,22 +381,23 @@ func (s *DevopsBuildPipelineStageResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.CreateBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DevopsBuildPipelineStageResourceCrud) getBuildPipelineStageFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	buildPipelineStageId, err := buildPipelineStageWaitForWorkRequest(workId, "buildPipelineStage",
+	buildPipelineStageId, err := buildPipelineStageWaitForWorkRequest(s.Context(), workId, "buildPipelineStage",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -429,9 +431,9 @@ func buildPipelineStageWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func buildPipelineStageWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = buildPipelineStageWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_devops.GetWorkRequestResponse{}
@@ -448,7 +450,7 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -460,7 +462,7 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -477,14 +479,14 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed || response.Status == oci_devops.OperationStatusCanceled {
-		return nil, getErrorFromDevopsBuildPipelineStageWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsBuildPipelineStageWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsBuildPipelineStageWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsBuildPipelineStageWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -512,9 +514,9 @@ func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BuildPipelineStageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.GetBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -530,15 +532,15 @@ func (s *DevopsBuildPipelineStageResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.UpdateBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
@@ -547,16 +549,16 @@ func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BuildPipelineStageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.DeleteBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := buildPipelineStageWaitForWorkRequest(workId, "buildPipelineStage",
+	_, delWorkRequestErr := buildPipelineStageWaitForWorkRequest(s.Context(), workId, "buildPipelineStage",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
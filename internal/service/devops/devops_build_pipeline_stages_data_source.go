@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsBuildPipelineStagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsBuildPipelineStages,
+		ReadContext: readDevopsBuildPipelineStages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"build_pipeline_id": {
@@ -269,16 +270,16 @@ func DevopsBuildPipelineStagesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsBuildPipelineStages(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildPipelineStages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildPipelineStagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListBuildPipelineStagesResponse
 }
@@ -314,9 +315,9 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.BuildPipelineStageLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+	response, err := s.Client.ListBuildPipelineStages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -325,7 +326,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+		listResponse, err := s.Client.ListBuildPipelineStages(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsBuildPipelinesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsBuildPipelines,
+		ReadContext: readDevopsBuildPipelines,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DevopsBuildPipelinesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsBuildPipelines(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildPipelines(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelinesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildPipelinesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListBuildPipelinesResponse
 }
@@ -101,9 +102,9 @@ func (s *DevopsBuildPipelinesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.BuildPipelineLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListBuildPipelines(context.Background(), request)
+	response, err := s.Client.ListBuildPipelines(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DevopsBuildPipelinesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildPipelines(context.Background(), request)
+		listResponse, err := s.Client.ListBuildPipelines(s.Context(), request)
 		if err != nil {
 			return err
 		}
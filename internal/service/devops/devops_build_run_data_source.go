@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
@@ -22,16 +23,16 @@ func DevopsBuildRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsBuildRunResource(), fieldMap, readSingularDevopsBuildRun)
 }
 
-func readSingularDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildRunDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetBuildRunResponse
 }
@@ -48,9 +49,9 @@ func (s *DevopsBuildRunDataSourceCrud) Get() error {
 		request.BuildRunId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -24,11 +25,11 @@ func DevopsBuildRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsBuildRun,
-		Read:     readDevopsBuildRun,
-		Update:   updateDevopsBuildRun,
-		Delete:   deleteDevopsBuildRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsBuildRun,
+		ReadContext:   readDevopsBuildRun,
+		UpdateContext: updateDevopsBuildRun,
+		DeleteContext: deleteDevopsBuildRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"build_pipeline_id": {
@@ -445,31 +446,31 @@ func DevopsBuildRunResource() *schema.Resource {
 	}
 }
 
-func createDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func createDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func updateDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -552,9 +553,10 @@ func (s *DevopsBuildRunResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildRun(context.Background(), request)
+	response, err := s.Client.CreateBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -569,9 +571,9 @@ func (s *DevopsBuildRunResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BuildRunId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -603,9 +605,9 @@ func (s *DevopsBuildRunResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildRun(context.Background(), request)
+	response, err := s.Client.UpdateBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
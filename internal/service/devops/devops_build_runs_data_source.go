@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsBuildRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsBuildRuns,
+		ReadContext: readDevopsBuildRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"build_pipeline_id": {
@@ -326,16 +327,16 @@ func DevopsBuildRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsBuildRuns(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsBuildRunsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListBuildRunsResponse
 }
@@ -376,9 +377,9 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.BuildRunLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListBuildRuns(context.Background(), request)
+	response, err := s.Client.ListBuildRuns(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +388,7 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildRuns(context.Background(), request)
+		listResponse, err := s.Client.ListBuildRuns(s.Context(), request)
 		if err != nil {
 			return err
 		}
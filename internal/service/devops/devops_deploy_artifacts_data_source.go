@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsDeployArtifactsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsDeployArtifacts,
+		ReadContext: readDevopsDeployArtifacts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DevopsDeployArtifactsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsDeployArtifacts(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployArtifacts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployArtifactsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployArtifactsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListDeployArtifactsResponse
 }
@@ -101,9 +102,9 @@ func (s *DevopsDeployArtifactsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.DeployArtifactLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListDeployArtifacts(context.Background(), request)
+	response, err := s.Client.ListDeployArtifacts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DevopsDeployArtifactsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployArtifacts(context.Background(), request)
+		listResponse, err := s.Client.ListDeployArtifacts(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
@@ -23,16 +24,16 @@ func DevopsDeployEnvironmentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsDeployEnvironmentResource(), fieldMap, readSingularDevopsDeployEnvironment)
 }
 
-func readSingularDevopsDeployEnvironment(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsDeployEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployEnvironmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetDeployEnvironmentResponse
 }
@@ -49,9 +50,9 @@ func (s *DevopsDeployEnvironmentDataSourceCrud) Get() error {
 		request.DeployEnvironmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetDeployEnvironment(context.Background(), request)
+	response, err := s.Client.GetDeployEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsDeployEnvironmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsDeployEnvironments,
+		ReadContext: readDevopsDeployEnvironments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DevopsDeployEnvironmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsDeployEnvironments(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployEnvironments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployEnvironmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListDeployEnvironmentsResponse
 }
@@ -101,9 +102,9 @@ func (s *DevopsDeployEnvironmentsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.DeployEnvironmentLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListDeployEnvironments(context.Background(), request)
+	response, err := s.Client.ListDeployEnvironments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DevopsDeployEnvironmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployEnvironments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployEnvironments(s.Context(), request)
 		if err != nil {
 			return err
 		}
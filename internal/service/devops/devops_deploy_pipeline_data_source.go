@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -22,16 +23,16 @@ func DevopsDeployPipelineDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsDeployPipelineResource(), fieldMap, readSingularDevopsDeployPipeline)
 }
 
-func readSingularDevopsDeployPipeline(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsDeployPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployPipelineDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetDeployPipelineResponse
 }
@@ -48,9 +49,9 @@ func (s *DevopsDeployPipelineDataSourceCrud) Get() error {
 		request.DeployPipelineId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetDeployPipeline(context.Background(), request)
+	response, err := s.Client.GetDeployPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
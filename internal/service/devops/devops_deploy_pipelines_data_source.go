@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsDeployPipelinesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsDeployPipelines,
+		ReadContext: readDevopsDeployPipelines,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DevopsDeployPipelinesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsDeployPipelines(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployPipelines(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelinesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployPipelinesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListDeployPipelinesResponse
 }
@@ -101,9 +102,9 @@ func (s *DevopsDeployPipelinesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.DeployPipelineLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListDeployPipelines(context.Background(), request)
+	response, err := s.Client.ListDeployPipelines(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DevopsDeployPipelinesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployPipelines(context.Background(), request)
+		listResponse, err := s.Client.ListDeployPipelines(s.Context(), request)
 		if err != nil {
 			return err
 		}
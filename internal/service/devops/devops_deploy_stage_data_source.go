@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
@@ -24,16 +25,16 @@ func DevopsDeployStageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsDeployStageResource(), fieldMap, readSingularDevopsDeployStage)
 }
 
-func readSingularDevopsDeployStage(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsDeployStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployStageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeployStageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetDeployStageResponse
 }
@@ -50,9 +51,9 @@ func (s *DevopsDeployStageDataSourceCrud) Get() error {
 		request.DeployStageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetDeployStage(context.Background(), request)
+	response, err := s.Client.GetDeployStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
@@ -17,7 +18,7 @@ import (
 
 func DevopsDeploymentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsDeployments,
+		ReadContext: readDevopsDeployments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -70,16 +71,16 @@ func DevopsDeploymentsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsDeployments(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsDeploymentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListDeploymentsResponse
 }
@@ -136,9 +137,9 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListDeployments(context.Background(), request)
+	response, err := s.Client.ListDeployments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployments(s.Context(), request)
 		if err != nil {
 			return err
 		}
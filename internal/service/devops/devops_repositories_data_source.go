@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositories,
+		ReadContext: readDevopsRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,16 +57,16 @@ func DevopsRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositories(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListRepositoriesResponse
 }
@@ -119,9 +120,9 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_devops.RepositoryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +131,7 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsRepositoryArchiveContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryArchiveContent,
+		ReadContext: readSingularDevopsRepositoryArchiveContent,
 		Schema: map[string]*schema.Schema{
 			"format": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func DevopsRepositoryArchiveContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryArchiveContent(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryArchiveContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryArchiveContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryArchiveContentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetRepositoryArchiveContentResponse
 }
@@ -70,9 +71,9 @@ func (s *DevopsRepositoryArchiveContentDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetRepositoryArchiveContent(context.Background(), request)
+	response, err := s.Client.GetRepositoryArchiveContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsRepositoryAuthorDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryAuthor,
+		ReadContext: readSingularDevopsRepositoryAuthor,
 		Schema: map[string]*schema.Schema{
 			"ref_name": {
 				Type:     schema.TypeString,
@@ -57,16 +58,16 @@ func DevopsRepositoryAuthorDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryAuthor(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryAuthor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryAuthorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryAuthorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListAuthorsResponse
 }
@@ -88,9 +89,9 @@ func (s *DevopsRepositoryAuthorDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsRepositoryAuthorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryAuthors,
+		ReadContext: readDevopsRepositoryAuthors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ref_name": {
@@ -66,16 +67,16 @@ func DevopsRepositoryAuthorsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryAuthors(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryAuthors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryAuthorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryAuthorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListAuthorsResponse
 }
@@ -97,9 +98,9 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuthors(context.Background(), request)
+		listResponse, err := s.Client.ListAuthors(s.Context(), request)
 		if err != nil {
 			return err
 		}
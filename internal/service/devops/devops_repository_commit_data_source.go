@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryCommitDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryCommit,
+		ReadContext: readSingularDevopsRepositoryCommit,
 		Schema: map[string]*schema.Schema{
 			"commit_id": {
 				Type:     schema.TypeString,
@@ -65,16 +66,16 @@ func DevopsRepositoryCommitDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryCommit(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryCommit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryCommitDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryCommitDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetCommitResponse
 }
@@ -96,9 +97,9 @@ func (s *DevopsRepositoryCommitDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetCommit(context.Background(), request)
+	response, err := s.Client.GetCommit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
@@ -17,7 +18,7 @@ import (
 
 func DevopsRepositoryCommitsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryCommits,
+		ReadContext: readDevopsRepositoryCommits,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"author_name": {
@@ -126,16 +127,16 @@ func DevopsRepositoryCommitsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryCommits(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryCommits(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryCommitsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryCommitsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListCommitsResponse
 }
@@ -193,9 +194,9 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 		request.TimestampLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListCommits(context.Background(), request)
+	response, err := s.Client.ListCommits(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +205,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommits(context.Background(), request)
+		listResponse, err := s.Client.ListCommits(s.Context(), request)
 		if err != nil {
 			return err
 		}
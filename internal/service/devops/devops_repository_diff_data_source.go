@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 )
 
 func DevopsRepositoryDiffDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryDiff,
+		ReadContext: readSingularDevopsRepositoryDiff,
 		Schema: map[string]*schema.Schema{
 			"base_version": {
 				Type:     schema.TypeString,
@@ -145,16 +146,16 @@ func DevopsRepositoryDiffDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryDiff(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryDiff(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryDiffDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryDiffDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetFileDiffResponse
 }
@@ -191,9 +192,9 @@ func (s *DevopsRepositoryDiffDataSourceCrud) Get() error {
 		request.TargetVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetFileDiff(context.Background(), request)
+	response, err := s.Client.GetFileDiff(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryDiffsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryDiffs,
+		ReadContext: readDevopsRepositoryDiffs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"base_version": {
@@ -167,16 +168,16 @@ func DevopsRepositoryDiffsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryDiffs(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryDiffs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryDiffsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryDiffsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListCommitDiffsResponse
 }
@@ -208,9 +209,9 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 		request.TargetVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListCommitDiffs(context.Background(), request)
+	response, err := s.Client.ListCommitDiffs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +220,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommitDiffs(context.Background(), request)
+		listResponse, err := s.Client.ListCommitDiffs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryFileLineDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryFileLine,
+		ReadContext: readSingularDevopsRepositoryFileLine,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func DevopsRepositoryFileLineDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryFileLine(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryFileLine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryFileLineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryFileLineDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetRepositoryFileLinesResponse
 }
@@ -100,9 +101,9 @@ func (s *DevopsRepositoryFileLineDataSourceCrud) Get() error {
 		request.StartLineNumber = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetRepositoryFileLines(context.Background(), request)
+	response, err := s.Client.GetRepositoryFileLines(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryMirrorRecordDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryMirrorRecord,
+		ReadContext: readSingularDevopsRepositoryMirrorRecord,
 		Schema: map[string]*schema.Schema{
 			"mirror_record_type": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func DevopsRepositoryMirrorRecordDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryMirrorRecord(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryMirrorRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryMirrorRecordDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryMirrorRecordDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetMirrorRecordResponse
 }
@@ -80,9 +81,9 @@ func (s *DevopsRepositoryMirrorRecordDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetMirrorRecord(context.Background(), request)
+	response, err := s.Client.GetMirrorRecord(s.Context(), request)
 	if err != nil {
 		return err
 	}
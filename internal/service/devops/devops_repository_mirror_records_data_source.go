@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryMirrorRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryMirrorRecords,
+		ReadContext: readDevopsRepositoryMirrorRecords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"repository_id": {
@@ -72,16 +73,16 @@ func DevopsRepositoryMirrorRecordsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryMirrorRecords(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryMirrorRecords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryMirrorRecordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryMirrorRecordsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListMirrorRecordsResponse
 }
@@ -98,9 +99,9 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListMirrorRecords(context.Background(), request)
+	response, err := s.Client.ListMirrorRecords(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMirrorRecords(context.Background(), request)
+		listResponse, err := s.Client.ListMirrorRecords(s.Context(), request)
 		if err != nil {
 			return err
 		}
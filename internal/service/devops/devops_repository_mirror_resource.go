@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DevopsRepositoryMirrorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsRepositoryMirror,
-		Read:     readDevopsRepositoryMirror,
-		Delete:   deleteDevopsRepositoryMirror,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsRepositoryMirror,
+		ReadContext:   readDevopsRepositoryMirror,
+		DeleteContext: deleteDevopsRepositoryMirror,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"repository_id": {
@@ -43,19 +44,19 @@ func DevopsRepositoryMirrorResource() *schema.Resource {
 	}
 }
 
-func createDevopsRepositoryMirror(d *schema.ResourceData, m interface{}) error {
+func createDevopsRepositoryMirror(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryMirrorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDevopsRepositoryMirror(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryMirror(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDevopsRepositoryMirror(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsRepositoryMirror(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -89,9 +90,9 @@ func (s *DevopsRepositoryMirrorResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -108,22 +109,22 @@ func (s *DevopsRepositoryMirrorResourceCrud) Create() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.MirrorRepository(context.Background(), request)
+	response, err := s.Client.MirrorRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRepositoryMirrorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getRepositoryMirrorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DevopsRepositoryMirrorResourceCrud) getRepositoryMirrorFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	repositoryMirrorId, err := repositoryMirrorWaitForWorkRequest(workId, "repository",
+	repositoryMirrorId, err := repositoryMirrorWaitForWorkRequest(s.Context(), workId, "repository",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -157,9 +158,9 @@ func repositoryMirrorWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func repositoryMirrorWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func repositoryMirrorWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = repositoryMirrorWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_devops.GetWorkRequestResponse{}
@@ -176,7 +177,7 @@ func repositoryMirrorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -188,7 +189,7 @@ func repositoryMirrorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -205,14 +206,14 @@ func repositoryMirrorWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed || response.Status == oci_devops.OperationStatusCanceled {
-		return nil, getErrorFromDevopsRepositoryMirrorWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsRepositoryMirrorWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsRepositoryMirrorWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsRepositoryMirrorWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
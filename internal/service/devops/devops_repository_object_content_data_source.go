@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryObjectContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryObjectContent,
+		ReadContext: readSingularDevopsRepositoryObjectContent,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func DevopsRepositoryObjectContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryObjectContent(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryObjectContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryObjectContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryObjectContentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetObjectContentResponse
 }
@@ -70,9 +71,9 @@ func (s *DevopsRepositoryObjectContentDataSourceCrud) Get() error {
 		request.Sha = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetObjectContent(context.Background(), request)
+	response, err := s.Client.GetObjectContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -16,7 +17,7 @@ import (
 
 func DevopsRepositoryObjectDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryObject,
+		ReadContext: readSingularDevopsRepositoryObject,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -51,16 +52,16 @@ func DevopsRepositoryObjectDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryObject(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryObjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryObjectDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetObjectResponse
 }
@@ -87,9 +88,9 @@ func (s *DevopsRepositoryObjectDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
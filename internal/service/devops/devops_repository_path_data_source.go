@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -16,7 +17,7 @@ import (
 
 func DevopsRepositoryPathDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryPath,
+		ReadContext: readSingularDevopsRepositoryPath,
 		Schema: map[string]*schema.Schema{
 			"display_name": {
 				Type:     schema.TypeString,
@@ -90,16 +91,16 @@ func DevopsRepositoryPathDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryPath(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryPathDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryPathDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListPathsResponse
 }
@@ -136,9 +137,9 @@ func (s *DevopsRepositoryPathDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryPathsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryPaths,
+		ReadContext: readDevopsRepositoryPaths,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -92,16 +93,16 @@ func DevopsRepositoryPathsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryPaths(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryPaths(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryPathsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryPathsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListPathsResponse
 }
@@ -138,9 +139,9 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPaths(context.Background(), request)
+		listResponse, err := s.Client.ListPaths(s.Context(), request)
 		if err != nil {
 			return err
 		}
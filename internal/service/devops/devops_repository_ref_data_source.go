@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -27,16 +28,16 @@ func DevopsRepositoryRefDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsRepositoryRefResource(), fieldMap, readSingularDevopsRepositoryRef)
 }
 
-func readSingularDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryRefDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetRefResponse
 }
@@ -58,9 +59,9 @@ func (s *DevopsRepositoryRefDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
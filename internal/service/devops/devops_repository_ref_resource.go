@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DevopsRepositoryRefResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsRepositoryRef,
-		Read:     readDevopsRepositoryRef,
-		Update:   updateDevopsRepositoryRef,
-		Delete:   deleteDevopsRepositoryRef,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsRepositoryRef,
+		ReadContext:   readDevopsRepositoryRef,
+		UpdateContext: updateDevopsRepositoryRef,
+		DeleteContext: deleteDevopsRepositoryRef,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ref_name": {
@@ -73,37 +74,37 @@ func DevopsRepositoryRefResource() *schema.Resource {
 	}
 }
 
-func createDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func createDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func updateDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DevopsRepositoryRefResourceCrud struct {
@@ -135,9 +136,10 @@ func (s *DevopsRepositoryRefResourceCrud) Create() error {
 		request.RefName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -167,9 +169,9 @@ func (s *DevopsRepositoryRefResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,9 +187,9 @@ func (s *DevopsRepositoryRefResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,9 +211,9 @@ func (s *DevopsRepositoryRefResourceCrud) Delete() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "devops")
 
-	_, err := s.Client.DeleteRef(context.Background(), request)
+	_, err := s.Client.DeleteRef(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryRefsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryRefs,
+		ReadContext: readDevopsRepositoryRefs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"commit_id": {
@@ -52,16 +53,16 @@ func DevopsRepositoryRefsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryRefs(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryRefs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsRepositoryRefsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.ListRefsResponse
 }
@@ -92,9 +93,9 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 		request.RepositoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.ListRefs(context.Background(), request)
+	response, err := s.Client.ListRefs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRefs(context.Background(), request)
+		listResponse, err := s.Client.ListRefs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v61/devops"
 
@@ -22,16 +23,16 @@ func DevopsTriggerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsTriggerResource(), fieldMap, readSingularDevopsTrigger)
 }
 
-func readSingularDevopsTrigger(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsTrigger(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsTriggerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DevopsTriggerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_devops.DevopsClient
 	Res    *oci_devops.GetTriggerResponse
 }
@@ -48,9 +49,9 @@ func (s *DevopsTriggerDataSourceCrud) Get() error {
 		request.TriggerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "devops")
 
-	response, err := s.Client.GetTrigger(context.Background(), request)
+	response, err := s.Client.GetTrigger(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"net"
@@ -28,11 +29,11 @@ import (
 
 func DnsRecordResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsRecord,
-		Read:     readDnsRecord,
-		Update:   updateDnsRecord,
-		Delete:   deleteDnsRecord,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsRecord,
+		ReadContext:   readDnsRecord,
+		UpdateContext: updateDnsRecord,
+		DeleteContext: deleteDnsRecord,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone_name_or_id": {
@@ -92,37 +93,37 @@ func DnsRecordResource() *schema.Resource {
 	}
 }
 
-func createDnsRecord(d *schema.ResourceData, m interface{}) error {
+func createDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsRecord(d *schema.ResourceData, m interface{}) error {
+func readDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsRecord(d *schema.ResourceData, m interface{}) error {
+func updateDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsRecord(d *schema.ResourceData, m interface{}) error {
+func deleteDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsRecordResourceCrud struct {
@@ -168,8 +169,8 @@ func (s *DnsRecordResourceCrud) Create() error {
 
 	request.Items = []oci_dns.RecordOperation{ro}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
+	response, err := s.Client.PatchRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 
 	s.Res = item
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -222,11 +223,11 @@ func (s *DnsRecordResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
 	var err error
 	for true {
-		response, err := s.Client.GetRRSet(context.Background(), request)
+		response, err := s.Client.GetRRSet(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -274,8 +275,8 @@ func (s *DnsRecordResourceCrud) Update() error {
 
 	request.Items = []oci_dns.RecordOperation{removeOp, addOp}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
+	response, err := s.Client.PatchRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -293,7 +294,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 	s.Res = item
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -325,8 +326,8 @@ func (s *DnsRecordResourceCrud) Delete() error {
 
 	request.Items = []oci_dns.RecordOperation{ro}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	_, err := s.Client.PatchRRSet(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
+	_, err := s.Client.PatchRRSet(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
 
 func DnsRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsRecords,
+		ReadContext: readDnsRecords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 
@@ -66,16 +67,16 @@ func DnsRecordsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsRecords(d *schema.ResourceData, m interface{}) error {
+func readDnsRecords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsRecordsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetZoneRecordsResponse
 }
@@ -127,9 +128,9 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 		request.ZoneVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetZoneRecords(context.Background(), request)
+	response, err := s.Client.GetZoneRecords(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetZoneRecords(context.Background(), request)
+		listResponse, err := s.Client.GetZoneRecords(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -26,16 +27,16 @@ func DnsResolverDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsResolverResource(), fieldMap, readSingularDnsResolver)
 }
 
-func readSingularDnsResolver(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsResolverDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetResolverResponse
 }
@@ -56,9 +57,9 @@ func (s *DnsResolverDataSourceCrud) Get() error {
 		request.Scope = oci_dns.GetResolverScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetResolver(context.Background(), request)
+	response, err := s.Client.GetResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
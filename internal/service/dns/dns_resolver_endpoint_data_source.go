@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -31,16 +32,16 @@ func DnsResolverEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsResolverEndpointResource(), fieldMap, readSingularDnsResolverEndpoint)
 }
 
-func readSingularDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsResolverEndpointDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetResolverEndpointResponse
 }
@@ -66,9 +67,9 @@ func (s *DnsResolverEndpointDataSourceCrud) Get() error {
 		request.Scope = oci_dns.GetResolverEndpointScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
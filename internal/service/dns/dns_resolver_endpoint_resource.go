@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsResolverEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsResolverEndpoint,
-		Read:     readDnsResolverEndpoint,
-		Update:   updateDnsResolverEndpoint,
-		Delete:   deleteDnsResolverEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsResolverEndpoint,
+		ReadContext:   readDnsResolverEndpoint,
+		UpdateContext: updateDnsResolverEndpoint,
+		DeleteContext: deleteDnsResolverEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"is_forwarding": {
@@ -120,37 +121,37 @@ func DnsResolverEndpointResource() *schema.Resource {
 	}
 }
 
-func createDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func createDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func readDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsResolverEndpointResourceCrud struct {
@@ -195,9 +196,10 @@ func (s *DnsResolverEndpointResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.CreateResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -234,9 +236,9 @@ func (s *DnsResolverEndpointResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,9 +254,9 @@ func (s *DnsResolverEndpointResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -280,9 +282,9 @@ func (s *DnsResolverEndpointResourceCrud) Delete() error {
 		request.Scope = oci_dns.DeleteResolverEndpointScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteResolverEndpoint(context.Background(), request)
+	_, err := s.Client.DeleteResolverEndpoint(s.Context(), request)
 	return err
 }
 
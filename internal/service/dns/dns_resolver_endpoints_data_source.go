@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
 
 func DnsResolverEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsResolverEndpoints,
+		ReadContext: readDnsResolverEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -44,16 +45,16 @@ func DnsResolverEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsResolverEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDnsResolverEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsResolverEndpointsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListResolverEndpointsResponse
 }
@@ -83,9 +84,9 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_dns.ResolverEndpointSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListResolverEndpoints(context.Background(), request)
+	response, err := s.Client.ListResolverEndpoints(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolverEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListResolverEndpoints(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsResolverResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsResolver,
-		Read:     readDnsResolver,
-		Update:   updateDnsResolver,
-		Delete:   deleteDnsResolver,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsResolver,
+		ReadContext:   readDnsResolver,
+		UpdateContext: updateDnsResolver,
+		DeleteContext: deleteDnsResolver,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"resolver_id": {
@@ -228,21 +229,22 @@ func DnsResolverResource() *schema.Resource {
 	}
 }
 
-func createDnsResolver(d *schema.ResourceData, m interface{}) error {
+func createDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResourceContext(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
 		err = sync.updateCompartment(compartment)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
@@ -258,23 +260,23 @@ func createDnsResolver(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readDnsResolver(d *schema.ResourceData, m interface{}) error {
+func readDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsResolver(d *schema.ResourceData, m interface{}) error {
+func updateDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsResolver(d *schema.ResourceData, m interface{}) error {
+func deleteDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -376,9 +378,9 @@ func (s *DnsResolverResourceCrud) Create() error {
 		request.Scope = oci_dns.UpdateResolverScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -408,9 +410,9 @@ func (s *DnsResolverResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolver(context.Background(), request)
+	response, err := s.Client.GetResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -489,9 +491,9 @@ func (s *DnsResolverResourceCrud) Update() error {
 		request.Scope = oci_dns.UpdateResolverScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -734,14 +736,14 @@ func (s *DnsResolverResourceCrud) updateCompartment(compartment interface{}) err
 		changeCompartmentRequest.Scope = oci_dns.ChangeResolverCompartmentScopeEnum(scope.(string))
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeResolverCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResolverCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
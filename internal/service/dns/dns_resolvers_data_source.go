@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
 
 func DnsResolversDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsResolvers,
+		ReadContext: readDnsResolvers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func DnsResolversDataSource() *schema.Resource {
 	}
 }
 
-func readDnsResolvers(d *schema.ResourceData, m interface{}) error {
+func readDnsResolvers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolversDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsResolversDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListResolversResponse
 }
@@ -91,9 +92,9 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 		request.LifecycleState = oci_dns.ResolverSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListResolvers(context.Background(), request)
+	response, err := s.Client.ListResolvers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolvers(context.Background(), request)
+		listResponse, err := s.Client.ListResolvers(s.Context(), request)
 		if err != nil {
 			return err
 		}
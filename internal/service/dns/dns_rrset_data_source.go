@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -46,16 +47,16 @@ func DnsRrsetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsRrsetResource(), fieldMap, readSingularDnsRrset)
 }
 
-func readSingularDnsRrset(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsRrsetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetRRSetResponse
 }
@@ -101,9 +102,9 @@ func (s *DnsRrsetDataSourceCrud) Get() error {
 		request.ZoneVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
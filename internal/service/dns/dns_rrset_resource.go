@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -26,11 +27,11 @@ func DnsRrsetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsRrset,
-		Read:     readDnsRrset,
-		Update:   updateDnsRrset,
-		Delete:   deleteDnsRrset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsRrset,
+		ReadContext:   readDnsRrset,
+		UpdateContext: updateDnsRrset,
+		DeleteContext: deleteDnsRrset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"domain": {
@@ -120,37 +121,37 @@ func DnsRrsetResource() *schema.Resource {
 	}
 }
 
-func createDnsRrset(d *schema.ResourceData, m interface{}) error {
+func createDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsRrset(d *schema.ResourceData, m interface{}) error {
+func readDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsRrset(d *schema.ResourceData, m interface{}) error {
+func updateDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsRrset(d *schema.ResourceData, m interface{}) error {
+func deleteDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsRrsetResourceCrud struct {
@@ -215,9 +216,9 @@ func (s *DnsRrsetResourceCrud) Create() error {
 		request.ZoneNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -226,7 +227,7 @@ func (s *DnsRrsetResourceCrud) Create() error {
 	rrSet.Items = response.Items
 	s.Res = &rrSet
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -280,9 +281,9 @@ func (s *DnsRrsetResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,9 +343,9 @@ func (s *DnsRrsetResourceCrud) Update() error {
 		request.ZoneNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -354,7 +355,7 @@ func (s *DnsRrsetResourceCrud) Update() error {
 	s.Res = &rrSet
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -393,9 +394,9 @@ func (s *DnsRrsetResourceCrud) Delete() error {
 		request.ZoneNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteRRSet(context.Background(), request)
+	_, err := s.Client.DeleteRRSet(s.Context(), request)
 	return err
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsSteeringPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsSteeringPolicies,
+		ReadContext: readDnsSteeringPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -65,16 +66,16 @@ func DnsSteeringPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readDnsSteeringPolicies(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsSteeringPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListSteeringPoliciesResponse
 }
@@ -136,9 +137,9 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListSteeringPolicies(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListSteeringPolicies(s.Context(), request)
 		if err != nil {
 			return err
 		}
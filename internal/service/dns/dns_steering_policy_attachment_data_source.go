@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -22,16 +23,16 @@ func DnsSteeringPolicyAttachmentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsSteeringPolicyAttachmentResource(), fieldMap, readSingularDnsSteeringPolicyAttachment)
 }
 
-func readSingularDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsSteeringPolicyAttachmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetSteeringPolicyAttachmentResponse
 }
@@ -48,9 +49,9 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
 		request.SteeringPolicyAttachmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -19,11 +20,11 @@ func DnsSteeringPolicyAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsSteeringPolicyAttachment,
-		Read:     readDnsSteeringPolicyAttachment,
-		Update:   updateDnsSteeringPolicyAttachment,
-		Delete:   deleteDnsSteeringPolicyAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsSteeringPolicyAttachment,
+		ReadContext:   readDnsSteeringPolicyAttachment,
+		UpdateContext: updateDnsSteeringPolicyAttachment,
+		DeleteContext: deleteDnsSteeringPolicyAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"domain_name": {
@@ -78,37 +79,37 @@ func DnsSteeringPolicyAttachmentResource() *schema.Resource {
 	}
 }
 
-func createDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func createDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func updateDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsSteeringPolicyAttachmentResourceCrud struct {
@@ -167,9 +168,10 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 		request.ZoneId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,9 +186,9 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,9 +208,9 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,9 +225,9 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicyAttachment(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicyAttachment(s.Context(), request)
 	return err
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsSteeringPolicyAttachments,
+		ReadContext: readDnsSteeringPolicyAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -69,16 +70,16 @@ func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsSteeringPolicyAttachments(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicyAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsSteeringPolicyAttachmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListSteeringPolicyAttachmentsResponse
 }
@@ -145,9 +146,9 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 		request.ZoneId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicyAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +157,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListSteeringPolicyAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -22,16 +23,16 @@ func DnsSteeringPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsSteeringPolicyResource(), fieldMap, readSingularDnsSteeringPolicy)
 }
 
-func readSingularDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsSteeringPolicyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetSteeringPolicyResponse
 }
@@ -48,9 +49,9 @@ func (s *DnsSteeringPolicyDataSourceCrud) Get() error {
 		request.SteeringPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DnsSteeringPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsSteeringPolicy,
-		Read:     readDnsSteeringPolicy,
-		Update:   updateDnsSteeringPolicy,
-		Delete:   deleteDnsSteeringPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsSteeringPolicy,
+		ReadContext:   readDnsSteeringPolicy,
+		UpdateContext: updateDnsSteeringPolicy,
+		DeleteContext: deleteDnsSteeringPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -260,37 +261,37 @@ func DnsSteeringPolicyResource() *schema.Resource {
 	}
 }
 
-func createDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func createDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func updateDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsSteeringPolicyResourceCrud struct {
@@ -401,16 +402,17 @@ func (s *DnsSteeringPolicyResourceCrud) Create() error {
 		request.Ttl = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.SteeringPolicy
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -423,9 +425,9 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -480,9 +482,9 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 		request.Ttl = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -490,7 +492,7 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 	s.Res = &response.SteeringPolicy
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -503,9 +505,9 @@ func (s *DnsSteeringPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicy(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicy(s.Context(), request)
 	return err
 }
 
@@ -1175,14 +1177,14 @@ func (s *DnsSteeringPolicyResourceCrud) updateCompartment(compartment interface{
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SteeringPolicyId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeSteeringPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSteeringPolicyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -19,11 +20,11 @@ func DnsTsigKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsTsigKey,
-		Read:     readDnsTsigKey,
-		Update:   updateDnsTsigKey,
-		Delete:   deleteDnsTsigKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsTsigKey,
+		ReadContext:   readDnsTsigKey,
+		UpdateContext: updateDnsTsigKey,
+		DeleteContext: deleteDnsTsigKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"algorithm": {
@@ -83,37 +84,37 @@ func DnsTsigKeyResource() *schema.Resource {
 	}
 }
 
-func createDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func createDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func readDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func updateDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func deleteDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsTsigKeyResourceCrud struct {
@@ -186,9 +187,9 @@ func (s *DnsTsigKeyResourceCrud) Create() error {
 		request.Secret = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateTsigKey(context.Background(), request)
+	response, err := s.Client.CreateTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -203,9 +204,9 @@ func (s *DnsTsigKeyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.TsigKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetTsigKey(context.Background(), request)
+	response, err := s.Client.GetTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +242,9 @@ func (s *DnsTsigKeyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.TsigKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateTsigKey(context.Background(), request)
+	response, err := s.Client.UpdateTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -258,9 +259,9 @@ func (s *DnsTsigKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TsigKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteTsigKey(context.Background(), request)
+	_, err := s.Client.DeleteTsigKey(s.Context(), request)
 	return err
 }
 
@@ -313,14 +314,14 @@ func (s *DnsTsigKeyResourceCrud) updateCompartment(compartment interface{}) erro
 	idTmp := s.D.Id()
 	changeCompartmentRequest.TsigKeyId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeTsigKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTsigKeyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
 
 func DnsTsigKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsTsigKeys,
+		ReadContext: readDnsTsigKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func DnsTsigKeysDataSource() *schema.Resource {
 	}
 }
 
-func readDnsTsigKeys(d *schema.ResourceData, m interface{}) error {
+func readDnsTsigKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsTsigKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListTsigKeysResponse
 }
@@ -83,9 +84,9 @@ func (s *DnsTsigKeysDataSourceCrud) Get() error {
 		request.LifecycleState = oci_dns.TsigKeySummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListTsigKeys(context.Background(), request)
+	response, err := s.Client.ListTsigKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DnsTsigKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTsigKeys(context.Background(), request)
+		listResponse, err := s.Client.ListTsigKeys(s.Context(), request)
 		if err != nil {
 			return err
 		}
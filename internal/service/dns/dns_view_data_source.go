@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
 )
@@ -26,16 +27,16 @@ func DnsViewDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsViewResource(), fieldMap, readSingularDnsView)
 }
 
-func readSingularDnsView(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsViewDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.GetViewResponse
 }
@@ -56,9 +57,9 @@ func (s *DnsViewDataSourceCrud) Get() error {
 		request.ViewId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(s.Context(), request)
 	if err != nil {
 		return err
 	}
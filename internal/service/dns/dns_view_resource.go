@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -24,11 +25,11 @@ func DnsViewResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsView,
-		Read:     readDnsView,
-		Update:   updateDnsView,
-		Delete:   deleteDnsView,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsView,
+		ReadContext:   readDnsView,
+		UpdateContext: updateDnsView,
+		DeleteContext: deleteDnsView,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -86,37 +87,37 @@ func DnsViewResource() *schema.Resource {
 	}
 }
 
-func createDnsView(d *schema.ResourceData, m interface{}) error {
+func createDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsView(d *schema.ResourceData, m interface{}) error {
+func readDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsView(d *schema.ResourceData, m interface{}) error {
+func updateDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsView(d *schema.ResourceData, m interface{}) error {
+func deleteDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsViewResourceCrud struct {
@@ -181,9 +182,10 @@ func (s *DnsViewResourceCrud) Create() error {
 		request.Scope = oci_dns.CreateViewScopeEnum(scope.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateView(context.Background(), request)
+	response, err := s.Client.CreateView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -212,9 +214,9 @@ func (s *DnsViewResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ViewId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,9 +261,9 @@ func (s *DnsViewResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ViewId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateView(context.Background(), request)
+	response, err := s.Client.UpdateView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -280,9 +282,9 @@ func (s *DnsViewResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ViewId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteView(context.Background(), request)
+	_, err := s.Client.DeleteView(s.Context(), request)
 	return err
 }
 
@@ -335,14 +337,14 @@ func (s *DnsViewResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ViewId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeViewCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeViewCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
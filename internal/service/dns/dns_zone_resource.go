@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsZoneResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsZone,
-		Read:     readDnsZone,
-		Update:   updateDnsZone,
-		Delete:   deleteDnsZone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsZone,
+		ReadContext:   readDnsZone,
+		UpdateContext: updateDnsZone,
+		DeleteContext: deleteDnsZone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -149,37 +150,37 @@ func DnsZoneResource() *schema.Resource {
 	}
 }
 
-func createDnsZone(d *schema.ResourceData, m interface{}) error {
+func createDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readDnsZone(d *schema.ResourceData, m interface{}) error {
+func readDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateDnsZone(d *schema.ResourceData, m interface{}) error {
+func updateDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteDnsZone(d *schema.ResourceData, m interface{}) error {
+func deleteDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type DnsZoneResourceCrud struct {
@@ -250,9 +251,9 @@ func (s *DnsZoneResourceCrud) Create() error {
 	}
 	request.CreateZoneDetails = createZoneDetailsRequest
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateZone(context.Background(), request)
+	response, err := s.Client.CreateZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -295,9 +296,9 @@ func (s *DnsZoneResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetZone(context.Background(), request)
+	response, err := s.Client.GetZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -364,9 +365,9 @@ func (s *DnsZoneResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateZone(context.Background(), request)
+	response, err := s.Client.UpdateZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -395,9 +396,9 @@ func (s *DnsZoneResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteZone(context.Background(), request)
+	_, err := s.Client.DeleteZone(s.Context(), request)
 	return err
 }
 
@@ -519,14 +520,14 @@ func (s *DnsZoneResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ZoneId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeZoneCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeZoneCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
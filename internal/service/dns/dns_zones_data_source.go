@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v61/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsZonesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsZones,
+		ReadContext: readDnsZones,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -77,16 +78,16 @@ func DnsZonesDataSource() *schema.Resource {
 	}
 }
 
-func readDnsZones(d *schema.ResourceData, m interface{}) error {
+func readDnsZones(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZonesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DnsZonesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_dns.DnsClient
 	Res    *oci_dns.ListZonesResponse
 }
@@ -161,9 +162,9 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 		request.ZoneType = oci_dns.ListZonesZoneTypeEnum(zoneType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "dns")
 
-	response, err := s.Client.ListZones(context.Background(), request)
+	response, err := s.Client.ListZones(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +173,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListZones(context.Background(), request)
+		listResponse, err := s.Client.ListZones(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func EmailDkimResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailDkim,
-		Read:     readEmailDkim,
-		Update:   updateEmailDkim,
-		Delete:   deleteEmailDkim,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailDkim,
+		ReadContext:   readEmailDkim,
+		UpdateContext: updateEmailDkim,
+		DeleteContext: deleteEmailDkim,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"email_domain_id": {
@@ -106,37 +107,37 @@ func EmailDkimResource() *schema.Resource {
 	}
 }
 
-func createEmailDkim(d *schema.ResourceData, m interface{}) error {
+func createEmailDkim(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailDkimResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readEmailDkim(d *schema.ResourceData, m interface{}) error {
+func readEmailDkim(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailDkimResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateEmailDkim(d *schema.ResourceData, m interface{}) error {
+func updateEmailDkim(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailDkimResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteEmailDkim(d *schema.ResourceData, m interface{}) error {
+func deleteEmailDkim(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailDkimResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type EmailDkimResourceCrud struct {
@@ -205,22 +206,23 @@ func (s *EmailDkimResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateDkim(context.Background(), request)
+	response, err := s.Client.CreateDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDkimFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDkimFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email"), oci_email.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *EmailDkimResourceCrud) getDkimFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_email.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dkimId, err := dkimWaitForWorkRequest(workId, "email",
+	dkimId, err := dkimWaitForWorkRequest(s.Context(), workId, "email",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -256,9 +258,9 @@ func dkimWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 	}
 }
 
-func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.ActionTypeEnum,
+func dkimWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_email.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_email.EmailClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "email")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "email")
 	retryPolicy.ShouldRetryOperation = dkimWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_email.GetWorkRequestResponse{}
@@ -275,7 +277,7 @@ func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.Act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_email.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -287,7 +289,7 @@ func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.Act
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -304,14 +306,14 @@ func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.Act
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_email.OperationStatusFailed || response.Status == oci_email.OperationStatusCanceled {
-		return nil, getErrorFromEmailDkimWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromEmailDkimWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromEmailDkimWorkRequest(client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromEmailDkimWorkRequest(ctx context.Context, client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_email.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -339,9 +341,9 @@ func (s *EmailDkimResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DkimId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetDkim(context.Background(), request)
+	response, err := s.Client.GetDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -373,15 +375,15 @@ func (s *EmailDkimResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateDkim(context.Background(), request)
+	response, err := s.Client.UpdateDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDkimFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDkimFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *EmailDkimResourceCrud) Delete() error {
@@ -390,16 +392,16 @@ func (s *EmailDkimResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DkimId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.DeleteDkim(context.Background(), request)
+	response, err := s.Client.DeleteDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := dkimWaitForWorkRequest(workId, "email",
+	_, delWorkRequestErr := dkimWaitForWorkRequest(s.Context(), workId, "email",
 		oci_email.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
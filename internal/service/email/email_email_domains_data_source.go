@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
 )
 
 func EmailEmailDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmailEmailDomains,
+		ReadContext: readEmailEmailDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func EmailEmailDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readEmailEmailDomains(d *schema.ResourceData, m interface{}) error {
+func readEmailEmailDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailEmailDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EmailEmailDomainsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_email.EmailClient
 	Res    *oci_email.ListEmailDomainsResponse
 }
@@ -92,9 +93,9 @@ func (s *EmailEmailDomainsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_email.EmailDomainLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "email")
 
-	response, err := s.Client.ListEmailDomains(context.Background(), request)
+	response, err := s.Client.ListEmailDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *EmailEmailDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEmailDomains(context.Background(), request)
+		listResponse, err := s.Client.ListEmailDomains(s.Context(), request)
 		if err != nil {
 			return err
 		}
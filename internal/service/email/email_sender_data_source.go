@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
 )
@@ -22,16 +23,16 @@ func EmailSenderDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmailSenderResource(), fieldMap, readSingularEmailSender)
 }
 
-func readSingularEmailSender(d *schema.ResourceData, m interface{}) error {
+func readSingularEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EmailSenderDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_email.EmailClient
 	Res    *oci_email.GetSenderResponse
 }
@@ -48,9 +49,9 @@ func (s *EmailSenderDataSourceCrud) Get() error {
 		request.SenderId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
@@ -19,11 +20,11 @@ func EmailSenderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailSender,
-		Read:     readEmailSender,
-		Update:   updateEmailSender,
-		Delete:   deleteEmailSender,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailSender,
+		ReadContext:   readEmailSender,
+		UpdateContext: updateEmailSender,
+		DeleteContext: deleteEmailSender,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -72,37 +73,37 @@ func EmailSenderResource() *schema.Resource {
 	}
 }
 
-func createEmailSender(d *schema.ResourceData, m interface{}) error {
+func createEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readEmailSender(d *schema.ResourceData, m interface{}) error {
+func readEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateEmailSender(d *schema.ResourceData, m interface{}) error {
+func updateEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteEmailSender(d *schema.ResourceData, m interface{}) error {
+func deleteEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type EmailSenderResourceCrud struct {
@@ -165,9 +166,9 @@ func (s *EmailSenderResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSender(context.Background(), request)
+	response, err := s.Client.CreateSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -182,9 +183,9 @@ func (s *EmailSenderResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -220,9 +221,9 @@ func (s *EmailSenderResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateSender(context.Background(), request)
+	response, err := s.Client.UpdateSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,9 +238,9 @@ func (s *EmailSenderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSender(context.Background(), request)
+	_, err := s.Client.DeleteSender(s.Context(), request)
 	return err
 }
 
@@ -284,14 +285,14 @@ func (s *EmailSenderResourceCrud) updateCompartment(compartment interface{}) err
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SenderId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.ChangeSenderCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSenderCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
 )
@@ -22,16 +23,16 @@ func EmailSuppressionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmailSuppressionResource(), fieldMap, readSingularEmailSuppression)
 }
 
-func readSingularEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func readSingularEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EmailSuppressionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_email.EmailClient
 	Res    *oci_email.GetSuppressionResponse
 }
@@ -48,9 +49,9 @@ func (s *EmailSuppressionDataSourceCrud) Get() error {
 		request.SuppressionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(s.Context(), request)
 	if err != nil {
 		return err
 	}
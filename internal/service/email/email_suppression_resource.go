@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
@@ -19,10 +20,10 @@ func EmailSuppressionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailSuppression,
-		Read:     readEmailSuppression,
-		Delete:   deleteEmailSuppression,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailSuppression,
+		ReadContext:   readEmailSuppression,
+		DeleteContext: deleteEmailSuppression,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -68,29 +69,29 @@ func EmailSuppressionResource() *schema.Resource {
 	}
 }
 
-func createEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func createEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func readEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func deleteEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type EmailSuppressionResourceCrud struct {
@@ -117,9 +118,9 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 		request.EmailAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSuppression(context.Background(), request)
+	response, err := s.Client.CreateSuppression(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -134,9 +135,9 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SuppressionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -151,9 +152,9 @@ func (s *EmailSuppressionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SuppressionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSuppression(context.Background(), request)
+	_, err := s.Client.DeleteSuppression(s.Context(), request)
 	return err
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_email "github.com/oracle/oci-go-sdk/v61/email"
@@ -17,7 +18,7 @@ import (
 
 func EmailSuppressionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmailSuppressions,
+		ReadContext: readEmailSuppressions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -45,16 +46,16 @@ func EmailSuppressionsDataSource() *schema.Resource {
 	}
 }
 
-func readEmailSuppressions(d *schema.ResourceData, m interface{}) error {
+func readEmailSuppressions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EmailSuppressionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_email.EmailClient
 	Res    *oci_email.ListSuppressionsResponse
 }
@@ -92,9 +93,9 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "email")
 
-	response, err := s.Client.ListSuppressions(context.Background(), request)
+	response, err := s.Client.ListSuppressions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSuppressions(context.Background(), request)
+		listResponse, err := s.Client.ListSuppressions(s.Context(), request)
 		if err != nil {
 			return err
 		}
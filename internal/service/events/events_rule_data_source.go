@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_events "github.com/oracle/oci-go-sdk/v61/events"
 )
@@ -22,16 +23,16 @@ func EventsRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EventsRuleResource(), fieldMap, readSingularEventsRule)
 }
 
-func readSingularEventsRule(d *schema.ResourceData, m interface{}) error {
+func readSingularEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EventsRuleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_events.EventsClient
 	Res    *oci_events.GetRuleResponse
 }
@@ -48,9 +49,9 @@ func (s *EventsRuleDataSourceCrud) Get() error {
 		request.RuleId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
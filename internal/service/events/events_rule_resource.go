@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func EventsRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEventsRule,
-		Read:     readEventsRule,
-		Update:   updateEventsRule,
-		Delete:   deleteEventsRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEventsRule,
+		ReadContext:   readEventsRule,
+		UpdateContext: updateEventsRule,
+		DeleteContext: deleteEventsRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"actions": {
@@ -161,37 +162,37 @@ func EventsRuleResource() *schema.Resource {
 	}
 }
 
-func createEventsRule(d *schema.ResourceData, m interface{}) error {
+func createEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readEventsRule(d *schema.ResourceData, m interface{}) error {
+func readEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateEventsRule(d *schema.ResourceData, m interface{}) error {
+func updateEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteEventsRule(d *schema.ResourceData, m interface{}) error {
+func deleteEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type EventsRuleResourceCrud struct {
@@ -281,9 +282,10 @@ func (s *EventsRuleResourceCrud) Create() error {
 		request.IsEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.CreateRule(context.Background(), request)
+	response, err := s.Client.CreateRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -298,9 +300,9 @@ func (s *EventsRuleResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -367,9 +369,9 @@ func (s *EventsRuleResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.UpdateRule(context.Background(), request)
+	response, err := s.Client.UpdateRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -384,9 +386,9 @@ func (s *EventsRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.DeleteRule(context.Background(), request)
+	_, err := s.Client.DeleteRule(s.Context(), request)
 	return err
 }
 
@@ -655,14 +657,14 @@ func (s *EventsRuleResourceCrud) updateCompartment(compartment interface{}) erro
 	idTmp := s.D.Id()
 	changeCompartmentRequest.RuleId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.ChangeRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
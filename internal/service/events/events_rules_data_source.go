@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_events "github.com/oracle/oci-go-sdk/v61/events"
 )
 
 func EventsRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEventsRules,
+		ReadContext: readEventsRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func EventsRulesDataSource() *schema.Resource {
 	}
 }
 
-func readEventsRules(d *schema.ResourceData, m interface{}) error {
+func readEventsRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EventsRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_events.EventsClient
 	Res    *oci_events.ListRulesResponse
 }
@@ -74,9 +75,9 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_events.RuleLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "events")
 
-	response, err := s.Client.ListRules(context.Background(), request)
+	response, err := s.Client.ListRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRules(context.Background(), request)
+		listResponse, err := s.Client.ListRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
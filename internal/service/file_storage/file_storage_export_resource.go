@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
@@ -21,11 +22,11 @@ func FileStorageExportResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageExport,
-		Read:     readFileStorageExport,
-		Update:   updateFileStorageExport,
-		Delete:   deleteFileStorageExport,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageExport,
+		ReadContext:   readFileStorageExport,
+		UpdateContext: updateFileStorageExport,
+		DeleteContext: deleteFileStorageExport,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"export_set_id": {
@@ -106,37 +107,37 @@ func FileStorageExportResource() *schema.Resource {
 	}
 }
 
-func createFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func createFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FileStorageExportResourceCrud struct {
@@ -209,15 +210,16 @@ func (s *FileStorageExportResourceCrud) Create() error {
 		request.Path = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateExport(context.Background(), request)
+	response, err := s.Client.CreateExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Export
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -230,9 +232,9 @@ func (s *FileStorageExportResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExportId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExport(context.Background(), request)
+	response, err := s.Client.GetExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,9 +266,9 @@ func (s *FileStorageExportResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExport(context.Background(), request)
+	response, err := s.Client.UpdateExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,9 +283,9 @@ func (s *FileStorageExportResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExportId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteExport(context.Background(), request)
+	_, err := s.Client.DeleteExport(s.Context(), request)
 	return err
 }
 
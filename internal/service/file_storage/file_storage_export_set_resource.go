@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
@@ -21,11 +22,11 @@ func FileStorageExportSetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageExportSet,
-		Read:     readFileStorageExportSet,
-		Update:   updateFileStorageExportSet,
-		Delete:   deleteFileStorageExportSet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageExportSet,
+		ReadContext:   readFileStorageExportSet,
+		UpdateContext: updateFileStorageExportSet,
+		DeleteContext: deleteFileStorageExportSet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"mount_target_id": {
@@ -80,31 +81,31 @@ func FileStorageExportSetResource() *schema.Resource {
 	}
 }
 
-func createFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func createFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Export set is deleted when a mount target is deleted.
 	return nil
 }
@@ -128,9 +129,9 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 		request := oci_file_storage.GetMountTargetRequest{}
 		request.MountTargetId = &tmp
 
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-		response, err := s.Client.GetMountTarget(context.Background(), request)
+		response, err := s.Client.GetMountTarget(s.Context(), request)
 		if err != nil {
 			return fmt.Errorf("getting mount target details failed with error: %s", err.Error())
 		}
@@ -154,9 +155,9 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExportSetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExportSet(context.Background(), request)
+	response, err := s.Client.GetExportSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,9 +195,9 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 		request.MaxFsStatFiles = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExportSet(context.Background(), request)
+	response, err := s.Client.UpdateExportSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
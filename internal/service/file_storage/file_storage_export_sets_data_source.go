@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
 
 func FileStorageExportSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageExportSets,
+		ReadContext: readFileStorageExportSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,16 +48,16 @@ func FileStorageExportSetsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageExportSets(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExportSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageExportSetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.ListExportSetsResponse
 }
@@ -92,9 +93,9 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListExportSetsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.ListExportSets(context.Background(), request)
+	response, err := s.Client.ListExportSets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExportSets(context.Background(), request)
+		listResponse, err := s.Client.ListExportSets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
 
 func FileStorageExportsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageExports,
+		ReadContext: readFileStorageExports,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,16 +48,16 @@ func FileStorageExportsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageExports(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExports(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageExportsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.ListExportsResponse
 }
@@ -92,9 +93,9 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListExportsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.ListExports(context.Background(), request)
+	response, err := s.Client.ListExports(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExports(context.Background(), request)
+		listResponse, err := s.Client.ListExports(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
@@ -20,11 +21,11 @@ func FileStorageFileSystemResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageFileSystem,
-		Read:     readFileStorageFileSystem,
-		Update:   updateFileStorageFileSystem,
-		Delete:   deleteFileStorageFileSystem,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageFileSystem,
+		ReadContext:   readFileStorageFileSystem,
+		UpdateContext: updateFileStorageFileSystem,
+		DeleteContext: deleteFileStorageFileSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -118,37 +119,37 @@ func FileStorageFileSystemResource() *schema.Resource {
 	}
 }
 
-func createFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func createFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func readFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FileStorageFileSystemResourceCrud struct {
@@ -226,15 +227,16 @@ func (s *FileStorageFileSystemResourceCrud) Create() error {
 		request.SourceSnapshotId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateFileSystem(context.Background(), request)
+	response, err := s.Client.CreateFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.FileSystem
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -247,9 +249,9 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.FileSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetFileSystem(context.Background(), request)
+	response, err := s.Client.GetFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -295,9 +297,9 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 		request.KmsKeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateFileSystem(context.Background(), request)
+	response, err := s.Client.UpdateFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,9 +314,9 @@ func (s *FileStorageFileSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FileSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteFileSystem(context.Background(), request)
+	_, err := s.Client.DeleteFileSystem(s.Context(), request)
 	return err
 }
 
@@ -395,14 +397,14 @@ func (s *FileStorageFileSystemResourceCrud) updateCompartment(compartment interf
 	idTmp := s.D.Id()
 	changeCompartmentRequest.FileSystemId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeFileSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeFileSystemCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
 
 func FileStorageFileSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageFileSystems,
+		ReadContext: readFileStorageFileSystems,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -56,16 +57,16 @@ func FileStorageFileSystemsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageFileSystems(d *schema.ResourceData, m interface{}) error {
+func readFileStorageFileSystems(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageFileSystemsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.ListFileSystemsResponse
 }
@@ -111,9 +112,9 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListFileSystemsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.ListFileSystems(context.Background(), request)
+	response, err := s.Client.ListFileSystems(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -122,7 +123,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFileSystems(context.Background(), request)
+		listResponse, err := s.Client.ListFileSystems(s.Context(), request)
 		if err != nil {
 			return err
 		}
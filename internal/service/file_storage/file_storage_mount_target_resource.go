@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
@@ -20,11 +21,11 @@ func FileStorageMountTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageMountTarget,
-		Read:     readFileStorageMountTarget,
-		Update:   updateFileStorageMountTarget,
-		Delete:   deleteFileStorageMountTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageMountTarget,
+		ReadContext:   readFileStorageMountTarget,
+		UpdateContext: updateFileStorageMountTarget,
+		DeleteContext: deleteFileStorageMountTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -113,41 +114,41 @@ func FileStorageMountTargetResource() *schema.Resource {
 	}
 }
 
-func createFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func createFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func readFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FileStorageMountTargetResourceCrud struct {
@@ -247,15 +248,16 @@ func (s *FileStorageMountTargetResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateMountTarget(context.Background(), request)
+	response, err := s.Client.CreateMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.MountTarget
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -268,9 +270,9 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetMountTarget(context.Background(), request)
+	response, err := s.Client.GetMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -325,9 +327,9 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateMountTarget(context.Background(), request)
+	response, err := s.Client.UpdateMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,9 +344,9 @@ func (s *FileStorageMountTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteMountTarget(context.Background(), request)
+	_, err := s.Client.DeleteMountTarget(s.Context(), request)
 	return err
 }
 
@@ -413,14 +415,14 @@ func (s *FileStorageMountTargetResourceCrud) updateCompartment(compartment inter
 	idTmp := s.D.Id()
 	changeCompartmentRequest.MountTargetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeMountTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMountTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -432,9 +434,9 @@ func (s *FileStorageMountTargetResourceCrud) setPrivateIpDetails(privateIpOcid s
 
 	request.PrivateIpId = &privateIpOcid
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "core")
 
-	response, err := s.VirtualNetworkClient.GetPrivateIp(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetPrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
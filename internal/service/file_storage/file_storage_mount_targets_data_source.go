@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
 
 func FileStorageMountTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageMountTargets,
+		ReadContext: readFileStorageMountTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -51,16 +52,16 @@ func FileStorageMountTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageMountTargets(d *schema.ResourceData, m interface{}) error {
+func readFileStorageMountTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageMountTargetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.ListMountTargetsResponse
 }
@@ -101,9 +102,9 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListMountTargetsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.ListMountTargets(context.Background(), request)
+	response, err := s.Client.ListMountTargets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMountTargets(context.Background(), request)
+		listResponse, err := s.Client.ListMountTargets(s.Context(), request)
 		if err != nil {
 			return err
 		}
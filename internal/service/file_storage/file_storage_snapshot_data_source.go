@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
@@ -22,16 +23,16 @@ func FileStorageSnapshotDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FileStorageSnapshotResource(), fieldMap, readSingularFileStorageSnapshot)
 }
 
-func readSingularFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func readSingularFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageSnapshotDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.GetSnapshotResponse
 }
@@ -48,9 +49,9 @@ func (s *FileStorageSnapshotDataSourceCrud) Get() error {
 		request.SnapshotId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
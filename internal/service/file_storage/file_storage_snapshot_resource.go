@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
@@ -19,11 +20,11 @@ func FileStorageSnapshotResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageSnapshot,
-		Read:     readFileStorageSnapshot,
-		Update:   updateFileStorageSnapshot,
-		Delete:   deleteFileStorageSnapshot,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageSnapshot,
+		ReadContext:   readFileStorageSnapshot,
+		UpdateContext: updateFileStorageSnapshot,
+		DeleteContext: deleteFileStorageSnapshot,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"file_system_id": {
@@ -77,37 +78,37 @@ func FileStorageSnapshotResource() *schema.Resource {
 	}
 }
 
-func createFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func createFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func readFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FileStorageSnapshotResourceCrud struct {
@@ -170,15 +171,16 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateSnapshot(context.Background(), request)
+	response, err := s.Client.CreateSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Snapshot
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -191,9 +193,9 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -220,9 +222,9 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateSnapshot(context.Background(), request)
+	response, err := s.Client.UpdateSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,9 +239,9 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteSnapshot(context.Background(), request)
+	_, err := s.Client.DeleteSnapshot(s.Context(), request)
 	return err
 }
 
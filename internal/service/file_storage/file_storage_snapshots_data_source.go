@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v61/filestorage"
 )
 
 func FileStorageSnapshotsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageSnapshots,
+		ReadContext: readFileStorageSnapshots,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"file_system_id": {
@@ -39,16 +40,16 @@ func FileStorageSnapshotsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageSnapshots(d *schema.ResourceData, m interface{}) error {
+func readFileStorageSnapshots(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FileStorageSnapshotsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_file_storage.FileStorageClient
 	Res    *oci_file_storage.ListSnapshotsResponse
 }
@@ -74,9 +75,9 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListSnapshotsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "file_storage")
 
-	response, err := s.Client.ListSnapshots(context.Background(), request)
+	response, err := s.Client.ListSnapshots(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSnapshots(context.Background(), request)
+		listResponse, err := s.Client.ListSnapshots(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
 )
@@ -22,16 +23,16 @@ func FunctionsApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FunctionsApplicationResource(), fieldMap, readSingularFunctionsApplication)
 }
 
-func readSingularFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FunctionsApplicationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_functions.FunctionsManagementClient
 	Res    *oci_functions.GetApplicationResponse
 }
@@ -48,9 +49,9 @@ func (s *FunctionsApplicationDataSourceCrud) Get() error {
 		request.ApplicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
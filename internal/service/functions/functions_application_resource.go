@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
@@ -24,11 +25,11 @@ func FunctionsApplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsApplication,
-		Read:     readFunctionsApplication,
-		Update:   updateFunctionsApplication,
-		Delete:   deleteFunctionsApplication,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsApplication,
+		ReadContext:   readFunctionsApplication,
+		UpdateContext: updateFunctionsApplication,
+		DeleteContext: deleteFunctionsApplication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -165,37 +166,37 @@ func FunctionsApplicationResource() *schema.Resource {
 	}
 }
 
-func createFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func createFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func readFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func updateFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FunctionsApplicationResourceCrud struct {
@@ -316,9 +317,9 @@ func (s *FunctionsApplicationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -333,9 +334,9 @@ func (s *FunctionsApplicationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -416,9 +417,9 @@ func (s *FunctionsApplicationResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -433,9 +434,9 @@ func (s *FunctionsApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(s.Context(), request)
 	return err
 }
 
@@ -597,14 +598,14 @@ func (s *FunctionsApplicationResourceCrud) updateCompartment(compartment interfa
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
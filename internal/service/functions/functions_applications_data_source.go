@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
 )
 
 func FunctionsApplicationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFunctionsApplications,
+		ReadContext: readFunctionsApplications,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func FunctionsApplicationsDataSource() *schema.Resource {
 	}
 }
 
-func readFunctionsApplications(d *schema.ResourceData, m interface{}) error {
+func readFunctionsApplications(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FunctionsApplicationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_functions.FunctionsManagementClient
 	Res    *oci_functions.ListApplicationsResponse
 }
@@ -83,9 +84,9 @@ func (s *FunctionsApplicationsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_functions.ApplicationLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 
-	response, err := s.Client.ListApplications(context.Background(), request)
+	response, err := s.Client.ListApplications(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *FunctionsApplicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApplications(context.Background(), request)
+		listResponse, err := s.Client.ListApplications(s.Context(), request)
 		if err != nil {
 			return err
 		}
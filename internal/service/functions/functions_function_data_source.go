@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
 )
@@ -23,16 +24,16 @@ func FunctionsFunctionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FunctionsFunctionResource(), fieldMap, readSingularFunctionsFunction)
 }
 
-func readSingularFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func readSingularFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FunctionsFunctionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_functions.FunctionsManagementClient
 	Res    *oci_functions.GetFunctionResponse
 }
@@ -49,9 +50,9 @@ func (s *FunctionsFunctionDataSourceCrud) Get() error {
 		request.FunctionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -41,11 +42,11 @@ func FunctionsFunctionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsFunction,
-		Read:     readFunctionsFunction,
-		Update:   updateFunctionsFunction,
-		Delete:   deleteFunctionsFunction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsFunction,
+		ReadContext:   readFunctionsFunction,
+		UpdateContext: updateFunctionsFunction,
+		DeleteContext: deleteFunctionsFunction,
 		CustomizeDiff: customdiff.All(
 			customdiff.IfValueChange("image",
 				func(ctx context.Context, old, new, meta interface{}) bool {
@@ -177,37 +178,37 @@ func FunctionsFunctionResource() *schema.Resource {
 	}
 }
 
-func createFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func createFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func readFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func updateFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type FunctionsFunctionResourceCrud struct {
@@ -313,9 +314,9 @@ func (s *FunctionsFunctionResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateFunction(context.Background(), request)
+	response, err := s.Client.CreateFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -330,9 +331,9 @@ func (s *FunctionsFunctionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.FunctionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -401,9 +402,9 @@ func (s *FunctionsFunctionResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateFunction(context.Background(), request)
+	response, err := s.Client.UpdateFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -418,9 +419,9 @@ func (s *FunctionsFunctionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FunctionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteFunction(context.Background(), request)
+	_, err := s.Client.DeleteFunction(s.Context(), request)
 	return err
 }
 
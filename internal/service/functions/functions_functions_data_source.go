@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
 )
 
 func FunctionsFunctionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFunctionsFunctions,
+		ReadContext: readFunctionsFunctions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"application_id": {
@@ -44,16 +45,16 @@ func FunctionsFunctionsDataSource() *schema.Resource {
 	}
 }
 
-func readFunctionsFunctions(d *schema.ResourceData, m interface{}) error {
+func readFunctionsFunctions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type FunctionsFunctionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_functions.FunctionsManagementClient
 	Res    *oci_functions.ListFunctionsResponse
 }
@@ -84,9 +85,9 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_functions.FunctionLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 
-	response, err := s.Client.ListFunctions(context.Background(), request)
+	response, err := s.Client.ListFunctions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFunctions(context.Background(), request)
+		listResponse, err := s.Client.ListFunctions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_functions "github.com/oracle/oci-go-sdk/v61/functions"
@@ -22,10 +23,10 @@ import (
 
 func FunctionsInvokeFunctionResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsInvokeFunction,
-		Read:     readFunctionsInvokeFunction,
-		Delete:   deleteFunctionsInvokeFunction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsInvokeFunction,
+		ReadContext:   readFunctionsInvokeFunction,
+		DeleteContext: deleteFunctionsInvokeFunction,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"function_id": {
@@ -89,23 +90,24 @@ func FunctionsInvokeFunctionResource() *schema.Resource {
 	}
 }
 
-func createFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func createFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsInvokeFunctionResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 
 	endPoint, ok := d.GetOkExists("invoke_endpoint")
 	if !ok {
 		var err error
 		endPoint, err = sync.getInvokeEndPoint(m)
 		if err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		// cache endpoint as it won't change for a function
 		d.Set("invoke_endpoint", endPoint)
 	}
 
 	sync.Client, _ = m.(*client.OracleClients).FunctionsInvokeClientWithEndpoint(endPoint.(string))
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
 func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (string, error) {
@@ -117,9 +119,9 @@ func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (
 		functionGetRequest.FunctionId = &tmp
 	}
 
-	functionGetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	functionGetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 
-	functionGetResponse, err := functionsManagementClient.GetFunction(context.Background(), functionGetRequest)
+	functionGetResponse, err := functionsManagementClient.GetFunction(s.Context(), functionGetRequest)
 	if err != nil {
 		return "", err
 	}
@@ -132,11 +134,11 @@ func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (
 	return endPoint, nil
 }
 
-func readFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func readFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -190,12 +192,12 @@ func (s *FunctionsInvokeFunctionResourceCrud) Create() error {
 		request.FunctionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "functions")
 	if endPoint, ok := s.D.GetOkExists("invoke_endpoint"); !ok {
 		s.Client.Host = endPoint.(string)
 	}
 
-	response, err := s.Client.InvokeFunction(context.Background(), request)
+	response, err := s.Client.InvokeFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
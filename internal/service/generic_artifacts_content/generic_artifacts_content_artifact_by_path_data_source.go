@@ -6,6 +6,7 @@ package generic_artifacts_content
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v61/genericartifactscontent"
 
@@ -30,16 +31,16 @@ func GenericArtifactsContentArtifactByPathDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(GenericArtifactsContentArtifactByPathResource(), fieldMap, readSingularGenericArtifactsContentArtifactByPath)
 }
 
-func readSingularGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func readSingularGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GenericArtifactsContentArtifactByPathDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_generic_artifacts_content.GenericArtifactsContentClient
 	Res    *oci_generic_artifacts_content.GetGenericArtifactContentByPathResponse
 }
@@ -66,9 +67,9 @@ func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) Get() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v61/genericartifactscontent"
 
@@ -23,10 +24,10 @@ import (
 
 func GenericArtifactsContentArtifactByPathResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createGenericArtifactsContentArtifactByPath,
-		Read:     readGenericArtifactsContentArtifactByPath,
-		Delete:   deleteGenericArtifactsContentArtifactByPath,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createGenericArtifactsContentArtifactByPath,
+		ReadContext:   readGenericArtifactsContentArtifactByPath,
+		DeleteContext: deleteGenericArtifactsContentArtifactByPath,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"repository_id": {
@@ -110,23 +111,23 @@ func GenericArtifactsContentArtifactByPathResource() *schema.Resource {
 	}
 }
 
-func createGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func createGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func readGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func deleteGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -182,9 +183,9 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get() error {
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -229,9 +230,9 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByCont
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -271,9 +272,9 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySour
 		request.Version = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
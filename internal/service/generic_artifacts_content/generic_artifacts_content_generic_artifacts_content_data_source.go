@@ -6,6 +6,7 @@ package generic_artifacts_content
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v61/genericartifactscontent"
 
@@ -15,7 +16,7 @@ import (
 
 func GenericArtifactsContentGenericArtifactsContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGenericArtifactsContentGenericArtifactsContent,
+		ReadContext: readSingularGenericArtifactsContentGenericArtifactsContent,
 		Schema: map[string]*schema.Schema{
 			"artifact_id": {
 				Type:     schema.TypeString,
@@ -26,16 +27,16 @@ func GenericArtifactsContentGenericArtifactsContentDataSource() *schema.Resource
 	}
 }
 
-func readSingularGenericArtifactsContentGenericArtifactsContent(d *schema.ResourceData, m interface{}) error {
+func readSingularGenericArtifactsContentGenericArtifactsContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentGenericArtifactsContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GenericArtifactsContentGenericArtifactsContentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_generic_artifacts_content.GenericArtifactsContentClient
 	Res    *oci_generic_artifacts_content.GetGenericArtifactContentResponse
 }
@@ -52,9 +53,9 @@ func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) Get() err
 		request.ArtifactId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContent(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
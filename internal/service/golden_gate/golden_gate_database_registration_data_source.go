@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v61/goldengate"
 )
@@ -22,16 +23,16 @@ func GoldenGateDatabaseRegistrationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(GoldenGateDatabaseRegistrationResource(), fieldMap, readSingularGoldenGateDatabaseRegistration)
 }
 
-func readSingularGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GoldenGateDatabaseRegistrationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_golden_gate.GoldenGateClient
 	Res    *oci_golden_gate.GetDatabaseRegistrationResponse
 }
@@ -48,9 +49,9 @@ func (s *GoldenGateDatabaseRegistrationDataSourceCrud) Get() error {
 		request.DatabaseRegistrationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
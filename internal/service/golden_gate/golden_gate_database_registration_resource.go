@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func GoldenGateDatabaseRegistrationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createGoldenGateDatabaseRegistration,
-		Read:     readGoldenGateDatabaseRegistration,
-		Update:   updateGoldenGateDatabaseRegistration,
-		Delete:   deleteGoldenGateDatabaseRegistration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createGoldenGateDatabaseRegistration,
+		ReadContext:   readGoldenGateDatabaseRegistration,
+		UpdateContext: updateGoldenGateDatabaseRegistration,
+		DeleteContext: deleteGoldenGateDatabaseRegistration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"alias_name": {
@@ -163,37 +164,37 @@ func GoldenGateDatabaseRegistrationResource() *schema.Resource {
 	}
 }
 
-func createGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func createGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func updateGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func deleteGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type GoldenGateDatabaseRegistrationResourceCrud struct {
@@ -328,22 +329,23 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Create() error {
 		request.Wallet = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.CreateDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *GoldenGateDatabaseRegistrationResourceCrud) getDatabaseRegistrationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_golden_gate.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	databaseRegistrationId, err := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	databaseRegistrationId, err := databaseRegistrationWaitForWorkRequest(s.Context(), workId, "databaseregistration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -379,9 +381,9 @@ func databaseRegistrationWorkRequestShouldRetryFunc(timeout time.Duration) func(
 	}
 }
 
-func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
+func databaseRegistrationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_golden_gate.GoldenGateClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "golden_gate")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "golden_gate")
 	retryPolicy.ShouldRetryOperation = databaseRegistrationWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_golden_gate.GetWorkRequestResponse{}
@@ -397,7 +399,7 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -409,7 +411,7 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -426,14 +428,14 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_golden_gate.OperationStatusFailed || response.Status == oci_golden_gate.OperationStatusCanceled {
-		return nil, getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromGoldenGateDatabaseRegistrationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(ctx context.Context, client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -461,9 +463,9 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatabaseRegistrationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -543,15 +545,15 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Update() error {
 		request.Wallet = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
@@ -560,16 +562,16 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseRegistrationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	_, delWorkRequestErr := databaseRegistrationWaitForWorkRequest(s.Context(), workId, "databaseregistration",
 		oci_golden_gate.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -749,16 +751,16 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) updateCompartment(compartme
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DatabaseRegistrationId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.ChangeDatabaseRegistrationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseRegistrationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, changeWorkRequestErr := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	_, changeWorkRequestErr := databaseRegistrationWaitForWorkRequest(s.Context(), workId, "databaseregistration",
 		oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	return changeWorkRequestErr
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v61/goldengate"
 )
 
 func GoldenGateDatabaseRegistrationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDatabaseRegistrations,
+		ReadContext: readGoldenGateDatabaseRegistrations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func GoldenGateDatabaseRegistrationsDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDatabaseRegistrations(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDatabaseRegistrations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GoldenGateDatabaseRegistrationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_golden_gate.GoldenGateClient
 	Res    *oci_golden_gate.ListDatabaseRegistrationsResponse
 }
@@ -83,9 +84,9 @@ func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_golden_gate.ListDatabaseRegistrationsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "golden_gate")
 
-	response, err := s.Client.ListDatabaseRegistrations(context.Background(), request)
+	response, err := s.Client.ListDatabaseRegistrations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseRegistrations(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseRegistrations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v61/goldengate"
 )
 
 func GoldenGateDeploymentBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDeploymentBackups,
+		ReadContext: readGoldenGateDeploymentBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func GoldenGateDeploymentBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDeploymentBackups(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDeploymentBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GoldenGateDeploymentBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_golden_gate.GoldenGateClient
 	Res    *oci_golden_gate.ListDeploymentBackupsResponse
 }
@@ -92,9 +93,9 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_golden_gate.ListDeploymentBackupsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentBackups(context.Background(), request)
+	response, err := s.Client.ListDeploymentBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentBackups(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
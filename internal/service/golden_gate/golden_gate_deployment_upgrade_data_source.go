@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v61/goldengate"
 )
 
 func GoldenGateDeploymentUpgradeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGoldenGateDeploymentUpgrade,
+		ReadContext: readSingularGoldenGateDeploymentUpgrade,
 		Schema: map[string]*schema.Schema{
 			"deployment_upgrade_id": {
 				Type:     schema.TypeString,
@@ -93,16 +94,16 @@ func GoldenGateDeploymentUpgradeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularGoldenGateDeploymentUpgrade(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateDeploymentUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentUpgradeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GoldenGateDeploymentUpgradeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_golden_gate.GoldenGateClient
 	Res    *oci_golden_gate.GetDeploymentUpgradeResponse
 }
@@ -119,9 +120,9 @@ func (s *GoldenGateDeploymentUpgradeDataSourceCrud) Get() error {
 		request.DeploymentUpgradeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "golden_gate")
 
-	response, err := s.Client.GetDeploymentUpgrade(context.Background(), request)
+	response, err := s.Client.GetDeploymentUpgrade(s.Context(), request)
 	if err != nil {
 		return err
 	}
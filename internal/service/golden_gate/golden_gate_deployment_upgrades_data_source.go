@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v61/goldengate"
 )
 
 func GoldenGateDeploymentUpgradesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDeploymentUpgrades,
+		ReadContext: readGoldenGateDeploymentUpgrades,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -130,16 +131,16 @@ func GoldenGateDeploymentUpgradesDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDeploymentUpgrades(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDeploymentUpgrades(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentUpgradesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type GoldenGateDeploymentUpgradesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_golden_gate.GoldenGateClient
 	Res    *oci_golden_gate.ListDeploymentUpgradesResponse
 }
@@ -170,9 +171,9 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_golden_gate.ListDeploymentUpgradesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+	response, err := s.Client.ListDeploymentUpgrades(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentUpgrades(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
 
@@ -22,16 +23,16 @@ func HealthChecksHttpMonitorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(HealthChecksHttpMonitorResource(), fieldMap, readSingularHealthChecksHttpMonitor)
 }
 
-func readSingularHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func readSingularHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type HealthChecksHttpMonitorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_health_checks.HealthChecksClient
 	Res    *oci_health_checks.GetHttpMonitorResponse
 }
@@ -48,9 +49,9 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
 		request.MonitorId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
@@ -19,11 +20,11 @@ func HealthChecksHttpMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksHttpMonitor,
-		Read:     readHealthChecksHttpMonitor,
-		Update:   updateHealthChecksHttpMonitor,
-		Delete:   deleteHealthChecksHttpMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksHttpMonitor,
+		ReadContext:   readHealthChecksHttpMonitor,
+		UpdateContext: updateHealthChecksHttpMonitor,
+		DeleteContext: deleteHealthChecksHttpMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -121,37 +122,37 @@ func HealthChecksHttpMonitorResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func updateHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type HealthChecksHttpMonitorResourceCrud struct {
@@ -253,9 +254,10 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateHttpMonitor(context.Background(), request)
+	response, err := s.Client.CreateHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -270,9 +272,9 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -376,9 +378,9 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdateHttpMonitor(context.Background(), request)
+	response, err := s.Client.UpdateHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,9 +395,9 @@ func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeleteHttpMonitor(context.Background(), request)
+	_, err := s.Client.DeleteHttpMonitor(s.Context(), request)
 	return err
 }
 
@@ -468,14 +470,14 @@ func (s *HealthChecksHttpMonitorResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.MonitorId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangeHttpMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpMonitorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksHttpMonitors,
+		ReadContext: readHealthChecksHttpMonitors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksHttpMonitors(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpMonitors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type HealthChecksHttpMonitorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_health_checks.HealthChecksClient
 	Res    *oci_health_checks.ListHttpMonitorsResponse
 }
@@ -75,9 +76,9 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 		request.HomeRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "health_checks")
 
-	response, err := s.Client.ListHttpMonitors(context.Background(), request)
+	response, err := s.Client.ListHttpMonitors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListHttpMonitors(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -5,7 +5,7 @@ package health_checks
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
@@ -19,10 +19,10 @@ func HealthChecksHttpProbeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksHttpProbe,
-		Read:     readHealthChecksHttpProbe,
-		Delete:   deleteHealthChecksHttpProbe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksHttpProbe,
+		ReadContext:   readHealthChecksHttpProbe,
+		DeleteContext: deleteHealthChecksHttpProbe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -103,19 +103,19 @@ func HealthChecksHttpProbeResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksHttpProbe(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksHttpProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpProbeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readHealthChecksHttpProbe(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteHealthChecksHttpProbe(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksHttpProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -192,9 +192,9 @@ func (s *HealthChecksHttpProbeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandHttpProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandHttpProbe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
 
@@ -22,16 +23,16 @@ func HealthChecksPingMonitorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(HealthChecksPingMonitorResource(), fieldMap, readSingularHealthChecksPingMonitor)
 }
 
-func readSingularHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func readSingularHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type HealthChecksPingMonitorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_health_checks.HealthChecksClient
 	Res    *oci_health_checks.GetPingMonitorResponse
 }
@@ -48,9 +49,9 @@ func (s *HealthChecksPingMonitorDataSourceCrud) Get() error {
 		request.MonitorId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
@@ -19,11 +20,11 @@ func HealthChecksPingMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksPingMonitor,
-		Read:     readHealthChecksPingMonitor,
-		Update:   updateHealthChecksPingMonitor,
-		Delete:   deleteHealthChecksPingMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksPingMonitor,
+		ReadContext:   readHealthChecksPingMonitor,
+		UpdateContext: updateHealthChecksPingMonitor,
+		DeleteContext: deleteHealthChecksPingMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -105,37 +106,37 @@ func HealthChecksPingMonitorResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func updateHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type HealthChecksPingMonitorResourceCrud struct {
@@ -224,9 +225,10 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreatePingMonitor(context.Background(), request)
+	response, err := s.Client.CreatePingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +243,9 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -334,9 +336,9 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdatePingMonitor(context.Background(), request)
+	response, err := s.Client.UpdatePingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,9 +353,9 @@ func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeletePingMonitor(context.Background(), request)
+	_, err := s.Client.DeletePingMonitor(s.Context(), request)
 	return err
 }
 
@@ -418,14 +420,14 @@ func (s *HealthChecksPingMonitorResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.MonitorId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangePingMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePingMonitorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
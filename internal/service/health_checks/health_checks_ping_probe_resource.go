@@ -5,7 +5,7 @@ package health_checks
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v61/healthchecks"
@@ -19,10 +19,10 @@ func HealthChecksPingProbeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksPingProbe,
-		Read:     readHealthChecksPingProbe,
-		Delete:   deleteHealthChecksPingProbe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksPingProbe,
+		ReadContext:   readHealthChecksPingProbe,
+		DeleteContext: deleteHealthChecksPingProbe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -84,19 +84,19 @@ func HealthChecksPingProbeResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingProbeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -159,9 +159,9 @@ func (s *HealthChecksPingProbeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandPingProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandPingProbe(s.Context(), request)
 	if err != nil {
 		return err
 	}
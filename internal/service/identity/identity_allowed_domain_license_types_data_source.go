@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 )
 
 func IdentityAllowedDomainLicenseTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAllowedDomainLicenseTypes,
+		ReadContext: readIdentityAllowedDomainLicenseTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"current_license_type_name": {
@@ -51,16 +52,16 @@ func IdentityAllowedDomainLicenseTypesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAllowedDomainLicenseTypes(d *schema.ResourceData, m interface{}) error {
+func readIdentityAllowedDomainLicenseTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAllowedDomainLicenseTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityAllowedDomainLicenseTypesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListAllowedDomainLicenseTypesResponse
 }
@@ -77,9 +78,9 @@ func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) Get() error {
 		request.CurrentLicenseTypeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListAllowedDomainLicenseTypes(context.Background(), request)
+	response, err := s.Client.ListAllowedDomainLicenseTypes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
@@ -26,10 +27,10 @@ func IdentityApiKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityApiKey,
-		Read:     readIdentityApiKey,
-		Delete:   deleteIdentityApiKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityApiKey,
+		ReadContext:   readIdentityApiKey,
+		DeleteContext: deleteIdentityApiKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"key_value": {
@@ -72,29 +73,29 @@ func IdentityApiKeyResource() *schema.Resource {
 	}
 }
 
-func createIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func createIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func readIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityApiKeyResourceCrud struct {
@@ -149,9 +150,10 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UploadApiKey(context.Background(), request)
+	response, err := s.Client.UploadApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,9 +178,9 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -208,9 +210,9 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteApiKey(context.Background(), request)
+	_, err := s.Client.DeleteApiKey(s.Context(), request)
 	return err
 }
 
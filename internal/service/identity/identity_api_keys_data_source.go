@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 )
 
 func IdentityApiKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityApiKeys,
+		ReadContext: readIdentityApiKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,16 +33,16 @@ func IdentityApiKeysDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityApiKeys(d *schema.ResourceData, m interface{}) error {
+func readIdentityApiKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityApiKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListApiKeysResponse
 }
@@ -58,9 +59,9 @@ func (s *IdentityApiKeysDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
@@ -26,11 +27,11 @@ func IdentityAuthTokenResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityAuthToken,
-		Read:     readIdentityAuthToken,
-		Update:   updateIdentityAuthToken,
-		Delete:   deleteIdentityAuthToken,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityAuthToken,
+		ReadContext:   readIdentityAuthToken,
+		UpdateContext: updateIdentityAuthToken,
+		DeleteContext: deleteIdentityAuthToken,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -70,37 +71,37 @@ func IdentityAuthTokenResource() *schema.Resource {
 	}
 }
 
-func createIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func createIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func updateIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityAuthTokenResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateAuthToken(context.Background(), request)
+	response, err := s.Client.CreateAuthToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -182,9 +184,9 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthToken(context.Background(), request)
+	response, err := s.Client.UpdateAuthToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -238,9 +240,9 @@ func (s *IdentityAuthTokenResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteAuthToken(context.Background(), request)
+	_, err := s.Client.DeleteAuthToken(s.Context(), request)
 	return err
 }
 
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 )
 
 func IdentityAuthTokensDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAuthTokens,
+		ReadContext: readIdentityAuthTokens,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,16 +33,16 @@ func IdentityAuthTokensDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAuthTokens(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthTokens(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokensDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityAuthTokensDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListAuthTokensResponse
 }
@@ -58,9 +59,9 @@ func (s *IdentityAuthTokensDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(s.Context(), request)
 	if err != nil {
 		return err
 	}
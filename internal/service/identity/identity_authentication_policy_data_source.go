@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -22,16 +23,16 @@ func IdentityAuthenticationPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityAuthenticationPolicyResource(), fieldMap, readSingularIdentityAuthenticationPolicy)
 }
 
-func readSingularIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityAuthenticationPolicyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetAuthenticationPolicyResponse
 }
@@ -48,9 +49,9 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
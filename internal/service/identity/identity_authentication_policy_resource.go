@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityAuthenticationPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityAuthenticationPolicy,
-		Read:     readIdentityAuthenticationPolicy,
-		Update:   updateIdentityAuthenticationPolicy,
-		Delete:   deleteIdentityAuthenticationPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityAuthenticationPolicy,
+		ReadContext:   readIdentityAuthenticationPolicy,
+		UpdateContext: updateIdentityAuthenticationPolicy,
+		DeleteContext: deleteIdentityAuthenticationPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -114,31 +115,31 @@ func IdentityAuthenticationPolicyResource() *schema.Resource {
 	}
 }
 
-func createIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func createIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func updateIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -183,9 +184,9 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,9 +210,9 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -250,9 +251,9 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
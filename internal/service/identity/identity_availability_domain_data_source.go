@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -21,7 +22,7 @@ import (
 
 func IdentityAvailabilityDomainDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAvailabilityDomain,
+		ReadContext: readAvailabilityDomain,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -46,16 +47,16 @@ func IdentityAvailabilityDomainDataSource() *schema.Resource {
 	}
 }
 
-func readAvailabilityDomain(d *schema.ResourceData, m interface{}) error {
+func readAvailabilityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AvailabilityDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type AvailabilityDomainDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListAvailabilityDomainsResponse
 }
@@ -72,9 +73,9 @@ func (s *AvailabilityDomainDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -17,7 +18,7 @@ import (
 
 func IdentityAvailabilityDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAvailabilityDomains,
+		ReadContext: readIdentityAvailabilityDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -53,16 +54,16 @@ func IdentityAvailabilityDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAvailabilityDomains(d *schema.ResourceData, m interface{}) error {
+func readIdentityAvailabilityDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAvailabilityDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityAvailabilityDomainsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListAvailabilityDomainsResponse
 }
@@ -79,9 +80,9 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCompartmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityCompartment,
+		ReadContext: readSingularIdentityCompartment,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -65,16 +66,16 @@ func IdentityCompartmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityCompartmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetCompartmentResponse
 }
@@ -93,9 +94,9 @@ func (s *IdentityCompartmentDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(s.Context(), request)
 	if err != nil {
 		return err
 	}
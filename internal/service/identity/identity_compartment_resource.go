@@ -12,6 +12,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -27,10 +28,10 @@ func IdentityCompartmentResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Delete: tfresource.GetTimeoutDuration("90m"), // service team states: p50: 30 min, p90: 60 min, max: 180 min
 		},
-		Create: createIdentityCompartment,
-		Read:   readIdentityCompartment,
-		Update: updateIdentityCompartment,
-		Delete: deleteIdentityCompartment,
+		CreateContext: createIdentityCompartment,
+		ReadContext:   readIdentityCompartment,
+		UpdateContext: updateIdentityCompartment,
+		DeleteContext: deleteIdentityCompartment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			// @next-break: remove customizations
@@ -91,32 +92,32 @@ func IdentityCompartmentResource() *schema.Resource {
 	}
 }
 
-func createIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func createIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func readIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func updateIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if enableDelete, ok := d.GetOkExists("enable_delete"); !ok || !enableDelete.(bool) {
 		return nil
 	}
@@ -126,7 +127,7 @@ func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityCompartmentResourceCrud struct {
@@ -210,9 +211,10 @@ func (s *IdentityCompartmentResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCompartment(context.Background(), request)
+	response, err := s.Client.CreateCompartment(s.Context(), request)
 	if err != nil {
 		if response.RawResponse != nil && response.RawResponse.StatusCode == 409 {
 
@@ -235,7 +237,9 @@ If you intended to manage an existing compartment, use terraform import instead.
 				s.D.Set("compartment_id", request.CompartmentId)
 				log.Println(fmt.Sprintf("[DEBUG] The specified compartment with name '%s' may already exist, listing compartments to lookup with name instead.",
 					*request.Name))
-				dsCrud := &IdentityCompartmentsDataSourceCrud{s.D, s.Client, nil}
+				dsCrud := &IdentityCompartmentsDataSourceCrud{Client: s.Client}
+				dsCrud.D = s.D
+				dsCrud.SetContext(s.Context())
 				if err := dsCrud.Get(); err != nil {
 					return err
 				}
@@ -269,9 +273,9 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CompartmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -317,9 +321,9 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCompartment(context.Background(), request)
+	response, err := s.Client.UpdateCompartment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -334,9 +338,9 @@ func (s *IdentityCompartmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CompartmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCompartment(context.Background(), request)
+	_, err := s.Client.DeleteCompartment(s.Context(), request)
 	return err
 }
 
@@ -387,14 +391,14 @@ func (s *IdentityCompartmentResourceCrud) updateCompartment(compartment interfac
 		changeCompartmentRequest.TargetCompartmentId = &tmp
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.MoveCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.MoveCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
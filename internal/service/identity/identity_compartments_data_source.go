@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCompartmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCompartments,
+		ReadContext: readIdentityCompartments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -48,16 +49,16 @@ func IdentityCompartmentsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCompartments(d *schema.ResourceData, m interface{}) error {
+func readIdentityCompartments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityCompartmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListCompartmentsResponse
 }
@@ -92,9 +93,9 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.CompartmentLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListCompartments(context.Background(), request)
+	response, err := s.Client.ListCompartments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCompartments(context.Background(), request)
+		listResponse, err := s.Client.ListCompartments(s.Context(), request)
 		if err != nil {
 			return err
 		}
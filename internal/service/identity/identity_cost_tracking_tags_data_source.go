@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityCostTrackingTagsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCostTrackingTags,
+		ReadContext: readIdentityCostTrackingTags,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -113,16 +114,16 @@ func IdentityCostTrackingTagsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCostTrackingTags(d *schema.ResourceData, m interface{}) error {
+func readIdentityCostTrackingTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCostTrackingTagsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityCostTrackingTagsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListCostTrackingTagsResponse
 }
@@ -139,9 +140,9 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListCostTrackingTags(context.Background(), request)
+	response, err := s.Client.ListCostTrackingTags(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -150,7 +151,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCostTrackingTags(context.Background(), request)
+		listResponse, err := s.Client.ListCostTrackingTags(s.Context(), request)
 		if err != nil {
 			return err
 		}
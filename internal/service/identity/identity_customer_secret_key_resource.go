@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentityCustomerSecretKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityCustomerSecretKey,
-		Read:     readIdentityCustomerSecretKey,
-		Update:   updateIdentityCustomerSecretKey,
-		Delete:   deleteIdentityCustomerSecretKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityCustomerSecretKey,
+		ReadContext:   readIdentityCustomerSecretKey,
+		UpdateContext: updateIdentityCustomerSecretKey,
+		DeleteContext: deleteIdentityCustomerSecretKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -70,37 +71,37 @@ func IdentityCustomerSecretKeyResource() *schema.Resource {
 	}
 }
 
-func createIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func createIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func readIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func updateIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityCustomerSecretKeyResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.CreateCustomerSecretKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -182,9 +184,9 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.UpdateCustomerSecretKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -238,9 +240,9 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
+	_, err := s.Client.DeleteCustomerSecretKey(s.Context(), request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCustomerSecretKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCustomerSecretKeys,
+		ReadContext: readIdentityCustomerSecretKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,16 +33,16 @@ func IdentityCustomerSecretKeysDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCustomerSecretKeys(d *schema.ResourceData, m interface{}) error {
+func readIdentityCustomerSecretKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityCustomerSecretKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListCustomerSecretKeysResponse
 }
@@ -58,9 +59,9 @@ func (s *IdentityCustomerSecretKeysDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
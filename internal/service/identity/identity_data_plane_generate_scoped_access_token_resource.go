@@ -5,7 +5,7 @@ package identity
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,10 +19,10 @@ func IdentityDataPlaneGenerateScopedAccessTokenResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDataPlaneGenerateScopedAccessToken,
-		Read:     readIdentityDataPlaneGenerateScopedAccessToken,
-		Delete:   deleteIdentityDataPlaneGenerateScopedAccessToken,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDataPlaneGenerateScopedAccessToken,
+		ReadContext:   readIdentityDataPlaneGenerateScopedAccessToken,
+		DeleteContext: deleteIdentityDataPlaneGenerateScopedAccessToken,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"public_key": {
@@ -47,19 +47,19 @@ func IdentityDataPlaneGenerateScopedAccessTokenResource() *schema.Resource {
 	}
 }
 
-func createIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func createIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDataPlaneGenerateScopedAccessTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataplaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func readIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -87,9 +87,9 @@ func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create() error
 		request.Scope = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_data_plane")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity_data_plane")
 
-	response, err := s.Client.GenerateScopedAccessToken(context.Background(), request)
+	response, err := s.Client.GenerateScopedAccessToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
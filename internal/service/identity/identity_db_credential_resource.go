@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -23,10 +24,10 @@ import (
 
 func IdentityDbCredentialResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDbCredential,
-		Read:     readIdentityDbCredential,
-		Delete:   deleteIdentityDbCredential,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDbCredential,
+		ReadContext:   readIdentityDbCredential,
+		DeleteContext: deleteIdentityDbCredential,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -69,29 +70,29 @@ func IdentityDbCredentialResource() *schema.Resource {
 	}
 }
 
-func createIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func createIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func readIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityDbCredentialResourceCrud struct {
@@ -147,9 +148,10 @@ func (s *IdentityDbCredentialResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDbCredential(context.Background(), request)
+	response, err := s.Client.CreateDbCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -174,9 +176,9 @@ func (s *IdentityDbCredentialResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,9 +213,9 @@ func (s *IdentityDbCredentialResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDbCredential(context.Background(), request)
+	_, err := s.Client.DeleteDbCredential(s.Context(), request)
 	return err
 }
 
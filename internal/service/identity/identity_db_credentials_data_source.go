@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityDbCredentialsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityDbCredentials,
+		ReadContext: readIdentityDbCredentials,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -39,16 +40,16 @@ func IdentityDbCredentialsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityDbCredentials(d *schema.ResourceData, m interface{}) error {
+func readIdentityDbCredentials(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityDbCredentialsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListDbCredentialsResponse
 }
@@ -74,9 +75,9 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbCredentials(context.Background(), request)
+		listResponse, err := s.Client.ListDbCredentials(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -22,16 +23,16 @@ func IdentityDomainDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityDomainResource(), fieldMap, readSingularIdentityDomain)
 }
 
-func readSingularIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityDomainDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetDomainResponse
 }
@@ -48,9 +49,9 @@ func (s *IdentityDomainDataSourceCrud) Get() error {
 		request.DomainId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,11 +25,11 @@ func IdentityDomainResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDomain,
-		Read:     readIdentityDomain,
-		Update:   updateIdentityDomain,
-		Delete:   deleteIdentityDomain,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDomain,
+		ReadContext:   readIdentityDomain,
+		UpdateContext: updateIdentityDomain,
+		DeleteContext: deleteIdentityDomain,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -169,9 +170,10 @@ func IdentityDomainResource() *schema.Resource {
 	}
 }
 
-func createIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func createIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	var powerOff = false
 	if powerState, ok := sync.D.GetOkExists("state"); ok {
@@ -181,30 +183,31 @@ func createIdentityDomain(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if err := tfresource.CreateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.CreateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if powerOff {
 		if err := sync.deActivate(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateInactive)
 	}
 	return nil
 }
 
-func readIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func readIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func updateIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
 	powerOn, powerOff := false, false
@@ -220,18 +223,18 @@ func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
 
 	if powerOn {
 		if err := sync.activate(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if powerOff {
 		if err := sync.deActivate(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateInactive)
 	}
@@ -239,13 +242,13 @@ func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityDomainResourceCrud struct {
@@ -356,9 +359,10 @@ func (s *IdentityDomainResourceCrud) Create() error {
 		request.LicenseType = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDomain(context.Background(), request)
+	response, err := s.Client.CreateDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -371,7 +375,7 @@ func (s *IdentityDomainResourceCrud) getDomainFromWorkRequest(workId *string,
 	actionTypeEnum oci_identity.IamWorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	domainId, err := domainWaitForWorkRequest(workId, "domain",
+	domainId, err := domainWaitForWorkRequest(s.Context(), workId, "domain",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -405,9 +409,9 @@ func domainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_c
 	}
 }
 
-func domainWaitForWorkRequest(wId *string, entityType string, action oci_identity.IamWorkRequestResourceActionTypeEnum,
+func domainWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_identity.IamWorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_identity.IdentityClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "identity")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "identity")
 	retryPolicy.ShouldRetryOperation = domainWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_identity.GetIamWorkRequestResponse{}
@@ -424,7 +428,7 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetIamWorkRequest(context.Background(),
+			response, err = client.GetIamWorkRequest(ctx,
 				oci_identity.GetIamWorkRequestRequest{
 					IamWorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -435,7 +439,7 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -452,15 +456,15 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_identity.IamWorkRequestStatusFailed || response.Status == oci_identity.IamWorkRequestStatusCanceled {
-		return nil, getErrorFromIdentityDomainWorkRequest(client, wId, retryPolicy, response.OperationType)
+		return nil, getErrorFromIdentityDomainWorkRequest(ctx, client, wId, retryPolicy, response.OperationType)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy, operationType oci_identity.IamWorkRequestOperationTypeEnum) error {
+func getErrorFromIdentityDomainWorkRequest(ctx context.Context, client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy, operationType oci_identity.IamWorkRequestOperationTypeEnum) error {
 
-	errorMessage, err := getErrorMessageFromIdentityDomainWorkRequest(client, workId, retryPolicy)
+	errorMessage, err := getErrorMessageFromIdentityDomainWorkRequest(ctx, client, workId, retryPolicy)
 	if err != nil {
 		return err
 	}
@@ -468,9 +472,9 @@ func getErrorFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient,
 	return workRequestErr
 }
 
-func getErrorMessageFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
+func getErrorMessageFromIdentityDomainWorkRequest(ctx context.Context, client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
 	errorMessage := ""
-	response, err := client.ListIamWorkRequestErrors(context.Background(),
+	response, err := client.ListIamWorkRequestErrors(ctx,
 		oci_identity.ListIamWorkRequestErrorsRequest{
 			IamWorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -497,9 +501,9 @@ func (s *IdentityDomainResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DomainId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -561,9 +565,9 @@ func (s *IdentityDomainResourceCrud) Update() error {
 		request.IsHiddenOnLogin = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDomain(context.Background(), request)
+	response, err := s.Client.UpdateDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -578,16 +582,16 @@ func (s *IdentityDomainResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DomainId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeleteDomain(context.Background(), request)
+	response, err := s.Client.DeleteDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := domainWaitForWorkRequest(workId, "domain",
+	_, delWorkRequestErr := domainWaitForWorkRequest(s.Context(), workId, "domain",
 		oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -675,9 +679,9 @@ func (s *IdentityDomainResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DomainId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDomainCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -695,9 +699,9 @@ func (s *IdentityDomainResourceCrud) updateLicenseType(licenseType interface{})
 	idTmp := s.D.Id()
 	changeDomainLicenseTypeRequest.DomainId = &idTmp
 
-	changeDomainLicenseTypeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	changeDomainLicenseTypeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainLicenseType(context.Background(), changeDomainLicenseTypeRequest)
+	response, err := s.Client.ChangeDomainLicenseType(s.Context(), changeDomainLicenseTypeRequest)
 	if err != nil {
 		return err
 	}
@@ -712,9 +716,9 @@ func (s *IdentityDomainResourceCrud) activate() error {
 	idTmp := s.D.Id()
 	activateDomainRequest.DomainId = &idTmp
 
-	activateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	activateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ActivateDomain(context.Background(), activateDomainRequest)
+	response, err := s.Client.ActivateDomain(s.Context(), activateDomainRequest)
 	if err != nil {
 		return err
 	}
@@ -729,9 +733,9 @@ func (s *IdentityDomainResourceCrud) deActivate() error {
 	idTmp := s.D.Id()
 	deactivateDomainRequest.DomainId = &idTmp
 
-	deactivateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	deactivateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeactivateDomain(context.Background(), deactivateDomainRequest)
+	response, err := s.Client.DeactivateDomain(s.Context(), deactivateDomainRequest)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityDomains,
+		ReadContext: readIdentityDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -63,16 +64,16 @@ func IdentityDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityDomains(d *schema.ResourceData, m interface{}) error {
+func readIdentityDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityDomainsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListDomainsResponse
 }
@@ -128,9 +129,9 @@ func (s *IdentityDomainsDataSourceCrud) Get() error {
 		request.Url = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListDomains(context.Background(), request)
+	response, err := s.Client.ListDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -139,7 +140,7 @@ func (s *IdentityDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDomains(context.Background(), request)
+		listResponse, err := s.Client.ListDomains(s.Context(), request)
 		if err != nil {
 			return err
 		}
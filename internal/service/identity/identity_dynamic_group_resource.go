@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func IdentityDynamicGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDynamicGroup,
-		Read:     readIdentityDynamicGroup,
-		Update:   updateIdentityDynamicGroup,
-		Delete:   deleteIdentityDynamicGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDynamicGroup,
+		ReadContext:   readIdentityDynamicGroup,
+		UpdateContext: updateIdentityDynamicGroup,
+		DeleteContext: deleteIdentityDynamicGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func IdentityDynamicGroupResource() *schema.Resource {
 	}
 }
 
-func createIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func createIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func readIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func updateIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityDynamicGroupResourceCrud struct {
@@ -181,9 +182,10 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDynamicGroup(context.Background(), request)
+	response, err := s.Client.CreateDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -198,9 +200,9 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DynamicGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDynamicGroup(context.Background(), request)
+	response, err := s.Client.GetDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,9 +239,9 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 		request.MatchingRule = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
+	response, err := s.Client.UpdateDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -254,9 +256,9 @@ func (s *IdentityDynamicGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DynamicGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
+	_, err := s.Client.DeleteDynamicGroup(s.Context(), request)
 	return err
 }
 
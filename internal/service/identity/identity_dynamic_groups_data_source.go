@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityDynamicGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityDynamicGroups,
+		ReadContext: readIdentityDynamicGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func IdentityDynamicGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityDynamicGroups(d *schema.ResourceData, m interface{}) error {
+func readIdentityDynamicGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityDynamicGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListDynamicGroupsResponse
 }
@@ -75,9 +76,9 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.DynamicGroupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListDynamicGroups(context.Background(), request)
+	response, err := s.Client.ListDynamicGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDynamicGroups(context.Background(), request)
+		listResponse, err := s.Client.ListDynamicGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityFaultDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityFaultDomains,
+		ReadContext: readIdentityFaultDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -59,16 +60,16 @@ func IdentityFaultDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityFaultDomains(d *schema.ResourceData, m interface{}) error {
+func readIdentityFaultDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityFaultDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityFaultDomainsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListFaultDomainsResponse
 }
@@ -90,9 +91,9 @@ func (s *IdentityFaultDomainsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListFaultDomains(context.Background(), request)
+	response, err := s.Client.ListFaultDomains(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -23,16 +24,16 @@ func IdentityGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityGroupResource(), fieldMap, readSingularIdentityGroup)
 }
 
-func readSingularIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityGroupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetGroupResponse
 }
@@ -49,9 +50,9 @@ func (s *IdentityGroupDataSourceCrud) Get() error {
 		request.GroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
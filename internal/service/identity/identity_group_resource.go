@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityGroup,
-		Read:     readIdentityGroup,
-		Update:   updateIdentityGroup,
-		Delete:   deleteIdentityGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityGroup,
+		ReadContext:   readIdentityGroup,
+		UpdateContext: updateIdentityGroup,
+		DeleteContext: deleteIdentityGroup,
 		Schema: map[string]*schema.Schema{
 			// The legacy provider exposed this as read-only/computed. The API requires this param. For legacy users who are
 			// not supplying a value, make it optional, behind the scenes it will use the tenancy ocid if not supplied.
@@ -82,38 +83,38 @@ func IdentityGroupResource() *schema.Resource {
 	}
 }
 
-func createIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func createIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func readIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func updateIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityGroupResourceCrud struct {
@@ -197,9 +198,10 @@ func (s *IdentityGroupResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateGroup(context.Background(), request)
+	response, err := s.Client.CreateGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -214,9 +216,9 @@ func (s *IdentityGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -248,9 +250,9 @@ func (s *IdentityGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateGroup(context.Background(), request)
+	response, err := s.Client.UpdateGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -265,9 +267,9 @@ func (s *IdentityGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteGroup(context.Background(), request)
+	_, err := s.Client.DeleteGroup(s.Context(), request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityGroups,
+		ReadContext: readIdentityGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func IdentityGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityGroups(d *schema.ResourceData, m interface{}) error {
+func readIdentityGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListGroupsResponse
 }
@@ -75,9 +76,9 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.GroupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListGroups(context.Background(), request)
+	response, err := s.Client.ListGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGroups(context.Background(), request)
+		listResponse, err := s.Client.ListGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityIamWorkRequest,
+		ReadContext: readSingularIdentityIamWorkRequest,
 		Schema: map[string]*schema.Schema{
 			"iam_work_request_id": {
 				Type:     schema.TypeString,
@@ -83,16 +84,16 @@ func IdentityIamWorkRequestDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityIamWorkRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityIamWorkRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIamWorkRequestDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetIamWorkRequestResponse
 }
@@ -109,9 +110,9 @@ func (s *IdentityIamWorkRequestDataSourceCrud) Get() error {
 		request.IamWorkRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetIamWorkRequest(context.Background(), request)
+	response, err := s.Client.GetIamWorkRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestErrorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequestErrors,
+		ReadContext: readIdentityIamWorkRequestErrors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"iam_work_request_id": {
@@ -51,16 +52,16 @@ func IdentityIamWorkRequestErrorsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequestErrors(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequestErrors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestErrorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIamWorkRequestErrorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIamWorkRequestErrorsResponse
 }
@@ -77,9 +78,9 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 		request.IamWorkRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestErrors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestErrors(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequestLogs,
+		ReadContext: readIdentityIamWorkRequestLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"iam_work_request_id": {
@@ -47,16 +48,16 @@ func IdentityIamWorkRequestLogsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequestLogs(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequestLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIamWorkRequestLogsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIamWorkRequestLogsResponse
 }
@@ -73,9 +74,9 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 		request.IamWorkRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestLogs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestLogs(s.Context(), request)
 		if err != nil {
 			return err
 		}
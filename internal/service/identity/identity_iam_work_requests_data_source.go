@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequests,
+		ReadContext: readIdentityIamWorkRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -104,16 +105,16 @@ func IdentityIamWorkRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequests(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIamWorkRequestsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIamWorkRequestsResponse
 }
@@ -135,9 +136,9 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 		request.ResourceIdentifier = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIamWorkRequests(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequests(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +147,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequests(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIdentityProviderGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdentityProviderGroups,
+		ReadContext: readIdentityIdentityProviderGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"identity_provider_id": {
@@ -75,16 +76,16 @@ func IdentityIdentityProviderGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdentityProviderGroups(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProviderGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIdentityProviderGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIdentityProviderGroupsResponse
 }
@@ -110,9 +111,9 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.IdentityProviderLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+	response, err := s.Client.ListIdentityProviderGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviderGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
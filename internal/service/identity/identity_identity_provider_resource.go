@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func IdentityIdentityProviderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityIdentityProvider,
-		Read:     readIdentityIdentityProvider,
-		Update:   updateIdentityIdentityProvider,
-		Delete:   deleteIdentityIdentityProvider,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityIdentityProvider,
+		ReadContext:   readIdentityIdentityProvider,
+		UpdateContext: updateIdentityIdentityProvider,
+		DeleteContext: deleteIdentityIdentityProvider,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -113,37 +114,37 @@ func IdentityIdentityProviderResource() *schema.Resource {
 	}
 }
 
-func createIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func createIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func updateIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityIdentityProviderResourceCrud struct {
@@ -189,9 +190,10 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdentityProvider(context.Background(), request)
+	response, err := s.Client.CreateIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,9 +208,9 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IdentityProviderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdentityProvider(context.Background(), request)
+	response, err := s.Client.GetIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,9 +226,9 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
+	response, err := s.Client.UpdateIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +243,9 @@ func (s *IdentityIdentityProviderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IdentityProviderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
+	_, err := s.Client.DeleteIdentityProvider(s.Context(), request)
 	return err
 }
 
@@ -8,6 +8,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -17,7 +18,7 @@ import (
 
 func IdentityIdentityProvidersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdentityProviders,
+		ReadContext: readIdentityIdentityProviders,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -45,16 +46,16 @@ func IdentityIdentityProvidersDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdentityProviders(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProviders(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProvidersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIdentityProvidersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIdentityProvidersResponse
 }
@@ -84,9 +85,9 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.IdentityProviderLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIdentityProviders(context.Background(), request)
+	response, err := s.Client.ListIdentityProviders(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviders(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviders(s.Context(), request)
 		if err != nil {
 			return err
 		}
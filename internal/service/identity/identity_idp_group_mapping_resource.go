@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -25,11 +26,11 @@ func IdentityIdpGroupMappingResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityIdpGroupMapping,
-		Read:     readIdentityIdpGroupMapping,
-		Update:   updateIdentityIdpGroupMapping,
-		Delete:   deleteIdentityIdpGroupMapping,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityIdpGroupMapping,
+		ReadContext:   readIdentityIdpGroupMapping,
+		UpdateContext: updateIdentityIdpGroupMapping,
+		DeleteContext: deleteIdentityIdpGroupMapping,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"group_id": {
@@ -69,37 +70,37 @@ func IdentityIdpGroupMappingResource() *schema.Resource {
 	}
 }
 
-func createIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func createIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func updateIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityIdpGroupMappingResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 		request.IdpGroupName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.CreateIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,9 +187,9 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.GetIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -217,9 +219,9 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.UpdateIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,9 +241,9 @@ func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
+	_, err := s.Client.DeleteIdpGroupMapping(s.Context(), request)
 	return err
 }
 
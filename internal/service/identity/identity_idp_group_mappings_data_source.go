@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityIdpGroupMappingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdpGroupMappings,
+		ReadContext: readIdentityIdpGroupMappings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"identity_provider_id": {
@@ -32,16 +33,16 @@ func IdentityIdpGroupMappingsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdpGroupMappings(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdpGroupMappings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityIdpGroupMappingsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListIdpGroupMappingsResponse
 }
@@ -58,9 +59,9 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 		request.IdentityProviderId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+	response, err := s.Client.ListIdpGroupMappings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -69,7 +70,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+		listResponse, err := s.Client.ListIdpGroupMappings(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -23,16 +24,16 @@ func IdentityNetworkSourceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityNetworkSourceResource(), fieldMap, readSingularIdentityNetworkSource)
 }
 
-func readSingularIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityNetworkSourceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetNetworkSourceResponse
 }
@@ -49,9 +50,9 @@ func (s *IdentityNetworkSourceDataSourceCrud) Get() error {
 		request.NetworkSourceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetNetworkSource(context.Background(), request)
+	response, err := s.Client.GetNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func IdentityNetworkSourceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityNetworkSource,
-		Read:     readIdentityNetworkSource,
-		Update:   updateIdentityNetworkSource,
-		Delete:   deleteIdentityNetworkSource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityNetworkSource,
+		ReadContext:   readIdentityNetworkSource,
+		UpdateContext: updateIdentityNetworkSource,
+		DeleteContext: deleteIdentityNetworkSource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -113,37 +114,37 @@ func IdentityNetworkSourceResource() *schema.Resource {
 	}
 }
 
-func createIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func createIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func readIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func updateIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityNetworkSourceResourceCrud struct {
@@ -254,9 +255,10 @@ func (s *IdentityNetworkSourceResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateNetworkSource(context.Background(), request)
+	response, err := s.Client.CreateNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -271,9 +273,9 @@ func (s *IdentityNetworkSourceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NetworkSourceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetNetworkSource(context.Background(), request)
+	response, err := s.Client.GetNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,9 +350,9 @@ func (s *IdentityNetworkSourceResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateNetworkSource(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -365,9 +367,9 @@ func (s *IdentityNetworkSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkSourceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteNetworkSource(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSource(s.Context(), request)
 	return err
 }
 
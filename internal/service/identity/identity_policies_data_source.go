@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityPolicies,
+		ReadContext: readIdentityPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func IdentityPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityPolicies(d *schema.ResourceData, m interface{}) error {
+func readIdentityPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListPoliciesResponse
 }
@@ -75,9 +76,9 @@ func (s *IdentityPoliciesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_identity.PolicyLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *IdentityPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListPolicies(s.Context(), request)
 		if err != nil {
 			return err
 		}
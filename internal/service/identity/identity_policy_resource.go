@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityPolicy,
-		Read:     readIdentityPolicy,
-		Update:   updateIdentityPolicy,
-		Delete:   deleteIdentityPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityPolicy,
+		ReadContext:   readIdentityPolicy,
+		UpdateContext: updateIdentityPolicy,
+		DeleteContext: deleteIdentityPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			// The legacy provider required this and the API requires. Do not make it optional or swap tenancy OCID in behind the scenes
@@ -114,37 +115,37 @@ func IdentityPolicyResource() *schema.Resource {
 	}
 }
 
-func createIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func createIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func readIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func updateIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityPolicyResourceCrud struct {
@@ -235,9 +236,10 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 		request.VersionDate = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreatePolicy(context.Background(), request)
+	response, err := s.Client.CreatePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,9 +261,9 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetPolicy(context.Background(), request)
+	response, err := s.Client.GetPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -319,9 +321,9 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 		request.VersionDate = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdatePolicy(context.Background(), request)
+	response, err := s.Client.UpdatePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -343,9 +345,9 @@ func (s *IdentityPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeletePolicy(context.Background(), request)
+	_, err := s.Client.DeletePolicy(s.Context(), request)
 	return err
 }
 
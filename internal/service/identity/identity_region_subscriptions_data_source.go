@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityRegionSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityRegionSubscriptions,
+		ReadContext: readIdentityRegionSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"tenancy_id": {
@@ -59,16 +60,16 @@ func IdentityRegionSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityRegionSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readIdentityRegionSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityRegionSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityRegionSubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListRegionSubscriptionsResponse
 }
@@ -85,9 +86,9 @@ func (s *IdentityRegionSubscriptionsDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListRegionSubscriptions(context.Background(), request)
+	response, err := s.Client.ListRegionSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
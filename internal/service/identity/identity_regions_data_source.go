@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityRegionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityRegions,
+		ReadContext: readIdentityRegions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"regions": {
@@ -43,16 +44,16 @@ func IdentityRegionsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityRegions(d *schema.ResourceData, m interface{}) error {
+func readIdentityRegions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityRegionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityRegionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListRegionsResponse
 }
@@ -64,9 +65,9 @@ func (s *IdentityRegionsDataSourceCrud) VoidState() {
 func (s *IdentityRegionsDataSourceCrud) Get() error {
 	request := oci_identity.ListRegionsRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListRegions(context.Background())
+	response, err := s.Client.ListRegions(s.Context())
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentitySmtpCredentialResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentitySmtpCredential,
-		Read:     readIdentitySmtpCredential,
-		Update:   updateIdentitySmtpCredential,
-		Delete:   deleteIdentitySmtpCredential,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentitySmtpCredential,
+		ReadContext:   readIdentitySmtpCredential,
+		UpdateContext: updateIdentitySmtpCredential,
+		DeleteContext: deleteIdentitySmtpCredential,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -74,37 +75,37 @@ func IdentitySmtpCredentialResource() *schema.Resource {
 	}
 }
 
-func createIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func createIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func readIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func updateIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func deleteIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentitySmtpCredentialResourceCrud struct {
@@ -159,9 +160,10 @@ func (s *IdentitySmtpCredentialResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSmtpCredential(context.Background(), request)
+	response, err := s.Client.CreateSmtpCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,9 +199,9 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,9 +233,9 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSmtpCredential(context.Background(), request)
+	response, err := s.Client.UpdateSmtpCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -253,9 +255,9 @@ func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
+	_, err := s.Client.DeleteSmtpCredential(s.Context(), request)
 	return err
 }
 
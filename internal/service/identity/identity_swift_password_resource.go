@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentitySwiftPasswordResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentitySwiftPassword,
-		Read:     readIdentitySwiftPassword,
-		Update:   updateIdentitySwiftPassword,
-		Delete:   deleteIdentitySwiftPassword,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentitySwiftPassword,
+		ReadContext:   readIdentitySwiftPassword,
+		UpdateContext: updateIdentitySwiftPassword,
+		DeleteContext: deleteIdentitySwiftPassword,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -70,37 +71,37 @@ func IdentitySwiftPasswordResource() *schema.Resource {
 	}
 }
 
-func createIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func createIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func readIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func updateIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func deleteIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentitySwiftPasswordResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSwiftPassword(context.Background(), request)
+	response, err := s.Client.CreateSwiftPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -182,9 +184,9 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSwiftPassword(context.Background(), request)
+	response, err := s.Client.UpdateSwiftPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -238,9 +240,9 @@ func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSwiftPassword(context.Background(), request)
+	_, err := s.Client.DeleteSwiftPassword(s.Context(), request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentitySwiftPasswordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentitySwiftPasswords,
+		ReadContext: readIdentitySwiftPasswords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,16 +33,16 @@ func IdentitySwiftPasswordsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentitySwiftPasswords(d *schema.ResourceData, m interface{}) error {
+func readIdentitySwiftPasswords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentitySwiftPasswordsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListSwiftPasswordsResponse
 }
@@ -58,9 +59,9 @@ func (s *IdentitySwiftPasswordsDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -22,16 +23,16 @@ func IdentityTagDefaultDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityTagDefaultResource(), fieldMap, readSingularIdentityTagDefault)
 }
 
-func readSingularIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTagDefaultDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetTagDefaultResponse
 }
@@ -48,9 +49,9 @@ func (s *IdentityTagDefaultDataSourceCrud) Get() error {
 		request.TagDefaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func IdentityTagDefaultResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityTagDefault,
-		Read:     readIdentityTagDefault,
-		Update:   updateIdentityTagDefault,
-		Delete:   deleteIdentityTagDefault,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityTagDefault,
+		ReadContext:   readIdentityTagDefault,
+		UpdateContext: updateIdentityTagDefault,
+		DeleteContext: deleteIdentityTagDefault,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -70,37 +71,37 @@ func IdentityTagDefaultResource() *schema.Resource {
 	}
 }
 
-func createIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func createIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func updateIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityTagDefaultResourceCrud struct {
@@ -155,9 +156,10 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 		request.Value = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateTagDefault(context.Background(), request)
+	response, err := s.Client.CreateTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +171,9 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 		tmp := isRequired.(bool)
 
 		if tmp {
-			time.Sleep(20 * time.Second)
+			if err := tfresource.SleepContext(s.Context(), 20*time.Second); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -182,9 +186,9 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.TagDefaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,9 +213,9 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 		request.Value = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagDefault(context.Background(), request)
+	response, err := s.Client.UpdateTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +226,9 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 		tmp := isRequired.(bool)
 
 		if tmp {
-			time.Sleep(20 * time.Second)
+			if err := tfresource.SleepContext(s.Context(), 20*time.Second); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -234,9 +240,9 @@ func (s *IdentityTagDefaultResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TagDefaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagDefault(context.Background(), request)
+	_, err := s.Client.DeleteTagDefault(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTagDefaultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTagDefaults,
+		ReadContext: readIdentityTagDefaults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func IdentityTagDefaultsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTagDefaults(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagDefaults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTagDefaultsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListTagDefaultsResponse
 }
@@ -83,9 +84,9 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 		request.TagDefinitionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListTagDefaults(context.Background(), request)
+	response, err := s.Client.ListTagDefaults(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTagDefaults(context.Background(), request)
+		listResponse, err := s.Client.ListTagDefaults(s.Context(), request)
 		if err != nil {
 			return err
 		}
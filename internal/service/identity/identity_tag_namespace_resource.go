@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -25,11 +26,11 @@ func IdentityTagNamespaceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityTagNamespace,
-		Read:     readIdentityTagNamespace,
-		Update:   updateIdentityTagNamespace,
-		Delete:   deleteIdentityTagNamespace,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityTagNamespace,
+		ReadContext:   readIdentityTagNamespace,
+		UpdateContext: updateIdentityTagNamespace,
+		DeleteContext: deleteIdentityTagNamespace,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -80,31 +81,31 @@ func IdentityTagNamespaceResource() *schema.Resource {
 	}
 }
 
-func createIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func createIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func updateIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Only empty tag namespaces can be deleted, to execute our tests we don't want to delete namespaces as we Create
 	// namespaces with tags and deleting a tag is a sequential and time consuming operation allowed one per tenancy
 	importIfExists, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("tags_import_if_exists", "false"))
@@ -117,7 +118,7 @@ func deleteIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityTagNamespaceResourceCrud struct {
@@ -183,9 +184,10 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := s.Context()
 	response, err := s.Client.CreateTagNamespace(contextToUse, request)
 	if err == nil {
 		s.Res = &response.TagNamespace
@@ -205,7 +207,9 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		// List all namespaces using the datasource to find that namespace with the matching name.
 		s.D.Set("compartment_id", request.CompartmentId)
 		s.D.Set("name", request.Name)
-		dsCrud := &IdentityTagNamespacesDataSourceCrud{s.D, s.Client, nil}
+		dsCrud := &IdentityTagNamespacesDataSourceCrud{Client: s.Client}
+		dsCrud.D = s.D
+		dsCrud.SetContext(s.Context())
 		if dsErr := dsCrud.Get(); dsErr != nil {
 			//return original error when datasource call fails
 			return err
@@ -234,9 +238,9 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagNamespace(context.Background(), request)
+	response, err := s.Client.GetTagNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -282,9 +286,9 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagNamespace(context.Background(), request)
+	response, err := s.Client.UpdateTagNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -299,9 +303,9 @@ func (s *IdentityTagNamespaceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagNamespace(context.Background(), request)
+	_, err := s.Client.DeleteTagNamespace(s.Context(), request)
 	return err
 }
 
@@ -346,14 +350,14 @@ func (s *IdentityTagNamespaceResourceCrud) updateCompartment(compartment interfa
 	idTmp := s.D.Id()
 	changeCompartmentRequest.TagNamespaceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.ChangeTagNamespaceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTagNamespaceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
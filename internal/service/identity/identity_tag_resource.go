@@ -14,6 +14,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -36,10 +37,10 @@ func IdentityTagResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("15m"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createIdentityTag,
-		Read:   readIdentityTag,
-		Update: updateIdentityTag,
-		Delete: deleteIdentityTag,
+		CreateContext: createIdentityTag,
+		ReadContext:   readIdentityTag,
+		UpdateContext: updateIdentityTag,
+		DeleteContext: deleteIdentityTag,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -125,31 +126,31 @@ func IdentityTagResource() *schema.Resource {
 	}
 }
 
-func createIdentityTag(d *schema.ResourceData, m interface{}) error {
+func createIdentityTag(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityTag(d *schema.ResourceData, m interface{}) error {
+func readIdentityTag(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityTag(d *schema.ResourceData, m interface{}) error {
+func updateIdentityTag(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityTag(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityTag(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// prevent tag deletion when testing, as its a time consuming and sequential operation permitted one per tenancy.
 	importIfExists, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("tags_import_if_exists", "false"))
 	if importIfExists {
@@ -161,7 +162,7 @@ func deleteIdentityTag(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityTagResourceCrud struct {
@@ -243,9 +244,10 @@ func (s *IdentityTagResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := s.Context()
 	response, err := s.Client.CreateTag(contextToUse, request)
 	if err == nil {
 		s.Res = &response.Tag
@@ -266,7 +268,9 @@ func (s *IdentityTagResourceCrud) Create() error {
 		// List all tag definitions using the datasource to find that tag definition which matches
 		s.D.Set("tag_namespace_id", request.TagNamespaceId)
 		s.D.Set("name", request.Name)
-		dsCrud := &IdentityTagsDataSourceCrud{s.D, s.Client, nil}
+		dsCrud := &IdentityTagsDataSourceCrud{Client: s.Client}
+		dsCrud.D = s.D
+		dsCrud.SetContext(s.Context())
 		if dsErr := dsCrud.Get(); dsErr != nil {
 			//return original error when datasource call fails
 			return err
@@ -309,9 +313,9 @@ func (s *IdentityTagResourceCrud) Get() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTag(context.Background(), request)
+	response, err := s.Client.GetTag(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -395,9 +399,9 @@ func (s *IdentityTagResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTag(context.Background(), request)
+	response, err := s.Client.UpdateTag(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -430,8 +434,8 @@ func (s *IdentityTagResourceCrud) Delete() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
-	response, err := s.Client.DeleteTag(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
+	response, err := s.Client.DeleteTag(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -439,14 +443,14 @@ func (s *IdentityTagResourceCrud) Delete() error {
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := IdentityTaggingWaitForWorkRequest(workId, "identity",
+	_, delWorkRequestErr := IdentityTaggingWaitForWorkRequest(s.Context(), workId, "identity",
 		oci_identity.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
-func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum,
+func IdentityTaggingWaitForWorkRequest(ctx context.Context, workRequestId *string, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_identity.IdentityClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "identity")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "identity")
 	retryPolicy.ShouldRetryOperation = identityTagWorkRequestShouldRetryFunc(timeout)
 	response := oci_identity.GetTaggingWorkRequestResponse{}
 	stateConf := &resource.StateChangeConf{
@@ -462,7 +466,7 @@ func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetTaggingWorkRequest(context.Background(), oci_identity.GetTaggingWorkRequestRequest{
+			response, err = client.GetTaggingWorkRequest(ctx, oci_identity.GetTaggingWorkRequestRequest{
 				WorkRequestId: workRequestId,
 				RequestMetadata: oci_common.RequestMetadata{
 					RetryPolicy: retryPolicy,
@@ -479,7 +483,7 @@ func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string,
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -494,13 +498,13 @@ func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string,
 	}
 
 	if response.Status == oci_identity.TaggingWorkRequestStatusFailed || response.Status == oci_identity.TaggingWorkRequestStatusCanceled {
-		return nil, getIdentityTaggingWorkRequestErrors(client, workRequestId, retryPolicy, entityType, action)
+		return nil, getIdentityTaggingWorkRequestErrors(ctx, client, workRequestId, retryPolicy, entityType, action)
 	}
 	return identifier, nil
 }
 
-func getIdentityTaggingWorkRequestErrors(client *oci_identity.IdentityClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListTaggingWorkRequestErrors(context.Background(), oci_identity.ListTaggingWorkRequestErrorsRequest{
+func getIdentityTaggingWorkRequestErrors(ctx context.Context, client *oci_identity.IdentityClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListTaggingWorkRequestErrors(ctx, oci_identity.ListTaggingWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
@@ -9,13 +9,14 @@ import (
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 )
 
 func IdentityTagStandardTagNamespaceTemplateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityTagStandardTagNamespaceTemplate,
+		ReadContext: readSingularIdentityTagStandardTagNamespaceTemplate,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -78,16 +79,16 @@ func IdentityTagStandardTagNamespaceTemplateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityTagStandardTagNamespaceTemplate(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTagStandardTagNamespaceTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagStandardTagNamespaceTemplateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTagStandardTagNamespaceTemplateDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetStandardTagTemplateResponse
 }
@@ -109,9 +110,9 @@ func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) Get() error {
 		request.StandardTagNamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetStandardTagTemplate(context.Background(), request)
+	response, err := s.Client.GetStandardTagTemplate(s.Context(), request)
 	if err != nil {
 		return err
 	}
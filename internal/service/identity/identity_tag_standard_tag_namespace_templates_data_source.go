@@ -9,13 +9,14 @@ import (
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 )
 
 func IdentityTagStandardTagNamespaceTemplatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTagStandardTagNamespaceTemplates,
+		ReadContext: readIdentityTagStandardTagNamespaceTemplates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,16 +52,16 @@ func IdentityTagStandardTagNamespaceTemplatesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTagStandardTagNamespaceTemplates(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagStandardTagNamespaceTemplates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagStandardTagNamespaceTemplatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTagStandardTagNamespaceTemplatesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListStandardTagNamespacesResponse
 }
@@ -77,9 +78,9 @@ func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListStandardTagNamespaces(context.Background(), request)
+	response, err := s.Client.ListStandardTagNamespaces(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListStandardTagNamespaces(context.Background(), request)
+		listResponse, err := s.Client.ListStandardTagNamespaces(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTagsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTags,
+		ReadContext: readIdentityTags,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"state": {
@@ -35,16 +36,16 @@ func IdentityTagsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTags(d *schema.ResourceData, m interface{}) error {
+func readIdentityTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTagsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListTagsResponse
 }
@@ -65,9 +66,9 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListTags(context.Background(), request)
+	response, err := s.Client.ListTags(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -76,7 +77,7 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTags(context.Background(), request)
+		listResponse, err := s.Client.ListTags(s.Context(), request)
 		if err != nil {
 			return err
 		}
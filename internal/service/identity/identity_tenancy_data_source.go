@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTenancyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityTenancy,
+		ReadContext: readSingularIdentityTenancy,
 		Schema: map[string]*schema.Schema{
 			"tenancy_id": {
 				Type:     schema.TypeString,
@@ -52,16 +53,16 @@ func IdentityTenancyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityTenancy(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTenancy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTenancyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityTenancyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetTenancyResponse
 }
@@ -78,9 +79,9 @@ func (s *IdentityTenancyDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetTenancy(context.Background(), request)
+	response, err := s.Client.GetTenancy(s.Context(), request)
 	if err != nil {
 		return err
 	}
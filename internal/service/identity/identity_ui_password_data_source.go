@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -22,16 +23,16 @@ func IdentityUiPasswordDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityUiPasswordResource(), fieldMap, readSingularIdentityUiPassword)
 }
 
-func readSingularIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUiPasswordDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityUiPasswordDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.GetUserUIPasswordInformationResponse
 }
@@ -48,9 +49,9 @@ func (s *IdentityUiPasswordDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.GetUserUIPasswordInformation(context.Background(), request)
+	response, err := s.Client.GetUserUIPasswordInformation(s.Context(), request)
 	if err != nil {
 		return err
 	}
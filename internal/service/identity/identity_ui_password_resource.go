@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -17,10 +18,10 @@ import (
 
 func IdentityUiPasswordResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUiPassword,
-		Read:     readIdentityUiPassword,
-		Delete:   deleteIdentityUiPassword,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUiPassword,
+		ReadContext:   readIdentityUiPassword,
+		DeleteContext: deleteIdentityUiPassword,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"user_id": {
@@ -52,19 +53,19 @@ func IdentityUiPasswordResource() *schema.Resource {
 	}
 }
 
-func createIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func createIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUiPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func readIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -111,9 +112,10 @@ func (s *IdentityUiPasswordResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateOrResetUIPassword(context.Background(), request)
+	response, err := s.Client.CreateOrResetUIPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
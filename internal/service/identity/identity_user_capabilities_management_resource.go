@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityUserCapabilitiesManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createUserCapabilitiesManagement,
-		Read:     readUserCapabilitiesManagement,
-		Update:   updateUserCapabilitiesManagement,
-		Delete:   deleteUserCapabilitiesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createUserCapabilitiesManagement,
+		ReadContext:   readUserCapabilitiesManagement,
+		UpdateContext: updateUserCapabilitiesManagement,
+		DeleteContext: deleteUserCapabilitiesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"user_id": {
@@ -68,36 +69,36 @@ func IdentityUserCapabilitiesManagementResource() *schema.Resource {
 	}
 }
 
-func createUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func createUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func readUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func updateUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type UserCapabilitiesManagementResourceCrud struct {
@@ -161,7 +162,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 		userCapabilityRequest.CanUseSmtpCredentials = &canUseSmtpCredentials
 	}
 
-	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(context.Background(), userCapabilityRequest)
+	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(s.Context(), userCapabilityRequest)
 	if err != nil {
 		return err
 	}
@@ -180,9 +181,9 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s with err %v", s.D.Id(), err)
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,10 +21,10 @@ func IdentityUserGroupMembershipResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUserGroupMembership,
-		Read:     readIdentityUserGroupMembership,
-		Delete:   deleteIdentityUserGroupMembership,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUserGroupMembership,
+		ReadContext:   readIdentityUserGroupMembership,
+		DeleteContext: deleteIdentityUserGroupMembership,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"group_id": {
@@ -63,29 +64,29 @@ func IdentityUserGroupMembershipResource() *schema.Resource {
 	}
 }
 
-func createIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func createIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func readIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityUserGroupMembershipResourceCrud struct {
@@ -136,9 +137,10 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.AddUserToGroup(context.Background(), request)
+	response, err := s.Client.AddUserToGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -153,9 +155,9 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.UserGroupMembershipId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUserGroupMembership(context.Background(), request)
+	response, err := s.Client.GetUserGroupMembership(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,9 +172,9 @@ func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserGroupMembershipId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.RemoveUserFromGroup(context.Background(), request)
+	_, err := s.Client.RemoveUserFromGroup(s.Context(), request)
 	return err
 }
 
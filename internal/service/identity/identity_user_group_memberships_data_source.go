@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v61/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityUserGroupMembershipsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityUserGroupMemberships,
+		ReadContext: readIdentityUserGroupMemberships,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func IdentityUserGroupMembershipsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityUserGroupMemberships(d *schema.ResourceData, m interface{}) error {
+func readIdentityUserGroupMemberships(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IdentityUserGroupMembershipsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_identity.IdentityClient
 	Res    *oci_identity.ListUserGroupMembershipsResponse
 }
@@ -76,9 +77,9 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "identity")
 
-	response, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+	response, err := s.Client.ListUserGroupMemberships(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+		listResponse, err := s.Client.ListUserGroupMemberships(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUser,
-		Read:     readIdentityUser,
-		Update:   updateIdentityUser,
-		Delete:   deleteIdentityUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUser,
+		ReadContext:   readIdentityUser,
+		UpdateContext: updateIdentityUser,
+		DeleteContext: deleteIdentityUser,
 		Schema: map[string]*schema.Schema{
 			// The legacy provider exposed this as read-only/computed. The API requires this param. For legacy users who are
 			// not supplying a value, make it optional, behind the scenes it will use the tenancy ocid if not supplied.
@@ -152,38 +153,38 @@ func IdentityUserResource() *schema.Resource {
 	}
 }
 
-func createIdentityUser(d *schema.ResourceData, m interface{}) error {
+func createIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readIdentityUser(d *schema.ResourceData, m interface{}) error {
+func readIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIdentityUser(d *schema.ResourceData, m interface{}) error {
+func updateIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteIdentityUser(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IdentityUserResourceCrud struct {
@@ -274,9 +275,10 @@ func (s *IdentityUserResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateUser(context.Background(), request)
+	response, err := s.Client.CreateUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -291,9 +293,9 @@ func (s *IdentityUserResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -330,9 +332,9 @@ func (s *IdentityUserResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateUser(context.Background(), request)
+	response, err := s.Client.UpdateUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -347,9 +349,9 @@ func (s *IdentityUserResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteUser(context.Background(), request)
+	_, err := s.Client.DeleteUser(s.Context(), request)
 	return err
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_integration "github.com/oracle/oci-go-sdk/v61/integration"
 )
@@ -22,16 +23,16 @@ func IntegrationIntegrationInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IntegrationIntegrationInstanceResource(), fieldMap, readSingularIntegrationIntegrationInstance)
 }
 
-func readSingularIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IntegrationIntegrationInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_integration.IntegrationInstanceClient
 	Res    *oci_integration.GetIntegrationInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *IntegrationIntegrationInstanceDataSourceCrud) Get() error {
 		request.IntegrationInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -34,10 +35,10 @@ func IntegrationIntegrationInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createIntegrationIntegrationInstance,
-		Read:   readIntegrationIntegrationInstance,
-		Update: updateIntegrationIntegrationInstance,
-		Delete: deleteIntegrationIntegrationInstance,
+		CreateContext: createIntegrationIntegrationInstance,
+		ReadContext:   readIntegrationIntegrationInstance,
+		UpdateContext: updateIntegrationIntegrationInstance,
+		DeleteContext: deleteIntegrationIntegrationInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -259,7 +260,7 @@ func IntegrationIntegrationInstanceResource() *schema.Resource {
 	}
 }
 
-func createIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func createIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
@@ -272,35 +273,36 @@ func createIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	if error := tfresource.CreateResource(d, sync); error != nil {
-		return error
+	if error := tfresource.CreateResourceContext(ctx, d, sync); error != nil {
+		return tfresource.ToDiagnostics(sync, error)
 	}
 
 	if powerOff {
-		return powerOffIntegrationInstance(d, sync)
+		return tfresource.ToDiagnostics(sync, powerOffIntegrationInstance(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func powerOffIntegrationInstance(d *schema.ResourceData, sync *IntegrationIntegrationInstanceResourceCrud) error {
+func powerOffIntegrationInstance(ctx context.Context, d *schema.ResourceData, sync *IntegrationIntegrationInstanceResourceCrud) error {
 	if err := sync.StopIntegerationInstance(); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResourceContext(ctx, sync)
 }
 
-func readIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func readIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func updateIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
 	// Start/Stop Integration instance
@@ -313,42 +315,42 @@ func updateIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 		} else if oci_integration.IntegrationInstanceLifecycleStateInactive == oci_integration.IntegrationInstanceLifecycleStateEnum(wantedState) {
 			powerOff = true
 		} else {
-			return fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState)
+			return tfresource.ToDiagnostics(sync, fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState))
 		}
 	}
 
 	if powerOn {
 		if err := sync.StartIntegerationInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateActive); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if powerOff {
 		if err := sync.StopIntegerationInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateInactive); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	return nil
 }
 
-func deleteIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func deleteIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type IntegrationIntegrationInstanceResourceCrud struct {
@@ -484,22 +486,23 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.CreateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.CreateIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *IntegrationIntegrationInstanceResourceCrud) getIntegrationInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_integration.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	integrationInstanceId, err := integrationInstanceWaitForWorkRequest(workId, "integration",
+	integrationInstanceId, err := integrationInstanceWaitForWorkRequest(s.Context(), workId, "integration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -533,9 +536,9 @@ func integrationInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func integrationInstanceWaitForWorkRequest(wId *string, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum,
+func integrationInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_integration.IntegrationInstanceClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "integration")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "integration")
 	retryPolicy.ShouldRetryOperation = integrationInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_integration.GetWorkRequestResponse{}
@@ -552,7 +555,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_integration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -564,7 +567,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -581,14 +584,14 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_integration.WorkRequestStatusFailed || response.Status == oci_integration.WorkRequestStatusCanceled {
-		return nil, getErrorFromIntegrationIntegrationInstanceWorkRequest(client, wId, response.CompartmentId, retryPolicy, entityType, action)
+		return nil, getErrorFromIntegrationIntegrationInstanceWorkRequest(ctx, client, wId, response.CompartmentId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromIntegrationIntegrationInstanceWorkRequest(client *oci_integration.IntegrationInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromIntegrationIntegrationInstanceWorkRequest(ctx context.Context, client *oci_integration.IntegrationInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_integration.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			WorkRequestId: workId,
@@ -617,9 +620,9 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -713,15 +716,15 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Update() error {
 		request.MessagePacks = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.UpdateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.UpdateIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
@@ -730,16 +733,16 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.DeleteIntegrationInstance(context.Background(), request)
+	response, err := s.Client.DeleteIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := integrationInstanceWaitForWorkRequest(workId, "integration",
+	_, delWorkRequestErr := integrationInstanceWaitForWorkRequest(s.Context(), workId, "integration",
 		oci_integration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1041,15 +1044,15 @@ func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(compartme
 	idTmp := s.D.Id()
 	changeCompartmentRequest.IntegrationInstanceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.ChangeIntegrationInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeIntegrationInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance() error {
@@ -1065,14 +1068,14 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance()
 
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StartIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartIntegrationInstance(s.Context(), request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance() error {
@@ -1088,12 +1091,12 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance()
 
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StopIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopIntegrationInstance(s.Context(), request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
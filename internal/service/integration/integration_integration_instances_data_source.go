@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_integration "github.com/oracle/oci-go-sdk/v61/integration"
 )
 
 func IntegrationIntegrationInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIntegrationIntegrationInstances,
+		ReadContext: readIntegrationIntegrationInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func IntegrationIntegrationInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readIntegrationIntegrationInstances(d *schema.ResourceData, m interface{}) error {
+func readIntegrationIntegrationInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type IntegrationIntegrationInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_integration.IntegrationInstanceClient
 	Res    *oci_integration.ListIntegrationInstancesResponse
 }
@@ -74,9 +75,9 @@ func (s *IntegrationIntegrationInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_integration.ListIntegrationInstancesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "integration")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "integration")
 
-	response, err := s.Client.ListIntegrationInstances(context.Background(), request)
+	response, err := s.Client.ListIntegrationInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *IntegrationIntegrationInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIntegrationInstances(context.Background(), request)
+		listResponse, err := s.Client.ListIntegrationInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package jms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v61/jms"
 
@@ -22,16 +23,16 @@ func JmsFleetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(JmsFleetResource(), fieldMap, readSingularJmsFleet)
 }
 
-func readSingularJmsFleet(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsFleet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type JmsFleetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_jms.JavaManagementServiceClient
 	Res    *oci_jms.GetFleetResponse
 }
@@ -48,9 +49,9 @@ func (s *JmsFleetDataSourceCrud) Get() error {
 		request.FleetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "jms")
 
-	response, err := s.Client.GetFleet(context.Background(), request)
+	response, err := s.Client.GetFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func JmsFleetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createJmsFleet,
-		Read:     readJmsFleet,
-		Update:   updateJmsFleet,
-		Delete:   deleteJmsFleet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createJmsFleet,
+		ReadContext:   readJmsFleet,
+		UpdateContext: updateJmsFleet,
+		DeleteContext: deleteJmsFleet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -94,37 +95,37 @@ func JmsFleetResource() *schema.Resource {
 	}
 }
 
-func createJmsFleet(d *schema.ResourceData, m interface{}) error {
+func createJmsFleet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readJmsFleet(d *schema.ResourceData, m interface{}) error {
+func readJmsFleet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateJmsFleet(d *schema.ResourceData, m interface{}) error {
+func updateJmsFleet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteJmsFleet(d *schema.ResourceData, m interface{}) error {
+func deleteJmsFleet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type JmsFleetResourceCrud struct {
@@ -192,22 +193,23 @@ func (s *JmsFleetResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.CreateFleet(context.Background(), request)
+	response, err := s.Client.CreateFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFleetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms"), oci_jms.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getFleetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms"), oci_jms.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *JmsFleetResourceCrud) getFleetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_jms.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	fleetId, err := fleetWaitForWorkRequest(workId, "fleet",
+	fleetId, err := fleetWaitForWorkRequest(s.Context(), workId, "fleet",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -242,9 +244,9 @@ func fleetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func fleetWaitForWorkRequest(wId *string, entityType string, action oci_jms.ActionTypeEnum,
+func fleetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_jms.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_jms.JavaManagementServiceClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "jms")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "jms")
 	retryPolicy.ShouldRetryOperation = fleetWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_jms.GetWorkRequestResponse{}
@@ -261,7 +263,7 @@ func fleetWaitForWorkRequest(wId *string, entityType string, action oci_jms.Acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_jms.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -273,7 +275,7 @@ func fleetWaitForWorkRequest(wId *string, entityType string, action oci_jms.Acti
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -300,9 +302,9 @@ func (s *JmsFleetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.FleetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.GetFleet(context.Background(), request)
+	response, err := s.Client.GetFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,15 +350,15 @@ func (s *JmsFleetResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.UpdateFleet(context.Background(), request)
+	response, err := s.Client.UpdateFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFleetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms"), oci_jms.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getFleetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms"), oci_jms.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *JmsFleetResourceCrud) Delete() error {
@@ -365,9 +367,9 @@ func (s *JmsFleetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FleetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms")
 
-	_, err := s.Client.DeleteFleet(context.Background(), request)
+	_, err := s.Client.DeleteFleet(s.Context(), request)
 	return err
 }
 
@@ -482,9 +484,9 @@ func (s *JmsFleetResourceCrud) updateCompartment(compartment interface{}) error
 	idTmp := s.D.Id()
 	changeCompartmentRequest.FleetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "jms")
 
-	_, err := s.Client.ChangeFleetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeFleetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
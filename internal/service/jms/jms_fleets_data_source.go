@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v61/jms"
 )
 
 func JmsFleetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsFleets,
+		ReadContext: readJmsFleets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func JmsFleetsDataSource() *schema.Resource {
 	}
 }
 
-func readJmsFleets(d *schema.ResourceData, m interface{}) error {
+func readJmsFleets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type JmsFleetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_jms.JavaManagementServiceClient
 	Res    *oci_jms.ListFleetsResponse
 }
@@ -92,9 +93,9 @@ func (s *JmsFleetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_jms.ListFleetsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "jms")
 
-	response, err := s.Client.ListFleets(context.Background(), request)
+	response, err := s.Client.ListFleets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *JmsFleetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFleets(context.Background(), request)
+		listResponse, err := s.Client.ListFleets(s.Context(), request)
 		if err != nil {
 			return err
 		}
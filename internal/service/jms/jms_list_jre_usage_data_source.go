@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v61/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsListJreUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsListJreUsage,
+		ReadContext: readSingularJmsListJreUsage,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:     schema.TypeString,
@@ -154,16 +155,16 @@ func JmsListJreUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsListJreUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsListJreUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsListJreUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type JmsListJreUsageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_jms.JavaManagementServiceClient
 	Res    *oci_jms.ListJreUsageResponse
 }
@@ -211,9 +212,9 @@ func (s *JmsListJreUsageDataSourceCrud) Get() error {
 		request.TimeStart = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "jms")
 
-	response, err := s.Client.ListJreUsage(context.Background(), request)
+	response, err := s.Client.ListJreUsage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v61/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsSummarizeResourceInventoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsSummarizeResourceInventory,
+		ReadContext: readSingularJmsSummarizeResourceInventory,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -56,16 +57,16 @@ func JmsSummarizeResourceInventoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsSummarizeResourceInventory(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsSummarizeResourceInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsSummarizeResourceInventoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type JmsSummarizeResourceInventoryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_jms.JavaManagementServiceClient
 	Res    *oci_jms.SummarizeResourceInventoryResponse
 }
@@ -98,9 +99,9 @@ func (s *JmsSummarizeResourceInventoryDataSourceCrud) Get() error {
 		request.TimeStart = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "jms")
 
-	response, err := s.Client.SummarizeResourceInventory(context.Background(), request)
+	response, err := s.Client.SummarizeResourceInventory(s.Context(), request)
 	if err != nil {
 		return err
 	}
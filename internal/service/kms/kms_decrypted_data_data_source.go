@@ -11,13 +11,14 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 )
 
 func KmsDecryptedDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDecryptedData,
+		ReadContext: readSingularDecryptedData,
 		Schema: map[string]*schema.Schema{
 			"associated_data": {
 				Type:     schema.TypeMap,
@@ -49,24 +50,24 @@ func KmsDecryptedDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDecryptedData(d *schema.ResourceData, m interface{}) error {
+func readSingularDecryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DecryptedDataDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type DecryptedDataDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsCryptoClient
 	Res    *oci_kms.DecryptResponse
 }
@@ -92,9 +93,9 @@ func (s *DecryptedDataDataSourceCrud) Get() error {
 		request.KeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.Decrypt(context.Background(), request)
+	response, err := s.Client.Decrypt(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 )
 
 func KmsEncryptedDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularEncryptedData,
+		ReadContext: readSingularEncryptedData,
 		Schema: map[string]*schema.Schema{
 			"associated_data": {
 				Type:     schema.TypeMap,
@@ -45,24 +46,24 @@ func KmsEncryptedDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularEncryptedData(d *schema.ResourceData, m interface{}) error {
+func readSingularEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EncryptedDataDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type EncryptedDataDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsCryptoClient
 	Res    *oci_kms.EncryptResponse
 }
@@ -88,9 +89,9 @@ func (s *EncryptedDataDataSourceCrud) Get() error {
 		request.Plaintext = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(s.Context(), request)
 	if err != nil {
 		return err
 	}
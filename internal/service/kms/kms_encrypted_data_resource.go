@@ -5,7 +5,7 @@ package kms
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,10 +19,10 @@ import (
 
 func KmsEncryptedDataResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsEncryptedData,
-		Read:     readKmsEncryptedData,
-		Delete:   deleteKmsEncryptedData,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsEncryptedData,
+		ReadContext:   readKmsEncryptedData,
+		DeleteContext: deleteKmsEncryptedData,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -76,39 +76,39 @@ func KmsEncryptedDataResource() *schema.Resource {
 	}
 }
 
-func createKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func createKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func readKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func deleteKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -153,9 +153,9 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 		request.Plaintext = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
@@ -18,10 +19,10 @@ import (
 
 func KmsGeneratedKeyResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsGeneratedKey,
-		Read:     readKmsGeneratedKey,
-		Delete:   deleteKmsGeneratedKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsGeneratedKey,
+		ReadContext:   readKmsGeneratedKey,
+		DeleteContext: deleteKmsGeneratedKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -103,27 +104,27 @@ func KmsGeneratedKeyResource() *schema.Resource {
 	}
 }
 
-func createKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func createKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsGeneratedKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func readKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func deleteKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -170,9 +171,9 @@ func (s *KmsGeneratedKeyResourceCrud) Create() error {
 		request.LoggingContext = tfresource.ObjectMapToStringMap(loggingContext.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
+	response, err := s.Client.GenerateDataEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 )
@@ -28,25 +29,25 @@ func KmsKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsKeyResource(), fieldMap, readSingularKmsKey)
 }
 
-func readSingularKmsKey(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsKeyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsManagementClient
 	Res    *oci_kms.GetKeyResponse
 }
@@ -63,9 +64,9 @@ func (s *KmsKeyDataSourceCrud) Get() error {
 		request.KeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"regexp"
@@ -32,11 +33,11 @@ func KmsKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsKey,
-		Read:     readKmsKey,
-		Update:   updateKmsKey,
-		Delete:   deleteKmsKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsKey,
+		ReadContext:   readKmsKey,
+		UpdateContext: updateKmsKey,
+		DeleteContext: deleteKmsKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -241,23 +242,23 @@ func KmsKeyResource() *schema.Resource {
 	}
 }
 
-func createKmsKey(d *schema.ResourceData, m interface{}) error {
+func createKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsKey(d *schema.ResourceData, m interface{}) error {
+func readKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
@@ -271,49 +272,49 @@ func readKmsKey(d *schema.ResourceData, m interface{}) error {
 			d.Set("management_endpoint", endpoint)
 			d.SetId(tokens[2])
 		} else {
-			return fmt.Errorf("id %s should be format: managementEndpoint/{managementEndpoint}/keys/{keyId}", id)
+			return tfresource.ToDiagnostics(sync, fmt.Errorf("id %s should be format: managementEndpoint/{managementEndpoint}/keys/{keyId}", id))
 		}
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateKmsKey(d *schema.ResourceData, m interface{}) error {
+func updateKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteKmsKey(d *schema.ResourceData, m interface{}) error {
+func deleteKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 // existing Id is key OCID and it is not the format that readKmsKey expects managementEndpoint/{managementEndpoint}/keys/{keyId}
@@ -443,9 +444,10 @@ func (s *KmsKeyResourceCrud) Create() error {
 		request.ProtectionMode = oci_kms.CreateKeyDetailsProtectionModeEnum(protectionMode.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKey(context.Background(), request)
+	response, err := s.Client.CreateKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -460,9 +462,9 @@ func (s *KmsKeyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -518,9 +520,9 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 	tmp := s.D.Id()
 
 	request.KeyId = &tmp
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateKey(context.Background(), request)
+	response, err := s.Client.UpdateKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -536,8 +538,8 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			tmpId := s.D.Id()
 			activationRequest.KeyId = &tmpId
 
-			activationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			activationResponse, err := s.Client.EnableKey(context.Background(), activationRequest)
+			activationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
+			activationResponse, err := s.Client.EnableKey(s.Context(), activationRequest)
 			if err != nil {
 				return err
 			}
@@ -547,8 +549,8 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			tmpId := s.D.Id()
 			deactivationRequest.KeyId = &tmpId
 
-			deactivationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			deactivationResponse, err := s.Client.DisableKey(context.Background(), deactivationRequest)
+			deactivationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
+			deactivationResponse, err := s.Client.DisableKey(s.Context(), deactivationRequest)
 			if err != nil {
 				return err
 			}
@@ -570,11 +572,11 @@ func (s *KmsKeyResourceCrud) Delete() error {
 		request.TimeOfDeletion = &oci_common.SDKTime{Time: tmpTime}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	_, err := s.Client.ScheduleKeyDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleKeyDeletion(s.Context(), request)
 	return err
 }
 
@@ -690,14 +692,14 @@ func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
 	idTmp := s.D.Id()
 	changeCompartmentRequest.KeyId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -718,9 +720,9 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromObjectStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -752,9 +754,9 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromFile() error {
 		request.ContentMd5 = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromFile(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -32,24 +33,24 @@ func KmsKeyVersionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsKeyVersionResource(), fieldMap, readSingularKmsKeyVersion)
 }
 
-func readSingularKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsKeyVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsManagementClient
 	Res    *oci_kms.GetKeyVersionResponse
 }
@@ -71,9 +72,9 @@ func (s *KmsKeyVersionDataSourceCrud) Get() error {
 		request.KeyVersionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
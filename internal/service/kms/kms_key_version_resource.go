@@ -12,6 +12,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -30,10 +31,10 @@ func KmsKeyVersionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsKeyVersion,
-		Read:     readKmsKeyVersion,
-		Delete:   deleteKmsKeyVersion,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsKeyVersion,
+		ReadContext:   readKmsKeyVersion,
+		DeleteContext: deleteKmsKeyVersion,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"key_id": {
@@ -112,23 +113,23 @@ func KmsKeyVersionResource() *schema.Resource {
 	}
 }
 
-func createKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func createKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func readKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
@@ -144,19 +145,19 @@ func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 			d.Set("key_version_id", tokens[3])
 			d.SetId(getKeyVersionCompositeId(tokens[2], tokens[3]))
 		} else {
-			return fmt.Errorf("id %s should be of format: managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id)
+			return tfresource.ToDiagnostics(sync, fmt.Errorf("id %s should be of format: managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id))
 		}
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func deleteKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// prevent kms version deletion as part of testing as version deletion is only applicable when the version is not the current version of the key
 	disableKmsVersionDeletion, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_kms_version_delete", "false"))
 	if disableKmsVersionDeletion {
@@ -167,15 +168,15 @@ func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 // existing ID() returned by getKeyVersionCompositeId method is of format keys/(.*)/keyVersions/(.*) and
@@ -234,14 +235,17 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 		request.KeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKeyVersion(context.Background(), request)
+	response, err := s.Client.CreateKeyVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	//has to wait some time, otherwise subsequent querying will fail
-	time.Sleep(time.Second * 30)
+	if err := tfresource.SleepContext(s.Context(), time.Second*30); err != nil {
+		return err
+	}
 	s.Res = &response.KeyVersion
 	return nil
 }
@@ -258,9 +262,9 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -289,9 +293,9 @@ func (s *KmsKeyVersionResourceCrud) Delete() error {
 		request.TimeOfDeletion = &oci_common.SDKTime{Time: tmpTime}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, error := s.Client.ScheduleKeyVersionDeletion(context.Background(), request)
+	_, error := s.Client.ScheduleKeyVersionDeletion(s.Context(), request)
 	return error
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -18,7 +19,7 @@ import (
 
 func KmsKeyVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsKeyVersions,
+		ReadContext: readKmsKeyVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"key_id": {
@@ -38,24 +39,24 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readKmsKeyVersions(d *schema.ResourceData, m interface{}) error {
+func readKmsKeyVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionsDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsKeyVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsManagementClient
 	Res    *oci_kms.ListKeyVersionsResponse
 }
@@ -69,9 +70,9 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 
 	request.KeyId = getKeyID(s)
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.ListKeyVersions(context.Background(), request)
+	response, err := s.Client.ListKeyVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeyVersions(context.Background(), request)
+		listResponse, err := s.Client.ListKeyVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
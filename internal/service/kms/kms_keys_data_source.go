@@ -8,6 +8,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -17,7 +18,7 @@ import (
 
 func KmsKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsKeys,
+		ReadContext: readKmsKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"algorithm": {
@@ -53,24 +54,24 @@ func KmsKeysDataSource() *schema.Resource {
 	}
 }
 
-func readKmsKeys(d *schema.ResourceData, m interface{}) error {
+func readKmsKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeysDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsManagementClient
 	Res    *oci_kms.ListKeysResponse
 }
@@ -104,9 +105,9 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 		request.ProtectionMode = oci_kms.ListKeysProtectionModeEnum(protectionMode.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.ListKeys(context.Background(), request)
+	response, err := s.Client.ListKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +116,7 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeys(context.Background(), request)
+		listResponse, err := s.Client.ListKeys(s.Context(), request)
 		if err != nil {
 			return err
 		}
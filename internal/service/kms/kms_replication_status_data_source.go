@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -16,7 +17,7 @@ import (
 
 func KmsReplicationStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularKmsReplicationStatus,
+		ReadContext: readSingularKmsReplicationStatus,
 		Schema: map[string]*schema.Schema{
 			"replication_id": {
 				Type:     schema.TypeString,
@@ -53,25 +54,25 @@ func KmsReplicationStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularKmsReplicationStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsReplicationStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsReplicationStatusDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("management endpoint missing"))
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsReplicationStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsManagementClient
 	Res    *oci_kms.GetReplicationStatusResponse
 }
@@ -88,9 +89,9 @@ func (s *KmsReplicationStatusDataSourceCrud) Get() error {
 		request.ReplicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.GetReplicationStatus(context.Background(), request)
+	response, err := s.Client.GetReplicationStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
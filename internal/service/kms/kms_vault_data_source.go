@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -22,16 +23,16 @@ func KmsVaultDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsVaultResource(), fieldMap, readSingularKmsVault)
 }
 
-func readSingularKmsVault(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsVaultDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsVaultClient
 	Res    *oci_kms.GetVaultResponse
 }
@@ -48,9 +49,9 @@ func (s *KmsVaultDataSourceCrud) Get() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
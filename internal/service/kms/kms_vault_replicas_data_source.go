@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func KmsVaultReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsVaultReplicas,
+		ReadContext: readKmsVaultReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"vault_id": {
@@ -55,16 +56,16 @@ func KmsVaultReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readKmsVaultReplicas(d *schema.ResourceData, m interface{}) error {
+func readKmsVaultReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsVaultReplicasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsVaultClient
 	Res    *oci_kms.ListVaultReplicasResponse
 }
@@ -81,9 +82,9 @@ func (s *KmsVaultReplicasDataSourceCrud) Get() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.ListVaultReplicas(context.Background(), request)
+	response, err := s.Client.ListVaultReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
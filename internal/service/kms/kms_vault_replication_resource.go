@@ -7,6 +7,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 )
@@ -16,11 +17,11 @@ func KmsVaultReplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsVaultReplica,
-		Read:     readKmsVaultReplica,
-		Update:   updateKmsVaultReplica,
-		Delete:   deleteKmsVaultReplica,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsVaultReplica,
+		ReadContext:   readKmsVaultReplica,
+		UpdateContext: updateKmsVaultReplica,
+		DeleteContext: deleteKmsVaultReplica,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"vault_id": {
@@ -40,36 +41,36 @@ func KmsVaultReplicationResource() *schema.Resource {
 	}
 }
 
-func createKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func createKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func readKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func updateKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func deleteKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type KmsVaultReplicaResourceCrud struct {
@@ -107,9 +108,9 @@ func (s *KmsVaultReplicaResourceCrud) Get() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.ListVaultReplicas(context.Background(), request)
+	response, err := s.Client.ListVaultReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,15 +173,15 @@ func (s *KmsVaultReplicaResourceCrud) createVaultReplicaHelper(vaultId string, r
 		request.ReplicaRegion = &replicaRegion
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.CreateVaultReplica(context.Background(), request)
+	_, err := s.Client.CreateVaultReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.Items[0].Status == oci_kms.VaultReplicaSummaryStatusCreated }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, replicaRegion string) error {
@@ -194,9 +195,9 @@ func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, r
 		request.ReplicaRegion = &replicaRegion
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.DeleteVaultReplica(context.Background(), request)
+	_, err := s.Client.DeleteVaultReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +205,7 @@ func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, r
 	retentionPolicyFunc := func() bool {
 		return (len(s.Res.Items) == 0 || s.Res.Items[0].Status == oci_kms.VaultReplicaSummaryStatusDeleted)
 	}
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
 }
 
 func (s *KmsVaultReplicaResourceCrud) SetData() error {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,11 +30,11 @@ func KmsVaultResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsVault,
-		Read:     readKmsVault,
-		Update:   updateKmsVault,
-		Delete:   deleteKmsVault,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsVault,
+		ReadContext:   readKmsVault,
+		UpdateContext: updateKmsVault,
+		DeleteContext: deleteKmsVault,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -191,36 +192,36 @@ func KmsVaultResource() *schema.Resource {
 	}
 }
 
-func createKmsVault(d *schema.ResourceData, m interface{}) error {
+func createKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsVault(d *schema.ResourceData, m interface{}) error {
+func readKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateKmsVault(d *schema.ResourceData, m interface{}) error {
+func updateKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteKmsVault(d *schema.ResourceData, m interface{}) error {
+func deleteKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type KmsVaultResourceCrud struct {
@@ -307,9 +308,10 @@ func (s *KmsVaultResourceCrud) Create() error {
 		request.VaultType = oci_kms.CreateVaultDetailsVaultTypeEnum(vaultType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateVault(context.Background(), request)
+	response, err := s.Client.CreateVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -324,9 +326,9 @@ func (s *KmsVaultResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -380,9 +382,9 @@ func (s *KmsVaultResourceCrud) UpdateVaultDetails() error {
 	}
 	tmp := s.D.Id()
 	request.VaultId = &tmp
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateVault(context.Background(), request)
+	response, err := s.Client.UpdateVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -405,9 +407,9 @@ func (s *KmsVaultResourceCrud) Delete() error {
 		request.TimeOfDeletion = &oci_common.SDKTime{Time: tmpTime}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ScheduleVaultDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleVaultDeletion(s.Context(), request)
 	return err
 }
 
@@ -482,14 +484,14 @@ func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VaultId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeVaultCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVaultCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -515,9 +517,9 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromObjectStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -555,9 +557,9 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromFile() error {
 		request.ContentMd5 = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromFile(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 )
 
 func KmsVaultUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularKmsVaultUsage,
+		ReadContext: readSingularKmsVaultUsage,
 		Schema: map[string]*schema.Schema{
 			"vault_id": {
 				Type:     schema.TypeString,
@@ -42,16 +43,16 @@ func KmsVaultUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularKmsVaultUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsVaultUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsVaultUsageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsVaultClient
 	Res    *oci_kms.GetVaultUsageResponse
 }
@@ -68,9 +69,9 @@ func (s *KmsVaultUsageDataSourceCrud) Get() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.GetVaultUsage(context.Background(), request)
+	response, err := s.Client.GetVaultUsage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v61/keymanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func KmsVaultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsVaults,
+		ReadContext: readKmsVaults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,16 +32,16 @@ func KmsVaultsDataSource() *schema.Resource {
 	}
 }
 
-func readKmsVaults(d *schema.ResourceData, m interface{}) error {
+func readKmsVaults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type KmsVaultsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_kms.KmsVaultClient
 	Res    *oci_kms.ListVaultsResponse
 }
@@ -57,9 +58,9 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "kms")
 
-	response, err := s.Client.ListVaults(context.Background(), request)
+	response, err := s.Client.ListVaults(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVaults(context.Background(), request)
+		listResponse, err := s.Client.ListVaults(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -20,10 +21,10 @@ func KmsVerifyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsVerify,
-		Read:     readKmsVerify,
-		Delete:   deleteKmsVerify,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsVerify,
+		ReadContext:   readKmsVerify,
+		DeleteContext: deleteKmsVerify,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -74,27 +75,27 @@ func KmsVerifyResource() *schema.Resource {
 	}
 }
 
-func createKmsVerify(d *schema.ResourceData, m interface{}) error {
+func createKmsVerify(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVerifyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.ToDiagnostics(sync, fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.ToDiagnostics(sync, err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readKmsVerify(d *schema.ResourceData, m interface{}) error {
+func readKmsVerify(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteKmsVerify(d *schema.ResourceData, m interface{}) error {
+func deleteKmsVerify(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -140,9 +141,9 @@ func (s *KmsVerifyResourceCrud) Create() error {
 		request.SigningAlgorithm = oci_kms.VerifyDataDetailsSigningAlgorithmEnum(signingAlgorithm.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Verify(context.Background(), request)
+	response, err := s.Client.Verify(s.Context(), request)
 	if err != nil {
 		return err
 	}
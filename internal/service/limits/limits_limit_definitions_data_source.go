@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsLimitDefinitionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsLimitDefinitions,
+		ReadContext: readLimitsLimitDefinitions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -84,16 +85,16 @@ func LimitsLimitDefinitionsDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsLimitDefinitions(d *schema.ResourceData, m interface{}) error {
+func readLimitsLimitDefinitions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsLimitDefinitionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsLimitDefinitionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.LimitsClient
 	Res    *oci_limits.ListLimitDefinitionsResponse
 }
@@ -120,9 +121,9 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 		request.ServiceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.ListLimitDefinitions(context.Background(), request)
+	response, err := s.Client.ListLimitDefinitions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitDefinitions(context.Background(), request)
+		listResponse, err := s.Client.ListLimitDefinitions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -17,7 +18,7 @@ import (
 
 func LimitsLimitValuesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsLimitValues,
+		ReadContext: readLimitsLimitValues,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -73,16 +74,16 @@ func LimitsLimitValuesDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsLimitValues(d *schema.ResourceData, m interface{}) error {
+func readLimitsLimitValues(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsLimitValuesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsLimitValuesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.LimitsClient
 	Res    *oci_limits.ListLimitValuesResponse
 }
@@ -118,9 +119,9 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 		request.ServiceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.ListLimitValues(context.Background(), request)
+	response, err := s.Client.ListLimitValues(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitValues(context.Background(), request)
+		listResponse, err := s.Client.ListLimitValues(s.Context(), request)
 		if err != nil {
 			return err
 		}
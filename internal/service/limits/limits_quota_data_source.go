@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -23,16 +24,16 @@ func LimitsQuotaDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LimitsQuotaResource(), fieldMap, readSingularLimitsQuota)
 }
 
-func readSingularLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func readSingularLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsQuotaDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.QuotasClient
 	Res    *oci_limits.GetQuotaResponse
 }
@@ -49,9 +50,9 @@ func (s *LimitsQuotaDataSourceCrud) Get() error {
 		request.QuotaId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
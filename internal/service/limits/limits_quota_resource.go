@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
@@ -20,11 +21,11 @@ func LimitsQuotaResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLimitsQuota,
-		Read:     readLimitsQuota,
-		Update:   updateLimitsQuota,
-		Delete:   deleteLimitsQuota,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLimitsQuota,
+		ReadContext:   readLimitsQuota,
+		UpdateContext: updateLimitsQuota,
+		DeleteContext: deleteLimitsQuota,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func LimitsQuotaResource() *schema.Resource {
 	}
 }
 
-func createLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func createLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func readLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func updateLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func deleteLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LimitsQuotaResourceCrud struct {
@@ -183,9 +184,10 @@ func (s *LimitsQuotaResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.CreateQuota(context.Background(), request)
+	response, err := s.Client.CreateQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,9 +202,9 @@ func (s *LimitsQuotaResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.QuotaId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -247,9 +249,9 @@ func (s *LimitsQuotaResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.UpdateQuota(context.Background(), request)
+	response, err := s.Client.UpdateQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,9 +266,9 @@ func (s *LimitsQuotaResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.QuotaId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "limits")
 
-	_, err := s.Client.DeleteQuota(context.Background(), request)
+	_, err := s.Client.DeleteQuota(s.Context(), request)
 	return err
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsQuotasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsQuotas,
+		ReadContext: readLimitsQuotas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func LimitsQuotasDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsQuotas(d *schema.ResourceData, m interface{}) error {
+func readLimitsQuotas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsQuotasDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.QuotasClient
 	Res    *oci_limits.ListQuotasResponse
 }
@@ -75,9 +76,9 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 		request.LifecycleState = oci_limits.ListQuotasLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.ListQuotas(context.Background(), request)
+	response, err := s.Client.ListQuotas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQuotas(context.Background(), request)
+		listResponse, err := s.Client.ListQuotas(s.Context(), request)
 		if err != nil {
 			return err
 		}
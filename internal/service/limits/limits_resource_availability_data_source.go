@@ -9,6 +9,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -17,7 +18,7 @@ import (
 
 func LimitsResourceAvailabilityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLimitsResourceAvailability,
+		ReadContext: readSingularLimitsResourceAvailability,
 		Schema: map[string]*schema.Schema{
 			"availability_domain": {
 				Type:             schema.TypeString,
@@ -61,16 +62,16 @@ func LimitsResourceAvailabilityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLimitsResourceAvailability(d *schema.ResourceData, m interface{}) error {
+func readSingularLimitsResourceAvailability(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsResourceAvailabilityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsResourceAvailabilityDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.LimitsClient
 	Res    *oci_limits.GetResourceAvailabilityResponse
 }
@@ -102,9 +103,9 @@ func (s *LimitsResourceAvailabilityDataSourceCrud) Get() error {
 		request.ServiceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.GetResourceAvailability(context.Background(), request)
+	response, err := s.Client.GetResourceAvailability(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v61/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsServices,
+		ReadContext: readLimitsServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func LimitsServicesDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsServices(d *schema.ResourceData, m interface{}) error {
+func readLimitsServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LimitsServicesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_limits.LimitsClient
 	Res    *oci_limits.ListServicesResponse
 }
@@ -74,9 +75,9 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "limits")
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+		listResponse, err := s.Client.ListServices(s.Context(), request)
 		if err != nil {
 			return err
 		}
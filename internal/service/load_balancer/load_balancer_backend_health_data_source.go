@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 )
 
 func LoadBalancerBackendHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerBackendHealth,
+		ReadContext: readSingularLoadBalancerBackendHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_name": {
 				Type:     schema.TypeString,
@@ -67,16 +68,16 @@ func LoadBalancerBackendHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerBackendHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerBackendHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerBackendHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.GetBackendHealthResponse
 }
@@ -103,9 +104,9 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
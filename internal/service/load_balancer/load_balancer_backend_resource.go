@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
@@ -26,11 +27,11 @@ func LoadBalancerBackendResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerBackend,
-		Read:     readLoadBalancerBackend,
-		Update:   updateLoadBalancerBackend,
-		Delete:   deleteLoadBalancerBackend,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoadBalancerBackend,
+		ReadContext:   readLoadBalancerBackend,
+		UpdateContext: updateLoadBalancerBackend,
+		DeleteContext: deleteLoadBalancerBackend,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"backendset_name": {
@@ -90,37 +91,37 @@ func LoadBalancerBackendResource() *schema.Resource {
 	}
 }
 
-func createLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
+func createLoadBalancerBackend(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerBackend(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
+func updateLoadBalancerBackend(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
+func deleteLoadBalancerBackend(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LoadBalancerBackendResourceCrud struct {
@@ -225,9 +226,10 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 		request.Weight = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateBackend(context.Background(), request)
+	response, err := s.Client.CreateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,13 +237,13 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 	workReqID := response.OpcWorkRequestId
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(s.Context(), s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -249,7 +251,7 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 }
 
 func (s *LoadBalancerBackendResourceCrud) Get() error {
-	_, stillWorking, err := loadBalancerResourceGet(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := loadBalancerResourceGet(s.Context(), s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -282,9 +284,9 @@ func (s *LoadBalancerBackendResourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetBackend(context.Background(), request)
+	response, err := s.Client.GetBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -331,9 +333,9 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 		request.Weight = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateBackend(context.Background(), request)
+	response, err := s.Client.UpdateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,13 +343,13 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 	workReqID := response.OpcWorkRequestId
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(s.Context(), s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -373,9 +375,9 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteBackend(context.Background(), request)
+	response, err := s.Client.DeleteBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,13 +385,13 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 	workReqID := response.OpcWorkRequestId
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer")
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(s.Context(), s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
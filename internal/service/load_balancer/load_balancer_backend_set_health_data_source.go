@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerBackendSetHealth,
+		ReadContext: readSingularLoadBalancerBackendSetHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_set_name": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func LoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerBackendSetHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerBackendSetHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendSetHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerBackendSetHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.GetBackendSetHealthResponse
 }
@@ -90,9 +91,9 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
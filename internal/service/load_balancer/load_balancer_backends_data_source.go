@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerBackendsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerBackends,
+		ReadContext: readLoadBalancerBackends,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backendset_name": {
@@ -35,16 +36,16 @@ func LoadBalancerBackendsDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerBackends(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerBackends(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerBackendsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListBackendsResponse
 }
@@ -66,9 +67,9 @@ func (s *LoadBalancerBackendsDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListBackends(context.Background(), request)
+	response, err := s.Client.ListBackends(s.Context(), request)
 	if err != nil {
 		return err
 	}
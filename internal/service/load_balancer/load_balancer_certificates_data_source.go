@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerCertificatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerCertificates,
+		ReadContext: readLoadBalancerCertificates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"load_balancer_id": {
@@ -31,16 +32,16 @@ func LoadBalancerCertificatesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerCertificates(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerCertificates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerCertificatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerCertificatesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListCertificatesResponse
 }
@@ -57,9 +58,9 @@ func (s *LoadBalancerCertificatesDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancerHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerLoadBalancerHealth,
+		ReadContext: readSingularLoadBalancerLoadBalancerHealth,
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -55,16 +56,16 @@ func LoadBalancerLoadBalancerHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerLoadBalancerHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerLoadBalancerHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerLoadBalancerHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.GetLoadBalancerHealthResponse
 }
@@ -81,9 +82,9 @@ func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetLoadBalancerHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerListenerRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerListenerRules,
+		ReadContext: readLoadBalancerListenerRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"listener_name": {
@@ -170,16 +171,16 @@ func LoadBalancerListenerRulesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerListenerRules(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerListenerRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerListenerRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerListenerRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListListenerRulesResponse
 }
@@ -201,9 +202,9 @@ func (s *LoadBalancerListenerRulesDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListListenerRules(context.Background(), request)
+	response, err := s.Client.ListListenerRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
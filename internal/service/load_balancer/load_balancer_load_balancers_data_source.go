@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerLoadBalancers,
+		ReadContext: readLoadBalancerLoadBalancers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerLoadBalancers(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerLoadBalancersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListLoadBalancersResponse
 }
@@ -83,9 +84,9 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_load_balancer.LoadBalancerLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListLoadBalancers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLoadBalancers(context.Background(), request)
+		listResponse, err := s.Client.ListLoadBalancers(s.Context(), request)
 		if err != nil {
 			return err
 		}
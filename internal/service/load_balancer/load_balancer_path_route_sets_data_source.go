@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerPathRouteSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerPathRouteSets,
+		ReadContext: readLoadBalancerPathRouteSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"load_balancer_id": {
@@ -31,16 +32,16 @@ func LoadBalancerPathRouteSetsDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerPathRouteSets(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerPathRouteSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerPathRouteSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerPathRouteSetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListPathRouteSetsResponse
 }
@@ -57,9 +58,9 @@ func (s *LoadBalancerPathRouteSetsDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListPathRouteSets(context.Background(), request)
+	response, err := s.Client.ListPathRouteSets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancerPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerLoadBalancerPolicies,
+		ReadContext: readLoadBalancerLoadBalancerPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func LoadBalancerLoadBalancerPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerLoadBalancerPolicies(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancerPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerLoadBalancerPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListPoliciesResponse
 }
@@ -69,9 +70,9 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
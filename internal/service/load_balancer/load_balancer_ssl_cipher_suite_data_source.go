@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -26,16 +27,16 @@ func LoadBalancerSslCipherSuiteDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoadBalancerSslCipherSuiteResource(), fieldMap, readSingularLoadBalancerSslCipherSuite)
 }
 
-func readSingularLoadBalancerSslCipherSuite(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerSslCipherSuite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuiteDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerSslCipherSuiteDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.GetSSLCipherSuiteResponse
 }
@@ -57,9 +58,9 @@ func (s *LoadBalancerSslCipherSuiteDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.GetSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.GetSSLCipherSuite(s.Context(), request)
 	if err != nil {
 		return err
 	}
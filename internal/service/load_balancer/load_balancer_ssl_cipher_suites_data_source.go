@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v61/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerSslCipherSuitesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerSslCipherSuites,
+		ReadContext: readLoadBalancerSslCipherSuites,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"load_balancer_id": {
@@ -31,16 +32,16 @@ func LoadBalancerSslCipherSuitesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerSslCipherSuites(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerSslCipherSuites(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuitesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoadBalancerSslCipherSuitesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_load_balancer.LoadBalancerClient
 	Res    *oci_load_balancer.ListSSLCipherSuitesResponse
 }
@@ -57,9 +58,9 @@ func (s *LoadBalancerSslCipherSuitesDataSourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "load_balancer")
 
-	response, err := s.Client.ListSSLCipherSuites(context.Background(), request)
+	response, err := s.Client.ListSSLCipherSuites(s.Context(), request)
 	if err != nil {
 		return err
 	}
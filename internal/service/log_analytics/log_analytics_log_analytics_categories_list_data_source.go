@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsCategoriesListDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsCategoriesList,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsCategoriesList,
 		Schema: map[string]*schema.Schema{
 			"category_display_text": {
 				Type:     schema.TypeString,
@@ -71,16 +72,16 @@ func LogAnalyticsLogAnalyticsCategoriesListDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsCategoriesList(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsCategoriesList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListCategoriesResponse
 }
@@ -112,9 +113,9 @@ func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(s.Context(), request)
 	if err != nil {
 		return err
 	}
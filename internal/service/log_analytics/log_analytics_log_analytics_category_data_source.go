@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsCategoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsCategory,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsCategory,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -46,16 +47,16 @@ func LogAnalyticsLogAnalyticsCategoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsCategory(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsCategory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsCategoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsCategoryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetCategoryResponse
 }
@@ -77,9 +78,9 @@ func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsEntities,
+		ReadContext: readLogAnalyticsLogAnalyticsEntities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cloud_resource_id": {
@@ -87,16 +88,16 @@ func LogAnalyticsLogAnalyticsEntitiesDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsLogAnalyticsEntities(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsEntities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntitiesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListLogAnalyticsEntitiesResponse
 }
@@ -174,9 +175,9 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_log_analytics.ListLogAnalyticsEntitiesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntities(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +186,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsEntities(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntitiesSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsEntitiesSummary,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsEntitiesSummary,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -42,16 +43,16 @@ func LogAnalyticsLogAnalyticsEntitiesSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntitiesSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntitiesSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetLogAnalyticsEntitiesSummaryResponse
 }
@@ -73,9 +74,9 @@ func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntitiesSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntitiesSummary(s.Context(), request)
 	if err != nil {
 		return err
 	}
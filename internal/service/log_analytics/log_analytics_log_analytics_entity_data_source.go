@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
@@ -26,16 +27,16 @@ func LogAnalyticsLogAnalyticsEntityDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsLogAnalyticsEntityResource(), fieldMap, readSingularLogAnalyticsLogAnalyticsEntity)
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetLogAnalyticsEntityResponse
 }
@@ -57,9 +58,9 @@ func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsEntityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsEntity,
-		Read:     readLogAnalyticsLogAnalyticsEntity,
-		Update:   updateLogAnalyticsLogAnalyticsEntity,
-		Delete:   deleteLogAnalyticsLogAnalyticsEntity,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsEntity,
+		ReadContext:   readLogAnalyticsLogAnalyticsEntity,
+		UpdateContext: updateLogAnalyticsLogAnalyticsEntity,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsEntity,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -135,37 +136,37 @@ func LogAnalyticsLogAnalyticsEntityResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityResourceCrud struct {
@@ -263,9 +264,10 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create() error {
 		request.TimezoneRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -293,9 +295,9 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -360,9 +362,9 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
 		request.TimezoneRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -382,9 +384,9 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsEntity(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsEntity(s.Context(), request)
 	return err
 }
 
@@ -571,14 +573,14 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) updateCompartment(compartme
 		changeCompartmentRequest.NamespaceName = &tmp
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntityTopologyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsEntityTopology,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsEntityTopology,
 		Schema: map[string]*schema.Schema{
 			"log_analytics_entity_id": {
 				Type:     schema.TypeString,
@@ -173,16 +174,16 @@ func LogAnalyticsLogAnalyticsEntityTopologyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntityTopology(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntityTopology(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListLogAnalyticsEntityTopologyResponse
 }
@@ -208,9 +209,9 @@ func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) Get() error {
 		request.LifecycleState = oci_log_analytics.ListLogAnalyticsEntityTopologyLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntityTopology(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntityTopology(s.Context(), request)
 	if err != nil {
 		return err
 	}
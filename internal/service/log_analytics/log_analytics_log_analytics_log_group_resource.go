@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsLogGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsLogGroup,
-		Read:     readLogAnalyticsLogAnalyticsLogGroup,
-		Update:   updateLogAnalyticsLogAnalyticsLogGroup,
-		Delete:   deleteLogAnalyticsLogAnalyticsLogGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsLogGroup,
+		ReadContext:   readLogAnalyticsLogAnalyticsLogGroup,
+		UpdateContext: updateLogAnalyticsLogAnalyticsLogGroup,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsLogGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func LogAnalyticsLogAnalyticsLogGroupResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupResourceCrud struct {
@@ -157,9 +158,10 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,9 +189,9 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,9 +242,9 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,9 +264,9 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsLogGroup(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsLogGroup(s.Context(), request)
 	return err
 }
 
@@ -377,14 +379,14 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) updateCompartment(compart
 		changeCompartmentRequest.NamespaceName = &tmp
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
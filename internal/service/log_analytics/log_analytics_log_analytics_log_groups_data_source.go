@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsLogGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsLogGroups,
+		ReadContext: readLogAnalyticsLogAnalyticsLogGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func LogAnalyticsLogAnalyticsLogGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsLogAnalyticsLogGroups(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsLogGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListLogAnalyticsLogGroupsResponse
 }
@@ -84,9 +85,9 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsLogGroups(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsLogGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsLogGroups(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsLogGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsLogGroupsSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsLogGroupsSummary,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsLogGroupsSummary,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func LogAnalyticsLogAnalyticsLogGroupsSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsLogGroupsSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsLogGroupsSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetLogAnalyticsLogGroupsSummaryResponse
 }
@@ -65,9 +66,9 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(s.Context(), request)
 	if err != nil {
 		return err
 	}
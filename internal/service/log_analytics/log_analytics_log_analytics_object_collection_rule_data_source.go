@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
@@ -26,16 +27,16 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsLogAnalyticsObjectCollectionRuleResource(), fieldMap, readSingularLogAnalyticsLogAnalyticsObjectCollectionRule)
 }
 
-func readSingularLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetLogAnalyticsObjectCollectionRuleResponse
 }
@@ -57,9 +58,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) Get() error
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Read:     readLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Update:   updateLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Delete:   deleteLogAnalyticsLogAnalyticsObjectCollectionRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsObjectCollectionRule,
+		ReadContext:   readLogAnalyticsLogAnalyticsObjectCollectionRule,
+		UpdateContext: updateLogAnalyticsLogAnalyticsObjectCollectionRule,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsObjectCollectionRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -175,37 +176,37 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud struct {
@@ -348,9 +349,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create() erro
 		request.PollTill = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,9 +384,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -481,9 +482,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update() erro
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -503,9 +504,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Delete() erro
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(s.Context(), request)
 	return err
 }
 
@@ -684,14 +685,14 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) updateCompart
 		changeCompartmentRequest.NamespaceName = &tmp
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
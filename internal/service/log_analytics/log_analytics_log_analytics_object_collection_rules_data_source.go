@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsObjectCollectionRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsObjectCollectionRules,
+		ReadContext: readLogAnalyticsLogAnalyticsObjectCollectionRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func LogAnalyticsLogAnalyticsObjectCollectionRulesDataSource() *schema.Resource
 	}
 }
 
-func readLogAnalyticsLogAnalyticsObjectCollectionRules(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsObjectCollectionRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListLogAnalyticsObjectCollectionRulesResponse
 }
@@ -92,9 +93,9 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 		request.LifecycleState = oci_log_analytics.ListLogAnalyticsObjectCollectionRulesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
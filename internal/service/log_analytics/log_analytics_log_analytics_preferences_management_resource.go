@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -23,11 +24,11 @@ func LogAnalyticsLogAnalyticsPreferencesManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsPreferencesManagement,
-		Update:   updateLogAnalyticsLogAnalyticsPreferencesManagement,
-		Read:     readLogAnalyticsLogAnalyticsPreferencesManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsPreferencesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsPreferencesManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsPreferencesManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsPreferencesManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsPreferencesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -65,33 +66,33 @@ func LogAnalyticsLogAnalyticsPreferencesManagementResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud struct {
@@ -216,8 +217,8 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) updatePrefer
 	request := oci_log_analytics.UpdatePreferencesRequest{}
 	request.NamespaceName = &namespace
 	request.UpdatePreferencesDetails.Items = items
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdatePreferences(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	_, err := s.Client.UpdatePreferences(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -229,8 +230,8 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) removePrefer
 	request := oci_log_analytics.RemovePreferencesRequest{}
 	request.NamespaceName = &namespace
 	request.RemovePreferencesDetails.Items = items
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemovePreferences(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	_, err := s.Client.RemovePreferences(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsResourceCategoriesListDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsResourceCategoriesList,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsResourceCategoriesList,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -100,16 +101,16 @@ func LogAnalyticsLogAnalyticsResourceCategoriesListDataSource() *schema.Resource
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsResourceCategoriesList(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsResourceCategoriesList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListResourceCategoriesResponse
 }
@@ -141,9 +142,9 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) Get() err
 		request.Categories = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListResourceCategories(context.Background(), request)
+	response, err := s.Client.ListResourceCategories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -21,11 +22,11 @@ func LogAnalyticsLogAnalyticsResourceCategoriesManagementResource() *schema.Reso
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Update:   updateLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Read:     readLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -62,33 +63,33 @@ func LogAnalyticsLogAnalyticsResourceCategoriesManagementResource() *schema.Reso
 	}
 }
 
-func createLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud struct {
@@ -230,8 +231,8 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) updat
 	request := oci_log_analytics.UpdateResourceCategoriesRequest{}
 	request.NamespaceName = &namespace
 	request.UpdateResourceCategoriesDetails.Items = items
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdateResourceCategories(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	_, err := s.Client.UpdateResourceCategories(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -243,8 +244,8 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) remov
 	request := oci_log_analytics.RemoveResourceCategoriesRequest{}
 	request.NamespaceName = &namespace
 	request.RemoveResourceCategoriesDetails.Items = items
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemoveResourceCategories(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	_, err := s.Client.RemoveResourceCategories(s.Context(), request)
 
 	if err != nil {
 		return err
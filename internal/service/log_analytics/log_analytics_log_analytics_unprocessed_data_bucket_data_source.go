@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -42,16 +43,16 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSource() *schema.Resource
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetUnprocessedDataBucketResponse
 }
@@ -68,9 +69,9 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) Get() erro
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.GetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
@@ -19,11 +20,11 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResource() *schema.R
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Update:   updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Read:     readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -56,33 +57,33 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResource() *schema.R
 	}
 }
 
-func createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud struct {
@@ -114,9 +115,9 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Cr
 		request.IsEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -143,9 +144,9 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Up
 		request.IsEnabled = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,9 +171,9 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) De
 	isEnabled := false
 	request.IsEnabled = &isEnabled
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
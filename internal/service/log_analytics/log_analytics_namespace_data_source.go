@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsNamespaceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsNamespace,
+		ReadContext: readSingularLogAnalyticsNamespace,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -34,16 +35,16 @@ func LogAnalyticsNamespaceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsNamespaceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetNamespaceResponse
 }
@@ -60,9 +61,9 @@ func (s *LogAnalyticsNamespaceDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
@@ -25,10 +26,10 @@ func LogAnalyticsNamespaceResource() *schema.Resource {
 			Create: tfresource.GetTimeoutDuration("2m"),
 			Update: tfresource.GetTimeoutDuration("2m"),
 		},
-		Create: createLogAnalyticsNamespace,
-		Read:   readLogAnalyticsNamespace,
-		Update: updateLogAnalyticsNamespace,
-		Delete: deleteLogAnalyticsNamespace,
+		CreateContext: createLogAnalyticsNamespace,
+		ReadContext:   readLogAnalyticsNamespace,
+		UpdateContext: updateLogAnalyticsNamespace,
+		DeleteContext: deleteLogAnalyticsNamespace,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -46,31 +47,31 @@ func LogAnalyticsNamespaceResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -115,14 +116,14 @@ func (s *LogAnalyticsNamespaceResourceCrud) OnboardNamespace() error {
 		namespace = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OnboardNamespace(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	response, err := s.Client.OnboardNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace() error {
@@ -134,20 +135,20 @@ func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace() error {
 		namespace = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OffboardNamespace(context.Background(), request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
+	response, err := s.Client.OffboardNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesDeleted, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesDeleted, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *LogAnalyticsNamespaceResourceCrud) getNamespaceFromWorkRequest(workId *string, ns *string, retryPolicy *oci_common.RetryPolicy, actionTypeEnum oci_log_analytics.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	namespaceName, err := logAnalyticsWaitForWorkRequest(workId, ns, "loganalytics", actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
+	namespaceName, err := logAnalyticsWaitForWorkRequest(s.Context(), workId, ns, "loganalytics", actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		return err
@@ -168,9 +169,9 @@ func (s *LogAnalyticsNamespaceResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListNamespaces(context.Background(), request)
+	response, err := s.Client.ListNamespaces(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -210,8 +211,8 @@ func (s *LogAnalyticsNamespaceResourceCrud) SetData() error {
 	return nil
 }
 
-func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string, action oci_log_analytics.ActionTypesEnum, timeout time.Duration, disableFoundRetries bool, client *oci_log_analytics.LogAnalyticsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "log_analytics")
+func logAnalyticsWaitForWorkRequest(ctx context.Context, wId *string, ns *string, entityType string, action oci_log_analytics.ActionTypesEnum, timeout time.Duration, disableFoundRetries bool, client *oci_log_analytics.LogAnalyticsClient) (*string, error) {
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "log_analytics")
 	retryPolicy.ShouldRetryOperation = logAnalyticsWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_log_analytics.GetWorkRequestResponse{}
@@ -228,7 +229,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_log_analytics.GetWorkRequestRequest{
 					NamespaceName: ns,
 					WorkRequestId: wId,
@@ -241,7 +242,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -258,7 +259,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 
 	// The Log Analytics workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_log_analytics.OperationStatusFailed || response.Status == oci_log_analytics.OperationStatusCanceled {
-		return nil, getErrorFromLogAnalyticsWorkRequest(client, wId, ns, retryPolicy, entityType, action)
+		return nil, getErrorFromLogAnalyticsWorkRequest(ctx, client, wId, ns, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -287,8 +288,8 @@ func logAnalyticsWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func getErrorFromLogAnalyticsWorkRequest(client *oci_log_analytics.LogAnalyticsClient, wId *string, ns *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_log_analytics.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLogAnalyticsWorkRequest(ctx context.Context, client *oci_log_analytics.LogAnalyticsClient, wId *string, ns *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_log_analytics.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_log_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			NamespaceName: ns,
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
@@ -28,16 +29,16 @@ func LogAnalyticsNamespaceScheduledTaskDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsNamespaceScheduledTaskResource(), fieldMap, readSingularLogAnalyticsNamespaceScheduledTask)
 }
 
-func readSingularLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsNamespaceScheduledTaskDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.GetScheduledTaskResponse
 }
@@ -63,9 +64,9 @@ func (s *LogAnalyticsNamespaceScheduledTaskDataSourceCrud) Get() error {
 		return fmt.Errorf("scheduled_task_id is required to get a scheduled task")
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.GetScheduledTask(context.Background(), request)
+	response, err := s.Client.GetScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
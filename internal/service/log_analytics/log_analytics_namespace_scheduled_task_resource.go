@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func LogAnalyticsNamespaceScheduledTaskResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsNamespaceScheduledTask,
-		Read:     readLogAnalyticsNamespaceScheduledTask,
-		Update:   updateLogAnalyticsNamespaceScheduledTask,
-		Delete:   deleteLogAnalyticsNamespaceScheduledTask,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsNamespaceScheduledTask,
+		ReadContext:   readLogAnalyticsNamespaceScheduledTask,
+		UpdateContext: updateLogAnalyticsNamespaceScheduledTask,
+		DeleteContext: deleteLogAnalyticsNamespaceScheduledTask,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -237,37 +238,37 @@ func LogAnalyticsNamespaceScheduledTaskResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LogAnalyticsNamespaceScheduledTaskResourceCrud struct {
@@ -321,9 +322,10 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateScheduledTask(context.Background(), request)
+	response, err := s.Client.CreateScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -343,9 +345,9 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetScheduledTask(context.Background(), request)
+	response, err := s.Client.GetScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,9 +423,9 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
 	}
 
 	request.UpdateScheduledTaskDetails = details
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateScheduledTask(context.Background(), request)
+	response, err := s.Client.UpdateScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,9 +445,9 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err = s.Client.DeleteScheduledTask(context.Background(), request)
+	_, err = s.Client.DeleteScheduledTask(s.Context(), request)
 	return err
 }
 
@@ -859,9 +861,9 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) updateCompartment(compa
 		return fmt.Errorf("scheduled_task_id is required to update the compartment of a scheduled task")
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeScheduledTaskCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeScheduledTaskCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
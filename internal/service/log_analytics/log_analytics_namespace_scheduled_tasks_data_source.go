@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v61/loganalytics"
 )
 
 func LogAnalyticsNamespaceScheduledTasksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsNamespaceScheduledTasks,
+		ReadContext: readLogAnalyticsNamespaceScheduledTasks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func LogAnalyticsNamespaceScheduledTasksDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsNamespaceScheduledTasks(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceScheduledTasks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTasksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LogAnalyticsNamespaceScheduledTasksDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_log_analytics.LogAnalyticsClient
 	Res    *oci_log_analytics.ListScheduledTasksResponse
 }
@@ -92,9 +93,9 @@ func (s *LogAnalyticsNamespaceScheduledTasksDataSourceCrud) Get() error {
 		request.TaskType = oci_log_analytics.ListScheduledTasksTaskTypeEnum(taskType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "log_analytics")
 
-	response, err := s.Client.ListScheduledTasks(context.Background(), request)
+	response, err := s.Client.ListScheduledTasks(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *LogAnalyticsNamespaceScheduledTasksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScheduledTasks(context.Background(), request)
+		listResponse, err := s.Client.ListScheduledTasks(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
@@ -26,16 +27,16 @@ func LoggingLogDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoggingLogResource(), fieldMap, readSingularLoggingLog)
 }
 
-func readSingularLoggingLog(d *schema.ResourceData, m interface{}) error {
+func readSingularLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingLogDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.GetLogResponse
 }
@@ -57,9 +58,9 @@ func (s *LoggingLogDataSourceCrud) Get() error {
 		request.LogId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.GetLog(context.Background(), request)
+	response, err := s.Client.GetLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
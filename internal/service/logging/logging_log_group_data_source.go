@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
@@ -22,16 +23,16 @@ func LoggingLogGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoggingLogGroupResource(), fieldMap, readSingularLoggingLogGroup)
 }
 
-func readSingularLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingLogGroupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.GetLogGroupResponse
 }
@@ -48,9 +49,9 @@ func (s *LoggingLogGroupDataSourceCrud) Get() error {
 		request.LogGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.GetLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
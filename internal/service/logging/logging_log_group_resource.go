@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func LoggingLogGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingLogGroup,
-		Read:     readLoggingLogGroup,
-		Update:   updateLoggingLogGroup,
-		Delete:   deleteLoggingLogGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingLogGroup,
+		ReadContext:   readLoggingLogGroup,
+		UpdateContext: updateLoggingLogGroup,
+		DeleteContext: deleteLoggingLogGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func LoggingLogGroupResource() *schema.Resource {
 	}
 }
 
-func createLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func createLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func updateLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LoggingLogGroupResourceCrud struct {
@@ -174,15 +175,16 @@ func (s *LoggingLogGroupResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLogGroup(context.Background(), request)
+	response, err := s.Client.CreateLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, 5*time.Minute)
+	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, 5*time.Minute)
 
 }
 
@@ -190,13 +192,13 @@ func (s *LoggingLogGroupResourceCrud) getLogGroupFromWorkRequest(workId *string,
 	actionTypeEnum oci_logging.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	logGroupId, err := logGroupWaitForWorkRequest(workId, "loggroup",
+	logGroupId, err := logGroupWaitForWorkRequest(s.Context(), workId, "loggroup",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, logGroupId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_logging.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -236,9 +238,9 @@ func logGroupWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_logging.ActionTypesEnum,
+func logGroupWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_logging.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_logging.LoggingManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "logging")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "logging")
 	retryPolicy.ShouldRetryOperation = logGroupWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_logging.GetWorkRequestResponse{}
@@ -255,7 +257,7 @@ func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_loggi
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -267,7 +269,7 @@ func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_loggi
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -284,14 +286,14 @@ func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_loggi
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_logging.OperationStatusFailed || response.Status == oci_logging.OperationStatusCanceled {
-		return nil, getErrorFromLoggingLogGroupWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromLoggingLogGroupWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromLoggingLogGroupWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLoggingLogGroupWorkRequest(ctx context.Context, client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -319,9 +321,9 @@ func (s *LoggingLogGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.LogGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -367,15 +369,15 @@ func (s *LoggingLogGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.LogGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLogGroup(context.Background(), request)
+	response, err := s.Client.UpdateLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *LoggingLogGroupResourceCrud) Delete() error {
@@ -384,9 +386,9 @@ func (s *LoggingLogGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLogGroup(context.Background(), request)
+	_, err := s.Client.DeleteLogGroup(s.Context(), request)
 	return err
 }
 
@@ -431,9 +433,9 @@ func (s *LoggingLogGroupResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.LogGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.ChangeLogGroupCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeLogGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -441,5 +443,5 @@ func (s *LoggingLogGroupResourceCrud) updateCompartment(compartment interface{})
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
 
-	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getLogGroupFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
 
 func LoggingLogGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingLogGroups,
+		ReadContext: readLoggingLogGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func LoggingLogGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingLogGroups(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingLogGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.ListLogGroupsResponse
 }
@@ -75,9 +76,9 @@ func (s *LoggingLogGroupsDataSourceCrud) Get() error {
 		request.IsCompartmentIdInSubtree = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.ListLogGroups(context.Background(), request)
+	response, err := s.Client.ListLogGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *LoggingLogGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogGroups(context.Background(), request)
+		listResponse, err := s.Client.ListLogGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
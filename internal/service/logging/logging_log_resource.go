@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,11 +29,11 @@ func LoggingLogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingLog,
-		Read:     readLoggingLog,
-		Update:   updateLoggingLog,
-		Delete:   deleteLoggingLog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingLog,
+		ReadContext:   readLoggingLog,
+		UpdateContext: updateLoggingLog,
+		DeleteContext: deleteLoggingLog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -162,37 +163,37 @@ func LoggingLogResource() *schema.Resource {
 	}
 }
 
-func createLoggingLog(d *schema.ResourceData, m interface{}) error {
+func createLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLoggingLog(d *schema.ResourceData, m interface{}) error {
+func readLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLoggingLog(d *schema.ResourceData, m interface{}) error {
+func updateLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLoggingLog(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LoggingLogResourceCrud struct {
@@ -278,15 +279,16 @@ func (s *LoggingLogResourceCrud) Create() error {
 		request.RetentionDuration = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLog(context.Background(), request)
+	response, err := s.Client.CreateLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 
 }
 
@@ -294,13 +296,13 @@ func (s *LoggingLogResourceCrud) getLogFromWorkRequest(workId *string, retryPoli
 	actionTypeEnum oci_logging.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	logId, err := logWaitForWorkRequest(workId, "log",
+	logId, err := logWaitForWorkRequest(s.Context(), workId, "log",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, logId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_logging.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -340,9 +342,9 @@ func logWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_comm
 	}
 }
 
-func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.ActionTypesEnum,
+func logWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_logging.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_logging.LoggingManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "logging")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "logging")
 	retryPolicy.ShouldRetryOperation = logWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_logging.GetWorkRequestResponse{}
@@ -359,7 +361,7 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -371,7 +373,7 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -388,14 +390,14 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_logging.OperationStatusFailed || response.Status == oci_logging.OperationStatusCanceled {
-		return nil, getErrorFromLoggingLogWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromLoggingLogWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromLoggingLogWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLoggingLogWorkRequest(ctx context.Context, client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -436,9 +438,9 @@ func (s *LoggingLogResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLog(context.Background(), request)
+	response, err := s.Client.GetLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -496,15 +498,15 @@ func (s *LoggingLogResourceCrud) Update() error {
 		request.RetentionDuration = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLog(context.Background(), request)
+	response, err := s.Client.UpdateLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *LoggingLogResourceCrud) Delete() error {
@@ -518,9 +520,9 @@ func (s *LoggingLogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLog(context.Background(), request)
+	_, err := s.Client.DeleteLog(s.Context(), request)
 	return err
 }
 
@@ -699,13 +701,13 @@ func (s *LoggingLogResourceCrud) updateLogGroup(oldLogGroupId interface{}, newLo
 
 	updateLogGroupRequest.ChangeLogLogGroupDetails = updateLogGroupDetails
 
-	response, err := s.Client.ChangeLogLogGroup(context.Background(), updateLogGroupRequest)
+	response, err := s.Client.ChangeLogLogGroup(s.Context(), updateLogGroupRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
@@ -19,11 +20,11 @@ func LoggingLogSavedSearchResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingLogSavedSearch,
-		Read:     readLoggingLogSavedSearch,
-		Update:   updateLoggingLogSavedSearch,
-		Delete:   deleteLoggingLogSavedSearch,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingLogSavedSearch,
+		ReadContext:   readLoggingLogSavedSearch,
+		UpdateContext: updateLoggingLogSavedSearch,
+		DeleteContext: deleteLoggingLogSavedSearch,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -76,37 +77,37 @@ func LoggingLogSavedSearchResource() *schema.Resource {
 	}
 }
 
-func createLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func createLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func updateLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LoggingLogSavedSearchResourceCrud struct {
@@ -189,9 +190,10 @@ func (s *LoggingLogSavedSearchResourceCrud) Create() error {
 		request.Query = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.CreateLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,9 +208,9 @@ func (s *LoggingLogSavedSearchResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.LogSavedSearchId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLogSavedSearch(context.Background(), request)
+	response, err := s.Client.GetLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,9 +261,9 @@ func (s *LoggingLogSavedSearchResourceCrud) Update() error {
 		request.Query = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.UpdateLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,9 +278,9 @@ func (s *LoggingLogSavedSearchResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogSavedSearchId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLogSavedSearch(context.Background(), request)
+	_, err := s.Client.DeleteLogSavedSearch(s.Context(), request)
 	return err
 }
 
@@ -369,14 +371,14 @@ func (s *LoggingLogSavedSearchResourceCrud) updateCompartment(compartment interf
 	idTmp := s.D.Id()
 	changeCompartmentRequest.LogSavedSearchId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.ChangeLogSavedSearchCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogSavedSearchCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
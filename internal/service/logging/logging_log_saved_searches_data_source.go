@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
 
 func LoggingLogSavedSearchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingLogSavedSearches,
+		ReadContext: readLoggingLogSavedSearches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func LoggingLogSavedSearchesDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingLogSavedSearches(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogSavedSearches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingLogSavedSearchesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.ListLogSavedSearchesResponse
 }
@@ -84,9 +85,9 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.ListLogSavedSearches(context.Background(), request)
+	response, err := s.Client.ListLogSavedSearches(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogSavedSearches(context.Background(), request)
+		listResponse, err := s.Client.ListLogSavedSearches(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
 
 func LoggingLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingLogs,
+		ReadContext: readLoggingLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -51,16 +52,16 @@ func LoggingLogsDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingLogs(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingLogsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.ListLogsResponse
 }
@@ -100,9 +101,9 @@ func (s *LoggingLogsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_logging.ListLogsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.ListLogs(context.Background(), request)
+	response, err := s.Client.ListLogs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *LoggingLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogs(context.Background(), request)
+		listResponse, err := s.Client.ListLogs(s.Context(), request)
 		if err != nil {
 			return err
 		}
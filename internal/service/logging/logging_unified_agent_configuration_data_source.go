@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
 )
@@ -22,16 +23,16 @@ func LoggingUnifiedAgentConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoggingUnifiedAgentConfigurationResource(), fieldMap, readSingularLoggingUnifiedAgentConfiguration)
 }
 
-func readSingularLoggingUnifiedAgentConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularLoggingUnifiedAgentConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingUnifiedAgentConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.GetUnifiedAgentConfigurationResponse
 }
@@ -48,9 +49,9 @@ func (s *LoggingUnifiedAgentConfigurationDataSourceCrud) Get() error {
 		request.UnifiedAgentConfigurationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.GetUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.GetUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,11 +26,11 @@ func LoggingUnifiedAgentConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingUnifiedAgentConfiguration,
-		Read:     readLoggingUnifiedAgentConfiguration,
-		Update:   updateLoggingUnifiedAgentConfiguration,
-		Delete:   deleteLoggingUnifiedAgentConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingUnifiedAgentConfiguration,
+		ReadContext:   readLoggingUnifiedAgentConfiguration,
+		UpdateContext: updateLoggingUnifiedAgentConfiguration,
+		DeleteContext: deleteLoggingUnifiedAgentConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -393,37 +394,37 @@ func LoggingUnifiedAgentConfigurationResource() *schema.Resource {
 	}
 }
 
-func createLoggingUnifiedAgentConfiguration(d *schema.ResourceData, m interface{}) error {
+func createLoggingUnifiedAgentConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readLoggingUnifiedAgentConfiguration(d *schema.ResourceData, m interface{}) error {
+func readLoggingUnifiedAgentConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateLoggingUnifiedAgentConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateLoggingUnifiedAgentConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteLoggingUnifiedAgentConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingUnifiedAgentConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type LoggingUnifiedAgentConfigurationResourceCrud struct {
@@ -516,22 +517,23 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.CreateUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *LoggingUnifiedAgentConfigurationResourceCrud) getUnifiedAgentConfigurationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_logging.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	unifiedAgentConfigurationId, err := unifiedAgentConfigurationWaitForWorkRequest(workId, "unifiedagentconfiguration",
+	unifiedAgentConfigurationId, err := unifiedAgentConfigurationWaitForWorkRequest(s.Context(), workId, "unifiedagentconfiguration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -565,9 +567,9 @@ func unifiedAgentConfigurationWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string, action oci_logging.ActionTypesEnum,
+func unifiedAgentConfigurationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_logging.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_logging.LoggingManagementClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "logging")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "logging")
 	retryPolicy.ShouldRetryOperation = unifiedAgentConfigurationWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_logging.GetWorkRequestResponse{}
@@ -584,7 +586,7 @@ func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -596,7 +598,7 @@ func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -613,14 +615,14 @@ func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_logging.OperationStatusFailed || response.Status == oci_logging.OperationStatusCanceled {
-		return nil, getErrorFromLoggingUnifiedAgentConfigurationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromLoggingUnifiedAgentConfigurationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromLoggingUnifiedAgentConfigurationWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLoggingUnifiedAgentConfigurationWorkRequest(ctx context.Context, client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -648,9 +650,9 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.UnifiedAgentConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.GetUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -723,15 +725,15 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UnifiedAgentConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *LoggingUnifiedAgentConfigurationResourceCrud) Delete() error {
@@ -740,9 +742,9 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UnifiedAgentConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.DeleteUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.DeleteUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -750,7 +752,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Delete() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	_, delWorkRequestErr := unifiedAgentConfigurationWaitForWorkRequest(workId, "unifiedagentconfiguration",
+	_, delWorkRequestErr := unifiedAgentConfigurationWaitForWorkRequest(s.Context(), workId, "unifiedagentconfiguration",
 		oci_logging.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -2068,13 +2070,13 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) updateCompartment(compart
 	idTmp := s.D.Id()
 	changeCompartmentRequest.UnifiedAgentConfigurationId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.ChangeUnifiedAgentConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeUnifiedAgentConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getUnifiedAgentConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_logging "github.com/oracle/oci-go-sdk/v61/logging"
@@ -16,7 +17,7 @@ import (
 
 func LoggingUnifiedAgentConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingUnifiedAgentConfigurations,
+		ReadContext: readLoggingUnifiedAgentConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -122,16 +123,16 @@ func LoggingUnifiedAgentConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingUnifiedAgentConfigurations(d *schema.ResourceData, m interface{}) error {
+func readLoggingUnifiedAgentConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type LoggingUnifiedAgentConfigurationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_logging.LoggingManagementClient
 	Res    *oci_logging.ListUnifiedAgentConfigurationsResponse
 }
@@ -172,9 +173,9 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_logging.ListUnifiedAgentConfigurationsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "logging")
 
-	response, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+	response, err := s.Client.ListUnifiedAgentConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +184,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListUnifiedAgentConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
@@ -17,7 +18,7 @@ import (
 
 func ManagementAgentManagementAgentAvailableHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentAvailableHistories,
+		ReadContext: readManagementAgentManagementAgentAvailableHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"management_agent_id": {
@@ -65,16 +66,16 @@ func ManagementAgentManagementAgentAvailableHistoriesDataSource() *schema.Resour
 	}
 }
 
-func readManagementAgentManagementAgentAvailableHistories(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentAvailableHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.ListAvailabilityHistoriesResponse
 }
@@ -107,9 +108,9 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 		request.TimeAvailabilityStatusStartedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+	response, err := s.Client.ListAvailabilityHistories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+		listResponse, err := s.Client.ListAvailabilityHistories(s.Context(), request)
 		if err != nil {
 			return err
 		}
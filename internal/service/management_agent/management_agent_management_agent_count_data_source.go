@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentCount,
+		ReadContext: readSingularManagementAgentManagementAgentCount,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -91,16 +92,16 @@ func ManagementAgentManagementAgentCountDataSource() *schema.Resource {
 	}
 }
 
-func readSingularManagementAgentManagementAgentCount(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentCountDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.SummarizeManagementAgentCountsResponse
 }
@@ -139,9 +140,9 @@ func (s *ManagementAgentManagementAgentCountDataSourceCrud) Get() error {
 		request.InstallType = oci_management_agent.SummarizeManagementAgentCountsInstallTypeEnum(installType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.SummarizeManagementAgentCounts(context.Background(), request)
+	response, err := s.Client.SummarizeManagementAgentCounts(s.Context(), request)
 	if err != nil {
 		return err
 	}
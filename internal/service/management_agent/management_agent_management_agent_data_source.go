@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -22,16 +23,16 @@ func ManagementAgentManagementAgentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ManagementAgentManagementAgentResource(), fieldMap, readSingularManagementAgentManagementAgent)
 }
 
-func readSingularManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.GetManagementAgentResponse
 }
@@ -48,9 +49,9 @@ func (s *ManagementAgentManagementAgentDataSourceCrud) Get() error {
 		request.ManagementAgentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
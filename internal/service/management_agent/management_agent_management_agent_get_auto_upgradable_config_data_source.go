@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentGetAutoUpgradableConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentGetAutoUpgradableConfig,
+		ReadContext: readSingularManagementAgentManagementAgentGetAutoUpgradableConfig,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -30,16 +31,16 @@ func ManagementAgentManagementAgentGetAutoUpgradableConfigDataSource() *schema.R
 	}
 }
 
-func readSingularManagementAgentManagementAgentGetAutoUpgradableConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentGetAutoUpgradableConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.GetAutoUpgradableConfigResponse
 }
@@ -56,9 +57,9 @@ func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Ge
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.GetAutoUpgradableConfig(context.Background(), request)
+	response, err := s.Client.GetAutoUpgradableConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
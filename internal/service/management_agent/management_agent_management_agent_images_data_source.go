@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentImages,
+		ReadContext: readManagementAgentManagementAgentImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -83,16 +84,16 @@ func ManagementAgentManagementAgentImagesDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentImages(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentImagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.ListManagementAgentImagesResponse
 }
@@ -122,9 +123,9 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_management_agent.ListManagementAgentImagesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentImages(context.Background(), request)
+	response, err := s.Client.ListManagementAgentImages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -133,7 +134,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentImages(s.Context(), request)
 		if err != nil {
 			return err
 		}
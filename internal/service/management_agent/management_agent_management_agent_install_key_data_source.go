@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -23,16 +24,16 @@ func ManagementAgentManagementAgentInstallKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ManagementAgentManagementAgentInstallKeyResource(), fieldMap, readSingularManagementAgentManagementAgentInstallKey)
 }
 
-func readSingularManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.GetManagementAgentInstallKeyResponse
 }
@@ -49,9 +50,9 @@ func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) Get() error {
 		request.ManagementAgentInstallKeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
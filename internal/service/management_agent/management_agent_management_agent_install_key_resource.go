@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -21,11 +22,11 @@ func ManagementAgentManagementAgentInstallKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementAgentManagementAgentInstallKey,
-		Read:     readManagementAgentManagementAgentInstallKey,
-		Update:   updateManagementAgentManagementAgentInstallKey,
-		Delete:   deleteManagementAgentManagementAgentInstallKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementAgentManagementAgentInstallKey,
+		ReadContext:   readManagementAgentManagementAgentInstallKey,
+		UpdateContext: updateManagementAgentManagementAgentInstallKey,
+		DeleteContext: deleteManagementAgentManagementAgentInstallKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -86,37 +87,37 @@ func ManagementAgentManagementAgentInstallKeyResource() *schema.Resource {
 	}
 }
 
-func createManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func createManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func updateManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func deleteManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeyResourceCrud struct {
@@ -181,9 +182,10 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create() error {
 		request.TimeExpires = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.CreateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.CreateManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -198,9 +200,9 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ManagementAgentInstallKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -225,9 +227,9 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagementAgentInstallKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,9 +244,9 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagementAgentInstallKeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgentInstallKey(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgentInstallKey(s.Context(), request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -16,7 +17,7 @@ import (
 
 func ManagementAgentManagementAgentInstallKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentInstallKeys,
+		ReadContext: readManagementAgentManagementAgentInstallKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -48,16 +49,16 @@ func ManagementAgentManagementAgentInstallKeysDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentInstallKeys(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentInstallKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeysDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.ListManagementAgentInstallKeysResponse
 }
@@ -93,9 +94,9 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 		request.LifecycleState = oci_management_agent.ListManagementAgentInstallKeysLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+	response, err := s.Client.ListManagementAgentInstallKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentInstallKeys(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentPluginCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentPluginCount,
+		ReadContext: readSingularManagementAgentManagementAgentPluginCount,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -68,16 +69,16 @@ func ManagementAgentManagementAgentPluginCountDataSource() *schema.Resource {
 	}
 }
 
-func readSingularManagementAgentManagementAgentPluginCount(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentPluginCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentPluginCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentPluginCountDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.SummarizeManagementAgentPluginCountsResponse
 }
@@ -98,9 +99,9 @@ func (s *ManagementAgentManagementAgentPluginCountDataSourceCrud) Get() error {
 		request.GroupBy = oci_management_agent.SummarizeManagementAgentPluginCountsGroupByEnum(groupBy.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.SummarizeManagementAgentPluginCounts(context.Background(), request)
+	response, err := s.Client.SummarizeManagementAgentPluginCounts(s.Context(), request)
 	if err != nil {
 		return err
 	}
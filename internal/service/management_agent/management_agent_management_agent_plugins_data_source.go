@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentPluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentPlugins,
+		ReadContext: readManagementAgentManagementAgentPlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -89,16 +90,16 @@ func ManagementAgentManagementAgentPluginsDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentPlugins(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentPlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentPluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentPluginsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.ListManagementAgentPluginsResponse
 }
@@ -137,9 +138,9 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_management_agent.ListManagementAgentPluginsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListManagementAgentPlugins(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentPlugins(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func ManagementAgentManagementAgentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementAgentManagementAgent,
-		Read:     readManagementAgentManagementAgent,
-		Update:   updateManagementAgentManagementAgent,
-		Delete:   deleteManagementAgentManagementAgent,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementAgentManagementAgent,
+		ReadContext:   readManagementAgentManagementAgent,
+		UpdateContext: updateManagementAgentManagementAgent,
+		DeleteContext: deleteManagementAgentManagementAgent,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"managed_agent_id": {
@@ -179,37 +180,37 @@ func ManagementAgentManagementAgentResource() *schema.Resource {
 	}
 }
 
-func createManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func createManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func updateManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func deleteManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ManagementAgentManagementAgentResourceCrud struct {
@@ -252,13 +253,13 @@ func (s *ManagementAgentManagementAgentResourceCrud) getManagementAgentFromWorkR
 	actionTypeEnum oci_management_agent.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	managementAgentId, err := managementAgentWaitForWorkRequest(workId, "managementagent",
+	managementAgentId, err := managementAgentWaitForWorkRequest(s.Context(), workId, "managementagent",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, managementAgentId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_management_agent.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -298,9 +299,9 @@ func managementAgentWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func managementAgentWaitForWorkRequest(wId *string, entityType string, action oci_management_agent.ActionTypesEnum,
+func managementAgentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_management_agent.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_management_agent.ManagementAgentClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "management_agent")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "management_agent")
 	retryPolicy.ShouldRetryOperation = managementAgentWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_management_agent.GetWorkRequestResponse{}
@@ -317,7 +318,7 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_management_agent.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -329,7 +330,7 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -346,14 +347,14 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_management_agent.OperationStatusFailed || response.Status == oci_management_agent.OperationStatusCanceled {
-		return nil, getErrorFromManagementAgentManagementAgentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromManagementAgentManagementAgentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromManagementAgentManagementAgentWorkRequest(client *oci_management_agent.ManagementAgentClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_management_agent.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromManagementAgentManagementAgentWorkRequest(ctx context.Context, client *oci_management_agent.ManagementAgentClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_management_agent.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_management_agent.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -385,9 +386,9 @@ func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
 
 	managedInstanceId := s.D.Id() // For import case
 	request.ManagementAgentId = &managedInstanceId
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -424,9 +425,9 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 	agentId := s.D.Id()
 	request.ManagementAgentId = &agentId
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgent(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -457,9 +458,9 @@ func (s *ManagementAgentManagementAgentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagementAgentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgent(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgent(s.Context(), request)
 	return err
 }
 
@@ -597,15 +598,15 @@ func (s *ManagementAgentManagementAgentResourceCrud) deployPlugin(pluginIds []st
 	request.AgentCompartmentId = &compartmentId
 	request.PluginIds = pluginIds
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.DeployPlugins(context.Background(), request)
+	response, err := s.Client.DeployPlugins(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workRequestId := response.OpcWorkRequestId
 
-	_, workRequestErr := managementAgentWaitForWorkRequest(workRequestId, "managementagent",
+	_, workRequestErr := managementAgentWaitForWorkRequest(s.Context(), workRequestId, "managementagent",
 		oci_management_agent.ActionTypesUpdated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return workRequestErr
 }
@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v61/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgents,
+		ReadContext: readManagementAgentManagementAgents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_status": {
@@ -76,16 +77,16 @@ func ManagementAgentManagementAgentsDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgents(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementAgentManagementAgentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_agent.ManagementAgentClient
 	Res    *oci_management_agent.ListManagementAgentsResponse
 }
@@ -168,9 +169,9 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_agent")
 
-	response, err := s.Client.ListManagementAgents(context.Background(), request)
+	response, err := s.Client.ListManagementAgents(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -179,7 +180,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgents(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgents(s.Context(), request)
 		if err != nil {
 			return err
 		}
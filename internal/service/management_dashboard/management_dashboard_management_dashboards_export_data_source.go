@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_dashboard "github.com/oracle/oci-go-sdk/v61/managementdashboard"
 
@@ -17,7 +18,7 @@ import (
 
 func ManagementDashboardManagementDashboardsExportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementDashboardManagementDashboardsExport,
+		ReadContext: readSingularManagementDashboardManagementDashboardsExport,
 		Schema: map[string]*schema.Schema{
 			"export_dashboard_id": {
 				Type:     schema.TypeString,
@@ -32,16 +33,16 @@ func ManagementDashboardManagementDashboardsExportDataSource() *schema.Resource
 	}
 }
 
-func readSingularManagementDashboardManagementDashboardsExport(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementDashboardManagementDashboardsExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementDashboardManagementDashboardsExportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DashxApisClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ManagementDashboardManagementDashboardsExportDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_management_dashboard.DashxApisClient
 	Res    *oci_management_dashboard.ExportDashboardResponse
 }
@@ -58,9 +59,9 @@ func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) Get() erro
 		request.ExportDashboardId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_dashboard")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "management_dashboard")
 
-	response, err := s.Client.ExportDashboard(context.Background(), request)
+	response, err := s.Client.ExportDashboard(s.Context(), request)
 	if err != nil {
 		return err
 	}
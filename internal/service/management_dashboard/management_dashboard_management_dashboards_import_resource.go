@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_management_dashboard "github.com/oracle/oci-go-sdk/v61/managementdashboard"
@@ -22,10 +23,10 @@ func ManagementDashboardManagementDashboardsImportResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementDashboardManagementDashboardsImport,
-		Read:     readManagementDashboardManagementDashboardsImport,
-		Delete:   deleteManagementDashboardManagementDashboardsImport,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementDashboardManagementDashboardsImport,
+		ReadContext:   readManagementDashboardManagementDashboardsImport,
+		DeleteContext: deleteManagementDashboardManagementDashboardsImport,
 		Schema: map[string]*schema.Schema{
 			// Optional
 			"import_details_file": {
@@ -44,19 +45,19 @@ func ManagementDashboardManagementDashboardsImportResource() *schema.Resource {
 	}
 }
 
-func createManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func createManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementDashboardManagementDashboardsImportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DashxApisClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func readManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func deleteManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -103,9 +104,10 @@ func (s *ManagementDashboardManagementDashboardsImportResourceCrud) Create() err
 		return fmt.Errorf("Either import_details or import_details_file must be provided")
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_dashboard")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "management_dashboard")
 
-	_, err := s.Client.ImportDashboard(context.Background(), request)
+	_, err := s.Client.ImportDashboard(s.Context(), request)
 	if err != nil {
 		return err
 	}
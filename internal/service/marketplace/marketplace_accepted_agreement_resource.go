@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
@@ -19,11 +20,11 @@ func MarketplaceAcceptedAgreementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMarketplaceAcceptedAgreement,
-		Read:     readMarketplaceAcceptedAgreement,
-		Update:   updateMarketplaceAcceptedAgreement,
-		Delete:   deleteMarketplaceAcceptedAgreement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMarketplaceAcceptedAgreement,
+		ReadContext:   readMarketplaceAcceptedAgreement,
+		UpdateContext: updateMarketplaceAcceptedAgreement,
+		DeleteContext: deleteMarketplaceAcceptedAgreement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agreement_id": {
@@ -81,37 +82,37 @@ func MarketplaceAcceptedAgreementResource() *schema.Resource {
 	}
 }
 
-func createMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func createMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func updateMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MarketplaceAcceptedAgreementResourceCrud struct {
@@ -170,9 +171,10 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Create() error {
 		request.Signature = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.CreateAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,9 +189,9 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AcceptedAgreementId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.GetAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -221,9 +223,9 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.UpdateAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -243,9 +245,9 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Delete() error {
 		request.Signature = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeleteAcceptedAgreement(context.Background(), request)
+	_, err := s.Client.DeleteAcceptedAgreement(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceAcceptedAgreementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceAcceptedAgreements,
+		ReadContext: readMarketplaceAcceptedAgreements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"accepted_agreement_id": {
@@ -47,16 +48,16 @@ func MarketplaceAcceptedAgreementsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceAcceptedAgreements(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceAcceptedAgreements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceAcceptedAgreementsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListAcceptedAgreementsResponse
 }
@@ -93,9 +94,9 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 		request.PackageVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+	response, err := s.Client.ListAcceptedAgreements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAcceptedAgreements(s.Context(), request)
 		if err != nil {
 			return err
 		}
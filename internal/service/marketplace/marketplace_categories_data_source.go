@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceCategoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceCategories,
+		ReadContext: readMarketplaceCategories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func MarketplaceCategoriesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceCategories(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceCategories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceCategoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceCategoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListCategoriesResponse
 }
@@ -69,9 +70,9 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplaceListing,
+		ReadContext: readSingularMarketplaceListing,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -481,16 +482,16 @@ func MarketplaceListingDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplaceListing(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplaceListing(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.GetListingResponse
 }
@@ -512,9 +513,9 @@ func (s *MarketplaceListingDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.GetListing(context.Background(), request)
+	response, err := s.Client.GetListing(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,7 @@ package marketplace
 
 import (
 	"context"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,9 +15,9 @@ import (
 
 func MarketplaceListingPackageAgreementResource() *schema.Resource {
 	return &schema.Resource{
-		Create: createMarketplaceListingPackageAgreement,
-		Read:   readMarketplaceListingPackageAgreement,
-		Delete: deleteMarketplaceListingPackageAgreement,
+		CreateContext: createMarketplaceListingPackageAgreement,
+		ReadContext:   readMarketplaceListingPackageAgreement,
+		DeleteContext: deleteMarketplaceListingPackageAgreement,
 		Schema: map[string]*schema.Schema{
 			"agreement_id": {
 				Type:     schema.TypeString,
@@ -62,25 +62,25 @@ func MarketplaceListingPackageAgreementResource() *schema.Resource {
 	}
 }
 
-func createMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func createMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MarketplaceListingPackageAgreementResourceCrud struct {
@@ -117,9 +117,9 @@ func (s *MarketplaceListingPackageAgreementResourceCrud) Create() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.GetAgreement(context.Background(), request)
+	response, err := s.Client.GetAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingPackageAgreementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingPackageAgreements,
+		ReadContext: readMarketplaceListingPackageAgreements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -63,16 +64,16 @@ func MarketplaceListingPackageAgreementsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingPackageAgreements(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackageAgreements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingPackageAgreementsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListAgreementsResponse
 }
@@ -99,9 +100,9 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 		request.PackageVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListAgreements(context.Background(), request)
+	response, err := s.Client.ListAgreements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAgreements(s.Context(), request)
 		if err != nil {
 			return err
 		}
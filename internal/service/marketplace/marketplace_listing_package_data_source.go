@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplaceListingPackage,
+		ReadContext: readSingularMarketplaceListingPackage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -196,16 +197,16 @@ func MarketplaceListingPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplaceListingPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplaceListingPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingPackageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListingPackage
 }
@@ -232,9 +233,9 @@ func (s *MarketplaceListingPackageDataSourceCrud) Get() error {
 		request.PackageVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.GetPackage(context.Background(), request)
+	response, err := s.Client.GetPackage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingPackages,
+		ReadContext: readMarketplaceListingPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -136,16 +137,16 @@ func MarketplaceListingPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingPackages(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingPackagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListPackagesResponse
 }
@@ -177,9 +178,9 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 		request.PackageVersion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListPackages(context.Background(), request)
+	response, err := s.Client.ListPackages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -188,7 +189,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPackages(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingTaxesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingTaxes,
+		ReadContext: readMarketplaceListingTaxes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func MarketplaceListingTaxesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingTaxes(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingTaxes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingTaxesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingTaxesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListTaxesResponse
 }
@@ -90,9 +91,9 @@ func (s *MarketplaceListingTaxesDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListTaxes(context.Background(), request)
+	response, err := s.Client.ListTaxes(s.Context(), request)
 	if err != nil {
 		return err
 	}
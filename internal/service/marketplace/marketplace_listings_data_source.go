@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListings,
+		ReadContext: readMarketplaceListings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"category": {
@@ -245,16 +246,16 @@ func MarketplaceListingsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListings(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplaceListingsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListListingsResponse
 }
@@ -361,9 +362,9 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 		request.PublisherId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListListings(context.Background(), request)
+	response, err := s.Client.ListListings(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +373,7 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListListings(context.Background(), request)
+		listResponse, err := s.Client.ListListings(s.Context(), request)
 		if err != nil {
 			return err
 		}
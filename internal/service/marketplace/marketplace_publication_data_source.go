@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -22,16 +23,16 @@ func MarketplacePublicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MarketplacePublicationResource(), fieldMap, readSingularMarketplacePublication)
 }
 
-func readSingularMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplacePublicationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.GetPublicationResponse
 }
@@ -48,9 +49,9 @@ func (s *MarketplacePublicationDataSourceCrud) Get() error {
 		request.PublicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.GetPublication(context.Background(), request)
+	response, err := s.Client.GetPublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplacePublicationPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplacePublicationPackage,
+		ReadContext: readSingularMarketplacePublicationPackage,
 		Schema: map[string]*schema.Schema{
 			"package_version": {
 				Type:     schema.TypeString,
@@ -125,16 +126,16 @@ func MarketplacePublicationPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplacePublicationPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplacePublicationPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplacePublicationPackageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.GetPublicationPackageResponse
 }
@@ -156,9 +157,9 @@ func (s *MarketplacePublicationPackageDataSourceCrud) Get() error {
 		request.PublicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.GetPublicationPackage(context.Background(), request)
+	response, err := s.Client.GetPublicationPackage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublicationPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublicationPackages,
+		ReadContext: readMarketplacePublicationPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"package_type": {
@@ -67,16 +68,16 @@ func MarketplacePublicationPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublicationPackages(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublicationPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplacePublicationPackagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListPublicationPackagesResponse
 }
@@ -103,9 +104,9 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 		request.PublicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListPublicationPackages(context.Background(), request)
+	response, err := s.Client.ListPublicationPackages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPublicationPackages(s.Context(), request)
 		if err != nil {
 			return err
 		}
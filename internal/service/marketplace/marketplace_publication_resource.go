@@ -10,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MarketplacePublicationResource() *schema.Resource {
 			Update: &tfresource.TwentyMinutes,
 			Delete: &tfresource.TwentyMinutes,
 		},
-		Create: createMarketplacePublication,
-		Read:   readMarketplacePublication,
-		Update: updateMarketplacePublication,
-		Delete: deleteMarketplacePublication,
+		CreateContext: createMarketplacePublication,
+		ReadContext:   readMarketplacePublication,
+		UpdateContext: updateMarketplacePublication,
+		DeleteContext: deleteMarketplacePublication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -264,37 +265,37 @@ func MarketplacePublicationResource() *schema.Resource {
 	}
 }
 
-func createMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func createMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func updateMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MarketplacePublicationResourceCrud struct {
@@ -405,9 +406,10 @@ func (s *MarketplacePublicationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreatePublication(context.Background(), request)
+	response, err := s.Client.CreatePublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -422,9 +424,9 @@ func (s *MarketplacePublicationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PublicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetPublication(context.Background(), request)
+	response, err := s.Client.GetPublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -493,9 +495,9 @@ func (s *MarketplacePublicationResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdatePublication(context.Background(), request)
+	response, err := s.Client.UpdatePublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -510,9 +512,9 @@ func (s *MarketplacePublicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeletePublication(context.Background(), request)
+	_, err := s.Client.DeletePublication(s.Context(), request)
 	return err
 }
 
@@ -801,14 +803,14 @@ func (s *MarketplacePublicationResourceCrud) updateCompartment(compartment inter
 	idTmp := s.D.Id()
 	changeCompartmentRequest.PublicationId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.ChangePublicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
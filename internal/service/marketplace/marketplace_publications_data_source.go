@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublicationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublications,
+		ReadContext: readMarketplacePublications,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -53,16 +54,16 @@ func MarketplacePublicationsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublications(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublications(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplacePublicationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListPublicationsResponse
 }
@@ -114,9 +115,9 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 		request.PublicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListPublications(context.Background(), request)
+	response, err := s.Client.ListPublications(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublications(context.Background(), request)
+		listResponse, err := s.Client.ListPublications(s.Context(), request)
 		if err != nil {
 			return err
 		}
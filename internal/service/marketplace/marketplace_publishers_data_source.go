@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v61/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublishersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublishers,
+		ReadContext: readMarketplacePublishers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func MarketplacePublishersDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublishers(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublishers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublishersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MarketplacePublishersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_marketplace.MarketplaceClient
 	Res    *oci_marketplace.ListPublishersResponse
 }
@@ -86,9 +87,9 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 		request.PublisherId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "marketplace")
 
-	response, err := s.Client.ListPublishers(context.Background(), request)
+	response, err := s.Client.ListPublishers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublishers(context.Background(), request)
+		listResponse, err := s.Client.ListPublishers(s.Context(), request)
 		if err != nil {
 			return err
 		}
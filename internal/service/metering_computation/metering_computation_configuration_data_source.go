@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v61/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationConfigurationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMeteringComputationConfiguration,
+		ReadContext: readSingularMeteringComputationConfiguration,
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Type:     schema.TypeString,
@@ -50,16 +51,16 @@ func MeteringComputationConfigurationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMeteringComputationConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MeteringComputationConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_metering_computation.UsageapiClient
 	Res    *oci_metering_computation.RequestSummarizedConfigurationsResponse
 }
@@ -76,9 +77,9 @@ func (s *MeteringComputationConfigurationDataSourceCrud) Get() error {
 		request.TenantId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedConfigurations(context.Background(), request)
+	response, err := s.Client.RequestSummarizedConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v61/usageapi"
@@ -20,11 +21,11 @@ func MeteringComputationCustomTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationCustomTable,
-		Read:     readMeteringComputationCustomTable,
-		Update:   updateMeteringComputationCustomTable,
-		Delete:   deleteMeteringComputationCustomTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationCustomTable,
+		ReadContext:   readMeteringComputationCustomTable,
+		UpdateContext: updateMeteringComputationCustomTable,
+		DeleteContext: deleteMeteringComputationCustomTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -119,37 +120,37 @@ func MeteringComputationCustomTableResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func updateMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MeteringComputationCustomTableResourceCrud struct {
@@ -187,9 +188,10 @@ func (s *MeteringComputationCustomTableResourceCrud) Create() error {
 		request.SavedReportId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateCustomTable(context.Background(), request)
+	response, err := s.Client.CreateCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,9 +206,9 @@ func (s *MeteringComputationCustomTableResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CustomTableId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetCustomTable(context.Background(), request)
+	response, err := s.Client.GetCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -232,9 +234,9 @@ func (s *MeteringComputationCustomTableResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateCustomTable(context.Background(), request)
+	response, err := s.Client.UpdateCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,9 +251,9 @@ func (s *MeteringComputationCustomTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CustomTableId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteCustomTable(context.Background(), request)
+	_, err := s.Client.DeleteCustomTable(s.Context(), request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v61/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationCustomTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMeteringComputationCustomTables,
+		ReadContext: readMeteringComputationCustomTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,16 +45,16 @@ func MeteringComputationCustomTablesDataSource() *schema.Resource {
 	}
 }
 
-func readMeteringComputationCustomTables(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationCustomTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MeteringComputationCustomTablesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_metering_computation.UsageapiClient
 	Res    *oci_metering_computation.ListCustomTablesResponse
 }
@@ -75,9 +76,9 @@ func (s *MeteringComputationCustomTablesDataSourceCrud) Get() error {
 		request.SavedReportId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "metering_computation")
 
-	response, err := s.Client.ListCustomTables(context.Background(), request)
+	response, err := s.Client.ListCustomTables(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *MeteringComputationCustomTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCustomTables(context.Background(), request)
+		listResponse, err := s.Client.ListCustomTables(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v61/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationQueriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMeteringComputationQueries,
+		ReadContext: readMeteringComputationQueries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,16 +41,16 @@ func MeteringComputationQueriesDataSource() *schema.Resource {
 	}
 }
 
-func readMeteringComputationQueries(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationQueries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MeteringComputationQueriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_metering_computation.UsageapiClient
 	Res    *oci_metering_computation.ListQueriesResponse
 }
@@ -66,9 +67,9 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "metering_computation")
 
-	response, err := s.Client.ListQueries(context.Background(), request)
+	response, err := s.Client.ListQueries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQueries(context.Background(), request)
+		listResponse, err := s.Client.ListQueries(s.Context(), request)
 		if err != nil {
 			return err
 		}
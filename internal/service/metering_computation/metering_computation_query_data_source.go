@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v61/usageapi"
 
@@ -22,16 +23,16 @@ func MeteringComputationQueryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MeteringComputationQueryResource(), fieldMap, readSingularMeteringComputationQuery)
 }
 
-func readSingularMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MeteringComputationQueryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_metering_computation.UsageapiClient
 	Res    *oci_metering_computation.GetQueryResponse
 }
@@ -48,9 +49,9 @@ func (s *MeteringComputationQueryDataSourceCrud) Get() error {
 		request.QueryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "metering_computation")
 
-	response, err := s.Client.GetQuery(context.Background(), request)
+	response, err := s.Client.GetQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
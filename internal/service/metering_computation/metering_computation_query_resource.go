@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -23,11 +24,11 @@ func MeteringComputationQueryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationQuery,
-		Read:     readMeteringComputationQuery,
-		Update:   updateMeteringComputationQuery,
-		Delete:   deleteMeteringComputationQuery,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationQuery,
+		ReadContext:   readMeteringComputationQuery,
+		UpdateContext: updateMeteringComputationQuery,
+		DeleteContext: deleteMeteringComputationQuery,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -220,37 +221,37 @@ func MeteringComputationQueryResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func updateMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MeteringComputationQueryResourceCrud struct {
@@ -283,9 +284,10 @@ func (s *MeteringComputationQueryResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateQuery(context.Background(), request)
+	response, err := s.Client.CreateQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -300,9 +302,9 @@ func (s *MeteringComputationQueryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.QueryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetQuery(context.Background(), request)
+	response, err := s.Client.GetQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,9 +330,9 @@ func (s *MeteringComputationQueryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.QueryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateQuery(context.Background(), request)
+	response, err := s.Client.UpdateQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,9 +347,9 @@ func (s *MeteringComputationQueryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.QueryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteQuery(context.Background(), request)
+	_, err := s.Client.DeleteQuery(s.Context(), request)
 	return err
 }
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -21,10 +22,10 @@ import (
 
 func MeteringComputationUsageResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationUsage,
-		Read:     readMeteringComputationUsage,
-		Delete:   deleteMeteringComputationUsage,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationUsage,
+		ReadContext:   readMeteringComputationUsage,
+		DeleteContext: deleteMeteringComputationUsage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"granularity": {
@@ -309,19 +310,19 @@ func MeteringComputationUsageResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationUsageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -429,9 +430,9 @@ func (s *MeteringComputationUsageResourceCrud) Create() error {
 		request.TimeUsageStarted = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedUsages(context.Background(), request)
+	response, err := s.Client.RequestSummarizedUsages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
 )
@@ -22,16 +23,16 @@ func MonitoringAlarmDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MonitoringAlarmResource(), fieldMap, readSingularMonitoringAlarm)
 }
 
-func readSingularMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func readSingularMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringAlarmDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.GetAlarmResponse
 }
@@ -48,9 +49,9 @@ func (s *MonitoringAlarmDataSourceCrud) Get() error {
 		request.AlarmId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
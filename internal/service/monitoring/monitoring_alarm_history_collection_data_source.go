@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
@@ -17,7 +18,7 @@ import (
 
 func MonitoringAlarmHistoryCollectionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMonitoringAlarmHistoryCollection,
+		ReadContext: readSingularMonitoringAlarmHistoryCollection,
 		Schema: map[string]*schema.Schema{
 			"alarm_historytype": {
 				Type:     schema.TypeString,
@@ -69,16 +70,16 @@ func MonitoringAlarmHistoryCollectionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMonitoringAlarmHistoryCollection(d *schema.ResourceData, m interface{}) error {
+func readSingularMonitoringAlarmHistoryCollection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmHistoryCollectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringAlarmHistoryCollectionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.GetAlarmHistoryResponse
 }
@@ -115,9 +116,9 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
 		request.TimestampLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.GetAlarmHistory(context.Background(), request)
+	response, err := s.Client.GetAlarmHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -22,11 +23,11 @@ func MonitoringAlarmResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMonitoringAlarm,
-		Read:     readMonitoringAlarm,
-		Update:   updateMonitoringAlarm,
-		Delete:   deleteMonitoringAlarm,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMonitoringAlarm,
+		ReadContext:   readMonitoringAlarm,
+		UpdateContext: updateMonitoringAlarm,
+		DeleteContext: deleteMonitoringAlarm,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -163,37 +164,37 @@ func MonitoringAlarmResource() *schema.Resource {
 	}
 }
 
-func createMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func createMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func updateMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func deleteMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MonitoringAlarmResourceCrud struct {
@@ -336,9 +337,10 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.CreateAlarm(context.Background(), request)
+	response, err := s.Client.CreateAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -353,9 +355,9 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AlarmId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -483,9 +485,9 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.UpdateAlarm(context.Background(), request)
+	response, err := s.Client.UpdateAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -500,9 +502,9 @@ func (s *MonitoringAlarmResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AlarmId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.DeleteAlarm(context.Background(), request)
+	_, err := s.Client.DeleteAlarm(s.Context(), request)
 	return err
 }
 
@@ -640,14 +642,14 @@ func (s *MonitoringAlarmResourceCrud) updateCompartment(compartment interface{})
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.ChangeAlarmCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAlarmCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
 )
 
 func MonitoringAlarmStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringAlarmStatuses,
+		ReadContext: readMonitoringAlarmStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -92,16 +93,16 @@ func MonitoringAlarmStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringAlarmStatuses(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarmStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringAlarmStatusesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.ListAlarmsStatusResponse
 }
@@ -128,9 +129,9 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.ListAlarmsStatus(context.Background(), request)
+	response, err := s.Client.ListAlarmsStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -139,7 +140,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarmsStatus(context.Background(), request)
+		listResponse, err := s.Client.ListAlarmsStatus(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
 )
 
 func MonitoringAlarmsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringAlarms,
+		ReadContext: readMonitoringAlarms,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func MonitoringAlarmsDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringAlarms(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarms(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringAlarmsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.ListAlarmsResponse
 }
@@ -83,9 +84,9 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_monitoring.AlarmLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.ListAlarms(context.Background(), request)
+	response, err := s.Client.ListAlarms(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarms(context.Background(), request)
+		listResponse, err := s.Client.ListAlarms(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
@@ -17,7 +18,7 @@ import (
 
 func MonitoringMetricDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringMetricData,
+		ReadContext: readMonitoringMetricData,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -143,16 +144,16 @@ func MonitoringMetricDataDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+func readMonitoringMetricData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringMetricDataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringMetricDataDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.SummarizeMetricsDataResponse
 }
@@ -210,9 +211,9 @@ func (s *MonitoringMetricDataDataSourceCrud) Get() error {
 		request.StartTime = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.SummarizeMetricsData(context.Background(), request)
+	response, err := s.Client.SummarizeMetricsData(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v61/monitoring"
 )
 
 func MonitoringMetricsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringMetrics,
+		ReadContext: readMonitoringMetrics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -110,16 +111,16 @@ func MonitoringMetricsDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringMetrics(d *schema.ResourceData, m interface{}) error {
+func readMonitoringMetrics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringMetricsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MonitoringMetricsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_monitoring.MonitoringClient
 	Res    *oci_monitoring.ListMetricsResponse
 }
@@ -173,9 +174,9 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 		request.ResourceGroup = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "monitoring")
 
-	response, err := s.Client.ListMetrics(context.Background(), request)
+	response, err := s.Client.ListMetrics(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +185,7 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMetrics(context.Background(), request)
+		listResponse, err := s.Client.ListMetrics(s.Context(), request)
 		if err != nil {
 			return err
 		}
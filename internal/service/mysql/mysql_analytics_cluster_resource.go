@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MysqlAnalyticsClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlAnalyticsCluster,
-		Read:   readMysqlAnalyticsCluster,
-		Update: updateMysqlAnalyticsCluster,
-		Delete: deleteMysqlAnalyticsCluster,
+		CreateContext: createMysqlAnalyticsCluster,
+		ReadContext:   readMysqlAnalyticsCluster,
+		UpdateContext: updateMysqlAnalyticsCluster,
+		DeleteContext: deleteMysqlAnalyticsCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -108,9 +109,10 @@ func MysqlAnalyticsClusterResource() *schema.Resource {
 	}
 }
 
-func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func createMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	var powerOff = false
 	if powerState, ok := sync.D.GetOkExists("state"); ok {
@@ -120,13 +122,13 @@ func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if powerOff {
 		if err := sync.StopAnalyticsCluster(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateInactive)
 	}
@@ -134,17 +136,18 @@ func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func readMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func readMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func updateMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
 	powerOn, powerOff := false, false
@@ -160,18 +163,18 @@ func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 
 	if powerOn {
 		if err := sync.StartAnalyticsCluster(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if powerOff {
 		if err := sync.StopAnalyticsCluster(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateInactive)
 	}
@@ -179,13 +182,13 @@ func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MysqlAnalyticsClusterResourceCrud struct {
@@ -253,9 +256,10 @@ func (s *MysqlAnalyticsClusterResourceCrud) Create() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.AddAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -279,9 +283,9 @@ func (s *MysqlAnalyticsClusterResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.GetAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -308,9 +312,9 @@ func (s *MysqlAnalyticsClusterResourceCrud) Update() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.UpdateAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,9 +330,9 @@ func (s *MysqlAnalyticsClusterResourceCrud) Delete() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.DeleteAnalyticsCluster(s.Context(), request)
 	return err
 }
 
@@ -402,15 +406,15 @@ func (s *MysqlAnalyticsClusterResourceCrud) StartAnalyticsCluster() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StartAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.AnalyticsClusterLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster() error {
@@ -421,15 +425,15 @@ func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StopAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.AnalyticsClusterLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func AnalyticsClusterNodeToMap(obj oci_mysql.AnalyticsClusterNode) map[string]interface{} {
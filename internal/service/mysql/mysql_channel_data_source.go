@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 )
@@ -22,16 +23,16 @@ func MysqlChannelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlChannelResource(), fieldMap, readSingularMysqlChannel)
 }
 
-func readSingularMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlChannelDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.ChannelsClient
 	Res    *oci_mysql.GetChannelResponse
 }
@@ -48,9 +49,9 @@ func (s *MysqlChannelDataSourceCrud) Get() error {
 		request.ChannelId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
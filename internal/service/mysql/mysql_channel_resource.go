@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,10 +29,10 @@ func MysqlChannelResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createMysqlChannel,
-		Read:   readMysqlChannel,
-		Update: updateMysqlChannel,
-		Delete: deleteMysqlChannel,
+		CreateContext: createMysqlChannel,
+		ReadContext:   readMysqlChannel,
+		UpdateContext: updateMysqlChannel,
+		DeleteContext: deleteMysqlChannel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"source": {
@@ -203,37 +204,37 @@ func MysqlChannelResource() *schema.Resource {
 	}
 }
 
-func createMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func createMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func readMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func updateMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MysqlChannelResourceCrud struct {
@@ -344,9 +345,10 @@ func (s *MysqlChannelResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateChannel(context.Background(), request)
+	response, err := s.Client.CreateChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -361,9 +363,9 @@ func (s *MysqlChannelResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ChannelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -427,9 +429,9 @@ func (s *MysqlChannelResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateChannel(context.Background(), request)
+	_, err := s.Client.UpdateChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,9 +445,9 @@ func (s *MysqlChannelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ChannelId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteChannel(context.Background(), request)
+	_, err := s.Client.DeleteChannel(s.Context(), request)
 	return err
 }
 
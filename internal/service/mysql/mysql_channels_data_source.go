@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 )
 
 func MysqlChannelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlChannels,
+		ReadContext: readMysqlChannels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"channel_id": {
@@ -51,16 +52,16 @@ func MysqlChannelsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlChannels(d *schema.ResourceData, m interface{}) error {
+func readMysqlChannels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlChannelsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.ChannelsClient
 	Res    *oci_mysql.ListChannelsResponse
 }
@@ -101,9 +102,9 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_mysql.ChannelLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListChannels(context.Background(), request)
+	response, err := s.Client.ListChannels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListChannels(context.Background(), request)
+		listResponse, err := s.Client.ListChannels(s.Context(), request)
 		if err != nil {
 			return err
 		}
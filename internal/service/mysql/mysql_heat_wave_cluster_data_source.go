@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -22,16 +23,16 @@ func MysqlHeatWaveClusterDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlHeatWaveClusterResource(), fieldMap, readSingularMysqlHeatWaveCluster)
 }
 
-func readSingularMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlHeatWaveClusterDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.DbSystemClient
 	Res    *oci_mysql.GetHeatWaveClusterResponse
 }
@@ -48,9 +49,9 @@ func (s *MysqlHeatWaveClusterDataSourceCrud) Get() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.GetHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.GetHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
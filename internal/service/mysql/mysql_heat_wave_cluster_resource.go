@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MysqlHeatWaveClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlHeatWaveCluster,
-		Read:   readMysqlHeatWaveCluster,
-		Update: updateMysqlHeatWaveCluster,
-		Delete: deleteMysqlHeatWaveCluster,
+		CreateContext: createMysqlHeatWaveCluster,
+		ReadContext:   readMysqlHeatWaveCluster,
+		UpdateContext: updateMysqlHeatWaveCluster,
+		DeleteContext: deleteMysqlHeatWaveCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -108,9 +109,10 @@ func MysqlHeatWaveClusterResource() *schema.Resource {
 	}
 }
 
-func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func createMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
 	var powerOff = false
@@ -121,14 +123,14 @@ func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	// switch to power off
 	if powerOff {
 		if err := sync.Stop(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateInactive)
 	}
@@ -136,17 +138,18 @@ func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func readMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func updateMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
 	powerOn, powerOff := false, false
@@ -163,19 +166,19 @@ func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 	// switch to power on
 	if powerOn {
 		if err := sync.Start(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	// switch to power off
 	if powerOff {
 		if err := sync.Stop(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateInactive)
 	}
@@ -183,13 +186,13 @@ func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MysqlHeatWaveClusterResourceCrud struct {
@@ -257,9 +260,10 @@ func (s *MysqlHeatWaveClusterResourceCrud) Create() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.AddHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,9 +287,9 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.GetHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,9 +316,9 @@ func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
 		request.ShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.UpdateHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -330,9 +334,9 @@ func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.DeleteHeatWaveCluster(s.Context(), request)
 	return err
 }
 
@@ -426,15 +430,15 @@ func (s *MysqlHeatWaveClusterResourceCrud) Stop() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StopHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
@@ -445,13 +449,13 @@ func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StartHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
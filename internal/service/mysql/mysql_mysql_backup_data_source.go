@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -22,16 +23,16 @@ func MysqlMysqlBackupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlMysqlBackupResource(), fieldMap, readSingularMysqlMysqlBackup)
 }
 
-func readSingularMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlBackupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.DbBackupsClient
 	Res    *oci_mysql.GetBackupResponse
 }
@@ -48,9 +49,9 @@ func (s *MysqlMysqlBackupDataSourceCrud) Get() error {
 		request.BackupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func MysqlMysqlBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMysqlMysqlBackup,
-		Read:     readMysqlMysqlBackup,
-		Update:   updateMysqlMysqlBackup,
-		Delete:   deleteMysqlMysqlBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMysqlMysqlBackup,
+		ReadContext:   readMysqlMysqlBackup,
+		UpdateContext: updateMysqlMysqlBackup,
+		DeleteContext: deleteMysqlMysqlBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -306,37 +307,37 @@ func MysqlMysqlBackupResource() *schema.Resource {
 	}
 }
 
-func createMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func createMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func updateMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MysqlMysqlBackupResourceCrud struct {
@@ -425,9 +426,10 @@ func (s *MysqlMysqlBackupResourceCrud) Create() error {
 		request.RetentionInDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -442,9 +444,9 @@ func (s *MysqlMysqlBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -495,9 +497,9 @@ func (s *MysqlMysqlBackupResourceCrud) Update() error {
 		request.RetentionInDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateBackup(context.Background(), request)
+	_, err := s.Client.UpdateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -511,9 +513,9 @@ func (s *MysqlMysqlBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(s.Context(), request)
 	return err
 }
 
@@ -756,14 +758,14 @@ func (s *MysqlMysqlBackupResourceCrud) updateCompartment(compartment interface{}
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.ChangeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
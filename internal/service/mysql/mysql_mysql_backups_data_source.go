@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlBackups,
+		ReadContext: readMysqlMysqlBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backup_id": {
@@ -51,16 +52,16 @@ func MysqlMysqlBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlBackups(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlBackupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.DbBackupsClient
 	Res    *oci_mysql.ListBackupsResponse
 }
@@ -100,9 +101,9 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_mysql.BackupLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBackups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -16,7 +17,7 @@ import (
 
 func MysqlMysqlConfigurationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMysqlMysqlConfiguration,
+		ReadContext: readSingularMysqlMysqlConfiguration,
 		Schema: map[string]*schema.Schema{
 			"configuration_id": {
 				Type:     schema.TypeString,
@@ -358,16 +359,16 @@ func MysqlMysqlConfigurationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlConfigurationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.MysqlaasClient
 	Res    *oci_mysql.GetConfigurationResponse
 }
@@ -384,9 +385,9 @@ func (s *MysqlMysqlConfigurationDataSourceCrud) Get() error {
 		request.ConfigurationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
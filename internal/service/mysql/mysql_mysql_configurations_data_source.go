@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlConfigurations,
+		ReadContext: readMysqlMysqlConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -406,16 +407,16 @@ func MysqlMysqlConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlConfigurations(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlConfigurationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.MysqlaasClient
 	Res    *oci_mysql.ListConfigurationsResponse
 }
@@ -464,9 +465,9 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListConfigurations(context.Background(), request)
+	response, err := s.Client.ListConfigurations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -475,7 +476,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListConfigurations(s.Context(), request)
 		if err != nil {
 			return err
 		}
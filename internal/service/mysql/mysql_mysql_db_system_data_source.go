@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 )
@@ -22,16 +23,16 @@ func MysqlMysqlDbSystemDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlMysqlDbSystemResource(), fieldMap, readSingularMysqlMysqlDbSystem)
 }
 
-func readSingularMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlDbSystemDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.DbSystemClient
 	Res    *oci_mysql.GetDbSystemResponse
 }
@@ -48,9 +49,9 @@ func (s *MysqlMysqlDbSystemDataSourceCrud) Get() error {
 		request.DbSystemId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
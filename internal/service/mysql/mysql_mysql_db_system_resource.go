@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,10 +29,10 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlMysqlDbSystem,
-		Read:   readMysqlMysqlDbSystem,
-		Update: updateMysqlMysqlDbSystem,
-		Delete: deleteMysqlMysqlDbSystem,
+		CreateContext: createMysqlMysqlDbSystem,
+		ReadContext:   readMysqlMysqlDbSystem,
+		UpdateContext: updateMysqlMysqlDbSystem,
+		DeleteContext: deleteMysqlMysqlDbSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -565,9 +566,10 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 	}
 }
 
-func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func createMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
 	var powerOff = false
@@ -578,13 +580,13 @@ func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if powerOff {
 		if err := sync.StopMysqlDbInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateInactive)
 	}
@@ -592,17 +594,18 @@ func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func updateMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
 	// switch to power on
@@ -619,19 +622,19 @@ func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 
 	if powerOn {
 		if err := sync.StartMysqlDbInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	// switch to power off
 	if powerOff {
 		if err := sync.StopMysqlDbInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateInactive)
 	}
@@ -639,13 +642,13 @@ func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type MysqlMysqlDbSystemResourceCrud struct {
@@ -832,9 +835,10 @@ func (s *MysqlMysqlDbSystemResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateDbSystem(context.Background(), request)
+	response, err := s.Client.CreateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -849,9 +853,9 @@ func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -919,9 +923,9 @@ func (s *MysqlMysqlDbSystemResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateDbSystem(context.Background(), request)
+	_, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -935,9 +939,9 @@ func (s *MysqlMysqlDbSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteDbSystem(context.Background(), request)
+	_, err := s.Client.DeleteDbSystem(s.Context(), request)
 	return err
 }
 
@@ -1452,15 +1456,15 @@ func (s *MysqlMysqlDbSystemResourceCrud) StartMysqlDbInstance() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartDbSystem(context.Background(), request)
+	_, err := s.Client.StartDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.DbSystemLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance() error {
@@ -1489,13 +1493,13 @@ func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance() error {
 		return fmt.Errorf("unknown shutdown_type '%v' was specified", shutdown_type)
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopDbSystem(context.Background(), request)
+	_, err := s.Client.StopDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.DbSystemLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlDbSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlDbSystems,
+		ReadContext: readMysqlMysqlDbSystems,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func MysqlMysqlDbSystemsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlDbSystems(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlDbSystems(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlDbSystemsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.DbSystemClient
 	Res    *oci_mysql.ListDbSystemsResponse
 }
@@ -119,9 +120,9 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_mysql.DbSystemLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListDbSystems(context.Background(), request)
+	response, err := s.Client.ListDbSystems(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +131,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystems(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystems(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlVersions,
+		ReadContext: readMysqlMysqlVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -64,16 +65,16 @@ func MysqlMysqlVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlVersions(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlMysqlVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.MysqlaasClient
 	Res    *oci_mysql.ListVersionsResponse
 }
@@ -90,9 +91,9 @@ func (s *MysqlMysqlVersionsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListVersions(context.Background(), request)
+	response, err := s.Client.ListVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v61/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlShapes,
+		ReadContext: readMysqlShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -73,16 +74,16 @@ func MysqlShapesDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlShapes(d *schema.ResourceData, m interface{}) error {
+func readMysqlShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type MysqlShapesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_mysql.MysqlaasClient
 	Res    *oci_mysql.ListShapesResponse
 }
@@ -122,9 +123,9 @@ func (s *MysqlShapesDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "mysql")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerBackendHealth,
+		ReadContext: readSingularNetworkLoadBalancerBackendHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_name": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func NetworkLoadBalancerBackendHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerBackendHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetBackendHealthResponse
 }
@@ -95,9 +96,9 @@ func (s *NetworkLoadBalancerBackendHealthDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
@@ -26,16 +27,16 @@ func NetworkLoadBalancerBackendSetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerBackendSetResource(), fieldMap, readSingularNetworkLoadBalancerBackendSet)
 }
 
-func readSingularNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetBackendSetResponse
 }
@@ -57,9 +58,9 @@ func (s *NetworkLoadBalancerBackendSetDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
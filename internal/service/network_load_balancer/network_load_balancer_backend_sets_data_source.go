@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerBackendSets,
+		ReadContext: readNetworkLoadBalancerBackendSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancer_id": {
@@ -39,16 +40,16 @@ func NetworkLoadBalancerBackendSetsDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerBackendSets(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerBackendSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListBackendSetsResponse
 }
@@ -65,9 +66,9 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListBackendSets(context.Background(), request)
+	response, err := s.Client.ListBackendSets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -76,7 +77,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackendSets(context.Background(), request)
+		listResponse, err := s.Client.ListBackendSets(s.Context(), request)
 		if err != nil {
 			return err
 		}
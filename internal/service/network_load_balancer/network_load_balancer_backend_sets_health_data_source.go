@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerBackendSetHealth,
+		ReadContext: readSingularNetworkLoadBalancerBackendSetHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_set_name": {
 				Type:     schema.TypeString,
@@ -59,16 +60,16 @@ func NetworkLoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerBackendSetHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendSetHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetBackendSetHealthResponse
 }
@@ -90,9 +91,9 @@ func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerBackends,
+		ReadContext: readNetworkLoadBalancerBackends,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backend_set_name": {
@@ -44,16 +45,16 @@ func NetworkLoadBalancerBackendsDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerBackends(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerBackends(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListBackendsResponse
 }
@@ -75,9 +76,9 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListBackends(context.Background(), request)
+	response, err := s.Client.ListBackends(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackends(context.Background(), request)
+		listResponse, err := s.Client.ListBackends(s.Context(), request)
 		if err != nil {
 			return err
 		}
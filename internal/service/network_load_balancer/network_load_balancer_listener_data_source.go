@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
@@ -26,16 +27,16 @@ func NetworkLoadBalancerListenerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerListenerResource(), fieldMap, readSingularNetworkLoadBalancerListener)
 }
 
-func readSingularNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerListenerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetListenerResponse
 }
@@ -57,9 +58,9 @@ func (s *NetworkLoadBalancerListenerDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetListener(context.Background(), request)
+	response, err := s.Client.GetListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
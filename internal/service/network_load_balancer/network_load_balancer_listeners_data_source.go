@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerListenersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerListeners,
+		ReadContext: readNetworkLoadBalancerListeners,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancer_id": {
@@ -40,16 +41,16 @@ func NetworkLoadBalancerListenersDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerListeners(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerListeners(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerListenersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListListenersResponse
 }
@@ -66,9 +67,9 @@ func (s *NetworkLoadBalancerListenersDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListListeners(context.Background(), request)
+	response, err := s.Client.ListListeners(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *NetworkLoadBalancerListenersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListListeners(context.Background(), request)
+		listResponse, err := s.Client.ListListeners(s.Context(), request)
 		if err != nil {
 			return err
 		}
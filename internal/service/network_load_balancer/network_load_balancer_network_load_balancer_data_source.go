@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
@@ -22,16 +23,16 @@ func NetworkLoadBalancerNetworkLoadBalancerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerNetworkLoadBalancerResource(), fieldMap, readSingularNetworkLoadBalancerNetworkLoadBalancer)
 }
 
-func readSingularNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetNetworkLoadBalancerResponse
 }
@@ -48,9 +49,9 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) Get() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancerHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerNetworkLoadBalancerHealth,
+		ReadContext: readSingularNetworkLoadBalancerNetworkLoadBalancerHealth,
 		Schema: map[string]*schema.Schema{
 			"network_load_balancer_id": {
 				Type:     schema.TypeString,
@@ -55,16 +56,16 @@ func NetworkLoadBalancerNetworkLoadBalancerHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerNetworkLoadBalancerHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerNetworkLoadBalancerHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.GetNetworkLoadBalancerHealthResponse
 }
@@ -81,9 +82,9 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) Get() error
 		request.NetworkLoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancerHealth(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerNetworkLoadBalancers,
+		ReadContext: readNetworkLoadBalancerNetworkLoadBalancers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func NetworkLoadBalancerNetworkLoadBalancersDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancers(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListNetworkLoadBalancersResponse
 }
@@ -83,9 +84,9 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_network_load_balancer.ListNetworkLoadBalancersLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkLoadBalancers(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkLoadBalancers(s.Context(), request)
 		if err != nil {
 			return err
 		}
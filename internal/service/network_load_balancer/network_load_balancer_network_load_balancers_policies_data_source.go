@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerNetworkLoadBalancersPolicies,
+		ReadContext: readNetworkLoadBalancerNetworkLoadBalancersPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancers_policy_collection": {
@@ -42,16 +43,16 @@ func NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSource() *schema.Resourc
 	}
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancersPolicies(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancersPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListNetworkLoadBalancersPoliciesResponse
 }
@@ -63,9 +64,9 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud) VoidStat
 func (s *NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud) Get() error {
 	request := oci_network_load_balancer.ListNetworkLoadBalancersPoliciesRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancersPolicies(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancersPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v61/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerNetworkLoadBalancersProtocols,
+		ReadContext: readNetworkLoadBalancerNetworkLoadBalancersProtocols,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancers_protocol_collection": {
@@ -42,16 +43,16 @@ func NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSource() *schema.Resour
 	}
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancersProtocols(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancersProtocols(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_network_load_balancer.NetworkLoadBalancerClient
 	Res    *oci_network_load_balancer.ListNetworkLoadBalancersProtocolsResponse
 }
@@ -63,9 +64,9 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) VoidSta
 func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) Get() error {
 	request := oci_network_load_balancer.ListNetworkLoadBalancersProtocolsRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancersProtocols(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancersProtocols(s.Context(), request)
 	if err != nil {
 		return err
 	}
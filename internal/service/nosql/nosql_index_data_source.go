@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v61/nosql"
 )
@@ -30,16 +31,16 @@ func NosqlIndexDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NosqlIndexResource(), fieldMap, readSingularNosqlIndex)
 }
 
-func readSingularNosqlIndex(d *schema.ResourceData, m interface{}) error {
+func readSingularNosqlIndex(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlIndexDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NosqlIndexDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_nosql.NosqlClient
 	Res    *oci_nosql.GetIndexResponse
 }
@@ -66,9 +67,9 @@ func (s *NosqlIndexDataSourceCrud) Get() error {
 		request.TableNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "nosql")
 
-	response, err := s.Client.GetIndex(context.Background(), request)
+	response, err := s.Client.GetIndex(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v61/nosql"
 )
 
 func NosqlIndexesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNosqlIndexes,
+		ReadContext: readNosqlIndexes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func NosqlIndexesDataSource() *schema.Resource {
 	}
 }
 
-func readNosqlIndexes(d *schema.ResourceData, m interface{}) error {
+func readNosqlIndexes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlIndexesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NosqlIndexesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_nosql.NosqlClient
 	Res    *oci_nosql.ListIndexesResponse
 }
@@ -83,9 +84,9 @@ func (s *NosqlIndexesDataSourceCrud) Get() error {
 		request.TableNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "nosql")
 
-	response, err := s.Client.ListIndexes(context.Background(), request)
+	response, err := s.Client.ListIndexes(s.Context(), request)
 	if err != nil {
 		return err
 	}
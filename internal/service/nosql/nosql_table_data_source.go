@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v61/nosql"
 )
@@ -26,16 +27,16 @@ func NosqlTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NosqlTableResource(), fieldMap, readSingularNosqlTable)
 }
 
-func readSingularNosqlTable(d *schema.ResourceData, m interface{}) error {
+func readSingularNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NosqlTableDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_nosql.NosqlClient
 	Res    *oci_nosql.GetTableResponse
 }
@@ -57,9 +58,9 @@ func (s *NosqlTableDataSourceCrud) Get() error {
 		request.TableNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
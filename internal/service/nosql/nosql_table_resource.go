@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func NosqlTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createNosqlTable,
-		Read:     readNosqlTable,
-		Update:   updateNosqlTable,
-		Delete:   deleteNosqlTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createNosqlTable,
+		ReadContext:   readNosqlTable,
+		UpdateContext: updateNosqlTable,
+		DeleteContext: deleteNosqlTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -190,37 +191,37 @@ func NosqlTableResource() *schema.Resource {
 	}
 }
 
-func createNosqlTable(d *schema.ResourceData, m interface{}) error {
+func createNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readNosqlTable(d *schema.ResourceData, m interface{}) error {
+func readNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateNosqlTable(d *schema.ResourceData, m interface{}) error {
+func updateNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteNosqlTable(d *schema.ResourceData, m interface{}) error {
+func deleteNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type NosqlTableResourceCrud struct {
@@ -309,28 +310,29 @@ func (s *NosqlTableResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.CreateTable(context.Background(), request)
+	response, err := s.Client.CreateTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *NosqlTableResourceCrud) getTableFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_nosql.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	tableId, err := tableWaitForWorkRequest(workId, "TABLE",
+	tableId, err := tableWaitForWorkRequest(s.Context(), workId, "TABLE",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, tableId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_nosql.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -375,9 +377,9 @@ func tableWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum,
+func tableWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_nosql.NosqlClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "nosql")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "nosql")
 	retryPolicy.ShouldRetryOperation = tableWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_nosql.GetWorkRequestResponse{}
@@ -394,7 +396,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_nosql.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -406,7 +408,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 	var identifier *string
@@ -422,14 +424,14 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.WorkRequest.Status == oci_nosql.WorkRequestStatusFailed || response.WorkRequest.Status == oci_nosql.WorkRequestStatusCanceled {
-		return nil, getErrorFromNosqlTableWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromNosqlTableWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromNosqlTableWorkRequest(client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromNosqlTableWorkRequest(ctx context.Context, client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_nosql.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -467,9 +469,9 @@ func (s *NosqlTableResourceCrud) Get() error {
 		request.TableNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -505,7 +507,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 
 	request := oci_nosql.UpdateTableRequest{}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql")
 
 	if tableNameOrId, ok := s.D.GetOkExists("table_name_or_id"); ok {
 		tmp := tableNameOrId.(string)
@@ -518,7 +520,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 	if ddlStatement, ok := s.D.GetOkExists("ddl_statement"); ok && s.D.HasChange("ddl_statement") {
 		tmp := ddlStatement.(string)
 		request.DdlStatement = &tmp
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(s.Context(), s, request)
 		if err != nil {
 			return err
 		}
@@ -531,7 +533,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 			return err
 		}
 		request.DefinedTags = convertedDefinedTags
-		err = sendUpdateRequest(s, request)
+		err = sendUpdateRequest(s.Context(), s, request)
 		if err != nil {
 			return err
 		}
@@ -540,7 +542,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok && s.D.HasChange("freeform_tags") {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(s.Context(), s, request)
 		if err != nil {
 			return err
 		}
@@ -556,7 +558,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 			}
 			request.TableLimits = &tmp
 		}
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(s.Context(), s, request)
 		if err != nil {
 			return err
 		}
@@ -566,13 +568,13 @@ func (s *NosqlTableResourceCrud) Update() error {
 	return nil
 }
 
-func sendUpdateRequest(s *NosqlTableResourceCrud, request oci_nosql.UpdateTableRequest) error {
-	response, err := s.Client.UpdateTable(context.Background(), request)
+func sendUpdateRequest(ctx context.Context, s *NosqlTableResourceCrud, request oci_nosql.UpdateTableRequest) error {
+	response, err := s.Client.UpdateTable(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	err = s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicyContext(ctx, s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -600,16 +602,16 @@ func (s *NosqlTableResourceCrud) Delete() error {
 		request.TableNameOrId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.DeleteTable(context.Background(), request)
+	response, err := s.Client.DeleteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := tableWaitForWorkRequest(workId, "TABLE",
+	_, delWorkRequestErr := tableWaitForWorkRequest(s.Context(), workId, "TABLE",
 		oci_nosql.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -834,13 +836,13 @@ func (s *NosqlTableResourceCrud) updateCompartment(fromCompartmentId, toCompartm
 
 	changeCompartmentRequest.ToCompartmentId = &toCompartmentId
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.ChangeTableCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeTableCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
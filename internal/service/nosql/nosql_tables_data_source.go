@@ -6,6 +6,7 @@ package nosql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v61/nosql"
 
@@ -15,7 +16,7 @@ import (
 
 func NosqlTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNosqlTables,
+		ReadContext: readNosqlTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func NosqlTablesDataSource() *schema.Resource {
 	}
 }
 
-func readNosqlTables(d *schema.ResourceData, m interface{}) error {
+func readNosqlTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NosqlTablesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_nosql.NosqlClient
 	Res    *oci_nosql.ListTablesResponse
 }
@@ -74,9 +75,9 @@ func (s *NosqlTablesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_nosql.ListTablesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "nosql")
 
-	response, err := s.Client.ListTables(context.Background(), request)
+	response, err := s.Client.ListTables(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,8 +15,6 @@ import (
 	"sync"
 	"time"
 
-	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -113,7 +111,7 @@ func validateLowerCaseKeysInMetadata(raw interface{}, fieldName string) ([]strin
 	return nil, errors
 }
 
-func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func singlePartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourcePath := *multipartUploadData.SourcePath
 	sourceInfo := *multipartUploadData.SourceInfo
@@ -143,7 +141,7 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 	}
 	putObjectRequest.RequestMetadata.RetryPolicy = multipartUploadData.RequestMetadata.RetryPolicy
 
-	_, err = multipartUploadData.ObjectStorageClient.PutObject(context.Background(), *putObjectRequest)
+	_, err = multipartUploadData.ObjectStorageClient.PutObject(ctx, *putObjectRequest)
 	if err != nil {
 		return "", err
 	}
@@ -179,18 +177,18 @@ func PutObjectStorageTierEnumFromString(s string) oci_object_storage.PutObjectSt
 	}
 }
 
-func MultiPartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func MultiPartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourceInfo := *multipartUploadData.SourceInfo
 
 	if sourceInfo.Size() > DefaultFilePartSize {
-		return multiPartUploadImpl(multipartUploadData)
+		return multiPartUploadImpl(ctx, multipartUploadData)
 	}
 
-	return singlePartUpload(multipartUploadData)
+	return singlePartUpload(ctx, multipartUploadData)
 }
 
-func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error) {
+func multiPartUploadImpl(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	multipartUploadRequest := &oci_object_storage.CreateMultipartUploadRequest{
 		NamespaceName:   multipartUploadData.NamespaceName,
@@ -221,7 +219,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
 
-	multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+	multipartUploadResponse, err := client.CreateMultipartUpload(ctx, *multipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
 	}
@@ -240,7 +238,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	close(sourceBlocksChan)
 
 	for i := 0; i < workerCount; i++ {
-		go uploadPartsWorker(objectStorageMultiPartUploadContext{
+		go uploadPartsWorker(ctx, objectStorageMultiPartUploadContext{
 			client:                  *client,
 			wg:                      wg,
 			multipartUploadResponse: multipartUploadResponse,
@@ -282,7 +280,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 			RequestMetadata:    multipartUploadRequest.RequestMetadata,
 		}
 
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
+		_, err := client.AbortMultipartUpload(ctx, abortMultipartUploadRequest)
 
 		if err != nil {
 			log.Println("[WARN] Aborting the multi part upload failed")
@@ -301,7 +299,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
 
-	_, err = client.CommitMultipartUpload(context.Background(), commitMultipartUploadRequest)
+	_, err = client.CommitMultipartUpload(ctx, commitMultipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
@@ -374,8 +372,8 @@ func SplitSizeToOffsetsAndLimits(infoSize int64) ([]int64, []int64, error) {
 	return offsets, limits, nil
 }
 
-func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
-	for sourceBlock := range ctx.sourceBlocks {
+func uploadPartsWorker(ctx context.Context, uploadContext objectStorageMultiPartUploadContext) {
+	for sourceBlock := range uploadContext.sourceBlocks {
 
 		block := make([]byte, sourceBlock.section.Size())
 		_, err := sourceBlock.section.Read(block)
@@ -387,23 +385,23 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			osUploadPartResponse := &objectStorageUploadPartResponse{
 				error: err,
 			}
-			ctx.osUploadPartResponses <- *osUploadPartResponse
-			ctx.wg.Done()
+			uploadContext.osUploadPartResponses <- *osUploadPartResponse
+			uploadContext.wg.Done()
 			continue
 		}
 		tmpLength := int64(len(block))
 		uploadPartRequest := &oci_object_storage.UploadPartRequest{
-			UploadId:        ctx.multipartUploadResponse.UploadId,
-			ObjectName:      ctx.multipartUploadResponse.Object,
-			NamespaceName:   ctx.multipartUploadResponse.Namespace,
-			BucketName:      ctx.multipartUploadResponse.Bucket,
-			RequestMetadata: ctx.multipartUploadRequest.RequestMetadata,
+			UploadId:        uploadContext.multipartUploadResponse.UploadId,
+			ObjectName:      uploadContext.multipartUploadResponse.Object,
+			NamespaceName:   uploadContext.multipartUploadResponse.Namespace,
+			BucketName:      uploadContext.multipartUploadResponse.Bucket,
+			RequestMetadata: uploadContext.multipartUploadRequest.RequestMetadata,
 			ContentLength:   &tmpLength,
 			UploadPartBody:  ioutil.NopCloser(bytes.NewBuffer(block)),
 			UploadPartNum:   sourceBlock.blockNumber,
 		}
 
-		uploadPartResponse, err := ctx.client.UploadPart(context.Background(), *uploadPartRequest)
+		uploadPartResponse, err := uploadContext.client.UploadPart(ctx, *uploadPartRequest)
 
 		osUploadPartResponse := &objectStorageUploadPartResponse{
 			response:   uploadPartResponse,
@@ -411,21 +409,15 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			partNumber: uploadPartRequest.UploadPartNum,
 		}
 
-		ctx.osUploadPartResponses <- *osUploadPartResponse
-		ctx.wg.Done()
+		uploadContext.osUploadPartResponses <- *osUploadPartResponse
+		uploadContext.wg.Done()
 	}
 }
 
 func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string) error {
 	if s.SourceRegionClient == nil {
-		sourceObjectStorageClient, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
-		if err != nil {
-			return fmt.Errorf("cannot Create client for the source region: %v", err)
-		}
-		err = tf_client.ConfigureClientVar(&sourceObjectStorageClient.BaseClient)
-		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
-		}
+		// copy the client of the provider, so that the source region client is configured the same way
+		sourceObjectStorageClient := *s.Client
 		s.SourceRegionClient = &sourceObjectStorageClient
 	}
 	s.SourceRegionClient.SetRegion(region)
@@ -433,9 +425,9 @@ func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string
 	return nil
 }
 
-func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
+func copyObjectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
 
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "object_storage")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "object_storage")
 	retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(timeout)
 
 	stateConf := &resource.StateChangeConf{
@@ -453,7 +445,7 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 			getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 			getWorkRequestRequest.WorkRequestId = wId
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+			workRequestResponse, err := client.GetWorkRequest(ctx, getWorkRequestRequest)
 			wr := &workRequestResponse.WorkRequest
 			return workRequestResponse, string(wr.Status), err
 		},
@@ -465,14 +457,14 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 		stateConf.PollInterval = 1
 	}
 
-	wrr, e := stateConf.WaitForState()
+	wrr, e := stateConf.WaitForStateContext(ctx)
 	if e != nil {
 		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s. Message: %s", *wId, entityType, e)
 	}
 
 	wr := wrr.(oci_object_storage.GetWorkRequestResponse).WorkRequest
 	if wr.Status == oci_object_storage.WorkRequestStatusFailed {
-		errorMessage, _ := getObjectStorageErrorFromWorkRequest(wId, client, disableFoundRetries)
+		errorMessage, _ := getObjectStorageErrorFromWorkRequest(ctx, wId, client, disableFoundRetries)
 		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s. Message: %s", *wId, entityType, errorMessage)
 	}
 
@@ -503,11 +495,11 @@ func objectStorageWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 	}
 }
 
-func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_object_storage.ObjectStorageClient, disableFoundAutoRetries bool) (string, error) {
+func getObjectStorageErrorFromWorkRequest(ctx context.Context, workRequestId *string, client *oci_object_storage.ObjectStorageClient, disableFoundAutoRetries bool) (string, error) {
 	req := oci_object_storage.ListWorkRequestErrorsRequest{}
 	req.WorkRequestId = workRequestId
-	req.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableFoundAutoRetries, "object_storage")
-	res, err := client.ListWorkRequestErrors(context.Background(), req)
+	req.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(ctx, disableFoundAutoRetries, "object_storage")
+	res, err := client.ListWorkRequestErrors(ctx, req)
 
 	if err != nil {
 		return "", err
@@ -522,7 +514,7 @@ func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_obj
 	return errorMessage, nil
 }
 
-func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, bucket string, namespace string, prefix string) error {
+func DeleteAllObjectVersions(ctx context.Context, client *oci_object_storage.ObjectStorageClient, bucket string, namespace string, prefix string) error {
 	request := oci_object_storage.ListObjectVersionsRequest{}
 
 	request.BucketName = &bucket
@@ -532,7 +524,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 		request.Prefix = &prefix
 	}
 
-	response, err := client.ListObjectVersions(context.Background(), request)
+	response, err := client.ListObjectVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -540,9 +532,9 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(ctx, true, "object_storage")
 
-		listResponse, err := client.ListObjectVersions(context.Background(), request)
+		listResponse, err := client.ListObjectVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -559,9 +551,9 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 		deleteObjectVersionRequest.ObjectName = objectVersion.Name
 		deleteObjectVersionRequest.VersionId = objectVersion.VersionId
 
-		deleteObjectVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
+		deleteObjectVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(ctx, true, "object_storage")
 
-		_, err := client.DeleteObject(context.Background(), deleteObjectVersionRequest)
+		_, err := client.DeleteObject(ctx, deleteObjectVersionRequest)
 		if err != nil {
 			errors = append(errors, err.Error())
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
@@ -27,16 +28,16 @@ func ObjectStorageBucketDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStorageBucketResource(), fieldMap, readSingularObjectStorageBucket)
 }
 
-func readSingularObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageBucketDataSourceCrud struct {
-	D                      *schema.ResourceData
+	tfresource.BaseCrud
 	Client                 *oci_object_storage.ObjectStorageClient
 	Res                    *oci_object_storage.GetBucketResponse
 	RetentionRuleRes       []*oci_object_storage.RetentionRule
@@ -64,9 +65,9 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 	}
 
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), true, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -74,8 +75,8 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 	s.Res = &response
 
 	// using list call as summary and get response is same for a retention rule
-	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(s.Context(), listRetentionRulesRequest)
 	if e != nil {
 		return e
 	}
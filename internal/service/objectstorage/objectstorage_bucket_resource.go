@@ -19,6 +19,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
@@ -29,11 +30,11 @@ func ObjectStorageBucketResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageBucket,
-		Read:     readObjectStorageBucket,
-		Update:   updateObjectStorageBucket,
-		Delete:   deleteObjectStorageBucket,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageBucket,
+		ReadContext:   readObjectStorageBucket,
+		UpdateContext: updateObjectStorageBucket,
+		DeleteContext: deleteObjectStorageBucket,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -200,37 +201,37 @@ func ObjectStorageBucketResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func updateObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ObjectStorageBucketResourceCrud struct {
@@ -324,9 +325,9 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 		request.Versioning = oci_object_storage.CreateBucketDetailsVersioningEnum(versioning.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateBucket(context.Background(), request)
+	response, err := s.Client.CreateBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -368,9 +369,9 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	}
 
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -378,8 +379,8 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	s.Res = &response.Bucket
 
 	// using list call as summary and get response is same for a retention rule
-	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(s.Context(), listRetentionRulesRequest)
 
 	if e != nil {
 		return e
@@ -471,9 +472,9 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 		request.Versioning = oci_object_storage.UpdateBucketDetailsVersioningEnum(versioning.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateBucket(context.Background(), request)
+	response, err := s.Client.UpdateBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -501,9 +502,9 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteBucket(context.Background(), request)
+	_, err := s.Client.DeleteBucket(s.Context(), request)
 	return err
 }
 
@@ -640,9 +641,9 @@ func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(newRetentio
 		createRequest.DisplayName = item.DisplayName
 		createRequest.Duration = item.Duration
 		createRequest.TimeRuleLocked = item.TimeRuleLocked
-		createRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+		createRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-		createResponse, err := s.Client.CreateRetentionRule(context.Background(), createRequest)
+		createResponse, err := s.Client.CreateRetentionRule(s.Context(), createRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Create the retention rule '%s' : %v", *createRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -786,7 +787,7 @@ func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(retentionRu
 		updateRequest.TimeRuleLocked = item.TimeRuleLocked
 		updateRequest.RetentionRuleId = item.Id
 
-		updateResponse, err := s.Client.UpdateRetentionRule(context.Background(), updateRequest)
+		updateResponse, err := s.Client.UpdateRetentionRule(s.Context(), updateRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Update the retention rule '%s' : %v", *updateRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -826,10 +827,10 @@ func (s *ObjectStorageBucketResourceCrud) deleteRetentionRulesHelper(oldRetentio
 	for _, retentionRuleId := range deleteIds {
 		deleteRequest.RetentionRuleId = &retentionRuleId
 
-		_, err := s.Client.DeleteRetentionRule(context.Background(), deleteRequest)
+		_, err := s.Client.DeleteRetentionRule(s.Context(), deleteRequest)
 		if err != nil {
 			log.Printf("Failed to delete the retention rule '%s' : %v", *deleteRequest.RetentionRuleId, err)
-			e := tfresource.ReadResource(s)
+			e := tfresource.ReadResourceContext(s.Context(), s)
 			if e != nil {
 				log.Printf("Failed to store the retention rules in the state file: %v", e)
 			}
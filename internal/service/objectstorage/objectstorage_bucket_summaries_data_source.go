@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageBucketsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageBuckets,
+		ReadContext: readObjectStorageBuckets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,16 +36,16 @@ func ObjectStorageBucketsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageBuckets(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageBuckets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageBucketsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListBucketsResponse
 }
@@ -69,9 +70,9 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.ListBuckets(context.Background(), request)
+	response, err := s.Client.ListBuckets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuckets(context.Background(), request)
+		listResponse, err := s.Client.ListBuckets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageNamespaceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularObjectStorageNamespace,
+		ReadContext: readSingularObjectStorageNamespace,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -33,16 +34,16 @@ func ObjectStorageNamespaceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularObjectStorageNamespace(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageNamespaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageNamespaceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetNamespaceResponse
 }
@@ -59,9 +60,9 @@ func (s *ObjectStorageNamespaceDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
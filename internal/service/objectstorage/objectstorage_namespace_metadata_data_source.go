@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageNamespaceMetadataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNamespaceMetadata2,
+		ReadContext: readNamespaceMetadata2,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -36,15 +37,15 @@ func ObjectStorageNamespaceMetadataDataSource() *schema.Resource {
 	}
 }
 
-func readNamespaceMetadata2(d *schema.ResourceData, m interface{}) error {
+func readNamespaceMetadata2(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type NamespaceMetadataDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetNamespaceMetadataResponse
 }
@@ -59,9 +60,9 @@ func (s *NamespaceMetadataDataSourceCrud) Get() error {
 	tmp := s.D.Get("namespace").(string)
 	request.NamespaceName = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,17 +9,18 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   readNamespaceMetadata,
-		Read:     readNamespaceMetadata,
-		Update:   updateNamespaceMetadata,
-		Delete:   deleteNamespaceMetadata,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: readNamespaceMetadata,
+		ReadContext:   readNamespaceMetadata,
+		UpdateContext: updateNamespaceMetadata,
+		DeleteContext: deleteNamespaceMetadata,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -43,21 +44,21 @@ func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	}
 }
 
-func readNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func readNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func updateNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func deleteNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -80,9 +81,9 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -110,9 +111,9 @@ func (s *NamespaceMetadataResourceCrud) Update() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.UpdateNamespaceMetadata(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -14,13 +14,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageObjectDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularObjectStorageObject,
+		ReadContext: readSingularObjectStorageObject,
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -121,16 +122,16 @@ func ObjectStorageObjectDataSource() *schema.Resource {
 	}
 }
 
-func readSingularObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageObjectDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetObjectResponse
 }
@@ -158,14 +159,14 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 		headObjectRequest.ObjectName = &objectName
 	}
 
-	headObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	headObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
 	if versionId, ok := s.D.GetOkExists("version_id"); ok {
 		tmp := versionId.(string)
 		headObjectRequest.VersionId = &tmp
 	}
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(s.Context(), *headObjectRequest)
 	if err != nil {
 		return err
 	}
@@ -219,7 +220,7 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 		request.HttpResponseExpires = &tmp
 	}
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
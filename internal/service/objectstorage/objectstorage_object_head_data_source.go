@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 
@@ -17,7 +18,7 @@ import (
 
 func ObjectStorageObjectHeadDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectHead,
+		ReadContext: readObjectHead,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -59,15 +60,15 @@ func ObjectStorageObjectHeadDataSource() *schema.Resource {
 	}
 }
 
-func readObjectHead(d *schema.ResourceData, m interface{}) error {
+func readObjectHead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectHeadDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectHeadDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.HeadObjectResponse
 }
@@ -94,9 +95,9 @@ func (s *ObjectHeadDataSourceCrud) Get() error {
 		request.ObjectName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.HeadObject(context.Background(), request)
+	response, err := s.Client.HeadObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
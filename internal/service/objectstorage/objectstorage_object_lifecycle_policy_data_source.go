@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
@@ -26,16 +27,16 @@ func ObjectStorageObjectLifecyclePolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStorageObjectLifecyclePolicyResource(), fieldMap, readSingularObjectStorageObjectLifecyclePolicy)
 }
 
-func readSingularObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageObjectLifecyclePolicyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetObjectLifecyclePolicyResponse
 }
@@ -57,9 +58,9 @@ func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageObjectLifecyclePolicy,
-		Read:     readObjectStorageObjectLifecyclePolicy,
-		Update:   updateObjectStorageObjectLifecyclePolicy,
-		Delete:   deleteObjectStorageObjectLifecyclePolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageObjectLifecyclePolicy,
+		ReadContext:   readObjectStorageObjectLifecyclePolicy,
+		UpdateContext: updateObjectStorageObjectLifecyclePolicy,
+		DeleteContext: deleteObjectStorageObjectLifecyclePolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -146,37 +147,37 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func updateObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ObjectStorageObjectLifecyclePolicyResourceCrud struct {
@@ -221,9 +222,9 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 		request.Items = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -253,9 +254,9 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -294,9 +295,9 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 		request.Items = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -318,9 +319,9 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
+	_, err := s.Client.DeleteObjectLifecyclePolicy(s.Context(), request)
 	return err
 }
 
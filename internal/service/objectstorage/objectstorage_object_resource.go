@@ -22,6 +22,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
@@ -36,11 +37,11 @@ func ObjectStorageObjectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageObject,
-		Read:     readObjectStorageObject,
-		Update:   updateObjectStorageObject,
-		Delete:   deleteObjectStorageObject,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageObject,
+		ReadContext:   readObjectStorageObject,
+		UpdateContext: updateObjectStorageObject,
+		DeleteContext: deleteObjectStorageObject,
 		Schema: map[string]*schema.Schema{
 			// @CODEGEN 2/2018:
 			// Previous provider doesn't provide an Update method and sets all non-Computed fields to ForceNew.
@@ -242,12 +243,12 @@ func ObjectStorageObjectResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
 func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
@@ -321,12 +322,12 @@ func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
 	}
 
 	multipartUploadData.ObjectStorageClient = s.Client
-	multipartUploadData.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	multipartUploadData.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
 
-	id, multipartInitErr := MultiPartUpload(multipartUploadData)
+	id, multipartInitErr := MultiPartUpload(s.Context(), multipartUploadData)
 	if multipartInitErr != nil {
 		return multipartInitErr
 	}
@@ -427,7 +428,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 		copyObjectRequest.DestinationObjectName = &tmp
 	}
 
-	copyObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	copyObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
 	var workRequestId = ""
 	if state, ok := s.D.GetOkExists("state"); ok {
@@ -438,7 +439,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	}
 
 	if workRequestId == "" {
-		copyObjectResponse, err := s.SourceRegionClient.CopyObject(context.Background(), copyObjectRequest)
+		copyObjectResponse, err := s.SourceRegionClient.CopyObject(s.Context(), copyObjectRequest)
 		if err != nil {
 			s.D.Set("state", string(oci_object_storage.WorkRequestStatusCanceled))
 			return err
@@ -451,15 +452,15 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 
 	getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = &workRequestId
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
+	workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
 
 	copyTimeout := s.D.Timeout(schema.TimeoutCreate)
-	err = copyObjectWaitForWorkRequest(&workRequestId, "object", copyTimeout, s.DisableNotFoundRetries, s.SourceRegionClient)
+	err = copyObjectWaitForWorkRequest(s.Context(), &workRequestId, "object", copyTimeout, s.DisableNotFoundRetries, s.SourceRegionClient)
 
 	if err != nil {
 		// we are not able to verify the state of workRequest
@@ -474,7 +475,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	return s.Get()
 }
 
-func readObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectResourceCrud{}
 	// For backward compatibility with CompositeId change
 	log.Printf("[DEBUG] readObjectStorageObject() Resource Id in state: %s", d.Id())
@@ -493,24 +494,24 @@ func readObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+func updateObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 // There's no struct to represent this in SDK, so we define our own including a fake LifecycleState
@@ -656,12 +657,12 @@ func (s *ObjectStorageObjectResourceCrud) createContentObject() error {
 		request.OpcSseKmsKeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
 
-	_, err := s.Client.PutObject(context.Background(), request)
+	_, err := s.Client.PutObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -690,9 +691,9 @@ func (s *ObjectStorageObjectResourceCrud) getObjectHead() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	headObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	headObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(s.Context(), *headObjectRequest)
 	if err != nil {
 		return err
 	}
@@ -713,7 +714,7 @@ func (s *ObjectStorageObjectResourceCrud) updateState() (bool, error) {
 		if state == oci_object_storage.WorkRequestStatusInProgress {
 
 			if wrid, ok := s.D.GetOkExists("work_request_id"); ok {
-				retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+				retryPolicy := tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 				retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(s.D.Timeout(schema.TimeoutCreate))
 
 				getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
@@ -735,7 +736,7 @@ func (s *ObjectStorageObjectResourceCrud) updateState() (bool, error) {
 					return false, fmt.Errorf("no source_uri_details specified to verify copy state by WorkRequest")
 				}
 
-				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(context.Background(), getWorkRequestRequest)
+				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(s.Context(), getWorkRequestRequest)
 				if err != nil {
 					return false, err
 				}
@@ -814,9 +815,9 @@ func (s *ObjectStorageObjectResourceCrud) getObject() error {
 
 	// TODO: May be better to use HeadObject() to retrieve status of the object. For large content, doesn't make sense
 	// to call Get() all the time
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -856,8 +857,8 @@ func (s *ObjectStorageObjectResourceCrud) Update() error {
 		newName := newRaw.(string)
 		request.NewName = &newName
 
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-		_, err := s.Client.RenameObject(context.Background(), request)
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
+		_, err := s.Client.RenameObject(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -887,11 +888,11 @@ func (s *ObjectStorageObjectResourceCrud) Delete() error {
 	}
 
 	if deleteAllObjectVersions, ok := s.D.GetOkExists("delete_all_object_versions"); ok && deleteAllObjectVersions.(bool) {
-		return DeleteAllObjectVersions(s.Client, *request.BucketName, *request.NamespaceName, *request.ObjectName)
+		return DeleteAllObjectVersions(s.Context(), s.Client, *request.BucketName, *request.NamespaceName, *request.ObjectName)
 	} else {
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-		_, err := s.Client.DeleteObject(context.Background(), request)
+		_, err := s.Client.DeleteObject(s.Context(), request)
 		return err
 	}
 }
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageObjectVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageObjectVersions,
+		ReadContext: readObjectStorageObjectVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -117,16 +118,16 @@ func ObjectStorageObjectVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageObjectVersions(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObjectVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageObjectVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListObjectVersionsResponse
 }
@@ -179,9 +180,9 @@ func (s *ObjectStorageObjectVersionsDataSourceCrud) Get() error {
 		request.StartAfter = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.ListObjectVersions(context.Background(), request)
+	response, err := s.Client.ListObjectVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageObjectsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageObjects,
+		ReadContext: readObjectStorageObjects,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -106,16 +107,16 @@ func ObjectStorageObjectsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageObjects(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageObjectsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListObjects
 }
@@ -180,9 +181,9 @@ func (s *ObjectStorageObjectsDataSourceCrud) Get() error {
 	// @CODEGEN 2/2018: Preserve the custom logic to extract the ObjectSummary results from ListObjects response
 	// and to handle pagination.
 	for {
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-		response, err := s.Client.ListObjects(context.Background(), request)
+		response, err := s.Client.ListObjects(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
@@ -30,16 +31,16 @@ func ObjectStoragePreauthenticatedRequestDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStoragePreauthenticatedRequestResource(), fieldMap, readSingularObjectStoragePreauthenticatedRequest)
 }
 
-func readSingularObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetPreauthenticatedRequestResponse
 }
@@ -66,9 +67,9 @@ func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get() error {
 		request.ParId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
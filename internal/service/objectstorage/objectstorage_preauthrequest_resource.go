@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,10 +27,10 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStoragePreauthenticatedRequest,
-		Read:     readObjectStoragePreauthenticatedRequest,
-		Delete:   deleteObjectStoragePreauthenticatedRequest,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStoragePreauthenticatedRequest,
+		ReadContext:   readObjectStoragePreauthenticatedRequest,
+		DeleteContext: deleteObjectStoragePreauthenticatedRequest,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"access_type": {
@@ -107,15 +108,15 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 	}
 }
 
-func createObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func createObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func readObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	// For backward compatibility with CompositeId change
 
@@ -134,16 +135,16 @@ func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestResourceCrud struct {
@@ -201,9 +202,9 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 		request.TimeExpires = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreatePreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.CreatePreauthenticatedRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,9 +225,9 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s, err: %s ", s.D.Id(), err)
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -263,9 +264,9 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
 		tmp := parId.(string)
 		request.ParId = &tmp
 	}
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeletePreauthenticatedRequest(context.Background(), request)
+	_, err := s.Client.DeletePreauthenticatedRequest(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStoragePreauthenticatedRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStoragePreauthenticatedRequests,
+		ReadContext: readObjectStoragePreauthenticatedRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -39,16 +40,16 @@ func ObjectStoragePreauthenticatedRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStoragePreauthenticatedRequests(d *schema.ResourceData, m interface{}) error {
+func readObjectStoragePreauthenticatedRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListPreauthenticatedRequestsResponse
 }
@@ -75,9 +76,9 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 		request.ObjectNamePrefix = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+	response, err := s.Client.ListPreauthenticatedRequests(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+		listResponse, err := s.Client.ListPreauthenticatedRequests(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageReplicationPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageReplicationPolicies,
+		ReadContext: readObjectStorageReplicationPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -35,16 +36,16 @@ func ObjectStorageReplicationPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageReplicationPolicies(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageReplicationPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListReplicationPoliciesResponse
 }
@@ -66,9 +67,9 @@ func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.ListReplicationPolicies(context.Background(), request)
+	response, err := s.Client.ListReplicationPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationPolicies(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,10 +26,10 @@ func ObjectStorageReplicationPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageReplicationPolicy,
-		Read:     readObjectStorageReplicationPolicy,
-		Delete:   deleteObjectStorageReplicationPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageReplicationPolicy,
+		ReadContext:   readObjectStorageReplicationPolicy,
+		DeleteContext: deleteObjectStorageReplicationPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -89,29 +90,29 @@ func ObjectStorageReplicationPolicyResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ObjectStorageReplicationPolicyResourceCrud struct {
@@ -153,9 +154,9 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Create() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateReplicationPolicy(context.Background(), request)
+	response, err := s.Client.CreateReplicationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,9 +177,9 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetReplicationPolicy(context.Background(), request)
+	response, err := s.Client.GetReplicationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,9 +200,9 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Delete() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "object_storage")
 
-	_, err = s.Client.DeleteReplicationPolicy(context.Background(), request)
+	_, err = s.Client.DeleteReplicationPolicy(s.Context(), request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v61/objectstorage"
 )
 
 func ObjectStorageReplicationSourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageReplicationSources,
+		ReadContext: readObjectStorageReplicationSources,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -55,16 +56,16 @@ func ObjectStorageReplicationSourcesDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageReplicationSources(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationSources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationSourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ObjectStorageReplicationSourcesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.ListReplicationSourcesResponse
 }
@@ -86,9 +87,9 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "object_storage")
 
-	response, err := s.Client.ListReplicationSources(context.Background(), request)
+	response, err := s.Client.ListReplicationSources(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationSources(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationSources(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oce "github.com/oracle/oci-go-sdk/v61/oce"
 )
@@ -22,16 +23,16 @@ func OceOceInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OceOceInstanceResource(), fieldMap, readSingularOceOceInstance)
 }
 
-func readSingularOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OceOceInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_oce.OceInstanceClient
 	Res    *oci_oce.GetOceInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *OceOceInstanceDataSourceCrud) Get() error {
 		request.OceInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
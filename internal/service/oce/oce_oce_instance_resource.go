@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func OceOceInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("20m"),
 			Delete: tfresource.GetTimeoutDuration("20m"),
 		},
-		Create: createOceOceInstance,
-		Read:   readOceOceInstance,
-		Update: updateOceOceInstance,
-		Delete: deleteOceOceInstance,
+		CreateContext: createOceOceInstance,
+		ReadContext:   readOceOceInstance,
+		UpdateContext: updateOceOceInstance,
+		DeleteContext: deleteOceOceInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_email": {
@@ -170,37 +171,37 @@ func OceOceInstanceResource() *schema.Resource {
 	}
 }
 
-func createOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func createOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func readOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func updateOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OceOceInstanceResourceCrud struct {
@@ -327,21 +328,22 @@ func (s *OceOceInstanceResourceCrud) Create() error {
 		request.WafPrimaryDomain = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.CreateOceInstance(context.Background(), request)
+	response, err := s.Client.CreateOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OceOceInstanceResourceCrud) getOceInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_oce.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
-	oceInstanceId, err := oceInstanceWaitForWorkRequest(workId, "oce",
+	oceInstanceId, err := oceInstanceWaitForWorkRequest(s.Context(), workId, "oce",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -381,9 +383,9 @@ func oceInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum,
+func oceInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_oce.OceInstanceClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "oce")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "oce")
 	retryPolicy.ShouldRetryOperation = oceInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_oce.GetWorkRequestResponse{}
@@ -400,7 +402,7 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_oce.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -412,7 +414,7 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -429,14 +431,14 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_oce.WorkRequestStatusFailed || response.Status == oci_oce.WorkRequestStatusCanceled {
-		return nil, getErrorFromOceOceInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOceOceInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOceOceInstanceWorkRequest(client *oci_oce.OceInstanceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOceOceInstanceWorkRequest(ctx context.Context, client *oci_oce.OceInstanceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_oce.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -464,9 +466,9 @@ func (s *OceOceInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.OceInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -533,15 +535,15 @@ func (s *OceOceInstanceResourceCrud) Update() error {
 		request.WafPrimaryDomain = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.UpdateOceInstance(context.Background(), request)
+	response, err := s.Client.UpdateOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OceOceInstanceResourceCrud) Delete() error {
@@ -550,16 +552,16 @@ func (s *OceOceInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OceInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.DeleteOceInstance(context.Background(), request)
+	response, err := s.Client.DeleteOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := oceInstanceWaitForWorkRequest(workId, "oce",
+	_, delWorkRequestErr := oceInstanceWaitForWorkRequest(s.Context(), workId, "oce",
 		oci_oce.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -655,13 +657,13 @@ func (s *OceOceInstanceResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.OceInstanceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.ChangeOceInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOceInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oce "github.com/oracle/oci-go-sdk/v61/oce"
 )
 
 func OceOceInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOceOceInstances,
+		ReadContext: readOceOceInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func OceOceInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readOceOceInstances(d *schema.ResourceData, m interface{}) error {
+func readOceOceInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OceOceInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_oce.OceInstanceClient
 	Res    *oci_oce.ListOceInstancesResponse
 }
@@ -83,9 +84,9 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "oce")
 
-	response, err := s.Client.ListOceInstances(context.Background(), request)
+	response, err := s.Client.ListOceInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOceInstances(context.Background(), request)
+		listResponse, err := s.Client.ListOceInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
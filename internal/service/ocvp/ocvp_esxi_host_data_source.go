@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -22,16 +23,16 @@ func OcvpEsxiHostDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OcvpEsxiHostResource(), fieldMap, readSingularOcvpEsxiHost)
 }
 
-func readSingularOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func readSingularOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpEsxiHostDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.EsxiHostClient
 	Res    *oci_ocvp.GetEsxiHostResponse
 }
@@ -48,9 +49,9 @@ func (s *OcvpEsxiHostDataSourceCrud) Get() error {
 		request.EsxiHostId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,10 +28,10 @@ func OcvpEsxiHostResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createOcvpEsxiHost,
-		Read:   readOcvpEsxiHost,
-		Update: updateOcvpEsxiHost,
-		Delete: deleteOcvpEsxiHost,
+		CreateContext: createOcvpEsxiHost,
+		ReadContext:   readOcvpEsxiHost,
+		UpdateContext: updateOcvpEsxiHost,
+		DeleteContext: deleteOcvpEsxiHost,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"sddc_id": {
@@ -119,40 +120,40 @@ func OcvpEsxiHostResource() *schema.Resource {
 	}
 }
 
-func createOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func createOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func readOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func updateOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func deleteOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OcvpEsxiHostResourceCrud struct {
@@ -234,22 +235,23 @@ func (s *OcvpEsxiHostResourceCrud) Create() error {
 		request.SddcId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateEsxiHost(context.Background(), request)
+	response, err := s.Client.CreateEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEsxiHostFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEsxiHostFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OcvpEsxiHostResourceCrud) getEsxiHostFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ocvp.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	esxiHostId, err := esxiHostWaitForWorkRequest(workId, "esxihost",
+	esxiHostId, err := esxiHostWaitForWorkRequest(s.Context(), workId, "esxihost",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
@@ -283,9 +285,9 @@ func esxiHostWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
+func esxiHostWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ocvp.WorkRequestClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ocvp")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ocvp")
 	retryPolicy.ShouldRetryOperation = esxiHostWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ocvp.GetWorkRequestResponse{}
@@ -302,7 +304,7 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -314,7 +316,7 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -331,14 +333,14 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ocvp.OperationStatusFailed || response.Status == oci_ocvp.OperationStatusCanceled {
-		return nil, getErrorFromOcvpEsxiHostWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOcvpEsxiHostWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOcvpEsxiHostWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOcvpEsxiHostWorkRequest(ctx context.Context, client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -366,9 +368,9 @@ func (s *OcvpEsxiHostResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.EsxiHostId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -404,9 +406,9 @@ func (s *OcvpEsxiHostResourceCrud) Update() error {
 		request.NextSku = oci_ocvp.SkuEnum(nextSku.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateEsxiHost(context.Background(), request)
+	response, err := s.Client.UpdateEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,16 +423,16 @@ func (s *OcvpEsxiHostResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EsxiHostId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteEsxiHost(context.Background(), request)
+	response, err := s.Client.DeleteEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := esxiHostWaitForWorkRequest(workId, "esxihost",
+	_, delWorkRequestErr := esxiHostWaitForWorkRequest(s.Context(), workId, "esxihost",
 		oci_ocvp.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
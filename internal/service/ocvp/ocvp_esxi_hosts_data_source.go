@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpEsxiHostsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpEsxiHosts,
+		ReadContext: readOcvpEsxiHosts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"sddc_id": {
@@ -43,16 +44,16 @@ func OcvpEsxiHostsDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpEsxiHosts(d *schema.ResourceData, m interface{}) error {
+func readOcvpEsxiHosts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpEsxiHostsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.EsxiHostClient
 	Res    *oci_ocvp.ListEsxiHostsResponse
 }
@@ -83,9 +84,9 @@ func (s *OcvpEsxiHostsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ocvp.ListEsxiHostsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.ListEsxiHosts(context.Background(), request)
+	response, err := s.Client.ListEsxiHosts(s.Context(), request)
 	if err != nil {
 		return err
 	}
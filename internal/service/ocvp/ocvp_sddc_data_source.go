@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -22,16 +23,16 @@ func OcvpSddcDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OcvpSddcResource(), fieldMap, readSingularOcvpSddc)
 }
 
-func readSingularOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func readSingularOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpSddcDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.SddcClient
 	Res    *oci_ocvp.GetSddcResponse
 }
@@ -48,9 +49,9 @@ func (s *OcvpSddcDataSourceCrud) Get() error {
 		request.SddcId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func OcvpSddcResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: tfresource.GetTimeoutDuration("6h"),
 		},
-		Create: createOcvpSddc,
-		Read:   readOcvpSddc,
-		Update: updateOcvpSddc,
-		Delete: deleteOcvpSddc,
+		CreateContext: createOcvpSddc,
+		ReadContext:   readOcvpSddc,
+		UpdateContext: updateOcvpSddc,
+		DeleteContext: deleteOcvpSddc,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -296,40 +297,40 @@ const (
 	CancelDowngradeHcxAction string = "CANCEL_DOWNGRADE"
 )
 
-func createOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func createOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func readOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func updateOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func deleteOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OcvpSddcResourceCrud struct {
@@ -501,15 +502,16 @@ func (s *OcvpSddcResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateSddc(context.Background(), request)
+	response, err := s.Client.CreateSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	creationError := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	creationError := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 
 	if creationError != nil {
 		return creationError
@@ -529,7 +531,7 @@ func (s *OcvpSddcResourceCrud) getSddcFromWorkRequest(workId *string, retryPolic
 	actionTypeEnum oci_ocvp.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sddcId, err := sddcWaitForWorkRequest(workId, "sddc",
+	sddcId, err := sddcWaitForWorkRequest(s.Context(), workId, "sddc",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
@@ -563,9 +565,9 @@ func sddcWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 	}
 }
 
-func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
+func sddcWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ocvp.WorkRequestClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ocvp")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "ocvp")
 	retryPolicy.ShouldRetryOperation = sddcWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_ocvp.GetWorkRequestResponse{}
@@ -582,7 +584,7 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -594,7 +596,7 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -611,14 +613,14 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ocvp.OperationStatusFailed || response.Status == oci_ocvp.OperationStatusCanceled {
-		return nil, getErrorFromOcvpSddcWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOcvpSddcWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOcvpSddcWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOcvpSddcWorkRequest(ctx context.Context, client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -646,9 +648,9 @@ func (s *OcvpSddcResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SddcId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -749,9 +751,9 @@ func (s *OcvpSddcResourceCrud) Update() error {
 		request.VsphereVlanId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateSddc(context.Background(), request)
+	response, err := s.Client.UpdateSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -780,12 +782,12 @@ func (s *OcvpSddcResourceCrud) Update() error {
 		if action == UpgradeHcxAction {
 			hcxRequest := oci_ocvp.UpgradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.UpgradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.UpgradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
@@ -799,24 +801,24 @@ func (s *OcvpSddcResourceCrud) Update() error {
 				}
 				hcxRequest.ReservingHcxOnPremiseLicenseKeys = keys
 			}
-			hcxRes, hcxErr := s.Client.DowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.DowngradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
 		} else if action == CancelDowngradeHcxAction {
 			hcxRequest := oci_ocvp.CancelDowngradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
@@ -840,12 +842,12 @@ func (s *OcvpSddcResourceCrud) Update() error {
 func (s *OcvpSddcResourceCrud) refreshHcxLicenseStatus(sddcId *string, refresh interface{}) error {
 	hcxRequest := oci_ocvp.RefreshHcxLicenseStatusRequest{}
 	hcxRequest.SddcId = sddcId
-	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(context.Background(), hcxRequest)
+	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(s.Context(), hcxRequest)
 	if hcxErr != nil {
 		return hcxErr
 	}
 	workId := hcxRes.OpcWorkRequestId
-	err := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err == nil {
 		s.D.Set("refresh_hcx_license_status", refresh)
 	}
@@ -858,16 +860,16 @@ func (s *OcvpSddcResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SddcId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteSddc(context.Background(), request)
+	response, err := s.Client.DeleteSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := sddcWaitForWorkRequest(workId, "sddc",
+	_, delWorkRequestErr := sddcWaitForWorkRequest(s.Context(), workId, "sddc",
 		oci_ocvp.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -1153,14 +1155,14 @@ func (s *OcvpSddcResourceCrud) updateCompartment(compartment interface{}) error
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SddcId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ocvp")
 
-	_, err := s.Client.ChangeSddcCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSddcCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSddcsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSddcs,
+		ReadContext: readOcvpSddcs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func OcvpSddcsDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSddcs(d *schema.ResourceData, m interface{}) error {
+func readOcvpSddcs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpSddcsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.SddcClient
 	Res    *oci_ocvp.ListSddcsResponse
 }
@@ -83,9 +84,9 @@ func (s *OcvpSddcsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ocvp.ListSddcsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.ListSddcs(context.Background(), request)
+	response, err := s.Client.ListSddcs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSupportedSkusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSupportedSkus,
+		ReadContext: readOcvpSupportedSkus,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func OcvpSupportedSkusDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSupportedSkus(d *schema.ResourceData, m interface{}) error {
+func readOcvpSupportedSkus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSupportedSkusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpSupportedSkusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.SddcClient
 	Res    *oci_ocvp.ListSupportedSkusResponse
 }
@@ -69,9 +70,9 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.ListSupportedSkus(context.Background(), request)
+	response, err := s.Client.ListSupportedSkus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSupportedSkus(context.Background(), request)
+		listResponse, err := s.Client.ListSupportedSkus(s.Context(), request)
 		if err != nil {
 			return err
 		}
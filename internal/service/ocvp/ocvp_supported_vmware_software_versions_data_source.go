@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v61/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSupportedVmwareSoftwareVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSupportedVmwareSoftwareVersions,
+		ReadContext: readOcvpSupportedVmwareSoftwareVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func OcvpSupportedVmwareSoftwareVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSupportedVmwareSoftwareVersions(d *schema.ResourceData, m interface{}) error {
+func readOcvpSupportedVmwareSoftwareVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSupportedVmwareSoftwareVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OcvpSupportedVmwareSoftwareVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ocvp.SddcClient
 	Res    *oci_ocvp.ListSupportedVmwareSoftwareVersionsResponse
 }
@@ -74,9 +75,9 @@ func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ocvp")
 
-	response, err := s.Client.ListSupportedVmwareSoftwareVersions(context.Background(), request)
+	response, err := s.Client.ListSupportedVmwareSoftwareVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package oda
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oda "github.com/oracle/oci-go-sdk/v61/oda"
 
@@ -22,16 +23,16 @@ func OdaOdaInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OdaOdaInstanceResource(), fieldMap, readSingularOdaOdaInstance)
 }
 
-func readSingularOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OdaOdaInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_oda.OdaClient
 	Res    *oci_oda.GetOdaInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *OdaOdaInstanceDataSourceCrud) Get() error {
 		request.OdaInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oda")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
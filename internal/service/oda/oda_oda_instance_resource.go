@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,11 +27,11 @@ func OdaOdaInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOdaOdaInstance,
-		Read:     readOdaOdaInstance,
-		Update:   updateOdaOdaInstance,
-		Delete:   deleteOdaOdaInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOdaOdaInstance,
+		ReadContext:   readOdaOdaInstance,
+		UpdateContext: updateOdaOdaInstance,
+		DeleteContext: deleteOdaOdaInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -106,7 +107,7 @@ func OdaOdaInstanceResource() *schema.Resource {
 	}
 }
 
-func createOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func createOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
@@ -119,35 +120,36 @@ func createOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if error := tfresource.CreateResource(d, sync); error != nil {
-		return error
+	if error := tfresource.CreateResourceContext(ctx, d, sync); error != nil {
+		return tfresource.ToDiagnostics(sync, error)
 	}
 
 	if isInactiveRequest {
-		return inactiveOdaIfNeeded(d, sync)
+		return tfresource.ToDiagnostics(sync, inactiveOdaIfNeeded(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func inactiveOdaIfNeeded(d *schema.ResourceData, sync *OdaOdaInstanceResourceCrud) error {
+func inactiveOdaIfNeeded(ctx context.Context, d *schema.ResourceData, sync *OdaOdaInstanceResourceCrud) error {
 	if err := sync.StopOdaInstance(); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResourceContext(ctx, sync)
 }
 
-func readOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func readOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func updateOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
 	// Start/Stop ODA instance
@@ -162,43 +164,43 @@ func updateOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
 			stateInactive = true
 			stateActive = false
 		} else {
-			return fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState)
+			return tfresource.ToDiagnostics(sync, fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState))
 		}
 	}
 
 	if stateActive {
 		if err := sync.StartOdaInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_oda.OdaInstanceLifecycleStateActive); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	// when state is inactive, it is invalid to Update resource
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	if stateInactive {
 		if err := sync.StopOdaInstance(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		if err := sync.D.Set("state", oci_oda.OdaInstanceLifecycleStateInactive); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 	}
 
 	return nil
 }
 
-func deleteOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OdaOdaInstanceResourceCrud struct {
@@ -282,22 +284,23 @@ func (s *OdaOdaInstanceResourceCrud) Create() error {
 		request.ShapeName = oci_oda.CreateOdaInstanceDetailsShapeNameEnum(shapeName.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.CreateOdaInstance(context.Background(), request)
+	response, err := s.Client.CreateOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionCreate, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionCreate, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OdaOdaInstanceResourceCrud) getOdaInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_oda.WorkRequestResourceResourceActionEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	odaInstanceId, err := odaInstanceWaitForWorkRequest(workId, "oda",
+	odaInstanceId, err := odaInstanceWaitForWorkRequest(s.Context(), workId, "oda",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -331,9 +334,9 @@ func odaInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum,
+func odaInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_oda.OdaClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "oda")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "oda")
 	retryPolicy.ShouldRetryOperation = odaInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_oda.GetWorkRequestResponse{}
@@ -350,7 +353,7 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_oda.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -362,7 +365,7 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -379,14 +382,14 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_oda.WorkRequestStatusFailed || response.Status == oci_oda.WorkRequestStatusCanceled {
-		return nil, getErrorFromOdaOdaInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOdaOdaInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOdaOdaInstanceWorkRequest(client *oci_oda.OdaClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOdaOdaInstanceWorkRequest(ctx context.Context, client *oci_oda.OdaClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_oda.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -414,9 +417,9 @@ func (s *OdaOdaInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -462,9 +465,9 @@ func (s *OdaOdaInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.UpdateOdaInstance(context.Background(), request)
+	response, err := s.Client.UpdateOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -479,16 +482,16 @@ func (s *OdaOdaInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.DeleteOdaInstance(context.Background(), request)
+	response, err := s.Client.DeleteOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := odaInstanceWaitForWorkRequest(workId, "oda",
+	_, delWorkRequestErr := odaInstanceWaitForWorkRequest(s.Context(), workId, "oda",
 		oci_oda.WorkRequestResourceResourceActionDelete, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -550,15 +553,15 @@ func (s *OdaOdaInstanceResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.OdaInstanceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.ChangeOdaInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOdaInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionChangeCompartment, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionChangeCompartment, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OdaOdaInstanceResourceCrud) StartOdaInstance() error {
@@ -575,12 +578,12 @@ func (s *OdaOdaInstanceResourceCrud) StartOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StartOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartOdaInstance(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OdaOdaInstanceResourceCrud) StopOdaInstance() error {
@@ -597,10 +600,10 @@ func (s *OdaOdaInstanceResourceCrud) StopOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StopOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopOdaInstance(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceConditionContext(s.Context(), s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
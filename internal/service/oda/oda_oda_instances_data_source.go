@@ -6,6 +6,7 @@ package oda
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oda "github.com/oracle/oci-go-sdk/v61/oda"
 
@@ -15,7 +16,7 @@ import (
 
 func OdaOdaInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOdaOdaInstances,
+		ReadContext: readOdaOdaInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func OdaOdaInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readOdaOdaInstances(d *schema.ResourceData, m interface{}) error {
+func readOdaOdaInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OdaOdaInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_oda.OdaClient
 	Res    *oci_oda.ListOdaInstancesResponse
 }
@@ -74,9 +75,9 @@ func (s *OdaOdaInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_oda.ListOdaInstancesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oda")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "oda")
 
-	response, err := s.Client.ListOdaInstances(context.Background(), request)
+	response, err := s.Client.ListOdaInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *OdaOdaInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOdaInstances(context.Background(), request)
+		listResponse, err := s.Client.ListOdaInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
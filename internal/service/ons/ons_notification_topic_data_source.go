@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
 )
@@ -22,16 +23,16 @@ func OnsNotificationTopicDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OnsNotificationTopicResource(), fieldMap, readSingularOnsNotificationTopic)
 }
 
-func readSingularOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func readSingularOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OnsNotificationTopicDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ons.NotificationControlPlaneClient
 	Res    *oci_ons.GetTopicResponse
 }
@@ -48,9 +49,9 @@ func (s *OnsNotificationTopicDataSourceCrud) Get() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
@@ -27,10 +28,10 @@ func OnsNotificationTopicResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("15m"),
 			Delete: tfresource.GetTimeoutDuration("150m"),
 		},
-		Create: createOnsNotificationTopic,
-		Read:   readOnsNotificationTopic,
-		Update: updateOnsNotificationTopic,
-		Delete: deleteOnsNotificationTopic,
+		CreateContext: createOnsNotificationTopic,
+		ReadContext:   readOnsNotificationTopic,
+		UpdateContext: updateOnsNotificationTopic,
+		DeleteContext: deleteOnsNotificationTopic,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -92,37 +93,37 @@ func OnsNotificationTopicResource() *schema.Resource {
 	}
 }
 
-func createOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func createOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func readOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func updateOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func deleteOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OnsNotificationTopicResourceCrud struct {
@@ -194,9 +195,10 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateTopic(context.Background(), request)
+	response, err := s.Client.CreateTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -261,9 +263,9 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.UpdateTopic(context.Background(), request)
+	response, err := s.Client.UpdateTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -280,9 +282,9 @@ func (s *OnsNotificationTopicResourceCrud) Delete() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteTopic(context.Background(), request)
+	_, err := s.Client.DeleteTopic(s.Context(), request)
 	return err
 }
 
@@ -344,14 +346,14 @@ func (s *OnsNotificationTopicResourceCrud) updateCompartment(compartment interfa
 		changeCompartmentRequest.TopicId = &tmp
 	}
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeTopicCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTopicCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
 )
 
 func OnsNotificationTopicsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnsNotificationTopics,
+		ReadContext: readOnsNotificationTopics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func OnsNotificationTopicsDataSource() *schema.Resource {
 	}
 }
 
-func readOnsNotificationTopics(d *schema.ResourceData, m interface{}) error {
+func readOnsNotificationTopics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OnsNotificationTopicsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ons.NotificationControlPlaneClient
 	Res    *oci_ons.ListTopicsResponse
 }
@@ -83,9 +84,9 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ons.NotificationTopicSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ons")
 
-	response, err := s.Client.ListTopics(context.Background(), request)
+	response, err := s.Client.ListTopics(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTopics(context.Background(), request)
+		listResponse, err := s.Client.ListTopics(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
 )
@@ -23,16 +24,16 @@ func OnsSubscriptionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OnsSubscriptionResource(), fieldMap, readSingularOnsSubscription)
 }
 
-func readSingularOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func readSingularOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OnsSubscriptionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ons.NotificationDataPlaneClient
 	Res    *oci_ons.GetSubscriptionResponse
 }
@@ -49,9 +50,9 @@ func (s *OnsSubscriptionDataSourceCrud) Get() error {
 		request.SubscriptionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
@@ -22,11 +23,11 @@ func OnsSubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOnsSubscription,
-		Read:     readOnsSubscription,
-		Update:   updateOnsSubscription,
-		Delete:   deleteOnsSubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOnsSubscription,
+		ReadContext:   readOnsSubscription,
+		UpdateContext: updateOnsSubscription,
+		DeleteContext: deleteOnsSubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func OnsSubscriptionResource() *schema.Resource {
 	}
 }
 
-func createOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func createOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func readOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func updateOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func deleteOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OnsSubscriptionResourceCrud struct {
@@ -187,9 +188,10 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateSubscription(context.Background(), request)
+	response, err := s.Client.CreateSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,9 +206,9 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -251,9 +253,9 @@ func (s *OnsSubscriptionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.UpdateSubscription(context.Background(), request)
+	_, err := s.Client.UpdateSubscription(s.Context(), request)
 	if err == nil {
 		return s.Get()
 	}
@@ -267,9 +269,9 @@ func (s *OnsSubscriptionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteSubscription(context.Background(), request)
+	_, err := s.Client.DeleteSubscription(s.Context(), request)
 	return err
 }
 
@@ -366,14 +368,14 @@ func (s *OnsSubscriptionResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SubscriptionId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeSubscriptionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubscriptionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v61/ons"
 )
 
 func OnsSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnsSubscriptions,
+		ReadContext: readOnsSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -37,16 +38,16 @@ func OnsSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOnsSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOnsSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OnsSubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_ons.NotificationDataPlaneClient
 	Res    *oci_ons.ListSubscriptionsResponse
 }
@@ -68,9 +69,9 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 		request.TopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "ons")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,7 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlAccessRequestDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlAccessRequest,
+		ReadContext: readSingularOperatorAccessControlAccessRequest,
 		Schema: map[string]*schema.Schema{
 			"access_request_id": {
 				Type:     schema.TypeString,
@@ -149,16 +150,16 @@ func OperatorAccessControlAccessRequestDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlAccessRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlAccessRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.AccessRequestsClient
 	Res    *oci_operator_access_control.GetAccessRequestResponse
 }
@@ -175,9 +176,9 @@ func (s *OperatorAccessControlAccessRequestDataSourceCrud) Get() error {
 		request.AccessRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.GetAccessRequest(context.Background(), request)
+	response, err := s.Client.GetAccessRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
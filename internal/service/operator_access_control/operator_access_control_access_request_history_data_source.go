@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlAccessRequestHistoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlAccessRequestHistory,
+		ReadContext: readSingularOperatorAccessControlAccessRequestHistory,
 		Schema: map[string]*schema.Schema{
 			"access_request_id": {
 				Type:     schema.TypeString,
@@ -70,16 +71,16 @@ func OperatorAccessControlAccessRequestHistoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlAccessRequestHistory(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlAccessRequestHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestHistoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestHistoryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.AccessRequestsClient
 	Res    *oci_operator_access_control.ListAccessRequestHistoriesResponse
 }
@@ -96,9 +97,9 @@ func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) Get() error {
 		request.AccessRequestId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequestHistories(context.Background(), request)
+	response, err := s.Client.ListAccessRequestHistories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
@@ -17,7 +18,7 @@ import (
 
 func OperatorAccessControlAccessRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlAccessRequests,
+		ReadContext: readOperatorAccessControlAccessRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -191,16 +192,16 @@ func OperatorAccessControlAccessRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlAccessRequests(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlAccessRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.AccessRequestsClient
 	Res    *oci_operator_access_control.ListAccessRequestsResponse
 }
@@ -247,9 +248,9 @@ func (s *OperatorAccessControlAccessRequestsDataSourceCrud) Get() error {
 		request.TimeStart = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequests(context.Background(), request)
+	response, err := s.Client.ListAccessRequests(s.Context(), request)
 	if err != nil {
 		return err
 	}
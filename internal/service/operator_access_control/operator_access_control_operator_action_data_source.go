@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorActionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlOperatorAction,
+		ReadContext: readSingularOperatorAccessControlOperatorAction,
 		Schema: map[string]*schema.Schema{
 			"operator_action_id": {
 				Type:     schema.TypeString,
@@ -67,16 +68,16 @@ func OperatorAccessControlOperatorActionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlOperatorAction(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorActionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorActionsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlOperatorActionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.OperatorActionsClient
 	Res    *oci_operator_access_control.GetOperatorActionResponse
 }
@@ -93,9 +94,9 @@ func (s *OperatorAccessControlOperatorActionDataSourceCrud) Get() error {
 		request.OperatorActionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorAction(context.Background(), request)
+	response, err := s.Client.GetOperatorAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorActionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlOperatorActions,
+		ReadContext: readOperatorAccessControlOperatorActions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -107,16 +108,16 @@ func OperatorAccessControlOperatorActionsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlOperatorActions(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorActions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorActionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorActionsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlOperatorActionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.OperatorActionsClient
 	Res    *oci_operator_access_control.ListOperatorActionsResponse
 }
@@ -147,9 +148,9 @@ func (s *OperatorAccessControlOperatorActionsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_operator_access_control.ListOperatorActionsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorActions(context.Background(), request)
+	response, err := s.Client.ListOperatorActions(s.Context(), request)
 	if err != nil {
 		return err
 	}
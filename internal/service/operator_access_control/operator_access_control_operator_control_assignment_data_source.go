@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -23,16 +24,16 @@ func OperatorAccessControlOperatorControlAssignmentDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(OperatorAccessControlOperatorControlAssignmentResource(), fieldMap, readSingularOperatorAccessControlOperatorControlAssignment)
 }
 
-func readSingularOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlAssignmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.OperatorControlAssignmentClient
 	Res    *oci_operator_access_control.GetOperatorControlAssignmentResponse
 }
@@ -49,9 +50,9 @@ func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) Get() err
 		request.OperatorControlAssignmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -21,11 +22,11 @@ func OperatorAccessControlOperatorControlAssignmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOperatorAccessControlOperatorControlAssignment,
-		Read:     readOperatorAccessControlOperatorControlAssignment,
-		Update:   updateOperatorAccessControlOperatorControlAssignment,
-		Delete:   deleteOperatorAccessControlOperatorControlAssignment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOperatorAccessControlOperatorControlAssignment,
+		ReadContext:   readOperatorAccessControlOperatorControlAssignment,
+		UpdateContext: updateOperatorAccessControlOperatorControlAssignment,
+		DeleteContext: deleteOperatorAccessControlOperatorControlAssignment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -156,37 +157,37 @@ func OperatorAccessControlOperatorControlAssignmentResource() *schema.Resource {
 	}
 }
 
-func createOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func createOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func updateOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func deleteOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OperatorAccessControlOperatorControlAssignmentResourceCrud struct {
@@ -317,9 +318,10 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create() er
 		request.TimeAssignmentTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.CreateOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -334,9 +336,9 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error
 	tmp := s.D.Id()
 	request.OperatorControlAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -423,9 +425,9 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() er
 		request.TimeAssignmentTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -445,9 +447,9 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete() er
 	tmp := s.D.Id()
 	request.OperatorControlAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControlAssignment(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControlAssignment(s.Context(), request)
 	return err
 }
 
@@ -626,14 +628,14 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) updateCompa
 	idTmp := s.D.Id()
 	changeCompartmentRequest.OperatorControlAssignmentId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
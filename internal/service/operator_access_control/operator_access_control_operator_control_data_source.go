@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -22,16 +23,16 @@ func OperatorAccessControlOperatorControlDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OperatorAccessControlOperatorControlResource(), fieldMap, readSingularOperatorAccessControlOperatorControl)
 }
 
-func readSingularOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.OperatorControlClient
 	Res    *oci_operator_access_control.GetOperatorControlResponse
 }
@@ -48,9 +49,9 @@ func (s *OperatorAccessControlOperatorControlDataSourceCrud) Get() error {
 		request.OperatorControlId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControl(context.Background(), request)
+	response, err := s.Client.GetOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
@@ -19,11 +20,11 @@ func OperatorAccessControlOperatorControlResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOperatorAccessControlOperatorControl,
-		Read:     readOperatorAccessControlOperatorControl,
-		Update:   updateOperatorAccessControlOperatorControl,
-		Delete:   deleteOperatorAccessControlOperatorControl,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOperatorAccessControlOperatorControl,
+		ReadContext:   readOperatorAccessControlOperatorControl,
+		UpdateContext: updateOperatorAccessControlOperatorControl,
+		DeleteContext: deleteOperatorAccessControlOperatorControl,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"approver_groups_list": {
@@ -132,37 +133,37 @@ func OperatorAccessControlOperatorControlResource() *schema.Resource {
 	}
 }
 
-func createOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func createOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func updateOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func deleteOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OperatorAccessControlOperatorControlResourceCrud struct {
@@ -294,9 +295,10 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Create() error {
 		request.SystemMessage = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControl(context.Background(), request)
+	response, err := s.Client.CreateOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -311,9 +313,9 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.OperatorControlId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControl(context.Background(), request)
+	response, err := s.Client.GetOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,9 +423,9 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
 		request.SystemMessage = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControl(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,9 +445,9 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperatorControlId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControl(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControl(s.Context(), request)
 	return err
 }
 
@@ -562,14 +564,14 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) updateCompartment(com
 	idTmp := s.D.Id()
 	changeCompartmentRequest.OperatorControlId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
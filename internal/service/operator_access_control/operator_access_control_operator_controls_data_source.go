@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v61/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorControlsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlOperatorControls,
+		ReadContext: readOperatorAccessControlOperatorControls,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,16 +53,16 @@ func OperatorAccessControlOperatorControlsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlOperatorControls(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControls(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_operator_access_control.OperatorControlClient
 	Res    *oci_operator_access_control.ListOperatorControlsResponse
 }
@@ -92,9 +93,9 @@ func (s *OperatorAccessControlOperatorControlsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_operator_access_control.ListOperatorControlsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorControls(context.Background(), request)
+	response, err := s.Client.ListOperatorControls(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
@@ -18,7 +19,7 @@ import (
 
 func OpsiAwrHubAwrSnapshotDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiAwrHubAwrSnapshot,
+		ReadContext: readSingularOpsiAwrHubAwrSnapshot,
 		Schema: map[string]*schema.Schema{
 			"awr_hub_id": {
 				Type:     schema.TypeString,
@@ -82,16 +83,16 @@ func OpsiAwrHubAwrSnapshotDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiAwrHubAwrSnapshot(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHubAwrSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSnapshotDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiAwrHubAwrSnapshotDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListAwrSnapshotsResponse
 }
@@ -129,9 +130,9 @@ func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) Get() error {
 		request.TimeLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(s.Context(), request)
 	if err != nil {
 		return err
 	}
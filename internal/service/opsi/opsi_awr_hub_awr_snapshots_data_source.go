@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
@@ -18,7 +19,7 @@ import (
 
 func OpsiAwrHubAwrSnapshotsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiAwrHubAwrSnapshots,
+		ReadContext: readOpsiAwrHubAwrSnapshots,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"awr_hub_id": {
@@ -103,16 +104,16 @@ func OpsiAwrHubAwrSnapshotsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiAwrHubAwrSnapshots(d *schema.ResourceData, m interface{}) error {
+func readOpsiAwrHubAwrSnapshots(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSnapshotsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiAwrHubAwrSnapshotsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListAwrSnapshotsResponse
 }
@@ -150,9 +151,9 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 		request.TimeLessThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +162,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAwrSnapshots(context.Background(), request)
+		listResponse, err := s.Client.ListAwrSnapshots(s.Context(), request)
 		if err != nil {
 			return err
 		}
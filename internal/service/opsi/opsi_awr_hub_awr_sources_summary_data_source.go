@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiAwrHubAwrSourcesSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiAwrHubAwrSourcesSummary,
+		ReadContext: readSingularOpsiAwrHubAwrSourcesSummary,
 		Schema: map[string]*schema.Schema{
 			"awr_hub_id": {
 				Type:     schema.TypeString,
@@ -83,16 +84,16 @@ func OpsiAwrHubAwrSourcesSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiAwrHubAwrSourcesSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHubAwrSourcesSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSourcesSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiAwrHubAwrSourcesSummaryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.SummarizeAwrSourcesSummariesResponse
 }
@@ -119,9 +120,9 @@ func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.SummarizeAwrSourcesSummaries(context.Background(), request)
+	response, err := s.Client.SummarizeAwrSourcesSummaries(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package opsi
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 
@@ -22,16 +23,16 @@ func OpsiAwrHubDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiAwrHubResource(), fieldMap, readSingularOpsiAwrHub)
 }
 
-func readSingularOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiAwrHubDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.GetAwrHubResponse
 }
@@ -48,9 +49,9 @@ func (s *OpsiAwrHubDataSourceCrud) Get() error {
 		request.AwrHubId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.GetAwrHub(context.Background(), request)
+	response, err := s.Client.GetAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
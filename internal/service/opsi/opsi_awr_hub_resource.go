@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiAwrHubResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiAwrHub,
-		Read:     readOpsiAwrHub,
-		Update:   updateOpsiAwrHub,
-		Delete:   deleteOpsiAwrHub,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiAwrHub,
+		ReadContext:   readOpsiAwrHub,
+		UpdateContext: updateOpsiAwrHub,
+		DeleteContext: deleteOpsiAwrHub,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -96,37 +97,37 @@ func OpsiAwrHubResource() *schema.Resource {
 	}
 }
 
-func createOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func createOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func readOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func updateOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiAwrHubResourceCrud struct {
@@ -199,22 +200,23 @@ func (s *OpsiAwrHubResourceCrud) Create() error {
 		request.OperationsInsightsWarehouseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateAwrHub(context.Background(), request)
+	response, err := s.Client.CreateAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAwrHubFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAwrHubFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OpsiAwrHubResourceCrud) getAwrHubFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	awrHubId, err := awrHubWaitForWorkRequest(workId, "opsi",
+	awrHubId, err := awrHubWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -248,9 +250,9 @@ func awrHubWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_c
 	}
 }
 
-func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func awrHubWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = awrHubWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -267,7 +269,7 @@ func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.Ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -279,7 +281,7 @@ func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.Ac
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -296,14 +298,14 @@ func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.Ac
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiAwrHubWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiAwrHubWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiAwrHubWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiAwrHubWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -331,9 +333,9 @@ func (s *OpsiAwrHubResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AwrHubId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetAwrHub(context.Background(), request)
+	response, err := s.Client.GetAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -365,15 +367,15 @@ func (s *OpsiAwrHubResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateAwrHub(context.Background(), request)
+	response, err := s.Client.UpdateAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAwrHubFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAwrHubFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OpsiAwrHubResourceCrud) Delete() error {
@@ -382,16 +384,16 @@ func (s *OpsiAwrHubResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AwrHubId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteAwrHub(context.Background(), request)
+	response, err := s.Client.DeleteAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := awrHubWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := awrHubWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
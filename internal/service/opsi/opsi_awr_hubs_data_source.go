@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiAwrHubsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiAwrHubs,
+		ReadContext: readOpsiAwrHubs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func OpsiAwrHubsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiAwrHubs(d *schema.ResourceData, m interface{}) error {
+func readOpsiAwrHubs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiAwrHubsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListAwrHubsResponse
 }
@@ -114,9 +115,9 @@ func (s *OpsiAwrHubsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListAwrHubs(context.Background(), request)
+	response, err := s.Client.ListAwrHubs(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *OpsiAwrHubsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAwrHubs(context.Background(), request)
+		listResponse, err := s.Client.ListAwrHubs(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiDatabaseInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiDatabaseInsight,
-		Read:     readOpsiDatabaseInsight,
-		Update:   updateOpsiDatabaseInsight,
-		Delete:   deleteOpsiDatabaseInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiDatabaseInsight,
+		ReadContext:   readOpsiDatabaseInsight,
+		UpdateContext: updateOpsiDatabaseInsight,
+		DeleteContext: deleteOpsiDatabaseInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -149,37 +150,37 @@ func OpsiDatabaseInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiDatabaseInsightResourceCrud struct {
@@ -225,9 +226,10 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.CreateDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +237,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -247,10 +249,11 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 		wantedState := strings.ToUpper(status.(string))
 		if oci_opsi.ResourceStatusDisabled == oci_opsi.ResourceStatusEnum(wantedState) {
 			request := oci_opsi.DisableDatabaseInsightRequest{}
-			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+			tfresource.SetCreateRetryToken(s.Context(), &request)
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.DatabaseInsightId = &tmp
-			response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+			response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -258,7 +261,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+			databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -274,7 +277,7 @@ func (s *OpsiDatabaseInsightResourceCrud) getDatabaseInsightFromWorkRequest(work
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -308,9 +311,9 @@ func databaseInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func databaseInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = databaseInsightWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -327,7 +330,7 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -339,7 +342,7 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -356,14 +359,14 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiDatabaseInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiDatabaseInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiDatabaseInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiDatabaseInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -391,9 +394,9 @@ func (s *OpsiDatabaseInsightResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DatabaseInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetDatabaseInsight(context.Background(), request)
+	response, err := s.Client.GetDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -418,16 +421,16 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -448,10 +451,10 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 
 	if disableDatabaseInsight {
 		request := oci_opsi.DisableDatabaseInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -459,7 +462,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+		databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -469,7 +472,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 
 	if enableDatabaseInsight {
 		request := oci_opsi.EnableDatabaseInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
 		err := s.populateTopLevelPolymorphicEnableDatabaseInsightRequest(&request)
@@ -477,7 +480,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.EnableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -485,7 +488,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+		databaseInsightId, err := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -500,10 +503,10 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
 		request := oci_opsi.DisableDatabaseInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -511,7 +514,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := databaseInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -523,16 +526,16 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteDatabaseInsight(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := databaseInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := databaseInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -909,13 +912,13 @@ func (s *OpsiDatabaseInsightResourceCrud) updateCompartment(compartment interfac
 	idTmp := s.D.Id()
 	changeCompartmentRequest.DatabaseInsightId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeDatabaseInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseInsightFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiDatabaseInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiDatabaseInsights,
+		ReadContext: readOpsiDatabaseInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -91,16 +92,16 @@ func OpsiDatabaseInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiDatabaseInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiDatabaseInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiDatabaseInsightsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListDatabaseInsightsResponse
 }
@@ -201,9 +202,9 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListDatabaseInsights(context.Background(), request)
+	response, err := s.Client.ListDatabaseInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +213,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseInsights(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
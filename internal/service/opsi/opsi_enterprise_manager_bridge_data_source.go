@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
@@ -22,16 +23,16 @@ func OpsiEnterpriseManagerBridgeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiEnterpriseManagerBridgeResource(), fieldMap, readSingularOpsiEnterpriseManagerBridge)
 }
 
-func readSingularOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiEnterpriseManagerBridgeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.GetEnterpriseManagerBridgeResponse
 }
@@ -48,9 +49,9 @@ func (s *OpsiEnterpriseManagerBridgeDataSourceCrud) Get() error {
 		request.EnterpriseManagerBridgeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.GetEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.GetEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
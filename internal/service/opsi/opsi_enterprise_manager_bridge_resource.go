@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiEnterpriseManagerBridgeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiEnterpriseManagerBridge,
-		Read:     readOpsiEnterpriseManagerBridge,
-		Update:   updateOpsiEnterpriseManagerBridge,
-		Delete:   deleteOpsiEnterpriseManagerBridge,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiEnterpriseManagerBridge,
+		ReadContext:   readOpsiEnterpriseManagerBridge,
+		UpdateContext: updateOpsiEnterpriseManagerBridge,
+		DeleteContext: deleteOpsiEnterpriseManagerBridge,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -99,37 +100,37 @@ func OpsiEnterpriseManagerBridgeResource() *schema.Resource {
 	}
 }
 
-func createOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func createOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func readOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func updateOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiEnterpriseManagerBridgeResourceCrud struct {
@@ -203,22 +204,23 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Create() error {
 		request.ObjectStorageBucketName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.CreateEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OpsiEnterpriseManagerBridgeResourceCrud) getEnterpriseManagerBridgeFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	enterpriseManagerBridgeId, err := enterpriseManagerBridgeWaitForWorkRequest(workId, "opsi",
+	enterpriseManagerBridgeId, err := enterpriseManagerBridgeWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -252,9 +254,9 @@ func enterpriseManagerBridgeWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func enterpriseManagerBridgeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = enterpriseManagerBridgeWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -271,7 +273,7 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -283,7 +285,7 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -300,14 +302,14 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -335,9 +337,9 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.EnterpriseManagerBridgeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.GetEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,15 +385,15 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.UpdateEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
@@ -400,16 +402,16 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EnterpriseManagerBridgeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.DeleteEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := enterpriseManagerBridgeWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := enterpriseManagerBridgeWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -529,13 +531,13 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) updateCompartment(compartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.EnterpriseManagerBridgeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
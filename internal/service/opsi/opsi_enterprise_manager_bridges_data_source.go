@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiEnterpriseManagerBridgesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiEnterpriseManagerBridges,
+		ReadContext: readOpsiEnterpriseManagerBridges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func OpsiEnterpriseManagerBridgesDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiEnterpriseManagerBridges(d *schema.ResourceData, m interface{}) error {
+func readOpsiEnterpriseManagerBridges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiEnterpriseManagerBridgesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListEnterpriseManagerBridgesResponse
 }
@@ -113,9 +114,9 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+	response, err := s.Client.ListEnterpriseManagerBridges(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+		listResponse, err := s.Client.ListEnterpriseManagerBridges(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
@@ -23,16 +24,16 @@ func OpsiExadataInsightDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiExadataInsightResource(), fieldMap, readSingularOpsiExadataInsight)
 }
 
-func readSingularOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiExadataInsightDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ExadataInsight
 }
@@ -49,9 +50,9 @@ func (s *OpsiExadataInsightDataSourceCrud) Get() error {
 		request.ExadataInsightId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
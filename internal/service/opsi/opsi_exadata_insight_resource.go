@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiExadataInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiExadataInsight,
-		Read:     readOpsiExadataInsight,
-		Update:   updateOpsiExadataInsight,
-		Delete:   deleteOpsiExadataInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiExadataInsight,
+		ReadContext:   readOpsiExadataInsight,
+		UpdateContext: updateOpsiExadataInsight,
+		DeleteContext: deleteOpsiExadataInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -144,37 +145,37 @@ func OpsiExadataInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiExadataInsightResourceCrud struct {
@@ -220,9 +221,10 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateExadataInsight(context.Background(), request)
+	response, err := s.Client.CreateExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -230,7 +232,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -242,10 +244,11 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 		wantedState := strings.ToUpper(status.(string))
 		if oci_opsi.ResourceStatusDisabled == oci_opsi.ResourceStatusEnum(wantedState) {
 			request := oci_opsi.DisableExadataInsightRequest{}
-			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+			tfresource.SetCreateRetryToken(s.Context(), &request)
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.ExadataInsightId = &tmp
-			response, err := s.Client.DisableExadataInsight(context.Background(), request)
+			response, err := s.Client.DisableExadataInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -253,7 +256,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+			exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -269,7 +272,7 @@ func (s *OpsiExadataInsightResourceCrud) getExadataInsightFromWorkRequest(workId
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -303,9 +306,9 @@ func exadataInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func exadataInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = exadataInsightWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -322,7 +325,7 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -334,7 +337,7 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -351,14 +354,14 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiExadataInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiExadataInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiExadataInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiExadataInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -386,9 +389,9 @@ func (s *OpsiExadataInsightResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExadataInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -413,16 +416,16 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateExadataInsight(context.Background(), request)
+	response, err := s.Client.UpdateExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -443,10 +446,10 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 
 	if disableExadataInsight {
 		request := oci_opsi.DisableExadataInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -454,7 +457,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+		exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -464,7 +467,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 
 	if enableExadataInsight {
 		request := oci_opsi.EnableExadataInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
 		err := s.populateTopLevelPolymorphicEnableExadataInsightRequest(&request)
@@ -472,7 +475,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableExadataInsight(context.Background(), request)
+		response, err := s.Client.EnableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -480,7 +483,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+		exadataInsightId, err := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -495,10 +498,10 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
 		request := oci_opsi.DisableExadataInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -506,7 +509,7 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := exadataInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -518,16 +521,16 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExadataInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteExadataInsight(context.Background(), request)
+	response, err := s.Client.DeleteExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := exadataInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := exadataInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -866,13 +869,13 @@ func (s *OpsiExadataInsightResourceCrud) updateCompartment(compartment interface
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ExadataInsightId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeExadataInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeExadataInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getExadataInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getExadataInsightFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
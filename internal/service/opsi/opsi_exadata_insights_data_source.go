@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiExadataInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiExadataInsights,
+		ReadContext: readOpsiExadataInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -73,16 +74,16 @@ func OpsiExadataInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiExadataInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiExadataInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiExadataInsightsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListExadataInsightsResponse
 }
@@ -152,9 +153,9 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListExadataInsights(context.Background(), request)
+	response, err := s.Client.ListExadataInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +164,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExadataInsights(context.Background(), request)
+		listResponse, err := s.Client.ListExadataInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiHostInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiHostInsight,
-		Read:     readOpsiHostInsight,
-		Update:   updateOpsiHostInsight,
-		Delete:   deleteOpsiHostInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiHostInsight,
+		ReadContext:   readOpsiHostInsight,
+		UpdateContext: updateOpsiHostInsight,
+		DeleteContext: deleteOpsiHostInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -163,37 +164,37 @@ func OpsiHostInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiHostInsightResourceCrud struct {
@@ -239,9 +240,10 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateHostInsight(context.Background(), request)
+	response, err := s.Client.CreateHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,7 +251,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -261,10 +263,11 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 		wantedState := strings.ToUpper(status.(string))
 		if oci_opsi.ResourceStatusDisabled == oci_opsi.ResourceStatusEnum(wantedState) {
 			request := oci_opsi.DisableHostInsightRequest{}
-			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+			tfresource.SetCreateRetryToken(s.Context(), &request)
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.HostInsightId = &tmp
-			response, err := s.Client.DisableHostInsight(context.Background(), request)
+			response, err := s.Client.DisableHostInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -272,7 +275,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+			hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -288,7 +291,7 @@ func (s *OpsiHostInsightResourceCrud) getHostInsightFromWorkRequest(workId *stri
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -322,9 +325,9 @@ func hostInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func hostInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = hostInsightWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -341,7 +344,7 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -353,7 +356,7 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -370,14 +373,14 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiHostInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiHostInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiHostInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiHostInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -405,9 +408,9 @@ func (s *OpsiHostInsightResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.HostInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetHostInsight(context.Background(), request)
+	response, err := s.Client.GetHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -432,9 +435,9 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateHostInsight(context.Background(), request)
+	response, err := s.Client.UpdateHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -442,7 +445,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -463,10 +466,10 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 
 	if disableHostInsight {
 		request := oci_opsi.DisableHostInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -474,7 +477,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+		hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -484,7 +487,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 
 	if enableHostInsight {
 		request := oci_opsi.EnableHostInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
 		err := s.populateTopLevelPolymorphicEnableHostInsightRequest(&request)
@@ -492,7 +495,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableHostInsight(context.Background(), request)
+		response, err := s.Client.EnableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -500,7 +503,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+		hostInsightId, err := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -516,10 +519,10 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
 		request := oci_opsi.DisableHostInsightRequest{}
-		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -527,7 +530,7 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := hostInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -540,16 +543,16 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostInsightId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteHostInsight(context.Background(), request)
+	response, err := s.Client.DeleteHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := hostInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := hostInsightWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1011,13 +1014,13 @@ func (s *OpsiHostInsightResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.HostInsightId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeHostInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeHostInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostInsightFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiHostInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiHostInsights,
+		ReadContext: readOpsiHostInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -77,16 +78,16 @@ func OpsiHostInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiHostInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiHostInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiHostInsightsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListHostInsightsResponse
 }
@@ -161,9 +162,9 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListHostInsights(context.Background(), request)
+	response, err := s.Client.ListHostInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +173,7 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostInsights(context.Background(), request)
+		listResponse, err := s.Client.ListHostInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
@@ -22,16 +23,16 @@ func OpsiOperationsInsightsWarehouseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiOperationsInsightsWarehouseResource(), fieldMap, readSingularOpsiOperationsInsightsWarehouse)
 }
 
-func readSingularOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.GetOperationsInsightsWarehouseResponse
 }
@@ -48,9 +49,9 @@ func (s *OpsiOperationsInsightsWarehouseDataSourceCrud) Get() error {
 		request.OperationsInsightsWarehouseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
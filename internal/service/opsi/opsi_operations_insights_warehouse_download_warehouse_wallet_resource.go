@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
@@ -20,10 +21,10 @@ func OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource() *schema.Re
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
-		Read:     readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
-		Delete:   deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		ReadContext:   readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		DeleteContext: deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"operations_insights_warehouse_id": {
@@ -45,19 +46,19 @@ func OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource() *schema.Re
 	}
 }
 
-func createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -85,9 +86,10 @@ func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) Cre
 		request.OperationsInsightsWarehouseWalletPassword = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(context.Background(), request)
+	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiOperationsInsightsWarehouseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiOperationsInsightsWarehouse,
-		Read:     readOpsiOperationsInsightsWarehouse,
-		Update:   updateOpsiOperationsInsightsWarehouse,
-		Delete:   deleteOpsiOperationsInsightsWarehouse,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiOperationsInsightsWarehouse,
+		ReadContext:   readOpsiOperationsInsightsWarehouse,
+		UpdateContext: updateOpsiOperationsInsightsWarehouse,
+		DeleteContext: deleteOpsiOperationsInsightsWarehouse,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -111,37 +112,37 @@ func OpsiOperationsInsightsWarehouseResource() *schema.Resource {
 	}
 }
 
-func createOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func createOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func updateOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OpsiOperationsInsightsWarehouseResourceCrud struct {
@@ -214,22 +215,23 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Create() error {
 		request.StorageAllocatedInGBs = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.CreateOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *OpsiOperationsInsightsWarehouseResourceCrud) getOperationsInsightsWarehouseFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	operationsInsightsWarehouseId, err := operationsInsightsWarehouseWaitForWorkRequest(workId, "opsi",
+	operationsInsightsWarehouseId, err := operationsInsightsWarehouseWaitForWorkRequest(s.Context(), workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -263,9 +265,9 @@ func operationsInsightsWarehouseWorkRequestShouldRetryFunc(timeout time.Duration
 	}
 }
 
-func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func operationsInsightsWarehouseWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = operationsInsightsWarehouseWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_opsi.GetWorkRequestResponse{}
@@ -282,7 +284,7 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -294,7 +296,7 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -311,14 +313,14 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -346,9 +348,9 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsWarehouseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -390,15 +392,15 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update() error {
 		request.StorageAllocatedInGBs = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.UpdateOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
@@ -407,16 +409,16 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsWarehouseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.DeleteOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := operationsInsightsWarehouseWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := operationsInsightsWarehouseWaitForWorkRequest(s.Context(), workId, "opsi",
 		oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
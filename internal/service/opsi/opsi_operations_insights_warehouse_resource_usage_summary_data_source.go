@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary,
+		ReadContext: readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary,
 		Schema: map[string]*schema.Schema{
 			"operations_insights_warehouse_id": {
 				Type:     schema.TypeString,
@@ -38,16 +39,16 @@ func OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSource() *schema.Res
 	}
 }
 
-func readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.SummarizeOperationsInsightsWarehouseResourceUsageResponse
 }
@@ -64,9 +65,9 @@ func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Get(
 		request.OperationsInsightsWarehouseId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(context.Background(), request)
+	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(s.Context(), request)
 	if err != nil {
 		return err
 	}
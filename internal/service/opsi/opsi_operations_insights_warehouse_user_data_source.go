@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
@@ -22,16 +23,16 @@ func OpsiOperationsInsightsWarehouseUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiOperationsInsightsWarehouseUserResource(), fieldMap, readSingularOpsiOperationsInsightsWarehouseUser)
 }
 
-func readSingularOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseUserDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.GetOperationsInsightsWarehouseUserResponse
 }
@@ -48,9 +49,9 @@ func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) Get() error {
 		request.OperationsInsightsWarehouseUserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouseUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiOperationsInsightsWarehouseUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiOperationsInsightsWarehouseUsers,
+		ReadContext: readOpsiOperationsInsightsWarehouseUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func OpsiOperationsInsightsWarehouseUsersDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiOperationsInsightsWarehouseUsers(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouseUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseUsersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListOperationsInsightsWarehouseUsersResponse
 }
@@ -114,9 +115,9 @@ func (s *OpsiOperationsInsightsWarehouseUsersDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListOperationsInsightsWarehouseUsers(context.Background(), request)
+	response, err := s.Client.ListOperationsInsightsWarehouseUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *OpsiOperationsInsightsWarehouseUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOperationsInsightsWarehouseUsers(context.Background(), request)
+		listResponse, err := s.Client.ListOperationsInsightsWarehouseUsers(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v61/opsi"
 )
 
 func OpsiOperationsInsightsWarehousesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiOperationsInsightsWarehouses,
+		ReadContext: readOpsiOperationsInsightsWarehouses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func OpsiOperationsInsightsWarehousesDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiOperationsInsightsWarehouses(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehousesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehousesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_opsi.OperationsInsightsClient
 	Res    *oci_opsi.ListOperationsInsightsWarehousesResponse
 }
@@ -105,9 +106,9 @@ func (s *OpsiOperationsInsightsWarehousesDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "opsi")
 
-	response, err := s.Client.ListOperationsInsightsWarehouses(context.Background(), request)
+	response, err := s.Client.ListOperationsInsightsWarehouses(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +117,7 @@ func (s *OpsiOperationsInsightsWarehousesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOperationsInsightsWarehouses(context.Background(), request)
+		listResponse, err := s.Client.ListOperationsInsightsWarehouses(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerCategoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerCategories,
+		ReadContext: readOptimizerCategories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -138,16 +139,16 @@ func OptimizerCategoriesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerCategories(d *schema.ResourceData, m interface{}) error {
+func readOptimizerCategories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerCategoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerCategoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListCategoriesResponse
 }
@@ -178,9 +179,9 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_optimizer.ListCategoriesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +190,7 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(s.Context(), request)
 		if err != nil {
 			return err
 		}
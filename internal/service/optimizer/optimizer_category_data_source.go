@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerCategoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerCategory,
+		ReadContext: readSingularOptimizerCategory,
 		Schema: map[string]*schema.Schema{
 			"category_id": {
 				Type:     schema.TypeString,
@@ -101,16 +102,16 @@ func OptimizerCategoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerCategory(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerCategory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerCategoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerCategoryDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.GetCategoryResponse
 }
@@ -127,9 +128,9 @@ func (s *OptimizerCategoryDataSourceCrud) Get() error {
 		request.CategoryId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -22,16 +23,16 @@ func OptimizerEnrollmentStatusDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerEnrollmentStatusResource(), fieldMap, readSingularOptimizerEnrollmentStatus)
 }
 
-func readSingularOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerEnrollmentStatusDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.GetEnrollmentStatusResponse
 }
@@ -48,9 +49,9 @@ func (s *OptimizerEnrollmentStatusDataSourceCrud) Get() error {
 		request.EnrollmentStatusId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
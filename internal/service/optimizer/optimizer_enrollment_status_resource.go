@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
@@ -19,11 +20,11 @@ func OptimizerEnrollmentStatusResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerEnrollmentStatus,
-		Read:     readOptimizerEnrollmentStatus,
-		Update:   updateOptimizerEnrollmentStatus,
-		Delete:   deleteOptimizerEnrollmentStatus,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerEnrollmentStatus,
+		ReadContext:   readOptimizerEnrollmentStatus,
+		UpdateContext: updateOptimizerEnrollmentStatus,
+		DeleteContext: deleteOptimizerEnrollmentStatus,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"enrollment_status_id": {
@@ -63,31 +64,31 @@ func OptimizerEnrollmentStatusResource() *schema.Resource {
 	}
 }
 
-func createOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func createOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func readOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -140,9 +141,9 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Create() error {
 		request.Status = oci_optimizer.OptimizerEnrollmentStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -157,9 +158,9 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.EnrollmentStatusId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -178,9 +179,9 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Update() error {
 		request.Status = oci_optimizer.OptimizerEnrollmentStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerEnrollmentStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerEnrollmentStatuses,
+		ReadContext: readOptimizerEnrollmentStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func OptimizerEnrollmentStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerEnrollmentStatuses(d *schema.ResourceData, m interface{}) error {
+func readOptimizerEnrollmentStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerEnrollmentStatusesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListEnrollmentStatusesResponse
 }
@@ -82,9 +83,9 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 		request.Status = oci_optimizer.ListEnrollmentStatusesStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+	response, err := s.Client.ListEnrollmentStatuses(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+		listResponse, err := s.Client.ListEnrollmentStatuses(s.Context(), request)
 		if err != nil {
 			return err
 		}
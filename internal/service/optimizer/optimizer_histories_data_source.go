@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerHistories,
+		ReadContext: readOptimizerHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -166,16 +167,16 @@ func OptimizerHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerHistories(d *schema.ResourceData, m interface{}) error {
+func readOptimizerHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerHistoriesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListHistoriesResponse
 }
@@ -225,9 +226,9 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 		request.Status = oci_optimizer.ListHistoriesStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListHistories(context.Background(), request)
+	response, err := s.Client.ListHistories(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +237,7 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHistories(context.Background(), request)
+		listResponse, err := s.Client.ListHistories(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -22,16 +23,16 @@ func OptimizerProfileDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerProfileResource(), fieldMap, readSingularOptimizerProfile)
 }
 
-func readSingularOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerProfileDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.GetProfileResponse
 }
@@ -48,9 +49,9 @@ func (s *OptimizerProfileDataSourceCrud) Get() error {
 		request.ProfileId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.GetProfile(context.Background(), request)
+	response, err := s.Client.GetProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfileLevelDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerProfileLevel,
+		ReadContext: readSingularOptimizerProfileLevel,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -107,16 +108,16 @@ func OptimizerProfileLevelDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerProfileLevel(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerProfileLevel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileLevelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerProfileLevelDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListProfileLevelsResponse
 }
@@ -148,9 +149,9 @@ func (s *OptimizerProfileLevelDataSourceCrud) Get() error {
 		request.RecommendationName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListProfileLevels(context.Background(), request)
+	response, err := s.Client.ListProfileLevels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfileLevelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerProfileLevels,
+		ReadContext: readOptimizerProfileLevels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -113,16 +114,16 @@ func OptimizerProfileLevelsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerProfileLevels(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfileLevels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileLevelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerProfileLevelsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListProfileLevelsResponse
 }
@@ -154,9 +155,9 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 		request.RecommendationName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListProfileLevels(context.Background(), request)
+	response, err := s.Client.ListProfileLevels(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +166,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfileLevels(context.Background(), request)
+		listResponse, err := s.Client.ListProfileLevels(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
@@ -20,11 +21,11 @@ func OptimizerProfileResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerProfile,
-		Read:     readOptimizerProfile,
-		Update:   updateOptimizerProfile,
-		Delete:   deleteOptimizerProfile,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerProfile,
+		ReadContext:   readOptimizerProfile,
+		UpdateContext: updateOptimizerProfile,
+		DeleteContext: deleteOptimizerProfile,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -189,37 +190,37 @@ func OptimizerProfileResource() *schema.Resource {
 	}
 }
 
-func createOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func createOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OptimizerProfileResourceCrud struct {
@@ -327,9 +328,10 @@ func (s *OptimizerProfileResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.CreateProfile(context.Background(), request)
+	response, err := s.Client.CreateProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -344,9 +346,9 @@ func (s *OptimizerProfileResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ProfileId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetProfile(context.Background(), request)
+	response, err := s.Client.GetProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,9 +423,9 @@ func (s *OptimizerProfileResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateProfile(context.Background(), request)
+	response, err := s.Client.UpdateProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -438,9 +440,9 @@ func (s *OptimizerProfileResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProfileId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	_, err := s.Client.DeleteProfile(context.Background(), request)
+	_, err := s.Client.DeleteProfile(s.Context(), request)
 	return err
 }
 
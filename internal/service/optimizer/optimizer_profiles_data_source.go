@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfilesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerProfiles,
+		ReadContext: readOptimizerProfiles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func OptimizerProfilesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerProfiles(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfiles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfilesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerProfilesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListProfilesResponse
 }
@@ -83,9 +84,9 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_optimizer.ListProfilesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListProfiles(context.Background(), request)
+	response, err := s.Client.ListProfiles(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfiles(context.Background(), request)
+		listResponse, err := s.Client.ListProfiles(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -23,16 +24,16 @@ func OptimizerRecommendationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerRecommendationResource(), fieldMap, readSingularOptimizerRecommendation)
 }
 
-func readSingularOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerRecommendationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.GetRecommendationResponse
 }
@@ -49,9 +50,9 @@ func (s *OptimizerRecommendationDataSourceCrud) Get() error {
 		request.RecommendationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
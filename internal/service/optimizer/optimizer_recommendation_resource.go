@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -21,11 +22,11 @@ func OptimizerRecommendationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerRecommendation,
-		Read:     readOptimizerRecommendation,
-		Update:   updateOptimizerRecommendation,
-		Delete:   deleteOptimizerRecommendation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerRecommendation,
+		ReadContext:   readOptimizerRecommendation,
+		UpdateContext: updateOptimizerRecommendation,
+		DeleteContext: deleteOptimizerRecommendation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"recommendation_id": {
@@ -147,31 +148,31 @@ func OptimizerRecommendationResource() *schema.Resource {
 	}
 }
 
-func createOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func createOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -232,9 +233,9 @@ func (s *OptimizerRecommendationResourceCrud) Create() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,9 +250,9 @@ func (s *OptimizerRecommendationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RecommendationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -278,9 +279,9 @@ func (s *OptimizerRecommendationResourceCrud) Update() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
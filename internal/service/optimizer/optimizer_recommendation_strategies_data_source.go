@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerRecommendationStrategiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerRecommendationStrategies,
+		ReadContext: readOptimizerRecommendationStrategies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -123,16 +124,16 @@ func OptimizerRecommendationStrategiesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerRecommendationStrategies(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendationStrategies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationStrategiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerRecommendationStrategiesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListRecommendationStrategiesResponse
 }
@@ -164,9 +165,9 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 		request.RecommendationName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +176,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendationStrategies(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -16,7 +17,7 @@ import (
 
 func OptimizerRecommendationStrategyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerRecommendationStrategy,
+		ReadContext: readSingularOptimizerRecommendationStrategy,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -116,16 +117,16 @@ func OptimizerRecommendationStrategyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerRecommendationStrategy(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerRecommendationStrategy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationStrategyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerRecommendationStrategyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListRecommendationStrategiesResponse
 }
@@ -157,9 +158,9 @@ func (s *OptimizerRecommendationStrategyDataSourceCrud) Get() error {
 		request.RecommendationName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(s.Context(), request)
 	if err != nil {
 		return err
 	}
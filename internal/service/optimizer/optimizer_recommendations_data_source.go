@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerRecommendationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerRecommendations,
+		ReadContext: readOptimizerRecommendations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"category_id": {
@@ -60,16 +61,16 @@ func OptimizerRecommendationsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerRecommendations(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerRecommendationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListRecommendationsResponse
 }
@@ -109,9 +110,9 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 		request.Status = oci_optimizer.ListRecommendationsStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListRecommendations(context.Background(), request)
+	response, err := s.Client.ListRecommendations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendations(s.Context(), request)
 		if err != nil {
 			return err
 		}
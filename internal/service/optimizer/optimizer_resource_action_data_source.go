@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -23,16 +24,16 @@ func OptimizerResourceActionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerResourceActionResource(), fieldMap, readSingularOptimizerResourceAction)
 }
 
-func readSingularOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerResourceActionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.GetResourceActionResponse
 }
@@ -49,9 +50,9 @@ func (s *OptimizerResourceActionDataSourceCrud) Get() error {
 		request.ResourceActionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -21,11 +22,11 @@ func OptimizerResourceActionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerResourceAction,
-		Read:     readOptimizerResourceAction,
-		Update:   updateOptimizerResourceAction,
-		Delete:   deleteOptimizerResourceAction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerResourceAction,
+		ReadContext:   readOptimizerResourceAction,
+		UpdateContext: updateOptimizerResourceAction,
+		DeleteContext: deleteOptimizerResourceAction,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"resource_action_id": {
@@ -133,31 +134,31 @@ func OptimizerResourceActionResource() *schema.Resource {
 	}
 }
 
-func createOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func createOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func readOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -218,9 +219,9 @@ func (s *OptimizerResourceActionResourceCrud) Create() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,9 +236,9 @@ func (s *OptimizerResourceActionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ResourceActionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,9 +265,9 @@ func (s *OptimizerResourceActionResourceCrud) Update() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
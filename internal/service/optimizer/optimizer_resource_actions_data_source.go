@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v61/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerResourceActionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerResourceActions,
+		ReadContext: readOptimizerResourceActions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -64,16 +65,16 @@ func OptimizerResourceActionsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerResourceActions(d *schema.ResourceData, m interface{}) error {
+func readOptimizerResourceActions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OptimizerResourceActionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_optimizer.OptimizerClient
 	Res    *oci_optimizer.ListResourceActionsResponse
 }
@@ -118,9 +119,9 @@ func (s *OptimizerResourceActionsDataSourceCrud) Get() error {
 		request.Status = oci_optimizer.ListResourceActionsStatusEnum(status.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "optimizer")
 
-	response, err := s.Client.ListResourceActions(context.Background(), request)
+	response, err := s.Client.ListResourceActions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *OptimizerResourceActionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResourceActions(context.Background(), request)
+		listResponse, err := s.Client.ListResourceActions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
@@ -22,16 +23,16 @@ func OsmanagementManagedInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OsmanagementManagedInstanceResource(), fieldMap, readSingularOsmanagementManagedInstance)
 }
 
-func readSingularOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementManagedInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.OsManagementClient
 	Res    *oci_osmanagement.GetManagedInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *OsmanagementManagedInstanceDataSourceCrud) Get() error {
 		request.ManagedInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
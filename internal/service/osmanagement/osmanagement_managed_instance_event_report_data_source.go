@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
@@ -17,7 +18,7 @@ import (
 
 func OsmanagementManagedInstanceEventReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsmanagementManagedInstanceEventReport,
+		ReadContext: readSingularOsmanagementManagedInstanceEventReport,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -44,16 +45,16 @@ func OsmanagementManagedInstanceEventReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsmanagementManagedInstanceEventReport(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstanceEventReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceEventReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementManagedInstanceEventReportDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.EventClient
 	Res    *oci_osmanagement.GetEventReportResponse
 }
@@ -91,9 +92,9 @@ func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) Get() error {
 		request.ManagedInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.GetEventReport(context.Background(), request)
+	response, err := s.Client.GetEventReport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
@@ -22,16 +23,16 @@ func OsmanagementManagedInstanceGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OsmanagementManagedInstanceGroupResource(), fieldMap, readSingularOsmanagementManagedInstanceGroup)
 }
 
-func readSingularOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementManagedInstanceGroupDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.OsManagementClient
 	Res    *oci_osmanagement.GetManagedInstanceGroupResponse
 }
@@ -48,9 +49,9 @@ func (s *OsmanagementManagedInstanceGroupDataSourceCrud) Get() error {
 		request.ManagedInstanceGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.GetManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
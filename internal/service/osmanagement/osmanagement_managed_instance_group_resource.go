@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementManagedInstanceGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstanceGroup,
-		Read:     readOsmanagementManagedInstanceGroup,
-		Update:   updateOsmanagementManagedInstanceGroup,
-		Delete:   deleteOsmanagementManagedInstanceGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstanceGroup,
+		ReadContext:   readOsmanagementManagedInstanceGroup,
+		UpdateContext: updateOsmanagementManagedInstanceGroup,
+		DeleteContext: deleteOsmanagementManagedInstanceGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -95,37 +96,37 @@ func OsmanagementManagedInstanceGroupResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OsmanagementManagedInstanceGroupResourceCrud struct {
@@ -197,9 +198,10 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Create() error {
 		request.OsFamily = oci_osmanagement.OsFamiliesEnum(osFamily.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -214,9 +216,9 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.GetManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,9 +264,9 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -279,9 +281,9 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteManagedInstanceGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedInstanceGroup(s.Context(), request)
 	return err
 }
 
@@ -340,14 +342,14 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) updateCompartment(compart
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ManagedInstanceGroupId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeManagedInstanceGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedInstanceGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
 
 func OsmanagementManagedInstanceGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstanceGroups,
+		ReadContext: readOsmanagementManagedInstanceGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func OsmanagementManagedInstanceGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstanceGroups(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementManagedInstanceGroupsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.OsManagementClient
 	Res    *oci_osmanagement.ListManagedInstanceGroupsResponse
 }
@@ -82,9 +83,9 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_osmanagement.ListManagedInstanceGroupsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+	response, err := s.Client.ListManagedInstanceGroups(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+		listResponse, err := s.Client.ListManagedInstanceGroups(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -13,17 +13,18 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
 
 func OsmanagementManagedInstanceManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstanceManagement,
-		Read:     readOsmanagementManagedInstanceManagement,
-		Update:   updateOsmanagementManagedInstanceManagement,
-		Delete:   deleteOsmanagementManagedInstanceManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstanceManagement,
+		ReadContext:   readOsmanagementManagedInstanceManagement,
+		UpdateContext: updateOsmanagementManagedInstanceManagement,
+		DeleteContext: deleteOsmanagementManagedInstanceManagement,
 		Schema: map[string]*schema.Schema{
 			"managed_instance_id": {
 				Type:     schema.TypeString,
@@ -144,31 +145,31 @@ func OsmanagementManagedInstanceManagementResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -284,9 +285,9 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Get() error {
 		request.ManagedInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -459,7 +460,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(s.Context(), detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -474,7 +475,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(s.Context(), attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -506,7 +507,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(s.Context(), detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -518,7 +519,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(s.Context(), attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -540,7 +541,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 		detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 		detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(s.Context(), detachChildSourceRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 		}
@@ -555,7 +556,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(s.Context(), attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -586,7 +587,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(s.Context(), detachChildSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 			}
@@ -598,7 +599,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(s.Context(), attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -620,7 +621,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 		detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 		detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(s.Context(), detachManagedInstanceFromGroupRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 		}
@@ -635,7 +636,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(s.Context(), attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach managed instance to  managed instance Group, error: %v", attachErr)
 			}
@@ -667,7 +668,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 			detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(s.Context(), detachManagedInstanceFromGroupRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 			}
@@ -679,7 +680,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(s.Context(), attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementManagedInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstance,
-		Read:     readOsmanagementManagedInstance,
-		Update:   updateOsmanagementManagedInstance,
-		Delete:   deleteOsmanagementManagedInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstance,
+		ReadContext:   readOsmanagementManagedInstance,
+		UpdateContext: updateOsmanagementManagedInstance,
+		DeleteContext: deleteOsmanagementManagedInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"managed_instance_id": {
@@ -205,31 +206,31 @@ func OsmanagementManagedInstanceResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -262,9 +263,9 @@ func (s *OsmanagementManagedInstanceResourceCrud) Create() error {
 		request.NotificationTopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -279,9 +280,9 @@ func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -306,9 +307,9 @@ func (s *OsmanagementManagedInstanceResourceCrud) Update() error {
 		request.NotificationTopicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
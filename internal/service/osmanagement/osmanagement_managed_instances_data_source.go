@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
 
 func OsmanagementManagedInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstances,
+		ReadContext: readOsmanagementManagedInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func OsmanagementManagedInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstances(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementManagedInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.OsManagementClient
 	Res    *oci_osmanagement.ListManagedInstancesResponse
 }
@@ -74,9 +75,9 @@ func (s *OsmanagementManagedInstancesDataSourceCrud) Get() error {
 		request.OsFamily = oci_osmanagement.ListManagedInstancesOsFamilyEnum(osFamily.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.ListManagedInstances(context.Background(), request)
+	response, err := s.Client.ListManagedInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *OsmanagementManagedInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedInstances(context.Background(), request)
+		listResponse, err := s.Client.ListManagedInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementSoftwareSourceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementSoftwareSource,
-		Read:     readOsmanagementSoftwareSource,
-		Update:   updateOsmanagementSoftwareSource,
-		Delete:   deleteOsmanagementSoftwareSource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementSoftwareSource,
+		ReadContext:   readOsmanagementSoftwareSource,
+		UpdateContext: updateOsmanagementSoftwareSource,
+		DeleteContext: deleteOsmanagementSoftwareSource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"arch_type": {
@@ -148,37 +149,37 @@ func OsmanagementSoftwareSourceResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type OsmanagementSoftwareSourceResourceCrud struct {
@@ -274,9 +275,10 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Create() error {
 		request.ParentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateSoftwareSource(context.Background(), request)
+	response, err := s.Client.CreateSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -291,9 +293,9 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetSoftwareSource(context.Background(), request)
+	response, err := s.Client.GetSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,9 +360,9 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateSoftwareSource(context.Background(), request)
+	response, err := s.Client.UpdateSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -375,9 +377,9 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteSoftwareSource(context.Background(), request)
+	_, err := s.Client.DeleteSoftwareSource(s.Context(), request)
 	return err
 }
 
@@ -470,14 +472,14 @@ func (s *OsmanagementSoftwareSourceResourceCrud) updateCompartment(compartment i
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SoftwareSourceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeSoftwareSourceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSoftwareSourceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v61/osmanagement"
 )
 
 func OsmanagementSoftwareSourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementSoftwareSources,
+		ReadContext: readOsmanagementSoftwareSources,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,16 +40,16 @@ func OsmanagementSoftwareSourcesDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementSoftwareSources(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementSoftwareSources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsmanagementSoftwareSourcesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osmanagement.OsManagementClient
 	Res    *oci_osmanagement.ListSoftwareSourcesResponse
 }
@@ -74,9 +75,9 @@ func (s *OsmanagementSoftwareSourcesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_osmanagement.ListSoftwareSourcesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osmanagement")
 
-	response, err := s.Client.ListSoftwareSources(context.Background(), request)
+	response, err := s.Client.ListSoftwareSources(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *OsmanagementSoftwareSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSoftwareSources(context.Background(), request)
+		listResponse, err := s.Client.ListSoftwareSources(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v61/ospgateway"
 
@@ -16,7 +17,7 @@ import (
 
 func OspGatewayInvoiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOspGatewayInvoice,
+		ReadContext: readSingularOspGatewayInvoice,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -282,16 +283,16 @@ func OspGatewayInvoiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOspGatewayInvoice(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewayInvoice(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewayInvoiceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.InvoiceServiceClient
 	Res    *oci_osp_gateway.GetInvoiceResponse
 }
@@ -318,9 +319,9 @@ func (s *OspGatewayInvoiceDataSourceCrud) Get() error {
 		request.OspHomeRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.GetInvoice(context.Background(), request)
+	response, err := s.Client.GetInvoice(s.Context(), request)
 	if err != nil {
 		return err
 	}
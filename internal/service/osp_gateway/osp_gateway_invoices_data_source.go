@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	"github.com/oracle/oci-go-sdk/v61/ospgateway"
@@ -18,7 +19,7 @@ import (
 
 func OspGatewayInvoicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewayInvoices,
+		ReadContext: readOspGatewayInvoices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -363,16 +364,16 @@ func OspGatewayInvoicesDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewayInvoices(d *schema.ResourceData, m interface{}) error {
+func readOspGatewayInvoices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewayInvoicesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.InvoiceServiceClient
 	Res    *oci_osp_gateway.ListInvoicesResponse
 }
@@ -462,9 +463,9 @@ func (s *OspGatewayInvoicesDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.ListInvoices(context.Background(), request)
+	response, err := s.Client.ListInvoices(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -473,7 +474,7 @@ func (s *OspGatewayInvoicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoices(context.Background(), request)
+		listResponse, err := s.Client.ListInvoices(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v61/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewayInvoicesInvoiceLineDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOspGatewayInvoicesInvoiceLine,
+		ReadContext: readSingularOspGatewayInvoicesInvoiceLine,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -112,16 +113,16 @@ func OspGatewayInvoicesInvoiceLineDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOspGatewayInvoicesInvoiceLine(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewayInvoicesInvoiceLine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesInvoiceLineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewayInvoicesInvoiceLineDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.InvoiceServiceClient
 	Res    *oci_osp_gateway.ListInvoiceLinesResponse
 }
@@ -148,9 +149,9 @@ func (s *OspGatewayInvoicesInvoiceLineDataSourceCrud) Get() error {
 		request.OspHomeRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.ListInvoiceLines(context.Background(), request)
+	response, err := s.Client.ListInvoiceLines(s.Context(), request)
 	if err != nil {
 		return err
 	}
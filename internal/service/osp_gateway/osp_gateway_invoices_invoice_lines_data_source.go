@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v61/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewayInvoicesInvoiceLinesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewayInvoicesInvoiceLines,
+		ReadContext: readOspGatewayInvoicesInvoiceLines,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -120,16 +121,16 @@ func OspGatewayInvoicesInvoiceLinesDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewayInvoicesInvoiceLines(d *schema.ResourceData, m interface{}) error {
+func readOspGatewayInvoicesInvoiceLines(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesInvoiceLinesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewayInvoicesInvoiceLinesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.InvoiceServiceClient
 	Res    *oci_osp_gateway.ListInvoiceLinesResponse
 }
@@ -156,9 +157,9 @@ func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get() error {
 		request.OspHomeRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.ListInvoiceLines(context.Background(), request)
+	response, err := s.Client.ListInvoiceLines(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoiceLines(context.Background(), request)
+		listResponse, err := s.Client.ListInvoiceLines(s.Context(), request)
 		if err != nil {
 			return err
 		}
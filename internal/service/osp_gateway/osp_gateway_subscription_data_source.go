@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v61/ospgateway"
 
@@ -30,16 +31,16 @@ func OspGatewaySubscriptionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OspGatewaySubscriptionResource(), fieldMap, readSingularOspGatewaySubscription)
 }
 
-func readSingularOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewaySubscriptionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.SubscriptionServiceClient
 	Res    *oci_osp_gateway.GetSubscriptionResponse
 }
@@ -66,9 +67,9 @@ func (s *OspGatewaySubscriptionDataSourceCrud) Get() error {
 		request.SubscriptionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
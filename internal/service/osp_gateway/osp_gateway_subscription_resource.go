@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -26,11 +27,11 @@ func OspGatewaySubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOspGatewaySubscription,
-		Read:     readOspGatewaySubscription,
-		Update:   updateOspGatewaySubscription,
-		Delete:   deleteOspGatewaySubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOspGatewaySubscription,
+		ReadContext:   readOspGatewaySubscription,
+		UpdateContext: updateOspGatewaySubscription,
+		DeleteContext: deleteOspGatewaySubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -535,31 +536,31 @@ func OspGatewaySubscriptionResource() *schema.Resource {
 	}
 }
 
-func createOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func createOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func readOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func updateOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func deleteOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -608,9 +609,9 @@ func (s *OspGatewaySubscriptionResourceCrud) Create() error {
 		request.SubscriptionId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.UpdateSubscription(context.Background(), request)
+	response, err := s.Client.UpdateSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -642,9 +643,9 @@ func (s *OspGatewaySubscriptionResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -685,9 +686,9 @@ func (s *OspGatewaySubscriptionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.UpdateSubscription(context.Background(), request)
+	response, err := s.Client.UpdateSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
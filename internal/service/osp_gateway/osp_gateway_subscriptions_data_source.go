@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v61/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewaySubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewaySubscriptions,
+		ReadContext: readOspGatewaySubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,16 +45,16 @@ func OspGatewaySubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewaySubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOspGatewaySubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OspGatewaySubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osp_gateway.SubscriptionServiceClient
 	Res    *oci_osp_gateway.ListSubscriptionsResponse
 }
@@ -75,9 +76,9 @@ func (s *OspGatewaySubscriptionsDataSourceCrud) Get() error {
 		request.OspHomeRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osp_gateway")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *OspGatewaySubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
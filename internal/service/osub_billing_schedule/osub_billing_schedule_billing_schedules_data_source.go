@@ -6,6 +6,7 @@ package osub_billing_schedule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_billing_schedule "github.com/oracle/oci-go-sdk/v61/osubbillingschedule"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubBillingScheduleBillingSchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubBillingScheduleBillingSchedules,
+		ReadContext: readOsubBillingScheduleBillingSchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -117,16 +118,16 @@ func OsubBillingScheduleBillingSchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readOsubBillingScheduleBillingSchedules(d *schema.ResourceData, m interface{}) error {
+func readOsubBillingScheduleBillingSchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubBillingScheduleBillingSchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BillingScheduleClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubBillingScheduleBillingSchedulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_billing_schedule.BillingScheduleClient
 	Res    *oci_osub_billing_schedule.ListBillingSchedulesResponse
 }
@@ -158,9 +159,9 @@ func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_billing_schedule")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_billing_schedule")
 
-	response, err := s.Client.ListBillingSchedules(context.Background(), request)
+	response, err := s.Client.ListBillingSchedules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +170,7 @@ func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBillingSchedules(context.Background(), request)
+		listResponse, err := s.Client.ListBillingSchedules(s.Context(), request)
 		if err != nil {
 			return err
 		}
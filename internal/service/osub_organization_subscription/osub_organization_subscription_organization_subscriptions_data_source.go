@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_organization_subscription "github.com/oracle/oci-go-sdk/v61/osuborganizationsubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OsubOrganizationSubscriptionOrganizationSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubOrganizationSubscriptionOrganizationSubscriptions,
+		ReadContext: readOsubOrganizationSubscriptionOrganizationSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -101,16 +102,16 @@ func OsubOrganizationSubscriptionOrganizationSubscriptionsDataSource() *schema.R
 	}
 }
 
-func readOsubOrganizationSubscriptionOrganizationSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOsubOrganizationSubscriptionOrganizationSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OrganizationSubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_organization_subscription.OrganizationSubscriptionClient
 	Res    *oci_osub_organization_subscription.ListOrganizationSubscriptionsResponse
 }
@@ -137,9 +138,9 @@ func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Ge
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_organization_subscription")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_organization_subscription")
 
-	response, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+	response, err := s.Client.ListOrganizationSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Ge
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListOrganizationSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
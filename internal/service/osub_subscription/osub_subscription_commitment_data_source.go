@@ -6,6 +6,7 @@ package osub_subscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_subscription "github.com/oracle/oci-go-sdk/v61/osubsubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubSubscriptionCommitmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsubSubscriptionCommitment,
+		ReadContext: readSingularOsubSubscriptionCommitment,
 		Schema: map[string]*schema.Schema{
 			"commitment_id": {
 				Type:     schema.TypeString,
@@ -58,16 +59,16 @@ func OsubSubscriptionCommitmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsubSubscriptionCommitment(d *schema.ResourceData, m interface{}) error {
+func readSingularOsubSubscriptionCommitment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubSubscriptionCommitmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CommitmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubSubscriptionCommitmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_subscription.CommitmentClient
 	Res    *oci_osub_subscription.GetCommitmentResponse
 }
@@ -94,9 +95,9 @@ func (s *OsubSubscriptionCommitmentDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_subscription")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_subscription")
 
-	response, err := s.Client.GetCommitment(context.Background(), request)
+	response, err := s.Client.GetCommitment(s.Context(), request)
 	if err != nil {
 		return err
 	}
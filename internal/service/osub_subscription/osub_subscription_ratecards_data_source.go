@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_osub_subscription "github.com/oracle/oci-go-sdk/v61/osubsubscription"
@@ -18,7 +19,7 @@ import (
 
 func OsubSubscriptionRatecardsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubSubscriptionRatecards,
+		ReadContext: readOsubSubscriptionRatecards,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -173,16 +174,16 @@ func OsubSubscriptionRatecardsDataSource() *schema.Resource {
 	}
 }
 
-func readOsubSubscriptionRatecards(d *schema.ResourceData, m interface{}) error {
+func readOsubSubscriptionRatecards(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubSubscriptionRatecardsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RatecardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubSubscriptionRatecardsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_subscription.RatecardClient
 	Res    *oci_osub_subscription.ListRateCardsResponse
 }
@@ -230,9 +231,9 @@ func (s *OsubSubscriptionRatecardsDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_subscription")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_subscription")
 
-	response, err := s.Client.ListRateCards(context.Background(), request)
+	response, err := s.Client.ListRateCards(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +242,7 @@ func (s *OsubSubscriptionRatecardsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRateCards(context.Background(), request)
+		listResponse, err := s.Client.ListRateCards(s.Context(), request)
 		if err != nil {
 			return err
 		}
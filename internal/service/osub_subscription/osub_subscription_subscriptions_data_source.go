@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_subscription "github.com/oracle/oci-go-sdk/v61/osubsubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OsubSubscriptionSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubSubscriptionSubscriptions,
+		ReadContext: readOsubSubscriptionSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"buyer_email": {
@@ -264,16 +265,16 @@ func OsubSubscriptionSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOsubSubscriptionSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOsubSubscriptionSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubSubscriptionSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubSubscriptionSubscriptionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_subscription.SubscriptionClient
 	Res    *oci_osub_subscription.ListSubscriptionsResponse
 }
@@ -320,9 +321,9 @@ func (s *OsubSubscriptionSubscriptionsDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_subscription")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_subscription")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -331,7 +332,7 @@ func (s *OsubSubscriptionSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
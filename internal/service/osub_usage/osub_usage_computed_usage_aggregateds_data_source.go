@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_osub_usage "github.com/oracle/oci-go-sdk/v61/osubusage"
@@ -17,7 +18,7 @@ import (
 
 func OsubUsageComputedUsageAggregatedsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubUsageComputedUsageAggregateds,
+		ReadContext: readOsubUsageComputedUsageAggregateds,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -220,16 +221,16 @@ func OsubUsageComputedUsageAggregatedsDataSource() *schema.Resource {
 	}
 }
 
-func readOsubUsageComputedUsageAggregateds(d *schema.ResourceData, m interface{}) error {
+func readOsubUsageComputedUsageAggregateds(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubUsageComputedUsageAggregatedsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubUsageComputedUsageAggregatedsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_usage.ComputedUsageClient
 	Res    *oci_osub_usage.ListComputedUsageAggregatedsResponse
 }
@@ -281,9 +282,9 @@ func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_usage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_usage")
 
-	response, err := s.Client.ListComputedUsageAggregateds(context.Background(), request)
+	response, err := s.Client.ListComputedUsageAggregateds(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputedUsageAggregateds(context.Background(), request)
+		listResponse, err := s.Client.ListComputedUsageAggregateds(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package osub_usage
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_usage "github.com/oracle/oci-go-sdk/v61/osubusage"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubUsageComputedUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsubUsageComputedUsage,
+		ReadContext: readSingularOsubUsageComputedUsage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -211,16 +212,16 @@ func OsubUsageComputedUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsubUsageComputedUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularOsubUsageComputedUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubUsageComputedUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type OsubUsageComputedUsageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_osub_usage.ComputedUsageClient
 	Res    *oci_osub_usage.GetComputedUsageResponse
 }
@@ -260,9 +261,9 @@ func (s *OsubUsageComputedUsageDataSourceCrud) Get() error {
 		request.XOneOriginRegion = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_usage")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "osub_usage")
 
-	response, err := s.Client.GetComputedUsage(context.Background(), request)
+	response, err := s.Client.GetComputedUsage(s.Context(), request)
 	if err != nil {
 		return err
 	}
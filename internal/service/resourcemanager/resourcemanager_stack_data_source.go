@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v61/resourcemanager"
@@ -17,7 +18,7 @@ import (
 
 func ResourcemanagerStackDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularResourcemanagerStack,
+		ReadContext: readSingularResourcemanagerStack,
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
 				Type:     schema.TypeString,
@@ -93,16 +94,16 @@ func ResourcemanagerStackDataSource() *schema.Resource {
 	}
 }
 
-func readSingularResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+func readSingularResourcemanagerStack(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStackDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ResourcemanagerStackDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_resourcemanager.ResourceManagerClient
 	Res    *oci_resourcemanager.GetStackResponse
 }
@@ -119,9 +120,9 @@ func (s *ResourcemanagerStackDataSourceCrud) Get() error {
 		request.StackId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "resourcemanager")
 
-	response, err := s.Client.GetStack(context.Background(), request)
+	response, err := s.Client.GetStack(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v61/resourcemanager"
 
@@ -17,7 +18,7 @@ import (
 
 func ResourcemanagerStackTfStateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularResourcemanagerStackTfState,
+		ReadContext: readSingularResourcemanagerStackTfState,
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
 				Type:     schema.TypeString,
@@ -32,16 +33,16 @@ func ResourcemanagerStackTfStateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularResourcemanagerStackTfState(d *schema.ResourceData, m interface{}) error {
+func readSingularResourcemanagerStackTfState(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStackTfStateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ResourcemanagerStackTfStateDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_resourcemanager.ResourceManagerClient
 	Res    *oci_resourcemanager.GetStackTfStateResponse
 }
@@ -58,9 +59,9 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) Get() error {
 		request.StackId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "resourcemanager")
 
-	response, err := s.Client.GetStackTfState(context.Background(), request)
+	response, err := s.Client.GetStackTfState(s.Context(), request)
 	if err != nil {
 		return err
 	}
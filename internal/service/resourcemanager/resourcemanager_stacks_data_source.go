@@ -6,6 +6,7 @@ package resourcemanager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v61/resourcemanager"
@@ -16,7 +17,7 @@ import (
 
 func ResourcemanagerStacksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readResourcemanagerStacks,
+		ReadContext: readResourcemanagerStacks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -129,16 +130,16 @@ func ResourcemanagerStacksDataSource() *schema.Resource {
 	}
 }
 
-func readResourcemanagerStacks(d *schema.ResourceData, m interface{}) error {
+func readResourcemanagerStacks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStacksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ResourcemanagerStacksDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_resourcemanager.ResourceManagerClient
 	Res    *oci_resourcemanager.ListStacksResponse
 }
@@ -169,9 +170,9 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 		request.LifecycleState = oci_resourcemanager.StackLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "resourcemanager")
 
-	response, err := s.Client.ListStacks(context.Background(), request)
+	response, err := s.Client.ListStacks(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListStacks(context.Background(), request)
+		listResponse, err := s.Client.ListStacks(s.Context(), request)
 		if err != nil {
 			return err
 		}
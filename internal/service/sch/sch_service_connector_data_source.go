@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_sch "github.com/oracle/oci-go-sdk/v61/sch"
 )
@@ -22,16 +23,16 @@ func SchServiceConnectorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(SchServiceConnectorResource(), fieldMap, readSingularSchServiceConnector)
 }
 
-func readSingularSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func readSingularSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type SchServiceConnectorDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_sch.ServiceConnectorClient
 	Res    *oci_sch.GetServiceConnectorResponse
 }
@@ -48,9 +49,9 @@ func (s *SchServiceConnectorDataSourceCrud) Get() error {
 		request.ServiceConnectorId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func SchServiceConnectorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createSchServiceConnector,
-		Read:     readSchServiceConnector,
-		Update:   updateSchServiceConnector,
-		Delete:   deleteSchServiceConnector,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createSchServiceConnector,
+		ReadContext:   readSchServiceConnector,
+		UpdateContext: updateSchServiceConnector,
+		DeleteContext: deleteSchServiceConnector,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -363,9 +364,10 @@ func SchServiceConnectorResource() *schema.Resource {
 	}
 }
 
-func createSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func createSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
 	var powerOff = false
@@ -376,30 +378,31 @@ func createSchServiceConnector(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResourceContext(ctx, d, sync); e != nil {
+		return tfresource.ToDiagnostics(sync, e)
 	}
 
 	if powerOff {
 		if err := sync.StopSchResource(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateInactive)
 	}
 	return nil
 }
 
-func readSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func readSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func updateSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
+	sync.SetContext(ctx)
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
 	// switch to power on
@@ -416,32 +419,32 @@ func updateSchServiceConnector(d *schema.ResourceData, m interface{}) error {
 
 	if powerOn {
 		if err := sync.StartSchResource(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResourceContext(ctx, d, sync); err != nil {
+		return tfresource.ToDiagnostics(sync, err)
 	}
 
 	// switch to power off
 	if powerOff {
 		if err := sync.StopSchResource(); err != nil {
-			return err
+			return tfresource.ToDiagnostics(sync, err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateInactive)
 	}
 	return nil
 }
 
-func deleteSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func deleteSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type SchServiceConnectorResourceCrud struct {
@@ -548,22 +551,23 @@ func (s *SchServiceConnectorResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.CreateServiceConnector(context.Background(), request)
+	response, err := s.Client.CreateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *SchServiceConnectorResourceCrud) getServiceConnectorFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_sch.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	serviceConnectorId, err := serviceConnectorWaitForWorkRequest(workId, "serviceConnector",
+	serviceConnectorId, err := serviceConnectorWaitForWorkRequest(s.Context(), workId, "serviceConnector",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -599,9 +603,9 @@ func serviceConnectorWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action oci_sch.ActionTypeEnum,
+func serviceConnectorWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_sch.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_sch.ServiceConnectorClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "sch")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "sch")
 	retryPolicy.ShouldRetryOperation = serviceConnectorWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_sch.GetWorkRequestResponse{}
@@ -618,7 +622,7 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_sch.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -630,7 +634,7 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -647,14 +651,14 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_sch.OperationStatusFailed || response.Status == oci_sch.OperationStatusCanceled {
-		return nil, getErrorFromSchServiceConnectorWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromSchServiceConnectorWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromSchServiceConnectorWorkRequest(client *oci_sch.ServiceConnectorClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_sch.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromSchServiceConnectorWorkRequest(ctx context.Context, client *oci_sch.ServiceConnectorClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_sch.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_sch.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -682,9 +686,9 @@ func (s *SchServiceConnectorResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ServiceConnectorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -769,15 +773,15 @@ func (s *SchServiceConnectorResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.UpdateServiceConnector(context.Background(), request)
+	response, err := s.Client.UpdateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *SchServiceConnectorResourceCrud) Delete() error {
@@ -786,16 +790,16 @@ func (s *SchServiceConnectorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceConnectorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeleteServiceConnector(context.Background(), request)
+	response, err := s.Client.DeleteServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := serviceConnectorWaitForWorkRequest(workId, "serviceConnector",
+	_, delWorkRequestErr := serviceConnectorWaitForWorkRequest(s.Context(), workId, "serviceConnector",
 		oci_sch.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1432,15 +1436,15 @@ func (s *SchServiceConnectorResourceCrud) updateCompartment(compartment interfac
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ServiceConnectorId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ChangeServiceConnectorCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeServiceConnectorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "serviceConnector"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "serviceConnector"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *SchServiceConnectorResourceCrud) StartSchResource() error {
@@ -1449,15 +1453,15 @@ func (s *SchServiceConnectorResourceCrud) StartSchResource() error {
 	tmp := s.D.Id()
 	request.ServiceConnectorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ActivateServiceConnector(context.Background(), request)
+	response, err := s.Client.ActivateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *SchServiceConnectorResourceCrud) StopSchResource() error {
@@ -1466,13 +1470,13 @@ func (s *SchServiceConnectorResourceCrud) StopSchResource() error {
 	tmp := s.D.Id()
 	request.ServiceConnectorId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeactivateServiceConnector(context.Background(), request)
+	response, err := s.Client.DeactivateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
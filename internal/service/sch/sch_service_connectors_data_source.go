@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_sch "github.com/oracle/oci-go-sdk/v61/sch"
 )
 
 func SchServiceConnectorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSchServiceConnectors,
+		ReadContext: readSchServiceConnectors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func SchServiceConnectorsDataSource() *schema.Resource {
 	}
 }
 
-func readSchServiceConnectors(d *schema.ResourceData, m interface{}) error {
+func readSchServiceConnectors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type SchServiceConnectorsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_sch.ServiceConnectorClient
 	Res    *oci_sch.ListServiceConnectorsResponse
 }
@@ -83,9 +84,9 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_sch.ListServiceConnectorsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "sch")
 
-	response, err := s.Client.ListServiceConnectors(context.Background(), request)
+	response, err := s.Client.ListServiceConnectors(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListServiceConnectors(s.Context(), request)
 		if err != nil {
 			return err
 		}
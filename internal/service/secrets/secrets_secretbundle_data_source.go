@@ -12,13 +12,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_secrets "github.com/oracle/oci-go-sdk/v61/secrets"
 )
 
 func SecretsSecretbundleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularSecretsSecretbundle,
+		ReadContext: readSingularSecretsSecretbundle,
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:     schema.TypeString,
@@ -91,16 +92,16 @@ func SecretsSecretbundleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularSecretsSecretbundle(d *schema.ResourceData, m interface{}) error {
+func readSingularSecretsSecretbundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SecretsSecretbundleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SecretsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type SecretsSecretbundleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_secrets.SecretsClient
 	Res    *oci_secrets.GetSecretBundleResponse
 }
@@ -135,9 +136,9 @@ func (s *SecretsSecretbundleDataSourceCrud) Get() error {
 		request.VersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "secrets")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "secrets")
 
-	response, err := s.Client.GetSecretBundle(context.Background(), request)
+	response, err := s.Client.GetSecretBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_secrets "github.com/oracle/oci-go-sdk/v61/secrets"
 )
 
 func SecretsSecretbundleVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSecretsSecretbundleVersions,
+		ReadContext: readSecretsSecretbundleVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"secret_id": {
@@ -71,16 +72,16 @@ func SecretsSecretbundleVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readSecretsSecretbundleVersions(d *schema.ResourceData, m interface{}) error {
+func readSecretsSecretbundleVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SecretsSecretbundleVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SecretsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type SecretsSecretbundleVersionsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_secrets.SecretsClient
 	Res    *oci_secrets.ListSecretBundleVersionsResponse
 }
@@ -97,9 +98,9 @@ func (s *SecretsSecretbundleVersionsDataSourceCrud) Get() error {
 		request.SecretId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "secrets")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "secrets")
 
-	response, err := s.Client.ListSecretBundleVersions(context.Background(), request)
+	response, err := s.Client.ListSecretBundleVersions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *SecretsSecretbundleVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecretBundleVersions(context.Background(), request)
+		listResponse, err := s.Client.ListSecretBundleVersions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -22,16 +23,16 @@ func ServiceCatalogPrivateApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogPrivateApplicationResource(), fieldMap, readSingularServiceCatalogPrivateApplication)
 }
 
-func readSingularServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.GetPrivateApplicationResponse
 }
@@ -48,9 +49,9 @@ func (s *ServiceCatalogPrivateApplicationDataSourceCrud) Get() error {
 		request.PrivateApplicationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplication(context.Background(), request)
+	response, err := s.Client.GetPrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -16,7 +17,7 @@ import (
 
 func ServiceCatalogPrivateApplicationPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularServiceCatalogPrivateApplicationPackage,
+		ReadContext: readSingularServiceCatalogPrivateApplicationPackage,
 		Schema: map[string]*schema.Schema{
 			"private_application_package_id": {
 				Type:     schema.TypeString,
@@ -55,16 +56,16 @@ func ServiceCatalogPrivateApplicationPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularServiceCatalogPrivateApplicationPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogPrivateApplicationPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationPackageDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.GetPrivateApplicationPackageResponse
 }
@@ -81,9 +82,9 @@ func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) Get() error {
 		request.PrivateApplicationPackageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplicationPackage(context.Background(), request)
+	response, err := s.Client.GetPrivateApplicationPackage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceCatalogPrivateApplicationPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceCatalogPrivateApplicationPackages,
+		ReadContext: readServiceCatalogPrivateApplicationPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -94,16 +95,16 @@ func ServiceCatalogPrivateApplicationPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readServiceCatalogPrivateApplicationPackages(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogPrivateApplicationPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationPackagesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.ListPrivateApplicationPackagesResponse
 }
@@ -143,9 +144,9 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 		request.PrivateApplicationPackageId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+	response, err := s.Client.ListPrivateApplicationPackages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateApplicationPackages(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceCatalogPrivateApplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceCatalogPrivateApplication,
-		Read:     readServiceCatalogPrivateApplication,
-		Update:   updateServiceCatalogPrivateApplication,
-		Delete:   deleteServiceCatalogPrivateApplication,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceCatalogPrivateApplication,
+		ReadContext:   readServiceCatalogPrivateApplication,
+		UpdateContext: updateServiceCatalogPrivateApplication,
+		DeleteContext: deleteServiceCatalogPrivateApplication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -153,37 +154,37 @@ func ServiceCatalogPrivateApplicationResource() *schema.Resource {
 	}
 }
 
-func createServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func createServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func updateServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func deleteServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ServiceCatalogPrivateApplicationResourceCrud struct {
@@ -272,9 +273,10 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Create() error {
 		request.ShortDescription = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreatePrivateApplication(context.Background(), request)
+	response, err := s.Client.CreatePrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -289,7 +291,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) getPrivateApplicationFrom
 	actionTypeEnum oci_service_catalog.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	privateApplicationId, err := privateApplicationWaitForWorkRequest(workId, "service_catalog",
+	privateApplicationId, err := privateApplicationWaitForWorkRequest(s.Context(), workId, "service_catalog",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -335,9 +337,9 @@ func privateApplicationWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func privateApplicationWaitForWorkRequest(wId *string, entityType string, action oci_service_catalog.ActionTypeEnum,
+func privateApplicationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_catalog.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_catalog.ServiceCatalogClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_catalog")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "service_catalog")
 	retryPolicy.ShouldRetryOperation = privateApplicationWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_service_catalog.GetWorkRequestResponse{}
@@ -349,7 +351,7 @@ func privateApplicationWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_catalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -361,7 +363,7 @@ func privateApplicationWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -378,14 +380,14 @@ func privateApplicationWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_catalog.OperationStatusFailed {
-		return nil, getErrorFromServiceCatalogPrivateApplicationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceCatalogPrivateApplicationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceCatalogPrivateApplicationWorkRequest(client *oci_service_catalog.ServiceCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_catalog.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceCatalogPrivateApplicationWorkRequest(ctx context.Context, client *oci_service_catalog.ServiceCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_catalog.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_catalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -413,9 +415,9 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PrivateApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplication(context.Background(), request)
+	response, err := s.Client.GetPrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -471,9 +473,9 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Update() error {
 		request.ShortDescription = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.UpdatePrivateApplication(context.Background(), request)
+	response, err := s.Client.UpdatePrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -491,9 +493,9 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateApplicationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeletePrivateApplication(context.Background(), request)
+	_, err := s.Client.DeletePrivateApplication(s.Context(), request)
 	return err
 
 	//if err != nil {
@@ -657,9 +659,9 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) updateCompartment(compart
 	idTmp := s.D.Id()
 	changeCompartmentRequest.PrivateApplicationId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.ChangePrivateApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePrivateApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
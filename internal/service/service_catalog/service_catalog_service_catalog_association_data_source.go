@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -22,16 +23,16 @@ func ServiceCatalogServiceCatalogAssociationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogServiceCatalogAssociationResource(), fieldMap, readSingularServiceCatalogServiceCatalogAssociation)
 }
 
-func readSingularServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.GetServiceCatalogAssociationResponse
 }
@@ -48,9 +49,9 @@ func (s *ServiceCatalogServiceCatalogAssociationDataSourceCrud) Get() error {
 		request.ServiceCatalogAssociationId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.GetServiceCatalogAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
@@ -19,10 +20,10 @@ func ServiceCatalogServiceCatalogAssociationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceCatalogServiceCatalogAssociation,
-		Read:     readServiceCatalogServiceCatalogAssociation,
-		Delete:   deleteServiceCatalogServiceCatalogAssociation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceCatalogServiceCatalogAssociation,
+		ReadContext:   readServiceCatalogServiceCatalogAssociation,
+		DeleteContext: deleteServiceCatalogServiceCatalogAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"entity_id": {
@@ -53,29 +54,29 @@ func ServiceCatalogServiceCatalogAssociationResource() *schema.Resource {
 	}
 }
 
-func createServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func createServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationResourceCrud struct {
@@ -107,9 +108,10 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create() error {
 		request.ServiceCatalogId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalogAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -124,9 +126,9 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogAssociationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.GetServiceCatalogAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -141,9 +143,9 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogAssociationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalogAssociation(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalogAssociation(s.Context(), request)
 	return err
 }
 
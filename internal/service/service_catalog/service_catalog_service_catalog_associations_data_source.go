@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceCatalogServiceCatalogAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceCatalogServiceCatalogAssociations,
+		ReadContext: readServiceCatalogServiceCatalogAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"entity_id": {
@@ -52,16 +53,16 @@ func ServiceCatalogServiceCatalogAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readServiceCatalogServiceCatalogAssociations(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalogAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.ListServiceCatalogAssociationsResponse
 }
@@ -93,9 +94,9 @@ func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get() error {
 		request.ServiceCatalogId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.ListServiceCatalogAssociations(context.Background(), request)
+	response, err := s.Client.ListServiceCatalogAssociations(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceCatalogAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListServiceCatalogAssociations(s.Context(), request)
 		if err != nil {
 			return err
 		}
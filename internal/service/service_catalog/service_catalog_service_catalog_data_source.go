@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
 
@@ -22,16 +23,16 @@ func ServiceCatalogServiceCatalogDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogServiceCatalogResource(), fieldMap, readSingularServiceCatalogServiceCatalog)
 }
 
-func readSingularServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_catalog.ServiceCatalogClient
 	Res    *oci_service_catalog.GetServiceCatalogResponse
 }
@@ -48,9 +49,9 @@ func (s *ServiceCatalogServiceCatalogDataSourceCrud) Get() error {
 		request.ServiceCatalogId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v61/servicecatalog"
@@ -19,11 +20,11 @@ func ServiceCatalogServiceCatalogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceCatalogServiceCatalog,
-		Read:     readServiceCatalogServiceCatalog,
-		Update:   updateServiceCatalogServiceCatalog,
-		Delete:   deleteServiceCatalogServiceCatalog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceCatalogServiceCatalog,
+		ReadContext:   readServiceCatalogServiceCatalog,
+		UpdateContext: updateServiceCatalogServiceCatalog,
+		DeleteContext: deleteServiceCatalogServiceCatalog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -67,37 +68,37 @@ func ServiceCatalogServiceCatalogResource() *schema.Resource {
 	}
 }
 
-func createServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func createServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func updateServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func deleteServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type ServiceCatalogServiceCatalogResourceCrud struct {
@@ -156,9 +157,10 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalog(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -173,9 +175,9 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -216,9 +218,9 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.UpdateServiceCatalog(context.Background(), request)
+	response, err := s.Client.UpdateServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -233,9 +235,9 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalog(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalog(s.Context(), request)
 	return err
 }
 
@@ -300,14 +302,14 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) updateCompartment(compartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ServiceCatalogId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.ChangeServiceCatalogCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceCatalogCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package service_manager_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v61/servicemanagerproxy"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceManagerProxyServiceEnvironmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularServiceManagerProxyServiceEnvironment,
+		ReadContext: readSingularServiceManagerProxyServiceEnvironment,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -92,16 +93,16 @@ func ServiceManagerProxyServiceEnvironmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularServiceManagerProxyServiceEnvironment(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceManagerProxyServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceManagerProxyServiceEnvironmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceManagerProxyClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceManagerProxyServiceEnvironmentDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_manager_proxy.ServiceManagerProxyClient
 	Res    *oci_service_manager_proxy.GetServiceEnvironmentResponse
 }
@@ -123,9 +124,9 @@ func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) Get() error {
 		request.ServiceEnvironmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_manager_proxy")
 
-	response, err := s.Client.GetServiceEnvironment(context.Background(), request)
+	response, err := s.Client.GetServiceEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_manager_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v61/servicemanagerproxy"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceManagerProxyServiceEnvironmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceManagerProxyServiceEnvironments,
+		ReadContext: readServiceManagerProxyServiceEnvironments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -141,16 +142,16 @@ func ServiceManagerProxyServiceEnvironmentsDataSource() *schema.Resource {
 	}
 }
 
-func readServiceManagerProxyServiceEnvironments(d *schema.ResourceData, m interface{}) error {
+func readServiceManagerProxyServiceEnvironments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceManagerProxyServiceEnvironmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceManagerProxyClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type ServiceManagerProxyServiceEnvironmentsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_service_manager_proxy.ServiceManagerProxyClient
 	Res    *oci_service_manager_proxy.ListServiceEnvironmentsResponse
 }
@@ -182,9 +183,9 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 		request.ServiceEnvironmentType = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "service_manager_proxy")
 
-	response, err := s.Client.ListServiceEnvironments(context.Background(), request)
+	response, err := s.Client.ListServiceEnvironments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +194,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceEnvironments(context.Background(), request)
+		listResponse, err := s.Client.ListServiceEnvironments(s.Context(), request)
 		if err != nil {
 			return err
 		}
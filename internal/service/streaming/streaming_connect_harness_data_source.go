@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_streaming "github.com/oracle/oci-go-sdk/v61/streaming"
 )
@@ -22,16 +23,16 @@ func StreamingConnectHarnessDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(StreamingConnectHarnessResource(), fieldMap, readSingularStreamingConnectHarness)
 }
 
-func readSingularStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func readSingularStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type StreamingConnectHarnessDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_streaming.StreamAdminClient
 	Res    *oci_streaming.GetConnectHarnessResponse
 }
@@ -48,9 +49,9 @@ func (s *StreamingConnectHarnessDataSourceCrud) Get() error {
 		request.ConnectHarnessId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "streaming")
 
-	response, err := s.Client.GetConnectHarness(context.Background(), request)
+	response, err := s.Client.GetConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v61/streaming"
@@ -19,11 +20,11 @@ func StreamingConnectHarnessResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingConnectHarness,
-		Read:     readStreamingConnectHarness,
-		Update:   updateStreamingConnectHarness,
-		Delete:   deleteStreamingConnectHarness,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingConnectHarness,
+		ReadContext:   readStreamingConnectHarness,
+		UpdateContext: updateStreamingConnectHarness,
+		DeleteContext: deleteStreamingConnectHarness,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -68,37 +69,37 @@ func StreamingConnectHarnessResource() *schema.Resource {
 	}
 }
 
-func createStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func createStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func readStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func updateStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type StreamingConnectHarnessResourceCrud struct {
@@ -173,9 +174,10 @@ func (s *StreamingConnectHarnessResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateConnectHarness(context.Background(), request)
+	response, err := s.Client.CreateConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -190,9 +192,9 @@ func (s *StreamingConnectHarnessResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ConnectHarnessId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetConnectHarness(context.Background(), request)
+	response, err := s.Client.GetConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,9 +230,9 @@ func (s *StreamingConnectHarnessResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateConnectHarness(context.Background(), request)
+	response, err := s.Client.UpdateConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,9 +247,9 @@ func (s *StreamingConnectHarnessResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConnectHarnessId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteConnectHarness(context.Background(), request)
+	_, err := s.Client.DeleteConnectHarness(s.Context(), request)
 	return err
 }
 
@@ -288,14 +290,14 @@ func (s *StreamingConnectHarnessResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ConnectHarnessId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeConnectHarnessCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeConnectHarnessCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_streaming "github.com/oracle/oci-go-sdk/v61/streaming"
 )
 
 func StreamingConnectHarnessesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readStreamingConnectHarnesses,
+		ReadContext: readStreamingConnectHarnesses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func StreamingConnectHarnessesDataSource() *schema.Resource {
 	}
 }
 
-func readStreamingConnectHarnesses(d *schema.ResourceData, m interface{}) error {
+func readStreamingConnectHarnesses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type StreamingConnectHarnessesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_streaming.StreamAdminClient
 	Res    *oci_streaming.ListConnectHarnessesResponse
 }
@@ -83,9 +84,9 @@ func (s *StreamingConnectHarnessesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_streaming.ConnectHarnessSummaryLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "streaming")
 
-	response, err := s.Client.ListConnectHarnesses(context.Background(), request)
+	response, err := s.Client.ListConnectHarnesses(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *StreamingConnectHarnessesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnectHarnesses(context.Background(), request)
+		listResponse, err := s.Client.ListConnectHarnesses(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v61/streaming"
@@ -20,11 +21,11 @@ func StreamingStreamPoolResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingStreamPool,
-		Read:     readStreamingStreamPool,
-		Update:   updateStreamingStreamPool,
-		Delete:   deleteStreamingStreamPool,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingStreamPool,
+		ReadContext:   readStreamingStreamPool,
+		UpdateContext: updateStreamingStreamPool,
+		DeleteContext: deleteStreamingStreamPool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,37 +175,37 @@ func StreamingStreamPoolResource() *schema.Resource {
 	}
 }
 
-func createStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func createStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func readStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func updateStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type StreamingStreamPoolResourceCrud struct {
@@ -312,9 +313,10 @@ func (s *StreamingStreamPoolResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStreamPool(context.Background(), request)
+	response, err := s.Client.CreateStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,9 +331,9 @@ func (s *StreamingStreamPoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.StreamPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStreamPool(context.Background(), request)
+	response, err := s.Client.GetStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -394,9 +396,9 @@ func (s *StreamingStreamPoolResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.StreamPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStreamPool(context.Background(), request)
+	response, err := s.Client.UpdateStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -411,9 +413,9 @@ func (s *StreamingStreamPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamPoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStreamPool(context.Background(), request)
+	_, err := s.Client.DeleteStreamPool(s.Context(), request)
 	return err
 }
 
@@ -600,14 +602,14 @@ func (s *StreamingStreamPoolResourceCrud) updateCompartment(compartment interfac
 	idTmp := s.D.Id()
 	changeCompartmentRequest.StreamPoolId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamPoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
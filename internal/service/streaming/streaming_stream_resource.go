@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v61/streaming"
@@ -19,11 +20,11 @@ func StreamingStreamResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingStream,
-		Read:     readStreamingStream,
-		Update:   updateStreamingStream,
-		Delete:   deleteStreamingStream,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingStream,
+		ReadContext:   readStreamingStream,
+		UpdateContext: updateStreamingStream,
+		DeleteContext: deleteStreamingStream,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"name": {
@@ -91,37 +92,37 @@ func StreamingStreamResource() *schema.Resource {
 	}
 }
 
-func createStreamingStream(d *schema.ResourceData, m interface{}) error {
+func createStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readStreamingStream(d *schema.ResourceData, m interface{}) error {
+func readStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateStreamingStream(d *schema.ResourceData, m interface{}) error {
+func updateStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteStreamingStream(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type StreamingStreamResourceCrud struct {
@@ -211,9 +212,9 @@ func (s *StreamingStreamResourceCrud) Create() error {
 		request.StreamPoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStream(context.Background(), request)
+	response, err := s.Client.CreateStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,9 +229,9 @@ func (s *StreamingStreamResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.StreamId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStream(context.Background(), request)
+	response, err := s.Client.GetStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -271,9 +272,9 @@ func (s *StreamingStreamResourceCrud) Update() error {
 		request.StreamPoolId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStream(context.Background(), request)
+	response, err := s.Client.UpdateStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -288,9 +289,9 @@ func (s *StreamingStreamResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStream(context.Background(), request)
+	_, err := s.Client.DeleteStream(s.Context(), request)
 	return err
 }
 
@@ -347,14 +348,14 @@ func (s *StreamingStreamResourceCrud) updateCompartment(compartment interface{})
 	idTmp := s.D.Id()
 	changeCompartmentRequest.StreamId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
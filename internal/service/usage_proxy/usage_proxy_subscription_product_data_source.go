@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionProductDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularUsageProxySubscriptionProduct,
+		ReadContext: readSingularUsageProxySubscriptionProduct,
 		Schema: map[string]*schema.Schema{
 			"producttype": {
 				Type:     schema.TypeString,
@@ -71,16 +72,16 @@ func UsageProxySubscriptionProductDataSource() *schema.Resource {
 	}
 }
 
-func readSingularUsageProxySubscriptionProduct(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionProduct(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionProductDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionProductDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListProductsResponse
 }
@@ -111,9 +112,9 @@ func (s *UsageProxySubscriptionProductDataSourceCrud) Get() error {
 		request.UsagePeriodKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListProducts(context.Background(), request)
+	response, err := s.Client.ListProducts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionProductsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionProducts,
+		ReadContext: readUsageProxySubscriptionProducts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"producttype": {
@@ -92,16 +93,16 @@ func UsageProxySubscriptionProductsDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionProducts(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionProducts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionProductsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionProductsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListProductsResponse
 }
@@ -132,9 +133,9 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 		request.UsagePeriodKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListProducts(context.Background(), request)
+	response, err := s.Client.ListProducts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +144,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProducts(context.Background(), request)
+		listResponse, err := s.Client.ListProducts(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -26,16 +27,16 @@ func UsageProxySubscriptionRedeemableUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(UsageProxySubscriptionRedeemableUserResource(), fieldMap, readSingularUsageProxySubscriptionRedeemableUser)
 }
 
-func readSingularUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionRedeemableUserDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListRedeemableUsersResponse
 }
@@ -57,9 +58,9 @@ func (s *UsageProxySubscriptionRedeemableUserDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
@@ -24,10 +25,10 @@ func UsageProxySubscriptionRedeemableUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createUsageProxySubscriptionRedeemableUser,
-		Read:     readUsageProxySubscriptionRedeemableUser,
-		Delete:   deleteUsageProxySubscriptionRedeemableUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createUsageProxySubscriptionRedeemableUser,
+		ReadContext:   readUsageProxySubscriptionRedeemableUser,
+		DeleteContext: deleteUsageProxySubscriptionRedeemableUser,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subscription_id": {
@@ -73,29 +74,29 @@ func UsageProxySubscriptionRedeemableUserResource() *schema.Resource {
 	}
 }
 
-func createUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func createUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func deleteUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func deleteUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type UsageProxySubscriptionRedeemableUserResourceCrud struct {
@@ -144,9 +145,10 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.CreateRedeemableUser(context.Background(), request)
+	response, err := s.Client.CreateRedeemableUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,9 +178,9 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,9 +206,9 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "usage_proxy")
 
-	_, err := s.Client.DeleteRedeemableUser(context.Background(), request)
+	_, err := s.Client.DeleteRedeemableUser(s.Context(), request)
 	return err
 }
 
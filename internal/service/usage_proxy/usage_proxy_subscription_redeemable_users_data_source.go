@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRedeemableUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionRedeemableUsers,
+		ReadContext: readUsageProxySubscriptionRedeemableUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"subscription_id": {
@@ -44,16 +45,16 @@ func UsageProxySubscriptionRedeemableUsersDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionRedeemableUsers(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRedeemableUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionRedeemableUsersDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListRedeemableUsersResponse
 }
@@ -75,9 +76,9 @@ func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRedeemableUsers(context.Background(), request)
+		listResponse, err := s.Client.ListRedeemableUsers(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRewardDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularUsageProxySubscriptionReward,
+		ReadContext: readSingularUsageProxySubscriptionReward,
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:     schema.TypeString,
@@ -124,16 +125,16 @@ func UsageProxySubscriptionRewardDataSource() *schema.Resource {
 	}
 }
 
-func readSingularUsageProxySubscriptionReward(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionReward(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRewardDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionRewardDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListRewardsResponse
 }
@@ -155,9 +156,9 @@ func (s *UsageProxySubscriptionRewardDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListRewards(context.Background(), request)
+	response, err := s.Client.ListRewards(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v61/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRewardsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionRewards,
+		ReadContext: readUsageProxySubscriptionRewards,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"subscription_id": {
@@ -145,16 +146,16 @@ func UsageProxySubscriptionRewardsDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionRewards(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRewards(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRewardsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type UsageProxySubscriptionRewardsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_usage_proxy.RewardsClient
 	Res    *oci_usage_proxy.ListRewardsResponse
 }
@@ -176,9 +177,9 @@ func (s *UsageProxySubscriptionRewardsDataSourceCrud) Get() error {
 		request.TenancyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "usage_proxy")
 
-	response, err := s.Client.ListRewards(context.Background(), request)
+	response, err := s.Client.ListRewards(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v61/vault"
 
@@ -23,16 +24,16 @@ func VaultSecretDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VaultSecretResource(), fieldMap, readSingularVaultSecret)
 }
 
-func readSingularVaultSecret(d *schema.ResourceData, m interface{}) error {
+func readSingularVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VaultSecretDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vault.VaultsClient
 	Res    *oci_vault.GetSecretResponse
 }
@@ -49,9 +50,9 @@ func (s *VaultSecretDataSourceCrud) Get() error {
 		request.SecretId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vault")
 
-	response, err := s.Client.GetSecret(context.Background(), request)
+	response, err := s.Client.GetSecret(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func VaultSecretResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVaultSecret,
-		Read:     readVaultSecret,
-		Update:   updateVaultSecret,
-		Delete:   deleteVaultSecret,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVaultSecret,
+		ReadContext:   readVaultSecret,
+		UpdateContext: updateVaultSecret,
+		DeleteContext: deleteVaultSecret,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -193,36 +194,36 @@ func VaultSecretResource() *schema.Resource {
 	}
 }
 
-func createVaultSecret(d *schema.ResourceData, m interface{}) error {
+func createVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVaultSecret(d *schema.ResourceData, m interface{}) error {
+func readVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVaultSecret(d *schema.ResourceData, m interface{}) error {
+func updateVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVaultSecret(d *schema.ResourceData, m interface{}) error {
+func deleteVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VaultSecretResourceCrud struct {
@@ -334,9 +335,10 @@ func (s *VaultSecretResourceCrud) Create() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.CreateSecret(context.Background(), request)
+	response, err := s.Client.CreateSecret(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,9 +353,9 @@ func (s *VaultSecretResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SecretId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.GetSecret(context.Background(), request)
+	response, err := s.Client.GetSecret(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -435,9 +437,9 @@ func (s *VaultSecretResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.UpdateSecret(context.Background(), request)
+	response, err := s.Client.UpdateSecret(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,9 +454,9 @@ func (s *VaultSecretResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecretId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vault")
 
-	_, err := s.Client.ScheduleSecretDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleSecretDeletion(s.Context(), request)
 	return err
 }
 
@@ -648,14 +650,14 @@ func (s *VaultSecretResourceCrud) updateCompartment(compartment interface{}) err
 	idTmp := s.D.Id()
 	changeCompartmentRequest.SecretId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vault")
 
-	_, err := s.Client.ChangeSecretCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecretCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
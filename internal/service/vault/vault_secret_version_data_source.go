@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v61/vault"
 )
 
 func VaultSecretVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularVaultSecretVersion,
+		ReadContext: readSingularVaultSecretVersion,
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:     schema.TypeString,
@@ -63,16 +64,16 @@ func VaultSecretVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularVaultSecretVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularVaultSecretVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VaultSecretVersionDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vault.VaultsClient
 	Res    *oci_vault.GetSecretVersionResponse
 }
@@ -98,9 +99,9 @@ func (s *VaultSecretVersionDataSourceCrud) Get() error {
 		request.SecretVersionNumber = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vault")
 
-	response, err := s.Client.GetSecretVersion(context.Background(), request)
+	response, err := s.Client.GetSecretVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v61/vault"
 
@@ -15,7 +16,7 @@ import (
 
 func VaultSecretsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVaultSecrets,
+		ReadContext: readVaultSecrets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,16 +44,16 @@ func VaultSecretsDataSource() *schema.Resource {
 	}
 }
 
-func readVaultSecrets(d *schema.ResourceData, m interface{}) error {
+func readVaultSecrets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VaultSecretsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vault.VaultsClient
 	Res    *oci_vault.ListSecretsResponse
 }
@@ -83,9 +84,9 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 		request.VaultId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vault")
 
-	response, err := s.Client.ListSecrets(context.Background(), request)
+	response, err := s.Client.ListSecrets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecrets(context.Background(), request)
+		listResponse, err := s.Client.ListSecrets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -3,6 +3,7 @@ package visual_builder
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -16,7 +17,7 @@ func VisualBuilderVbInstanceApplicationsDataSource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Read: readVisualBuilderVbInstanceApplication,
+		ReadContext: readVisualBuilderVbInstanceApplication,
 		Schema: map[string]*schema.Schema{
 			"vb_instance_id": {
 				Type:     schema.TypeString,
@@ -65,16 +66,16 @@ func VisualBuilderVbInstanceApplicationsDataSource() *schema.Resource {
 	}
 }
 
-func readVisualBuilderVbInstanceApplication(d *schema.ResourceData, m interface{}) error {
+func readVisualBuilderVbInstanceApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceApplicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VisualBuilderVbInstanceApplicationsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_visual_builder.VbInstanceClient
 	Res    *oci_visual_builder.RequestSummarizedApplicationsResponse
 }
@@ -99,9 +100,9 @@ func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) Get() error {
 		request.IdcsOpenId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "visual_builder")
 
-	response, err := s.Client.RequestSummarizedApplications(context.Background(), request)
+	response, err := s.Client.RequestSummarizedApplications(s.Context(), request)
 	if err != nil {
 		return err
 	}
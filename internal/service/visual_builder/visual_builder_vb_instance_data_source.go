@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_visual_builder "github.com/oracle/oci-go-sdk/v61/visualbuilder"
 )
@@ -22,16 +23,16 @@ func VisualBuilderVbInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VisualBuilderVbInstanceResource(), fieldMap, readSingularVisualBuilderVbInstance)
 }
 
-func readSingularVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VisualBuilderVbInstanceDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_visual_builder.VbInstanceClient
 	Res    *oci_visual_builder.GetVbInstanceResponse
 }
@@ -48,9 +49,9 @@ func (s *VisualBuilderVbInstanceDataSourceCrud) Get() error {
 		request.VbInstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func VisualBuilderVbInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVisualBuilderVbInstance,
-		Read:     readVisualBuilderVbInstance,
-		Update:   updateVisualBuilderVbInstance,
-		Delete:   deleteVisualBuilderVbInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVisualBuilderVbInstance,
+		ReadContext:   readVisualBuilderVbInstance,
+		UpdateContext: updateVisualBuilderVbInstance,
+		DeleteContext: deleteVisualBuilderVbInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -162,37 +163,37 @@ func VisualBuilderVbInstanceResource() *schema.Resource {
 	}
 }
 
-func createVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func createVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func readVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func updateVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func deleteVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VisualBuilderVbInstanceResourceCrud struct {
@@ -302,15 +303,16 @@ func (s *VisualBuilderVbInstanceResourceCrud) Create() error {
 		request.NodeCount = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.CreateVbInstance(context.Background(), request)
+	response, err := s.Client.CreateVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *VisualBuilderVbInstanceResourceCrud) getVbInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
@@ -318,7 +320,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) getVbInstanceFromWorkRequest(workI
 
 	// Wait until it finishes
 	// entityType is visualbuilder and not visual_builder
-	vbInstanceId, err := vbInstanceWaitForWorkRequest(workId, "visualbuilder",
+	vbInstanceId, err := vbInstanceWaitForWorkRequest(s.Context(), workId, "visualbuilder",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -352,9 +354,9 @@ func vbInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum,
+func vbInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_visual_builder.VbInstanceClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "visual_builder")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "visual_builder")
 	retryPolicy.ShouldRetryOperation = vbInstanceWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_visual_builder.GetWorkRequestResponse{}
@@ -371,7 +373,7 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_visual_builder.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -383,7 +385,7 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -400,14 +402,14 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_visual_builder.WorkRequestStatusFailed || response.Status == oci_visual_builder.WorkRequestStatusCanceled {
-		return nil, getErrorFromVisualBuilderVbInstanceWorkRequest(client, response.CompartmentId, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVisualBuilderVbInstanceWorkRequest(ctx, client, response.CompartmentId, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVisualBuilderVbInstanceWorkRequest(client *oci_visual_builder.VbInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVisualBuilderVbInstanceWorkRequest(ctx context.Context, client *oci_visual_builder.VbInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_visual_builder.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			WorkRequestId: workId,
@@ -436,9 +438,9 @@ func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VbInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -522,15 +524,15 @@ func (s *VisualBuilderVbInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VbInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.UpdateVbInstance(context.Background(), request)
+	response, err := s.Client.UpdateVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
@@ -539,9 +541,9 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VbInstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.DeleteVbInstance(context.Background(), request)
+	response, err := s.Client.DeleteVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -549,7 +551,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
 	// entityType is visualbuilder and not visual_builder
-	_, delWorkRequestErr := vbInstanceWaitForWorkRequest(workId, "visualbuilder",
+	_, delWorkRequestErr := vbInstanceWaitForWorkRequest(s.Context(), workId, "visualbuilder",
 		oci_visual_builder.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -741,13 +743,13 @@ func (s *VisualBuilderVbInstanceResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.VbInstanceId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.ChangeVbInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVbInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
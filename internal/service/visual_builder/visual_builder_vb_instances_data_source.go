@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_visual_builder "github.com/oracle/oci-go-sdk/v61/visualbuilder"
 )
 
 func VisualBuilderVbInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVisualBuilderVbInstances,
+		ReadContext: readVisualBuilderVbInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func VisualBuilderVbInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readVisualBuilderVbInstances(d *schema.ResourceData, m interface{}) error {
+func readVisualBuilderVbInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VisualBuilderVbInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_visual_builder.VbInstanceClient
 	Res    *oci_visual_builder.ListVbInstancesResponse
 }
@@ -83,9 +84,9 @@ func (s *VisualBuilderVbInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_visual_builder.ListVbInstancesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "visual_builder")
 
-	response, err := s.Client.ListVbInstances(context.Background(), request)
+	response, err := s.Client.ListVbInstances(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VisualBuilderVbInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVbInstances(context.Background(), request)
+		listResponse, err := s.Client.ListVbInstances(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
 )
@@ -22,16 +23,16 @@ func VulnerabilityScanningContainerScanRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningContainerScanRecipeResource(), fieldMap, readSingularVulnerabilityScanningContainerScanRecipe)
 }
 
-func readSingularVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VulnerabilityScanningContainerScanRecipeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
 	Res    *oci_vulnerability_scanning.GetContainerScanRecipeResponse
 }
@@ -48,9 +49,9 @@ func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) Get() error {
 		request.ContainerScanRecipeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func VulnerabilityScanningContainerScanRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningContainerScanRecipe,
-		Read:     readVulnerabilityScanningContainerScanRecipe,
-		Update:   updateVulnerabilityScanningContainerScanRecipe,
-		Delete:   deleteVulnerabilityScanningContainerScanRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningContainerScanRecipe,
+		ReadContext:   readVulnerabilityScanningContainerScanRecipe,
+		UpdateContext: updateVulnerabilityScanningContainerScanRecipe,
+		DeleteContext: deleteVulnerabilityScanningContainerScanRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -97,37 +98,37 @@ func VulnerabilityScanningContainerScanRecipeResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VulnerabilityScanningContainerScanRecipeResourceCrud struct {
@@ -201,22 +202,23 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) getContainerScanRecipeFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	containerScanRecipeId, err := containerScanRecipeWaitForWorkRequest(workId, "containerScanRecipe",
+	containerScanRecipeId, err := containerScanRecipeWaitForWorkRequest(s.Context(), workId, "containerScanRecipe",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -250,9 +252,9 @@ func containerScanRecipeWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func containerScanRecipeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = containerScanRecipeWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_vulnerability_scanning.GetWorkRequestResponse{}
@@ -267,7 +269,7 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -279,7 +281,7 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -296,14 +298,14 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -331,9 +333,9 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ContainerScanRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -385,15 +387,15 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
@@ -402,16 +404,16 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ContainerScanRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := containerScanRecipeWaitForWorkRequest(workId, "containerScanRecipe",
+	_, delWorkRequestErr := containerScanRecipeWaitForWorkRequest(s.Context(), workId, "containerScanRecipe",
 		oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -519,14 +521,14 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) updateCompartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ContainerScanRecipeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func VulnerabilityScanningContainerScanTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningContainerScanTarget,
-		Read:     readVulnerabilityScanningContainerScanTarget,
-		Update:   updateVulnerabilityScanningContainerScanTarget,
-		Delete:   deleteVulnerabilityScanningContainerScanTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningContainerScanTarget,
+		ReadContext:   readVulnerabilityScanningContainerScanTarget,
+		UpdateContext: updateVulnerabilityScanningContainerScanTarget,
+		DeleteContext: deleteVulnerabilityScanningContainerScanTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -129,37 +130,37 @@ func VulnerabilityScanningContainerScanTargetResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VulnerabilityScanningContainerScanTargetResourceCrud struct {
@@ -243,22 +244,23 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.CreateContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *VulnerabilityScanningContainerScanTargetResourceCrud) getContainerScanTargetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	containerScanTargetId, err := containerScanTargetWaitForWorkRequest(workId, "containerScanTarget",
+	containerScanTargetId, err := containerScanTargetWaitForWorkRequest(s.Context(), workId, "containerScanTarget",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -292,9 +294,9 @@ func containerScanTargetWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func containerScanTargetWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func containerScanTargetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = containerScanTargetWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_vulnerability_scanning.GetWorkRequestResponse{}
@@ -309,7 +311,7 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -321,7 +323,7 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -338,14 +340,14 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -373,9 +375,9 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ContainerScanTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanTarget(context.Background(), request)
+	response, err := s.Client.GetContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -437,15 +439,15 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
@@ -454,16 +456,16 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ContainerScanTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := containerScanTargetWaitForWorkRequest(workId, "containerScanTarget",
+	_, delWorkRequestErr := containerScanTargetWaitForWorkRequest(s.Context(), workId, "containerScanTarget",
 		oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -632,14 +634,14 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) updateCompartment
 	idTmp := s.D.Id()
 	changeCompartmentRequest.ContainerScanTargetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
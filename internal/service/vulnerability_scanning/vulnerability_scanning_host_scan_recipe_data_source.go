@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
 )
@@ -22,16 +23,16 @@ func VulnerabilityScanningHostScanRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningHostScanRecipeResource(), fieldMap, readSingularVulnerabilityScanningHostScanRecipe)
 }
 
-func readSingularVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanRecipeDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
 	Res    *oci_vulnerability_scanning.GetHostScanRecipeResponse
 }
@@ -48,9 +49,9 @@ func (s *VulnerabilityScanningHostScanRecipeDataSourceCrud) Get() error {
 		request.HostScanRecipeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanRecipe(context.Background(), request)
+	response, err := s.Client.GetHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func VulnerabilityScanningHostScanRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningHostScanRecipe,
-		Read:     readVulnerabilityScanningHostScanRecipe,
-		Update:   updateVulnerabilityScanningHostScanRecipe,
-		Delete:   deleteVulnerabilityScanningHostScanRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningHostScanRecipe,
+		ReadContext:   readVulnerabilityScanningHostScanRecipe,
+		UpdateContext: updateVulnerabilityScanningHostScanRecipe,
+		DeleteContext: deleteVulnerabilityScanningHostScanRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agent_settings": {
@@ -210,37 +211,37 @@ func VulnerabilityScanningHostScanRecipeResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VulnerabilityScanningHostScanRecipeResourceCrud struct {
@@ -336,9 +337,10 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -353,9 +355,9 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.HostScanRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanRecipe(context.Background(), request)
+	response, err := s.Client.GetHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -429,20 +431,20 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *VulnerabilityScanningHostScanRecipeResourceCrud) getHostScanRecipeFromWorkRequest(workId *string, policy *common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 	// Wait until it finishes
-	vssHostScanRecipeId, err := hostScanRecipeWaitForWorkRequest(workId, "hostScanRecipe",
+	vssHostScanRecipeId, err := hostScanRecipeWaitForWorkRequest(s.Context(), workId, "hostScanRecipe",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -460,9 +462,9 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) getHostScanRecipeFromW
 	return s.Get()
 }
 
-func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func hostScanRecipeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = hostScanWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_vulnerability_scanning.GetWorkRequestResponse{}
@@ -477,7 +479,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -489,7 +491,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -505,7 +507,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 	}
 
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromHostScanRecipeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromHostScanRecipeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -534,8 +536,8 @@ func hostScanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func getErrorFromHostScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromHostScanRecipeWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -563,14 +565,14 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostScanRecipeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	_, delWorkRequestErr := hostScanRecipeWaitForWorkRequest(workId, "hostScanRecipe", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	_, delWorkRequestErr := hostScanRecipeWaitForWorkRequest(s.Context(), workId, "hostScanRecipe", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
@@ -847,14 +849,14 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) updateCompartment(comp
 	idTmp := s.D.Id()
 	changeCompartmentRequest.HostScanRecipeId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
 )
 
 func VulnerabilityScanningHostScanRecipesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVulnerabilityScanningHostScanRecipes,
+		ReadContext: readVulnerabilityScanningHostScanRecipes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func VulnerabilityScanningHostScanRecipesDataSource() *schema.Resource {
 	}
 }
 
-func readVulnerabilityScanningHostScanRecipes(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanRecipes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanRecipesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
 	Res    *oci_vulnerability_scanning.ListHostScanRecipesResponse
 }
@@ -83,9 +84,9 @@ func (s *VulnerabilityScanningHostScanRecipesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_vulnerability_scanning.ListHostScanRecipesLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vulnerability_scanning")
 
-	response, err := s.Client.ListHostScanRecipes(context.Background(), request)
+	response, err := s.Client.ListHostScanRecipes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VulnerabilityScanningHostScanRecipesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostScanRecipes(context.Background(), request)
+		listResponse, err := s.Client.ListHostScanRecipes(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
 )
@@ -22,16 +23,16 @@ func VulnerabilityScanningHostScanTargetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningHostScanTargetResource(), fieldMap, readSingularVulnerabilityScanningHostScanTarget)
 }
 
-func readSingularVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanTargetDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
 	Res    *oci_vulnerability_scanning.GetHostScanTargetResponse
 }
@@ -48,9 +49,9 @@ func (s *VulnerabilityScanningHostScanTargetDataSourceCrud) Get() error {
 		request.HostScanTargetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanTarget(context.Background(), request)
+	response, err := s.Client.GetHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
@@ -26,11 +27,11 @@ func VulnerabilityScanningHostScanTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningHostScanTarget,
-		Read:     readVulnerabilityScanningHostScanTarget,
-		Update:   updateVulnerabilityScanningHostScanTarget,
-		Delete:   deleteVulnerabilityScanningHostScanTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningHostScanTarget,
+		ReadContext:   readVulnerabilityScanningHostScanTarget,
+		UpdateContext: updateVulnerabilityScanningHostScanTarget,
+		DeleteContext: deleteVulnerabilityScanningHostScanTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -101,37 +102,37 @@ func VulnerabilityScanningHostScanTargetResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type VulnerabilityScanningHostScanTargetResourceCrud struct {
@@ -222,9 +223,10 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create() error {
 		request.TargetCompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanTarget(context.Background(), request)
+	response, err := s.Client.CreateHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,9 +241,9 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.HostScanTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanTarget(context.Background(), request)
+	response, err := s.Client.GetHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,20 +312,20 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update() error {
 		request.TargetCompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *VulnerabilityScanningHostScanTargetResourceCrud) getHostScanTargetFromWorkRequest(workId *string, policy *oci_common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 	// Wait until it finishes
-	vssHostScanTargetId, err := hostScanRecipeWaitForWorkRequest(workId, "hostScanTarget",
+	vssHostScanTargetId, err := hostScanRecipeWaitForWorkRequest(s.Context(), workId, "hostScanTarget",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -341,9 +343,9 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) getHostScanTargetFromW
 	return s.Get()
 }
 
-func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func hostScanTargetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = hostScanWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_vulnerability_scanning.GetWorkRequestResponse{}
@@ -358,7 +360,7 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -370,7 +372,7 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -386,14 +388,14 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 	}
 
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromHostScanTargetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromHostScanTargetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromHostScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromHostScanTargetWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -421,15 +423,15 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostScanTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 
-	_, delWorkRequestErr := hostScanTargetWaitForWorkRequest(workId, "hostScanTarget", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	_, delWorkRequestErr := hostScanTargetWaitForWorkRequest(s.Context(), workId, "hostScanTarget", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
@@ -536,14 +538,14 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) updateCompartment(comp
 	idTmp := s.D.Id()
 	changeCompartmentRequest.HostScanTargetId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
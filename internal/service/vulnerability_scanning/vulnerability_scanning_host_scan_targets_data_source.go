@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v61/vulnerabilityscanning"
 )
 
 func VulnerabilityScanningHostScanTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVulnerabilityScanningHostScanTargets,
+		ReadContext: readVulnerabilityScanningHostScanTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,16 +49,16 @@ func VulnerabilityScanningHostScanTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readVulnerabilityScanningHostScanTargets(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanTargetsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
 	Res    *oci_vulnerability_scanning.ListHostScanTargetsResponse
 }
@@ -83,9 +84,9 @@ func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_vulnerability_scanning.ListHostScanTargetsLifecycleStateEnum(state.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "vulnerability_scanning")
 
-	response, err := s.Client.ListHostScanTargets(context.Background(), request)
+	response, err := s.Client.ListHostScanTargets(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostScanTargets(context.Background(), request)
+		listResponse, err := s.Client.ListHostScanTargets(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
 )
@@ -22,16 +23,16 @@ func WaasAddressListDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasAddressListResource(), fieldMap, readSingularWaasAddressList)
 }
 
-func readSingularWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasAddressListDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.GetAddressListResponse
 }
@@ -48,9 +49,9 @@ func (s *WaasAddressListDataSourceCrud) Get() error {
 		request.AddressListId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.GetAddressList(context.Background(), request)
+	response, err := s.Client.GetAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
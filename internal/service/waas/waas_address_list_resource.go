@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -19,11 +20,11 @@ func WaasAddressListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasAddressList,
-		Read:     readWaasAddressList,
-		Update:   updateWaasAddressList,
-		Delete:   deleteWaasAddressList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasAddressList,
+		ReadContext:   readWaasAddressList,
+		UpdateContext: updateWaasAddressList,
+		DeleteContext: deleteWaasAddressList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"addresses": {
@@ -74,37 +75,37 @@ func WaasAddressListResource() *schema.Resource {
 	}
 }
 
-func createWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func createWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func readWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func updateWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func deleteWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type WaasAddressListResourceCrud struct {
@@ -180,9 +181,10 @@ func (s *WaasAddressListResourceCrud) Create() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateAddressList(context.Background(), request)
+	response, err := s.Client.CreateAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,9 +199,9 @@ func (s *WaasAddressListResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AddressListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetAddressList(context.Background(), request)
+	response, err := s.Client.GetAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -253,9 +255,9 @@ func (s *WaasAddressListResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateAddressList(context.Background(), request)
+	response, err := s.Client.UpdateAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -270,9 +272,9 @@ func (s *WaasAddressListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AddressListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteAddressList(context.Background(), request)
+	_, err := s.Client.DeleteAddressList(s.Context(), request)
 	return err
 }
 
@@ -315,14 +317,14 @@ func (s *WaasAddressListResourceCrud) updateCompartment(compartment interface{})
 	compartmentTmp := compartment.(string)
 	changeCompartmentRequest.CompartmentId = &compartmentTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeAddressListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAddressListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
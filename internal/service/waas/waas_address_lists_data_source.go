@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -17,7 +18,7 @@ import (
 
 func WaasAddressListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasAddressLists,
+		ReadContext: readWaasAddressLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -62,16 +63,16 @@ func WaasAddressListsDataSource() *schema.Resource {
 	}
 }
 
-func readWaasAddressLists(d *schema.ResourceData, m interface{}) error {
+func readWaasAddressLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasAddressListsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.ListAddressListsResponse
 }
@@ -143,9 +144,9 @@ func (s *WaasAddressListsDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.ListAddressLists(context.Background(), request)
+	response, err := s.Client.ListAddressLists(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *WaasAddressListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAddressLists(context.Background(), request)
+		listResponse, err := s.Client.ListAddressLists(s.Context(), request)
 		if err != nil {
 			return err
 		}
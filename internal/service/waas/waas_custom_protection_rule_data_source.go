@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
 )
@@ -22,16 +23,16 @@ func WaasCustomProtectionRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasCustomProtectionRuleResource(), fieldMap, readSingularWaasCustomProtectionRule)
 }
 
-func readSingularWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasCustomProtectionRuleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.GetCustomProtectionRuleResponse
 }
@@ -48,9 +49,9 @@ func (s *WaasCustomProtectionRuleDataSourceCrud) Get() error {
 		request.CustomProtectionRuleId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -19,11 +20,11 @@ func WaasCustomProtectionRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasCustomProtectionRule,
-		Read:     readWaasCustomProtectionRule,
-		Update:   updateWaasCustomProtectionRule,
-		Delete:   deleteWaasCustomProtectionRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasCustomProtectionRule,
+		ReadContext:   readWaasCustomProtectionRule,
+		UpdateContext: updateWaasCustomProtectionRule,
+		DeleteContext: deleteWaasCustomProtectionRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -79,37 +80,37 @@ func WaasCustomProtectionRuleResource() *schema.Resource {
 	}
 }
 
-func createWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func createWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func updateWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func deleteWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type WaasCustomProtectionRuleResourceCrud struct {
@@ -182,9 +183,10 @@ func (s *WaasCustomProtectionRuleResourceCrud) Create() error {
 		request.Template = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.CreateCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,9 +201,9 @@ func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CustomProtectionRuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,9 +254,9 @@ func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
 		request.Template = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.UpdateCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -269,9 +271,9 @@ func (s *WaasCustomProtectionRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CustomProtectionRuleId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteCustomProtectionRule(context.Background(), request)
+	_, err := s.Client.DeleteCustomProtectionRule(s.Context(), request)
 	return err
 }
 
@@ -318,14 +320,14 @@ func (s *WaasCustomProtectionRuleResourceCrud) updateCompartment(compartment int
 	idTmp := s.D.Id()
 	changeCompartmentRequest.CustomProtectionRuleId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeCustomProtectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCustomProtectionRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
 )
@@ -22,16 +23,16 @@ func WaasHttpRedirectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasHttpRedirectResource(), fieldMap, readSingularWaasHttpRedirect)
 }
 
-func readSingularWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasHttpRedirectDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.RedirectClient
 	Res    *oci_waas.GetHttpRedirectResponse
 }
@@ -48,9 +49,9 @@ func (s *WaasHttpRedirectDataSourceCrud) Get() error {
 		request.HttpRedirectId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.GetHttpRedirect(context.Background(), request)
+	response, err := s.Client.GetHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func WaasHttpRedirectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasHttpRedirect,
-		Read:     readWaasHttpRedirect,
-		Update:   updateWaasHttpRedirect,
-		Delete:   deleteWaasHttpRedirect,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasHttpRedirect,
+		ReadContext:   readWaasHttpRedirect,
+		UpdateContext: updateWaasHttpRedirect,
+		DeleteContext: deleteWaasHttpRedirect,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -116,40 +117,40 @@ func WaasHttpRedirectResource() *schema.Resource {
 	}
 }
 
-func createWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func createWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func readWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func updateWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func deleteWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type WaasHttpRedirectResourceCrud struct {
@@ -234,28 +235,29 @@ func (s *WaasHttpRedirectResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateHttpRedirect(context.Background(), request)
+	response, err := s.Client.CreateHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *WaasHttpRedirectResourceCrud) getHttpRedirectFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waas.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	httpRedirectId, err := httpRedirectWaitForWorkRequest(workId, "redirect",
+	httpRedirectId, err := httpRedirectWaitForWorkRequest(s.Context(), workId, "redirect",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WaasClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, httpRedirectId)
-		_, cancelErr := s.WaasClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WaasClient.CancelWorkRequest(s.Context(),
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -295,9 +297,9 @@ func httpRedirectWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
+func httpRedirectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waas.WaasClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waas")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "waas")
 	retryPolicy.ShouldRetryOperation = httpRedirectWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_waas.GetWorkRequestResponse{}
@@ -314,7 +316,7 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -326,7 +328,7 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -366,9 +368,9 @@ func (s *WaasHttpRedirectResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.HttpRedirectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetHttpRedirect(context.Background(), request)
+	response, err := s.Client.GetHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -425,15 +427,15 @@ func (s *WaasHttpRedirectResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateHttpRedirect(context.Background(), request)
+	response, err := s.Client.UpdateHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *WaasHttpRedirectResourceCrud) Delete() error {
@@ -442,16 +444,16 @@ func (s *WaasHttpRedirectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HttpRedirectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteHttpRedirect(context.Background(), request)
+	response, err := s.Client.DeleteHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := httpRedirectWaitForWorkRequest(workId, "waas",
+	_, delWorkRequestErr := httpRedirectWaitForWorkRequest(s.Context(), workId, "waas",
 		oci_waas.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WaasClient)
 	return delWorkRequestErr
 }
@@ -558,14 +560,14 @@ func (s *WaasHttpRedirectResourceCrud) updateCompartment(compartment interface{}
 	idTmp := s.D.Id()
 	changeCompartmentRequest.HttpRedirectId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeHttpRedirectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpRedirectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateContext(s.Context(), s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
 )
 
 func WaasProtectionRuleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularWaasProtectionRule,
+		ReadContext: readSingularWaasProtectionRule,
 		Schema: map[string]*schema.Schema{
 			"protection_rule_key": {
 				Type:     schema.TypeString,
@@ -84,16 +85,16 @@ func WaasProtectionRuleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasProtectionRuleDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.GetProtectionRuleResponse
 }
@@ -115,9 +116,9 @@ func (s *WaasProtectionRuleDataSourceCrud) Get() error {
 		request.WaasPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	response, err := s.Client.GetProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
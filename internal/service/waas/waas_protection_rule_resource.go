@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,10 +33,10 @@ func WaasProtectionRuleResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createWaasProtectionRule,
-		Read:   readWaasProtectionRule,
-		Update: updateWaasProtectionRule,
-		Delete: deleteWaasProtectionRule,
+		CreateContext: createWaasProtectionRule,
+		ReadContext:   readWaasProtectionRule,
+		UpdateContext: updateWaasProtectionRule,
+		DeleteContext: deleteWaasProtectionRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"waas_policy_id": {
@@ -107,31 +108,31 @@ func WaasProtectionRuleResource() *schema.Resource {
 	}
 }
 
-func createWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func createWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func updateWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func deleteWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -174,13 +175,13 @@ func (s *WaasProtectionRuleResourceCrud) getProtectionRuleFromWorkRequest(workId
 	actionTypeEnum oci_waas.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	waasPolicyId, err := protectionRuleWaitForWorkRequest(workId, "waas",
+	waasPolicyId, err := protectionRuleWaitForWorkRequest(s.Context(), workId, "waas",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, waasPolicyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -219,9 +220,9 @@ func protectionRuleWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func protectionRuleWaitForWorkRequest(wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
+func protectionRuleWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waas.WaasClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waas")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "waas")
 	retryPolicy.ShouldRetryOperation = protectionRuleWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_waas.GetWorkRequestResponse{}
@@ -238,7 +239,7 @@ func protectionRuleWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -250,7 +251,7 @@ func protectionRuleWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -298,9 +299,9 @@ func (s *WaasProtectionRuleResourceCrud) Get() error {
 	}
 	s.D.Set("waas_policy_id", waasPolicyId) // Response doesn't have this field
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	response, err := s.Client.GetProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,15 +349,15 @@ func (s *WaasProtectionRuleResourceCrud) Update() error {
 
 	request.ProtectionRules = append(request.ProtectionRules, protectionRuleAction)
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateProtectionRules(context.Background(), request)
+	response, err := s.Client.UpdateProtectionRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getProtectionRuleFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getProtectionRuleFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *WaasProtectionRuleResourceCrud) SetData() error {
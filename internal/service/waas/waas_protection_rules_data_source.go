@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
 )
 
 func WaasProtectionRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasProtectionRules,
+		ReadContext: readWaasProtectionRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"action": {
@@ -45,16 +46,16 @@ func WaasProtectionRulesDataSource() *schema.Resource {
 	}
 }
 
-func readWaasProtectionRules(d *schema.ResourceData, m interface{}) error {
+func readWaasProtectionRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasProtectionRulesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.ListProtectionRulesResponse
 }
@@ -97,9 +98,9 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 		request.WaasPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.ListProtectionRules(context.Background(), request)
+	response, err := s.Client.ListProtectionRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -5,10 +5,10 @@ package waas
 
 import (
 	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -21,9 +21,9 @@ func WaasPurgeCacheResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createWaasPurgeCache,
-		Read:   readWaasPurgeCache,
-		Delete: deleteWaasPurgeCache,
+		CreateContext: createWaasPurgeCache,
+		ReadContext:   readWaasPurgeCache,
+		DeleteContext: deleteWaasPurgeCache,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"waas_policy_id": {
@@ -48,19 +48,19 @@ func WaasPurgeCacheResource() *schema.Resource {
 	}
 }
 
-func createWaasPurgeCache(d *schema.ResourceData, m interface{}) error {
+func createWaasPurgeCache(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasPurgeCacheResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWaasPurgeCache(d *schema.ResourceData, m interface{}) error {
+func readWaasPurgeCache(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteWaasPurgeCache(d *schema.ResourceData, m interface{}) error {
+func deleteWaasPurgeCache(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -96,15 +96,15 @@ func (s *WaasPurgeCacheResourceCrud) Create() error {
 		request.WaasPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.PurgeCache(context.Background(), request)
+	response, err := s.Client.PurgeCache(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, delWorkRequestErr := waasPolicyWaitForWorkRequest(workId, "waas",
+	_, delWorkRequestErr := waasPolicyWaitForWorkRequest(s.Context(), workId, "waas",
 		oci_waas.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -17,7 +18,7 @@ import (
 
 func WaasWaasPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasWaasPolicies,
+		ReadContext: readWaasWaasPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -62,16 +63,16 @@ func WaasWaasPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readWaasWaasPolicies(d *schema.ResourceData, m interface{}) error {
+func readWaasWaasPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasWaasPoliciesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.ListWaasPoliciesResponse
 }
@@ -143,9 +144,9 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.ListWaasPolicies(context.Background(), request)
+	response, err := s.Client.ListWaasPolicies(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWaasPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListWaasPolicies(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_waas "github.com/oracle/oci-go-sdk/v61/waas"
@@ -16,7 +17,7 @@ import (
 
 func WaasWaasPolicyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularWaasWaasPolicy,
+		ReadContext: readSingularWaasWaasPolicy,
 		Schema: map[string]*schema.Schema{
 			"waas_policy_id": {
 				Type:     schema.TypeString,
@@ -1235,16 +1236,16 @@ func WaasWaasPolicyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WaasWaasPolicyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waas.WaasClient
 	Res    *oci_waas.GetWaasPolicyResponse
 }
@@ -1261,9 +1262,9 @@ func (s *WaasWaasPolicyDataSourceCrud) Get() error {
 		request.WaasPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
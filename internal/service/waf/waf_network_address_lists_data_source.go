@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
 
 func WafNetworkAddressListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafNetworkAddressLists,
+		ReadContext: readWafNetworkAddressLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,16 +56,16 @@ func WafNetworkAddressListsDataSource() *schema.Resource {
 	}
 }
 
-func readWafNetworkAddressLists(d *schema.ResourceData, m interface{}) error {
+func readWafNetworkAddressLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafNetworkAddressListsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.ListNetworkAddressListsResponse
 }
@@ -107,9 +108,9 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+	response, err := s.Client.ListNetworkAddressLists(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkAddressLists(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
 
 func WafProtectionCapabilitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafProtectionCapabilities,
+		ReadContext: readWafProtectionCapabilities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -138,16 +139,16 @@ func WafProtectionCapabilitiesDataSource() *schema.Resource {
 	}
 }
 
-func readWafProtectionCapabilities(d *schema.ResourceData, m interface{}) error {
+func readWafProtectionCapabilities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafProtectionCapabilitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafProtectionCapabilitiesDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.ListProtectionCapabilitiesResponse
 }
@@ -204,9 +205,9 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 		request.Type = oci_waf.ProtectionCapabilitySummaryTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilities(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilities(s.Context(), request)
 		if err != nil {
 			return err
 		}
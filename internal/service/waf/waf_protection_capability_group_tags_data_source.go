@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
 
 func WafProtectionCapabilityGroupTagsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafProtectionCapabilityGroupTags,
+		ReadContext: readWafProtectionCapabilityGroupTags,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -64,16 +65,16 @@ func WafProtectionCapabilityGroupTagsDataSource() *schema.Resource {
 	}
 }
 
-func readWafProtectionCapabilityGroupTags(d *schema.ResourceData, m interface{}) error {
+func readWafProtectionCapabilityGroupTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafProtectionCapabilityGroupTagsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafProtectionCapabilityGroupTagsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.ListProtectionCapabilityGroupTagsResponse
 }
@@ -99,9 +100,9 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 		request.Type = oci_waf.ProtectionCapabilitySummaryTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilityGroupTags(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
@@ -23,16 +24,16 @@ func WafWebAppFirewallDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WafWebAppFirewallResource(), fieldMap, readSingularWafWebAppFirewall)
 }
 
-func readSingularWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func readSingularWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafWebAppFirewallDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.GetWebAppFirewallResponse
 }
@@ -49,9 +50,9 @@ func (s *WafWebAppFirewallDataSourceCrud) Get() error {
 		request.WebAppFirewallId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewall(s.Context(), request)
 	if err != nil {
 		return err
 	}
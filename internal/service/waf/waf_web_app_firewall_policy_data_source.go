@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
@@ -22,16 +23,16 @@ func WafWebAppFirewallPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WafWebAppFirewallPolicyResource(), fieldMap, readSingularWafWebAppFirewallPolicy)
 }
 
-func readSingularWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafWebAppFirewallPolicyDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.GetWebAppFirewallPolicyResponse
 }
@@ -48,9 +49,9 @@ func (s *WafWebAppFirewallPolicyDataSourceCrud) Get() error {
 		request.WebAppFirewallPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func WafWebAppFirewallPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWafWebAppFirewallPolicy,
-		Read:     readWafWebAppFirewallPolicy,
-		Update:   updateWafWebAppFirewallPolicy,
-		Delete:   deleteWafWebAppFirewallPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWafWebAppFirewallPolicy,
+		ReadContext:   readWafWebAppFirewallPolicy,
+		UpdateContext: updateWafWebAppFirewallPolicy,
+		DeleteContext: deleteWafWebAppFirewallPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -732,37 +733,37 @@ func WafWebAppFirewallPolicyResource() *schema.Resource {
 	}
 }
 
-func createWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func createWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.CreateResourceContext(ctx, d, sync))
 }
 
-func readWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
-func updateWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func updateWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.UpdateResourceContext(ctx, d, sync))
 }
 
-func deleteWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.ToDiagnostics(sync, tfresource.DeleteResourceContext(ctx, d, sync))
 }
 
 type WafWebAppFirewallPolicyResourceCrud struct {
@@ -905,22 +906,23 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Create() error {
 		request.SystemTags = convertedSystemTags
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+	tfresource.SetCreateRetryToken(s.Context(), &request)
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.CreateWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *WafWebAppFirewallPolicyResourceCrud) getWebAppFirewallPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waf.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	webAppFirewallPolicyId, err := webAppFirewallPolicyWaitForWorkRequest(workId, "webAppFirewallPolicy",
+	webAppFirewallPolicyId, err := webAppFirewallPolicyWaitForWorkRequest(s.Context(), workId, "webAppFirewallPolicy",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -954,9 +956,9 @@ func webAppFirewallPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(
 	}
 }
 
-func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
+func webAppFirewallPolicyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waf.WafClient) (*string, error) {
-	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waf")
+	retryPolicy := tfresource.GetRetryPolicyContext(ctx, disableFoundRetries, "waf")
 	retryPolicy.ShouldRetryOperation = webAppFirewallPolicyWorkRequestShouldRetryFunc(timeout)
 
 	response := oci_waf.GetWorkRequestResponse{}
@@ -973,7 +975,7 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -985,7 +987,7 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Timeout: timeout,
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		return nil, e
 	}
 
@@ -1002,14 +1004,14 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_waf.WorkRequestStatusFailed || response.Status == oci_waf.WorkRequestStatusCanceled {
-		return nil, getErrorFromWafWebAppFirewallPolicyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromWafWebAppFirewallPolicyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromWafWebAppFirewallPolicyWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromWafWebAppFirewallPolicyWorkRequest(ctx context.Context, client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1037,9 +1039,9 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallPolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1160,15 +1162,15 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallPolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
@@ -1177,16 +1179,16 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallPolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := webAppFirewallPolicyWaitForWorkRequest(workId, "webAppFirewallPolicy",
+	_, delWorkRequestErr := webAppFirewallPolicyWaitForWorkRequest(s.Context(), workId, "webAppFirewallPolicy",
 		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -2155,13 +2157,13 @@ func (s *WafWebAppFirewallPolicyResourceCrud) updateCompartment(compartment inte
 	idTmp := s.D.Id()
 	changeCompartmentRequest.WebAppFirewallPolicyId = &idTmp
 
-	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicyContext(s.Context(), s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v61/waf"
 )
 
 func WafWebAppFirewallsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafWebAppFirewalls,
+		ReadContext: readWafWebAppFirewalls,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,16 +60,16 @@ func WafWebAppFirewallsDataSource() *schema.Resource {
 	}
 }
 
-func readWafWebAppFirewalls(d *schema.ResourceData, m interface{}) error {
+func readWafWebAppFirewalls(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ToDiagnostics(sync, tfresource.ReadResourceContext(ctx, sync))
 }
 
 type WafWebAppFirewallsDataSourceCrud struct {
-	D      *schema.ResourceData
+	tfresource.BaseCrud
 	Client *oci_waf.WafClient
 	Res    *oci_waf.ListWebAppFirewallsResponse
 }
@@ -116,9 +117,9 @@ func (s *WafWebAppFirewallsDataSourceCrud) Get() error {
 		request.WebAppFirewallPolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyContext(s.Context(), false, "waf")
 
-	response, err := s.Client.ListWebAppFirewalls(context.Background(), request)
+	response, err := s.Client.ListWebAppFirewalls(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *WafWebAppFirewallsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWebAppFirewalls(context.Background(), request)
+		listResponse, err := s.Client.ListWebAppFirewalls(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -7,9 +7,11 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -21,10 +23,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-oci/internal/progress"
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
@@ -52,8 +58,8 @@ var (
 	}
 	convertResFieldsToDSFields             = convertResourceFieldsToDatasourceFields
 	jsonMarshal                            = json.Marshal
-	waitForStateRefreshVar                 = WaitForStateRefresh
-	WaitForWorkRequestVar                  = WaitForWorkRequest
+	waitForStateRefreshVar                 = WaitForStateRefreshContext
+	WaitForWorkRequestVar                  = WaitForWorkRequestContext
 	getWorkRequestErrorsVar                = getWorkRequestErrors
 	waitForStateRefreshForHybridPollingVar = waitForStateRefreshForHybridPolling
 	stateRefreshFuncVar                    = stateRefreshFunc
@@ -72,14 +78,33 @@ const (
 )
 
 type BaseCrud struct {
-	D     *schema.ResourceData
-	Mutex *sync.Mutex
+	D        *schema.ResourceData
+	Mutex    *sync.Mutex
+	ctx      context.Context
+	warnings diag.Diagnostics
 }
 
 func (s *BaseCrud) VoidState() {
 	s.D.SetId("")
 }
 
+// SetContext is called by the *Context CRUD helpers with the context Terraform passed to the operation
+func (s *BaseCrud) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// Context returns the context of the running CRUD operation, or context.Background() outside of the CRUD helpers
+func (s *BaseCrud) Context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func (s *BaseCrud) resourceData() *schema.ResourceData {
+	return s.D
+}
+
 // Default implementation, used in conjunction with State()
 func (s *BaseCrud) setState(sync StatefulResource) error {
 	// Pseudo code:
@@ -136,14 +161,20 @@ type workReqClient interface {
 	ListWorkRequestErrors(context.Context, oci_work_requests.ListWorkRequestErrorsRequest) (oci_work_requests.ListWorkRequestErrorsResponse, error)
 }
 
-func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+// workReqLogsClient is implemented by the work request clients that read the log entries of work requests, which are
+// reported with their progress
+type workReqLogsClient interface {
+	ListWorkRequestLogs(context.Context, oci_work_requests.ListWorkRequestLogsRequest) (oci_work_requests.ListWorkRequestLogsResponse, error)
+}
+
+func waitForStateRefreshForHybridPolling(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	disableFoundRetries bool, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
-		Timeout: timeout,
+		Refresh: workRequestProgressRefreshFunc(ctx, workRequestClient, workRequestIds, stateRefreshFuncVar(sync)),
+		Timeout: remainingOperationTimeout(ctx, timeout),
 	}
 
 	// Should not wait when in replay mode
@@ -151,12 +182,12 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
-			retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
-			retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
-			e = getWorkRequestErrorsVar(workRequestClient, workRequestIds, retryPolicy, entityType, action)
+			retryPolicy := GetRetryPolicyContext(ctx, disableFoundRetries, "work_request")
+			retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(remainingOperationTimeout(ctx, timeout))
+			e = getWorkRequestErrorsVar(ctx, workRequestClient, workRequestIds, retryPolicy, entityType, action)
 			return e
 		}
 
@@ -173,14 +204,16 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 	return nil
 }
 
-func ResourceRefreshForHybridPolling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+func ResourceRefreshForHybridPollingContext(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	disableFoundRetries bool, d schemaResourceData, sync ResourceCreator) error {
+	ctx = WithOperationDeadline(ctx, d.Timeout(schema.TimeoutCreate))
+	setResourceContext(ctx, sync)
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
 
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshForHybridPollingVar(workRequestClient, workRequestIds, entityType, action, disableFoundRetries, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshForHybridPollingVar(ctx, workRequestClient, workRequestIds, entityType, action, disableFoundRetries, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			if stateful.State() == FAILED {
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
 				// TODO: automatic retry on WorkRequestFailed
@@ -202,13 +235,17 @@ func ResourceRefreshForHybridPolling(workRequestClient workReqClient, workReques
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := SleepContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 
 	return nil
 }
 
-func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
+func CreateResourceUsingHybridPollingContext(ctx context.Context, sync ResourceCreator) error {
+	setResourceContext(ctx, sync)
+
 	if e := sync.Create(); e != nil {
 		return HandleErrorVar(sync, e)
 	}
@@ -216,7 +253,10 @@ func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
 	return nil
 }
 
-func CreateResource(d schemaResourceData, sync ResourceCreator) error {
+// CreateResourceContext requests a Create(). The context is handed to the resource (if it implements
+// ContextAwareResource) before Create() is called and is used for any state polling afterwards, so that a cancelled
+// apply stops the SDK retries and pollers immediately.
+func CreateResourceContext(ctx context.Context, d schemaResourceData, sync ResourceCreator) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -224,15 +264,25 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 		}
 	}
 
-	if e := sync.Create(); e != nil {
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+	ctx = WithOperationDeadline(ctx, d.Timeout(schema.TimeoutCreate))
+	setResourceContext(ctx, sync)
+
+	interrupted, e := CreateOrResume(ctx, d, sync)
+	if e != nil {
 		return HandleError(sync, e)
 	}
+	if interrupted {
+		return nil
+	}
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
 
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			if stateful.State() == FAILED {
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
 				// TODO: automatic retry on WorkRequestFailed
@@ -244,6 +294,9 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 				log.Printf("[ERROR] error setting data after WaitForStateRefresh() error: %v", setDataErr)
 			}
 
+			if stateful.State() != FAILED && SaveInterruptedCreation(ctx, d, sync, sync.ID()) {
+				return nil
+			}
 			return e
 		}
 	}
@@ -252,14 +305,254 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 	if e := sync.SetData(); e != nil {
 		return e
 	}
+	ClearPendingCreation(ctx)
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := SleepContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 	return nil
 }
 
-func ReadResource(sync ResourceReader) error {
+// CreateOrResume calls Create() on the resource, unless a creation of the resource accepted by an apply that was
+// interrupted, e.g. killed, is journaled under the create key of the context, see resume.Journal. The resource of that
+// creation is then fetched with Get() instead, to wait for it like a resource that was just created, and a creation
+// whose resource is unknown is retried with its retry token, which gets its resource if OCI accepted it. Creations are
+// journaled as soon as OCI accepts them.
+//
+// The creation of a ResumableCreator is also saved to the private state of the resource as soon as OCI accepts it,
+// and stays there until ClearPendingCreation is called once the resource is created. If the apply is cancelled
+// afterwards, the created resource is saved to the partial state of the create, and the create returns true with a
+// warning rather than an error: Terraform would otherwise forget the resource, or taint it, and the next apply would
+// create it a second time. The next read waits for the creation instead. A create that times out still fails. The
+// requests of Create() get the retry tokens of the creation, see SetCreateRetryToken.
+func CreateOrResume(ctx context.Context, d schemaResourceData, sync ResourceCreator) (bool, error) {
+	pending := resume.PendingFromContext(ctx)
+	retryToken := ""
+	if pending != nil {
+		retryToken = pending.RetryToken()
+	}
+
+	journal, key := resume.JournalVar, resume.CreateKeyFromContext(ctx)
+	if journal == nil || key == "" {
+		journal, key = nil, ""
+	}
+	if journal != nil {
+		if creation, ok := journal.Get(key); ok {
+			adopted, err := adoptCreatedResource(d, sync, creation.Entry)
+			if err != nil {
+				return false, err
+			}
+			if adopted {
+				if pending != nil {
+					pending.Set(creation.Entry)
+				}
+				return false, nil
+			}
+			if creation.Identifier == "" && creation.RetryToken != "" {
+				log.Printf("[INFO] retrying the creation of '%s' started by an interrupted apply", key)
+				retryToken = creation.RetryToken
+				if pending != nil {
+					pending.SetRetryToken(retryToken)
+				}
+			}
+		}
+		// The creation is journaled before it starts, as OCI may accept it without the apply getting the response
+		if retryToken != "" {
+			if err := journal.Put(key, resume.Creation{RetryToken: retryToken}); err != nil {
+				log.Printf("[WARN] unable to journal the creation of '%s': %v", key, err)
+			}
+		} else {
+			journal.Delete(key)
+		}
+	}
+
+	createCtx := ctx
+	if retryToken != "" {
+		createCtx = resume.WithRetryTokens(ctx, resume.NewRetryTokens(retryToken))
+	}
+	recorder := resume.NewRecorder(journal, key, retryToken)
+	setResourceContext(resume.WithRecorder(createCtx, recorder), sync)
+	defer setResourceContext(ctx, sync)
+	err := sync.Create()
+
+	resumable, ok := sync.(ResumableCreator)
+	if !ok {
+		return false, err
+	}
+	entry, accepted := recorder.Entry()
+	if accepted && pending != nil {
+		pending.Set(entry)
+	}
+	if err == nil || !accepted {
+		return false, err
+	}
+	if entry.Identifier == "" {
+		if ctx.Err() != nil {
+			log.Printf("[WARN] creation interrupted after work request %s was accepted, the resource it creates is not saved to the state", entry.WorkRequestId)
+		}
+		return false, err
+	}
+	if SaveInterruptedCreation(ctx, d, resumable, entry.Identifier) {
+		return true, nil
+	}
+	if ctx.Err() != nil {
+		// The resource is saved to the state, as a tainted resource, along with its work request
+		d.SetId(entry.Identifier)
+	}
+	return false, err
+}
+
+// adoptCreatedResource fetches the resource whose creation was journaled by an interrupted apply. Returns false if
+// the resource is unknown or cannot be adopted, e.g. because it was deleted since.
+func adoptCreatedResource(d schemaResourceData, sync ResourceCreator, entry resume.Entry) (bool, error) {
+	fetcher, ok := sync.(ResourceFetcher)
+	if !ok || entry.Identifier == "" {
+		return false, nil
+	}
+
+	d.SetId(entry.Identifier)
+	if err := fetcher.Get(); err != nil {
+		d.SetId("")
+		if failure, ok := serviceErrorCheck(err); ok && failure.GetHTTPStatusCode() == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	if stateful, ok := sync.(StatefullyCreatedResource); ok {
+		created := false
+		for _, state := range append(stateful.CreatedPending(), stateful.CreatedTarget()...) {
+			created = created || stateful.State() == state
+		}
+		if !created {
+			log.Printf("[INFO] not adopting %s created by an interrupted apply, its state is %s", entry.Identifier, stateful.State())
+			d.SetId("")
+			return false, nil
+		}
+	}
+	log.Printf("[INFO] adopting %s created by an interrupted apply", entry.Identifier)
+	return true, nil
+}
+
+// CreateKey identifies the creation of a resource across applies, to journal it, by the type of the resource and a
+// hash of its configuration. Terraform does not give providers the address of a resource. Two resources with the same
+// type and configuration share a key, the first of them created by the next apply adopts the journaled resource.
+func CreateKey(resourceType string, resourceSchema map[string]*schema.Schema, d *schema.ResourceData) string {
+	config := map[string]interface{}{}
+	for name, attribute := range resourceSchema {
+		if attribute.Required || attribute.Optional {
+			config[name] = normalizeConfigValue(d.Get(name))
+		}
+	}
+	content, err := json.Marshal(config)
+	if err != nil {
+		return ""
+	}
+	configHash := sha256.Sum256(content)
+	return fmt.Sprintf("%s,%s", resourceType, hex.EncodeToString(configHash[:]))
+}
+
+// normalizeConfigValue converts sets to lists, which are ordered by the hash codes of their elements
+func normalizeConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return normalizeConfigValue(v.List())
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i := range v {
+			result[i] = normalizeConfigValue(v[i])
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k := range v {
+			result[k] = normalizeConfigValue(v[k])
+		}
+		return result
+	}
+	return value
+}
+
+// SaveInterruptedCreation keeps the resource of a ResumableCreator create in the state, with a warning, when the apply
+// was cancelled after OCI accepted the creation. Returns false if the create was not cancelled, e.g. if it timed out,
+// or if the resource is unknown.
+func SaveInterruptedCreation(ctx context.Context, d schemaResourceData, sync ResourceCreator, identifier string) bool {
+	resumable, ok := sync.(ResumableCreator)
+	if !ok || !errors.Is(ctx.Err(), context.Canceled) || identifier == "" {
+		return false
+	}
+	log.Printf("[WARN] creation of %s interrupted, the resource is saved to the state", identifier)
+	d.SetId(identifier)
+	resumable.AddWarning("Creation interrupted",
+		fmt.Sprintf("The creation of %s was interrupted before the resource was ready. The resource is saved to the state, the next apply waits for its creation instead of creating it again.", identifier), nil)
+	return true
+}
+
+// ClearPendingCreation removes the creation saved by CreateOrResume from the private state once the resource is created
+func ClearPendingCreation(ctx context.Context) {
+	if pending := resume.PendingFromContext(ctx); pending != nil {
+		pending.Clear()
+	}
+}
+
+// waitForPendingCreation waits for the creation of a resource which a previous apply left in its private state, so that
+// the resources depending on it are not used before it is created. The resource of a failed creation is removed from
+// the state, so that it is created again.
+func waitForPendingCreation(ctx context.Context, sync ResourceReader) error {
+	pending := resume.PendingFromContext(ctx)
+	if pending == nil {
+		return nil
+	}
+	entry, ok := pending.Entry()
+	if !ok {
+		return nil
+	}
+	stateful, ok := sync.(StatefullyCreatedResource)
+	if !ok {
+		pending.Clear()
+		return nil
+	}
+
+	if stateful.State() == FAILED {
+		log.Printf("[WARN] creation of %s by work request %s failed, the resource is removed from the state", entry.Identifier, entry.WorkRequestId)
+		stateful.VoidState()
+		pending.Clear()
+		return nil
+	}
+	creating := false
+	for _, state := range stateful.CreatedPending() {
+		creating = creating || stateful.State() == state
+	}
+	if !creating {
+		pending.Clear()
+		return nil
+	}
+
+	log.Printf("[INFO] waiting for the creation of %s by work request %s, started by a previous apply", entry.Identifier, entry.WorkRequestId)
+	timeout := *DefaultTimeout.Create
+	if crud, ok := sync.(interface{ resourceData() *schema.ResourceData }); ok && crud.resourceData() != nil {
+		timeout = crud.resourceData().Timeout(schema.TimeoutCreate)
+	}
+	if e := waitForStateRefreshVar(ctx, stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if stateful.State() == FAILED {
+			log.Printf("[WARN] creation of %s by work request %s failed, the resource is removed from the state", entry.Identifier, entry.WorkRequestId)
+			stateful.VoidState()
+			pending.Clear()
+			return nil
+		}
+		return fmt.Errorf("the creation of %s by work request %s, started by a previous apply, is not complete: %v", entry.Identifier, entry.WorkRequestId, e)
+	}
+	pending.Clear()
+	return sync.SetData()
+}
+
+func ReadResourceContext(ctx context.Context, sync ResourceReader) error {
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+	setResourceContext(ctx, sync)
+
 	if e := sync.Get(); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
@@ -270,6 +563,10 @@ func ReadResource(sync ResourceReader) error {
 		return e
 	}
 
+	if e := waitForPendingCreation(ctx, sync); e != nil {
+		return e
+	}
+
 	// Remove resource from state if it has been terminated so that it is recreated on next apply
 	if dr, ok := sync.(StatefullyDeletedResource); ok {
 		for _, target := range dr.DeletedTarget() {
@@ -283,7 +580,7 @@ func ReadResource(sync ResourceReader) error {
 	return nil
 }
 
-func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
+func UpdateResourceContext(ctx context.Context, d schemaResourceData, sync ResourceUpdater) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -291,6 +588,12 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 		}
 	}
 
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+	ctx = WithOperationDeadline(ctx, d.Timeout(schema.TimeoutUpdate))
+	setResourceContext(ctx, sync)
+
 	d.Partial(true)
 	if e := sync.Update(); e != nil {
 
@@ -299,7 +602,7 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 	d.Partial(false)
 
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 
 			return e
 		}
@@ -312,11 +615,11 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 	return nil
 }
 
-// DeleteResource requests a Delete(). If the resource deletes
+// DeleteResourceContext requests a Delete(). If the resource deletes
 // statefully (not immediately), poll State to ensure:
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
-func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
+func DeleteResourceContext(ctx context.Context, d schemaResourceData, sync ResourceDeleter) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -324,24 +627,34 @@ func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
 		}
 	}
 
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+	ctx = WithOperationDeadline(ctx, d.Timeout(schema.TimeoutDelete))
+	setResourceContext(ctx, sync)
+
 	if e := sync.Delete(); e != nil {
 		handleMissingResourceError(sync, &e)
 		return HandleError(sync, e)
 	}
 
 	if stateful, ok := sync.(StatefullyDeletedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
 			handleMissingResourceError(sync, &e)
 			return e
 		}
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := SleepContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostDelete())
+		if e := SleepContext(ctx, ew.ExtraWaitPostDelete()); e != nil {
+			return e
+		}
 	}
 
 	sync.VoidState()
@@ -365,9 +678,9 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 
 // Helper function to wait for Update to reach terminal state before doing another Update
 // Useful in situations where more than one Update is needed and prior Update needs to complete
-func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
+func WaitForUpdatedStateContext(ctx context.Context, d schemaResourceData, sync ResourceUpdater) error {
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -377,10 +690,10 @@ func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
 
 // Helper function to wait for Create to reach terminal state before doing another operation
 // Useful in situations where another operation is done right after Create
-func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
+func WaitForCreatedStateContext(ctx context.Context, d schemaResourceData, sync ResourceCreator) error {
 	d.SetId(sync.ID())
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -388,17 +701,29 @@ func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
 	return nil
 }
 
-// WaitForStateRefresh takes a StatefulResource, a timeout duration, a list of states to treat as Pending, and a list of states to treat as Target. It uses those to wrap resource.StateChangeConf.WaitForState(). If the resource returns a missing status, it will not be treated as an error.
+// WaitForStateRefreshContext takes a StatefulResource, a timeout duration, a list of states to treat as Pending, and a list of states to treat as Target. It uses those to wrap resource.StateChangeConf.WaitForState(). If the resource returns a missing status, it will not be treated as an error.
 //
 // sync.D.Id must be set.
-// It does not set state from that refreshed state.
-func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
+// It does not set state from that refreshed state. Polling stops as soon as ctx is done.
+func WaitForStateRefreshContext(ctx context.Context, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) (err error) {
+	ctx, span := tracing.Start(ctx, "WaitForStateRefresh")
+	span.SetAttribute(tracing.OcidAttr, sync.ID())
+	span.SetAttribute(tracing.OperationAttr, operationName)
+	defer func() { span.End(err) }()
+
 	// TODO: try to move this onto sync
+	refresh := stateRefreshFuncVar(sync)
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
-		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			_, pollSpan := tracing.Start(ctx, "state refresh poll")
+			result, state, err := refresh()
+			pollSpan.SetAttribute(tracing.LifecycleStateAttr, state)
+			pollSpan.End(err)
+			return result, state, err
+		},
+		Timeout: remainingOperationTimeout(ctx, timeout),
 	}
 
 	// Should not wait when in replay mode
@@ -406,7 +731,7 @@ func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			if len(target) > 0 {
@@ -430,6 +755,57 @@ func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 	return nil
 }
 
+// ReadResourceSchema calls the read function of a resource or data source schema, whether it is defined through
+// the legacy Read field or through ReadContext. Use it instead of calling Read directly, since resources migrated
+// to the context-aware CRUD helpers no longer set Read.
+func ReadResourceSchema(ctx context.Context, resourceSchema *schema.Resource, d *schema.ResourceData, m interface{}) error {
+	if resourceSchema.ReadContext != nil {
+		return diagnosticsToError(resourceSchema.ReadContext(ctx, d, m))
+	}
+	if resourceSchema.Read != nil {
+		return resourceSchema.Read(d, m)
+	}
+	return fmt.Errorf("resource schema does not define a read function")
+}
+
+// HasReadFunction returns true if the schema defines either Read or ReadContext
+func HasReadFunction(resourceSchema *schema.Resource) bool {
+	return resourceSchema.Read != nil || resourceSchema.ReadContext != nil
+}
+
+func diagnosticsToError(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+	errs := make([]string, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			errs = append(errs, d.Summary)
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(errs, "\n"))
+}
+
+// setResourceContext hands ctx to resources that have been migrated to context-aware SDK calls
+func setResourceContext(ctx context.Context, sync interface{}) {
+	if contextAware, ok := sync.(ContextAwareResource); ok {
+		contextAware.SetContext(ctx)
+	}
+}
+
+// SleepContext waits for the given duration, returning early with the context error if ctx is done first
+func SleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func FilterMissingResourceError(sync ResourceVoider, err *error) {
 	if err != nil && strings.Contains((*err).Error(), "does not exist") {
 		log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
@@ -658,8 +1034,8 @@ func NormalizeBoolString(v string) (string, error) {
 
 // WaitForResourceCondition polls on a resource, waiting for it to reach a specified condition. This is done with exponential
 // backoff. The terminating condition is specified as a boolean function; and this will return a timeout error if the
-// specified condition isn't reached within the specified timeout period.
-func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
+// specified condition isn't reached within the specified timeout period. Polling stops as soon as ctx is done.
+func WaitForResourceConditionContext(ctx context.Context, s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
 	backoffTime := time.Second
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
@@ -690,7 +1066,9 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 			backoffTime = 10 * time.Millisecond
 		}
 
-		time.Sleep(backoffTime)
+		if err := SleepContext(ctx, backoffTime); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -709,12 +1087,14 @@ func GetDataSourceItemSchema(resourceSchema *schema.Resource) *schema.Resource {
 	// as though they were resources.
 	resourceSchema.Create = nil
 	resourceSchema.Read = nil
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
 
 	return convertResFieldsToDSFields(resourceSchema)
 }
 
 // Get the Singular DataSource Schema from Resource Schema with additional fields and Read Function
-func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMap map[string]*schema.Schema, readFunc schema.ReadFunc) *schema.Resource {
+func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMap map[string]*schema.Schema, readFunc schema.ReadContextFunc) *schema.Resource {
 	if _, idExists := resourceSchema.Schema["id"]; !idExists {
 		resourceSchema.Schema["id"] = &schema.Schema{
 			Type:     schema.TypeString,
@@ -727,7 +1107,11 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 	resourceSchema.Create = nil
 	resourceSchema.Update = nil
 	resourceSchema.Delete = nil
-	resourceSchema.Read = readFunc
+	resourceSchema.CreateContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
+	resourceSchema.Read = nil
+	resourceSchema.ReadContext = readFunc
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
@@ -772,7 +1156,8 @@ func convertResourceFieldsToDatasourceFields(resourceSchema *schema.Resource) *s
 }
 
 func GetRetryPolicyWithAdditionalRetryCondition(timeout time.Duration, retryConditionFunction func(oci_common.OCIOperationResponse) bool, service string) *oci_common.RetryPolicy {
-	startTime := time.Now()
+	startTime := retryClock.Now()
+	backoff := newRetryBackoff(service, startTime, time.Time{})
 	return &oci_common.RetryPolicy{
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
 			if ShouldRetryVar(response, false, service, startTime) {
@@ -785,7 +1170,7 @@ func GetRetryPolicyWithAdditionalRetryCondition(timeout time.Duration, retryCond
 			return false
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return GetRetryBackoffDuration(response, false, service, startTime)
+			return backoff.next(response, false)
 		},
 		MaximumNumberAttempts: 0,
 	}
@@ -795,7 +1180,7 @@ func elaspedInMillisecond(start time.Time) int64 {
 	return time.Since(start).Nanoseconds() / int64(time.Millisecond)
 }
 
-func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+func WaitForWorkRequestWithErrorHandlingContext(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool) (*string, error) {
 	var identifier *string
 	workRequestIdsSet := map[string]bool{}
@@ -807,7 +1192,7 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 	}
 
 	for wId := range workRequestIdsSet {
-		id, err := WaitForWorkRequestVar(workRequestClient, &wId, entityType, action, timeout, disableFoundRetries, true)
+		id, err := WaitForWorkRequestVar(ctx, workRequestClient, &wId, entityType, action, timeout, disableFoundRetries, true)
 		if err != nil {
 			return id, err
 		}
@@ -817,10 +1202,19 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 
 }
 
-func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
-	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (*string, error) {
-	retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
-	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
+// WaitForWorkRequestContext waits for a work request to complete. Cancelling ctx stops both the polling loop and any
+// in-flight GetWorkRequest retries.
+func WaitForWorkRequestContext(ctx context.Context, workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (_ *string, err error) {
+	ctx, span := tracing.Start(ctx, "WaitForWorkRequest")
+	span.SetAttribute(tracing.WorkRequestIdAttr, *workRequestId)
+	span.SetAttribute(tracing.ResourceTypeAttr, entityType)
+	span.SetAttribute(tracing.OperationAttr, string(action))
+	defer func() { span.End(err) }()
+
+	// The poll interval is the backoff of the retry policy, which stops polling by the end of the operation
+	retryPolicy := GetRetryPolicyWithDeadline(OperationDeadline(ctx, timeout), disableFoundRetries, "work_request")
+	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(remainingOperationTimeout(ctx, timeout))
 
 	response := oci_work_requests.GetWorkRequestResponse{}
 	stateConf := &resource.StateChangeConf{
@@ -835,8 +1229,9 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 			string(oci_work_requests.WorkRequestStatusCanceled),
 		},
 		Refresh: func() (interface{}, string, error) {
+			pollCtx, pollSpan := tracing.Start(ctx, "work request poll")
 			var err error
-			response, err = workRequestClient.GetWorkRequest(context.Background(),
+			response, err = workRequestClient.GetWorkRequest(pollCtx,
 				oci_work_requests.GetWorkRequestRequest{
 					WorkRequestId: workRequestId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -844,14 +1239,19 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 					},
 				})
 			wr := &response.WorkRequest
+			pollSpan.SetAttribute(tracing.WorkRequestStatusAttr, string(wr.Status))
+			pollSpan.End(err)
+			if err == nil {
+				reportWorkRequestProgress(ctx, workRequestClient, *wr, wr.Id != nil && progress.ReadDue(*wr.Id))
+			}
 			return wr, string(wr.Status), err
 		},
-		Timeout: timeout,
+		Timeout: remainingOperationTimeout(ctx, timeout),
 	}
 
 	var identifier *string
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		for _, res := range response.Resources {
 			if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
 				if res.Identifier != nil {
@@ -879,19 +1279,19 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 			return nil, fmt.Errorf("work request succeeded but no identifier was found, workId: %s, entity: %s, action: %s",
 				*workRequestId, entityType, action)
 		}
-		return nil, getWorkRequestErrorsVar(workRequestClient, workRequestId, retryPolicy, entityType, action)
+		return nil, getWorkRequestErrorsVar(ctx, workRequestClient, workRequestId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
 func workRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
-	startTime := time.Now()
+	startTime := retryClock.Now()
 	stopTime := startTime.Add(timeout)
 	return func(response oci_common.OCIOperationResponse) bool {
 
 		// Stop after timeout has elapsed
-		if time.Now().After(stopTime) {
+		if retryClock.Now().After(stopTime) {
 			return false
 		}
 
@@ -908,8 +1308,59 @@ func workRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.
 	}
 }
 
-func getWorkRequestErrors(workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
-	response, err := workRequestClient.ListWorkRequestErrors(context.Background(), oci_work_requests.ListWorkRequestErrorsRequest{
+// workRequestProgressRefreshFunc reads the work requests of a resource polled through its own state, to report their
+// progress periodically
+func workRequestProgressRefreshFunc(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if workRequestClient != nil && workRequestIds != nil {
+			for _, wId := range strings.Split(*workRequestIds, ",") {
+				wId = strings.TrimSpace(wId)
+				if wId == "" || !progress.ReadDue(wId) {
+					continue
+				}
+				response, err := workRequestClient.GetWorkRequest(ctx, oci_work_requests.GetWorkRequestRequest{WorkRequestId: &wId})
+				if err != nil {
+					log.Printf("[DEBUG] unable to read the progress of work request %s: %v", wId, err)
+					continue
+				}
+				reportWorkRequestProgress(ctx, workRequestClient, response.WorkRequest, true)
+			}
+		}
+		return refresh()
+	}
+}
+
+// reportWorkRequestProgress reports the progress of a work request, with its latest log entry if readLogEntry is set
+// and the client reads log entries
+func reportWorkRequestProgress(ctx context.Context, workRequestClient workReqClient, workRequest oci_work_requests.WorkRequest, readLogEntry bool) {
+	if workRequest.Id == nil {
+		return
+	}
+	workRequestProgress := progress.WorkRequest{
+		Id:              *workRequest.Id,
+		Status:          string(workRequest.Status),
+		PercentComplete: workRequest.PercentComplete,
+	}
+	if workRequest.OperationType != nil {
+		workRequestProgress.Operation = *workRequest.OperationType
+	}
+	if logsClient, ok := workRequestClient.(workReqLogsClient); ok && readLogEntry {
+		response, err := logsClient.ListWorkRequestLogs(ctx, oci_work_requests.ListWorkRequestLogsRequest{
+			WorkRequestId: workRequest.Id,
+			SortOrder:     oci_work_requests.ListWorkRequestLogsSortOrderDesc,
+			Limit:         oci_common.Int(1),
+		})
+		if err != nil {
+			log.Printf("[DEBUG] unable to read the log of work request %s: %v", *workRequest.Id, err)
+		} else if len(response.Items) > 0 && response.Items[0].Message != nil {
+			workRequestProgress.Message = *response.Items[0].Message
+		}
+	}
+	progress.Report(workRequestProgress)
+}
+
+func getWorkRequestErrors(ctx context.Context, workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+	response, err := workRequestClient.ListWorkRequestErrors(ctx, oci_work_requests.ListWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
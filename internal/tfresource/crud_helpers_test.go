@@ -4,21 +4,30 @@
 package tfresource
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 	oci_vault "github.com/oracle/oci-go-sdk/v61/vault"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing/tracingtest"
 )
 
 type extraWaitPostCreateDelete interface {
@@ -445,7 +454,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error { return nil },
 	}
-	reqReadFunc := func(d *schema.ResourceData, m interface{}) error { return nil }
+	reqReadFunc := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil }
 	reqAddFieldMap := map[string]*schema.Schema{
 		"foo": {
 			Computed: true,
@@ -458,7 +467,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 			},
 		},
 		Create:        nil,
-		Read:          reqReadFunc,
+		ReadContext:   reqReadFunc,
 		Update:        nil,
 		Delete:        nil,
 		Importer:      nil,
@@ -471,7 +480,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 	type args struct {
 		resourceSchema *schema.Resource
 		addFieldMap    map[string]*schema.Schema
-		readFunc       schema.ReadFunc
+		readFunc       schema.ReadContextFunc
 	}
 	type testFormat struct {
 		name   string
@@ -979,7 +988,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 					//return nil
 				}
@@ -990,7 +999,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					//return errors.New("default")
 					return nil
 				}
@@ -1000,12 +1009,109 @@ func TestUnitCreateResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := CreateResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := CreateResourceContext(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
+			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
+		}
+	}
+}
+
+func TestUnitCreateResourceContext(t *testing.T) {
+	s := &ResourceCrud{}
+	reqResourceData := &mockResourceData{}
+	s.D = reqResourceData
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx  context.Context
+		d    *mockResourceData
+		sync ResourceCreator
+	}
+	type testFormat struct {
+		name     string
+		args     args
+		gotError bool
+		mockFunc func()
+	}
+	tests := []testFormat{
+		{
+			name:     "Test cancelled context is returned before Create",
+			args:     args{ctx: cancelledCtx, sync: &ResourceCrud{id: "4"}, d: reqResourceData},
+			gotError: true,
+			mockFunc: func() {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+					t.Errorf("waitForStateRefreshVar should not be called for a cancelled context")
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Test context is passed to the waiter",
+			args:     args{ctx: context.WithValue(context.Background(), "key", "value"), sync: s, d: reqResourceData},
+			gotError: false,
+			mockFunc: func() {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+					if ctx.Value("key") != "value" {
+						return errors.New("context was not propagated")
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Test the deadline of the operation is passed to the waiter",
+			args:     args{ctx: context.Background(), sync: s, d: reqResourceData},
+			gotError: false,
+			mockFunc: func() {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+					if deadline, ok := contextDeadline(ctx); !ok || deadline.After(time.Now().Add(timeout)) {
+						return errors.New("the deadline of the operation was not propagated")
+					}
+					return nil
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		test.mockFunc()
+		if res := CreateResourceContext(test.args.ctx, test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
 }
 
+func TestUnitBaseCrudContext(t *testing.T) {
+	s := &BaseCrud{}
+	if s.Context() != context.Background() {
+		t.Errorf("Expected context.Background() when no context was set")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	setResourceContext(ctx, s)
+	if s.Context() != ctx {
+		t.Errorf("Expected context set through setResourceContext to be returned")
+	}
+}
+
+func TestUnitSleepContext(t *testing.T) {
+	if err := SleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := SleepContext(ctx, time.Hour); err != context.Canceled {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("SleepContext did not return when the context was cancelled")
+	}
+}
+
 func TestUnitReadResource(t *testing.T) {
 	s := &readResourceCrud{}
 	reqResourceData := &mockResourceData{}
@@ -1028,7 +1134,7 @@ func TestUnitReadResource(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
-		if res := ReadResource(test.args.sync); (res != nil) != test.gotError {
+		if res := ReadResourceContext(context.Background(), test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1055,7 +1161,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1065,7 +1171,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1074,7 +1180,7 @@ func TestUnitUpdateResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := UpdateResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := UpdateResourceContext(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1101,7 +1207,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1111,7 +1217,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1120,7 +1226,7 @@ func TestUnitDeleteResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := DeleteResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := DeleteResourceContext(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1147,7 +1253,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1157,7 +1263,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1166,7 +1272,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := WaitForUpdatedState(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := WaitForUpdatedStateContext(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1193,7 +1299,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1203,7 +1309,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1212,7 +1318,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := WaitForCreatedState(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := WaitForCreatedStateContext(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1302,13 +1408,57 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := WaitForStateRefresh(test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.gotError {
+		if res := WaitForStateRefreshContext(context.Background(), test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.gotError {
 			t.Log(res)
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
 }
 
+func TestUnitWaitForStateRefreshContext_cancelled(t *testing.T) {
+	stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+		return func() (res interface{}, s string, e error) {
+			wr := oci_work_requests.WorkRequest{Status: "IN_PROGRESS"}
+			return wr, string(wr.Status), nil
+		}
+	}
+	defer func() { stateRefreshFuncVar = stateRefreshFunc }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := WaitForStateRefreshContext(ctx, &ResourceCrud{D: &mockResourceData{}}, time.Hour, "creation", []string{"IN_PROGRESS"}, []string{"SUCCEEDED"})
+	if err == nil {
+		t.Errorf("Expected an error when the context is cancelled")
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Errorf("WaitForStateRefreshContext kept polling after the context was cancelled")
+	}
+}
+
+func TestUnitWaitForStateRefreshContext_operationDeadline(t *testing.T) {
+	stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+		return func() (res interface{}, s string, e error) {
+			wr := oci_work_requests.WorkRequest{Status: "IN_PROGRESS"}
+			return wr, string(wr.Status), nil
+		}
+	}
+	defer func() { stateRefreshFuncVar = stateRefreshFunc }()
+
+	// The operation started earlier and has little time left, polling must not wait for the full timeout
+	ctx := WithOperationDeadline(context.Background(), 200*time.Millisecond)
+
+	start := time.Now()
+	err := WaitForStateRefreshContext(ctx, &ResourceCrud{D: &mockResourceData{}}, time.Hour, "creation", []string{"IN_PROGRESS"}, []string{"SUCCEEDED"})
+	if err == nil || !strings.Contains(err.Error(), "timeout while waiting for state") {
+		t.Errorf("Expected a timeout error at the deadline of the operation, got %v", err)
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Errorf("WaitForStateRefreshContext kept polling after the deadline of the operation")
+	}
+}
+
 func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 	type output struct {
 		identifier string
@@ -1336,7 +1486,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "", timeout: timeoutDuration, disableFoundRetries: false},
 			output: output{identifier: "test", gotError: false},
 			mockFunc: func() {
-				WaitForWorkRequestVar = func(wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
+				WaitForWorkRequestVar = func(ctx context.Context, wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
 					id := "test"
 					return &id, nil
 				}
@@ -1347,7 +1497,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "", timeout: timeoutDuration, disableFoundRetries: false},
 			output: output{identifier: "test", gotError: true},
 			mockFunc: func() {
-				WaitForWorkRequestVar = func(wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
+				WaitForWorkRequestVar = func(ctx context.Context, wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
 					id := "test"
 					return &id, errors.New("default")
 				}
@@ -1357,7 +1507,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		id, err := WaitForWorkRequestWithErrorHandling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries)
+		id, err := WaitForWorkRequestWithErrorHandlingContext(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries)
 		if (err != nil) != test.output.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", err, test.output.gotError)
 		}
@@ -1396,7 +1546,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "oci", gotError: false},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return nil
 				}
 			},
@@ -1407,7 +1557,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1418,7 +1568,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1429,7 +1579,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1438,7 +1588,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		id, err := WaitForWorkRequest(test.args.workRequestClient, &test.workReqId, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries, test.args.expectIdentifier)
+		id, err := WaitForWorkRequestContext(context.Background(), test.args.workRequestClient, &test.workReqId, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries, test.args.expectIdentifier)
 		if id != nil && *id != test.output.identifier {
 			t.Log(*id)
 			t.Errorf("Output identifier - %s not equal to expected identifier - %s", *id, test.output.identifier)
@@ -1472,7 +1622,7 @@ func TestUnitgetWorkRequestErrors(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
-		if res := getWorkRequestErrors(test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
+		if res := getWorkRequestErrors(context.Background(), test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1510,7 +1660,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1526,7 +1676,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1542,7 +1692,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1558,7 +1708,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1567,7 +1717,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := waitForStateRefreshForHybridPolling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.output {
+		if res := waitForStateRefreshForHybridPolling(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1632,7 +1782,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, d: reqResourceData, sync: s},
 			output: true,
 			mockFunc: func() {
-				waitForStateRefreshForHybridPollingVar = func(wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
+				waitForStateRefreshForHybridPollingVar = func(ctx context.Context, wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
 					return errors.New("")
 				}
 			},
@@ -1642,7 +1792,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, d: reqResourceData, sync: s},
 			output: false,
 			mockFunc: func() {
-				waitForStateRefreshForHybridPollingVar = func(wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
+				waitForStateRefreshForHybridPollingVar = func(ctx context.Context, wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
 					return nil
 				}
 			},
@@ -1651,7 +1801,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := ResourceRefreshForHybridPolling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.d, test.args.sync); (res != nil) != test.output {
+		if res := ResourceRefreshForHybridPollingContext(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.d, test.args.sync); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1692,7 +1842,7 @@ func TestUnitCreateResourceUsingHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := CreateResourceUsingHybridPolling(test.args.sync); (res != nil) != test.output {
+		if res := CreateResourceUsingHybridPollingContext(context.Background(), test.args.sync); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1914,7 +2064,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 	}
 
 	// Test normal case
-	err := WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err := WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, 0)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from single attempt", err)
 		return
@@ -1922,7 +2072,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test normal case with multiple attempts
 	testResource = &TestResource{GetError: nil, GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from multiple attempts", err)
 		return
@@ -1930,7 +2080,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after 1 attempt
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 1}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after single attempt, expected a GetError", err)
 		return
@@ -1938,7 +2088,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after multiple attempts
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after multiple attempts, expected a GetError", err)
 		return
@@ -1946,7 +2096,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with zero timeout duration
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -1954,7 +2104,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with non-zero timeout duration, also validate that we got expected number of Get attempts due to exponential backoff
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 20*time.Second)
+	err = WaitForResourceConditionContext(context.Background(), testResource, finalStateFunc, 20*time.Second)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -1966,3 +2116,477 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+func TestUnitWaitForWorkRequestContext_tracing(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	ctx, operation := tracing.StartOperation(context.Background(), "oci_core_vcn create")
+	workRequestId := "1"
+	id, err := WaitForWorkRequestContext(ctx, &mockWorkRequestClient{}, &workRequestId, "default", "CREATED", time.Second, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "oci", *id)
+	operation.End(nil)
+
+	wait := collector.SpansNamed("WaitForWorkRequest")
+	assert.Len(t, wait, 1)
+	assert.Equal(t, operation.SpanId(), wait[0].ParentSpanId)
+	assert.Equal(t, "1", wait[0].Attributes[tracing.WorkRequestIdAttr])
+	assert.Equal(t, "CREATED", wait[0].Attributes[tracing.OperationAttr])
+	polls := collector.SpansNamed("work request poll")
+	assert.Len(t, polls, 1)
+	assert.Equal(t, wait[0].SpanId, polls[0].ParentSpanId)
+	assert.Equal(t, "SUCCEEDED", polls[0].Attributes[tracing.WorkRequestStatusAttr])
+}
+
+type mockWorkRequestLogsClient struct {
+	mockWorkRequestClient
+	getRequests  int
+	logsRequests []oci_work_requests.ListWorkRequestLogsRequest
+}
+
+func (client *mockWorkRequestLogsClient) GetWorkRequest(_ context.Context, wreq oci_work_requests.GetWorkRequestRequest) (oci_work_requests.GetWorkRequestResponse, error) {
+	client.getRequests++
+	operation := "CreateDbSystem"
+	percentComplete := float32(45)
+	wr := oci_work_requests.WorkRequest{Id: wreq.WorkRequestId, OperationType: &operation, Status: "IN_PROGRESS", PercentComplete: &percentComplete}
+	return oci_work_requests.GetWorkRequestResponse{WorkRequest: wr}, nil
+}
+
+func (client *mockWorkRequestLogsClient) ListWorkRequestLogs(_ context.Context, request oci_work_requests.ListWorkRequestLogsRequest) (oci_work_requests.ListWorkRequestLogsResponse, error) {
+	client.logsRequests = append(client.logsRequests, request)
+	message := "Provisioning storage"
+	return oci_work_requests.ListWorkRequestLogsResponse{Items: []oci_work_requests.WorkRequestLogEntry{{Message: &message}}}, nil
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWorkRequestProgressRefreshFunc(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	previousOutput := log.Writer()
+	log.SetOutput(buffer)
+	defer log.SetOutput(previousOutput)
+
+	client := &mockWorkRequestLogsClient{}
+	workRequestIds := "ocid1.progress.a, ocid1.progress.b"
+	refreshes := 0
+	refresh := workRequestProgressRefreshFunc(context.Background(), client, &workRequestIds, func() (interface{}, string, error) {
+		refreshes++
+		return nil, "PROVISIONING", nil
+	})
+	for i := 0; i < 3; i++ {
+		_, state, err := refresh()
+		assert.NoError(t, err)
+		assert.Equal(t, "PROVISIONING", state)
+	}
+
+	assert.Equal(t, 3, refreshes)
+	assert.Equal(t, 2, client.getRequests, "work requests should only be read once per progress interval")
+	assert.Len(t, client.logsRequests, 2)
+	assert.Equal(t, oci_work_requests.ListWorkRequestLogsSortOrderDesc, client.logsRequests[0].SortOrder)
+	assert.Equal(t, 1, *client.logsRequests[0].Limit)
+	assert.Contains(t, buffer.String(), "[INFO] Work request ocid1.progress.a (CreateDbSystem) is IN_PROGRESS, 45% complete: Provisioning storage")
+	assert.Contains(t, buffer.String(), "[INFO] Work request ocid1.progress.b (CreateDbSystem) is IN_PROGRESS, 45% complete: Provisioning storage")
+
+	// Resources without work request client
+	refresh = workRequestProgressRefreshFunc(context.Background(), nil, &workRequestIds, func() (interface{}, string, error) {
+		return nil, "PROVISIONING", nil
+	})
+	_, state, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "PROVISIONING", state)
+}
+
+type mockResumableCrud struct {
+	BaseCrud
+	id       string
+	state    string
+	getErr   error
+	createFn func(ctx context.Context) error
+	creates  int
+}
+
+func (s *mockResumableCrud) ID() string {
+	return s.id
+}
+func (s *mockResumableCrud) ResumableCreate() {}
+func (s *mockResumableCrud) Create() error {
+	s.creates++
+	return s.createFn(s.Context())
+}
+func (s *mockResumableCrud) Get() error {
+	if s.getErr != nil {
+		return s.getErr
+	}
+	if s.D.Id() != "" {
+		s.id = s.D.Id()
+	}
+	return nil
+}
+func (s *mockResumableCrud) SetData() error {
+	return nil
+}
+func (s *mockResumableCrud) State() string {
+	return s.state
+}
+func (s *mockResumableCrud) CreatedPending() []string {
+	return []string{"PROVISIONING"}
+}
+func (s *mockResumableCrud) CreatedTarget() []string {
+	return []string{"AVAILABLE"}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateOrResume(t *testing.T) {
+	accept := func(workRequestId string, identifier string, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			resume.RecorderFromContext(ctx).Record(workRequestId, identifier)
+			if err == nil {
+				return ctx.Err()
+			}
+			return err
+		}
+	}
+	type testFormat struct {
+		name        string
+		createFn    func(ctx context.Context) error
+		cancel      bool
+		timeout     bool
+		gotError    bool
+		interrupted bool
+		id          string
+		pending     bool
+	}
+	tests := []testFormat{
+		{
+			name:     "Test creation",
+			createFn: accept("ocid1.workrequest", "ocid1.created", nil),
+			pending:  true,
+		},
+		{
+			name:     "Test failed creation is not saved",
+			createFn: accept("ocid1.workrequest", "ocid1.created", errors.New("failed")),
+			gotError: true,
+			pending:  true,
+		},
+		{
+			name:        "Test resource of an interrupted creation is saved",
+			createFn:    accept("ocid1.workrequest", "ocid1.created", nil),
+			cancel:      true,
+			interrupted: true,
+			id:          "ocid1.created",
+			pending:     true,
+		},
+		{
+			name:     "Test resource of a timed out creation is saved with the timeout error",
+			createFn: accept("ocid1.workrequest", "ocid1.created", nil),
+			timeout:  true,
+			gotError: true,
+			id:       "ocid1.created",
+			pending:  true,
+		},
+		{
+			name:     "Test interrupted creation of an unknown resource is not saved",
+			createFn: accept("ocid1.workrequest", "", nil),
+			cancel:   true,
+			gotError: true,
+			pending:  true,
+		},
+		{
+			name:     "Test creation interrupted before it was accepted is not saved",
+			createFn: func(ctx context.Context) error { return ctx.Err() },
+			cancel:   true,
+			gotError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		sync := &mockResumableCrud{BaseCrud: BaseCrud{D: d}, createFn: test.createFn}
+		pending := resume.NewPending(nil)
+		ctx, cancel := context.WithCancel(resume.WithPending(context.Background(), pending))
+		if test.cancel {
+			cancel()
+		}
+		if test.timeout {
+			ctx, cancel = context.WithDeadline(ctx, time.Now())
+		}
+
+		interrupted, err := CreateOrResume(ctx, d, sync)
+		cancel()
+		assert.Equal(t, test.gotError, err != nil, "%v", err)
+		assert.Equal(t, test.interrupted, interrupted)
+		assert.Equal(t, 1, sync.creates)
+		assert.Equal(t, test.id, d.Id())
+		assert.Equal(t, test.interrupted, len(sync.Warnings()) > 0, "an interrupted creation should be reported")
+		entry, ok := pending.Entry()
+		assert.Equal(t, test.pending, ok, "an accepted creation should be saved to the private state")
+		if test.pending {
+			assert.Equal(t, "ocid1.workrequest", entry.WorkRequestId)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateOrResume_journal(t *testing.T) {
+	previousJournal, previousServiceErrorCheck := resume.JournalVar, serviceErrorCheck
+	defer func() {
+		resume.JournalVar, serviceErrorCheck = previousJournal, previousServiceErrorCheck
+	}()
+	serviceErrorCheck = func(err error) (oci_common.ServiceError, bool) {
+		failure, ok := err.(*MockServiceFailure)
+		return failure, ok
+	}
+
+	const key = "oci_test,config"
+	type testFormat struct {
+		name       string
+		creation   *resume.Creation
+		state      string
+		getErr     error
+		gotError   bool
+		creates    int
+		id         string
+		retryToken string
+		journaled  *resume.Creation
+	}
+	tests := []testFormat{
+		{
+			name:       "Test creation is journaled as soon as it is accepted",
+			creates:    1,
+			retryToken: "token",
+			journaled:  &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.created"}, RetryToken: "token"},
+		},
+		{
+			name:      "Test resource of an interrupted creation is adopted",
+			creation:  &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+			state:     "PROVISIONING",
+			id:        "ocid1.interrupted",
+			journaled: &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+		},
+		{
+			name:       "Test deleted resource is created again",
+			creation:   &resume.Creation{Entry: resume.Entry{Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+			getErr:     &MockServiceFailure{StatusCode: 404},
+			creates:    1,
+			retryToken: "token",
+			journaled:  &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.created"}, RetryToken: "token"},
+		},
+		{
+			name:       "Test terminated resource is created again",
+			creation:   &resume.Creation{Entry: resume.Entry{Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+			state:      "TERMINATED",
+			creates:    1,
+			retryToken: "token",
+			journaled:  &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.created"}, RetryToken: "token"},
+		},
+		{
+			name:       "Test creation of an unknown resource is retried with its retry token",
+			creation:   &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest"}, RetryToken: "interrupted"},
+			creates:    1,
+			retryToken: "interrupted",
+			journaled:  &resume.Creation{Entry: resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.created"}, RetryToken: "interrupted"},
+		},
+		{
+			name:      "Test creation is kept when the resource cannot be read",
+			creation:  &resume.Creation{Entry: resume.Entry{Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+			getErr:    &MockServiceFailure{StatusCode: 500},
+			gotError:  true,
+			journaled: &resume.Creation{Entry: resume.Entry{Identifier: "ocid1.interrupted"}, RetryToken: "interrupted"},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		resume.JournalVar = resume.GetJournal(filepath.Join(t.TempDir(), "journal.json"))
+		if test.creation != nil {
+			assert.NoError(t, resume.JournalVar.Put(key, *test.creation))
+		}
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		var retryToken string
+		sync := &mockResumableCrud{BaseCrud: BaseCrud{D: d}, state: test.state, getErr: test.getErr}
+		sync.createFn = func(ctx context.Context) error {
+			retryToken = resume.RetryTokensFromContext(ctx).Next()
+			journaled, ok := resume.JournalVar.Get(key)
+			assert.True(t, ok, "the creation should be journaled before it starts")
+			assert.Equal(t, resume.Creation{RetryToken: retryToken}, journaled)
+			resume.RecorderFromContext(ctx).Record("ocid1.workrequest", "ocid1.created")
+			sync.id = "ocid1.created"
+			return nil
+		}
+		pending := resume.NewPending(nil)
+		pending.SetRetryToken("token")
+		ctx := resume.WithCreateKey(resume.WithPending(context.Background(), pending), key)
+
+		_, err := CreateOrResume(ctx, d, sync)
+		assert.Equal(t, test.gotError, err != nil, "%v", err)
+		assert.Equal(t, test.creates, sync.creates)
+		assert.Equal(t, test.retryToken, retryToken)
+		if test.id != "" {
+			assert.Equal(t, test.id, d.Id())
+			entry, ok := pending.Entry()
+			assert.True(t, ok, "the next read should wait for the creation of an adopted resource")
+			assert.Equal(t, test.id, entry.Identifier)
+		}
+		if test.retryToken != "" {
+			assert.Equal(t, test.retryToken, pending.RetryToken())
+		}
+		journaled, ok := resume.JournalVar.Get(key)
+		assert.Equal(t, test.journaled != nil, ok)
+		if test.journaled != nil {
+			assert.Equal(t, *test.journaled, journaled)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateKey(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString, Optional: true},
+		"cidr_blocks":  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+		"state":        {Type: schema.TypeString, Computed: true},
+	}
+	config := func(displayName string, cidrBlocks ...interface{}) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"display_name": displayName, "cidr_blocks": cidrBlocks})
+	}
+
+	key := CreateKey("oci_core_vcn", resourceSchema, config("vcn", "10.0.0.0/16", "10.1.0.0/16"))
+	assert.True(t, strings.HasPrefix(key, "oci_core_vcn,"))
+	assert.Equal(t, key, CreateKey("oci_core_vcn", resourceSchema, config("vcn", "10.1.0.0/16", "10.0.0.0/16")), "the order of the elements of sets should not change the key")
+	assert.NotEqual(t, key, CreateKey("oci_core_vcn", resourceSchema, config("other", "10.0.0.0/16", "10.1.0.0/16")))
+	assert.NotEqual(t, key, CreateKey("oci_core_subnet", resourceSchema, config("vcn", "10.0.0.0/16", "10.1.0.0/16")))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateResourceContext_interrupted(t *testing.T) {
+	defer func() { waitForStateRefreshVar = WaitForStateRefreshContext }()
+	waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	// Two creations of identical resources are not told apart, each one creates its own resource
+	for _, identifier := range []string{"ocid1.first", "ocid1.second"} {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		ctx, cancel := context.WithCancel(context.Background())
+		sync := &mockResumableCrud{BaseCrud: BaseCrud{D: d}, state: "PROVISIONING"}
+		sync.createFn = func(ctx context.Context) error {
+			resume.RecorderFromContext(ctx).Record("", identifier)
+			sync.id = identifier
+			cancel()
+			return nil
+		}
+
+		assert.NoError(t, CreateResourceContext(ctx, d, sync), "the resource of an interrupted creation should be saved")
+		assert.Equal(t, identifier, d.Id())
+		assert.Equal(t, 1, sync.creates)
+		assert.Len(t, sync.Warnings(), 1)
+	}
+
+	// A resource whose creation failed is not saved
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	ctx, cancel := context.WithCancel(context.Background())
+	sync := &mockResumableCrud{BaseCrud: BaseCrud{D: d}, state: FAILED}
+	sync.createFn = func(ctx context.Context) error {
+		sync.id = "ocid1.failed"
+		cancel()
+		return nil
+	}
+	assert.Error(t, CreateResourceContext(ctx, d, sync))
+	assert.Empty(t, sync.Warnings())
+
+	// A creation that timed out fails, the resource is saved with its work request
+	d = schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	pending := resume.NewPending(nil)
+	ctx, cancel = context.WithTimeout(resume.WithPending(context.Background(), pending), 50*time.Millisecond)
+	defer cancel()
+	sync = &mockResumableCrud{BaseCrud: BaseCrud{D: d}, state: "PROVISIONING"}
+	sync.createFn = func(ctx context.Context) error {
+		resume.RecorderFromContext(ctx).Record("ocid1.workrequest", "ocid1.timedout")
+		sync.id = "ocid1.timedout"
+		<-ctx.Done()
+		return nil
+	}
+	err := CreateResourceContext(ctx, d, sync)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "the timeout should be returned: %v", err)
+	assert.Equal(t, "ocid1.timedout", d.Id())
+	assert.Empty(t, sync.Warnings())
+	entry, ok := pending.Entry()
+	assert.True(t, ok)
+	assert.Equal(t, resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.timedout"}, entry)
+
+	// The work request of a completed creation is removed from the private state
+	d = schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	pending = resume.NewPending(nil)
+	sync = &mockResumableCrud{BaseCrud: BaseCrud{D: d}, state: "AVAILABLE"}
+	sync.createFn = func(ctx context.Context) error {
+		resume.RecorderFromContext(ctx).Record("ocid1.workrequest", "ocid1.created")
+		sync.id = "ocid1.created"
+		return nil
+	}
+	waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+		return nil
+	}
+	assert.NoError(t, CreateResourceContext(resume.WithPending(context.Background(), pending), d, sync))
+	_, ok = pending.Entry()
+	assert.False(t, ok)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitReadResourceContext_pendingCreation(t *testing.T) {
+	defer func() { waitForStateRefreshVar = WaitForStateRefreshContext }()
+	type testFormat struct {
+		name     string
+		state    string
+		waitFn   func(sync *mockResumableCrud) error
+		gotError bool
+		id       string
+		waited   bool
+		pending  bool
+	}
+	tests := []testFormat{
+		{
+			name:   "Test read waits for the pending creation",
+			state:  "PROVISIONING",
+			waitFn: func(sync *mockResumableCrud) error { sync.state = "AVAILABLE"; return nil },
+			id:     "ocid1.created",
+			waited: true,
+		},
+		{
+			name:  "Test created resource is not waited for",
+			state: "AVAILABLE",
+			id:    "ocid1.created",
+		},
+		{
+			name:  "Test resource of a failed creation is removed from the state",
+			state: FAILED,
+		},
+		{
+			name:     "Test creation not complete within the read timeout is kept pending",
+			state:    "PROVISIONING",
+			waitFn:   func(sync *mockResumableCrud) error { return context.DeadlineExceeded },
+			gotError: true,
+			id:       "ocid1.created",
+			waited:   true,
+			pending:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId("ocid1.created")
+		sync := &mockResumableCrud{BaseCrud: BaseCrud{D: d}, id: "ocid1.created", state: test.state}
+		waited := false
+		waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+			waited = true
+			return test.waitFn(sync)
+		}
+		pending := resume.NewPending(nil)
+		pending.Set(resume.Entry{WorkRequestId: "ocid1.workrequest", Identifier: "ocid1.created"})
+
+		err := ReadResourceContext(resume.WithPending(context.Background(), pending), sync)
+		assert.Equal(t, test.gotError, err != nil, "%v", err)
+		assert.Equal(t, test.id, d.Id())
+		assert.Equal(t, test.waited, waited)
+		_, ok := pending.Entry()
+		assert.Equal(t, test.pending, ok)
+	}
+}
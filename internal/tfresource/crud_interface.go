@@ -4,15 +4,19 @@
 package tfresource
 
 import (
+	"context"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 // Common interfaces
 
 // Gets the current BareMetal Resource
 type ResourceFetcher interface {
-	// Get should Update the s.Resource, and is used by ReadResource() to populate s.D
+	// Get should Update the s.Resource, and is used by ReadResourceContext() to populate s.D
 	// Get() may expect s.D.Id() to be set, but not s.Resource, or anything else in s.D
 	Get() error
 }
@@ -33,10 +37,23 @@ type ResourceDataWriter interface {
 	SetData() error
 }
 
+// ContextAwareResource receives the Terraform-provided context before a CRUD operation starts.
+// Resources pass Context() to their SDK calls, retry policies and waiters so that cancelling an apply stops them.
+// BaseCrud implements this interface.
+type ContextAwareResource interface {
+	SetContext(ctx context.Context)
+	Context() context.Context
+}
+
+// WarningReporter returns the non-fatal diagnostics a resource recorded during a CRUD operation
+type WarningReporter interface {
+	Warnings() diag.Diagnostics
+}
+
 // CRUD standard interfaces
 
 // ResourceCreator may Create a BareMetal resource and populate into
-// ResourceData state by using CreateResource()
+// ResourceData state by using CreateResourceContext()
 type ResourceCreator interface {
 	ResourceDataWriter
 	// ID identifies the resource, or a work request to Create the resource.
@@ -44,6 +61,17 @@ type ResourceCreator interface {
 	Create() error
 }
 
+// ResumableCreator is a ResourceCreator whose first request accepted by OCI creates the resource, e.g. a VCN or a
+// DB system. If its create is interrupted afterwards, the resource is saved to the state instead of being created again
+// by the next apply. The creation is recorded from the requests made with the context set by SetContext().
+type ResumableCreator interface {
+	ResourceCreator
+	ContextAwareResource
+	AddWarning(summary string, detail string, path cty.Path)
+	// ResumableCreate marks the resources whose first accepted request creates the resource
+	ResumableCreate()
+}
+
 // ResourceReader get BareMetal Resource and updated ResourceData
 type ResourceReader interface {
 	ResourceFetcher
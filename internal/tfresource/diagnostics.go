@@ -0,0 +1,220 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Matches dotted or single identifiers in service error messages, e.g. "shapeConfig.ocpus" or "display_name"
+var attributeNameInMessageRegex = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9_]*(?:\.[a-zA-Z][a-zA-Z0-9_]*)*`)
+
+var camelCaseBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// diagnosticError is returned by HandleError. It formats exactly like the plain error used to, but keeps the
+// structured customError around so that ToDiagnostics can build a diagnostic with an attribute path from it.
+type diagnosticError struct {
+	tfError customError
+	err     error
+}
+
+func (e *diagnosticError) Error() string {
+	return e.err.Error()
+}
+
+// Diagnostic converts the custom error into a Terraform diagnostic. The summary carries the error code, the
+// detail carries the same information as the formatted error message.
+func (tfE customError) Diagnostic() diag.Diagnostic {
+	result := diag.Diagnostic{
+		Severity:      diag.Error,
+		AttributePath: tfE.AttributePath,
+	}
+
+	var detail strings.Builder
+	switch tfE.TypeOfError {
+	case ServiceError:
+		result.Summary = fmt.Sprintf("%d-%s", tfE.ErrorCode, tfE.ErrorCodeName)
+		fmt.Fprintf(&detail, "%s\nService: %s\nError Message: %s\nOPC request ID: %s\n", tfE.VersionError, tfE.Service, tfE.Message, tfE.OpcRequestID)
+	case TimeoutError:
+		result.Summary = tfE.ErrorCodeName
+		fmt.Fprintf(&detail, "%s\nService: %s\nError Message: %s\n", tfE.VersionError, tfE.Service, tfE.Message)
+	case UnexpectedStateError, WorkRequestError:
+		result.Summary = tfE.ErrorCodeName
+		fmt.Fprintf(&detail, "%s\nService: %s\nError Message: %s\nResource OCID: %s\n", tfE.VersionError, tfE.Service, tfE.Message, tfE.ResourceOCID)
+	default:
+		result.Summary = tfE.Message
+	}
+	if tfE.Suggestion != "" {
+		fmt.Fprintf(&detail, "Suggestion: %s", tfE.Suggestion)
+	}
+	result.Detail = strings.TrimSpace(detail.String())
+
+	return result
+}
+
+// ToDiagnostics converts the error returned by one of the CRUD helpers into diagnostics. Errors produced by
+// HandleError keep their summary, detail and attribute path; any other error is reported as is. Warnings
+// recorded by the resource during the operation are returned as well, even if the operation failed.
+func ToDiagnostics(sync interface{}, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if reporter, ok := sync.(WarningReporter); ok {
+		diags = append(diags, reporter.Warnings()...)
+	}
+
+	if err == nil {
+		return diags
+	}
+
+	var tfErr *diagnosticError
+	if errors.As(err, &tfErr) {
+		return append(diags, tfErr.tfError.Diagnostic())
+	}
+
+	return append(diags, diag.FromErr(err)...)
+}
+
+// HandleDiagError is the diagnostics counterpart of HandleError
+func HandleDiagError(sync interface{}, err error) diag.Diagnostics {
+	return ToDiagnostics(sync, HandleError(sync, err))
+}
+
+// AddWarning records a non-fatal diagnostic, e.g. a deprecated field in use or a placeholder value added by the
+// provider. Warnings are returned to Terraform by ToDiagnostics once the CRUD operation completes. A warning already
+// recorded, e.g. by a Get called both from Create and from the waiter, is recorded only once.
+func (s *BaseCrud) AddWarning(summary string, detail string, path cty.Path) {
+	warning := diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: path,
+	}
+	for _, existing := range s.warnings {
+		if existing.Summary == summary && existing.Detail == detail && existing.AttributePath.Equals(path) {
+			return
+		}
+	}
+	s.warnings = append(s.warnings, warning)
+}
+
+func (s *BaseCrud) Warnings() diag.Diagnostics {
+	return s.warnings
+}
+
+// getAttributePathFromError finds the configuration attribute an InvalidParameter error is about. Service error
+// messages name the parameter by its API name (e.g. "shapeConfig.ocpus"); this is mapped onto the snake case
+// attribute names of the schema and only returned if the attribute is actually set on the resource.
+func getAttributePathFromError(sync interface{}, message string) cty.Path {
+	d := getResourceData(sync)
+	if d == nil {
+		return nil
+	}
+
+	candidates := attributeNameInMessageRegex.FindAllString(message, -1)
+	// Prefer dotted paths, then camel or snake case names, over plain words that may collide with attribute names
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return attributeCandidateRank(candidates[i]) > attributeCandidateRank(candidates[j])
+	})
+
+	for _, candidate := range candidates {
+		if path, ok := resolveAttributePath(d, strings.Split(candidate, ".")); ok {
+			return path
+		}
+	}
+	return nil
+}
+
+func attributeCandidateRank(candidate string) int {
+	switch {
+	case strings.Contains(candidate, "."):
+		return 2
+	case strings.ContainsAny(candidate, "_ABCDEFGHIJKLMNOPQRSTUVWXYZ"):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func resolveAttributePath(d schemaResourceData, segments []string) (cty.Path, bool) {
+	var path cty.Path
+	key := ""
+	for i, segment := range segments {
+		name := toSnakeCase(segment)
+		if key == "" {
+			key = name
+		} else {
+			key = key + "." + name
+		}
+		if _, ok := d.GetOkExists(key); !ok {
+			return nil, false
+		}
+		path = path.GetAttr(name)
+
+		// Nested blocks are single element lists in most resources, so look into the first element
+		if i < len(segments)-1 {
+			if _, ok := d.GetOkExists(key + ".0." + toSnakeCase(segments[i+1])); ok {
+				key = key + ".0"
+				path = path.IndexInt(0)
+			}
+		}
+	}
+	return path, len(path) > 0
+}
+
+func toSnakeCase(name string) string {
+	return strings.ToLower(camelCaseBoundaryRegex.ReplaceAllString(name, "${1}_${2}"))
+}
+
+// getResourceData returns the D field of a resource or data source crud, if it has one
+func getResourceData(sync interface{}) (d schemaResourceData) {
+	defer func() {
+		if r := recover(); r != nil {
+			d = nil
+		}
+	}()
+
+	v := reflect.ValueOf(sync)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	field := v.FieldByName("D")
+	if !field.IsValid() || (field.Kind() == reflect.Ptr && field.IsNil()) {
+		return nil
+	}
+	if resourceData, ok := field.Interface().(schemaResourceData); ok {
+		return resourceData
+	}
+	return nil
+}
+
+// FormatAttributePath renders a cty.Path the way attributes are addressed in ResourceData, e.g. "shape_config.0.ocpus"
+func FormatAttributePath(path cty.Path) string {
+	parts := make([]string, 0, len(path))
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				i, _ := s.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(i, 10))
+			} else if s.Key.Type() == cty.String {
+				parts = append(parts, s.Key.AsString())
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type DiagnosticsTestResourceCrud struct {
+	BaseCrud
+}
+
+func diagnosticsTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"shape": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"shape_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ocpus": {
+						Type:     schema.TypeFloat,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"display_name": "instance",
+		"shape":        "VM.Standard.E4.Flex",
+		"shape_config": []interface{}{map[string]interface{}{"ocpus": 200.0}},
+	})
+}
+
+func TestUnitToSnakeCase(t *testing.T) {
+	assert.Equal(t, "shape_config", toSnakeCase("shapeConfig"))
+	assert.Equal(t, "shape_config", toSnakeCase("shape_config"))
+	assert.Equal(t, "ocpus", toSnakeCase("ocpus"))
+	assert.Equal(t, "is_ipv6enabled", toSnakeCase("isIpv6enabled"))
+}
+
+func TestUnitGetAttributePathFromError(t *testing.T) {
+	sync := &DiagnosticsTestResourceCrud{}
+	sync.D = diagnosticsTestResourceData(t)
+
+	type testFormat struct {
+		name    string
+		message string
+		output  string
+	}
+	tests := []testFormat{
+		{
+			name:    "Test nested camel case parameter is mapped to the list element",
+			message: "Invalid shapeConfig.ocpus: must be between 1 and 64",
+			output:  "shape_config.0.ocpus",
+		},
+		{
+			name:    "Test top level camel case parameter",
+			message: "displayName is invalid",
+			output:  "display_name",
+		},
+		{
+			name:    "Test no attribute is found",
+			message: "Something went wrong",
+			output:  "",
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		assert.Equal(t, test.output, FormatAttributePath(getAttributePathFromError(sync, test.message)))
+	}
+
+	assert.Nil(t, getAttributePathFromError(&Test{}, "Invalid shapeConfig.ocpus"))
+}
+
+func TestUnitToDiagnostics(t *testing.T) {
+	sync := &DiagnosticsTestResourceCrud{}
+	sync.D = diagnosticsTestResourceData(t)
+
+	// No error and no warnings
+	assert.Nil(t, ToDiagnostics(sync, nil))
+
+	// Plain errors are returned as is
+	diags := ToDiagnostics(sync, errors.New("plain error"))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "plain error", diags[0].Summary)
+
+	// Warnings are returned together with the error
+	sync.AddWarning("deprecated", "use something else", cty.GetAttrPath("shape"))
+	sync.AddWarning("deprecated", "use something else", cty.GetAttrPath("shape"))
+	mockServiceFailure := &MockServiceFailure{
+		StatusCode:   400,
+		Code:         "InvalidParameter",
+		Message:      "Invalid shapeConfig.ocpus: must be between 1 and 64",
+		OpcRequestID: "opcRequestId",
+	}
+	serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return mockServiceFailure, true }
+	defer func() {
+		serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return oci_common.IsServiceError(err) }
+	}()
+
+	err := HandleError(sync, mockServiceFailure)
+	assert.Contains(t, err.Error(), "400-InvalidParameter")
+
+	diags = ToDiagnostics(sync, err)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "shape", FormatAttributePath(diags[0].AttributePath))
+	assert.Equal(t, diag.Error, diags[1].Severity)
+	assert.Equal(t, "400-InvalidParameter", diags[1].Summary)
+	assert.Contains(t, diags[1].Detail, "OPC request ID: opcRequestId")
+	assert.Contains(t, diags[1].Detail, "Suggestion: Please Update the parameter(s)")
+	assert.Equal(t, "shape_config.0.ocpus", FormatAttributePath(diags[1].AttributePath))
+
+	assert.Equal(t, diags[1:], HandleDiagError(&DiagnosticsTestResourceCrud{BaseCrud{D: sync.D}}, mockServiceFailure))
+}
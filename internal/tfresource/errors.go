@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
@@ -37,6 +38,7 @@ type customError struct {
 	ResourceOCID  string
 	Suggestion    string
 	VersionError  string
+	AttributePath cty.Path
 }
 
 // Create new error format for Terraform output
@@ -54,6 +56,9 @@ func newCustomError(sync interface{}, err error) error {
 			OpcRequestID:  failure.GetOpcRequestID(),
 			Service:       getServiceName(sync),
 		}
+		if tfError.ErrorCode == 400 {
+			tfError.AttributePath = getAttributePathFromError(sync, tfError.Message)
+		}
 	} else if strings.Contains(errorMessage, "timeout while waiting for state") {
 		// Timeout error
 		tfError = customError{
@@ -86,7 +91,7 @@ func newCustomError(sync interface{}, err error) error {
 
 	tfError.VersionError = GetVersionAndDateError()
 	tfError.Suggestion = getSuggestionFromError(tfError)
-	return tfError.Error()
+	return &diagnosticError{tfError: tfError, err: tfError.Error()}
 }
 
 func (tfE customError) Error() error {
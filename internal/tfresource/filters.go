@@ -4,15 +4,23 @@
 package tfresource
 
 import (
+	"context"
+	"errors"
 	"log"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
+	"time"
 
 	"fmt"
 	"strings"
 
+	"github.com/agext/levenshtein"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceFiltersSchema() *schema.Schema {
@@ -38,11 +46,48 @@ func DataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(FilterOperators, false),
+				},
 			},
 		},
 	}
 }
 
+// Filter operators. Without an operator, or with eq, values are matched exactly or, if regex is set, as regular
+// expressions. The negated operators ne and not_regex match items for which none of the values match.
+const (
+	FilterOperatorEq       = "eq"
+	FilterOperatorNe       = "ne"
+	FilterOperatorLt       = "lt"
+	FilterOperatorLe       = "le"
+	FilterOperatorGt       = "gt"
+	FilterOperatorGe       = "ge"
+	FilterOperatorContains = "contains"
+	FilterOperatorPrefix   = "prefix"
+	filterOperatorRegex    = "regex"
+	FilterOperatorNotRegex = "not_regex"
+)
+
+var FilterOperators = []string{
+	FilterOperatorEq,
+	FilterOperatorNe,
+	FilterOperatorLt,
+	FilterOperatorLe,
+	FilterOperatorGt,
+	FilterOperatorGe,
+	FilterOperatorContains,
+	FilterOperatorPrefix,
+	FilterOperatorNotRegex,
+}
+
+// Layouts of the time fields of items: RFC3339 as used in configurations, and the format of SDKTime.String() in
+// which times are stored in state
+var filterTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST"}
+
 var PrimitiveDataTypes = map[schema.ValueType]bool{
 	schema.TypeString: true,
 	schema.TypeBool:   true,
@@ -58,41 +103,12 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		matches := getFilterMatcher(f.(map[string]interface{}), resourceSchema)
 
 		// build a collection of items from matches against the set of filters
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
-			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if matches(item) {
 				res = append(res, item)
 			}
 		}
@@ -102,41 +118,30 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 	return items
 }
 
+// getFiltersMatcher builds the matchers of the filters once, for the callers filtering items one at a time. Items
+// match if they match every filter, as with ApplyFilters.
+func getFiltersMatcher(filters *schema.Set, resourceSchema map[string]*schema.Schema) func(item map[string]interface{}) bool {
+	matchers := []func(item map[string]interface{}) bool{}
+	for _, f := range filters.List() {
+		matchers = append(matchers, getFilterMatcher(f.(map[string]interface{}), resourceSchema))
+	}
+	return func(item map[string]interface{}) bool {
+		for _, matches := range matchers {
+			if !matches(item) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resourceSchema map[string]*schema.Schema) []interface{} {
 	if filters == nil || filters.Len() == 0 {
 		return items
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		matches := getFilterMatcher(f.(map[string]interface{}), collectionItemSchema(resourceSchema))
 
 		// build a collection of items from matches against the set of filters
 		res := make([]interface{}, 0)
@@ -145,8 +150,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 			if !ok {
 				continue
 			}
-			targetVal, targetValOk := getValueFromPath(itemMap, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if matches(itemMap) {
 				res = append(res, itemMap)
 			}
 		}
@@ -156,6 +160,447 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 	return items
 }
 
+// AddFilterValidation makes a data source with filters fail before listing anything if its filters cannot match the
+// items it lists, see ValidateFilters. The names of the filters are validated with the configuration, so that
+// `terraform validate` reports filters on unknown attributes, and their values before reading. Data sources whose
+// items cannot be found in their schema are left unchanged.
+func AddFilterValidation(dataSource *schema.Resource) {
+	if dataSource == nil || dataSource.Schema["filter"] == nil {
+		return
+	}
+	itemSchema := dataSourceItemSchema(dataSource)
+	if itemSchema == nil {
+		return
+	}
+	if filterSchema, ok := filterSchemaWithNameValidation(dataSource.Schema["filter"], itemSchema); ok {
+		dataSource.Schema["filter"] = filterSchema
+	}
+	validate := func(d *schema.ResourceData) error {
+		if filters, ok := d.GetOkExists("filter"); ok {
+			return ValidateFilters(filters.(*schema.Set), itemSchema)
+		}
+		return nil
+	}
+
+	if read := dataSource.Read; read != nil {
+		dataSource.Read = func(d *schema.ResourceData, m interface{}) error {
+			if err := validate(d); err != nil {
+				return err
+			}
+			return read(d, m)
+		}
+	}
+	if read := dataSource.ReadContext; read != nil {
+		dataSource.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := validate(d); err != nil {
+				return diag.FromErr(err)
+			}
+			return read(ctx, d, m)
+		}
+	}
+}
+
+// filterSchemaWithNameValidation returns a copy of the filter schema whose name is validated against the schema of the
+// items. The filter schema is copied as it may be shared by several data sources.
+func filterSchemaWithNameValidation(filterSchema *schema.Schema, itemSchema map[string]*schema.Schema) (*schema.Schema, bool) {
+	elem, ok := filterSchema.Elem.(*schema.Resource)
+	if !ok || elem.Schema["name"] == nil || elem.Schema["name"].ValidateFunc != nil || elem.Schema["name"].ValidateDiagFunc != nil {
+		return nil, false
+	}
+	nameSchema := *elem.Schema["name"]
+	nameSchema.ValidateFunc = func(v interface{}, k string) ([]string, []error) {
+		if _, err := validateFilterName(v.(string), itemSchema); err != nil {
+			return nil, []error{err}
+		}
+		return nil, nil
+	}
+	elemSchema := make(map[string]*schema.Schema, len(elem.Schema))
+	for name, attribute := range elem.Schema {
+		elemSchema[name] = attribute
+	}
+	elemSchema["name"] = &nameSchema
+	result := *filterSchema
+	result.Elem = &schema.Resource{Schema: elemSchema}
+	return &result, true
+}
+
+// dataSourceItemSchema returns the schema of the items a data source lists and filters: the elements of its only
+// computed list of objects, or the items of that list if it is a collection. Returns nil if there is no such list.
+func dataSourceItemSchema(dataSource *schema.Resource) map[string]*schema.Schema {
+	var itemSchema map[string]*schema.Schema
+	for name, attribute := range dataSource.Schema {
+		if name == "filter" || !attribute.Computed || attribute.Optional || (attribute.Type != schema.TypeList && attribute.Type != schema.TypeSet) {
+			continue
+		}
+		elem, ok := attribute.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		if itemSchema != nil {
+			return nil
+		}
+		itemSchema = collectionItemSchema(elem.Schema)
+	}
+	return itemSchema
+}
+
+// collectionItemSchema returns the schema of the "items" of a collection, e.g. the element of listener_collection
+// whose items are listeners, and the schema itself if it is not a collection
+func collectionItemSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	if items, ok := resourceSchema["items"]; ok && items.Type == schema.TypeList {
+		if elem, ok := items.Elem.(*schema.Resource); ok {
+			return elem.Schema
+		}
+	}
+	return resourceSchema
+}
+
+// ValidateFilters returns an error for every filter that cannot match any item: filters on attributes that are not in
+// the schema of the items, invalid regular expressions and values that are not numbers or booleans while the
+// attribute is
+func ValidateFilters(filters *schema.Set, resourceSchema map[string]*schema.Schema) error {
+	if filters == nil || resourceSchema == nil {
+		return nil
+	}
+	var result *multierror.Error
+	for _, f := range filters.List() {
+		if err := validateFilter(f.(map[string]interface{}), resourceSchema); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func validateFilter(fSet map[string]interface{}, resourceSchema map[string]*schema.Schema) error {
+	keyword := fSet["name"].(string)
+	pathElements, err := validateFilterName(keyword, resourceSchema)
+	if err != nil {
+		return err
+	}
+
+	operator := FilterOperatorEq
+	if op, ok := fSet["operator"]; ok && op != nil && op.(string) != "" {
+		operator = op.(string)
+	}
+	if regex, ok := fSet["regex"]; ok && regex.(bool) && operator == FilterOperatorEq {
+		operator = filterOperatorRegex
+	}
+	valueType := getFieldValueType(resourceSchema, pathElements)
+
+	for _, value := range fSet["values"].([]interface{}) {
+		filterVal := fmt.Sprint(value)
+		switch {
+		case operator == filterOperatorRegex || operator == FilterOperatorNotRegex:
+			if _, err := regexp.Compile(filterVal); err != nil {
+				return fmt.Errorf("invalid regular expression %q in filter %q: %v", filterVal, keyword, err)
+			}
+		case operator == FilterOperatorContains || operator == FilterOperatorPrefix:
+		case valueType == schema.TypeInt || valueType == schema.TypeFloat:
+			if _, err := strconv.ParseFloat(filterVal, 64); err != nil {
+				return fmt.Errorf("invalid value %q in filter %q: %s is a number", filterVal, keyword, keyword)
+			}
+		case valueType == schema.TypeBool:
+			if _, err := strconv.ParseBool(filterVal); err != nil {
+				return fmt.Errorf("invalid value %q in filter %q: %s is a boolean", filterVal, keyword, keyword)
+			}
+		}
+	}
+	return nil
+}
+
+// validateFilterName returns the path of the attribute a filter matches, or an error if it is not in the schema
+func validateFilterName(keyword string, resourceSchema map[string]*schema.Schema) ([]string, error) {
+	pathElements, err := getFieldPathElements(resourceSchema, keyword)
+	if err != nil {
+		// Attributes of unsupported types are still matched by name
+		if resourceSchema[keyword] == nil {
+			return nil, unknownFilterNameError(keyword, resourceSchema)
+		}
+		pathElements = []string{keyword}
+	}
+	return pathElements, nil
+}
+
+const (
+	maxFilterNameDistance    = 3
+	maxFilterNameSuggestions = 3
+)
+
+// unknownFilterNameError returns the error of a filter on an attribute that is not in the schema, suggesting the
+// attributes with the closest names
+func unknownFilterNameError(keyword string, resourceSchema map[string]*schema.Schema) error {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	var suggestions []suggestion
+	for _, name := range getFilterNames(resourceSchema, "") {
+		distance := levenshtein.Distance(strings.ToLower(keyword), name, nil)
+		if distance <= maxFilterNameDistance || strings.HasSuffix(name, "_"+keyword) {
+			suggestions = append(suggestions, suggestion{name: name, distance: distance})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	message := fmt.Sprintf("invalid filter name %q: there is no such attribute", keyword)
+	if len(suggestions) > 0 {
+		names := []string{}
+		for i := 0; i < len(suggestions) && i < maxFilterNameSuggestions; i++ {
+			names = append(names, strconv.Quote(suggestions[i].name))
+		}
+		message += fmt.Sprintf(", did you mean %s?", strings.Join(names, " or "))
+	}
+	return errors.New(message)
+}
+
+// getFilterNames returns the sorted names that filters can refer to in the schema, including the attributes of nested
+// structures, e.g. source_details.source_type
+func getFilterNames(resourceSchema map[string]*schema.Schema, prefix string) []string {
+	var names []string
+	for name, fieldSchema := range resourceSchema {
+		if !isValidSchemaType(fieldSchema) {
+			continue
+		}
+		if elem, ok := fieldSchema.Elem.(*schema.Resource); ok {
+			names = append(names, getFilterNames(elem.Schema, prefix+name+".")...)
+			continue
+		}
+		names = append(names, prefix+name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getFilterMatcher returns a function that checks whether an item matches a filter
+func getFilterMatcher(fSet map[string]interface{}, resourceSchema map[string]*schema.Schema) func(item map[string]interface{}) bool {
+	keyword := fSet["name"].(string)
+	var pathElements []string
+	var err error
+	if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
+		log.Printf(err.Error())
+		pathElements = []string{keyword}
+	}
+	values := fSet["values"].([]interface{})
+
+	operator := ""
+	if op, ok := fSet["operator"]; ok && op != nil {
+		operator = op.(string)
+	}
+	if operator != "" && operator != FilterOperatorEq {
+		return getOperatorFilterMatcher(keyword, pathElements, values, operator, getFieldValueType(resourceSchema, pathElements))
+	}
+
+	isReg := false
+	if regex, regexOk := fSet["regex"]; regexOk {
+		isReg = regex.(bool)
+	}
+
+	// Create a string equality check strategy based on this filters "regex" flag
+	stringsEqual := func(propertyVal string, filterVal string) bool {
+		if isReg {
+			re, err := regexp.Compile(filterVal)
+			if err != nil {
+				// todo: when all SetData() fns are refactored to return a possible error, these log statements should
+				// be converted to errors for return propagation
+				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
+				return false
+			}
+			return re.MatchString(propertyVal)
+		}
+
+		return filterVal == propertyVal
+	}
+
+	return func(item map[string]interface{}) bool {
+		targetVal, targetValOk := getValueFromPath(item, pathElements)
+		return targetValOk && orComparator(targetVal, values, stringsEqual)
+	}
+}
+
+// getOperatorFilterMatcher returns a function that checks whether any value of an item's property, or the property
+// itself if it is not a list, compares to any of the filter values with the operator. Negated operators match if no
+// value compares.
+func getOperatorFilterMatcher(keyword string, pathElements []string, values []interface{}, operator string, valueType schema.ValueType) func(item map[string]interface{}) bool {
+	negate := operator == FilterOperatorNe || operator == FilterOperatorNotRegex
+	switch operator {
+	case FilterOperatorNe:
+		operator = FilterOperatorEq
+	case FilterOperatorNotRegex:
+		operator = filterOperatorRegex
+	}
+
+	filterVals := make([]string, 0, len(values))
+	regexes := make([]*regexp.Regexp, 0, len(values))
+	for _, value := range values {
+		filterVal := fmt.Sprint(value)
+		filterVals = append(filterVals, filterVal)
+		if operator == filterOperatorRegex {
+			re, err := regexp.Compile(filterVal)
+			if err != nil {
+				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
+				continue
+			}
+			regexes = append(regexes, re)
+		}
+	}
+
+	compares := func(propertyVal string) bool {
+		if operator == filterOperatorRegex {
+			for _, re := range regexes {
+				if re.MatchString(propertyVal) {
+					return true
+				}
+			}
+			return false
+		}
+		for _, filterVal := range filterVals {
+			if compareFilterValue(propertyVal, filterVal, operator, valueType) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(item map[string]interface{}) bool {
+		targetVal, targetValOk := getValueFromPath(item, pathElements)
+		if !targetValOk || targetVal == nil {
+			return false
+		}
+		matched := false
+		val := reflect.ValueOf(targetVal)
+		switch val.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < val.Len() && !matched; i++ {
+				matched = compares(fmt.Sprint(val.Index(i).Interface()))
+			}
+		case reflect.Map, reflect.Struct, reflect.Ptr:
+			log.Printf("[WARN] Filtering against unsupported type of field \"%s\"", keyword)
+			return false
+		default:
+			matched = compares(fmt.Sprint(targetVal))
+		}
+		return matched != negate
+	}
+}
+
+// compareFilterValue compares a property value to a filter value with the operator, according to the type of the
+// property. Strings that are both times or both numbers are compared as such.
+func compareFilterValue(propertyVal string, filterVal string, operator string, valueType schema.ValueType) bool {
+	switch operator {
+	case FilterOperatorContains:
+		return strings.Contains(propertyVal, filterVal)
+	case FilterOperatorPrefix:
+		return strings.HasPrefix(propertyVal, filterVal)
+	}
+
+	comparison, ok := compareTypedValues(propertyVal, filterVal, valueType)
+	if !ok {
+		return false
+	}
+	switch operator {
+	case FilterOperatorEq:
+		return comparison == 0
+	case FilterOperatorLt:
+		return comparison < 0
+	case FilterOperatorLe:
+		return comparison <= 0
+	case FilterOperatorGt:
+		return comparison > 0
+	case FilterOperatorGe:
+		return comparison >= 0
+	}
+	return false
+}
+
+// compareTypedValues returns -1, 0 or 1 if a is less than, equal to or greater than b, false if they can not be compared
+func compareTypedValues(a string, b string, valueType schema.ValueType) (int, bool) {
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		return compareFloats(a, b)
+	case schema.TypeBool:
+		aBool, aErr := strconv.ParseBool(a)
+		bBool, bErr := strconv.ParseBool(b)
+		if aErr != nil || bErr != nil {
+			log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
+			return 0, false
+		}
+		if aBool == bBool {
+			return 0, true
+		}
+		if !aBool {
+			return -1, true
+		}
+		return 1, true
+	}
+
+	if aTime, ok := parseFilterTime(a); ok {
+		if bTime, ok := parseFilterTime(b); ok {
+			switch {
+			case aTime.Before(bTime):
+				return -1, true
+			case aTime.After(bTime):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if comparison, ok := compareFloats(a, b); ok {
+		return comparison, true
+	}
+	return strings.Compare(a, b), true
+}
+
+func compareFloats(a string, b string) (int, bool) {
+	aFloat, aErr := strconv.ParseFloat(a, 64)
+	bFloat, bErr := strconv.ParseFloat(b, 64)
+	if aErr != nil || bErr != nil {
+		return 0, false
+	}
+	switch {
+	case aFloat < bFloat:
+		return -1, true
+	case aFloat > bFloat:
+		return 1, true
+	}
+	return 0, true
+}
+
+func parseFilterTime(value string) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// getFieldValueType returns the type of the values of the field at the path, e.g. TypeString for the elements of a
+// list of strings or the values of a map. Returns TypeInvalid if the field is not in the schema.
+func getFieldValueType(resourceSchema map[string]*schema.Schema, pathElements []string) schema.ValueType {
+	currentSchema := resourceSchema
+	for index, pathElement := range pathElements {
+		fieldSchema, ok := currentSchema[pathElement]
+		if !ok || fieldSchema == nil {
+			return schema.TypeInvalid
+		}
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			currentSchema = elem.Schema
+			continue
+		case *schema.Schema:
+			return elem.Type
+		}
+		if fieldSchema.Type == schema.TypeMap || index < len(pathElements)-1 {
+			// map values without an element schema are strings
+			return schema.TypeString
+		}
+		return fieldSchema.Type
+	}
+	return schema.TypeInvalid
+}
+
 func getValueFromPath(item map[string]interface{}, path []string) (targetVal interface{}, targetValOk bool) {
 	workingMap := item
 	tempWorkingMap := item
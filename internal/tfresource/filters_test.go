@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	oci_core "github.com/oracle/oci-go-sdk/v61/core"
 )
 
@@ -1189,3 +1190,350 @@ func CoreInstanceResource() *schema.Resource {
 		},
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitApplyFilters_operators(t *testing.T) {
+	items := []map[string]interface{}{
+		{"display_name": "web-1", "size_in_gbs": "50", "cpus": 2, "enabled": true, "time_created": "2022-01-10 08:00:00 +0000 UTC", "tags": []interface{}{"prod", "web"}},
+		{"display_name": "web-2", "size_in_gbs": "100", "cpus": 8, "enabled": false, "time_created": "2022-02-10 08:00:00 +0000 UTC", "tags": []interface{}{"dev"}},
+		{"display_name": "db-1", "size_in_gbs": "1024", "cpus": 16, "enabled": true, "time_created": "2022-03-10 08:00:00.5 +0000 UTC", "tags": []interface{}{}},
+	}
+	testSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString},
+		"size_in_gbs":  {Type: schema.TypeString},
+		"cpus":         {Type: schema.TypeInt},
+		"enabled":      {Type: schema.TypeBool},
+		"time_created": {Type: schema.TypeString},
+		"tags":         {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+
+	type testFormat struct {
+		name     string
+		filter   map[string]interface{}
+		expected []string
+	}
+	tests := []testFormat{
+		{
+			name:     "Test eq is the default exact match",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"web-1"}, "operator": "eq"},
+			expected: []string{"web-1"},
+		},
+		{
+			name:     "Test eq with regex",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"^web-"}, "operator": "eq", "regex": true},
+			expected: []string{"web-1", "web-2"},
+		},
+		{
+			name:     "Test ne matches none of the values",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"web-1", "web-2"}, "operator": "ne"},
+			expected: []string{"db-1"},
+		},
+		{
+			name:     "Test numeric string ge",
+			filter:   map[string]interface{}{"name": "size_in_gbs", "values": []interface{}{"100"}, "operator": "ge"},
+			expected: []string{"web-2", "db-1"},
+		},
+		{
+			name:     "Test int lt",
+			filter:   map[string]interface{}{"name": "cpus", "values": []interface{}{"8"}, "operator": "lt"},
+			expected: []string{"web-1"},
+		},
+		{
+			name:     "Test int le with non numeric value",
+			filter:   map[string]interface{}{"name": "cpus", "values": []interface{}{"many"}, "operator": "le"},
+			expected: []string{},
+		},
+		{
+			name:     "Test bool ne",
+			filter:   map[string]interface{}{"name": "enabled", "values": []interface{}{"true"}, "operator": "ne"},
+			expected: []string{"web-2"},
+		},
+		{
+			name:     "Test time lt with RFC3339",
+			filter:   map[string]interface{}{"name": "time_created", "values": []interface{}{"2022-02-10T08:00:00Z"}, "operator": "lt"},
+			expected: []string{"web-1"},
+		},
+		{
+			name:     "Test time gt with RFC3339 in another time zone",
+			filter:   map[string]interface{}{"name": "time_created", "values": []interface{}{"2022-02-10T09:00:00+01:00"}, "operator": "gt"},
+			expected: []string{"db-1"},
+		},
+		{
+			name:     "Test contains",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"b-"}, "operator": "contains"},
+			expected: []string{"web-1", "web-2", "db-1"},
+		},
+		{
+			name:     "Test prefix",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"db"}, "operator": "prefix"},
+			expected: []string{"db-1"},
+		},
+		{
+			name:     "Test not_regex",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"^web-"}, "operator": "not_regex"},
+			expected: []string{"db-1"},
+		},
+		{
+			name:     "Test not_regex with invalid regex",
+			filter:   map[string]interface{}{"name": "display_name", "values": []interface{}{"^web-("}, "operator": "not_regex"},
+			expected: []string{"web-1", "web-2", "db-1"},
+		},
+		{
+			name:     "Test ne against list of strings",
+			filter:   map[string]interface{}{"name": "tags", "values": []interface{}{"prod"}, "operator": "ne"},
+			expected: []string{"web-2", "db-1"},
+		},
+		{
+			name:     "Test prefix against list of strings",
+			filter:   map[string]interface{}{"name": "tags", "values": []interface{}{"de"}, "operator": "prefix"},
+			expected: []string{"web-2"},
+		},
+		{
+			name:     "Test operator against nonexistent property",
+			filter:   map[string]interface{}{"name": "shape", "values": []interface{}{"VM"}, "operator": "ne"},
+			expected: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		filters := &schema.Set{F: func(interface{}) int { return 1 }}
+		filters.Add(test.filter)
+
+		names := []string{}
+		for _, item := range ApplyFilters(filters, items, testSchema) {
+			names = append(names, item["display_name"].(string))
+		}
+		assert.Equal(t, test.expected, names)
+
+		collection := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			collection = append(collection, item)
+		}
+		assert.Len(t, ApplyFiltersInCollection(filters, collection, testSchema), len(test.expected))
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitApplyFilters_operatorsCascadeAND(t *testing.T) {
+	items := []map[string]interface{}{
+		{"size_in_gbs": 50, "freeform_tags": map[string]interface{}{"env": "prod"}},
+		{"size_in_gbs": 200, "freeform_tags": map[string]interface{}{"env": "prod"}},
+		{"size_in_gbs": 400, "freeform_tags": map[string]interface{}{"env": "dev"}},
+	}
+	testSchema := map[string]*schema.Schema{
+		"size_in_gbs":   {Type: schema.TypeInt},
+		"freeform_tags": {Type: schema.TypeMap, Elem: schema.TypeString},
+	}
+
+	filters := &schema.Set{F: func(v interface{}) int { return schema.HashString(v.(map[string]interface{})["name"]) }}
+	filters.Add(map[string]interface{}{"name": "size_in_gbs", "values": []interface{}{"100"}, "operator": "gt"})
+	filters.Add(map[string]interface{}{"name": "freeform_tags.env", "values": []interface{}{"prod"}, "operator": "eq"})
+
+	res := ApplyFilters(filters, items, testSchema)
+	assert.Len(t, res, 1)
+	assert.Equal(t, 200, res[0]["size_in_gbs"])
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDataSourceFiltersSchema_operator(t *testing.T) {
+	operatorSchema := DataSourceFiltersSchema().Elem.(*schema.Resource).Schema["operator"]
+	assert.False(t, operatorSchema.Required)
+
+	for _, operator := range append(FilterOperators, "") {
+		_, errs := operatorSchema.ValidateFunc(operator, "operator")
+		assert.Equal(t, operator == "", len(errs) > 0, "operator %q", operator)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetFieldValueType(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"cpus":          {Type: schema.TypeInt},
+		"freeform_tags": {Type: schema.TypeMap},
+		"tags":          {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+		"shape_config": {Type: schema.TypeList, MaxItems: 1, MinItems: 1, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"ocpus": {Type: schema.TypeFloat},
+		}}},
+	}
+	assert.Equal(t, schema.TypeInt, getFieldValueType(testSchema, []string{"cpus"}))
+	assert.Equal(t, schema.TypeString, getFieldValueType(testSchema, []string{"freeform_tags", "env"}))
+	assert.Equal(t, schema.TypeString, getFieldValueType(testSchema, []string{"tags"}))
+	assert.Equal(t, schema.TypeFloat, getFieldValueType(testSchema, []string{"shape_config", "ocpus"}))
+	assert.Equal(t, schema.TypeInvalid, getFieldValueType(testSchema, []string{"shape"}))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateFilters(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"display_name":        {Type: schema.TypeString},
+		"cpus":                {Type: schema.TypeInt},
+		"is_enabled":          {Type: schema.TypeBool},
+		"freeform_tags":       {Type: schema.TypeMap},
+		"ip_addresses":        {Type: schema.TypeList, Elem: &schema.Resource{Schema: map[string]*schema.Schema{"ip": {Type: schema.TypeString}}}},
+		"availability_domain": {Type: schema.TypeString},
+		"source_details": {Type: schema.TypeList, MaxItems: 1, MinItems: 1, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"source_type": {Type: schema.TypeString},
+		}}},
+	}
+
+	type testFormat struct {
+		name          string
+		filter        map[string]interface{}
+		expectedError string
+	}
+	tests := []testFormat{
+		{
+			name:   "Test valid filter",
+			filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"web"}, "regex": false},
+		},
+		{
+			name:   "Test valid nested filter",
+			filter: map[string]interface{}{"name": "source_details.source_type", "values": []interface{}{"image"}, "regex": false},
+		},
+		{
+			name:   "Test valid map filter",
+			filter: map[string]interface{}{"name": "freeform_tags.com.oracle.department", "values": []interface{}{"finance"}, "regex": false},
+		},
+		{
+			name:   "Test attribute of unsupported type",
+			filter: map[string]interface{}{"name": "ip_addresses", "values": []interface{}{"10.0.0.1"}, "regex": false},
+		},
+		{
+			name:          "Test misspelled name",
+			filter:        map[string]interface{}{"name": "display_nam", "values": []interface{}{"web"}, "regex": false},
+			expectedError: `invalid filter name "display_nam": there is no such attribute, did you mean "display_name"?`,
+		},
+		{
+			name:          "Test name suffix",
+			filter:        map[string]interface{}{"name": "domain", "values": []interface{}{"AD-1"}, "regex": false},
+			expectedError: `invalid filter name "domain": there is no such attribute, did you mean "availability_domain"?`,
+		},
+		{
+			name:          "Test misspelled nested name",
+			filter:        map[string]interface{}{"name": "source_details.source_typ", "values": []interface{}{"image"}, "regex": false},
+			expectedError: `invalid filter name "source_details.source_typ": there is no such attribute, did you mean "source_details.source_type"?`,
+		},
+		{
+			name:          "Test unknown name",
+			filter:        map[string]interface{}{"name": "shape", "values": []interface{}{"VM"}, "regex": false},
+			expectedError: `invalid filter name "shape": there is no such attribute`,
+		},
+		{
+			name:          "Test invalid regex",
+			filter:        map[string]interface{}{"name": "display_name", "values": []interface{}{"web-("}, "regex": true},
+			expectedError: "invalid regular expression \"web-(\" in filter \"display_name\": error parsing regexp: missing closing ): `web-(`",
+		},
+		{
+			name:          "Test invalid not_regex",
+			filter:        map[string]interface{}{"name": "display_name", "values": []interface{}{"*"}, "regex": false, "operator": "not_regex"},
+			expectedError: "invalid regular expression \"*\" in filter \"display_name\": error parsing regexp: missing argument to repetition operator: `*`",
+		},
+		{
+			name:   "Test regex flag with comparison operator",
+			filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"web-("}, "regex": true, "operator": "prefix"},
+		},
+		{
+			name:          "Test non numeric value",
+			filter:        map[string]interface{}{"name": "cpus", "values": []interface{}{"2", "many"}, "regex": false, "operator": "ge"},
+			expectedError: `invalid value "many" in filter "cpus": cpus is a number`,
+		},
+		{
+			name:          "Test non boolean value",
+			filter:        map[string]interface{}{"name": "is_enabled", "values": []interface{}{"yes"}, "regex": false},
+			expectedError: `invalid value "yes" in filter "is_enabled": is_enabled is a boolean`,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		filters := &schema.Set{F: func(interface{}) int { return 1 }}
+		filters.Add(test.filter)
+
+		err := ValidateFilters(filters, testSchema)
+		if test.expectedError == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.expectedError)
+			assert.Equal(t, test.expectedError, err.(interface{ WrappedErrors() []error }).WrappedErrors()[0].Error())
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAddFilterValidation(t *testing.T) {
+	itemSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Computed: true},
+	}
+	read := 0
+	dataSource := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			read++
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"filter":         DataSourceFiltersSchema(),
+			"compartment_id": {Type: schema.TypeString, Required: true},
+			"listener_collection": {Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"items": {Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: itemSchema}},
+			}}},
+		},
+	}
+	AddFilterValidation(dataSource)
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaa",
+		"filter":         []interface{}{map[string]interface{}{"name": "nam", "values": []interface{}{"listener"}}},
+	})
+	err := dataSource.Read(d, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `did you mean "name"?`)
+	assert.Equal(t, 0, read, "items should not be listed with invalid filters")
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaa",
+		"filter":         []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"listener"}}},
+	})
+	assert.NoError(t, dataSource.Read(d, nil))
+	assert.Equal(t, 1, read)
+
+	// Filters on unknown attributes are reported by terraform validate
+	diags := dataSource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaa",
+		"filter":         []interface{}{map[string]interface{}{"name": "nam", "values": []interface{}{"listener"}}},
+	}))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, `did you mean "name"?`)
+	diags = dataSource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaa",
+		"filter":         []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"listener"}}},
+	}))
+	assert.False(t, diags.HasError())
+	assert.Nil(t, DataSourceFiltersSchema().Elem.(*schema.Resource).Schema["name"].ValidateFunc)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDataSourceItemSchema(t *testing.T) {
+	itemSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Computed: true},
+	}
+	items := &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: itemSchema}}
+
+	assert.Equal(t, itemSchema, dataSourceItemSchema(&schema.Resource{Schema: map[string]*schema.Schema{
+		"filter":    DataSourceFiltersSchema(),
+		"listeners": items,
+		"state":     {Type: schema.TypeString, Optional: true},
+	}}))
+	assert.Equal(t, itemSchema, dataSourceItemSchema(&schema.Resource{Schema: map[string]*schema.Schema{
+		"listener_collection": {Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"items": items,
+		}}},
+	}}))
+	assert.Nil(t, dataSourceItemSchema(&schema.Resource{Schema: map[string]*schema.Schema{
+		"listeners":         items,
+		"ignored_listeners": items,
+	}}), "the item schema should not be guessed among several lists")
+	assert.Nil(t, dataSourceItemSchema(&schema.Resource{Schema: map[string]*schema.Schema{
+		"names": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}}))
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	MaxResultsAttrName = "max_results"
+	PageSizeAttrName   = "page_size"
+
+	// Pages fetched ahead of the page being converted and filtered
+	readAheadPages = 2
+	// Partitions of a listing listed at the same time
+	maxConcurrentPartitions = 4
+)
+
+// ListPageFunc fetches a page of a list operation. page is nil for the first page and limit is nil to let the service
+// choose the page size. It returns the items of the page and the token of the next page, nil on the last page.
+type ListPageFunc func(ctx context.Context, page *string, limit *int) (items []interface{}, nextPage *string, err error)
+
+// ItemToMapFunc converts an item returned by a ListPageFunc to its representation in the data source
+type ItemToMapFunc func(item interface{}) map[string]interface{}
+
+// DataSourceMaxResultsSchema is the schema of the argument limiting the number of items a list data source returns
+func DataSourceMaxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// DataSourcePageSizeSchema is the schema of the argument setting the number of items requested per page, for list
+// operations that support a limit
+func DataSourcePageSizeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, 1000),
+	}
+}
+
+type listedPage struct {
+	items []interface{}
+	err   error
+}
+
+// ListDataSourceItems lists the items of a list data source page by page and returns the ones matching its filters, up
+// to max_results, along with their representation returned by toMap. Listing stops as soon as max_results items
+// matched, and the next pages are fetched while the current one is filtered. OCI list operations are paginated with
+// opaque tokens, so the pages of a listing can only be read ahead one after the other, see
+// ListDataSourceItemsInPartitions to list independent partitions concurrently.
+//
+// itemSchema is the schema of the items, used to filter their representation returned by toMap. Data sources
+// without a max_results or page_size argument list all items with the page size of the service.
+func ListDataSourceItems(ctx context.Context, d *schema.ResourceData, itemSchema map[string]*schema.Schema, listPage ListPageFunc, toMap ItemToMapFunc) ([]interface{}, []map[string]interface{}, error) {
+	return ListDataSourceItemsInPartitions(ctx, d, itemSchema, []ListPageFunc{listPage}, toMap)
+}
+
+// ListDataSourceItemsInPartitions is ListDataSourceItems for list operations whose items can be split into independent
+// listings, e.g. by time range. Up to maxConcurrentPartitions partitions are listed concurrently, and the items are
+// returned in the order of the partitions.
+func ListDataSourceItemsInPartitions(ctx context.Context, d *schema.ResourceData, itemSchema map[string]*schema.Schema, partitions []ListPageFunc, toMap ItemToMapFunc) ([]interface{}, []map[string]interface{}, error) {
+	maxResults := 0
+	if value, ok := d.GetOkExists(MaxResultsAttrName); ok {
+		maxResults = value.(int)
+	}
+	var pageSize *int
+	if value, ok := d.GetOkExists(PageSizeAttrName); ok {
+		tmp := value.(int)
+		pageSize = &tmp
+	}
+	var matches func(item map[string]interface{}) bool
+	if value, ok := d.GetOkExists("filter"); ok && value.(*schema.Set).Len() > 0 {
+		matches = getFiltersMatcher(value.(*schema.Set), itemSchema)
+	}
+	// Without filters every item is returned, so there is no need to list more than max_results items
+	listLimit := 0
+	if matches == nil {
+		listLimit = maxResults
+	}
+
+	// Cancel the pages read ahead when returning early, and wait for them so that no request outlives the listing
+	var readAhead sync.WaitGroup
+	defer readAhead.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The partitions are started in order, so that the partition being filtered is always listed
+	partitionPages := make([]chan listedPage, len(partitions))
+	for i := range partitionPages {
+		partitionPages[i] = make(chan listedPage, readAheadPages)
+	}
+	readAhead.Add(1)
+	go func() {
+		defer readAhead.Done()
+		listing := make(chan struct{}, maxConcurrentPartitions)
+		for i, listPage := range partitions {
+			select {
+			case listing <- struct{}{}:
+			case <-ctx.Done():
+				for _, pages := range partitionPages[i:] {
+					close(pages)
+				}
+				return
+			}
+			readAhead.Add(1)
+			go func(listPage ListPageFunc, pages chan<- listedPage) {
+				defer readAhead.Done()
+				defer func() { <-listing }()
+				readPagesAhead(ctx, listPage, pageSize, listLimit, pages)
+			}(listPage, partitionPages[i])
+		}
+	}()
+
+	items := []interface{}{}
+	itemMaps := []map[string]interface{}{}
+	for _, pages := range partitionPages {
+		for page := range pages {
+			if page.err != nil {
+				return nil, nil, page.err
+			}
+			for _, item := range page.items {
+				itemMap := toMap(item)
+				if matches != nil && !matches(itemMap) {
+					continue
+				}
+				items = append(items, item)
+				itemMaps = append(itemMaps, itemMap)
+				if maxResults > 0 && len(items) >= maxResults {
+					return items, itemMaps, nil
+				}
+			}
+		}
+	}
+	return items, itemMaps, nil
+}
+
+// readPagesAhead sends the pages of a listing to pages, up to listLimit items if set, and closes it
+func readPagesAhead(ctx context.Context, listPage ListPageFunc, pageSize *int, listLimit int, pages chan<- listedPage) {
+	defer close(pages)
+	var page *string
+	listed := 0
+	for {
+		limit := pageSize
+		if listLimit > 0 && (limit == nil || *limit > listLimit-listed) {
+			remaining := listLimit - listed
+			limit = &remaining
+		}
+		items, nextPage, err := listPage(ctx, page, limit)
+		select {
+		case pages <- listedPage{items: items, err: err}:
+		case <-ctx.Done():
+			return
+		}
+		listed += len(items)
+		if err != nil || nextPage == nil || (listLimit > 0 && listed >= listLimit) {
+			return
+		}
+		page = nextPage
+	}
+}
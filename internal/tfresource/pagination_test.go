@@ -0,0 +1,247 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type listPageMock struct {
+	pages int
+	items int
+
+	mutex  sync.Mutex
+	limits []*int
+	err    error
+}
+
+// listPage returns pages of up to mock.items items, or limit items if set, named item-<n> with increasing <n>
+func (m *listPageMock) listPage(ctx context.Context, page *string, limit *int) ([]interface{}, *string, error) {
+	m.mutex.Lock()
+	m.limits = append(m.limits, limit)
+	pageNumber := len(m.limits) - 1
+	m.mutex.Unlock()
+
+	if m.err != nil && pageNumber == 1 {
+		return nil, nil, m.err
+	}
+	if page != nil && *page != strconv.Itoa(pageNumber) {
+		return nil, nil, fmt.Errorf("unexpected page %s", *page)
+	}
+	count := m.items
+	if limit != nil {
+		count = *limit
+	}
+	items := []interface{}{}
+	for i := 0; i < count; i++ {
+		items = append(items, fmt.Sprintf("item-%d", pageNumber*count+i))
+	}
+	if pageNumber == m.pages-1 {
+		return items, nil, nil
+	}
+	nextPage := strconv.Itoa(pageNumber + 1)
+	return items, &nextPage, nil
+}
+
+func (m *listPageMock) listedPages() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.limits)
+}
+
+func listItemToMap(item interface{}) map[string]interface{} {
+	return map[string]interface{}{"name": item}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitListDataSourceItems(t *testing.T) {
+	itemSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Computed: true},
+	}
+	dataSourceSchema := map[string]*schema.Schema{
+		"filter":           DataSourceFiltersSchema(),
+		MaxResultsAttrName: DataSourceMaxResultsSchema(),
+		PageSizeAttrName:   DataSourcePageSizeSchema(),
+		"items":            {Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: itemSchema}},
+	}
+	intPtr := func(i int) *int { return &i }
+
+	type testFormat struct {
+		name           string
+		config         map[string]interface{}
+		expectedItems  int
+		expectedFirst  string
+		expectedLimits []*int
+	}
+	tests := []testFormat{
+		{
+			name:           "Test all pages are listed by default",
+			config:         map[string]interface{}{},
+			expectedItems:  50,
+			expectedFirst:  "item-0",
+			expectedLimits: []*int{nil, nil, nil, nil, nil},
+		},
+		{
+			name:           "Test page size",
+			config:         map[string]interface{}{PageSizeAttrName: 20},
+			expectedItems:  100,
+			expectedFirst:  "item-0",
+			expectedLimits: []*int{intPtr(20), intPtr(20), intPtr(20), intPtr(20), intPtr(20)},
+		},
+		{
+			name:           "Test max results without filters limits the pages",
+			config:         map[string]interface{}{MaxResultsAttrName: 15},
+			expectedItems:  15,
+			expectedFirst:  "item-0",
+			expectedLimits: []*int{intPtr(15)},
+		},
+		{
+			name:           "Test max results with smaller page size",
+			config:         map[string]interface{}{MaxResultsAttrName: 15, PageSizeAttrName: 10},
+			expectedItems:  15,
+			expectedFirst:  "item-0",
+			expectedLimits: []*int{intPtr(10), intPtr(5)},
+		},
+		{
+			name: "Test filters without max results",
+			config: map[string]interface{}{"filter": []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"item-4."}, "regex": true},
+			}},
+			expectedItems:  10,
+			expectedFirst:  "item-40",
+			expectedLimits: []*int{nil, nil, nil, nil, nil},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		d := schema.TestResourceDataRaw(t, dataSourceSchema, test.config)
+		mock := &listPageMock{pages: 5, items: 10}
+
+		conversions := 0
+		toMap := func(item interface{}) map[string]interface{} {
+			conversions++
+			return listItemToMap(item)
+		}
+		items, itemMaps, err := ListDataSourceItems(context.Background(), d, itemSchema, mock.listPage, toMap)
+		assert.NoError(t, err)
+		assert.Len(t, items, test.expectedItems)
+		assert.Equal(t, test.expectedFirst, items[0])
+		assert.Equal(t, test.expectedLimits, mock.limits)
+		assert.Len(t, itemMaps, test.expectedItems)
+		assert.Equal(t, map[string]interface{}{"name": test.expectedFirst}, itemMaps[0])
+		listed := 0
+		for _, limit := range mock.limits {
+			if limit == nil {
+				listed += mock.items
+			} else {
+				listed += *limit
+			}
+		}
+		assert.Equal(t, listed, conversions, "items should be converted once")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitListDataSourceItems_stopsEarlyWithFilters(t *testing.T) {
+	itemSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Computed: true},
+	}
+	dataSourceSchema := map[string]*schema.Schema{
+		"filter":           DataSourceFiltersSchema(),
+		MaxResultsAttrName: DataSourceMaxResultsSchema(),
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceSchema, map[string]interface{}{
+		MaxResultsAttrName: 3,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"[05]$"}, "regex": true},
+		},
+	})
+	mock := &listPageMock{pages: 1000, items: 10}
+
+	items, _, err := ListDataSourceItems(context.Background(), d, itemSchema, mock.listPage, listItemToMap)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"item-0", "item-5", "item-10"}, items)
+	assert.LessOrEqual(t, mock.listedPages(), 2+readAheadPages+1, "listing should stop once max_results items matched")
+	assert.Nil(t, mock.limits[0], "page size should not be limited when filtering")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitListDataSourceItems_error(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"filter": DataSourceFiltersSchema()}, map[string]interface{}{})
+	mock := &listPageMock{pages: 5, items: 10, err: errors.New("500 Internal Server Error")}
+
+	items, itemMaps, err := ListDataSourceItems(context.Background(), d, nil, mock.listPage, listItemToMap)
+	assert.EqualError(t, err, "500 Internal Server Error")
+	assert.Nil(t, items)
+	assert.Nil(t, itemMaps)
+	assert.Equal(t, 2, mock.listedPages())
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitListDataSourceItemsInPartitions(t *testing.T) {
+	dataSourceSchema := map[string]*schema.Schema{
+		MaxResultsAttrName: DataSourceMaxResultsSchema(),
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceSchema, map[string]interface{}{})
+
+	// Every partition blocks on its first page until maxConcurrentPartitions partitions are listing at the same time
+	var listing, maxListing int32
+	concurrent := make(chan struct{})
+	var concurrentOnce sync.Once
+	partitions := []ListPageFunc{}
+	for i := 0; i < maxConcurrentPartitions+2; i++ {
+		mock := &listPageMock{pages: 2, items: 3}
+		partition := i
+		partitions = append(partitions, func(ctx context.Context, page *string, limit *int) ([]interface{}, *string, error) {
+			current := atomic.AddInt32(&listing, 1)
+			defer atomic.AddInt32(&listing, -1)
+			for previous := atomic.LoadInt32(&maxListing); current > previous && !atomic.CompareAndSwapInt32(&maxListing, previous, current); previous = atomic.LoadInt32(&maxListing) {
+			}
+			if current == maxConcurrentPartitions {
+				concurrentOnce.Do(func() { close(concurrent) })
+			}
+			if page == nil && partition < maxConcurrentPartitions {
+				select {
+				case <-concurrent:
+				case <-time.After(5 * time.Second):
+				}
+			}
+			items, nextPage, err := mock.listPage(ctx, page, limit)
+			for j := range items {
+				items[j] = fmt.Sprintf("partition-%d-%s", partition, items[j])
+			}
+			return items, nextPage, err
+		})
+	}
+
+	items, itemMaps, err := ListDataSourceItemsInPartitions(context.Background(), d, nil, partitions, listItemToMap)
+	assert.NoError(t, err)
+	assert.Len(t, items, (maxConcurrentPartitions+2)*6)
+	assert.Len(t, itemMaps, len(items))
+	assert.Equal(t, int32(maxConcurrentPartitions), atomic.LoadInt32(&maxListing), "partitions should be listed concurrently")
+	for i, item := range items {
+		assert.Equal(t, fmt.Sprintf("partition-%d-item-%d", i/6, i%6), item, "items should be in the order of the partitions")
+	}
+
+	// The items of the first partitions are returned up to max_results
+	d = schema.TestResourceDataRaw(t, dataSourceSchema, map[string]interface{}{MaxResultsAttrName: 4})
+	partitions = []ListPageFunc{}
+	for i := 0; i < maxConcurrentPartitions+2; i++ {
+		mock := &listPageMock{pages: 2, items: 3}
+		partitions = append(partitions, mock.listPage)
+	}
+	items, _, err = ListDataSourceItemsInPartitions(context.Background(), d, nil, partitions, listItemToMap)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"item-0", "item-1", "item-2", "item-3"}, items)
+}
@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -11,13 +12,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	oci_common "github.com/oracle/oci-go-sdk/v61/common"
-
-	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
 
 const (
@@ -27,7 +27,6 @@ const (
 	identityService                 = "identity"
 	coreService                     = "core"
 	WaasService                     = "waas"
-	kmsService                      = "kms"
 	objectstorageService            = "object_storage"
 	logAnalyticsService             = "log_analytics"
 	updateResource                  = "update"
@@ -35,11 +34,26 @@ const (
 	getResource                     = "get"
 	waasDeleteConflictRetryDuration = 60 * time.Minute
 	certificateService              = "certificate"
+	AllServicesRetryConfig          = "*"
 )
 
+// Backoff strategies of retry policies. The wait before a retry is jittered between minRetryBackoff and a cap that
+// grows with the attempt number, up to the cap of the quadratic backoff.
+const (
+	QuadraticRetryBackoff   = "quadratic"
+	ExponentialRetryBackoff = "exponential"
+	LinearRetryBackoff      = "linear"
+	ConstantRetryBackoff    = "constant"
+)
+
+var RetryBackoffStrategies = []string{QuadraticRetryBackoff, ExponentialRetryBackoff, LinearRetryBackoff, ConstantRetryBackoff}
+
+// RetryConfig is the retry policy of a service set in the provider configuration, applied on top of the defaults of
+// the service. The policies of a provider are carried by the requests of its clients, see client.ResponseRetryConfigs.
+type RetryConfig = client.RetryConfig
+
 type ServiceExpectedRetryDurationFunc func(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, optionals ...interface{}) time.Duration
 type expectedRetryDurationFn func(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration
-type getRetryPolicyFunc func(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy
 
 var serviceExpectedRetryDurationMap = map[string]ServiceExpectedRetryDurationFunc{
 	coreService:          getCoreExpectedRetryDuration,
@@ -49,13 +63,11 @@ var serviceExpectedRetryDurationMap = map[string]ServiceExpectedRetryDurationFun
 	WaasService:          getWaasExpectedRetryDuration,
 	logAnalyticsService:  getLogAnalyticsExpectedRetryDuration,
 }
-var serviceRetryPolicyFnMap = map[string]getRetryPolicyFunc{
-	kmsService: kmsGetRetryPolicy,
-}
 
 var ShortRetryTime = 2 * time.Minute
 var LongRetryTime = 10 * time.Minute
 var ConfiguredRetryDuration *time.Duration
+
 var isServiceErrorVar = oci_common.IsServiceError
 var isErrorAffectedByEventualConsistency = oci_common.IsErrorAffectedByEventualConsistency
 
@@ -64,44 +76,51 @@ func init() {
 }
 
 func GetRetryBackoffDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, optionals ...interface{}) time.Duration {
-	return getRetryBackoffDurationWithExpectedRetryDurationFn(response, disableNotFoundRetries, service, startTime, getExpectedRetryDuration, optionals...)
+	// The previous waits are unknown, so the wait is jittered up to the cap of the attempt
+	return newRetryBackoff(service, startTime, time.Time{}).next(response, disableNotFoundRetries, optionals...)
 }
 
-func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, expectedRetryDurationFn expectedRetryDurationFn, optionals ...interface{}) time.Duration {
-	if httpreplay.ShouldRetryImmediately() {
-		return 0
-	}
-
+// getRetryBackoffCap returns the longest wait before retrying the attempt with the backoff strategy of the retry config
+func getRetryBackoffCap(attempt uint, config *RetryConfig) time.Duration {
 	// Avoid having a very large retry backoff
-	attempt := response.AttemptNumber
+	maxBackoff := time.Duration(2*quadraticBackoffCap*quadraticBackoffCap) * time.Second
 	if attempt > quadraticBackoffCap {
 		attempt = quadraticBackoffCap
 	}
-	retryBackoffRange := time.Duration(2*attempt*attempt)*time.Second - minRetryBackoff
 
-	// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
-	backoffDuration := time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
-
-	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
-	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
-	expectedRetryDuration := expectedRetryDurationFn(response, disableNotFoundRetries, service, optionals...)
-	timeWaited := GetElapsedRetryDuration(startTime)
-	if timeWaited < expectedRetryDuration && timeWaited+backoffDuration > expectedRetryDuration {
-		extraJitterRange := int64(float64(expectedRetryDuration) * 0.05)
-		finalBackoffDuration := expectedRetryDuration - timeWaited + time.Duration(rand.Int63n(extraJitterRange+1)) + minRetryBackoff
-		if finalBackoffDuration < backoffDuration {
-			backoffDuration = finalBackoffDuration
+	backoff := time.Duration(2*attempt*attempt) * time.Second
+	if config != nil {
+		switch config.Backoff {
+		case ExponentialRetryBackoff:
+			backoff = time.Duration(1<<attempt) * time.Second
+		case LinearRetryBackoff:
+			backoff = time.Duration(2*attempt) * time.Second
+		case ConstantRetryBackoff:
+			backoff = 2 * minRetryBackoff
 		}
 	}
-	utils.Logf("Time elapsed for retry: %v;  Expected retry duration: %v \n", timeWaited.Round(time.Second), expectedRetryDuration.Round(time.Second))
-	return backoffDuration
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	if backoff < minRetryBackoff {
+		return minRetryBackoff
+	}
+	return backoff
 }
 
 func GetElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
-	return time.Now().Sub(firstAttemptTime)
+	return retryClock.Now().Sub(firstAttemptTime)
 }
 
 func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	expectedRetryDuration := getServiceExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+	if config := getServiceRetryConfig(response, service); config != nil {
+		return retryConfigExpectedDuration(config, response, expectedRetryDuration)
+	}
+	return expectedRetryDuration
+}
+
+func getServiceExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
 	// Get the override retry duration function if it exists. This gives the most granular control over what value to return, and is passed
 	// into GetRetryPolicy function as an optional argument to override retry durations on a per API basis.
 	if len(optionals) > 0 {
@@ -119,6 +138,62 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 	return GetDefaultExpectedRetryDuration(response, disableNotFoundRetries)
 }
 
+// getServiceRetryConfig returns the retry policy configured for the service by the provider that made the attempt of
+// the response, nil if there is none. The policy of AllServicesRetryConfig applies to the services without a policy
+// of their own.
+func getServiceRetryConfig(response oci_common.OCIOperationResponse, service string) *RetryConfig {
+	retryConfigs := client.ResponseRetryConfigs(response)
+	if config, ok := retryConfigs[service]; ok {
+		return config
+	}
+	return retryConfigs[AllServicesRetryConfig]
+}
+
+// retryConfigExpectedDuration returns the duration for which to retry the response according to the retry config,
+// given the duration of the service defaults. The retryable codes of the config are retried in addition to the errors
+// retried by the service defaults.
+func retryConfigExpectedDuration(c *RetryConfig, response oci_common.OCIOperationResponse, defaultDuration time.Duration) time.Duration {
+	if response.Error == nil {
+		return defaultDuration
+	}
+	if defaultDuration == 0 && isRetryableByConfig(c, response) {
+		defaultDuration = ShortRetryTime
+	}
+	if defaultDuration > 0 && c.MaxDuration != nil {
+		return *c.MaxDuration
+	}
+	return defaultDuration
+}
+
+// isRetryableByConfig returns true if the error of the response is retryable according to the retry config
+func isRetryableByConfig(c *RetryConfig, response oci_common.OCIOperationResponse) bool {
+	statusCode := 0
+	if serviceError, ok := oci_common.IsServiceError(response.Error); ok {
+		statusCode = serviceError.GetHTTPStatusCode()
+	} else if response.Response != nil && response.Response.HTTPResponse() != nil {
+		statusCode = response.Response.HTTPResponse().StatusCode
+	}
+	for _, retryableStatusCode := range c.RetryableStatusCodes {
+		if statusCode == retryableStatusCode {
+			return true
+		}
+	}
+	for _, retryableServiceCode := range c.RetryableServiceCodes {
+		if serviceError, ok := oci_common.IsServiceError(response.Error); ok && serviceError.GetCode() == retryableServiceCode {
+			return true
+		}
+	}
+	return false
+}
+
+// getMaxRetryAttempts returns the maximum number of attempts of the requests to the service, 0 for no limit
+func getMaxRetryAttempts(response oci_common.OCIOperationResponse, service string) uint {
+	if config := getServiceRetryConfig(response, service); config != nil {
+		return config.MaxAttempts
+	}
+	return 0
+}
+
 func GetDefaultExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool) time.Duration {
 	defaultRetryTime := ShortRetryTime
 
@@ -178,7 +253,7 @@ func GetDefaultExpectedRetryDuration(response oci_common.OCIOperationResponse, d
 
 func isRetriableByEc(r oci_common.OCIOperationResponse) (bool, *time.Duration) {
 	if _, ok := isServiceErrorVar(r.Error); ok {
-		now := time.Now()
+		now := retryClock.Now()
 		if r.EndOfWindowTime == nil || r.EndOfWindowTime.Before(now) {
 			// either no eventually consistent effects, or they have disappeared by now
 			utils.Debugln(fmt.Sprintf("EC.ShouldRetryOperation, no EC or in the past, returning false: endOfWindowTime = %v, now = %v", r.EndOfWindowTime, now))
@@ -203,7 +278,7 @@ func getRemainingEventualConsistencyDuration(r oci_common.OCIOperationResponse)
 		// there was an eventually consistent request
 		if endOfWindow.After(r.InitialAttemptTime) {
 			// and the eventually consistent effects may still be present
-			remaining := endOfWindow.Sub(time.Now())
+			remaining := endOfWindow.Sub(retryClock.Now())
 			if remaining > 0 {
 				return &remaining
 			}
@@ -337,27 +412,47 @@ func getLogAnalyticsExpectedRetryDuration(response oci_common.OCIOperationRespon
 
 func ShouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, optionals ...interface {
 }) bool {
+	// Attempts are limited here rather than in the SDK retry policy, which polling functions reuse with their own
+	// retry condition
+	if maxAttempts := getMaxRetryAttempts(response, service); maxAttempts > 0 && response.AttemptNumber >= maxAttempts {
+		return false
+	}
 	return GetElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
 }
 
 // Because this function notes the start time for making should retry decisions, it's advised
 // for this function call to be made immediately before the client API call.
 func GetRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
-	if serviceRetryPolicyFn, ok := serviceRetryPolicyFnMap[service]; ok {
-		return serviceRetryPolicyFn(disableNotFoundRetries, service, optionals...)
-	}
-	return getDefaultRetryPolicy(disableNotFoundRetries, service, optionals...)
+	return getDefaultRetryPolicy(time.Time{}, disableNotFoundRetries, service, optionals...)
+}
+
+// GetRetryPolicyWithDeadline is GetRetryPolicy for requests of a Terraform operation that must end by the deadline:
+// the waits between attempts are shortened so that the attempts happen before the deadline, and there are no retries
+// past it.
+func GetRetryPolicyWithDeadline(deadline time.Time, disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
+	return getDefaultRetryPolicy(deadline, disableNotFoundRetries, service, optionals...)
+}
+
+// GetRetryPolicyContext is GetRetryPolicy for requests made with the context of a Terraform operation: the requests
+// end by the deadline of the operation set by the CRUD helpers, see GetRetryPolicyWithDeadline.
+func GetRetryPolicyContext(ctx context.Context, disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
+	deadline, _ := contextDeadline(ctx)
+	return getDefaultRetryPolicy(deadline, disableNotFoundRetries, service, optionals...)
 }
 
-func getDefaultRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
-	startTime := time.Now()
+func getDefaultRetryPolicy(deadline time.Time, disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
+	startTime := retryClock.Now()
+	backoff := newRetryBackoff(service, startTime, deadline)
 	retryPolicy := &oci_common.RetryPolicy{
 		MaximumNumberAttempts: 0,
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
+			if !deadline.IsZero() && !retryClock.Now().Before(deadline) {
+				return false
+			}
 			return ShouldRetry(response, disableNotFoundRetries, service, startTime, optionals...)
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return GetRetryBackoffDuration(response, disableNotFoundRetries, service, startTime, optionals...)
+			return backoff.next(response, disableNotFoundRetries, optionals...)
 		},
 	}
 
@@ -407,40 +502,6 @@ func getCoreExpectedRetryDuration(response oci_common.OCIOperationResponse, disa
 	return GetDefaultExpectedRetryDuration(response, disableNotFoundRetries)
 }
 
-func kmsGetRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
-	startTime := time.Now()
-	retryPolicy := &oci_common.RetryPolicy{
-		MaximumNumberAttempts: 0,
-		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			return ShouldRetry(response, disableNotFoundRetries, service, startTime, optionals...)
-		},
-		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return getKmsNextRetryDuration(response, disableNotFoundRetries, startTime, optionals...)
-		},
-	}
-	return retryPolicy
-}
-
-func getKmsNextRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, startTime time.Time, optionals ...interface{}) time.Duration {
-	if httpreplay.ShouldRetryImmediately() {
-		return 0
-	}
-	defaultRetryTime := GetRetryBackoffDuration(response, disableNotFoundRetries, "kms", startTime, optionals...)
-	if response.Response == nil || response.Response.HTTPResponse() == nil {
-		return defaultRetryTime
-	}
-	switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
-	case 429:
-		rawResponse := response.Response.HTTPResponse()
-		if retryAfterVal := rawResponse.Header["retry-after"]; len(retryAfterVal) > 0 {
-			if i, err := strconv.Atoi(retryAfterVal[0]); err == nil {
-				return time.Duration(i) * time.Second
-			}
-		}
-	}
-	return defaultRetryTime
-}
-
 func getWaasExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, optionals ...interface{}) time.Duration {
 	if len(optionals) > 0 {
 		if key, ok := optionals[0].(string); ok {
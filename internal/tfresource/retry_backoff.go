@@ -0,0 +1,206 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v61/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	retryAfterHeader = "Retry-After"
+	// Growth factor of decorrelated jitter: a wait is at most three times the previous one
+	decorrelatedJitterFactor = 3
+)
+
+type clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// retryClock is the clock of retry decisions and backoffs, replaced by tests to make them deterministic
+var retryClock clock = systemClock{}
+
+// retryJitter returns a random duration between 0 and max included, replaced by tests to make them deterministic
+var retryJitter = func(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// retryBackoff computes the waits between the attempts of a request. It honors the Retry-After header of the
+// responses, otherwise it uses decorrelated jitter: each wait is random between minRetryBackoff and three times the
+// previous wait, within the cap of the backoff strategy of the service for the attempt. Waits never go past the end
+// of the eventual consistency window of errors caused by it, nor past the deadline of the Terraform operation while
+// it is ahead.
+type retryBackoff struct {
+	service   string
+	startTime time.Time
+	// Zero if the requests are not bound by an operation timeout
+	deadline time.Time
+	// Previous wait, zero before the first retry or if the previous waits are unknown
+	previous time.Duration
+}
+
+func newRetryBackoff(service string, startTime time.Time, deadline time.Time) *retryBackoff {
+	return &retryBackoff{
+		service:   service,
+		startTime: startTime,
+		deadline:  deadline,
+	}
+}
+
+func (b *retryBackoff) next(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, optionals ...interface{}) time.Duration {
+	if httpreplay.ShouldRetryImmediately() {
+		return 0
+	}
+
+	now := retryClock.Now()
+	backoffDuration, ok := getRetryAfter(response, now)
+	if ok {
+		utils.Logf("Retrying after %v as requested by the service\n", backoffDuration.Round(time.Second))
+		// Without a deadline, the service cannot hold the request for longer than its retries are expected to last
+		if b.deadline.IsZero() {
+			expectedRetryDuration := getExpectedRetryDuration(response, disableNotFoundRetries, b.service, optionals...)
+			if remaining := expectedRetryDuration - now.Sub(b.startTime); backoffDuration > remaining {
+				backoffDuration = remaining
+				if backoffDuration < minRetryBackoff {
+					backoffDuration = minRetryBackoff
+				}
+			}
+		}
+	} else {
+		backoffDuration = b.jitteredBackoff(response, disableNotFoundRetries, now, optionals...)
+
+		// There is no point in waiting past the end of the eventual consistency window for errors caused by it
+		if retriable, remaining := isRetriableByEc(response); retriable && remaining != nil && *remaining < backoffDuration {
+			backoffDuration = *remaining
+			if backoffDuration < minRetryBackoff {
+				backoffDuration = minRetryBackoff
+			}
+		}
+	}
+
+	// Leave time for a last attempt before the deadline when possible, otherwise retry at the deadline. Past the
+	// deadline, the retry condition stops the retries.
+	if remaining := b.deadline.Sub(now); !b.deadline.IsZero() && remaining > 0 {
+		if remaining > minRetryBackoff {
+			remaining -= minRetryBackoff
+		}
+		if backoffDuration > remaining {
+			backoffDuration = remaining
+		}
+	}
+
+	b.previous = backoffDuration
+	return backoffDuration
+}
+
+func (b *retryBackoff) jitteredBackoff(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, now time.Time, optionals ...interface{}) time.Duration {
+	maxBackoff := getRetryBackoffCap(response.AttemptNumber, getServiceRetryConfig(response, b.service))
+	if b.previous > 0 && decorrelatedJitterFactor*b.previous < maxBackoff {
+		maxBackoff = decorrelatedJitterFactor * b.previous
+	}
+	if maxBackoff < minRetryBackoff {
+		maxBackoff = minRetryBackoff
+	}
+
+	// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and maximum backoff time to avoid clustering.
+	backoffDuration := retryJitter(maxBackoff-minRetryBackoff) + minRetryBackoff
+
+	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
+	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
+	expectedRetryDuration := getExpectedRetryDuration(response, disableNotFoundRetries, b.service, optionals...)
+	timeWaited := now.Sub(b.startTime)
+	if timeWaited < expectedRetryDuration && timeWaited+backoffDuration > expectedRetryDuration {
+		extraJitterRange := time.Duration(float64(expectedRetryDuration) * 0.05)
+		finalBackoffDuration := expectedRetryDuration - timeWaited + retryJitter(extraJitterRange) + minRetryBackoff
+		if finalBackoffDuration < backoffDuration {
+			backoffDuration = finalBackoffDuration
+		}
+	}
+	utils.Logf("Time elapsed for retry: %v;  Expected retry duration: %v \n", timeWaited.Round(time.Second), expectedRetryDuration.Round(time.Second))
+	return backoffDuration
+}
+
+// getRetryAfter returns the wait requested by the Retry-After header of the response, in seconds or as an HTTP date
+func getRetryAfter(response oci_common.OCIOperationResponse, now time.Time) (time.Duration, bool) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return 0, false
+	}
+	header := response.Response.HTTPResponse().Header
+	value := header.Get(retryAfterHeader)
+	if value == "" {
+		// Headers set without canonicalizing their key
+		if values := header[strings.ToLower(retryAfterHeader)]; len(values) > 0 {
+			value = values[0]
+		}
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+type operationDeadlineKey struct{}
+
+// OperationDeadline returns the time by which a Terraform operation started now with the timeout must end, or the
+// deadline of the context if it is earlier
+func OperationDeadline(ctx context.Context, timeout time.Duration) time.Time {
+	deadline := retryClock.Now().Add(timeout)
+	if ctxDeadline, ok := contextDeadline(ctx); ok && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+	return deadline
+}
+
+// WithOperationDeadline returns a context carrying the deadline of the Terraform operation started now with the
+// timeout, for the retry policies and the state polling of the requests made with it. Unlike a context deadline, it
+// does not cancel the requests in flight.
+func WithOperationDeadline(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, operationDeadlineKey{}, OperationDeadline(ctx, timeout))
+}
+
+// contextDeadline returns the earliest of the operation deadline and the deadline of the context
+func contextDeadline(ctx context.Context) (time.Time, bool) {
+	deadline, ok := ctx.Value(operationDeadlineKey{}).(time.Time)
+	if ctxDeadline, hasDeadline := ctx.Deadline(); hasDeadline && (!ok || ctxDeadline.Before(deadline)) {
+		return ctxDeadline, true
+	}
+	return deadline, ok
+}
+
+// remainingOperationTimeout returns the time left before the end of the operation of the context, within the timeout
+func remainingOperationTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	return OperationDeadline(ctx, timeout).Sub(retryClock.Now())
+}
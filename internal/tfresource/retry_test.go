@@ -4,13 +4,17 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
 	"github.com/stretchr/testify/assert"
@@ -18,11 +22,13 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 
 	"github.com/oracle/oci-go-sdk/v61/common"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v61/workrequests"
 )
 
 type TestOCIResponse struct {
-	statusCode int
-	header     map[string][]string
+	statusCode   int
+	header       map[string][]string
+	retryConfigs map[string]*RetryConfig
 }
 
 type retryTestInput struct {
@@ -85,12 +91,47 @@ type TestServiceError interface {
 
 func (response TestOCIResponse) HTTPResponse() *http.Response {
 	result := http.Response{}
-	result.Request = &http.Request{}
+	result.Request = (&http.Request{}).WithContext(client.WithRetryConfigs(context.Background(), response.retryConfigs))
 	result.StatusCode = response.statusCode
 	result.Header = http.Header(response.header)
 	return &result
 }
 
+// withRetryConfigs returns the response of an attempt made by a provider with the retry policies
+func withRetryConfigs(response common.OCIOperationResponse, retryConfigs map[string]*RetryConfig) common.OCIOperationResponse {
+	testResponse, _ := response.Response.(TestOCIResponse)
+	testResponse.retryConfigs = retryConfigs
+	response.Response = testResponse
+	return response
+}
+
+type testRequestDispatcher func(*http.Request) (*http.Response, error)
+
+func (f testRequestDispatcher) Do(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+type testRequestSigner struct{}
+
+func (testRequestSigner) Sign(*http.Request) error {
+	return nil
+}
+
+// testServiceError returns the error of the SDK for a response of the service with the status code and error code
+func testServiceError(statusCode int, code string) error {
+	client := common.BaseClient{
+		Signer:    testRequestSigner{},
+		UserAgent: "test",
+		HTTPClient: testRequestDispatcher(func(request *http.Request) (*http.Response, error) {
+			body := fmt.Sprintf(`{"code":%q,"message":"%s error"}`, code, code)
+			return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body)), Request: request}, nil
+		}),
+	}
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	_, err := client.Call(context.Background(), request)
+	return err
+}
+
 func retryLoop(t *testing.T, r *retryTestInput) {
 	retryPolicy := GetRetryPolicy(r.disableNotFoundRetries, r.serviceName, r.optionals...)
 	startTime := time.Now()
@@ -841,3 +882,481 @@ func TestUnitDefaultExpectedRetryDuration(t *testing.T) {
 
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryConfig_expectedRetryDuration(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Second
+	LongRetryTime = 2 * time.Second
+	ConfiguredRetryDuration = nil
+
+	maxDuration := 5 * time.Second
+	type testFormat struct {
+		name     string
+		config   *RetryConfig
+		service  string
+		response common.OCIOperationResponse
+		output   time.Duration
+	}
+	tests := []testFormat{
+		{
+			name:     "Test service defaults without config",
+			service:  "database",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, fmt.Errorf("IncorrectState"), 1),
+			output:   2 * time.Second,
+		},
+		{
+			name:     "Test max duration of retryable error",
+			config:   &RetryConfig{MaxDuration: &maxDuration},
+			service:  "database",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, fmt.Errorf("IncorrectState"), 1),
+			output:   5 * time.Second,
+		},
+		{
+			name:     "Test max duration does not retry non retryable error",
+			config:   &RetryConfig{MaxDuration: &maxDuration},
+			service:  "database",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("InvalidParameter"), 1),
+			output:   0,
+		},
+		{
+			name:     "Test max duration of successful response",
+			config:   &RetryConfig{MaxDuration: &maxDuration},
+			service:  "database",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 200}, nil, 1),
+			output:   0,
+		},
+		{
+			name:     "Test retryable status code not retried by default",
+			config:   &RetryConfig{RetryableStatusCodes: []int{412}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 412}, fmt.Errorf("NoEtagMatch"), 1),
+			output:   1 * time.Second,
+		},
+		{
+			name:     "Test status code not in retryable status codes",
+			config:   &RetryConfig{RetryableStatusCodes: []int{412}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("InvalidParameter"), 1),
+			output:   0,
+		},
+		{
+			name:     "Test retryable status codes keep the retries of the service defaults",
+			config:   &RetryConfig{RetryableStatusCodes: []int{412}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500}, fmt.Errorf("InternalServerError"), 1),
+			output:   1 * time.Second,
+		},
+		{
+			name:     "Test retryable service code",
+			config:   &RetryConfig{RetryableServiceCodes: []string{"IncorrectState"}, MaxDuration: &maxDuration},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, testServiceError(409, "IncorrectState"), 1),
+			output:   5 * time.Second,
+		},
+		{
+			name:     "Test service code not in retryable service codes",
+			config:   &RetryConfig{RetryableServiceCodes: []string{"IncorrectState"}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, testServiceError(400, "InvalidParameter"), 1),
+			output:   0,
+		},
+		{
+			name:     "Test retryable service codes keep the retries of the service defaults",
+			config:   &RetryConfig{RetryableServiceCodes: []string{"IncorrectState"}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429}, testServiceError(429, "TooManyRequests"), 1),
+			output:   2 * time.Second,
+		},
+		{
+			name:     "Test service code only in the message of the error",
+			config:   &RetryConfig{RetryableServiceCodes: []string{"IncorrectState"}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("400-IncorrectState"), 1),
+			output:   0,
+		},
+		{
+			name:     "Test network errors are retried with retryable codes",
+			config:   &RetryConfig{RetryableStatusCodes: []int{429}},
+			service:  "core",
+			response: common.NewOCIOperationResponse(TestOCIResponse{}, &net.DNSError{Err: "Timeout", IsTimeout: true}, 1),
+			output:   1 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		response := test.response
+		if test.config != nil {
+			response = withRetryConfigs(test.response, map[string]*RetryConfig{test.service: test.config})
+		}
+		assert.Equal(t, test.output, getExpectedRetryDuration(response, false, test.service))
+
+		if test.config != nil {
+			// The policy for all services applies to services without a policy of their own
+			retryConfigs := map[string]*RetryConfig{AllServicesRetryConfig: test.config, "identity": {}}
+			response = withRetryConfigs(test.response, retryConfigs)
+			assert.Equal(t, test.output, getExpectedRetryDuration(response, false, test.service))
+			assert.Equal(t, test.config, getServiceRetryConfig(response, test.service))
+
+			// The policies of a provider do not apply to the attempts of other providers
+			assert.Nil(t, getServiceRetryConfig(test.response, test.service))
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryConfig_maxAttempts(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Minute
+	LongRetryTime = 2 * time.Minute
+	ConfiguredRetryDuration = nil
+	retryConfigs := map[string]*RetryConfig{"core": {MaxAttempts: 3}}
+
+	for _, service := range []string{"core", "kms"} {
+		retryPolicy := GetRetryPolicy(false, service)
+		assert.Equal(t, uint(0), retryPolicy.MaximumNumberAttempts, "attempts should not be limited for polling functions reusing the policy")
+		for attempt := uint(1); attempt <= 5; attempt++ {
+			response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, retryConfigs: retryConfigs}, fmt.Errorf("InternalServerError"), attempt)
+			assert.Equal(t, service != "core" || attempt < 3, retryPolicy.ShouldRetryOperation(response), "service %s, attempt %d", service, attempt)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRetryBackoffCap(t *testing.T) {
+	type testFormat struct {
+		backoff  string
+		attempts []uint
+		output   []time.Duration
+	}
+	tests := []testFormat{
+		{backoff: "", attempts: []uint{1, 2, 12, 20}, output: []time.Duration{2 * time.Second, 8 * time.Second, 288 * time.Second, 288 * time.Second}},
+		{backoff: QuadraticRetryBackoff, attempts: []uint{1, 3}, output: []time.Duration{2 * time.Second, 18 * time.Second}},
+		{backoff: ExponentialRetryBackoff, attempts: []uint{1, 4, 8, 9, 30}, output: []time.Duration{2 * time.Second, 16 * time.Second, 256 * time.Second, 288 * time.Second, 288 * time.Second}},
+		{backoff: LinearRetryBackoff, attempts: []uint{1, 5, 200}, output: []time.Duration{2 * time.Second, 10 * time.Second, 24 * time.Second}},
+		{backoff: ConstantRetryBackoff, attempts: []uint{1, 10}, output: []time.Duration{2 * time.Second, 2 * time.Second}},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s backoff", test.backoff)
+		for i, attempt := range test.attempts {
+			assert.Equal(t, test.output[i], getRetryBackoffCap(attempt, &RetryConfig{Backoff: test.backoff}), "attempt %d", attempt)
+		}
+	}
+	assert.Equal(t, 8*time.Second, getRetryBackoffCap(2, nil))
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// useFakeRetryClock makes the retry decisions and backoffs deterministic: time only moves when the returned clock is
+// advanced, and jitter always returns its maximum, or its minimum with minJitter
+func useFakeRetryClock(t *testing.T, minJitter bool) *fakeClock {
+	clock := &fakeClock{now: time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)}
+	previousClock, previousJitter := retryClock, retryJitter
+	previousIsServiceError, previousIsAffectedByEc := isServiceErrorVar, isErrorAffectedByEventualConsistency
+	retryClock = clock
+	retryJitter = func(max time.Duration) time.Duration {
+		if minJitter {
+			return 0
+		}
+		return max
+	}
+	isServiceErrorVar = common.IsServiceError
+	isErrorAffectedByEventualConsistency = common.IsErrorAffectedByEventualConsistency
+	t.Cleanup(func() {
+		retryClock, retryJitter = previousClock, previousJitter
+		isServiceErrorVar, isErrorAffectedByEventualConsistency = previousIsServiceError, previousIsAffectedByEc
+	})
+	return clock
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_decorrelatedJitter(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Hour
+	LongRetryTime = 2 * time.Hour
+	ConfiguredRetryDuration = nil
+
+	type testFormat struct {
+		name      string
+		minJitter bool
+		config    *RetryConfig
+		waits     []time.Duration
+	}
+	tests := []testFormat{
+		{
+			name:  "Test waits grow up to three times the previous wait within the cap of the attempt",
+			waits: []time.Duration{2 * time.Second, 6 * time.Second, 18 * time.Second, 32 * time.Second, 50 * time.Second, 72 * time.Second},
+		},
+		{
+			name:      "Test short waits keep the next waits short",
+			minJitter: true,
+			waits:     []time.Duration{time.Second, time.Second, time.Second},
+		},
+		{
+			name:   "Test waits are capped by the backoff strategy of the service",
+			config: &RetryConfig{Backoff: LinearRetryBackoff},
+			waits:  []time.Duration{2 * time.Second, 4 * time.Second, 6 * time.Second, 8 * time.Second},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		clock := useFakeRetryClock(t, test.minJitter)
+		retryConfigs := map[string]*RetryConfig{"core": test.config}
+
+		retryPolicy := GetRetryPolicy(false, "core")
+		for i, expectedWait := range test.waits {
+			response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, retryConfigs: retryConfigs}, fmt.Errorf("InternalServerError"), uint(i+1))
+			assert.True(t, retryPolicy.ShouldRetryOperation(response))
+			wait := retryPolicy.NextDuration(response)
+			assert.Equal(t, expectedWait, wait, "attempt %d", i+1)
+			clock.Advance(wait)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_retryAfter(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Hour
+	LongRetryTime = 2 * time.Hour
+	ConfiguredRetryDuration = nil
+	clock := useFakeRetryClock(t, false)
+
+	type testFormat struct {
+		name       string
+		statusCode int
+		header     map[string][]string
+		wait       time.Duration
+	}
+	tests := []testFormat{
+		{
+			name:       "Test Retry-After in seconds",
+			statusCode: 429,
+			header:     map[string][]string{"Retry-After": {"7"}},
+			wait:       7 * time.Second,
+		},
+		{
+			name:       "Test Retry-After header without canonical key",
+			statusCode: 503,
+			header:     map[string][]string{"retry-after": {"3"}},
+			wait:       3 * time.Second,
+		},
+		{
+			name:       "Test Retry-After as an HTTP date",
+			statusCode: 503,
+			header:     map[string][]string{"Retry-After": {clock.Now().Add(30 * time.Second).Format(http.TimeFormat)}},
+			wait:       30 * time.Second,
+		},
+		{
+			name:       "Test Retry-After in the past",
+			statusCode: 503,
+			header:     map[string][]string{"Retry-After": {clock.Now().Add(-30 * time.Second).Format(http.TimeFormat)}},
+			wait:       0,
+		},
+		{
+			name:       "Test invalid Retry-After falls back to the backoff",
+			statusCode: 429,
+			header:     map[string][]string{"Retry-After": {"soon"}},
+			wait:       2 * time.Second,
+		},
+		{
+			name:       "Test backoff without Retry-After",
+			statusCode: 429,
+			wait:       2 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: test.statusCode, header: test.header}, fmt.Errorf("TooManyRequests"), 1)
+		for _, service := range []string{"core", "kms", "work_request"} {
+			assert.Equal(t, test.wait, GetRetryPolicy(false, service).NextDuration(response), "service %s", service)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_deadline(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Hour
+	LongRetryTime = 2 * time.Hour
+	ConfiguredRetryDuration = nil
+
+	type testFormat struct {
+		name    string
+		elapsed time.Duration
+		header  map[string][]string
+		wait    time.Duration
+	}
+	tests := []testFormat{
+		{
+			name:    "Test wait leaves time for a last attempt before the deadline",
+			elapsed: 0,
+			wait:    9 * time.Second,
+		},
+		{
+			name:    "Test Retry-After is shortened by the deadline",
+			elapsed: 0,
+			header:  map[string][]string{"Retry-After": {"60"}},
+			wait:    9 * time.Second,
+		},
+		{
+			name:    "Test wait until the deadline when there is no time left for another attempt",
+			elapsed: 9500 * time.Millisecond,
+			wait:    500 * time.Millisecond,
+		},
+		{
+			name:    "Test wait is not shortened past the deadline",
+			elapsed: 11 * time.Second,
+			wait:    32 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		clock := useFakeRetryClock(t, false)
+		retryPolicy := GetRetryPolicyWithDeadline(clock.Now().Add(10*time.Second), false, "core")
+		clock.Advance(test.elapsed)
+
+		response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, header: test.header}, fmt.Errorf("InternalServerError"), 4)
+		assert.Equal(t, test.elapsed < 10*time.Second, retryPolicy.ShouldRetryOperation(response))
+		assert.Equal(t, test.wait, retryPolicy.NextDuration(response))
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_workRequestPolling(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Hour
+	LongRetryTime = 2 * time.Hour
+	ConfiguredRetryDuration = nil
+	ShouldRetryVar = ShouldRetry
+	clock := useFakeRetryClock(t, false)
+
+	// The polling policy of WaitForWorkRequest
+	timeout := 20 * time.Second
+	retryPolicy := GetRetryPolicyWithDeadline(OperationDeadline(context.Background(), timeout), false, "work_request")
+	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
+
+	inProgress := func(attempt uint, header http.Header) common.OCIOperationResponse {
+		return common.NewOCIOperationResponse(oci_work_requests.GetWorkRequestResponse{
+			RawResponse: &http.Response{StatusCode: 200, Header: header},
+		}, nil, attempt)
+	}
+
+	var waits []time.Duration
+	for attempt := uint(1); retryPolicy.ShouldRetryOperation(inProgress(attempt, nil)); attempt++ {
+		header := http.Header{}
+		if attempt == 2 {
+			header.Set("Retry-After", "5")
+		}
+		wait := retryPolicy.NextDuration(inProgress(attempt, header))
+		waits = append(waits, wait)
+		clock.Advance(wait)
+	}
+	// Polls back off with decorrelated jitter and honor Retry-After, a poll happens at the timeout and none after it
+	assert.Equal(t, []time.Duration{2 * time.Second, 5 * time.Second, 12 * time.Second, time.Second, 3 * time.Second}, waits)
+
+	finished := oci_work_requests.GetWorkRequestResponse{RawResponse: &http.Response{StatusCode: 200}}
+	finished.TimeFinished = &common.SDKTime{Time: clock.Now()}
+	assert.False(t, workRequestShouldRetryFunc(timeout)(common.NewOCIOperationResponse(finished, nil, 1)))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_expectedRetryDuration(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 10 * time.Second
+	LongRetryTime = 20 * time.Second
+	ConfiguredRetryDuration = nil
+	clock := useFakeRetryClock(t, false)
+
+	retryPolicy := GetRetryPolicy(false, "core")
+	clock.Advance(8 * time.Second)
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500}, fmt.Errorf("InternalServerError"), 3)
+	// The last attempt happens when the retry duration expires, with up to 5% of jitter
+	assert.Equal(t, 3500*time.Millisecond, retryPolicy.NextDuration(response))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryBackoff_retryAfterCappedByExpectedRetryDuration(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 10 * time.Second
+	LongRetryTime = 20 * time.Second
+	ConfiguredRetryDuration = nil
+	clock := useFakeRetryClock(t, false)
+
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, header: map[string][]string{"Retry-After": {"60"}}}, fmt.Errorf("InternalServerError"), 2)
+	retryPolicy := GetRetryPolicy(false, "core")
+	deadlinePolicy := GetRetryPolicyWithDeadline(clock.Now().Add(time.Hour), false, "core")
+	clock.Advance(8 * time.Second)
+	// Without a deadline, the wait ends with the retries expected for the response
+	assert.Equal(t, 2*time.Second, retryPolicy.NextDuration(response))
+	// The deadline of the operation bounds the wait instead
+	assert.Equal(t, 60*time.Second, deadlinePolicy.NextDuration(response))
+
+	clock.Advance(20 * time.Second)
+	assert.Equal(t, time.Second, retryPolicy.NextDuration(response))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRetryPolicyContext(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Hour
+	LongRetryTime = 2 * time.Hour
+	ConfiguredRetryDuration = nil
+	clock := useFakeRetryClock(t, false)
+
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, header: map[string][]string{"Retry-After": {"60"}}}, fmt.Errorf("InternalServerError"), 1)
+	assert.Equal(t, 60*time.Second, GetRetryPolicyContext(context.Background(), false, "core").NextDuration(response))
+
+	ctx := WithOperationDeadline(context.Background(), 10*time.Second)
+	retryPolicy := GetRetryPolicyContext(ctx, false, "core")
+	assert.Equal(t, 9*time.Second, retryPolicy.NextDuration(response))
+	clock.Advance(10 * time.Second)
+	assert.False(t, retryPolicy.ShouldRetryOperation(response), "there should be no retries past the deadline of the operation")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOperationDeadline(t *testing.T) {
+	clock := useFakeRetryClock(t, false)
+
+	assert.Equal(t, clock.Now().Add(time.Hour), OperationDeadline(context.Background(), time.Hour))
+
+	ctx, cancel := context.WithDeadline(context.Background(), clock.Now().Add(time.Minute))
+	defer cancel()
+	assert.Equal(t, clock.Now().Add(time.Minute), OperationDeadline(ctx, time.Hour))
+	assert.Equal(t, clock.Now().Add(time.Second), OperationDeadline(ctx, time.Second))
+
+	// A nested operation ends with the operation it is part of
+	ctx = WithOperationDeadline(context.Background(), 30*time.Second)
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, clock.Now().Add(20*time.Second), OperationDeadline(ctx, time.Hour))
+	assert.Equal(t, 20*time.Second, remainingOperationTimeout(ctx, time.Hour))
+	assert.Equal(t, clock.Now().Add(5*time.Second), OperationDeadline(WithOperationDeadline(ctx, 5*time.Second), time.Hour))
+}
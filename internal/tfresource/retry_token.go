@@ -0,0 +1,36 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+var stringPointerType = reflect.TypeOf((*string)(nil))
+
+// SetCreateRetryToken sets the OpcRetryToken of request, a pointer to an SDK request made by the Create() of a
+// resource, to the next retry token of the creation. The token of a creation is drawn at random when the creation is
+// planned and kept in the private state of the resource while the creation is pending, so that the requests of the
+// creation get the same tokens when they are retried or resumed, while every creation, e.g. a replacement or the same
+// configuration in another workspace, gets tokens of its own. Requests made outside of CreateOrResume, and requests
+// with a token set already, keep their token.
+func SetCreateRetryToken(ctx context.Context, request interface{}) {
+	tokens := resume.RetryTokensFromContext(ctx)
+	if tokens == nil {
+		return
+	}
+	value := reflect.ValueOf(request)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	field := value.Elem().FieldByName("OpcRetryToken")
+	if !field.IsValid() || !field.CanSet() || field.Type() != stringPointerType || !field.IsNil() {
+		return
+	}
+	token := tokens.Next()
+	field.Set(reflect.ValueOf(&token))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/resume"
+)
+
+type retryTokenRequest struct {
+	DisplayName   *string
+	OpcRetryToken *string
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSetCreateRetryToken(t *testing.T) {
+	request := retryTokenRequest{}
+	SetCreateRetryToken(context.Background(), &request)
+	assert.Nil(t, request.OpcRetryToken, "requests made outside of a creation should use the tokens of the SDK")
+
+	ctx := resume.WithRetryTokens(context.Background(), resume.NewRetryTokens("token"))
+	SetCreateRetryToken(ctx, &request)
+	if assert.NotNil(t, request.OpcRetryToken) {
+		assert.Equal(t, "token", *request.OpcRetryToken)
+	}
+
+	token := "configured"
+	configured := retryTokenRequest{OpcRetryToken: &token}
+	SetCreateRetryToken(ctx, &configured)
+	assert.Equal(t, "configured", *configured.OpcRetryToken, "a token set on the request should be kept")
+
+	next := retryTokenRequest{}
+	SetCreateRetryToken(ctx, &next)
+	if assert.NotNil(t, next.OpcRetryToken) {
+		assert.Len(t, *next.OpcRetryToken, 64)
+		assert.NotEqual(t, "token", *next.OpcRetryToken, "the subsequent requests of a creation should get tokens of their own")
+	}
+
+	assert.NotPanics(t, func() {
+		SetCreateRetryToken(ctx, retryTokenRequest{})
+		SetCreateRetryToken(ctx, &struct{ DisplayName *string }{})
+		SetCreateRetryToken(ctx, (*retryTokenRequest)(nil))
+	})
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateOrResume_retryToken(t *testing.T) {
+	d := &mockResourceData{}
+	var tokens *resume.RetryTokens
+	sync := &mockResumableCrud{createFn: func(ctx context.Context) error {
+		tokens = resume.RetryTokensFromContext(ctx)
+		return nil
+	}}
+
+	_, err := CreateOrResume(context.Background(), d, sync)
+	assert.NoError(t, err)
+	assert.Nil(t, tokens, "creations without a retry token should use the tokens of the SDK")
+
+	pending := resume.NewPending(nil)
+	pending.SetRetryToken("token")
+	_, err = CreateOrResume(resume.WithPending(context.Background(), pending), d, sync)
+	assert.NoError(t, err)
+	if assert.NotNil(t, tokens) {
+		assert.Equal(t, "token", tokens.Next())
+	}
+	assert.Nil(t, resume.RetryTokensFromContext(sync.Context()), "the context of the resource should be restored")
+	assert.Equal(t, "token", pending.RetryToken(), "the creation should keep its token until it is applied")
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Matches the "{attribute_name}" placeholders of a composite ID format
+var compositeIdPlaceholderRegex = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// StateUpgradeStep changes a raw resource state in place. The raw state is the JSON decoded state of the
+// previous schema version: nested blocks, lists and sets are []interface{} and objects are map[string]interface{}.
+type StateUpgradeStep func(rawState map[string]interface{}) error
+
+// NewStateUpgrader builds a schema.StateUpgrader that upgrades state written with the given schema version
+// to version+1 by applying the steps in order. previousSchema must be the schema as it was at that version
+// so that Terraform can decode legacy flatmap state.
+//
+// Usage:
+//
+//	SchemaVersion: 1,
+//	StateUpgraders: []schema.StateUpgrader{
+//		tfresource.NewStateUpgrader(0, coreVcnResourceV0(),
+//			tfresource.RenameAttribute("cidr_block", "cidr_blocks"),
+//			tfresource.WrapInList("cidr_blocks"),
+//			tfresource.LowercaseTagKeys("defined_tags")),
+//	},
+//
+// Steps do not convert types, a step that changes the type of an attribute must be chained with the conversion to the
+// new type, e.g. the string of cidr_block is wrapped in a list by WrapInList once renamed to the cidr_blocks list.
+func NewStateUpgrader(version int, previousSchema *schema.Resource, steps ...StateUpgradeStep) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    previousSchema.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				return rawState, nil
+			}
+			for _, step := range steps {
+				if err := step(rawState); err != nil {
+					return rawState, fmt.Errorf("failed to upgrade state from schema version %d: %v", version, err)
+				}
+			}
+			return rawState, nil
+		},
+	}
+}
+
+// RenameAttribute moves the value of oldPath to newPath. Paths are dot separated; a path element that resolves to a
+// nested block applies the rest of the path to every element, e.g. "route_rules.cidr_block" renames the attribute in
+// each route rule. An existing non-empty value at newPath is never overwritten.
+func RenameAttribute(oldPath string, newPath string) StateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		oldParts := strings.Split(oldPath, ".")
+		newParts := strings.Split(newPath, ".")
+		if len(oldParts) != len(newParts) || !reflect.DeepEqual(oldParts[:len(oldParts)-1], newParts[:len(newParts)-1]) {
+			return fmt.Errorf("cannot rename '%s' to '%s', only the last path element may change", oldPath, newPath)
+		}
+
+		oldName := oldParts[len(oldParts)-1]
+		newName := newParts[len(newParts)-1]
+		forEachParentObject(rawState, oldParts[:len(oldParts)-1], func(parent map[string]interface{}) {
+			value, ok := parent[oldName]
+			if !ok {
+				return
+			}
+			delete(parent, oldName)
+			if existing, exists := parent[newName]; exists && !isEmptyStateValue(existing) {
+				log.Printf("[DEBUG] state upgrade: '%s' already set, dropping value of deprecated '%s'", newPath, oldPath)
+				return
+			}
+			parent[newName] = value
+		})
+		return nil
+	}
+}
+
+// ConvertListToSet removes duplicate elements from the list at path, as required once the attribute becomes a TypeSet
+func ConvertListToSet(path string) StateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		parts := strings.Split(path, ".")
+		name := parts[len(parts)-1]
+		var err error
+		forEachParentObject(rawState, parts[:len(parts)-1], func(parent map[string]interface{}) {
+			value, ok := parent[name]
+			if !ok || value == nil {
+				return
+			}
+			list, isList := value.([]interface{})
+			if !isList {
+				err = fmt.Errorf("'%s' is not a list: %T", path, value)
+				return
+			}
+			result := make([]interface{}, 0, len(list))
+			for _, item := range list {
+				duplicate := false
+				for _, existing := range result {
+					if reflect.DeepEqual(existing, item) {
+						duplicate = true
+						break
+					}
+				}
+				if !duplicate {
+					result = append(result, item)
+				}
+			}
+			parent[name] = result
+		})
+		return err
+	}
+}
+
+// WrapInList wraps the single value at path in a list once the attribute becomes a list, e.g. an attribute that used
+// to be a TypeString. Values already stored as a list are kept as is.
+func WrapInList(path string) StateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		parts := strings.Split(path, ".")
+		name := parts[len(parts)-1]
+		forEachParentObject(rawState, parts[:len(parts)-1], func(parent map[string]interface{}) {
+			value, ok := parent[name]
+			if !ok || value == nil {
+				return
+			}
+			if _, isList := value.([]interface{}); !isList {
+				parent[name] = []interface{}{value}
+			}
+		})
+		return nil
+	}
+}
+
+// SplitCompositeId populates the attributes named in format from the composite ID stored in state, e.g.
+// "networkLoadBalancers/{network_load_balancer_id}/listeners/{name}". If newIdAttribute is not empty, the
+// resource ID is then replaced with the value of that attribute.
+func SplitCompositeId(format string, newIdAttribute string) StateUpgradeStep {
+	attributes := []string{}
+	for _, match := range compositeIdPlaceholderRegex.FindAllStringSubmatch(format, -1) {
+		attributes = append(attributes, match[1])
+	}
+	idRegex := regexp.MustCompile(buildCompositeIdPattern(format))
+
+	return func(rawState map[string]interface{}) error {
+		id, _ := rawState["id"].(string)
+		if id == "" {
+			return nil
+		}
+		matches := idRegex.FindStringSubmatch(id)
+		if matches == nil {
+			// Already upgraded or imported with a plain ID
+			log.Printf("[DEBUG] state upgrade: id '%s' does not match composite ID format '%s'", id, format)
+			return nil
+		}
+		for i, attribute := range attributes {
+			value, err := url.PathUnescape(matches[i+1])
+			if err != nil {
+				return fmt.Errorf("illegal compositeId %s encountered: %v", id, err)
+			}
+			if existing, ok := rawState[attribute]; !ok || isEmptyStateValue(existing) {
+				rawState[attribute] = value
+			}
+		}
+		if newIdAttribute != "" {
+			newId, ok := rawState[newIdAttribute].(string)
+			if !ok || newId == "" {
+				return fmt.Errorf("cannot replace id with '%s', attribute is not set", newIdAttribute)
+			}
+			rawState["id"] = newId
+		}
+		return nil
+	}
+}
+
+// buildCompositeIdPattern turns "a/{x}/b/{y}" into "^a/([^/]+)/b/([^/]+)$"
+func buildCompositeIdPattern(format string) string {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range compositeIdPlaceholderRegex.FindAllStringIndex(format, -1) {
+		pattern.WriteString(regexp.QuoteMeta(format[last:loc[0]]))
+		pattern.WriteString("([^/]+)")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(format[last:]))
+	pattern.WriteString("$")
+	return pattern.String()
+}
+
+// LowercaseTagKeys lowercases the keys of the tag map at path. Tag namespaces and keys are case insensitive in OCI,
+// so keys that only differ by case are merged, keeping the value of the key that was already lowercase.
+func LowercaseTagKeys(path string) StateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		parts := strings.Split(path, ".")
+		name := parts[len(parts)-1]
+		var err error
+		forEachParentObject(rawState, parts[:len(parts)-1], func(parent map[string]interface{}) {
+			value, ok := parent[name]
+			if !ok || value == nil {
+				return
+			}
+			tags, isMap := value.(map[string]interface{})
+			if !isMap {
+				err = fmt.Errorf("'%s' is not a map: %T", path, value)
+				return
+			}
+			keys := make([]string, 0, len(tags))
+			for key := range tags {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			result := make(map[string]interface{}, len(tags))
+			for _, key := range keys {
+				lowerKey := strings.ToLower(key)
+				if _, exists := result[lowerKey]; exists && key != lowerKey {
+					continue
+				}
+				result[lowerKey] = tags[key]
+			}
+			parent[name] = result
+		})
+		return err
+	}
+}
+
+// forEachParentObject walks path through rawState and calls fn with every object found at the end of it.
+// Path elements that hold a list of objects (nested blocks) fan out to each element.
+func forEachParentObject(object map[string]interface{}, path []string, fn func(parent map[string]interface{})) {
+	if len(path) == 0 {
+		fn(object)
+		return
+	}
+
+	switch child := object[path[0]].(type) {
+	case map[string]interface{}:
+		forEachParentObject(child, path[1:], fn)
+	case []interface{}:
+		for _, item := range child {
+			if itemObject, ok := item.(map[string]interface{}); ok {
+				forEachParentObject(itemObject, path[1:], fn)
+			}
+		}
+	}
+}
+
+func isEmptyStateValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// The schemas below are the schemas of the resources of the captured states in testdata/state_upgraders, as they
+// were at schema version 0 and as they would be once upgraded
+
+func stateUpgraderTestStringSchema(optional bool) *schema.Schema {
+	return &schema.Schema{Type: schema.TypeString, Optional: optional, Computed: true}
+}
+
+func stateUpgraderTestCoreVcnV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Schema: map[string]*schema.Schema{
+			"cidr_block":               {Type: schema.TypeString, Required: true},
+			"compartment_id":           {Type: schema.TypeString, Required: true},
+			"default_dhcp_options_id":  stateUpgraderTestStringSchema(false),
+			"default_route_table_id":   stateUpgraderTestStringSchema(false),
+			"default_security_list_id": stateUpgraderTestStringSchema(false),
+			"defined_tags":             {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+			"display_name":             stateUpgraderTestStringSchema(true),
+			"dns_label":                stateUpgraderTestStringSchema(true),
+			"freeform_tags":            {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+			"ipv6cidr_block":           stateUpgraderTestStringSchema(true),
+			"ipv6public_cidr_block":    stateUpgraderTestStringSchema(false),
+			"is_ipv6enabled":           {Type: schema.TypeBool, Optional: true, Computed: true},
+			"state":                    stateUpgraderTestStringSchema(false),
+			"time_created":             stateUpgraderTestStringSchema(false),
+			"vcn_domain_name":          stateUpgraderTestStringSchema(false),
+		},
+	}
+}
+
+func stateUpgraderTestCoreVcnV1() *schema.Resource {
+	resource := stateUpgraderTestCoreVcnV0()
+	resource.Schema["cidr_block"] = stateUpgraderTestStringSchema(true)
+	resource.Schema["cidr_blocks"] = &schema.Schema{Type: schema.TypeList, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}}
+	return resource
+}
+
+func stateUpgraderTestCoreRouteTableV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Schema: map[string]*schema.Schema{
+			"compartment_id": {Type: schema.TypeString, Required: true},
+			"defined_tags":   {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+			"display_name":   stateUpgraderTestStringSchema(true),
+			"freeform_tags":  {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+			"route_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block":        stateUpgraderTestStringSchema(true),
+						"description":       stateUpgraderTestStringSchema(true),
+						"destination":       stateUpgraderTestStringSchema(true),
+						"destination_type":  stateUpgraderTestStringSchema(true),
+						"network_entity_id": {Type: schema.TypeString, Required: true},
+					},
+				},
+			},
+			"state":        stateUpgraderTestStringSchema(false),
+			"time_created": stateUpgraderTestStringSchema(false),
+			"vcn_id":       {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func stateUpgraderTestCoreRouteTableV1() *schema.Resource {
+	resource := stateUpgraderTestCoreRouteTableV0()
+	delete(resource.Schema["route_rules"].Elem.(*schema.Resource).Schema, "cidr_block")
+	return resource
+}
+
+func stateUpgraderTestNetworkLoadBalancerListenerV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Schema: map[string]*schema.Schema{
+			"default_backend_set_name": {Type: schema.TypeString, Required: true},
+			"name":                     stateUpgraderTestStringSchema(false),
+			"network_load_balancer_id": stateUpgraderTestStringSchema(false),
+			"port":                     {Type: schema.TypeInt, Required: true},
+			"protocol":                 {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+// readCapturedState returns the raw state of the resource of a state file written by Terraform, checking that it is
+// decoded with the schema of the resource at the version of the state as Terraform does before upgrading it
+func readCapturedState(t *testing.T, fileName string, previousSchema *schema.Resource) map[string]interface{} {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "state_upgraders", fileName))
+	if err != nil {
+		t.Fatalf("unable to read captured state: %v", err)
+	}
+	state := struct {
+		Resources []struct {
+			Instances []struct {
+				Attributes json.RawMessage `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		t.Fatalf("invalid captured state: %v", err)
+	}
+	attributes := state.Resources[0].Instances[0].Attributes
+	if _, err := ctyjson.Unmarshal(attributes, previousSchema.CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("captured state %s does not match the previous schema: %v", fileName, err)
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(attributes, &rawState); err != nil {
+		t.Fatalf("invalid captured state: %v", err)
+	}
+	return rawState
+}
+
+// decodeUpgradedState decodes the upgraded raw state with the current schema of the resource, as Terraform does
+func decodeUpgradedState(rawState map[string]interface{}, currentSchema *schema.Resource) error {
+	content, err := json.Marshal(rawState)
+	if err != nil {
+		return err
+	}
+	_, err = ctyjson.Unmarshal(content, currentSchema.CoreConfigSchema().ImpliedType())
+	return err
+}
+
+func TestUnitStateUpgradeSteps(t *testing.T) {
+	type testFormat struct {
+		name           string
+		stateFile      string
+		previousSchema *schema.Resource
+		currentSchema  *schema.Resource
+		steps          []StateUpgradeStep
+		expected       map[string]interface{}
+		gotError       bool
+		invalidState   bool
+	}
+	tests := []testFormat{
+		{
+			name:           "Test attribute is renamed and wrapped in a list",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			currentSchema:  stateUpgraderTestCoreVcnV1(),
+			steps:          []StateUpgradeStep{RenameAttribute("cidr_block", "cidr_blocks"), WrapInList("cidr_blocks")},
+			expected: map[string]interface{}{
+				"cidr_block":  nil,
+				"cidr_blocks": []interface{}{"10.0.0.0/16"},
+				"id":          "ocid1.vcn.oc1.phx.amaaaaaaw5txsnaaxdcbx5omefv5fxpnp7ez6mgofcc5ckbnlqpjabfnqjuq",
+			},
+		},
+		{
+			name:           "Test a list is not wrapped again",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			currentSchema:  stateUpgraderTestCoreVcnV1(),
+			steps:          []StateUpgradeStep{RenameAttribute("cidr_block", "cidr_blocks"), WrapInList("cidr_blocks"), WrapInList("cidr_blocks")},
+			expected:       map[string]interface{}{"cidr_blocks": []interface{}{"10.0.0.0/16"}},
+		},
+		{
+			name:           "Test renaming a string to a list without converting it is an invalid state",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			currentSchema:  stateUpgraderTestCoreVcnV1(),
+			steps:          []StateUpgradeStep{RenameAttribute("cidr_block", "cidr_blocks")},
+			expected:       map[string]interface{}{"cidr_blocks": "10.0.0.0/16"},
+			invalidState:   true,
+		},
+		{
+			name:           "Test tag keys are lowercased",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			currentSchema:  stateUpgraderTestCoreVcnV0(),
+			steps:          []StateUpgradeStep{LowercaseTagKeys("defined_tags"), LowercaseTagKeys("freeform_tags")},
+			expected: map[string]interface{}{
+				"defined_tags": map[string]interface{}{
+					"operations.costcenter": "42",
+					"oracle-tags.createdby": "oracleidentitycloudservice/jane.doe@example.com",
+				},
+				"freeform_tags": map[string]interface{}{"department": "Finance"},
+			},
+		},
+		{
+			name:           "Test list to set on a map is rejected",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			steps:          []StateUpgradeStep{ConvertListToSet("defined_tags")},
+			gotError:       true,
+		},
+		{
+			name:           "Test plain id is left alone",
+			stateFile:      "core_vcn_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreVcnV0(),
+			currentSchema:  stateUpgraderTestCoreVcnV0(),
+			steps:          []StateUpgradeStep{SplitCompositeId("compartments/{compartment_id}/vcns/{vcn_id}", "")},
+			expected:       map[string]interface{}{"id": "ocid1.vcn.oc1.phx.amaaaaaaw5txsnaaxdcbx5omefv5fxpnp7ez6mgofcc5ckbnlqpjabfnqjuq"},
+		},
+		{
+			name:           "Test nested attribute is renamed in every block without overwriting set values",
+			stateFile:      "core_route_table_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreRouteTableV0(),
+			currentSchema:  stateUpgraderTestCoreRouteTableV1(),
+			steps:          []StateUpgradeStep{RenameAttribute("route_rules.cidr_block", "route_rules.destination"), ConvertListToSet("route_rules")},
+			expected: map[string]interface{}{
+				"route_rules": []interface{}{
+					map[string]interface{}{
+						"description":       "",
+						"destination":       "0.0.0.0/0",
+						"destination_type":  "CIDR_BLOCK",
+						"network_entity_id": "ocid1.internetgateway.oc1.phx.aaaaaaaa2a5xjwnh4vjnhdzvjxbxnqotrmsdfmnzybccl6q6cjbhmwmnvtna",
+					},
+					map[string]interface{}{
+						"description":       "Object Storage through the service gateway",
+						"destination":       "oci-phx-objectstorage",
+						"destination_type":  "SERVICE_CIDR_BLOCK",
+						"network_entity_id": "ocid1.servicegateway.oc1.phx.aaaaaaaahkfxs3ebwcdv6ueuyxu6sn7z4cbyxrf4rmw4z6qwomtk4hc2xnwa",
+					},
+				},
+			},
+		},
+		{
+			name:           "Test rename across different parents is rejected",
+			stateFile:      "core_route_table_v0.tfstate",
+			previousSchema: stateUpgraderTestCoreRouteTableV0(),
+			steps:          []StateUpgradeStep{RenameAttribute("route_rules.cidr_block", "cidr_block")},
+			gotError:       true,
+		},
+		{
+			name:           "Test composite id is split",
+			stateFile:      "network_load_balancer_listener_v0.tfstate",
+			previousSchema: stateUpgraderTestNetworkLoadBalancerListenerV0(),
+			currentSchema:  stateUpgraderTestNetworkLoadBalancerListenerV0(),
+			steps:          []StateUpgradeStep{SplitCompositeId("networkLoadBalancers/{network_load_balancer_id}/listeners/{name}", "")},
+			expected: map[string]interface{}{
+				"id":                       "networkLoadBalancers/ocid1.networkloadbalancer.oc1.phx.amaaaaaaw5txsnaaiqgfc3cs7xtnqgzofe6nnkuawvnm5fmhiwtn4rntmwpa/listeners/https%20listener",
+				"name":                     "https listener",
+				"network_load_balancer_id": "ocid1.networkloadbalancer.oc1.phx.amaaaaaaw5txsnaaiqgfc3cs7xtnqgzofe6nnkuawvnm5fmhiwtn4rntmwpa",
+			},
+		},
+		{
+			name:           "Test composite id is split and replaced",
+			stateFile:      "network_load_balancer_listener_v0.tfstate",
+			previousSchema: stateUpgraderTestNetworkLoadBalancerListenerV0(),
+			currentSchema:  stateUpgraderTestNetworkLoadBalancerListenerV0(),
+			steps:          []StateUpgradeStep{SplitCompositeId("networkLoadBalancers/{network_load_balancer_id}/listeners/{name}", "name")},
+			expected:       map[string]interface{}{"id": "https listener", "port": float64(443)},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		rawState := readCapturedState(t, test.stateFile, test.previousSchema)
+		var err error
+		for _, step := range test.steps {
+			if err = step(rawState); err != nil {
+				break
+			}
+		}
+		if (err != nil) != test.gotError {
+			t.Errorf("Output error - %q which is not equal to expected error - %t", err, test.gotError)
+			continue
+		}
+		if test.gotError {
+			continue
+		}
+		for name, value := range test.expected {
+			assert.Equal(t, value, rawState[name], "unexpected value of '%s' in %s", name, test.name)
+		}
+		if err := decodeUpgradedState(rawState, test.currentSchema); (err != nil) != test.invalidState {
+			t.Errorf("Upgraded state error - %q which is not equal to expected invalid state - %t", err, test.invalidState)
+		}
+	}
+}
+
+func TestUnitNewStateUpgrader(t *testing.T) {
+	upgrader := NewStateUpgrader(0, stateUpgraderTestCoreVcnV0(),
+		RenameAttribute("cidr_block", "cidr_blocks"),
+		WrapInList("cidr_blocks"),
+		LowercaseTagKeys("defined_tags"),
+	)
+	assert.Equal(t, 0, upgrader.Version)
+	assert.True(t, upgrader.Type.IsObjectType())
+	assert.True(t, upgrader.Type.HasAttribute("cidr_block"))
+
+	rawState := readCapturedState(t, "core_vcn_v0.tfstate", stateUpgraderTestCoreVcnV0())
+	newState, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"10.0.0.0/16"}, newState["cidr_blocks"])
+	assert.Equal(t, "42", newState["defined_tags"].(map[string]interface{})["operations.costcenter"])
+	assert.NoError(t, decodeUpgradedState(newState, stateUpgraderTestCoreVcnV1()))
+
+	failing := NewStateUpgrader(0, stateUpgraderTestCoreVcnV0(), ConvertListToSet("defined_tags"))
+	_, err = failing.Upgrade(context.Background(), readCapturedState(t, "core_vcn_v0.tfstate", stateUpgraderTestCoreVcnV0()), nil)
+	assert.Error(t, err)
+
+	nilState, err := upgrader.Upgrade(context.Background(), nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, nilState)
+}
+
+func TestUnitStateUpgraderValidatesWithResource(t *testing.T) {
+	resource := stateUpgraderTestCoreVcnV1()
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		NewStateUpgrader(0, stateUpgraderTestCoreVcnV0(), RenameAttribute("cidr_block", "cidr_blocks"), WrapInList("cidr_blocks")),
+	}
+	resource.Read = func(d *schema.ResourceData, m interface{}) error { return nil }
+	resource.Create = func(d *schema.ResourceData, m interface{}) error { return nil }
+	resource.Delete = func(d *schema.ResourceData, m interface{}) error { return nil }
+	resource.Update = func(d *schema.ResourceData, m interface{}) error { return nil }
+	assert.NoError(t, resource.InternalValidate(nil, true))
+}
@@ -57,7 +57,8 @@ func getSuggestionFor409(tfError customError) string {
 }
 
 func getSuggestionFor429(tfError customError) string {
-	return fmt.Sprintf("Please re-apply your Terraform config and/or increase the retry timeout using this document: https://docs.oracle.com/en-us/iaas/Content/API/SDKDocs/terraformtroubleshooting.htm#common_issues__automaticretries")
+	return fmt.Sprintf("Please re-apply your Terraform config and/or increase the retry timeout using this document: https://docs.oracle.com/en-us/iaas/Content/API/SDKDocs/terraformtroubleshooting.htm#common_issues__automaticretries. " +
+		"To avoid being throttled, limit the request rate of the client using the `client_rate_limits` provider setting or the CLIENT_RATE_LIMITS environment variable")
 }
 
 func getSuggestionFor500(tfError customError) string {
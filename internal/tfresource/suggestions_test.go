@@ -114,7 +114,8 @@ func TestUnit_getSuggestionForServiceError(t *testing.T) {
 					ErrorCode: 429,
 				},
 			},
-			want: "Please re-apply your Terraform config and/or increase the retry timeout using this document: https://docs.oracle.com/en-us/iaas/Content/API/SDKDocs/terraformtroubleshooting.htm#common_issues__automaticretries",
+			want: "Please re-apply your Terraform config and/or increase the retry timeout using this document: https://docs.oracle.com/en-us/iaas/Content/API/SDKDocs/terraformtroubleshooting.htm#common_issues__automaticretries. " +
+				"To avoid being throttled, limit the request rate of the client using the `client_rate_limits` provider setting or the CLIENT_RATE_LIMITS environment variable",
 		},
 		{
 			name: "Test returned value is as expected for 500 code",
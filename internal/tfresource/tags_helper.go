@@ -4,11 +4,16 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 )
 
 var DefinedTagsToSuppress []string
@@ -114,3 +119,164 @@ func MapToSystemTags(rawMap map[string]interface{}) (map[string]map[string]inter
 	}
 	return systemTags, nil
 }
+
+// AddDefaultTags makes a resource with top level "freeform_tags" or "defined_tags" attributes pick up the default tags
+// of its provider. The tag attributes keep the tags of the configuration, the computed "freeform_tags_all" and
+// "defined_tags_all" attributes hold them merged with the default tags. Creations and updates send the merged tags,
+// reads remove the default tags from the tag attributes again so that they stay equal to the configuration.
+func AddDefaultTags(resource *schema.Resource) {
+	if resource == nil {
+		return
+	}
+	var keys []string
+	for _, key := range []string{"freeform_tags", "defined_tags"} {
+		if isDefaultTagsAttribute(resource, key) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	for _, key := range keys {
+		resource.Schema[AllTagsKey(key)] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			// Resources that cannot update their tags are replaced when the default tags change
+			ForceNew: resource.Schema[key].ForceNew,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	if resource.CustomizeDiff == nil {
+		resource.CustomizeDiff = defaultTagsCustomizeDiff(keys)
+	} else {
+		resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, defaultTagsCustomizeDiff(keys))
+	}
+	resource.CreateContext = withDefaultTags(keys, resource.CreateContext, true)
+	resource.ReadContext = withDefaultTags(keys, resource.ReadContext, false)
+	resource.UpdateContext = withDefaultTags(keys, resource.UpdateContext, true)
+}
+
+// AllTagsKey returns the attribute with the tags of the tag attribute key merged with the default tags of the provider
+func AllTagsKey(key string) string {
+	return key + "_all"
+}
+
+// Only optional and computed tag maps can be planned by the provider
+func isDefaultTagsAttribute(resource *schema.Resource, key string) bool {
+	attribute, ok := resource.Schema[key]
+	if _, exists := resource.Schema[AllTagsKey(key)]; exists {
+		return false
+	}
+	return ok && attribute.Type == schema.TypeMap && attribute.Optional && attribute.Computed
+}
+
+// providerDefaultTags returns the default tags of the provider, read from its meta, for the tag attribute key
+func providerDefaultTags(meta interface{}, key string) map[string]interface{} {
+	clients, ok := meta.(*client.OracleClients)
+	if !ok {
+		return nil
+	}
+	if key == "defined_tags" {
+		return clients.DefaultDefinedTags
+	}
+	return clients.DefaultFreeformTags
+}
+
+// defaultTagsCustomizeDiff plans the tags of the tag attributes keys merged with the default tags of the provider
+func defaultTagsCustomizeDiff(keys []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, key := range keys {
+			allKey := AllTagsKey(key)
+			if !d.NewValueKnown(key) {
+				// The configured tags are only known at apply time
+				if err := d.SetNewComputed(allKey); err != nil {
+					return err
+				}
+				continue
+			}
+			tags, _ := d.Get(key).(map[string]interface{})
+			merged := MergeDefaultTags(tags, providerDefaultTags(meta, key))
+			current, _ := d.Get(allKey).(map[string]interface{})
+			if reflect.DeepEqual(ToLowerCaseKeyMap(merged), ToLowerCaseKeyMap(current)) {
+				continue
+			}
+			if err := d.SetNew(allKey, merged); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// withDefaultTags wraps a CRUD function of a resource with the tag attributes keys. If send is true, the function
+// reads the tags merged with the default tags from the tag attributes, so that the requests it builds from them send
+// the merged tags. Once it returns, the tags of the resource are saved to the "_all" attributes, and the default tags
+// that the configuration does not set are removed from the tag attributes.
+func withDefaultTags(keys []string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, send bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		configured := make(map[string]map[string]interface{}, len(keys))
+		appliedDefaults := make(map[string]map[string]interface{}, len(keys))
+		for _, key := range keys {
+			tags, _ := d.Get(key).(map[string]interface{})
+			allTags, _ := d.Get(AllTagsKey(key)).(map[string]interface{})
+			configured[key] = tags
+			// The tags of the resource that are not in its tag attributes are default tags applied before, which
+			// are removed from the tag attributes even if they are no longer defaults of the provider
+			appliedDefaults[key] = withoutTags(allTags, tags)
+			if send {
+				if err := d.Set(key, MergeDefaultTags(tags, providerDefaultTags(m, key))); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		diags := fn(ctx, d, m)
+		if d.Id() == "" {
+			return diags
+		}
+
+		for _, key := range keys {
+			tags, _ := d.Get(key).(map[string]interface{})
+			if err := d.Set(AllTagsKey(key), tags); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			tags = withoutTags(tags, withoutTags(providerDefaultTags(m, key), configured[key]))
+			tags = withoutTags(tags, withoutTags(appliedDefaults[key], configured[key]))
+			if err := d.Set(key, tags); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+}
+
+// withoutTags returns tags without the tags of removed that have the same value. Tag keys are case insensitive.
+func withoutTags(tags map[string]interface{}, removed map[string]interface{}) map[string]interface{} {
+	lowerCaseRemoved := ToLowerCaseKeyMap(removed)
+	result := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		if removedValue, ok := lowerCaseRemoved[strings.ToLower(key)]; !ok || removedValue != value {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// MergeDefaultTags returns tags with every default tag added that is not already set. Tag keys are case insensitive,
+// a tag that is set keeps its value.
+func MergeDefaultTags(tags map[string]interface{}, defaultTags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(tags)+len(defaultTags))
+	for key, value := range tags {
+		merged[key] = value
+	}
+	lowerCaseTags := ToLowerCaseKeyMap(tags)
+	for key, value := range defaultTags {
+		if _, ok := lowerCaseTags[strings.ToLower(key)]; !ok {
+			merged[key] = value
+		}
+	}
+	return merged
+}
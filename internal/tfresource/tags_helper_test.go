@@ -1,10 +1,16 @@
 package tfresource
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 )
 
 func TestUnitDefinedTagsToMap(t *testing.T) {
@@ -268,3 +274,233 @@ func TestUnitDefinedTagsDiffSuppressFunction(t *testing.T) {
 		})
 	}
 }
+
+func defaultTagsTestResource(remoteTags map[string]interface{}) *schema.Resource {
+	// The tags of the resource in OCI are kept in remoteTags
+	write := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		d.SetId("ocid1.vcn.oc1..aaa")
+		for key := range remoteTags {
+			delete(remoteTags, key)
+		}
+		for key, value := range d.Get("freeform_tags").(map[string]interface{}) {
+			remoteTags[key] = value
+		}
+		return nil
+	}
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(d.Set("freeform_tags", remoteTags))
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return append(write(ctx, d, m), read(ctx, d, m)...)
+		},
+		ReadContext: read,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return append(write(ctx, d, m), read(ctx, d, m)...)
+		},
+	}
+	AddDefaultTags(resource)
+	return resource
+}
+
+func TestUnitMergeDefaultTags(t *testing.T) {
+	defaults := map[string]interface{}{"CostCenter": "42", "Owner": "platform"}
+	assert.Equal(t, map[string]interface{}{"CostCenter": "42", "Owner": "platform"}, MergeDefaultTags(nil, defaults))
+	assert.Equal(t, map[string]interface{}{"costcenter": "7", "Owner": "platform", "app": "web"}, MergeDefaultTags(map[string]interface{}{"costcenter": "7", "app": "web"}, defaults))
+	assert.Equal(t, map[string]interface{}{"app": "web"}, MergeDefaultTags(map[string]interface{}{"app": "web"}, nil))
+}
+
+func TestUnitDefaultTagsCustomizeDiff(t *testing.T) {
+	clients := &client.OracleClients{
+		DefaultFreeformTags: map[string]interface{}{"CostCenter": "42"},
+		DefaultDefinedTags:  map[string]interface{}{"Operations.Owner": "platform"},
+	}
+
+	type testFormat struct {
+		name     string
+		state    map[string]string
+		config   map[string]interface{}
+		clients  *client.OracleClients
+		expected map[string]string
+	}
+	tests := []testFormat{
+		{
+			name:   "Test defaults are added to the merged tags on create",
+			config: map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web"}},
+			expected: map[string]string{
+				"freeform_tags.%":                   "1",
+				"freeform_tags.app":                 "web",
+				"freeform_tags_all.%":               "2",
+				"freeform_tags_all.app":             "web",
+				"freeform_tags_all.CostCenter":      "42",
+				"defined_tags_all.%":                "1",
+				"defined_tags_all.Operations.Owner": "platform",
+			},
+		},
+		{
+			name:   "Test resource tags take precedence over defaults",
+			config: map[string]interface{}{"freeform_tags": map[string]interface{}{"costcenter": "7"}},
+			expected: map[string]string{
+				"freeform_tags.%":                   "1",
+				"freeform_tags.costcenter":          "7",
+				"freeform_tags_all.%":               "1",
+				"freeform_tags_all.costcenter":      "7",
+				"defined_tags_all.%":                "1",
+				"defined_tags_all.Operations.Owner": "platform",
+			},
+		},
+		{
+			name: "Test plan is clean once the resource carries the defaults",
+			state: map[string]string{
+				"id":                                "ocid1.vcn.oc1..aaa",
+				"freeform_tags.%":                   "1",
+				"freeform_tags.app":                 "web",
+				"freeform_tags_all.%":               "2",
+				"freeform_tags_all.app":             "web",
+				"freeform_tags_all.CostCenter":      "42",
+				"defined_tags.%":                    "0",
+				"defined_tags_all.%":                "1",
+				"defined_tags_all.operations.owner": "platform",
+			},
+			config:   map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web"}},
+			expected: map[string]string{},
+		},
+		{
+			name: "Test missing defaults are added on update",
+			state: map[string]string{
+				"id":                    "ocid1.vcn.oc1..aaa",
+				"freeform_tags.%":       "1",
+				"freeform_tags.app":     "web",
+				"freeform_tags_all.%":   "1",
+				"freeform_tags_all.app": "web",
+			},
+			config: map[string]interface{}{},
+			expected: map[string]string{
+				"freeform_tags_all.%":               "2",
+				"freeform_tags_all.CostCenter":      "42",
+				"defined_tags_all.%":                "1",
+				"defined_tags_all.Operations.Owner": "platform",
+			},
+		},
+		{
+			name: "Test defaults removed from the provider are removed on update",
+			state: map[string]string{
+				"id":                           "ocid1.vcn.oc1..aaa",
+				"freeform_tags.%":              "1",
+				"freeform_tags.app":            "web",
+				"freeform_tags_all.%":          "2",
+				"freeform_tags_all.app":        "web",
+				"freeform_tags_all.CostCenter": "42",
+			},
+			config:  map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web"}},
+			clients: &client.OracleClients{},
+			expected: map[string]string{
+				"freeform_tags_all.%":          "1",
+				"freeform_tags_all.CostCenter": "<removed>",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		var state *terraform.InstanceState
+		if test.state != nil {
+			state = &terraform.InstanceState{ID: test.state["id"], Attributes: test.state}
+		}
+		meta := clients
+		if test.clients != nil {
+			meta = test.clients
+		}
+		assert.Equal(t, test.expected, defaultTagsTestDiff(t, state, test.config, meta))
+	}
+
+	// Resources without optional tags are left alone
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"freeform_tags": {Type: schema.TypeMap, Computed: true, Elem: schema.TypeString}}}
+	AddDefaultTags(resource)
+	assert.Nil(t, resource.CustomizeDiff)
+	assert.NotContains(t, resource.Schema, "freeform_tags_all")
+}
+
+func defaultTagsTestDiff(t *testing.T, state *terraform.InstanceState, config map[string]interface{}, meta interface{}) map[string]string {
+	diff, err := defaultTagsTestResource(map[string]interface{}{}).Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	assert.NoError(t, err)
+
+	result := map[string]string{}
+	if diff != nil {
+		for key, attribute := range diff.Attributes {
+			if attribute.Old != attribute.New {
+				result[key] = attribute.New
+				if attribute.NewRemoved {
+					result[key] = "<removed>"
+				}
+			}
+		}
+	}
+	return result
+}
+
+func TestUnitDefaultTagsCustomizeDiff_providers(t *testing.T) {
+	// Each provider, e.g. each alias, merges its own default tags
+	first := &client.OracleClients{DefaultFreeformTags: map[string]interface{}{"CostCenter": "42"}}
+	second := &client.OracleClients{DefaultFreeformTags: map[string]interface{}{"CostCenter": "7"}}
+	config := map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web"}}
+
+	assert.Equal(t, "42", defaultTagsTestDiff(t, nil, config, first)["freeform_tags_all.CostCenter"])
+	assert.Equal(t, "7", defaultTagsTestDiff(t, nil, config, second)["freeform_tags_all.CostCenter"])
+	assert.NotContains(t, defaultTagsTestDiff(t, nil, config, &client.OracleClients{}), "freeform_tags_all.CostCenter")
+}
+
+func TestUnitDefaultTags_crud(t *testing.T) {
+	withDefaults := &client.OracleClients{DefaultFreeformTags: map[string]interface{}{"CostCenter": "42"}}
+	remoteTags := map[string]interface{}{}
+	resource := defaultTagsTestResource(remoteTags)
+
+	// The creation sends the merged tags, the tags of the configuration are kept as configured
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web"}})
+	assert.Empty(t, resource.CreateContext(context.Background(), d, withDefaults))
+	assert.Equal(t, map[string]interface{}{"app": "web", "CostCenter": "42"}, remoteTags)
+	assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("freeform_tags"))
+	assert.Equal(t, map[string]interface{}{"app": "web", "CostCenter": "42"}, d.Get("freeform_tags_all"))
+
+	// Tags added outside of Terraform show up in the tags of the resource
+	remoteTags["team"] = "db"
+	d = resource.Data(d.State())
+	assert.Empty(t, resource.ReadContext(context.Background(), d, withDefaults))
+	assert.Equal(t, map[string]interface{}{"app": "web", "team": "db"}, d.Get("freeform_tags"))
+	delete(remoteTags, "team")
+
+	// Default tags applied before are not moved to the tags of the resource once removed from the provider
+	d = resource.Data(d.State())
+	assert.Empty(t, resource.ReadContext(context.Background(), d, &client.OracleClients{}))
+	assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("freeform_tags"))
+	assert.Equal(t, map[string]interface{}{"app": "web", "CostCenter": "42"}, d.Get("freeform_tags_all"))
+
+	// A configured tag equal to a default tag is kept
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "web", "CostCenter": "42"}})
+	assert.Empty(t, resource.CreateContext(context.Background(), d, withDefaults))
+	assert.Equal(t, map[string]interface{}{"app": "web", "CostCenter": "42"}, d.Get("freeform_tags"))
+
+	// Resources that cannot update their tags are replaced when the default tags change
+	forceNew := &schema.Resource{Schema: map[string]*schema.Schema{"freeform_tags": {Type: schema.TypeMap, Optional: true, Computed: true, ForceNew: true, Elem: schema.TypeString}}}
+	AddDefaultTags(forceNew)
+	assert.True(t, forceNew.Schema["freeform_tags_all"].ForceNew)
+	assert.Nil(t, forceNew.UpdateContext)
+}
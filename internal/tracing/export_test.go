@@ -0,0 +1,7 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tracing
+
+// Exported for the tests of package tracing_test, which use the collector stub of package tracingtest
+var ParseTraceParent = parseTraceParent
@@ -0,0 +1,279 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package tracing records OpenTelemetry spans of Terraform operations, OCI API calls and polling with the OpenTelemetry
+// SDK, which exports them with its OTLP/HTTP exporter. Tracing is configured with the standard OTEL_* environment
+// variables and is disabled unless an OTLP endpoint is set.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// Span attributes
+const (
+	ResourceTypeAttr      = "oci.resource_type"
+	OperationAttr         = "terraform.operation"
+	OcidAttr              = "oci.ocid"
+	ServiceAttr           = "oci.service"
+	ClientAttr            = "oci.client"
+	OpcRequestIdAttr      = "oci.opc_request_id"
+	RetryAttemptAttr      = "oci.retry_attempt"
+	WorkRequestIdAttr     = "oci.work_request_id"
+	WorkRequestStatusAttr = "oci.work_request_status"
+	LifecycleStateAttr    = "oci.lifecycle_state"
+	HttpMethodAttr        = "http.method"
+	HttpUrlAttr           = "http.url"
+	HttpStatusCodeAttr    = "http.status_code"
+	defaultServiceName    = "terraform-provider-oci"
+	instrumentationName   = "github.com/terraform-providers/terraform-provider-oci"
+	otlpProtocolHttpProto = "http/protobuf"
+	// Maximum time an operation waits for its spans to be exported when it ends
+	flushTimeout = 10 * time.Second
+)
+
+type spanContextKey struct{}
+
+// Span is a timed operation of a trace. All methods are no-ops on a nil span, which is what Start returns while
+// tracing is disabled.
+type Span struct {
+	tracer *Tracer
+	span   trace.Span
+	// export pending spans when the span ends
+	flush bool
+}
+
+// Tracer records spans with the OpenTelemetry SDK and exports them with its OTLP/HTTP exporter
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+	// remote parent of the root spans of the process, from the TRACEPARENT environment variable
+	remoteParent trace.SpanContext
+}
+
+var (
+	tracerMutex sync.RWMutex
+	tracer      *Tracer
+)
+
+// Configure enables tracing if an OTLP endpoint is set in the environment and disables it otherwise. Spans pending
+// with a previous configuration are exported first.
+func Configure() {
+	newTracer, err := newTracerFromEnv()
+	if err != nil {
+		log.Printf("[WARN] tracing is disabled: %v", err)
+	}
+	setTracer(newTracer)
+}
+
+func setTracer(newTracer *Tracer) {
+	tracerMutex.Lock()
+	previous := tracer
+	tracer = newTracer
+	tracerMutex.Unlock()
+
+	previous.shutdown()
+}
+
+func getTracer() *Tracer {
+	tracerMutex.RLock()
+	defer tracerMutex.RUnlock()
+	return tracer
+}
+
+// Enabled returns true if spans are recorded
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+// Flush exports the pending spans
+func Flush() {
+	getTracer().Flush()
+}
+
+func newTracerFromEnv() (*Tracer, error) {
+	if disabled := utils.GetEnvSettingWithBlankDefault(globalvar.OtelSdkDisabledEnv); strings.EqualFold(disabled, "true") {
+		return nil, nil
+	}
+	if exporter := utils.GetEnvSettingWithBlankDefault(globalvar.OtelTracesExporterEnv); exporter != "" && exporter != "otlp" {
+		if exporter != "none" {
+			log.Printf("[WARN] unsupported %s '%s', only otlp is supported", globalvar.OtelTracesExporterEnv, exporter)
+		}
+		return nil, nil
+	}
+	// The exporter defaults to a local collector, spans are only exported to an endpoint set explicitly
+	if utils.GetEnvSettingWithBlankDefault(globalvar.OtelExporterOtlpTracesEndpointEnv) == "" && utils.GetEnvSettingWithBlankDefault(globalvar.OtelExporterOtlpEndpointEnv) == "" {
+		return nil, nil
+	}
+	protocol := utils.GetEnvSettingWithBlankDefault(globalvar.OtelExporterOtlpTracesProtocolEnv)
+	if protocol == "" {
+		protocol = utils.GetEnvSettingWithBlankDefault(globalvar.OtelExporterOtlpProtocolEnv)
+	}
+	if protocol != "" && protocol != otlpProtocolHttpProto {
+		return nil, fmt.Errorf("unsupported OTLP protocol '%s', only %s is supported", protocol, otlpProtocolHttpProto)
+	}
+
+	ctx := context.Background()
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OTLP exporter: %v", err)
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the default service name
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(defaultServiceName), semconv.ServiceVersionKey.String(globalvar.Version)),
+		resource.WithFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource attributes: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	newTracer := &Tracer{
+		provider: provider,
+		tracer:   provider.Tracer(instrumentationName, trace.WithInstrumentationVersion(globalvar.Version)),
+	}
+	if traceParent := utils.GetEnvSettingWithBlankDefault(globalvar.TraceParentEnv); traceParent != "" {
+		if remoteParent, ok := parseTraceParent(traceParent); ok {
+			newTracer.remoteParent = remoteParent
+		} else {
+			log.Printf("[WARN] ignoring invalid %s '%s'", globalvar.TraceParentEnv, traceParent)
+		}
+	}
+	return newTracer, nil
+}
+
+// parseTraceParent parses a W3C trace context traceparent, e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+func parseTraceParent(traceParent string) (trace.SpanContext, bool) {
+	carrier := propagation.MapCarrier{"traceparent": strings.TrimSpace(traceParent)}
+	spanContext := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	return spanContext, spanContext.IsValid()
+}
+
+// Start starts a span that is a child of the span in ctx, if any, and returns a context holding the new span
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, false, trace.WithSpanKind(trace.SpanKindInternal))
+}
+
+// StartClient starts a span of a request to a remote service
+func StartClient(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, false, trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// StartOperation starts the span of a Terraform operation. The spans of the operation are exported when it ends.
+func StartOperation(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, true, trace.WithSpanKind(trace.SpanKindInternal))
+}
+
+// RecordSpan records a span of an interval that already passed, e.g. the backoff between two attempts of a request
+func RecordSpan(ctx context.Context, name string, start time.Time, end time.Time, attributes map[string]string) {
+	t := getTracer()
+	if t == nil {
+		return
+	}
+	_, span := startSpan(t, ctx, name, false, trace.WithSpanKind(trace.SpanKindInternal), trace.WithTimestamp(start))
+	for key, value := range attributes {
+		span.SetAttribute(key, value)
+	}
+	span.span.End(trace.WithTimestamp(end))
+}
+
+func start(ctx context.Context, name string, flush bool, options ...trace.SpanStartOption) (context.Context, *Span) {
+	t := getTracer()
+	if t == nil {
+		return ctx, nil
+	}
+	return startSpan(t, ctx, name, flush, options...)
+}
+
+func startSpan(t *Tracer, ctx context.Context, name string, flush bool, options ...trace.SpanStartOption) (context.Context, *Span) {
+	parentCtx := ctx
+	if !trace.SpanContextFromContext(ctx).IsValid() && t.remoteParent.IsValid() {
+		parentCtx = trace.ContextWithRemoteSpanContext(ctx, t.remoteParent)
+	}
+	spanCtx, otelSpan := t.tracer.Start(parentCtx, name, options...)
+	span := &Span{tracer: t, span: otelSpan, flush: flush}
+	return context.WithValue(spanCtx, spanContextKey{}, span), span
+}
+
+// SpanFromContext returns the span in ctx, nil if there is none
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// SpanId returns the hex encoded ID of the span
+func (s *Span) SpanId() string {
+	if s == nil {
+		return ""
+	}
+	return s.span.SpanContext().SpanID().String()
+}
+
+// TraceId returns the hex encoded ID of the trace of the span
+func (s *Span) TraceId() string {
+	if s == nil {
+		return ""
+	}
+	return s.span.SpanContext().TraceID().String()
+}
+
+// SetAttribute sets an attribute of the span, empty values are ignored
+func (s *Span) SetAttribute(key string, value string) {
+	if s == nil || value == "" {
+		return
+	}
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+// End ends the span, marking it as failed if err is not nil
+func (s *Span) End(err error) {
+	if s == nil || !s.span.IsRecording() {
+		return
+	}
+	if err != nil {
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+	if s.flush {
+		s.tracer.Flush()
+	}
+}
+
+// Flush exports the pending spans of the tracer
+func (t *Tracer) Flush() {
+	if t == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	if err := t.provider.ForceFlush(ctx); err != nil {
+		log.Printf("[WARN] unable to export trace spans: %v", err)
+	}
+}
+
+func (t *Tracer) shutdown() {
+	if t == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	if err := t.provider.Shutdown(ctx); err != nil {
+		log.Printf("[WARN] unable to export trace spans: %v", err)
+	}
+}
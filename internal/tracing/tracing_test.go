@@ -0,0 +1,173 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing/tracingtest"
+)
+
+func setTestEnv(t *testing.T, values map[string]string) {
+	for key, value := range values {
+		previous, exists := os.LookupEnv(key)
+		os.Setenv(key, value)
+		key := key
+		t.Cleanup(func() {
+			if exists {
+				os.Setenv(key, previous)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+}
+
+func TestUnitStartOperation_exportsSpansWhenOperationEnds(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	ctx, operation := tracing.StartOperation(context.Background(), "oci_core_vcn create")
+	operation.SetAttribute(tracing.ResourceTypeAttr, "oci_core_vcn")
+	childCtx, child := tracing.Start(ctx, "WaitForWorkRequest")
+	_, request := tracing.StartClient(childCtx, "oci_core.VirtualNetworkClient GET")
+	request.SetAttribute(tracing.HttpStatusCodeAttr, "")
+	request.End(errors.New("500 Internal Server Error"))
+	child.End(nil)
+	assert.Equal(t, 0, collector.Exports(), "spans should be exported when the operation ends")
+
+	operation.SetAttribute(tracing.OcidAttr, "ocid1.vcn.oc1..aaa")
+	operation.End(nil)
+	operation.End(nil)
+	assert.Equal(t, 1, collector.Exports())
+
+	spans := collector.Spans()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "oci_core.VirtualNetworkClient GET", spans[0].Name)
+	assert.Equal(t, trace.SpanKindClient, spans[0].Kind)
+	assert.Equal(t, "500 Internal Server Error", spans[0].Error)
+	assert.NotContains(t, spans[0].Attributes, tracing.HttpStatusCodeAttr, "empty attributes should not be recorded")
+	assert.Equal(t, "WaitForWorkRequest", spans[1].Name)
+	assert.Equal(t, "oci_core_vcn create", spans[2].Name)
+	assert.Equal(t, map[string]string{tracing.ResourceTypeAttr: "oci_core_vcn", tracing.OcidAttr: "ocid1.vcn.oc1..aaa"}, spans[2].Attributes)
+
+	// Spans of an operation share its trace and are nested
+	assert.Equal(t, spans[1].SpanId, spans[0].ParentSpanId)
+	assert.Equal(t, spans[2].SpanId, spans[1].ParentSpanId)
+	assert.Empty(t, spans[2].ParentSpanId)
+	assert.Equal(t, spans[2].TraceId, spans[0].TraceId)
+	assert.Equal(t, spans[2].TraceId, spans[1].TraceId)
+	assert.False(t, spans[2].End.Before(spans[2].Start))
+}
+
+func TestUnitConfigure(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	setTestEnv(t, map[string]string{
+		globalvar.OtelExporterOtlpEndpointEnv: collector.URL() + "/",
+		globalvar.OtelExporterOtlpHeadersEnv:  "authorization=Bearer%20token,x-tenant=ci",
+		globalvar.OtelServiceNameEnv:          "terraform-ci",
+		globalvar.OtelResourceAttributesEnv:   "deployment.environment=test",
+		globalvar.TraceParentEnv:              "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	tracing.Configure()
+	assert.True(t, tracing.Enabled())
+
+	_, span := tracing.StartOperation(context.Background(), "oci_core_vcn read")
+	span.End(nil)
+	spans := collector.Spans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].TraceId, "root spans should continue the trace of TRACEPARENT")
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].ParentSpanId)
+	assert.Equal(t, "Bearer token", collector.Headers()[0].Get("Authorization"))
+	assert.Equal(t, "ci", collector.Headers()[0].Get("X-Tenant"))
+	assert.Equal(t, "terraform-ci", collector.Resources()[0]["service.name"])
+	assert.Equal(t, globalvar.Version, collector.Resources()[0]["service.version"])
+	assert.Equal(t, "test", collector.Resources()[0]["deployment.environment"])
+
+	type testFormat struct {
+		name string
+		env  map[string]string
+	}
+	tests := []testFormat{
+		{name: "Test tracing is disabled without endpoint", env: map[string]string{globalvar.OtelExporterOtlpEndpointEnv: ""}},
+		{name: "Test tracing is disabled by the SDK setting", env: map[string]string{globalvar.OtelSdkDisabledEnv: "true"}},
+		{name: "Test tracing is disabled without otlp exporter", env: map[string]string{globalvar.OtelTracesExporterEnv: "none"}},
+		{name: "Test unsupported protocol", env: map[string]string{globalvar.OtelExporterOtlpProtocolEnv: "grpc"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestEnv(t, test.env)
+			tracing.Configure()
+			assert.False(t, tracing.Enabled())
+			ctx, span := tracing.Start(context.Background(), "span")
+			assert.Nil(t, span)
+			assert.Nil(t, tracing.SpanFromContext(ctx))
+			span.SetAttribute(tracing.OcidAttr, "ocid1.vcn.oc1..aaa")
+			span.End(nil)
+		})
+	}
+}
+
+func TestUnitRecordSpan(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	ctx, operation := tracing.StartOperation(context.Background(), "oci_core_vcn delete")
+	start := time.Now()
+	tracing.RecordSpan(ctx, "retry backoff", start, start.Add(5e9), map[string]string{tracing.RetryAttemptAttr: "2"})
+	operation.End(nil)
+
+	backoff := collector.SpansNamed("retry backoff")
+	assert.Len(t, backoff, 1)
+	assert.Equal(t, operation.SpanId(), backoff[0].ParentSpanId)
+	assert.Equal(t, int64(5e9), backoff[0].End.Sub(backoff[0].Start).Nanoseconds())
+	assert.Equal(t, "2", backoff[0].Attributes[tracing.RetryAttemptAttr])
+}
+
+func TestUnitFlush_batches(t *testing.T) {
+	collector := tracingtest.StartCollectorStub()
+	defer collector.Close()
+
+	for i := 0; i < sdktrace.DefaultMaxExportBatchSize+1; i++ {
+		_, span := tracing.Start(context.Background(), "span")
+		span.End(nil)
+	}
+	tracing.Flush()
+	assert.Equal(t, 2, collector.Exports(), "spans should be exported in batches")
+	assert.Len(t, collector.Spans(), sdktrace.DefaultMaxExportBatchSize+1)
+}
+
+func TestUnitParseTraceParent(t *testing.T) {
+	type testFormat struct {
+		input string
+		valid bool
+	}
+	tests := []testFormat{
+		{input: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", valid: true},
+		{input: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7"},
+		{input: "00-4bf92f3577b34da6a3ce929d0e0e47zz-00f067aa0ba902b7-01"},
+		{input: "00-4bf92f35-00f067aa0ba902b7-01"},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.input)
+		spanContext, valid := tracing.ParseTraceParent(test.input)
+		assert.Equal(t, test.valid, valid)
+		if valid {
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+			assert.Equal(t, "00f067aa0ba902b7", spanContext.SpanID().String())
+		}
+	}
+}
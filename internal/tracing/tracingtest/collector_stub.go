@@ -0,0 +1,176 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package tracingtest provides an OTLP collector stub to test the spans exported by the provider
+package tracingtest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+)
+
+const (
+	otlpTracesPath        = "/v1/traces"
+	otlpProtobufMediaType = "application/x-protobuf"
+)
+
+// CollectorStub is an in-process OTLP/HTTP collector that keeps the spans it receives, for tests
+type CollectorStub struct {
+	listener net.Listener
+	server   *http.Server
+
+	mutex     sync.Mutex
+	spans     []CollectedSpan
+	resources []map[string]string
+	headers   []http.Header
+}
+
+// CollectedSpan is a span received by the collector stub
+type CollectedSpan struct {
+	TraceId      string
+	SpanId       string
+	ParentSpanId string
+	Name         string
+	Kind         trace.SpanKind
+	Start        time.Time
+	End          time.Time
+	Attributes   map[string]string
+	Error        string
+}
+
+// StartCollectorStub starts a collector stub on a local port and configures tracing to export to it. Close restores
+// the tracing configuration of the environment.
+func StartCollectorStub() *CollectorStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("unable to start collector stub: %v", err))
+	}
+	stub := &CollectorStub{listener: listener}
+	stub.server = &http.Server{Handler: http.HandlerFunc(stub.handle)}
+	go stub.server.Serve(listener)
+
+	previousEndpoint, endpointSet := os.LookupEnv(globalvar.OtelExporterOtlpTracesEndpointEnv)
+	os.Setenv(globalvar.OtelExporterOtlpTracesEndpointEnv, stub.URL()+otlpTracesPath)
+	tracing.Configure()
+	if endpointSet {
+		os.Setenv(globalvar.OtelExporterOtlpTracesEndpointEnv, previousEndpoint)
+	} else {
+		os.Unsetenv(globalvar.OtelExporterOtlpTracesEndpointEnv)
+	}
+	return stub
+}
+
+// URL returns the OTLP endpoint of the collector stub
+func (c *CollectorStub) URL() string {
+	return "http://" + c.listener.Addr().String()
+}
+
+// Close stops the collector stub
+func (c *CollectorStub) Close() {
+	tracing.Configure()
+	c.server.Close()
+}
+
+func (c *CollectorStub) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != otlpTracesPath || r.Header.Get("Content-Type") != otlpProtobufMediaType {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	request := &coltracepb.ExportTraceServiceRequest{}
+	if err == nil {
+		err = proto.Unmarshal(body, request)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.headers = append(c.headers, r.Header)
+	for _, resourceSpans := range request.ResourceSpans {
+		c.resources = append(c.resources, fromOtlpKeyValues(resourceSpans.GetResource().GetAttributes()))
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			for _, span := range scopeSpans.Spans {
+				collected := CollectedSpan{
+					TraceId:    fmt.Sprintf("%x", span.TraceId),
+					SpanId:     fmt.Sprintf("%x", span.SpanId),
+					Name:       span.Name,
+					Kind:       trace.SpanKind(span.Kind),
+					Start:      time.Unix(0, int64(span.StartTimeUnixNano)),
+					End:        time.Unix(0, int64(span.EndTimeUnixNano)),
+					Attributes: fromOtlpKeyValues(span.Attributes),
+					Error:      span.GetStatus().GetMessage(),
+				}
+				if len(span.ParentSpanId) > 0 {
+					collected.ParentSpanId = fmt.Sprintf("%x", span.ParentSpanId)
+				}
+				c.spans = append(c.spans, collected)
+			}
+		}
+	}
+	response, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", otlpProtobufMediaType)
+	w.Write(response)
+}
+
+// Spans returns the spans received so far
+func (c *CollectorStub) Spans() []CollectedSpan {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]CollectedSpan{}, c.spans...)
+}
+
+// SpansNamed returns the spans received so far with the given name
+func (c *CollectorStub) SpansNamed(name string) []CollectedSpan {
+	var result []CollectedSpan
+	for _, span := range c.Spans() {
+		if span.Name == name {
+			result = append(result, span)
+		}
+	}
+	return result
+}
+
+// Headers returns the headers of the export requests received so far
+func (c *CollectorStub) Headers() []http.Header {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]http.Header{}, c.headers...)
+}
+
+// Resources returns the resource attributes of the spans received so far
+func (c *CollectorStub) Resources() []map[string]string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]map[string]string{}, c.resources...)
+}
+
+// Exports returns the number of export requests received so far
+func (c *CollectorStub) Exports() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.headers)
+}
+
+func fromOtlpKeyValues(keyValues []*commonpb.KeyValue) map[string]string {
+	result := make(map[string]string, len(keyValues))
+	for _, keyValue := range keyValues {
+		result[keyValue.Key] = keyValue.GetValue().GetStringValue()
+	}
+	return result
+}
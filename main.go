@@ -9,8 +9,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
 	"github.com/fatih/color"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/resourcediscovery"
@@ -30,20 +28,40 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/provider"
 )
 
+// filterFlags collects the values of the filter flag, which can be specified multiple times
+type filterFlags []string
+
+func (f *filterFlags) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *filterFlags) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 func main() {
-	var command = flag.String("command", "", "Command to run. Supported commands include: 'export', 'list_export_resources' and 'list_export_services'. 'list_export_services' supports json format.")
+	var command = flag.String("command", "", "Command to run. Supported commands include: 'export', 'list_export_resources', 'list_export_services' and 'drift'. 'list_export_services' supports json format.")
 	var listExportServicesPath = flag.String("list_export_services_path", "", "[export] Path to output list of supported services in json format")
 	var compartmentId = flag.String("compartment_id", "", "[export] OCID of a compartment to export. If no compartment id nor name is specified, the root compartment will be used.")
 	var compartmentName = flag.String("compartment_name", "", "[export] The name of a compartment to export.")
+	var compartmentTree = flag.Bool("compartment_tree", false, "[export] Set this to also export all the subcompartments of the compartment, as one module per compartment under directories mirroring the compartment hierarchy.")
 	var includeRelatedResources = flag.Bool("include_related_resources", false, "[export] Set this flag to discover related resources for the resource OCIDs specified in `ids` argument.")
 	var outputPath = flag.String("output_path", "", "[export] Path to output generated configurations and state files of the exported compartment")
 	var services = flag.String("services", "", "[export] Comma-separated list of service resources to export. By default, all compartment-scope resources are exported.")
 	var excludeServices = flag.String("exclude_services", "", "[export] [experimental] Comma-separated list of service resources to exclude from export. If a service is present in both 'services' and 'exclude_services' argument, it will be excluded.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of tuples <resource Type:resource ID> for resources to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
+	var filters filterFlags
+	flag.Var(&filters, "filter", "[export] Filter on an attribute of the discovered resources, e.g. 'defined_tags.Ops.Team=payments', 'display_name=~^prod-' or 'time_created>2024-01-01'. Can be specified multiple times, a resource must match all the filters. The children of excluded resources are not exported. The allowed operators are =, !=, =~, !~, >, <, >= and <=")
 	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
+	var incremental = flag.Bool("incremental", false, "[export][experimental] Set this along with 'generate_state' to re-export into the 'output_path' of a previous export, keeping the names of the exported resources and importing only the resources added or changed since then. The changes are written to 'export_changes.json'. All the resources are still discovered, and the configuration files are generated again without reading the previous ones")
+	var nativeState = flag.Bool("native_state", false, "[export][experimental] Set this along with 'generate_state' to generate the state file from the discovered resources, without the terraform CLI")
+	var statePath = flag.String("state_path", "terraform.tfstate", "[drift] Path of the Terraform state file to compare with the resources in OCI")
+	var driftReportPath = flag.String("drift_report_path", "", "[drift] Path to output the drift report in json format")
 	var help = flag.Bool("help", false, "Prints usage options")
-	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12")
-	var retryTimeout = flag.String("retry_timeout", "15s", "[export] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
+	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12\n * 1.5, with an import block for each discovered resource")
+	var retryTimeout = flag.String("retry_timeout", "15s", "[export][drift] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
+	var outputFormat = flag.String("output_format", "hcl", "[export] The format of the generated configuration files. The allowed values are :\n * hcl\n * json, to generate '.tf.json' files")
 	var parallelism = flag.Int("parallelism", 1, "The number of threads to use for resource discovery. By default the value is 1")
 
 	flag.Parse()
@@ -57,9 +75,7 @@ func main() {
 	if command == nil || *command == "" {
 		log.Println("Executable runs in Terraform plugin mode by default. For additional usage options, please run with the '-help' flag.")
 		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: func() *schema.Provider {
-				return provider.Provider()
-			},
+			GRPCProviderFunc: provider.GRPCProviderServer,
 		})
 	} else {
 		switch *command {
@@ -70,8 +86,10 @@ func main() {
 				terraformVersion = &resourcediscovery.TfHclVersion11{Value: resourcediscovery.TfVersionEnum(*tfVersion)}
 			} else if *tfVersion == "" || resourcediscovery.TfVersionEnum(*tfVersion) == resourcediscovery.TfVersion12 {
 				terraformVersion = &resourcediscovery.TfHclVersion12{Value: resourcediscovery.TfVersionEnum(*tfVersion)}
+			} else if resourcediscovery.TfVersionEnum(*tfVersion) == resourcediscovery.TfVersion15 {
+				terraformVersion = &resourcediscovery.TfHclVersion15{TfHclVersion12: resourcediscovery.TfHclVersion12{Value: resourcediscovery.TfVersionEnum(*tfVersion)}}
 			} else {
-				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12\n", *tfVersion)
+				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12, 1.5\n", *tfVersion)
 				os.Exit(1)
 			}
 
@@ -89,6 +107,11 @@ func main() {
 				RetryTimeout:                 retryTimeout,
 				IsExportWithRelatedResources: *includeRelatedResources,
 				Parallelism:                  *parallelism,
+				CompartmentTree:              *compartmentTree,
+				NativeState:                  *nativeState,
+				OutputFormat:                 resourcediscovery.OutputFormatEnum(*outputFormat),
+				Filters:                      filters,
+				Incremental:                  *incremental,
 			}
 
 			if services != nil && *services != "" {
@@ -118,6 +141,18 @@ func main() {
 				color.Red("%v", err)
 				os.Exit(1)
 			}
+		case "drift":
+			args := &resourcediscovery.DriftCommandArgs{
+				StatePath:    *statePath,
+				ReportPath:   *driftReportPath,
+				RetryTimeout: retryTimeout,
+				Parallelism:  *parallelism,
+			}
+			err, status := resourcediscovery.RunDriftCommand(args)
+			if err != nil {
+				color.Red("%v", err)
+			}
+			os.Exit(int(status))
 		default:
 			log.Printf("[ERROR]: No command '%s' supported\n", *command)
 			os.Exit(1)